@@ -0,0 +1,961 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/raystack/frontier/core/permission"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/raystack/salt/printer"
+	cli "github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	applyManagedByKey   = "managed_by"
+	applyManagedByValue = "frontier-apply"
+)
+
+// ApplyManifest is the declarative state of platform config reconciled by `frontier apply`
+type ApplyManifest struct {
+	Permissions   []ApplyPermission   `yaml:"permissions"`
+	Roles         []ApplyRole         `yaml:"roles"`
+	Organizations []ApplyOrganization `yaml:"organizations"`
+}
+
+type ApplyPermission struct {
+	Name        string `yaml:"name"`
+	Namespace   string `yaml:"namespace"`
+	Key         string `yaml:"key"`
+	Title       string `yaml:"title"`
+	Description string `yaml:"description"`
+}
+
+func (p ApplyPermission) Slug() string {
+	if p.Key != "" {
+		return schema.FQPermissionNameFromNamespace(schema.PermissionNamespaceAndNameFromKey(p.Key))
+	}
+	return schema.FQPermissionNameFromNamespace(p.Namespace, p.Name)
+}
+
+type ApplyRole struct {
+	Name        string   `yaml:"name"`
+	Title       string   `yaml:"title"`
+	Description string   `yaml:"description"`
+	Permissions []string `yaml:"permissions"`
+	Scopes      []string `yaml:"scopes"`
+}
+
+type ApplyOrganization struct {
+	Name     string         `yaml:"name"`
+	Title    string         `yaml:"title"`
+	Avatar   string         `yaml:"avatar"`
+	Metadata map[string]any `yaml:"metadata"`
+	Roles    []ApplyRole    `yaml:"roles"`
+	Groups   []ApplyGroup   `yaml:"groups"`
+	Projects []ApplyProject `yaml:"projects"`
+	Policies []ApplyPolicy  `yaml:"policies"`
+}
+
+type ApplyGroup struct {
+	Name     string         `yaml:"name"`
+	Title    string         `yaml:"title"`
+	Metadata map[string]any `yaml:"metadata"`
+}
+
+type ApplyProject struct {
+	Name     string         `yaml:"name"`
+	Title    string         `yaml:"title"`
+	Metadata map[string]any `yaml:"metadata"`
+	Policies []ApplyPolicy  `yaml:"policies"`
+}
+
+// ApplyPolicy binds a role to a principal over the organization or project it is declared in.
+// Role is a role name or id, principal is in the form of namespace:id, groups of the
+// organization can be referred by name as app/group:<name>
+type ApplyPolicy struct {
+	Role      string `yaml:"role"`
+	Principal string `yaml:"principal"`
+	Title     string `yaml:"title"`
+}
+
+func ApplyCommand(cliConfig *Config) *cli.Command {
+	var dirPath, header string
+	var dryRun, prune bool
+
+	cmd := &cli.Command{
+		Use:   "apply",
+		Short: "Reconcile platform config from a directory of manifests",
+		Long: heredoc.Doc(`
+			Declaratively reconcile permissions, roles, organizations, projects, groups and
+			policies from yaml manifests. All .yaml and .yml files in the directory are merged.
+
+			Only the differences are applied, running apply again without changing the
+			manifests makes no changes. Roles, groups, projects and policies created or
+			updated by apply are tagged with the managed_by=frontier-apply metadata. With
+			--prune, tagged platform roles, and tagged roles, groups, projects and policies
+			of the declared organizations which are not present in the manifests are deleted.
+			Organizations, permissions and items not managed by apply are never deleted.
+		`),
+		Args: cli.NoArgs,
+		Example: heredoc.Doc(`
+			$ frontier apply -f ./platform --header=X-Frontier-Email:admin@acme.org --dry-run
+			$ frontier apply -f ./platform --header=X-Frontier-Email:admin@acme.org --prune
+		`),
+		Annotations: map[string]string{
+			"group":  "core",
+			"client": "true",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			manifest, err := LoadApplyManifest(dirPath)
+			if err != nil {
+				return err
+			}
+
+			ctx := setCtxHeader(cmd.Context(), header)
+			adminClient, cancel, err := createAdminClient(ctx, cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+			client, cancel, err := createClient(ctx, cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			planner := newApplyPlanner(client, adminClient, prune)
+			changes, err := planner.plan(ctx, manifest)
+			if err != nil {
+				return fmt.Errorf("failed to plan changes: %w", err)
+			}
+			if len(changes) == 0 {
+				fmt.Println("no changes, platform config is up to date")
+				return nil
+			}
+
+			report := [][]string{{"OP", "KIND", "NAME"}}
+			for _, c := range changes {
+				report = append(report, []string{c.Op, c.Kind, c.Name})
+			}
+			printer.Table(os.Stdout, report)
+			for _, warning := range planner.warnings {
+				fmt.Println("warning:", warning)
+			}
+			if dryRun {
+				return nil
+			}
+
+			for _, c := range changes {
+				if err := c.run(ctx); err != nil {
+					return fmt.Errorf("failed to %s %s %s: %w", c.Op, c.Kind, c.Name, err)
+				}
+			}
+			fmt.Printf("applied %d changes\n", len(changes))
+			return nil
+		},
+	}
+
+	bindFlagsFromClientConfig(cmd)
+	cmd.Flags().StringVarP(&dirPath, "file", "f", "", "Path to the manifest file or directory")
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only print the changes without applying them")
+	cmd.Flags().BoolVar(&prune, "prune", false, "Delete items managed by apply which are not present in the manifests")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+// LoadApplyManifest reads a manifest file or merges all manifests in a directory
+func LoadApplyManifest(path string) (ApplyManifest, error) {
+	var files []string
+	info, err := os.Stat(path)
+	if err != nil {
+		return ApplyManifest{}, err
+	}
+	if info.IsDir() {
+		if err = filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && (strings.HasSuffix(p, ".yaml") || strings.HasSuffix(p, ".yml")) {
+				files = append(files, p)
+			}
+			return nil
+		}); err != nil {
+			return ApplyManifest{}, err
+		}
+		sort.Strings(files)
+	} else {
+		files = []string{path}
+	}
+
+	var merged ApplyManifest
+	for _, f := range files {
+		fileBytes, err := os.ReadFile(f)
+		if err != nil {
+			return ApplyManifest{}, err
+		}
+		var manifest ApplyManifest
+		if err = yaml.Unmarshal(fileBytes, &manifest); err != nil {
+			return ApplyManifest{}, fmt.Errorf("invalid manifest %s: %w", f, err)
+		}
+		merged.Permissions = append(merged.Permissions, manifest.Permissions...)
+		merged.Roles = append(merged.Roles, manifest.Roles...)
+		merged.Organizations = append(merged.Organizations, manifest.Organizations...)
+	}
+	return merged, validateApplyManifest(merged)
+}
+
+func validateApplyManifest(manifest ApplyManifest) error {
+	seen := map[string]bool{}
+	checkDuplicate := func(kind, name string) error {
+		if name == "" {
+			return fmt.Errorf("%s name can't be empty", kind)
+		}
+		key := kind + "/" + name
+		if seen[key] {
+			return fmt.Errorf("%s %s is declared more than once", kind, name)
+		}
+		seen[key] = true
+		return nil
+	}
+
+	for _, p := range manifest.Permissions {
+		if err := checkDuplicate("permission", p.Slug()); err != nil {
+			return err
+		}
+	}
+	for _, r := range manifest.Roles {
+		if err := checkDuplicate("role", r.Name); err != nil {
+			return err
+		}
+	}
+	for _, o := range manifest.Organizations {
+		if err := checkDuplicate("organization", o.Name); err != nil {
+			return err
+		}
+		for _, r := range o.Roles {
+			if err := checkDuplicate("role", o.Name+"/"+r.Name); err != nil {
+				return err
+			}
+		}
+		for _, g := range o.Groups {
+			if err := checkDuplicate("group", o.Name+"/"+g.Name); err != nil {
+				return err
+			}
+		}
+		for _, p := range o.Projects {
+			if err := checkDuplicate("project", o.Name+"/"+p.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type applyChange struct {
+	Op   string
+	Kind string
+	Name string
+	run  func(ctx context.Context) error
+}
+
+// applyPlanner compares manifests with the state of frontier and builds changes to
+// reconcile them. ids of items which don't exist yet are resolved when changes are run.
+type applyPlanner struct {
+	client      frontierv1beta1.FrontierServiceClient
+	adminClient frontierv1beta1.AdminServiceClient
+	prune       bool
+
+	ids      map[string]string
+	changes  []applyChange
+	deletes  []applyChange
+	warnings []string
+}
+
+func newApplyPlanner(client frontierv1beta1.FrontierServiceClient,
+	adminClient frontierv1beta1.AdminServiceClient, prune bool) *applyPlanner {
+	return &applyPlanner{
+		client:      client,
+		adminClient: adminClient,
+		prune:       prune,
+		ids:         map[string]string{},
+	}
+}
+
+func (a *applyPlanner) add(op, kind, name string, run func(ctx context.Context) error) {
+	c := applyChange{Op: op, Kind: kind, Name: name, run: run}
+	if op == "delete" {
+		// deletes run after creates and updates, children first
+		a.deletes = append([]applyChange{c}, a.deletes...)
+		return
+	}
+	a.changes = append(a.changes, c)
+}
+
+func (a *applyPlanner) plan(ctx context.Context, manifest ApplyManifest) ([]applyChange, error) {
+	if err := a.planPermissions(ctx, manifest.Permissions); err != nil {
+		return nil, err
+	}
+	if err := a.planPlatformRoles(ctx, manifest.Roles); err != nil {
+		return nil, err
+	}
+
+	orgs, err := listAllPages(func(pageToken string) ([]*frontierv1beta1.Organization, string, error) {
+		resp, err := a.adminClient.ListAllOrganizations(ctx, &frontierv1beta1.ListAllOrganizationsRequest{PageToken: pageToken})
+		return resp.GetOrganizations(), resp.GetNextPageToken(), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	existingOrgs := map[string]*frontierv1beta1.Organization{}
+	for _, o := range orgs {
+		existingOrgs[o.GetName()] = o
+	}
+	for _, org := range manifest.Organizations {
+		if err := a.planOrganization(ctx, org, existingOrgs[org.Name]); err != nil {
+			return nil, err
+		}
+	}
+	return append(a.changes, a.deletes...), nil
+}
+
+func (a *applyPlanner) planPermissions(ctx context.Context, permissions []ApplyPermission) error {
+	resp, err := a.client.ListPermissions(ctx, &frontierv1beta1.ListPermissionsRequest{})
+	if err != nil {
+		return err
+	}
+	existing := map[string]*frontierv1beta1.Permission{}
+	for _, p := range resp.GetPermissions() {
+		existing[schema.FQPermissionNameFromNamespace(p.GetNamespace(), p.GetName())] = p
+	}
+
+	declared := map[string]bool{}
+	for _, perm := range permissions {
+		perm := perm
+		declared[perm.Slug()] = true
+
+		op := "create"
+		if current, ok := existing[perm.Slug()]; ok {
+			if current.GetMetadata().AsMap()["description"] == perm.Description {
+				continue
+			}
+			op = "update"
+		}
+		a.add(op, "permission", perm.Slug(), func(ctx context.Context) error {
+			metadata, err := structpb.NewStruct(map[string]any{"description": perm.Description})
+			if err != nil {
+				return err
+			}
+			_, err = a.adminClient.CreatePermission(ctx, &frontierv1beta1.CreatePermissionRequest{
+				Bodies: []*frontierv1beta1.PermissionRequestBody{{
+					Name:      perm.Name,
+					Namespace: perm.Namespace,
+					Key:       perm.Key,
+					Title:     perm.Title,
+					Metadata:  metadata,
+				}},
+			})
+			return err
+		})
+	}
+
+	if a.prune {
+		for slug, p := range existing {
+			if ns, _ := schema.SplitNamespaceResource(p.GetNamespace()); ns == schema.DefaultNamespace || declared[slug] {
+				continue
+			}
+			a.warnings = append(a.warnings, fmt.Sprintf("permission %s is not declared, permissions can only be "+
+				"removed with `frontier server schema rollback`", slug))
+		}
+	}
+	return nil
+}
+
+func (a *applyPlanner) planPlatformRoles(ctx context.Context, roles []ApplyRole) error {
+	resp, err := a.client.ListRoles(ctx, &frontierv1beta1.ListRolesRequest{})
+	if err != nil {
+		return err
+	}
+	existing := map[string]*frontierv1beta1.Role{}
+	for _, r := range resp.GetRoles() {
+		existing[r.GetName()] = r
+		a.ids[roleIDKey("", r.GetName())] = r.GetId()
+	}
+
+	declared := map[string]bool{}
+	for _, role := range roles {
+		role := role
+		declared[role.Name] = true
+		current, ok := existing[role.Name]
+		if !ok {
+			a.add("create", "role", role.Name, func(ctx context.Context) error {
+				body, err := role.toPB()
+				if err != nil {
+					return err
+				}
+				resp, err := a.adminClient.CreateRole(ctx, &frontierv1beta1.CreateRoleRequest{Body: body})
+				if err != nil {
+					return err
+				}
+				a.ids[roleIDKey("", role.Name)] = resp.GetRole().GetId()
+				return nil
+			})
+			continue
+		}
+		if role.isSame(current) {
+			continue
+		}
+		a.add("update", "role", role.Name, func(ctx context.Context) error {
+			body, err := role.toPB()
+			if err != nil {
+				return err
+			}
+			_, err = a.adminClient.UpdateRole(ctx, &frontierv1beta1.UpdateRoleRequest{Id: current.GetId(), Body: body})
+			return err
+		})
+	}
+
+	if a.prune {
+		predefined := map[string]bool{}
+		for _, r := range schema.PredefinedRoles {
+			predefined[r.Name] = true
+		}
+		for name, r := range existing {
+			if declared[name] || predefined[name] || !isApplyManaged(r.GetMetadata()) {
+				continue
+			}
+			id := r.GetId()
+			a.add("delete", "role", name, func(ctx context.Context) error {
+				_, err := a.adminClient.DeleteRole(ctx, &frontierv1beta1.DeleteRoleRequest{Id: id})
+				return err
+			})
+		}
+	}
+	return nil
+}
+
+func (a *applyPlanner) planOrganization(ctx context.Context, org ApplyOrganization, current *frontierv1beta1.Organization) error {
+	orgKey := "org/" + org.Name
+	if current == nil {
+		a.add("create", "organization", org.Name, func(ctx context.Context) error {
+			body, err := org.toPB()
+			if err != nil {
+				return err
+			}
+			resp, err := a.client.CreateOrganization(ctx, &frontierv1beta1.CreateOrganizationRequest{Body: body})
+			if err != nil {
+				return err
+			}
+			a.ids[orgKey] = resp.GetOrganization().GetId()
+			return nil
+		})
+	} else {
+		a.ids[orgKey] = current.GetId()
+		if org.Title != current.GetTitle() || org.Avatar != current.GetAvatar() || !isSameMetadata(org.Metadata, current.GetMetadata()) {
+			a.add("update", "organization", org.Name, func(ctx context.Context) error {
+				body, err := org.toPB()
+				if err != nil {
+					return err
+				}
+				_, err = a.client.UpdateOrganization(ctx, &frontierv1beta1.UpdateOrganizationRequest{Id: current.GetId(), Body: body})
+				return err
+			})
+		}
+	}
+
+	if err := a.planOrganizationRoles(ctx, org, current); err != nil {
+		return err
+	}
+	if err := a.planGroups(ctx, org, current); err != nil {
+		return err
+	}
+	if err := a.planProjects(ctx, org, current); err != nil {
+		return err
+	}
+
+	var existingPolicies []*frontierv1beta1.Policy
+	if current != nil {
+		var err error
+		existingPolicies, err = a.listPolicies(ctx, &frontierv1beta1.ListPoliciesRequest{OrgId: current.GetId()})
+		if err != nil {
+			return err
+		}
+	}
+	a.planPolicies(org.Name, org.Name, schema.OrganizationNamespace, orgKey, org.Policies, existingPolicies)
+	return nil
+}
+
+func (a *applyPlanner) listPolicies(ctx context.Context, request *frontierv1beta1.ListPoliciesRequest) ([]*frontierv1beta1.Policy, error) {
+	return listAllPages(func(pageToken string) ([]*frontierv1beta1.Policy, string, error) {
+		request.PageToken = pageToken
+		resp, err := a.adminClient.ListPolicies(ctx, request)
+		return resp.GetPolicies(), resp.GetNextPageToken(), err
+	})
+}
+
+func (a *applyPlanner) planOrganizationRoles(ctx context.Context, org ApplyOrganization, currentOrg *frontierv1beta1.Organization) error {
+	existing := map[string]*frontierv1beta1.Role{}
+	if currentOrg != nil {
+		resp, err := a.client.ListOrganizationRoles(ctx, &frontierv1beta1.ListOrganizationRolesRequest{OrgId: currentOrg.GetId()})
+		if err != nil {
+			return err
+		}
+		for _, r := range resp.GetRoles() {
+			existing[r.GetName()] = r
+			a.ids[roleIDKey(org.Name, r.GetName())] = r.GetId()
+		}
+	}
+
+	declared := map[string]bool{}
+	for _, role := range org.Roles {
+		role := role
+		declared[role.Name] = true
+		name := org.Name + "/" + role.Name
+		current, ok := existing[role.Name]
+		if !ok {
+			a.add("create", "role", name, func(ctx context.Context) error {
+				body, err := role.toPB()
+				if err != nil {
+					return err
+				}
+				resp, err := a.client.CreateOrganizationRole(ctx, &frontierv1beta1.CreateOrganizationRoleRequest{
+					OrgId: a.ids["org/"+org.Name],
+					Body:  body,
+				})
+				if err != nil {
+					return err
+				}
+				a.ids[roleIDKey(org.Name, role.Name)] = resp.GetRole().GetId()
+				return nil
+			})
+			continue
+		}
+		if role.isSame(current) {
+			continue
+		}
+		a.add("update", "role", name, func(ctx context.Context) error {
+			body, err := role.toPB()
+			if err != nil {
+				return err
+			}
+			_, err = a.client.UpdateOrganizationRole(ctx, &frontierv1beta1.UpdateOrganizationRoleRequest{
+				Id:    current.GetId(),
+				OrgId: current.GetOrgId(),
+				Body:  body,
+			})
+			return err
+		})
+	}
+
+	if a.prune {
+		for name, r := range existing {
+			if declared[name] || !isApplyManaged(r.GetMetadata()) {
+				continue
+			}
+			id, orgID := r.GetId(), r.GetOrgId()
+			a.add("delete", "role", org.Name+"/"+name, func(ctx context.Context) error {
+				_, err := a.client.DeleteOrganizationRole(ctx, &frontierv1beta1.DeleteOrganizationRoleRequest{Id: id, OrgId: orgID})
+				return err
+			})
+		}
+	}
+	return nil
+}
+
+func (a *applyPlanner) planGroups(ctx context.Context, org ApplyOrganization, currentOrg *frontierv1beta1.Organization) error {
+	existing := map[string]*frontierv1beta1.Group{}
+	if currentOrg != nil {
+		groups, err := listAllPages(func(pageToken string) ([]*frontierv1beta1.Group, string, error) {
+			resp, err := a.client.ListOrganizationGroups(ctx, &frontierv1beta1.ListOrganizationGroupsRequest{
+				OrgId:     currentOrg.GetId(),
+				PageToken: pageToken,
+			})
+			return resp.GetGroups(), resp.GetNextPageToken(), err
+		})
+		if err != nil {
+			return err
+		}
+		for _, g := range groups {
+			existing[g.GetName()] = g
+			a.ids[groupIDKey(org.Name, g.GetName())] = g.GetId()
+		}
+	}
+
+	declared := map[string]bool{}
+	for _, group := range org.Groups {
+		group := group
+		declared[group.Name] = true
+		name := org.Name + "/" + group.Name
+		current, ok := existing[group.Name]
+		if !ok {
+			a.add("create", "group", name, func(ctx context.Context) error {
+				body, err := group.toPB(nil)
+				if err != nil {
+					return err
+				}
+				resp, err := a.client.CreateGroup(ctx, &frontierv1beta1.CreateGroupRequest{
+					OrgId: a.ids["org/"+org.Name],
+					Body:  body,
+				})
+				if err != nil {
+					return err
+				}
+				a.ids[groupIDKey(org.Name, group.Name)] = resp.GetGroup().GetId()
+				return nil
+			})
+			continue
+		}
+		if group.Title == current.GetTitle() && isSameManagedMetadata(group.Metadata, current.GetMetadata()) {
+			continue
+		}
+		a.add("update", "group", name, func(ctx context.Context) error {
+			body, err := group.toPB(current.GetMetadata())
+			if err != nil {
+				return err
+			}
+			_, err = a.client.UpdateGroup(ctx, &frontierv1beta1.UpdateGroupRequest{
+				Id:    current.GetId(),
+				OrgId: current.GetOrgId(),
+				Body:  body,
+			})
+			return err
+		})
+	}
+
+	if a.prune {
+		for name, g := range existing {
+			if declared[name] || !isApplyManaged(g.GetMetadata()) {
+				continue
+			}
+			id, orgID := g.GetId(), g.GetOrgId()
+			a.add("delete", "group", org.Name+"/"+name, func(ctx context.Context) error {
+				_, err := a.client.DeleteGroup(ctx, &frontierv1beta1.DeleteGroupRequest{Id: id, OrgId: orgID})
+				return err
+			})
+		}
+	}
+	return nil
+}
+
+func (a *applyPlanner) planProjects(ctx context.Context, org ApplyOrganization, currentOrg *frontierv1beta1.Organization) error {
+	existing := map[string]*frontierv1beta1.Project{}
+	if currentOrg != nil {
+		projects, err := listAllPages(func(pageToken string) ([]*frontierv1beta1.Project, string, error) {
+			resp, err := a.client.ListOrganizationProjects(ctx, &frontierv1beta1.ListOrganizationProjectsRequest{
+				Id:        currentOrg.GetId(),
+				PageToken: pageToken,
+			})
+			return resp.GetProjects(), resp.GetNextPageToken(), err
+		})
+		if err != nil {
+			return err
+		}
+		for _, p := range projects {
+			existing[p.GetName()] = p
+		}
+	}
+
+	declared := map[string]bool{}
+	for _, project := range org.Projects {
+		project := project
+		declared[project.Name] = true
+		name := org.Name + "/" + project.Name
+		projectKey := "project/" + name
+		current, ok := existing[project.Name]
+		if !ok {
+			a.add("create", "project", name, func(ctx context.Context) error {
+				body, err := project.toPB(a.ids["org/"+org.Name], nil)
+				if err != nil {
+					return err
+				}
+				resp, err := a.client.CreateProject(ctx, &frontierv1beta1.CreateProjectRequest{Body: body})
+				if err != nil {
+					return err
+				}
+				a.ids[projectKey] = resp.GetProject().GetId()
+				return nil
+			})
+		} else {
+			a.ids[projectKey] = current.GetId()
+			if project.Title != current.GetTitle() || !isSameManagedMetadata(project.Metadata, current.GetMetadata()) {
+				a.add("update", "project", name, func(ctx context.Context) error {
+					body, err := project.toPB(current.GetOrgId(), current.GetMetadata())
+					if err != nil {
+						return err
+					}
+					_, err = a.client.UpdateProject(ctx, &frontierv1beta1.UpdateProjectRequest{Id: current.GetId(), Body: body})
+					return err
+				})
+			}
+		}
+
+		var existingPolicies []*frontierv1beta1.Policy
+		if ok {
+			var err error
+			existingPolicies, err = a.listPolicies(ctx, &frontierv1beta1.ListPoliciesRequest{ProjectId: current.GetId()})
+			if err != nil {
+				return err
+			}
+		}
+		a.planPolicies(org.Name, name, schema.ProjectNamespace, projectKey, project.Policies, existingPolicies)
+	}
+
+	if a.prune {
+		for name, p := range existing {
+			if declared[name] || !isApplyManaged(p.GetMetadata()) {
+				continue
+			}
+			id := p.GetId()
+			a.add("delete", "project", org.Name+"/"+name, func(ctx context.Context) error {
+				_, err := a.client.DeleteProject(ctx, &frontierv1beta1.DeleteProjectRequest{Id: id})
+				return err
+			})
+		}
+	}
+	return nil
+}
+
+// planPolicies reconciles policies over a resource, only policies created by apply are pruned
+// to keep the ones frontier creates itself like the owner of an organization
+func (a *applyPlanner) planPolicies(orgName, resourceName, resourceNamespace, resourceKey string,
+	policies []ApplyPolicy, existing []*frontierv1beta1.Policy) {
+	matched := map[string]bool{}
+	for _, pol := range policies {
+		pol := pol
+		roleID := a.resolveRoleID(orgName, pol.Role)
+		principal := a.resolvePrincipal(orgName, pol.Principal)
+
+		found := false
+		for _, e := range existing {
+			if e.GetRoleId() == roleID && e.GetPrincipal() == principal {
+				matched[e.GetId()] = true
+				found = true
+				break
+			}
+		}
+		if found {
+			continue
+		}
+
+		a.add("create", "policy", fmt.Sprintf("%s %s@%s", resourceName, pol.Role, pol.Principal), func(ctx context.Context) error {
+			metadata, err := structpb.NewStruct(map[string]any{applyManagedByKey: applyManagedByValue})
+			if err != nil {
+				return err
+			}
+			_, err = a.client.CreatePolicy(ctx, &frontierv1beta1.CreatePolicyRequest{
+				Body: &frontierv1beta1.PolicyRequestBody{
+					RoleId:    a.resolveRoleID(orgName, pol.Role),
+					Resource:  schema.JoinNamespaceAndResourceID(resourceNamespace, a.ids[resourceKey]),
+					Principal: a.resolvePrincipal(orgName, pol.Principal),
+					Title:     pol.Title,
+					Metadata:  metadata,
+				},
+			})
+			return err
+		})
+	}
+
+	if a.prune {
+		for _, e := range existing {
+			if matched[e.GetId()] || e.GetMetadata().AsMap()[applyManagedByKey] != applyManagedByValue {
+				continue
+			}
+			id := e.GetId()
+			a.add("delete", "policy", fmt.Sprintf("%s %s@%s", resourceName, e.GetRoleId(), e.GetPrincipal()), func(ctx context.Context) error {
+				_, err := a.client.DeletePolicy(ctx, &frontierv1beta1.DeletePolicyRequest{Id: id})
+				return err
+			})
+		}
+	}
+}
+
+// resolveRoleID looks up a role by name in the organization and then the platform,
+// if not found the role is assumed to be referred by its id
+func (a *applyPlanner) resolveRoleID(orgName, role string) string {
+	if id, ok := a.ids[roleIDKey(orgName, role)]; ok {
+		return id
+	}
+	if id, ok := a.ids[roleIDKey("", role)]; ok {
+		return id
+	}
+	return role
+}
+
+// resolvePrincipal replaces group names with their ids
+func (a *applyPlanner) resolvePrincipal(orgName, principal string) string {
+	ns, id, err := schema.SplitNamespaceAndResourceID(principal)
+	if err != nil || ns != schema.GroupPrincipal {
+		return principal
+	}
+	if groupID, ok := a.ids[groupIDKey(orgName, id)]; ok {
+		return schema.JoinNamespaceAndResourceID(ns, groupID)
+	}
+	return principal
+}
+
+func roleIDKey(orgName, roleName string) string {
+	return "role/" + orgName + "/" + roleName
+}
+
+func groupIDKey(orgName, groupName string) string {
+	return "group/" + orgName + "/" + groupName
+}
+
+func (r ApplyRole) toPB() (*frontierv1beta1.RoleRequestBody, error) {
+	metadata, err := structpb.NewStruct(map[string]any{
+		"description":     r.Description,
+		applyManagedByKey: applyManagedByValue,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &frontierv1beta1.RoleRequestBody{
+		Name:        r.Name,
+		Title:       r.Title,
+		Permissions: r.Permissions,
+		Scopes:      r.Scopes,
+		Metadata:    metadata,
+	}, nil
+}
+
+func (r ApplyRole) isSame(current *frontierv1beta1.Role) bool {
+	if r.Title != current.GetTitle() || !isApplyManaged(current.GetMetadata()) {
+		return false
+	}
+	var perms, currentPerms []string
+	for _, p := range r.Permissions {
+		perms = append(perms, permission.ParsePermissionName(p))
+	}
+	for _, p := range current.GetPermissions() {
+		currentPerms = append(currentPerms, permission.ParsePermissionName(p))
+	}
+	if !isSameSet(perms, currentPerms) || !isSameSet(r.Scopes, current.GetScopes()) {
+		return false
+	}
+	return current.GetMetadata().AsMap()["description"] == r.Description
+}
+
+// isSameSet compares two lists ignoring order and duplicates
+func isSameSet(a, b []string) bool {
+	as, bs := map[string]bool{}, map[string]bool{}
+	for _, v := range a {
+		as[v] = true
+	}
+	for _, v := range b {
+		bs[v] = true
+	}
+	if len(as) != len(bs) {
+		return false
+	}
+	for v := range as {
+		if !bs[v] {
+			return false
+		}
+	}
+	return true
+}
+
+func (o ApplyOrganization) toPB() (*frontierv1beta1.OrganizationRequestBody, error) {
+	metadata, err := metadataToPB(o.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	return &frontierv1beta1.OrganizationRequestBody{
+		Name:     o.Name,
+		Title:    o.Title,
+		Avatar:   o.Avatar,
+		Metadata: metadata,
+	}, nil
+}
+
+func (g ApplyGroup) toPB(current *structpb.Struct) (*frontierv1beta1.GroupRequestBody, error) {
+	metadata, err := managedMetadataToPB(g.Metadata, current)
+	if err != nil {
+		return nil, err
+	}
+	return &frontierv1beta1.GroupRequestBody{
+		Name:     g.Name,
+		Title:    g.Title,
+		Metadata: metadata,
+	}, nil
+}
+
+func (p ApplyProject) toPB(orgID string, current *structpb.Struct) (*frontierv1beta1.ProjectRequestBody, error) {
+	metadata, err := managedMetadataToPB(p.Metadata, current)
+	if err != nil {
+		return nil, err
+	}
+	return &frontierv1beta1.ProjectRequestBody{
+		Name:     p.Name,
+		Title:    p.Title,
+		OrgId:    orgID,
+		Metadata: metadata,
+	}, nil
+}
+
+func metadataToPB(m map[string]any) (*structpb.Struct, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return structpb.NewStruct(m)
+}
+
+// managedMetadataToPB tags declared metadata as managed by apply, if metadata is not
+// declared the current metadata is kept and only tagged
+func managedMetadataToPB(declared map[string]any, current *structpb.Struct) (*structpb.Struct, error) {
+	metadata := map[string]any{}
+	if declared == nil {
+		declared = current.AsMap()
+	}
+	for k, v := range declared {
+		metadata[k] = v
+	}
+	metadata[applyManagedByKey] = applyManagedByValue
+	return structpb.NewStruct(metadata)
+}
+
+// isApplyManaged reports if an item was created or last updated by apply
+func isApplyManaged(metadata *structpb.Struct) bool {
+	return metadata.AsMap()[applyManagedByKey] == applyManagedByValue
+}
+
+// isSameMetadata compares declared metadata with current, undeclared metadata is left as is
+func isSameMetadata(declared map[string]any, current *structpb.Struct) bool {
+	if declared == nil {
+		return true
+	}
+	declaredPB, err := structpb.NewStruct(declared)
+	if err != nil {
+		return false
+	}
+	if current == nil {
+		current = &structpb.Struct{}
+	}
+	return proto.Equal(declaredPB, current)
+}
+
+// isSameManagedMetadata is isSameMetadata for items tagged as managed by apply, items
+// which are not tagged yet are reported as changed to be tagged
+func isSameManagedMetadata(declared map[string]any, current *structpb.Struct) bool {
+	if !isApplyManaged(current) {
+		return false
+	}
+	if declared == nil {
+		return true
+	}
+	declaredPB, err := managedMetadataToPB(declared, nil)
+	if err != nil {
+		return false
+	}
+	return proto.Equal(declaredPB, current)
+}
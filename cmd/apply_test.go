@@ -0,0 +1,93 @@
+package cmd_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/raystack/frontier/cmd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadApplyManifest(t *testing.T) {
+	t.Run("should merge all manifests in a directory", func(t *testing.T) {
+		dir := t.TempDir()
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "permissions.yaml"), []byte(`
+permissions:
+  - name: get
+    namespace: potato/cart
+roles:
+  - name: cart_viewer
+    permissions:
+      - potato_cart_get
+`), 0o600))
+		assert.NoError(t, os.MkdirAll(filepath.Join(dir, "orgs"), 0o700))
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "orgs", "acme.yml"), []byte(`
+organizations:
+  - name: acme
+    title: Acme
+    groups:
+      - name: admins
+    projects:
+      - name: shop
+        policies:
+          - role: cart_viewer
+            principal: app/group:admins
+`), 0o600))
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a manifest"), 0o600))
+
+		manifest, err := cmd.LoadApplyManifest(dir)
+		assert.NoError(t, err)
+		assert.Len(t, manifest.Permissions, 1)
+		assert.Equal(t, "potato_cart_get", manifest.Permissions[0].Slug())
+		assert.Len(t, manifest.Roles, 1)
+		assert.Len(t, manifest.Organizations, 1)
+		assert.Equal(t, "app/group:admins", manifest.Organizations[0].Projects[0].Policies[0].Principal)
+	})
+	t.Run("should fail if an item is declared more than once", func(t *testing.T) {
+		dir := t.TempDir()
+		orgManifest := []byte(`
+organizations:
+  - name: acme
+`)
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "a.yaml"), orgManifest, 0o600))
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "b.yaml"), orgManifest, 0o600))
+
+		_, err := cmd.LoadApplyManifest(dir)
+		assert.EqualError(t, err, "organization acme is declared more than once")
+	})
+}
+
+func TestClientApply(t *testing.T) {
+	tests := []struct {
+		name        string
+		subCommands []string
+		err         error
+	}{
+		{
+			name:        "`apply` only should throw error host not found",
+			subCommands: []string{},
+			err:         cmd.ErrClientConfigHostNotFound,
+		},
+		{
+			name:        "`apply` with host flag should throw error missing required flag",
+			subCommands: []string{"-h", "test"},
+			err:         errors.New("required flag(s) \"file\" not set"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := cmd.New(&cmd.Config{})
+
+			buf := new(bytes.Buffer)
+			cli.SetOut(buf)
+			cli.SetArgs(append([]string{"apply"}, tt.subCommands...))
+
+			err := cli.Execute()
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, "", buf.String())
+		})
+	}
+}
@@ -0,0 +1,454 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/raystack/frontier/pkg/server/consts"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/raystack/salt/printer"
+	cli "github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	mailOTPStrategy = "mailotp"
+
+	clientCredentialsGrant = "client_credentials"
+	deviceCodeGrant        = "urn:ietf:params:oauth:grant-type:device_code"
+	defaultCallbackURL     = "http://localhost:7410/callback"
+
+	// tokenRefreshLeeway refreshes the access token before it expires
+	tokenRefreshLeeway = 30 * time.Second
+	browserLoginWait   = 5 * time.Minute
+	devicePollInterval = 5 * time.Second
+)
+
+var (
+	ErrNotLoggedIn = errors.New("not logged in, run `frontier auth login`")
+)
+
+func AuthCommand(cliConfig *Config) *cli.Command {
+	cmd := &cli.Command{
+		Use:   "auth",
+		Short: "Authenticate frontier client with a user or service user",
+		Long: heredoc.Doc(`
+			Login with a user or a service user, the access token is stored in client
+			config and attached to every request sent to the same host. Tokens are
+			refreshed automatically with the login session or client credentials.
+
+			An additional header with "key:value" format can still be sent with the
+			--header flag of a command.
+		`),
+		Example: heredoc.Doc(`
+			$ frontier auth login --email=user@raystack.org
+			$ frontier auth login --strategy=google
+			$ frontier auth login --device
+			$ frontier auth login --client-id=<id> --client-secret=<secret>
+			$ frontier auth status
+			$ frontier auth logout
+		`),
+		Annotations: map[string]string{
+			"group":  "core",
+			"client": "true",
+		},
+	}
+
+	cmd.AddCommand(loginAuthCommand(cliConfig))
+	cmd.AddCommand(logoutAuthCommand(cliConfig))
+	cmd.AddCommand(statusAuthCommand(cliConfig))
+
+	bindFlagsFromClientConfig(cmd)
+
+	return cmd
+}
+
+func loginAuthCommand(cliConfig *Config) *cli.Command {
+	var email, strategy, callbackURL, clientID, clientSecret string
+	var device bool
+
+	cmd := &cli.Command{
+		Use:   "login",
+		Short: "Login to a frontier host",
+		Long: heredoc.Doc(`
+			Login to a frontier host with one of the flows:
+			  --email                        a one time code is sent to the email
+			  --strategy                     login with an oidc provider in the browser, the
+			                                 callback url must be allowed in server config
+			  --device                       approve the login with a code in a browser of
+			                                 any device, for hosts without a browser
+			  --client-id, --client-secret   login as a service user
+
+			Credentials are only sent over tls, set "ca_cert_file" in client config or
+			"insecure: true" for local development.
+		`),
+		Args: cli.NoArgs,
+		Example: heredoc.Doc(`
+			$ frontier auth login --email=user@raystack.org
+			$ frontier auth login --strategy=google --callback-url=http://localhost:7410/callback
+			$ frontier auth login --device
+			$ frontier auth login --client-id=<id> --client-secret=<secret>
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			if (clientID != "") != (clientSecret != "") {
+				return errors.New("both --client-id and --client-secret are required")
+			}
+			if clientID == "" && email == "" && strategy == "" && !device {
+				return errors.New("one of --email, --strategy, --device or --client-id is required")
+			}
+			if config := loadConnectionConfig(); config.CACertFile == "" && !config.Insecure {
+				return ErrInsecureTransport
+			}
+
+			client, cancel, err := createAnonymousClient(cmd.Context(), cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			var auth AuthConfig
+			switch {
+			case clientID != "":
+				auth, err = loginWithClientCredentials(cmd.Context(), client, clientID, clientSecret)
+			case email != "":
+				auth, err = loginWithMailOTP(cmd.Context(), client, email)
+			case strategy != "":
+				auth, err = loginWithBrowser(cmd.Context(), client, strategy, callbackURL)
+			case device:
+				auth, err = loginWithDevice(cmd.Context(), client)
+			}
+			if err != nil {
+				return err
+			}
+
+			auth.Host = cliConfig.Host
+			if err := saveAuthConfig(auth); err != nil {
+				return err
+			}
+			fmt.Printf("successfully logged in to %s\n", cliConfig.Host)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&email, "email", "", "Email to receive a one time login code")
+	cmd.Flags().StringVar(&strategy, "strategy", "", "OIDC strategy name to login with in the browser")
+	cmd.Flags().StringVar(&callbackURL, "callback-url", defaultCallbackURL, "Local url the browser is redirected to after login")
+	cmd.Flags().BoolVar(&device, "device", false, "Login by approving a code in a browser of any device")
+	cmd.Flags().StringVar(&clientID, "client-id", "", "Service user client id")
+	cmd.Flags().StringVar(&clientSecret, "client-secret", "", "Service user client secret")
+
+	return cmd
+}
+
+func logoutAuthCommand(cliConfig *Config) *cli.Command {
+	cmd := &cli.Command{
+		Use:     "logout",
+		Short:   "Logout and remove stored credentials",
+		Args:    cli.NoArgs,
+		Example: "$ frontier auth logout",
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			config, err := LoadConfig()
+			if err != nil || config.Auth.Host == "" {
+				return ErrNotLoggedIn
+			}
+
+			// end the user session, client credentials stay valid until revoked
+			if config.Auth.SessionID != "" {
+				client, cancel, err := createAnonymousClient(cmd.Context(), config.Auth.Host)
+				if err != nil {
+					return err
+				}
+				defer cancel()
+
+				ctx := metadata.AppendToOutgoingContext(cmd.Context(), consts.SessionIDGatewayKey, config.Auth.SessionID)
+				if _, err := client.AuthLogout(ctx, &frontierv1beta1.AuthLogoutRequest{}); err != nil {
+					return err
+				}
+			}
+
+			if err := saveAuthConfig(AuthConfig{}); err != nil {
+				return err
+			}
+			fmt.Printf("successfully logged out from %s\n", config.Auth.Host)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func statusAuthCommand(cliConfig *Config) *cli.Command {
+	cmd := &cli.Command{
+		Use:     "status",
+		Short:   "View the logged in principal",
+		Args:    cli.NoArgs,
+		Example: "$ frontier auth status",
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd.Context(), cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.GetCurrentUser(cmd.Context(), &frontierv1beta1.GetCurrentUserRequest{})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+			report := [][]string{{"TYPE", "ID", "NAME"}}
+			if u := res.GetUser(); u != nil {
+				report = append(report, []string{"user", u.GetId(), u.GetEmail()})
+			}
+			if su := res.GetServiceuser(); su != nil {
+				report = append(report, []string{"serviceuser", su.GetId(), su.GetTitle()})
+			}
+			printer.Table(os.Stdout, report)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func loginWithClientCredentials(ctx context.Context, client frontierv1beta1.FrontierServiceClient,
+	clientID, clientSecret string) (AuthConfig, error) {
+	res, err := client.AuthToken(ctx, &frontierv1beta1.AuthTokenRequest{
+		GrantType:    clientCredentialsGrant,
+		ClientId:     clientID,
+		ClientSecret: clientSecret,
+	})
+	if err != nil {
+		return AuthConfig{}, err
+	}
+	return AuthConfig{
+		AccessToken:  res.GetAccessToken(),
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+	}, nil
+}
+
+func loginWithMailOTP(ctx context.Context, client frontierv1beta1.FrontierServiceClient, email string) (AuthConfig, error) {
+	res, err := client.Authenticate(ctx, &frontierv1beta1.AuthenticateRequest{
+		StrategyName: mailOTPStrategy,
+		Email:        email,
+	})
+	if err != nil {
+		return AuthConfig{}, err
+	}
+
+	fmt.Printf("enter the code sent to %s: ", email)
+	code, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return AuthConfig{}, err
+	}
+	return finishLogin(ctx, client, mailOTPStrategy, res.GetState(), strings.TrimSpace(code))
+}
+
+// loginWithBrowser starts an oidc flow and waits for the provider to redirect
+// the browser back to a local listener with the authorization code
+func loginWithBrowser(ctx context.Context, client frontierv1beta1.FrontierServiceClient,
+	strategy, callbackURL string) (AuthConfig, error) {
+	callback, err := url.Parse(callbackURL)
+	if err != nil {
+		return AuthConfig{}, fmt.Errorf("invalid callback url: %w", err)
+	}
+	listener, err := net.Listen("tcp", callback.Host)
+	if err != nil {
+		return AuthConfig{}, err
+	}
+
+	type callbackParams struct {
+		state, code string
+	}
+	params := make(chan callbackParams, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc(callback.Path, func(w http.ResponseWriter, r *http.Request) {
+		params <- callbackParams{
+			state: r.URL.Query().Get("state"),
+			code:  r.URL.Query().Get("code"),
+		}
+		fmt.Fprintln(w, "login complete, you can close this window")
+	})
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go server.Serve(listener)
+	defer server.Close()
+
+	res, err := client.Authenticate(ctx, &frontierv1beta1.AuthenticateRequest{
+		StrategyName: strategy,
+		CallbackUrl:  callbackURL,
+	})
+	if err != nil {
+		return AuthConfig{}, err
+	}
+	fmt.Printf("open the url in a browser to login:\n%s\n", res.GetEndpoint())
+
+	select {
+	case p := <-params:
+		return finishLogin(ctx, client, strategy, p.state, p.code)
+	case <-time.After(browserLoginWait):
+		return AuthConfig{}, errors.New("timed out waiting for browser login")
+	case <-ctx.Done():
+		return AuthConfig{}, ctx.Err()
+	}
+}
+
+// loginWithDevice starts a device login and polls for the access token until the
+// user approves the code in a browser
+func loginWithDevice(ctx context.Context, client frontierv1beta1.FrontierServiceClient) (AuthConfig, error) {
+	res, err := client.AuthDeviceCode(ctx, &frontierv1beta1.AuthDeviceCodeRequest{})
+	if err != nil {
+		return AuthConfig{}, err
+	}
+	if res.GetVerificationUriComplete() != "" {
+		fmt.Printf("open the url in a browser and confirm the code %s to login:\n%s\n",
+			res.GetUserCode(), res.GetVerificationUriComplete())
+	} else {
+		fmt.Printf("approve the code %s to login\n", res.GetUserCode())
+	}
+
+	interval := time.Duration(res.GetInterval()) * time.Second
+	if interval <= 0 {
+		interval = devicePollInterval
+	}
+	expired := time.After(time.Duration(res.GetExpiresIn()) * time.Second)
+	for {
+		select {
+		case <-time.After(interval):
+		case <-expired:
+			return AuthConfig{}, errors.New("device code expired, login again")
+		case <-ctx.Done():
+			return AuthConfig{}, ctx.Err()
+		}
+
+		var header metadata.MD
+		token, err := client.AuthToken(ctx, &frontierv1beta1.AuthTokenRequest{
+			GrantType:  deviceCodeGrant,
+			DeviceCode: res.GetDeviceCode(),
+		}, grpc.Header(&header))
+		if status.Code(err) == codes.FailedPrecondition {
+			// not approved yet
+			continue
+		}
+		if err != nil {
+			return AuthConfig{}, err
+		}
+		sessionIDs := header.Get(consts.SessionIDGatewayKey)
+		if len(sessionIDs) == 0 {
+			return AuthConfig{}, errors.New("server didn't return a session")
+		}
+		return AuthConfig{
+			AccessToken: token.GetAccessToken(),
+			SessionID:   sessionIDs[0],
+		}, nil
+	}
+}
+
+// finishLogin completes the flow to create a session and exchanges it for an access token
+func finishLogin(ctx context.Context, client frontierv1beta1.FrontierServiceClient,
+	strategy, state, code string) (AuthConfig, error) {
+	var header metadata.MD
+	if _, err := client.AuthCallback(ctx, &frontierv1beta1.AuthCallbackRequest{
+		StrategyName: strategy,
+		State:        state,
+		Code:         code,
+	}, grpc.Header(&header)); err != nil {
+		return AuthConfig{}, err
+	}
+	sessionIDs := header.Get(consts.SessionIDGatewayKey)
+	if len(sessionIDs) == 0 {
+		return AuthConfig{}, errors.New("server didn't return a session")
+	}
+
+	token, err := sessionAccessToken(ctx, client, sessionIDs[0])
+	if err != nil {
+		return AuthConfig{}, err
+	}
+	return AuthConfig{
+		AccessToken: token,
+		SessionID:   sessionIDs[0],
+	}, nil
+}
+
+func sessionAccessToken(ctx context.Context, client frontierv1beta1.FrontierServiceClient, sessionID string) (string, error) {
+	ctx = metadata.AppendToOutgoingContext(ctx, consts.SessionIDGatewayKey, sessionID)
+	res, err := client.AuthToken(ctx, &frontierv1beta1.AuthTokenRequest{})
+	if err != nil {
+		return "", err
+	}
+	return res.GetAccessToken(), nil
+}
+
+// loggedInAccessToken returns the stored access token for the host, refreshing
+// it if it is about to expire. Empty token is returned if not logged in.
+func loggedInAccessToken(ctx context.Context, host string, opts []grpc.DialOption) (string, error) {
+	config, err := LoadConfig()
+	if err != nil || config.Auth.Host == "" || config.Auth.Host != host {
+		return "", nil
+	}
+	auth := config.Auth
+	if auth.AccessToken != "" && !isTokenExpiring(auth.AccessToken) {
+		return auth.AccessToken, nil
+	}
+	if auth.SessionID == "" && auth.ClientID == "" {
+		return "", ErrNotLoggedIn
+	}
+
+	conn, err := grpc.DialContext(ctx, host, opts...)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	client := frontierv1beta1.NewFrontierServiceClient(conn)
+
+	if auth.SessionID != "" {
+		auth.AccessToken, err = sessionAccessToken(ctx, client, auth.SessionID)
+	} else {
+		var refreshed AuthConfig
+		refreshed, err = loginWithClientCredentials(ctx, client, auth.ClientID, auth.ClientSecret)
+		auth.AccessToken = refreshed.AccessToken
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to refresh access token, login again: %w", err)
+	}
+	if err := saveAuthConfig(auth); err != nil {
+		return "", err
+	}
+	return auth.AccessToken, nil
+}
+
+func isTokenExpiring(token string) bool {
+	parsed, err := jwt.ParseInsecure([]byte(token))
+	if err != nil {
+		return true
+	}
+	if parsed.Expiration().IsZero() {
+		return false
+	}
+	return time.Now().Add(tokenRefreshLeeway).After(parsed.Expiration())
+}
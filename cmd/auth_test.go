@@ -0,0 +1,52 @@
+package cmd_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/raystack/frontier/cmd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientAuth(t *testing.T) {
+	tests := []struct {
+		name        string
+		subCommands []string
+		err         error
+	}{
+		{
+			name:        "`auth` login only should throw error host not found",
+			subCommands: []string{"login"},
+			err:         cmd.ErrClientConfigHostNotFound,
+		},
+		{
+			name:        "`auth` login with host flag should throw error missing login flow",
+			subCommands: []string{"login", "-h", "test"},
+			err:         errors.New("one of --email, --strategy, --device or --client-id is required"),
+		},
+		{
+			name:        "`auth` login with client id only should throw error missing secret",
+			subCommands: []string{"login", "-h", "test", "--client-id", "abc"},
+			err:         errors.New("both --client-id and --client-secret are required"),
+		},
+		{
+			name:        "`auth` status without host should throw error host not found",
+			subCommands: []string{"status"},
+			err:         cmd.ErrClientConfigHostNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := cmd.New(&cmd.Config{})
+
+			buf := new(bytes.Buffer)
+			cli.SetOut(buf)
+			cli.SetArgs(append([]string{"auth"}, tt.subCommands...))
+
+			err := cli.Execute()
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, "", buf.String())
+		})
+	}
+}
@@ -12,25 +12,93 @@ import (
 	"google.golang.org/grpc"
 )
 
-func createConnection(ctx context.Context, host string, caCertFile string) (*grpc.ClientConn, error) {
+func createConnection(ctx context.Context, host string) (*grpc.ClientConn, error) {
+	config := loadConnectionConfig()
+	opts, err := connectionOptions(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// attach credentials of `frontier auth login` if issued for the host
+	token, err := loggedInAccessToken(ctx, host, opts)
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		if config.CACertFile == "" && !config.Insecure {
+			return nil, ErrInsecureTransport
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(bearerCredentials{
+			token:    token,
+			insecure: config.Insecure,
+		}))
+	}
+	return grpc.DialContext(ctx, host, opts...)
+}
+
+// loadConnectionConfig reads transport settings of the client config, plaintext
+// connections are used if there is no config
+func loadConnectionConfig() *Config {
+	config, err := LoadConfig()
+	if err != nil {
+		return &Config{}
+	}
+	return config
+}
+
+func connectionOptions(config *Config) ([]grpc.DialOption, error) {
 	creds := insecure.NewCredentials()
-	if caCertFile != "" {
-		tlsCreds, err := credentials.NewClientTLSFromFile(caCertFile, "")
+	if config.CACertFile != "" {
+		tlsCreds, err := credentials.NewClientTLSFromFile(config.CACertFile, "")
 		if err != nil {
 			return nil, err
 		}
 		creds = tlsCreds
 	}
-	opts := []grpc.DialOption{
+	return []grpc.DialOption{
 		grpc.WithTransportCredentials(creds),
 		grpc.WithBlock(),
+	}, nil
+}
+
+// createAnonymousClient creates a client without cached credentials, used to login
+func createAnonymousClient(ctx context.Context, host string) (frontierv1beta1.FrontierServiceClient, func(), error) {
+	dialTimeoutCtx, dialCancel := context.WithTimeout(ctx, time.Second*2)
+	opts, err := connectionOptions(loadConnectionConfig())
+	if err != nil {
+		dialCancel()
+		return nil, nil, err
 	}
-	return grpc.DialContext(ctx, host, opts...)
+	conn, err := grpc.DialContext(dialTimeoutCtx, host, opts...)
+	if err != nil {
+		dialCancel()
+		return nil, nil, err
+	}
+	cancel := func() {
+		dialCancel()
+		conn.Close()
+	}
+	return frontierv1beta1.NewFrontierServiceClient(conn), cancel, nil
+}
+
+// bearerCredentials sends the access token in authorization header of every request,
+// tokens are only sent over tls unless insecure is set in client config
+type bearerCredentials struct {
+	token    string
+	insecure bool
+}
+
+func (b bearerCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + b.token}, nil
+}
+
+func (b bearerCredentials) RequireTransportSecurity() bool {
+	return !b.insecure
 }
 
 func createClient(ctx context.Context, host string) (frontierv1beta1.FrontierServiceClient, func(), error) {
 	dialTimeoutCtx, dialCancel := context.WithTimeout(ctx, time.Second*2)
-	conn, err := createConnection(dialTimeoutCtx, host, "")
+	conn, err := createConnection(dialTimeoutCtx, host)
 	if err != nil {
 		dialCancel()
 		return nil, nil, err
@@ -46,7 +114,7 @@ func createClient(ctx context.Context, host string) (frontierv1beta1.FrontierSer
 
 func createAdminClient(ctx context.Context, host string) (frontierv1beta1.AdminServiceClient, func(), error) {
 	dialTimeoutCtx, dialCancel := context.WithTimeout(ctx, time.Second*2)
-	conn, err := createConnection(dialTimeoutCtx, host, "")
+	conn, err := createConnection(dialTimeoutCtx, host)
 	if err != nil {
 		dialCancel()
 		return nil, nil, err
@@ -90,3 +158,21 @@ func overrideClientConfigHost(cmd *cobra.Command, cliConfig *Config) error {
 func bindFlagsFromClientConfig(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringP("host", "h", "", "Frontier API service to connect to")
 }
+
+// listAllPages calls a paged list request with the token of each next page
+// and returns items of all pages
+func listAllPages[T any](list func(pageToken string) ([]T, string, error)) ([]T, error) {
+	var items []T
+	pageToken := ""
+	for {
+		page, nextPageToken, err := list(pageToken)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+		if nextPageToken == "" {
+			return items, nil
+		}
+		pageToken = nextPageToken
+	}
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/raystack/salt/cmdx"
@@ -9,7 +10,41 @@ import (
 )
 
 type Config struct {
-	Host string `mapstructure:"host"`
+	Host string `yaml:"host" mapstructure:"host"`
+	// CACertFile is the path of the certificate authority to connect to the host over tls
+	CACertFile string `yaml:"ca_cert_file,omitempty" mapstructure:"ca_cert_file"`
+	// Insecure allows sending credentials over a plaintext connection, it should
+	// only be set for local development
+	Insecure bool       `yaml:"insecure,omitempty" mapstructure:"insecure"`
+	Auth     AuthConfig `yaml:"auth,omitempty" mapstructure:"auth"`
+}
+
+// AuthConfig holds credentials cached by `frontier auth login`
+type AuthConfig struct {
+	// Host for which the credentials are issued, they are not sent to other hosts
+	Host        string `yaml:"host,omitempty" mapstructure:"host"`
+	AccessToken string `yaml:"access_token,omitempty" mapstructure:"access_token"`
+
+	// SessionID of a user login used to refresh the access token
+	SessionID string `yaml:"session_id,omitempty" mapstructure:"session_id"`
+
+	// ClientID and ClientSecret of a service user used to refresh the access token
+	ClientID     string `yaml:"client_id,omitempty" mapstructure:"client_id"`
+	ClientSecret string `yaml:"client_secret,omitempty" mapstructure:"client_secret"`
+}
+
+// saveAuthConfig persists credentials in the client config file readable only by the owner
+func saveAuthConfig(auth AuthConfig) error {
+	cfg := cmdx.SetConfig("frontier")
+
+	// start from an empty config if none exists yet
+	var config Config
+	_ = cfg.Load(&config)
+	config.Auth = auth
+	if err := cfg.Write(&config); err != nil {
+		return err
+	}
+	return os.Chmod(cfg.File(), 0o600)
 }
 
 func LoadConfig() (*Config, error) {
@@ -7,13 +7,18 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
+// setCtxHeader appends a <key>:<value> header to outgoing requests, it is a noop
+// for an empty header as requests are authenticated with `frontier auth login`
 func setCtxHeader(ctx context.Context, header string) context.Context {
-	s := strings.Split(header, ":")
+	if header == "" {
+		return ctx
+	}
+	s := strings.SplitN(header, ":", 2)
+	if len(s) != 2 {
+		return ctx
+	}
 	key := s[0]
 	val := s[1]
 
-	md := metadata.New(map[string]string{key: val})
-	ctx = metadata.NewOutgoingContext(ctx, md)
-
-	return ctx
+	return metadata.AppendToOutgoingContext(ctx, key, val)
 }
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/raystack/salt/printer"
+	cli "github.com/spf13/cobra"
+)
+
+// deleteFlags are shared by delete commands of organizations, projects and users
+type deleteFlags struct {
+	header  string
+	dryRun  bool
+	showIDs bool
+	confirm string
+}
+
+func (f *deleteFlags) bind(cmd *cli.Command) {
+	cmd.Flags().StringVarP(&f.header, "header", "H", "", "Header <key>:<value>")
+	cmd.Flags().BoolVar(&f.dryRun, "dry-run", false, "Report what would be deleted without deleting it")
+	cmd.Flags().BoolVar(&f.showIDs, "ids", false, "List ids of everything a dry run would delete instead of counts")
+	cmd.Flags().StringVar(&f.confirm, "confirm", "", "Confirmation token returned by a dry run")
+}
+
+// submitDelete sends a delete request, a dry run prints the impact of the delete
+// along with the token to confirm it
+func submitDelete(ctx context.Context, flags deleteFlags, name string,
+	send func(ctx context.Context, dryRun bool, token string) (*frontierv1beta1.DeleteReport, string, error)) error {
+	report, token, err := send(ctx, flags.dryRun, flags.confirm)
+	if err != nil {
+		return err
+	}
+	if !flags.dryRun {
+		fmt.Printf("successfully deleted %s\n", name)
+		return nil
+	}
+
+	printDeleteReport(report, flags.showIDs)
+	if token != "" {
+		fmt.Printf("\nconfirm the delete of %s with --confirm=%s\n", name, token)
+	}
+	return nil
+}
+
+func printDeleteReport(report *frontierv1beta1.DeleteReport, showIDs bool) {
+	kinds := []struct {
+		kind string
+		ids  []string
+	}{
+		{"organizations", report.GetOrganizations()},
+		{"projects", report.GetProjects()},
+		{"resources", report.GetResources()},
+		{"folders", report.GetFolders()},
+		{"groups", report.GetGroups()},
+		{"roles", report.GetRoles()},
+		{"policies", report.GetPolicies()},
+		{"invitations", report.GetInvitations()},
+		{"users", report.GetUsers()},
+		{"relations", report.GetRelations()},
+	}
+
+	table := [][]string{{"KIND", "COUNT"}}
+	if showIDs {
+		table = [][]string{{"KIND", "IDS"}}
+	}
+	for _, k := range kinds {
+		if showIDs {
+			if len(k.ids) > 0 {
+				table = append(table, []string{k.kind, strings.Join(k.ids, ",")})
+			}
+			continue
+		}
+		table = append(table, []string{k.kind, strconv.Itoa(len(k.ids))})
+	}
+	printer.Table(os.Stdout, table)
+}
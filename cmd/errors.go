@@ -27,4 +27,10 @@ var (
 		
 		Run "frontier help auth" for more information.
 	`))
+	ErrInsecureTransport = errors.New(heredoc.Doc(`
+		Refusing to send credentials over a plaintext connection.
+
+		Set "ca_cert_file" in frontier config to connect over tls, or set
+		"insecure: true" to allow plaintext connections for local development.
+	`))
 )
@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/raystack/frontier/pkg/file"
@@ -24,6 +25,8 @@ func GroupCommand(cliConfig *Config) *cli.Command {
 			$ frontier group edit
 			$ frontier group view
 			$ frontier group list
+			$ frontier group add-users
+			$ frontier group remove-users
 		`),
 		Annotations: map[string]string{
 			"group":  "core",
@@ -35,6 +38,8 @@ func GroupCommand(cliConfig *Config) *cli.Command {
 	cmd.AddCommand(editGroupCommand(cliConfig))
 	cmd.AddCommand(viewGroupCommand(cliConfig))
 	cmd.AddCommand(listGroupCommand(cliConfig))
+	cmd.AddCommand(addUsersGroupCommand(cliConfig))
+	cmd.AddCommand(removeUsersGroupCommand(cliConfig))
 
 	bindFlagsFromClientConfig(cmd)
 
@@ -90,7 +95,6 @@ func createGroupCommand(cliConfig *Config) *cli.Command {
 	cmd.Flags().StringVarP(&filePath, "file", "f", "", "Path to the group body file")
 	cmd.MarkFlagRequired("file")
 	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
-	cmd.MarkFlagRequired("header")
 
 	return cmd
 }
@@ -278,3 +282,109 @@ func listGroupCommand(cliConfig *Config) *cli.Command {
 
 	return cmd
 }
+
+func addUsersGroupCommand(cliConfig *Config) *cli.Command {
+	var filePath, header string
+	var createMissing bool
+
+	cmd := &cli.Command{
+		Use:   "add-users",
+		Short: "Add users to a group in background",
+		Long: heredoc.Doc(`
+			Add users listed in a file, one user id or email per line, as members of a group.
+			Users are added by a background job, users of unknown emails are created
+			with --create-missing.
+		`),
+		Args: cli.ExactArgs(2),
+		Example: heredoc.Doc(`
+			$ frontier group add-users <org-id> <group-id> --file=users.txt --create-missing --header=X-Frontier-Email:admin@acme.org
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			userIDs, err := readBulkItems(filePath)
+			if err != nil {
+				return err
+			}
+
+			ctx := setCtxHeader(cmd.Context(), header)
+			client, cancel, err := createClient(ctx, cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.AddGroupUsers(ctx, &frontierv1beta1.AddGroupUsersRequest{
+				Id:                 args[1],
+				OrgId:              args[0],
+				UserIds:            userIDs,
+				Async:              true,
+				CreateMissingUsers: createMissing,
+			})
+			if err != nil {
+				return err
+			}
+			printQueuedJob(res.GetJobId())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "Path to the file of user ids or emails")
+	cmd.MarkFlagRequired("file")
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	cmd.Flags().BoolVar(&createMissing, "create-missing", false, "Create users of unknown emails")
+
+	return cmd
+}
+
+func removeUsersGroupCommand(cliConfig *Config) *cli.Command {
+	var filePath, header string
+
+	cmd := &cli.Command{
+		Use:   "remove-users",
+		Short: "Remove users from a group in background",
+		Long: heredoc.Doc(`
+			Remove users listed in a file, one user id or email per line, from a group.
+			Users are removed by a background job, the last owner of the group is never removed.
+		`),
+		Args: cli.ExactArgs(2),
+		Example: heredoc.Doc(`
+			$ frontier group remove-users <org-id> <group-id> --file=users.txt --header=X-Frontier-Email:admin@acme.org
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			userIDs, err := readBulkItems(filePath)
+			if err != nil {
+				return err
+			}
+
+			ctx := setCtxHeader(cmd.Context(), header)
+			client, cancel, err := createClient(ctx, cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.RemoveGroupUser(ctx, &frontierv1beta1.RemoveGroupUserRequest{
+				Id:     args[1],
+				OrgId:  args[0],
+				UserId: strings.Join(userIDs, ","),
+				Async:  true,
+			})
+			if err != nil {
+				return err
+			}
+			printQueuedJob(res.GetJobId())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "Path to the file of user ids or emails")
+	cmd.MarkFlagRequired("file")
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+
+	return cmd
+}
@@ -44,7 +44,19 @@ func TestClientGroup(t *testing.T) {
 				name:        "`group` create with host flag should throw error missing required flag",
 				want:        "",
 				subCommands: []string{"create", "-h", "test"},
-				err:         errors.New("required flag(s) \"file\", \"header\" not set"),
+				err:         errors.New("required flag(s) \"file\" not set"),
+			},
+			{
+				name:        "`group` add-users with host flag should throw error missing required flag",
+				want:        "",
+				subCommands: []string{"add-users", orgID, "123", "-h", "test"},
+				err:         errors.New("required flag(s) \"file\" not set"),
+			},
+			{
+				name:        "`group` remove-users with host flag should throw error missing required flag",
+				want:        "",
+				subCommands: []string{"remove-users", orgID, "123", "-h", "test"},
+				err:         errors.New("required flag(s) \"file\" not set"),
 			},
 			{
 				name:        "`group` edit without host should throw error host not found",
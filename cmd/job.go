@@ -0,0 +1,197 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/raystack/salt/printer"
+	cli "github.com/spf13/cobra"
+)
+
+func JobCommand(cliConfig *Config) *cli.Command {
+	cmd := &cli.Command{
+		Use:   "job",
+		Short: "Manage background jobs",
+		Long: heredoc.Doc(`
+			Inspect and cancel background jobs like bulk membership changes. Jobs are
+			queued by async membership requests and run by the workers of all server
+			instances.
+		`),
+		Example: heredoc.Doc(`
+			$ frontier job list --state=running --header=X-Frontier-Email:admin@acme.org
+			$ frontier job view <job-id> --failed --header=X-Frontier-Email:admin@acme.org
+			$ frontier job cancel <job-id> --header=X-Frontier-Email:admin@acme.org
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+	}
+
+	cmd.AddCommand(jobListCommand(cliConfig))
+	cmd.AddCommand(jobViewCommand(cliConfig))
+	cmd.AddCommand(jobCancelCommand(cliConfig))
+
+	bindFlagsFromClientConfig(cmd)
+
+	return cmd
+}
+
+func jobListCommand(cliConfig *Config) *cli.Command {
+	var header, kind, state string
+
+	c := &cli.Command{
+		Use:     "list",
+		Short:   "List background jobs of all users",
+		Long:    "List background jobs of all users, requires a superuser.",
+		Example: "frontier job list --state=running",
+		RunE: func(c *cli.Command, args []string) error {
+			ctx := setCtxHeader(c.Context(), header)
+			adminClient, cancel, err := createAdminClient(ctx, cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := adminClient.ListJobs(ctx, &frontierv1beta1.ListJobsRequest{
+				Kind:  kind,
+				State: state,
+			})
+			if err != nil {
+				return err
+			}
+
+			report := [][]string{{"ID", "KIND", "STATE", "ITEMS", "SUCCEEDED", "FAILED", "CREATED AT"}}
+			for _, j := range res.GetJobs() {
+				report = append(report, []string{
+					j.GetId(),
+					j.GetKind(),
+					j.GetState(),
+					strconv.Itoa(len(j.GetItems())),
+					strconv.Itoa(int(j.GetSucceeded())),
+					strconv.Itoa(int(j.GetFailed())),
+					j.GetCreatedAt().AsTime().String(),
+				})
+			}
+			printer.Table(os.Stdout, report)
+			return nil
+		},
+	}
+
+	c.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	c.Flags().StringVar(&kind, "kind", "", "filter jobs of a kind")
+	c.Flags().StringVar(&state, "state", "", "filter jobs in a state, one of pending|running|completed|failed|cancelled")
+	return c
+}
+
+func jobViewCommand(cliConfig *Config) *cli.Command {
+	var header string
+	var failedOnly bool
+
+	c := &cli.Command{
+		Use:     "view",
+		Short:   "View progress and item results of a background job",
+		Args:    cli.ExactArgs(1),
+		Example: "frontier job view <job-id> --failed",
+		RunE: func(c *cli.Command, args []string) error {
+			ctx := setCtxHeader(c.Context(), header)
+			client, cancel, err := createClient(ctx, cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.GetJob(ctx, &frontierv1beta1.GetJobRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+
+			j := res.GetJob()
+			fmt.Printf("%s %s is %s, %d of %d items processed, %d failed\n",
+				j.GetKind(), j.GetId(), j.GetState(), j.GetSucceeded()+j.GetFailed(), len(j.GetItems()), j.GetFailed())
+			if j.GetError() != "" {
+				fmt.Printf("error: %s\n", j.GetError())
+			}
+
+			report := [][]string{{"ITEM", "STATE", "ERROR"}}
+			for _, item := range j.GetItems() {
+				if failedOnly && item.GetState() != "failed" {
+					continue
+				}
+				report = append(report, []string{item.GetKey(), item.GetState(), item.GetError()})
+			}
+			printer.Table(os.Stdout, report)
+			return nil
+		},
+	}
+
+	c.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	c.Flags().BoolVar(&failedOnly, "failed", false, "only show failed items")
+	return c
+}
+
+func jobCancelCommand(cliConfig *Config) *cli.Command {
+	var header string
+
+	c := &cli.Command{
+		Use:     "cancel",
+		Short:   "Cancel a pending or running background job",
+		Long:    "Items processed before cancellation are not reverted.",
+		Args:    cli.ExactArgs(1),
+		Example: "frontier job cancel <job-id>",
+		RunE: func(c *cli.Command, args []string) error {
+			ctx := setCtxHeader(c.Context(), header)
+			client, cancel, err := createClient(ctx, cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.CancelJob(ctx, &frontierv1beta1.CancelJobRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+			fmt.Printf("cancelled job %s\n", res.GetJob().GetId())
+			return nil
+		},
+	}
+
+	c.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	return c
+}
+
+// readBulkItems reads user ids or emails of a bulk request from a file,
+// one per line, empty lines and lines starting with # are ignored
+func readBulkItems(filePath string) ([]string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var items []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		items = append(items, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("no items found in %s", filePath)
+	}
+	return items, nil
+}
+
+// printQueuedJob prints the id of a job queued by an async request
+func printQueuedJob(jobID string) {
+	fmt.Printf("queued job %s, track it with `frontier job view %s`\n", jobID, jobID)
+}
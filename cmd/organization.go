@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -24,6 +25,10 @@ func OrganizationCommand(cliConfig *Config) *cli.Command {
 			$ frontier organization edit
 			$ frontier organization view
 			$ frontier organization list
+			$ frontier organization export
+			$ frontier organization import
+			$ frontier organization add-users
+			$ frontier organization delete
 		`),
 		Annotations: map[string]string{
 			"group":  "core",
@@ -36,6 +41,10 @@ func OrganizationCommand(cliConfig *Config) *cli.Command {
 	cmd.AddCommand(viewOrganizationCommand(cliConfig))
 	cmd.AddCommand(listOrganizationCommand(cliConfig))
 	cmd.AddCommand(admlistOrganizationCommand(cliConfig))
+	cmd.AddCommand(addUsersOrganizationCommand(cliConfig))
+	cmd.AddCommand(exportOrganizationCommand(cliConfig))
+	cmd.AddCommand(importOrganizationCommand(cliConfig))
+	cmd.AddCommand(deleteOrganizationCommand(cliConfig))
 
 	bindFlagsFromClientConfig(cmd)
 
@@ -92,7 +101,6 @@ func createOrganizationCommand(cliConfig *Config) *cli.Command {
 	cmd.Flags().StringVarP(&filePath, "file", "f", "", "Path to the organization body file")
 	cmd.MarkFlagRequired("file")
 	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
-	cmd.MarkFlagRequired("header")
 
 	return cmd
 }
@@ -228,6 +236,9 @@ func listOrganizationCommand(cliConfig *Config) *cli.Command {
 		Args:  cli.NoArgs,
 		Example: heredoc.Doc(`
 			$ frontier organization list
+			$ frontier organization export
+			$ frontier organization import
+			$ frontier organization add-users
 		`),
 		Annotations: map[string]string{
 			"group": "core",
@@ -327,3 +338,101 @@ func admlistOrganizationCommand(cliConfig *Config) *cli.Command {
 
 	return cmd
 }
+
+func addUsersOrganizationCommand(cliConfig *Config) *cli.Command {
+	var filePath, header string
+	var createMissing bool
+
+	cmd := &cli.Command{
+		Use:   "add-users",
+		Short: "Add users to an organization in background",
+		Long: heredoc.Doc(`
+			Add users listed in a file, one user id or email per line, as members of an
+			organization. Users are added by a background job, users of unknown emails are
+			created with --create-missing.
+		`),
+		Args: cli.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ frontier organization add-users <org-id> --file=users.txt --create-missing --header=X-Frontier-Email:admin@acme.org
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			userIDs, err := readBulkItems(filePath)
+			if err != nil {
+				return err
+			}
+
+			ctx := setCtxHeader(cmd.Context(), header)
+			client, cancel, err := createClient(ctx, cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.AddOrganizationUsers(ctx, &frontierv1beta1.AddOrganizationUsersRequest{
+				Id:                 args[0],
+				UserIds:            userIDs,
+				Async:              true,
+				CreateMissingUsers: createMissing,
+			})
+			if err != nil {
+				return err
+			}
+			printQueuedJob(res.GetJobId())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "Path to the file of user ids or emails")
+	cmd.MarkFlagRequired("file")
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	cmd.Flags().BoolVar(&createMissing, "create-missing", false, "Create users of unknown emails")
+
+	return cmd
+}
+
+func deleteOrganizationCommand(cliConfig *Config) *cli.Command {
+	var flags deleteFlags
+
+	cmd := &cli.Command{
+		Use:   "delete",
+		Short: "Delete an organization",
+		Long: heredoc.Doc(`
+			Delete an organization along with everything nested under it. It can be restored
+			with the enable API until it's purged after the grace period. Run it
+			with --dry-run first to see what would be deleted, the delete can be confirmed
+			with the token returned by the dry run.
+		`),
+		Args: cli.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ frontier organization delete <organization-id> --dry-run
+			$ frontier organization delete <organization-id> --confirm=<token>
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			ctx := setCtxHeader(cmd.Context(), flags.header)
+			client, cancel, err := createClient(ctx, cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			return submitDelete(ctx, flags, args[0], func(ctx context.Context, dryRun bool, token string) (*frontierv1beta1.DeleteReport, string, error) {
+				res, err := client.DeleteOrganization(ctx, &frontierv1beta1.DeleteOrganizationRequest{
+					Id:                args[0],
+					DryRun:            dryRun,
+					ConfirmationToken: token,
+				})
+				return res.GetReport(), res.GetConfirmationToken(), err
+			})
+		},
+	}
+
+	flags.bind(cmd)
+
+	return cmd
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/MakeNowJust/heredoc"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/raystack/salt/printer"
+	cli "github.com/spf13/cobra"
+)
+
+func exportOrganizationCommand(cliConfig *Config) *cli.Command {
+	var header, outPath, format string
+
+	cmd := &cli.Command{
+		Use:   "export",
+		Short: "Export identity graph of an organization",
+		Long: heredoc.Doc(`
+			Export users, groups and their members, projects, custom roles, policies,
+			resources, preferences and domains of an organization to an archive.
+
+			The archive is written as a single json document, or with --format=ndjson as
+			one entity per line which is easier to process for large organizations.
+		`),
+		Args: cli.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ frontier organization export acme -o acme.json --header=X-Frontier-Email:admin@acme.org
+			$ frontier organization export acme -o acme.ndjson --format=ndjson --header=X-Frontier-Email:admin@acme.org
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			ctx := setCtxHeader(cmd.Context(), header)
+			adminClient, cancel, err := createAdminClient(ctx, cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := adminClient.ExportOrganization(ctx, &frontierv1beta1.ExportOrganizationRequest{
+				Id:     args[0],
+				Format: format,
+			})
+			if err != nil {
+				return err
+			}
+
+			if outPath == "" {
+				_, err = os.Stdout.Write(res.GetArchive())
+				return err
+			}
+			if err := os.WriteFile(outPath, res.GetArchive(), 0o600); err != nil {
+				return err
+			}
+			fmt.Printf("exported organization %s to %s\n", args[0], outPath)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	cmd.Flags().StringVarP(&outPath, "output", "o", "", "Path of the archive, written to stdout if not set")
+	cmd.Flags().StringVar(&format, "format", "json", "Format of the archive, one of json|ndjson")
+
+	return cmd
+}
+
+func importOrganizationCommand(cliConfig *Config) *cli.Command {
+	var header, filePath string
+
+	cmd := &cli.Command{
+		Use:   "import",
+		Short: "Import identity graph of an organization",
+		Long: heredoc.Doc(`
+			Recreate an organization from an archive written by export, json and ndjson
+			archives are both accepted.
+
+			Entities are matched by name, users by email, and are only created if missing,
+			importing the same archive again makes no changes. Ids of the archive are
+			remapped to the ids of the created entities. Domains are created unverified and
+			have to be verified again, policies over service users are not imported.
+		`),
+		Args: cli.NoArgs,
+		Example: heredoc.Doc(`
+			$ frontier organization import -f acme.json --header=X-Frontier-Email:admin@acme.org
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			archive, err := os.ReadFile(filePath)
+			if err != nil {
+				return err
+			}
+
+			ctx := setCtxHeader(cmd.Context(), header)
+			adminClient, cancel, err := createAdminClient(ctx, cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := adminClient.ImportOrganization(ctx, &frontierv1beta1.ImportOrganizationRequest{
+				Archive: archive,
+			})
+			if err != nil {
+				return err
+			}
+
+			report := [][]string{{"KIND", "CREATED", "EXISTING", "SKIPPED"}}
+			for _, s := range res.GetStats() {
+				report = append(report, []string{s.GetKind(), fmt.Sprint(s.GetCreated()), fmt.Sprint(s.GetExisting()), fmt.Sprint(s.GetSkipped())})
+			}
+			printer.Table(os.Stdout, report)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "Path to the organization archive")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
@@ -41,7 +41,7 @@ func TestClientOrganization(t *testing.T) {
 				name:        "`organization` create with host flag should throw error missing required flag",
 				want:        "",
 				subCommands: []string{"create", "-h", "test"},
-				err:         errors.New("required flag(s) \"file\", \"header\" not set"),
+				err:         errors.New("required flag(s) \"file\" not set"),
 			},
 			{
 				name:        "`organization` edit without host should throw error host not found",
@@ -67,6 +67,42 @@ func TestClientOrganization(t *testing.T) {
 				subCommands: []string{"view", "123", "-h", "test"},
 				err:         context.DeadlineExceeded,
 			},
+			{
+				name:        "`organization` delete without host should throw error host not found",
+				want:        "",
+				subCommands: []string{"delete", "123", "--dry-run"},
+				err:         cmd.ErrClientConfigHostNotFound,
+			},
+			{
+				name:        "`organization` delete with host flag should pass",
+				want:        "",
+				subCommands: []string{"delete", "123", "--dry-run", "-h", "test"},
+				err:         context.DeadlineExceeded,
+			},
+			{
+				name:        "`organization` add-users with host flag should throw error missing required flag",
+				want:        "",
+				subCommands: []string{"add-users", "123", "-h", "test"},
+				err:         errors.New("required flag(s) \"file\" not set"),
+			},
+			{
+				name:        "`organization` export without host should throw error host not found",
+				want:        "",
+				subCommands: []string{"export", "123"},
+				err:         cmd.ErrClientConfigHostNotFound,
+			},
+			{
+				name:        "`organization` import without host should throw error host not found",
+				want:        "",
+				subCommands: []string{"import"},
+				err:         cmd.ErrClientConfigHostNotFound,
+			},
+			{
+				name:        "`organization` import with host flag should throw error missing required flag",
+				want:        "",
+				subCommands: []string{"import", "-h", "test"},
+				err:         errors.New("required flag(s) \"file\" not set"),
+			},
 		}
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
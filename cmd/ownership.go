@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/raystack/frontier/config"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/permission"
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/preference"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/role"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/store/postgres"
+	"github.com/raystack/frontier/internal/store/spicedb"
+	frontierlogger "github.com/raystack/frontier/pkg/logger"
+	"github.com/raystack/salt/printer"
+	cli "github.com/spf13/cobra"
+)
+
+func serverOrganizationCommand() *cli.Command {
+	cmd := &cli.Command{
+		Use:     "org",
+		Aliases: []string{"organization"},
+		Short:   "Administer organizations",
+		Example: heredoc.Doc(`
+			$ frontier server org repair-owners -c ./config.yaml --dry-run
+			$ frontier server org repair-owners -c ./config.yaml --owner=<user-id>
+		`),
+	}
+
+	cmd.AddCommand(serverOrganizationRepairOwnersCommand())
+	return cmd
+}
+
+func serverOrganizationRepairOwnersCommand() *cli.Command {
+	var configFile, ownerID string
+	var dryRun bool
+
+	c := &cli.Command{
+		Use:   "repair-owners [org-id...]",
+		Short: "Assign an owner to organizations left without one",
+		Long: heredoc.Doc(`
+			Find organizations without an owner and make a user their owner. The user passed
+			with --owner is assigned to every organization, otherwise the first admin of
+			each organization is. Organizations without an admin are reported and skipped.
+			All organizations are checked unless ids are passed as arguments.
+		`),
+		Example: "frontier server org repair-owners -c ./config.yaml --dry-run",
+		RunE: func(c *cli.Command, args []string) error {
+			orgService, userService, cleanup, err := buildOrganizationService(configFile)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			ctx := c.Context()
+			var orgs []organization.Organization
+			if len(args) == 0 {
+				if orgs, err = orgService.List(ctx, organization.Filter{}); err != nil {
+					return err
+				}
+			}
+			for _, id := range args {
+				org, err := orgService.GetRaw(ctx, id)
+				if err != nil {
+					return fmt.Errorf("%w: %s", err, id)
+				}
+				orgs = append(orgs, org)
+			}
+
+			report := [][]string{{"ORGANIZATION", "OWNER", "STATUS"}}
+			for _, org := range orgs {
+				ownerIDs, err := orgService.ListOwnerIDs(ctx, org.ID)
+				if err != nil {
+					return err
+				}
+				if len(ownerIDs) > 0 {
+					continue
+				}
+
+				newOwnerID, err := repairOwnerOf(ctx, userService, org.ID, ownerID)
+				if err != nil {
+					return err
+				}
+				status := "assigned"
+				switch {
+				case newOwnerID == "":
+					status = "skipped, no admin found"
+				case dryRun:
+					status = "would assign"
+				default:
+					if err := orgService.AddOwner(ctx, org.ID, newOwnerID); err != nil {
+						return err
+					}
+				}
+				report = append(report, []string{org.Name, newOwnerID, status})
+			}
+			printer.Table(os.Stdout, report)
+			return nil
+		},
+	}
+
+	c.Flags().StringVarP(&configFile, "config", "c", "", "config file path")
+	c.Flags().StringVar(&ownerID, "owner", "", "id of the user to make owner instead of the first admin")
+	c.Flags().BoolVar(&dryRun, "dry-run", false, "report organizations without owners without assigning any")
+	return c
+}
+
+// repairOwnerOf picks the user to make owner of an organization, an empty id is
+// returned if the organization has no admin to pick
+func repairOwnerOf(ctx context.Context, userService *user.Service, orgID, ownerID string) (string, error) {
+	if ownerID != "" {
+		return ownerID, nil
+	}
+	admins, err := userService.ListByOrg(ctx, orgID, organization.AdminPermission)
+	if err != nil {
+		return "", err
+	}
+	if len(admins) == 0 {
+		return "", nil
+	}
+	return admins[0].ID, nil
+}
+
+func buildOrganizationService(configFile string) (*organization.Service, *user.Service, func(), error) {
+	appConfig, err := config.Load(configFile)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	logger := frontierlogger.InitLogger(appConfig.Log)
+
+	dbc, err := setupDB(appConfig.DB, logger)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	spiceDBClient, err := spicedb.New(appConfig.SpiceDB, logger)
+	if err != nil {
+		dbc.Close()
+		return nil, nil, nil, err
+	}
+
+	relationService := relation.NewService(postgres.NewRelationRepository(dbc),
+		spicedb.NewRelationRepository(spiceDBClient, appConfig.SpiceDB.FullyConsistent))
+	roleService := role.NewService(postgres.NewRoleRepository(dbc), relationService,
+		permission.NewService(postgres.NewPermissionRepository(dbc)))
+	policyService := policy.NewService(postgres.NewPolicyRepository(dbc), relationService, roleService)
+	userService := user.NewService(postgres.NewUserRepository(dbc), relationService)
+	// authentication isn't needed to administer organizations from the command line
+	orgService := organization.NewService(postgres.NewOrganizationRepository(dbc),
+		postgres.NewOrganizationTransferRepository(dbc), relationService, userService, nil,
+		policyService, preference.NewService(postgres.NewPreferenceRepository(dbc)))
+	return orgService, userService, func() {
+		dbc.Close()
+	}, nil
+}
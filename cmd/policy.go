@@ -89,7 +89,6 @@ func createPolicyCommand(cliConfig *Config) *cli.Command {
 	cmd.Flags().StringVarP(&filePath, "file", "f", "", "Path to the policy body file")
 	cmd.MarkFlagRequired("file")
 	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
-	cmd.MarkFlagRequired("header")
 
 	return cmd
 }
@@ -183,12 +182,13 @@ func viewPolicyCommand(cliConfig *Config) *cli.Command {
 
 			spinner.Stop()
 
-			report = append(report, []string{"ID", "RESOURCE", "PRINCIPAL", "ROLEID"})
+			report = append(report, []string{"ID", "RESOURCE", "PRINCIPAL", "ROLEID", "EFFECT"})
 			report = append(report, []string{
 				policy.GetId(),
 				policy.GetResource(),
 				policy.GetPrincipal(),
 				policy.GetRoleId(),
+				policy.GetEffect(),
 			})
 			printer.Table(os.Stdout, report)
 
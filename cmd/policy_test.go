@@ -29,7 +29,7 @@ func TestClientPolicy(t *testing.T) {
 				name:        "`policy` create with host flag should throw error missing required flag",
 				want:        "",
 				subCommands: []string{"create", "-h", "test"},
-				err:         errors.New("required flag(s) \"file\", \"header\" not set"),
+				err:         errors.New("required flag(s) \"file\" not set"),
 			},
 			{
 				name:        "`policy` edit without host should throw error host not found",
@@ -83,7 +83,6 @@ func preferencesListCommand(cliConfig *Config) *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
-	cmd.MarkFlagRequired("header")
 
 	return cmd
 }
@@ -138,7 +137,6 @@ func preferencesSetCommand(cliConfig *Config) *cobra.Command {
 	cmd.Flags().StringVarP(&name, "name", "n", "", "Name of the preference")
 	cmd.Flags().StringVarP(&value, "value", "v", "", "Value of the preference")
 
-	cmd.MarkFlagRequired("header")
 	return cmd
 }
 
@@ -180,7 +178,6 @@ func preferencesGetCommand(cliConfig *Config) *cobra.Command {
 		},
 	}
 	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
-	cmd.MarkFlagRequired("header")
 
 	return cmd
 }
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -24,6 +25,7 @@ func ProjectCommand(cliConfig *Config) *cli.Command {
 			$ frontier project edit
 			$ frontier project view
 			$ frontier project list
+			$ frontier project delete
 		`),
 		Annotations: map[string]string{
 			"group":  "core",
@@ -35,6 +37,7 @@ func ProjectCommand(cliConfig *Config) *cli.Command {
 	cmd.AddCommand(editProjectCommand(cliConfig))
 	cmd.AddCommand(viewProjectCommand(cliConfig))
 	cmd.AddCommand(listProjectCommand(cliConfig))
+	cmd.AddCommand(deleteProjectCommand(cliConfig))
 
 	bindFlagsFromClientConfig(cmd)
 
@@ -91,7 +94,6 @@ func createProjectCommand(cliConfig *Config) *cli.Command {
 	cmd.Flags().StringVarP(&filePath, "file", "f", "", "Path to the project body file")
 	cmd.MarkFlagRequired("file")
 	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
-	cmd.MarkFlagRequired("header")
 
 	return cmd
 }
@@ -277,3 +279,47 @@ func listProjectCommand(cliConfig *Config) *cli.Command {
 
 	return cmd
 }
+
+func deleteProjectCommand(cliConfig *Config) *cli.Command {
+	var flags deleteFlags
+
+	cmd := &cli.Command{
+		Use:   "delete",
+		Short: "Delete a project",
+		Long: heredoc.Doc(`
+			Delete a project along with everything nested under it. It can be restored
+			with the enable API until it's purged after the grace period. Run it
+			with --dry-run first to see what would be deleted, the delete can be confirmed
+			with the token returned by the dry run.
+		`),
+		Args: cli.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ frontier project delete <project-id> --dry-run
+			$ frontier project delete <project-id> --confirm=<token>
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			ctx := setCtxHeader(cmd.Context(), flags.header)
+			client, cancel, err := createClient(ctx, cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			return submitDelete(ctx, flags, args[0], func(ctx context.Context, dryRun bool, token string) (*frontierv1beta1.DeleteReport, string, error) {
+				res, err := client.DeleteProject(ctx, &frontierv1beta1.DeleteProjectRequest{
+					Id:                args[0],
+					DryRun:            dryRun,
+					ConfirmationToken: token,
+				})
+				return res.GetReport(), res.GetConfirmationToken(), err
+			})
+		},
+	}
+
+	flags.bind(cmd)
+
+	return cmd
+}
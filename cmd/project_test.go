@@ -44,7 +44,7 @@ func TestClientProject(t *testing.T) {
 				name:        "`project` create with host flag should throw error missing required flag",
 				want:        "",
 				subCommands: []string{"create", "-h", "test"},
-				err:         errors.New("required flag(s) \"file\", \"header\" not set"),
+				err:         errors.New("required flag(s) \"file\" not set"),
 			},
 			{
 				name:        "`project` edit without host should throw error host not found",
@@ -70,6 +70,18 @@ func TestClientProject(t *testing.T) {
 				subCommands: []string{"view", "123", "-h", "test"},
 				err:         context.DeadlineExceeded,
 			},
+			{
+				name:        "`project` delete without host should throw error host not found",
+				want:        "",
+				subCommands: []string{"delete", "123", "--dry-run"},
+				err:         cmd.ErrClientConfigHostNotFound,
+			},
+			{
+				name:        "`project` delete with host flag should pass",
+				want:        "",
+				subCommands: []string{"delete", "123", "--dry-run", "-h", "test"},
+				err:         context.DeadlineExceeded,
+			},
 		}
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
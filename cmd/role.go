@@ -44,6 +44,7 @@ func RoleCommand(cliConfig *Config) *cli.Command {
 
 func createRoleCommand(cliConfig *Config) *cli.Command {
 	var filePath, header string
+	var parents []string
 
 	cmd := &cli.Command{
 		Use:   "create",
@@ -51,6 +52,7 @@ func createRoleCommand(cliConfig *Config) *cli.Command {
 		Args:  cli.NoArgs,
 		Example: heredoc.Doc(`
 			$ frontier role create --file=<role-body> --header=<key>:<value>
+			$ frontier role create --file=<role-body> --parent=<role-id-or-name>
 		`),
 		Annotations: map[string]string{
 			"role:core": "true",
@@ -63,6 +65,7 @@ func createRoleCommand(cliConfig *Config) *cli.Command {
 			if err := file.Parse(filePath, &reqBody); err != nil {
 				return err
 			}
+			reqBody.Parents = append(reqBody.Parents, parents...)
 
 			err := reqBody.ValidateAll()
 			if err != nil {
@@ -93,13 +96,15 @@ func createRoleCommand(cliConfig *Config) *cli.Command {
 	cmd.Flags().StringVarP(&filePath, "file", "f", "", "Path to the role body file")
 	cmd.MarkFlagRequired("file")
 	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
-	cmd.MarkFlagRequired("header")
+	cmd.Flags().StringSliceVar(&parents, "parent", nil, "Id or name of a role to inherit permissions from, can be repeated")
 
 	return cmd
 }
 
 func editRoleCommand(cliConfig *Config) *cli.Command {
 	var filePath string
+	var parents []string
+	var clearParents bool
 
 	cmd := &cli.Command{
 		Use:   "edit",
@@ -107,6 +112,8 @@ func editRoleCommand(cliConfig *Config) *cli.Command {
 		Args:  cli.ExactArgs(1),
 		Example: heredoc.Doc(`
 			$ frontier role edit <role-id> --file=<role-body>
+			$ frontier role edit <role-id> --file=<role-body> --parent=<role-id-or-name>
+			$ frontier role edit <role-id> --file=<role-body> --clear-parents
 		`),
 		Annotations: map[string]string{
 			"role:core": "true",
@@ -119,6 +126,8 @@ func editRoleCommand(cliConfig *Config) *cli.Command {
 			if err := file.Parse(filePath, &reqBody); err != nil {
 				return err
 			}
+			reqBody.Parents = append(reqBody.Parents, parents...)
+			reqBody.ClearParents = reqBody.ClearParents || clearParents
 
 			err := reqBody.ValidateAll()
 			if err != nil {
@@ -148,6 +157,8 @@ func editRoleCommand(cliConfig *Config) *cli.Command {
 
 	cmd.Flags().StringVarP(&filePath, "file", "f", "", "Path to the role body file")
 	cmd.MarkFlagRequired("file")
+	cmd.Flags().StringSliceVar(&parents, "parent", nil, "Id or name of a role to inherit permissions from, can be repeated")
+	cmd.Flags().BoolVar(&clearParents, "clear-parents", false, "Replace the parents of the role even if none are passed, removing all of them")
 
 	return cmd
 }
@@ -189,11 +200,12 @@ func viewRoleCommand(cliConfig *Config) *cli.Command {
 
 			spinner.Stop()
 
-			report = append(report, []string{"ID", "NAME", "PERMISSION(S)", "ORGID"})
+			report = append(report, []string{"ID", "NAME", "PERMISSION(S)", "PARENT(S)", "ORGID"})
 			report = append(report, []string{
 				role.GetId(),
 				role.GetName(),
 				strings.Join(role.GetPermissions(), ", "),
+				strings.Join(role.GetParents(), ", "),
 				role.GetOrgId(),
 			})
 			printer.Table(os.Stdout, report)
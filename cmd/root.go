@@ -49,15 +49,17 @@ func New(cliConfig *Config) *cli.Command {
 	cmd.AddCommand(PermissionCommand(cliConfig))
 	cmd.AddCommand(PolicyCommand(cliConfig))
 	cmd.AddCommand(SeedCommand(cliConfig))
+	cmd.AddCommand(ApplyCommand(cliConfig))
+	cmd.AddCommand(AuthCommand(cliConfig))
 	cmd.AddCommand(configCommand())
 	cmd.AddCommand(versionCommand())
 	cmd.AddCommand(PreferencesCommand(cliConfig))
+	cmd.AddCommand(JobCommand(cliConfig))
 
 	// Help topics
 	cmdx.SetHelp(cmd)
 	cmd.AddCommand(cmdx.SetCompletionCmd("frontier"))
 	cmd.AddCommand(cmdx.SetHelpTopicCmd("environment", envHelp))
-	cmd.AddCommand(cmdx.SetHelpTopicCmd("auth", authHelp))
 	cmd.AddCommand(cmdx.SetRefCmd(cmd))
 	return cmd
 }
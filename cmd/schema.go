@@ -0,0 +1,264 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/raystack/frontier/config"
+	"github.com/raystack/frontier/core/namespace"
+	"github.com/raystack/frontier/core/permission"
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/role"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/bootstrap"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/raystack/frontier/internal/store/blob"
+	"github.com/raystack/frontier/internal/store/postgres"
+	"github.com/raystack/frontier/internal/store/spicedb"
+	"github.com/raystack/frontier/pkg/file"
+	frontierlogger "github.com/raystack/frontier/pkg/logger"
+	"github.com/raystack/salt/printer"
+	cli "github.com/spf13/cobra"
+)
+
+func serverSchemaCommand() *cli.Command {
+	cmd := &cli.Command{
+		Use:   "schema",
+		Short: "Manage authorization schema versions",
+		Long: heredoc.Doc(`
+			Inspect, diff and rollback the authorization schema applied over the authz engine.
+			Every applied schema is stored as a version.
+		`),
+		Example: heredoc.Doc(`
+			$ frontier server schema history -c ./config.yaml
+			$ frontier server schema diff -c ./config.yaml
+			$ frontier server schema diff -c ./config.yaml --file=./permissions.yaml
+			$ frontier server schema rollback 3 -c ./config.yaml --dry-run
+		`),
+	}
+
+	cmd.AddCommand(serverSchemaHistoryCommand())
+	cmd.AddCommand(serverSchemaDiffCommand())
+	cmd.AddCommand(serverSchemaRollbackCommand())
+	return cmd
+}
+
+func serverSchemaHistoryCommand() *cli.Command {
+	var configFile string
+
+	c := &cli.Command{
+		Use:     "history",
+		Short:   "List applied schema versions",
+		Example: "frontier server schema history",
+		RunE: func(c *cli.Command, args []string) error {
+			bootstrapService, cleanup, err := buildSchemaBootstrapService(c.Context(), configFile)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			versions, err := bootstrapService.ListSchemaVersions(c.Context())
+			if err != nil {
+				return err
+			}
+
+			report := [][]string{{"VERSION", "PERMISSIONS", "ROLES", "APPLIED AT"}}
+			for _, v := range versions {
+				report = append(report, []string{
+					strconv.FormatInt(v.Version, 10),
+					strconv.Itoa(len(v.Definition.Permissions)),
+					strconv.Itoa(len(v.Definition.Roles)),
+					v.CreatedAt.String(),
+				})
+			}
+			printer.Table(os.Stdout, report)
+			return nil
+		},
+	}
+
+	c.Flags().StringVarP(&configFile, "config", "c", "", "config file path")
+	return c
+}
+
+func serverSchemaDiffCommand() *cli.Command {
+	var configFile, filePath string
+
+	c := &cli.Command{
+		Use:   "diff",
+		Short: "Show changes a service definition will make without applying it",
+		Long: heredoc.Doc(`
+			Show permissions and roles which will be added or removed along with the
+			relations which will be orphaned. Without a file, the service definition
+			from resources config path is used.
+		`),
+		Example: "frontier server schema diff --file=./permissions.yaml",
+		RunE: func(c *cli.Command, args []string) error {
+			bootstrapService, cleanup, err := buildSchemaBootstrapService(c.Context(), configFile)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			var diff bootstrap.SchemaDiff
+			if filePath != "" {
+				var definition schema.ServiceDefinition
+				if err = file.Parse(filePath, &definition); err != nil {
+					return err
+				}
+				diff, err = bootstrapService.PlanSchema(c.Context(), definition)
+			} else {
+				diff, err = bootstrapService.PlanMigration(c.Context())
+			}
+			if err != nil {
+				return err
+			}
+
+			printSchemaDiff(diff)
+			return nil
+		},
+	}
+
+	c.Flags().StringVarP(&configFile, "config", "c", "", "config file path")
+	c.Flags().StringVarP(&filePath, "file", "f", "", "path to the service definition file")
+	return c
+}
+
+func serverSchemaRollbackCommand() *cli.Command {
+	var configFile string
+	var dryRun, force bool
+
+	c := &cli.Command{
+		Use:   "rollback <version>",
+		Short: "Rollback authorization schema to a previous version",
+		Long: heredoc.Doc(`
+			Rollback authorization schema to a previous version. Permissions added after
+			the version are removed. If removed permissions are still granted by roles or
+			used by resources, rollback is aborted unless --force is passed which deletes
+			the orphaned relations.
+		`),
+		Args:    cli.ExactArgs(1),
+		Example: "frontier server schema rollback 3 --dry-run",
+		RunE: func(c *cli.Command, args []string) error {
+			version, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid version %s: %w", args[0], err)
+			}
+
+			bootstrapService, cleanup, err := buildSchemaBootstrapService(c.Context(), configFile)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			if dryRun {
+				diff, err := bootstrapService.PlanRollback(c.Context(), version)
+				if err != nil {
+					return err
+				}
+				printSchemaDiff(diff)
+				return nil
+			}
+
+			diff, err := bootstrapService.RollbackSchema(c.Context(), version, force)
+			if err != nil {
+				if errors.Is(err, bootstrap.ErrOrphanedRelations) {
+					printSchemaDiff(diff)
+					return fmt.Errorf("%w, use --force to delete them", err)
+				}
+				return err
+			}
+			printSchemaDiff(diff)
+			fmt.Printf("schema rolled back to version %d\n", version)
+			return nil
+		},
+	}
+
+	c.Flags().StringVarP(&configFile, "config", "c", "", "config file path")
+	c.Flags().BoolVar(&dryRun, "dry-run", false, "only show the changes rollback will make")
+	c.Flags().BoolVar(&force, "force", false, "delete relations and policies orphaned by the rollback")
+	return c
+}
+
+func printSchemaDiff(diff bootstrap.SchemaDiff) {
+	if diff.IsEmpty() && len(diff.OrphanedRelations) == 0 && len(diff.OrphanedPolicies) == 0 {
+		fmt.Println("no changes")
+		return
+	}
+
+	report := [][]string{{"CHANGE", "KIND", "NAME"}}
+	for _, p := range diff.AddedPermissions {
+		report = append(report, []string{"+", "permission", p.Slug()})
+	}
+	for _, p := range diff.RemovedPermissions {
+		report = append(report, []string{"-", "permission", p.Slug()})
+	}
+	for _, r := range diff.AddedRoles {
+		report = append(report, []string{"+", "role", r.Name})
+	}
+	for _, r := range diff.RemovedRoles {
+		report = append(report, []string{"-", "role", r.Name})
+	}
+	for _, r := range diff.ModifiedRoles {
+		report = append(report, []string{"~", "role", r.Name})
+	}
+	for _, r := range diff.OrphanedRelations {
+		report = append(report, []string{"!", "relation", fmt.Sprintf("%s:%s#%s@%s:%s",
+			r.Object.Namespace, r.Object.ID, r.RelationName, r.Subject.Namespace, r.Subject.ID)})
+	}
+	for _, p := range diff.OrphanedPolicies {
+		report = append(report, []string{"!", "policy", fmt.Sprintf("%s grants %s on %s:%s to %s:%s",
+			p.ID, p.RoleID, p.ResourceType, p.ResourceID, p.PrincipalType, p.PrincipalID)})
+	}
+	printer.Table(os.Stdout, report)
+}
+
+// buildSchemaBootstrapService connects to db and authz engine to build
+// a bootstrap service capable of managing schema versions
+func buildSchemaBootstrapService(ctx context.Context, configFile string) (*bootstrap.Service, func(), error) {
+	appConfig, err := config.Load(configFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	logger := frontierlogger.InitLogger(appConfig.Log)
+
+	dbc, err := setupDB(appConfig.DB, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	spiceDBClient, err := spicedb.New(appConfig.SpiceDB, logger)
+	if err != nil {
+		dbc.Close()
+		return nil, nil, err
+	}
+	resourceBlobFS, err := blob.NewStore(ctx, appConfig.App.ResourcesConfigPath, appConfig.App.ResourcesConfigPathSecret)
+	if err != nil {
+		dbc.Close()
+		return nil, nil, err
+	}
+
+	relationService := relation.NewService(postgres.NewRelationRepository(dbc),
+		spicedb.NewRelationRepository(spiceDBClient, appConfig.SpiceDB.FullyConsistent))
+	permissionService := permission.NewService(postgres.NewPermissionRepository(dbc))
+	roleService := role.NewService(postgres.NewRoleRepository(dbc), relationService, permissionService)
+	policyService := policy.NewService(postgres.NewPolicyRepository(dbc), relationService, roleService)
+	bootstrapService := bootstrap.NewBootstrapService(
+		appConfig.App.Admin,
+		blob.NewSchemaConfigRepository(resourceBlobFS),
+		namespace.NewService(postgres.NewNamespaceRepository(dbc)),
+		roleService,
+		permissionService,
+		user.NewService(postgres.NewUserRepository(dbc), relationService),
+		spicedb.NewSchemaRepository(logger, spiceDBClient),
+		relationService,
+		policyService,
+		postgres.NewSchemaVersionRepository(dbc),
+	)
+	return bootstrapService, func() {
+		dbc.Close()
+	}, nil
+}
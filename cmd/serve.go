@@ -14,8 +14,12 @@ import (
 
 	"github.com/raystack/frontier/core/preference"
 
+	"github.com/raystack/frontier/core/archive"
 	"github.com/raystack/frontier/core/audit"
 	"github.com/raystack/frontier/core/domain"
+	"github.com/raystack/frontier/core/email"
+	"github.com/raystack/frontier/core/exporter"
+	"github.com/raystack/frontier/core/folder"
 
 	"github.com/raystack/frontier/core/serviceuser"
 
@@ -25,6 +29,9 @@ import (
 	"github.com/raystack/frontier/pkg/server"
 
 	"github.com/raystack/frontier/core/invitation"
+	"github.com/raystack/frontier/core/job"
+	"github.com/raystack/frontier/core/membership"
+	"github.com/raystack/frontier/core/merger"
 
 	"github.com/raystack/frontier/pkg/mailer"
 
@@ -43,8 +50,10 @@ import (
 	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/raystack/frontier/config"
 	"github.com/raystack/frontier/core/group"
+	"github.com/raystack/frontier/core/identity"
 	"github.com/raystack/frontier/core/namespace"
 	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/pat"
 	"github.com/raystack/frontier/core/policy"
 	"github.com/raystack/frontier/core/project"
 	"github.com/raystack/frontier/core/relation"
@@ -158,6 +167,44 @@ func StartServer(logger *log.Zap, cfg *config.Frontier) error {
 		deps.AuthnService.Close()
 	}()
 
+	if err := deps.DeleterService.InitPurge(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		deps.DeleterService.Close()
+	}()
+
+	if err := deps.ServiceUserService.InitCredentialExpiry(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		deps.ServiceUserService.Close()
+	}()
+
+	if err := deps.InvitationService.InitInvitationExpiry(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		deps.InvitationService.Close()
+	}()
+
+	if err := deps.EmailService.InitQueue(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		logger.Debug("stopping mail queue")
+		deps.EmailService.Close()
+	}()
+
+	// jobs run outside of requests, audit service is set in context to log their events
+	if err := deps.JobService.InitWorkers(audit.SetContextWithService(ctx, deps.AuditService)); err != nil {
+		return err
+	}
+	defer func() {
+		logger.Debug("stopping job workers")
+		deps.JobService.Close()
+	}()
+
 	// serving server
 	return server.Serve(ctx, logger, cfg.App, nrApp, deps)
 }
@@ -215,10 +262,6 @@ func buildAPIDependencies(
 	userRepository := postgres.NewUserRepository(dbc)
 	userService := user.NewService(userRepository, relationService)
 
-	svUserRepo := postgres.NewServiceUserRepository(dbc)
-	scUserCredRepo := postgres.NewServiceUserCredentialRepository(dbc)
-	serviceUserService := serviceuser.NewService(svUserRepo, scUserCredRepo, relationService)
-
 	var mailDialer mailer.Dialer = mailer.NewMockDialer()
 	if cfg.App.Mailer.SMTPHost != "" && cfg.App.Mailer.SMTPHost != "smtp.example.com" {
 		mailDialer = mailer.NewDialerImpl(cfg.App.Mailer.SMTPHost,
@@ -230,6 +273,25 @@ func buildAPIDependencies(
 		)
 		logger.Info("mailer enabled", "host", cfg.App.Mailer.SMTPHost, "port", cfg.App.Mailer.SMTPPort)
 	}
+	emailService := email.NewService(logger, cfg.App.Email, mailDialer,
+		postgres.NewMailTemplateRepository(dbc), postgres.NewMailQueueRepository(dbc))
+	// templates from config are used if no template is set, they're
+	// registered so they can be previewed as well
+	for _, tmpl := range []email.Template{
+		{Name: email.TemplateMailOTP, Subject: cfg.App.Authentication.MailOTP.Subject, HTMLBody: cfg.App.Authentication.MailOTP.Body},
+		{Name: email.TemplateMailLink, Subject: cfg.App.Authentication.MailLink.Subject, HTMLBody: cfg.App.Authentication.MailLink.Body},
+		{Name: email.TemplateInvitation, Subject: cfg.App.Invitation.MailTemplate.Subject, HTMLBody: cfg.App.Invitation.MailTemplate.Body},
+		{Name: email.TemplateInvitationReminder, Subject: cfg.App.Invitation.ReminderMailTemplate.Subject, HTMLBody: cfg.App.Invitation.ReminderMailTemplate.Body},
+		{Name: email.TemplateCredentialExpiry, Subject: cfg.App.ServiceUser.MailTemplate.Subject, HTMLBody: cfg.App.ServiceUser.MailTemplate.Body},
+	} {
+		emailService.RegisterDefault(tmpl)
+	}
+
+	svUserRepo := postgres.NewServiceUserRepository(dbc)
+	scUserCredRepo := postgres.NewServiceUserCredentialRepository(dbc)
+	svUserTrustRepo := postgres.NewServiceUserTrustRepository(dbc)
+	serviceUserService := serviceuser.NewService(logger, cfg.App.ServiceUser, svUserRepo, scUserCredRepo,
+		svUserTrustRepo, relationService, userService, emailService)
 
 	wconfig := &webauthn.Config{
 		RPDisplayName: cfg.App.Authentication.PassKey.RPDisplayName,
@@ -244,8 +306,11 @@ func buildAPIDependencies(
 			return api.Deps{}, fmt.Errorf("failed to parse passkey config: %w", err)
 		}
 	}
+	identityService := identity.NewService(postgres.NewUserIdentityRepository(dbc), postgres.NewUserEmailRepository(dbc))
+	patService := pat.NewService(logger, cfg.App.PersonalToken, postgres.NewPersonalAccessTokenRepository(dbc))
 	authnService := authenticate.NewService(logger, cfg.App.Authentication,
-		postgres.NewFlowRepository(logger, dbc), mailDialer, tokenService, sessionService, userService, serviceUserService, webAuthConfig)
+		postgres.NewFlowRepository(logger, dbc), emailService, tokenService, sessionService, userService,
+		identityService, serviceUserService, patService, webAuthConfig)
 
 	groupRepository := postgres.NewGroupRepository(dbc)
 	groupService := group.NewService(groupRepository, relationService, authnService, policyService)
@@ -259,20 +324,28 @@ func buildAPIDependencies(
 		permissionService,
 		userService,
 		authzSchemaRepository,
+		relationService,
+		policyService,
+		postgres.NewSchemaVersionRepository(dbc),
 	)
 
 	organizationRepository := postgres.NewOrganizationRepository(dbc)
-	organizationService := organization.NewService(organizationRepository, relationService, userService,
+	organizationService := organization.NewService(organizationRepository,
+		postgres.NewOrganizationTransferRepository(dbc), relationService, userService,
 		authnService, policyService, preferenceService)
 
 	domainRepository := postgres.NewDomainRepository(logger, dbc)
-	domainService := domain.NewService(logger, domainRepository, userService, organizationService)
+	domainService := domain.NewService(logger, cfg.App.Domain, domainRepository, userService, organizationService,
+		groupService, policyService, roleService)
+	authnService.SetDomainService(domainService)
 
 	metaschemaRepository := postgres.NewMetaSchemaRepository(logger, dbc)
 	metaschemaService := metaschema.NewService(metaschemaRepository)
+	folderService := folder.NewService(postgres.NewFolderRepository(dbc), relationService, policyService, authnService)
+
 	projectRepository := postgres.NewProjectRepository(dbc)
 	projectService := project.NewService(projectRepository, relationService, userService, policyService,
-		authnService, serviceUserService, groupService)
+		authnService, serviceUserService, groupService, folderService)
 
 	resourcePGRepository := postgres.NewResourceRepository(dbc)
 	resourceService := resource.NewService(
@@ -284,10 +357,9 @@ func buildAPIDependencies(
 		organizationService,
 	)
 
-	invitationService := invitation.NewService(mailDialer, postgres.NewInvitationRepository(logger, dbc),
-		organizationService, groupService, userService, relationService, policyService, preferenceService)
-	cascadeDeleter := deleter.NewCascadeDeleter(organizationService, projectService, resourceService,
-		groupService, policyService, roleService, invitationService, userService)
+	invitationService := invitation.NewService(cfg.App.Invitation, emailService, postgres.NewInvitationRepository(logger, dbc),
+		postgres.NewInvitationLinkRepository(dbc),
+		organizationService, groupService, userService, relationService, policyService, roleService, preferenceService)
 
 	// we should default it with a stdout logger repository as postgres can start to bloat really fast
 	var auditRepository audit.Repository
@@ -300,6 +372,20 @@ func buildAPIDependencies(
 		auditRepository = audit.NewWriteOnlyRepository(io.Discard)
 	}
 	auditService := audit.NewService("frontier", auditRepository)
+	deleterConfig := cfg.App.Deleter
+	if deleterConfig.ConfirmationKey == "" {
+		deleterConfig.ConfirmationKey = cfg.App.Authentication.Session.HashSecretKey
+	}
+	cascadeDeleter := deleter.NewCascadeDeleter(logger, deleterConfig, organizationService, projectService, resourceService,
+		groupService, policyService, roleService, invitationService, userService, folderService, relationService, auditService)
+
+	jobService := job.NewService(logger, postgres.NewJobRepository(dbc))
+	membership.RegisterJobs(jobService, organizationService, groupService, userService)
+	mergerService := merger.NewService(userService, relationService, policyService, resourceService, preferenceService)
+	exporterService := exporter.NewService(userService, organizationService, groupService, policyService,
+		sessionService, preferenceService, identityService, auditService)
+	archiveService := archive.NewService(organizationService, userService, roleService, groupService, projectService,
+		resourceService, policyService, preferenceService, domainService)
 
 	dependencies := api.Deps{
 		OrgService:         organizationService,
@@ -322,6 +408,14 @@ func buildAPIDependencies(
 		AuditService:       auditService,
 		DomainService:      domainService,
 		PreferenceService:  preferenceService,
+		FolderService:      folderService,
+		JobService:         jobService,
+		MergerService:      mergerService,
+		IdentityService:    identityService,
+		ExporterService:    exporterService,
+		ArchiveService:     archiveService,
+		PATService:         patService,
+		EmailService:       emailService,
 	}
 	return dependencies, nil
 }
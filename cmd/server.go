@@ -29,6 +29,8 @@ func ServerCommand() *cobra.Command {
 			$ frontier server migrate-rollback
 			$ frontier server migrate-rollback -c ./config.yaml
 			$ frontier server keygen
+			$ frontier server schema history
+			$ frontier server org repair-owners
 		`),
 	}
 
@@ -37,6 +39,8 @@ func ServerCommand() *cobra.Command {
 	cmd.AddCommand(serverMigrateCommand())
 	cmd.AddCommand(serverMigrateRollbackCommand())
 	cmd.AddCommand(serverGenRSACommand())
+	cmd.AddCommand(serverSchemaCommand())
+	cmd.AddCommand(serverOrganizationCommand())
 
 	return cmd
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/raystack/frontier/pkg/file"
@@ -11,6 +12,7 @@ import (
 	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
 	"github.com/raystack/salt/printer"
 	cli "github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func UserCommand(cliConfig *Config) *cli.Command {
@@ -26,6 +28,10 @@ func UserCommand(cliConfig *Config) *cli.Command {
 			$ frontier user edit
 			$ frontier user view
 			$ frontier user list
+			$ frontier user delete
+			$ frontier user merge
+			$ frontier user export
+			$ frontier user token list
 		`),
 		Annotations: map[string]string{
 			"group":  "core",
@@ -37,6 +43,10 @@ func UserCommand(cliConfig *Config) *cli.Command {
 	cmd.AddCommand(editUserCommand(cliConfig))
 	cmd.AddCommand(viewUserCommand(cliConfig))
 	cmd.AddCommand(listUserCommand(cliConfig))
+	cmd.AddCommand(deleteUserCommand(cliConfig))
+	cmd.AddCommand(mergeUserCommand(cliConfig))
+	cmd.AddCommand(exportUserCommand(cliConfig))
+	cmd.AddCommand(tokenUserCommand(cliConfig))
 
 	bindFlagsFromClientConfig(cmd)
 
@@ -97,7 +107,6 @@ func createUserCommand(cliConfig *Config) *cli.Command {
 	cmd.Flags().StringVarP(&filePath, "file", "f", "", "Path to the user body file")
 	cmd.MarkFlagRequired("file")
 	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
-	cmd.MarkFlagRequired("header")
 
 	return cmd
 }
@@ -278,3 +287,330 @@ func listUserCommand(cliConfig *Config) *cli.Command {
 
 	return cmd
 }
+
+func deleteUserCommand(cliConfig *Config) *cli.Command {
+	var flags deleteFlags
+
+	cmd := &cli.Command{
+		Use:   "delete",
+		Short: "Delete a user",
+		Long: heredoc.Doc(`
+			Delete a user along with its memberships and policies. It can be restored
+			with the enable API until it's purged after the grace period. Run it
+			with --dry-run first to see what would be deleted, the delete can be confirmed
+			with the token returned by the dry run.
+		`),
+		Args: cli.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ frontier user delete <user-id> --dry-run
+			$ frontier user delete <user-id> --confirm=<token>
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			ctx := setCtxHeader(cmd.Context(), flags.header)
+			client, cancel, err := createClient(ctx, cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			return submitDelete(ctx, flags, args[0], func(ctx context.Context, dryRun bool, token string) (*frontierv1beta1.DeleteReport, string, error) {
+				res, err := client.DeleteUser(ctx, &frontierv1beta1.DeleteUserRequest{
+					Id:                args[0],
+					DryRun:            dryRun,
+					ConfirmationToken: token,
+				})
+				return res.GetReport(), res.GetConfirmationToken(), err
+			})
+		},
+	}
+
+	flags.bind(cmd)
+
+	return cmd
+}
+
+func mergeUserCommand(cliConfig *Config) *cli.Command {
+	var header, into string
+
+	cmd := &cli.Command{
+		Use:   "merge",
+		Short: "Merge a user into another user",
+		Long: heredoc.Doc(`
+			Move organization and group memberships, policies, resources and preferences
+			of a user to another user of the same person and disable it. Requires
+			superuser access.
+		`),
+		Args: cli.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ frontier user merge <user-id> --into=<user-id>
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			ctx := setCtxHeader(cmd.Context(), header)
+			adminClient, cancel, err := createAdminClient(ctx, cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := adminClient.MergeUser(ctx, &frontierv1beta1.MergeUserRequest{
+				Id:       args[0],
+				TargetId: into,
+			})
+			if err != nil {
+				return err
+			}
+			fmt.Printf("successfully merged user %s into %s, moved %d policies, %d relations, %d resources and %d preferences\n",
+				args[0], into, res.GetPolicies(), res.GetRelations(), res.GetResources(), res.GetPreferences())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	cmd.Flags().StringVar(&into, "into", "", "Id of the user to merge into")
+	cmd.MarkFlagRequired("into")
+
+	return cmd
+}
+
+func exportUserCommand(cliConfig *Config) *cli.Command {
+	var header, output string
+
+	cmd := &cli.Command{
+		Use:   "export",
+		Short: "Download data of the current user",
+		Long: heredoc.Doc(`
+			Download profile, memberships, policies, sessions, preferences, linked
+			identities and audit logs of the logged in user as a zip archive.
+		`),
+		Args: cli.NoArgs,
+		Example: heredoc.Doc(`
+			$ frontier user export --output=frontier-data.zip --header=Authorization:"Bearer <token>"
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			ctx := setCtxHeader(cmd.Context(), header)
+			client, cancel, err := createClient(ctx, cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.ExportCurrentUserData(ctx, &frontierv1beta1.ExportCurrentUserDataRequest{})
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(output, res.GetArchive(), 0600); err != nil {
+				return err
+			}
+			fmt.Printf("data export written to %s\n", output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	cmd.Flags().StringVarP(&output, "output", "o", "frontier-data.zip", "Path of the archive to write")
+
+	return cmd
+}
+
+func tokenUserCommand(cliConfig *Config) *cli.Command {
+	cmd := &cli.Command{
+		Use:   "token",
+		Short: "Manage personal access tokens",
+		Long: heredoc.Doc(`
+			Work with personal access tokens of the current user. A token authenticates
+			as the user when sent as a bearer token, narrowed to its org, projects and
+			permissions if it's scoped.
+		`),
+		Example: heredoc.Doc(`
+			$ frontier user token create
+			$ frontier user token list
+			$ frontier user token revoke
+		`),
+	}
+
+	cmd.AddCommand(createTokenCommand(cliConfig))
+	cmd.AddCommand(listTokenCommand(cliConfig))
+	cmd.AddCommand(revokeTokenCommand(cliConfig))
+
+	return cmd
+}
+
+func createTokenCommand(cliConfig *Config) *cli.Command {
+	var header, expiresAt, org string
+	var projects, permissions []string
+
+	cmd := &cli.Command{
+		Use:   "create <title>",
+		Short: "Create a personal access token",
+		Args:  cli.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ frontier user token create "deploy script" --expires-at=2027-01-01T00:00:00Z --org=<org-id> --permissions=app_project_get --header=Authorization:"Bearer <token>"
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			ctx := setCtxHeader(cmd.Context(), header)
+			client, cancel, err := createClient(ctx, cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			req := &frontierv1beta1.CreateCurrentUserPersonalTokenRequest{
+				Title:       args[0],
+				OrgId:       org,
+				ProjectIds:  projects,
+				Permissions: permissions,
+			}
+			if expiresAt != "" {
+				t, err := time.Parse(time.RFC3339, expiresAt)
+				if err != nil {
+					return err
+				}
+				req.ExpiresAt = timestamppb.New(t)
+			}
+			res, err := client.CreateCurrentUserPersonalToken(ctx, req)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("token %s created, it won't be shown again:\n%s\n", res.GetToken().GetId(), res.GetValue())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	cmd.Flags().StringVar(&expiresAt, "expires-at", "", "RFC3339 expiry of the token, defaults to the configured lifetime")
+	cmd.Flags().StringVar(&org, "org", "", "Id of the org the token is narrowed to")
+	cmd.Flags().StringSliceVar(&projects, "projects", nil, "Comma separated ids of projects the token is narrowed to")
+	cmd.Flags().StringSliceVar(&permissions, "permissions", nil, "Comma separated permissions the token is narrowed to")
+
+	return cmd
+}
+
+func listTokenCommand(cliConfig *Config) *cli.Command {
+	var header, org string
+
+	cmd := &cli.Command{
+		Use:   "list",
+		Short: "List personal access tokens",
+		Long: heredoc.Doc(`
+			List personal access tokens of the current user, or tokens of all users
+			narrowed to an org for admins of the org.
+		`),
+		Args: cli.NoArgs,
+		Example: heredoc.Doc(`
+			$ frontier user token list --header=Authorization:"Bearer <token>"
+			$ frontier user token list --org=<org-id> --header=Authorization:"Bearer <token>"
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			ctx := setCtxHeader(cmd.Context(), header)
+			client, cancel, err := createClient(ctx, cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			var tokens []*frontierv1beta1.PersonalToken
+			if org != "" {
+				res, err := client.ListOrganizationPersonalTokens(ctx, &frontierv1beta1.ListOrganizationPersonalTokensRequest{Id: org})
+				if err != nil {
+					return err
+				}
+				tokens = res.GetTokens()
+			} else {
+				res, err := client.ListCurrentUserPersonalTokens(ctx, &frontierv1beta1.ListCurrentUserPersonalTokensRequest{})
+				if err != nil {
+					return err
+				}
+				tokens = res.GetTokens()
+			}
+
+			fmt.Printf(" \nShowing %d tokens\n \n", len(tokens))
+			report := [][]string{{"ID", "USER", "TITLE", "ORG", "EXPIRES AT", "LAST USED AT"}}
+			for _, token := range tokens {
+				var lastUsedAt string
+				if token.GetLastUsedAt() != nil {
+					lastUsedAt = token.GetLastUsedAt().AsTime().Format(time.RFC3339)
+				}
+				report = append(report, []string{
+					token.GetId(),
+					token.GetUserId(),
+					token.GetTitle(),
+					token.GetOrgId(),
+					token.GetExpiresAt().AsTime().Format(time.RFC3339),
+					lastUsedAt,
+				})
+			}
+			printer.Table(os.Stdout, report)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	cmd.Flags().StringVar(&org, "org", "", "Id of the org to list tokens of")
+
+	return cmd
+}
+
+func revokeTokenCommand(cliConfig *Config) *cli.Command {
+	var header, org string
+
+	cmd := &cli.Command{
+		Use:   "revoke <token-id>",
+		Short: "Revoke a personal access token",
+		Long: heredoc.Doc(`
+			Revoke a personal access token of the current user, admins of an org can
+			revoke tokens of other users narrowed to the org.
+		`),
+		Args: cli.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ frontier user token revoke <token-id> --header=Authorization:"Bearer <token>"
+			$ frontier user token revoke <token-id> --org=<org-id> --header=Authorization:"Bearer <token>"
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			ctx := setCtxHeader(cmd.Context(), header)
+			client, cancel, err := createClient(ctx, cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			if org != "" {
+				_, err = client.RevokeOrganizationPersonalToken(ctx, &frontierv1beta1.RevokeOrganizationPersonalTokenRequest{
+					Id:      org,
+					TokenId: args[0],
+				})
+			} else {
+				_, err = client.RevokeCurrentUserPersonalToken(ctx, &frontierv1beta1.RevokeCurrentUserPersonalTokenRequest{
+					Id: args[0],
+				})
+			}
+			if err != nil {
+				return err
+			}
+			fmt.Printf("successfully revoked token %s\n", args[0])
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	cmd.Flags().StringVar(&org, "org", "", "Id of the org the token is narrowed to")
+
+	return cmd
+}
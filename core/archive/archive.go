@@ -0,0 +1,269 @@
+package archive
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	// Version is bumped on breaking changes of the archive format
+	Version = 1
+
+	FormatJSON   = "json"
+	FormatNDJSON = "ndjson"
+)
+
+var (
+	ErrUnsupported   = errors.New("unsupported organization archive")
+	ErrInvalidFormat = errors.New("invalid archive format, should be one of json|ndjson")
+)
+
+// Archive is the identity graph of an organization. Ids are of the source
+// environment and are remapped to the ids of the created entities on import.
+type Archive struct {
+	Version      int          `json:"version"`
+	ExportedAt   time.Time    `json:"exported_at"`
+	Organization Organization `json:"organization"`
+	Users        []User       `json:"users,omitempty"`
+	Roles        []Role       `json:"roles,omitempty"`
+	Groups       []Group      `json:"groups,omitempty"`
+	Projects     []Project    `json:"projects,omitempty"`
+	Resources    []Resource   `json:"resources,omitempty"`
+	Policies     []Policy     `json:"policies,omitempty"`
+	Preferences  []Preference `json:"preferences,omitempty"`
+	Domains      []Domain     `json:"domains,omitempty"`
+}
+
+type Organization struct {
+	ID       string         `json:"id"`
+	Name     string         `json:"name"`
+	Title    string         `json:"title,omitempty"`
+	Avatar   string         `json:"avatar,omitempty"`
+	Metadata map[string]any `json:"metadata,omitempty"`
+}
+
+type User struct {
+	ID       string         `json:"id"`
+	Name     string         `json:"name,omitempty"`
+	Email    string         `json:"email"`
+	Title    string         `json:"title,omitempty"`
+	Avatar   string         `json:"avatar,omitempty"`
+	Metadata map[string]any `json:"metadata,omitempty"`
+}
+
+type Role struct {
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	Title       string         `json:"title,omitempty"`
+	Permissions []string       `json:"permissions"`
+	Scopes      []string       `json:"scopes,omitempty"`
+	Metadata    map[string]any `json:"metadata,omitempty"`
+}
+
+type Group struct {
+	ID       string         `json:"id"`
+	Name     string         `json:"name"`
+	Title    string         `json:"title,omitempty"`
+	Metadata map[string]any `json:"metadata,omitempty"`
+	// Members are ids of users of the archive
+	Members []string `json:"members,omitempty"`
+}
+
+type Project struct {
+	ID       string         `json:"id"`
+	Name     string         `json:"name"`
+	Title    string         `json:"title,omitempty"`
+	Metadata map[string]any `json:"metadata,omitempty"`
+}
+
+type Resource struct {
+	ID        string         `json:"id"`
+	Name      string         `json:"name"`
+	Title     string         `json:"title,omitempty"`
+	Namespace string         `json:"namespace"`
+	ProjectID string         `json:"project_id"`
+	Principal string         `json:"principal,omitempty"`
+	Metadata  map[string]any `json:"metadata,omitempty"`
+}
+
+// Policy refers the role by name as ids of platform roles differ between
+// environments, resource and principal are in the form of namespace:id
+type Policy struct {
+	ID        string         `json:"id"`
+	Role      string         `json:"role"`
+	Resource  string         `json:"resource"`
+	Principal string         `json:"principal"`
+	Effect    string         `json:"effect,omitempty"`
+	Metadata  map[string]any `json:"metadata,omitempty"`
+}
+
+type Preference struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type Domain struct {
+	Name string `json:"name"`
+}
+
+// record is a line of the ndjson format, the first line is the organization
+// with the archive version followed by a line per entity
+type record struct {
+	Kind       string          `json:"kind"`
+	Version    int             `json:"version,omitempty"`
+	ExportedAt *time.Time      `json:"exported_at,omitempty"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// Write encodes the archive as a single json document, or with FormatNDJSON as
+// one entity per line which is easier to process for large organizations
+func Write(w io.Writer, a Archive, format string) error {
+	enc := json.NewEncoder(w)
+	switch format {
+	case FormatJSON:
+		enc.SetIndent("", "  ")
+		return enc.Encode(a)
+	case FormatNDJSON:
+	default:
+		return ErrInvalidFormat
+	}
+
+	write := func(kind string, items any) error {
+		raw, err := json.Marshal(items)
+		if err != nil {
+			return err
+		}
+		var rows []json.RawMessage
+		if err := json.Unmarshal(raw, &rows); err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if err := enc.Encode(record{Kind: kind, Data: row}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	orgRaw, err := json.Marshal(a.Organization)
+	if err != nil {
+		return err
+	}
+	if err := enc.Encode(record{
+		Kind:       KindOrganization,
+		Version:    a.Version,
+		ExportedAt: &a.ExportedAt,
+		Data:       orgRaw,
+	}); err != nil {
+		return err
+	}
+	for _, rec := range []struct {
+		kind  string
+		items any
+	}{
+		{KindUser, a.Users},
+		{KindRole, a.Roles},
+		{KindGroup, a.Groups},
+		{KindProject, a.Projects},
+		{KindResource, a.Resources},
+		{KindPolicy, a.Policies},
+		{KindPreference, a.Preferences},
+		{KindDomain, a.Domains},
+	} {
+		if err := write(rec.kind, rec.items); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Read decodes an archive written in json or ndjson format
+func Read(r io.Reader) (Archive, error) {
+	dec := json.NewDecoder(r)
+	var first json.RawMessage
+	if err := dec.Decode(&first); err != nil {
+		return Archive{}, fmt.Errorf("%w: %s", ErrUnsupported, err.Error())
+	}
+
+	var header record
+	if err := json.Unmarshal(first, &header); err != nil {
+		return Archive{}, fmt.Errorf("%w: %s", ErrUnsupported, err.Error())
+	}
+
+	var a Archive
+	if header.Kind == "" {
+		if err := json.Unmarshal(first, &a); err != nil {
+			return Archive{}, fmt.Errorf("%w: %s", ErrUnsupported, err.Error())
+		}
+	} else {
+		if header.Kind != KindOrganization {
+			return Archive{}, fmt.Errorf("%w: first record should be the organization", ErrUnsupported)
+		}
+		a.Version = header.Version
+		if header.ExportedAt != nil {
+			a.ExportedAt = *header.ExportedAt
+		}
+		if err := json.Unmarshal(header.Data, &a.Organization); err != nil {
+			return Archive{}, fmt.Errorf("%w: %s", ErrUnsupported, err.Error())
+		}
+		for {
+			var rec record
+			if err := dec.Decode(&rec); err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return Archive{}, fmt.Errorf("%w: %s", ErrUnsupported, err.Error())
+			}
+			if err := a.addRecord(rec); err != nil {
+				return Archive{}, err
+			}
+		}
+	}
+
+	if a.Version != Version {
+		return Archive{}, fmt.Errorf("%w: version %d", ErrUnsupported, a.Version)
+	}
+	if a.Organization.Name == "" {
+		return Archive{}, fmt.Errorf("%w: organization name is missing", ErrUnsupported)
+	}
+	return a, nil
+}
+
+func (a *Archive) addRecord(rec record) error {
+	var err error
+	switch rec.Kind {
+	case KindUser:
+		a.Users, err = appendRecord(a.Users, rec.Data)
+	case KindRole:
+		a.Roles, err = appendRecord(a.Roles, rec.Data)
+	case KindGroup:
+		a.Groups, err = appendRecord(a.Groups, rec.Data)
+	case KindProject:
+		a.Projects, err = appendRecord(a.Projects, rec.Data)
+	case KindResource:
+		a.Resources, err = appendRecord(a.Resources, rec.Data)
+	case KindPolicy:
+		a.Policies, err = appendRecord(a.Policies, rec.Data)
+	case KindPreference:
+		a.Preferences, err = appendRecord(a.Preferences, rec.Data)
+	case KindDomain:
+		a.Domains, err = appendRecord(a.Domains, rec.Data)
+	default:
+		return fmt.Errorf("%w: unknown record kind %s", ErrUnsupported, rec.Kind)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrUnsupported, err.Error())
+	}
+	return nil
+}
+
+func appendRecord[T any](items []T, data json.RawMessage) ([]T, error) {
+	var item T
+	if err := json.Unmarshal(data, &item); err != nil {
+		return nil, err
+	}
+	return append(items, item), nil
+}
@@ -0,0 +1,61 @@
+package archive_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/raystack/frontier/core/archive"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchive(t *testing.T) {
+	a := archive.Archive{
+		Version:    archive.Version,
+		ExportedAt: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+		Organization: archive.Organization{
+			ID:   "9f256f86-31a3-11ec-8d3d-0242ac130003",
+			Name: "acme",
+		},
+		Users: []archive.User{
+			{ID: "2e73f4a2-3763-4fc7-a0ce-7f7b1b4e6f8d", Email: "john@acme.org"},
+		},
+		Groups: []archive.Group{
+			{ID: "86e2f95d-92c7-4c59-8fed-b7686cccbf4f", Name: "admins", Members: []string{"2e73f4a2-3763-4fc7-a0ce-7f7b1b4e6f8d"}},
+		},
+		Policies: []archive.Policy{
+			{
+				ID:        "a4b8b9a0-1d7e-4b8a-9d3e-2f7c6a3e5b1c",
+				Role:      "app_organization_viewer",
+				Resource:  "app/organization:9f256f86-31a3-11ec-8d3d-0242ac130003",
+				Principal: "app/group:86e2f95d-92c7-4c59-8fed-b7686cccbf4f",
+			},
+		},
+		Domains: []archive.Domain{{Name: "acme.org"}},
+	}
+
+	for _, format := range []string{"json", "ndjson"} {
+		t.Run("should read archive written in "+format, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			assert.NoError(t, archive.Write(buf, a, format))
+
+			got, err := archive.Read(buf)
+			assert.NoError(t, err)
+			assert.Equal(t, a, got)
+		})
+	}
+	t.Run("should fail for unknown archive version", func(t *testing.T) {
+		_, err := archive.Read(strings.NewReader(`{"version": 2, "organization": {"name": "acme"}}`))
+		assert.ErrorIs(t, err, archive.ErrUnsupported)
+	})
+	t.Run("should fail for unknown format", func(t *testing.T) {
+		assert.ErrorIs(t, archive.Write(new(bytes.Buffer), a, "yaml"), archive.ErrInvalidFormat)
+	})
+	t.Run("should fail for unknown record kind", func(t *testing.T) {
+		_, err := archive.Read(strings.NewReader(
+			`{"kind": "organization", "version": 1, "data": {"name": "acme"}}` + "\n" +
+				`{"kind": "invoice", "data": {}}`))
+		assert.ErrorIs(t, err, archive.ErrUnsupported)
+	})
+}
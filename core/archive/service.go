@@ -0,0 +1,706 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/raystack/frontier/core/domain"
+	"github.com/raystack/frontier/core/group"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/preference"
+	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/core/resource"
+	"github.com/raystack/frontier/core/role"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/raystack/frontier/pkg/metadata"
+	"github.com/raystack/frontier/pkg/str"
+)
+
+const (
+	KindOrganization = "organization"
+	KindUser         = "user"
+	KindRole         = "role"
+	KindGroup        = "group"
+	KindMember       = "member"
+	KindProject      = "project"
+	KindResource     = "resource"
+	KindPolicy       = "policy"
+	KindPreference   = "preference"
+	KindDomain       = "domain"
+)
+
+// Kinds are the kinds of entities reported by import in order
+var Kinds = []string{KindOrganization, KindUser, KindRole, KindGroup, KindMember, KindProject,
+	KindResource, KindPolicy, KindPreference, KindDomain}
+
+type OrganizationService interface {
+	Get(ctx context.Context, idOrName string) (organization.Organization, error)
+	Create(ctx context.Context, org organization.Organization) (organization.Organization, error)
+}
+
+type UserService interface {
+	GetByEmail(ctx context.Context, email string) (user.User, error)
+	Create(ctx context.Context, user user.User) (user.User, error)
+	ListByOrg(ctx context.Context, orgID string, permissionFilter string) ([]user.User, error)
+	ListByGroup(ctx context.Context, groupID string, permissionFilter string) ([]user.User, error)
+}
+
+type RoleService interface {
+	List(ctx context.Context, f role.Filter) ([]role.Role, error)
+	Upsert(ctx context.Context, toCreate role.Role) (role.Role, error)
+}
+
+type GroupService interface {
+	List(ctx context.Context, flt group.Filter) ([]group.Group, error)
+	Create(ctx context.Context, grp group.Group) (group.Group, error)
+	AddUsers(ctx context.Context, groupID string, userIDs []string) error
+}
+
+type ProjectService interface {
+	List(ctx context.Context, f project.Filter) ([]project.Project, error)
+	Create(ctx context.Context, prj project.Project) (project.Project, error)
+}
+
+type ResourceService interface {
+	List(ctx context.Context, flt resource.Filter) ([]resource.Resource, error)
+	Create(ctx context.Context, res resource.Resource) (resource.Resource, error)
+}
+
+type PolicyService interface {
+	List(ctx context.Context, f policy.Filter) ([]policy.Policy, error)
+	Create(ctx context.Context, policy policy.Policy) (policy.Policy, error)
+}
+
+type PreferenceService interface {
+	List(ctx context.Context, filter preference.Filter) ([]preference.Preference, error)
+	Create(ctx context.Context, preference preference.Preference) (preference.Preference, error)
+}
+
+type DomainService interface {
+	List(ctx context.Context, flt domain.Filter) ([]domain.Domain, error)
+	Create(ctx context.Context, domain domain.Domain) (domain.Domain, error)
+}
+
+// Stats counts entities of a kind created, found existing or skipped by an import
+type Stats struct {
+	Created  int
+	Existing int
+	Skipped  int
+}
+
+// Report of an import, Stats are keyed by Kinds
+type Report struct {
+	OrganizationID string
+	Stats          map[string]*Stats
+}
+
+// Service exports the identity graph of an organization and recreates it from
+// an archive through the services so relations are written the same way as for
+// entities created by users
+type Service struct {
+	orgService        OrganizationService
+	userService       UserService
+	roleService       RoleService
+	groupService      GroupService
+	projectService    ProjectService
+	resourceService   ResourceService
+	policyService     PolicyService
+	preferenceService PreferenceService
+	domainService     DomainService
+	Now               func() time.Time
+}
+
+func NewService(orgService OrganizationService, userService UserService, roleService RoleService,
+	groupService GroupService, projectService ProjectService, resourceService ResourceService,
+	policyService PolicyService, preferenceService PreferenceService, domainService DomainService) *Service {
+	return &Service{
+		orgService:        orgService,
+		userService:       userService,
+		roleService:       roleService,
+		groupService:      groupService,
+		projectService:    projectService,
+		resourceService:   resourceService,
+		policyService:     policyService,
+		preferenceService: preferenceService,
+		domainService:     domainService,
+		Now: func() time.Time {
+			return time.Now().UTC()
+		},
+	}
+}
+
+// Export collects users, groups and their members, projects, custom roles,
+// policies, resources, preferences and domains of an organization
+func (s Service) Export(ctx context.Context, orgIDOrName string) (Archive, error) {
+	org, err := s.orgService.Get(ctx, orgIDOrName)
+	if err != nil {
+		return Archive{}, err
+	}
+	a := Archive{
+		Version:    Version,
+		ExportedAt: s.Now(),
+		Organization: Organization{
+			ID:       org.ID,
+			Name:     org.Name,
+			Title:    org.Title,
+			Avatar:   org.Avatar,
+			Metadata: org.Metadata,
+		},
+	}
+
+	users, err := s.userService.ListByOrg(ctx, org.ID, schema.MembershipPermission)
+	if err != nil {
+		return Archive{}, fmt.Errorf("failed to list users: %w", err)
+	}
+	for _, u := range users {
+		a.Users = append(a.Users, User{
+			ID:       u.ID,
+			Name:     u.Name,
+			Email:    u.Email,
+			Title:    u.Title,
+			Avatar:   u.Avatar,
+			Metadata: u.Metadata,
+		})
+	}
+
+	// policies refer roles by name, platform roles are not exported
+	roleNames := map[string]string{}
+	platformRoles, err := s.roleService.List(ctx, role.Filter{OrgID: schema.PlatformOrgID.String()})
+	if err != nil {
+		return Archive{}, fmt.Errorf("failed to list roles: %w", err)
+	}
+	for _, r := range platformRoles {
+		roleNames[r.ID] = r.Name
+	}
+	orgRoles, err := s.roleService.List(ctx, role.Filter{OrgID: org.ID})
+	if err != nil {
+		return Archive{}, fmt.Errorf("failed to list organization roles: %w", err)
+	}
+	for _, r := range orgRoles {
+		roleNames[r.ID] = r.Name
+		a.Roles = append(a.Roles, Role{
+			ID:          r.ID,
+			Name:        r.Name,
+			Title:       r.Title,
+			Permissions: r.Permissions,
+			Scopes:      r.Scopes,
+			Metadata:    r.Metadata,
+		})
+	}
+
+	var policies []policy.Policy
+	orgPolicies, err := s.listPolicies(ctx, policy.Filter{OrgID: org.ID})
+	if err != nil {
+		return Archive{}, fmt.Errorf("failed to list policies: %w", err)
+	}
+	policies = append(policies, orgPolicies...)
+
+	groups, err := s.groupService.List(ctx, group.Filter{OrganizationID: org.ID})
+	if err != nil {
+		return Archive{}, fmt.Errorf("failed to list groups: %w", err)
+	}
+	for _, g := range groups {
+		members, err := s.userService.ListByGroup(ctx, g.ID, schema.MembershipPermission)
+		if err != nil {
+			return Archive{}, fmt.Errorf("failed to list members of group %s: %w", g.Name, err)
+		}
+		grp := Group{
+			ID:       g.ID,
+			Name:     g.Name,
+			Title:    g.Title,
+			Metadata: g.Metadata,
+		}
+		for _, u := range members {
+			grp.Members = append(grp.Members, u.ID)
+		}
+		a.Groups = append(a.Groups, grp)
+
+		groupPolicies, err := s.listPolicies(ctx, policy.Filter{GroupID: g.ID})
+		if err != nil {
+			return Archive{}, fmt.Errorf("failed to list policies of group %s: %w", g.Name, err)
+		}
+		policies = append(policies, groupPolicies...)
+	}
+
+	resourceIDs := map[string]bool{}
+	projects, err := s.projectService.List(ctx, project.Filter{OrgID: org.ID})
+	if err != nil {
+		return Archive{}, fmt.Errorf("failed to list projects: %w", err)
+	}
+	for _, p := range projects {
+		a.Projects = append(a.Projects, Project{
+			ID:       p.ID,
+			Name:     p.Name,
+			Title:    p.Title,
+			Metadata: p.Metadata,
+		})
+
+		projectPolicies, err := s.listPolicies(ctx, policy.Filter{ProjectID: p.ID})
+		if err != nil {
+			return Archive{}, fmt.Errorf("failed to list policies of project %s: %w", p.Name, err)
+		}
+		policies = append(policies, projectPolicies...)
+
+		resources, err := s.resourceService.List(ctx, resource.Filter{ProjectID: p.ID})
+		if err != nil {
+			return Archive{}, fmt.Errorf("failed to list resources of project %s: %w", p.Name, err)
+		}
+		for _, r := range resources {
+			resourceIDs[r.ID] = true
+			var principal string
+			if r.PrincipalID != "" {
+				principal = schema.JoinNamespaceAndResourceID(r.PrincipalType, r.PrincipalID)
+			}
+			a.Resources = append(a.Resources, Resource{
+				ID:        r.ID,
+				Name:      r.Name,
+				Title:     r.Title,
+				Namespace: r.NamespaceID,
+				ProjectID: p.ID,
+				Principal: principal,
+				Metadata:  r.Metadata,
+			})
+		}
+	}
+
+	// policies can't be listed by resource, policies over resources are
+	// collected from the policies of the users of the organization
+	for _, u := range a.Users {
+		userPolicies, err := s.listPolicies(ctx, policy.Filter{
+			PrincipalID:   u.ID,
+			PrincipalType: schema.UserPrincipal,
+		})
+		if err != nil {
+			return Archive{}, fmt.Errorf("failed to list policies of user %s: %w", u.Email, err)
+		}
+		for _, p := range userPolicies {
+			if resourceIDs[p.ResourceID] {
+				policies = append(policies, p)
+			}
+		}
+	}
+
+	seenPolicies := map[string]bool{}
+	for _, p := range policies {
+		if seenPolicies[p.ID] {
+			continue
+		}
+		seenPolicies[p.ID] = true
+		a.Policies = append(a.Policies, Policy{
+			ID:        p.ID,
+			Role:      roleNames[p.RoleID],
+			Resource:  schema.JoinNamespaceAndResourceID(p.ResourceType, p.ResourceID),
+			Principal: schema.JoinNamespaceAndResourceID(p.PrincipalType, p.PrincipalID),
+			Effect:    p.Effect.String(),
+			Metadata:  p.Metadata,
+		})
+	}
+
+	prefs, err := s.preferenceService.List(ctx, preference.Filter{OrgID: org.ID})
+	if err != nil {
+		return Archive{}, fmt.Errorf("failed to list preferences: %w", err)
+	}
+	for _, p := range prefs {
+		a.Preferences = append(a.Preferences, Preference{Name: p.Name, Value: p.Value})
+	}
+
+	domains, err := s.domainService.List(ctx, domain.Filter{OrgID: org.ID})
+	if err != nil {
+		return Archive{}, fmt.Errorf("failed to list domains: %w", err)
+	}
+	for _, d := range domains {
+		a.Domains = append(a.Domains, Domain{Name: d.Name})
+	}
+	return a, nil
+}
+
+func (s Service) listPolicies(ctx context.Context, flt policy.Filter) ([]policy.Policy, error) {
+	policies, err := s.policyService.List(ctx, flt)
+	if err != nil && !errors.Is(err, policy.ErrNotExist) {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// Import recreates an archive. Entities are matched by name, users by email, and
+// are only created if missing, importing the same archive again makes no changes.
+// Domains are created unverified, policies over service users are not imported.
+// The report is returned with what got imported before a failure as well.
+func (s Service) Import(ctx context.Context, a Archive) (Report, error) {
+	if a.Version != Version {
+		return Report{}, fmt.Errorf("%w: version %d", ErrUnsupported, a.Version)
+	}
+	i := newImporter(s)
+	orgID, err := i.importOrganization(ctx, a.Organization)
+	if err != nil {
+		return i.report, fmt.Errorf("failed to import organization: %w", err)
+	}
+	i.report.OrganizationID = orgID
+	for _, step := range []struct {
+		kind string
+		fn   func(ctx context.Context, orgID string, a Archive) error
+	}{
+		{"users", i.importUsers},
+		{"roles", i.importRoles},
+		{"groups", i.importGroups},
+		{"projects", i.importProjects},
+		{"resources", i.importResources},
+		{"policies", i.importPolicies},
+		{"preferences", i.importPreferences},
+		{"domains", i.importDomains},
+	} {
+		if err := step.fn(ctx, orgID, a); err != nil {
+			return i.report, fmt.Errorf("failed to import %s: %w", step.kind, err)
+		}
+	}
+	return i.report, nil
+}
+
+type importer struct {
+	Service
+
+	// ids maps ids of the archive to ids of this environment
+	ids map[string]string
+	// roles maps names of organization and platform roles to their ids
+	roles  map[string]string
+	report Report
+}
+
+func newImporter(s Service) *importer {
+	stats := map[string]*Stats{}
+	for _, kind := range Kinds {
+		stats[kind] = &Stats{}
+	}
+	return &importer{
+		Service: s,
+		ids:     map[string]string{},
+		roles:   map[string]string{},
+		report:  Report{Stats: stats},
+	}
+}
+
+func (i *importer) importOrganization(ctx context.Context, org Organization) (string, error) {
+	current, err := i.orgService.Get(ctx, org.Name)
+	if err == nil {
+		i.ids[org.ID] = current.ID
+		i.report.Stats[KindOrganization].Existing++
+		return current.ID, nil
+	}
+	if !errors.Is(err, organization.ErrNotExist) {
+		return "", err
+	}
+
+	created, err := i.orgService.Create(ctx, organization.Organization{
+		Name:     org.Name,
+		Title:    org.Title,
+		Avatar:   org.Avatar,
+		Metadata: metadata.Build(org.Metadata),
+	})
+	if err != nil {
+		return "", err
+	}
+	i.ids[org.ID] = created.ID
+	i.report.Stats[KindOrganization].Created++
+	return created.ID, nil
+}
+
+func (i *importer) importUsers(ctx context.Context, orgID string, a Archive) error {
+	for _, u := range a.Users {
+		current, err := i.userService.GetByEmail(ctx, u.Email)
+		if err == nil {
+			i.ids[u.ID] = current.ID
+			i.report.Stats[KindUser].Existing++
+			continue
+		}
+		if !errors.Is(err, user.ErrNotExist) {
+			return fmt.Errorf("%s: %w", u.Email, err)
+		}
+
+		name := u.Name
+		if name == "" {
+			name = str.GenerateUserSlug(u.Email)
+		}
+		created, err := i.userService.Create(ctx, user.User{
+			Name:     name,
+			Email:    u.Email,
+			Title:    u.Title,
+			Avatar:   u.Avatar,
+			Metadata: metadata.Build(u.Metadata),
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", u.Email, err)
+		}
+		i.ids[u.ID] = created.ID
+		i.report.Stats[KindUser].Created++
+	}
+	return nil
+}
+
+func (i *importer) importRoles(ctx context.Context, orgID string, a Archive) error {
+	platformRoles, err := i.roleService.List(ctx, role.Filter{OrgID: schema.PlatformOrgID.String()})
+	if err != nil {
+		return err
+	}
+	for _, r := range platformRoles {
+		i.roles[r.Name] = r.ID
+	}
+	// organization roles take precedence over platform roles of the same name
+	orgRoles, err := i.roleService.List(ctx, role.Filter{OrgID: orgID})
+	if err != nil {
+		return err
+	}
+	for _, r := range orgRoles {
+		i.roles[r.Name] = r.ID
+	}
+
+	for _, r := range a.Roles {
+		if roleID, ok := i.roles[r.Name]; ok {
+			i.ids[r.ID] = roleID
+			i.report.Stats[KindRole].Existing++
+			continue
+		}
+		created, err := i.roleService.Upsert(ctx, role.Role{
+			OrgID:       orgID,
+			Name:        r.Name,
+			Title:       r.Title,
+			Permissions: r.Permissions,
+			Scopes:      r.Scopes,
+			Metadata:    metadata.Build(r.Metadata),
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", r.Name, err)
+		}
+		i.roles[r.Name] = created.ID
+		i.ids[r.ID] = created.ID
+		i.report.Stats[KindRole].Created++
+	}
+	return nil
+}
+
+func (i *importer) importGroups(ctx context.Context, orgID string, a Archive) error {
+	currentGroups, err := i.groupService.List(ctx, group.Filter{OrganizationID: orgID})
+	if err != nil {
+		return err
+	}
+	groupIDs := map[string]string{}
+	for _, g := range currentGroups {
+		groupIDs[g.Name] = g.ID
+	}
+
+	for _, g := range a.Groups {
+		groupID, ok := groupIDs[g.Name]
+		if ok {
+			i.report.Stats[KindGroup].Existing++
+		} else {
+			created, err := i.groupService.Create(ctx, group.Group{
+				Name:           g.Name,
+				Title:          g.Title,
+				OrganizationID: orgID,
+				Metadata:       metadata.Build(g.Metadata),
+			})
+			if err != nil {
+				return fmt.Errorf("%s: %w", g.Name, err)
+			}
+			groupID = created.ID
+			i.report.Stats[KindGroup].Created++
+		}
+		i.ids[g.ID] = groupID
+
+		members, err := i.userService.ListByGroup(ctx, groupID, schema.MembershipPermission)
+		if err != nil {
+			return fmt.Errorf("%s: %w", g.Name, err)
+		}
+		currentMembers := map[string]bool{}
+		for _, u := range members {
+			currentMembers[u.ID] = true
+		}
+		var userIDs []string
+		for _, member := range g.Members {
+			userID, ok := i.ids[member]
+			switch {
+			case !ok:
+				i.report.Stats[KindMember].Skipped++
+			case currentMembers[userID]:
+				i.report.Stats[KindMember].Existing++
+			default:
+				userIDs = append(userIDs, userID)
+			}
+		}
+		if len(userIDs) == 0 {
+			continue
+		}
+		if err := i.groupService.AddUsers(ctx, groupID, userIDs); err != nil {
+			return fmt.Errorf("%s: %w", g.Name, err)
+		}
+		i.report.Stats[KindMember].Created += len(userIDs)
+	}
+	return nil
+}
+
+func (i *importer) importProjects(ctx context.Context, orgID string, a Archive) error {
+	currentProjects, err := i.projectService.List(ctx, project.Filter{OrgID: orgID})
+	if err != nil {
+		return err
+	}
+	projectIDs := map[string]string{}
+	for _, p := range currentProjects {
+		projectIDs[p.Name] = p.ID
+	}
+
+	for _, p := range a.Projects {
+		if projectID, ok := projectIDs[p.Name]; ok {
+			i.ids[p.ID] = projectID
+			i.report.Stats[KindProject].Existing++
+			continue
+		}
+		created, err := i.projectService.Create(ctx, project.Project{
+			Name:         p.Name,
+			Title:        p.Title,
+			Organization: organization.Organization{ID: orgID},
+			Metadata:     metadata.Build(p.Metadata),
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", p.Name, err)
+		}
+		i.ids[p.ID] = created.ID
+		i.report.Stats[KindProject].Created++
+	}
+	return nil
+}
+
+func (i *importer) importResources(ctx context.Context, orgID string, a Archive) error {
+	// resources are matched by namespace and name within their project
+	currentResources := map[string]map[string]string{}
+	for _, r := range a.Resources {
+		projectID, ok := i.ids[r.ProjectID]
+		if !ok {
+			i.report.Stats[KindResource].Skipped++
+			continue
+		}
+		if _, ok := currentResources[projectID]; !ok {
+			resources, err := i.resourceService.List(ctx, resource.Filter{ProjectID: projectID})
+			if err != nil {
+				return err
+			}
+			currentResources[projectID] = map[string]string{}
+			for _, cr := range resources {
+				currentResources[projectID][cr.NamespaceID+"/"+cr.Name] = cr.ID
+			}
+		}
+		if resourceID, ok := currentResources[projectID][r.Namespace+"/"+r.Name]; ok {
+			i.ids[r.ID] = resourceID
+			i.report.Stats[KindResource].Existing++
+			continue
+		}
+
+		principalType, principalID, ok := i.remap(r.Principal)
+		if !ok {
+			i.report.Stats[KindResource].Skipped++
+			continue
+		}
+		created, err := i.resourceService.Create(ctx, resource.Resource{
+			Name:          r.Name,
+			Title:         r.Title,
+			ProjectID:     projectID,
+			NamespaceID:   r.Namespace,
+			PrincipalID:   principalID,
+			PrincipalType: principalType,
+			Metadata:      metadata.Build(r.Metadata),
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", r.Name, err)
+		}
+		i.ids[r.ID] = created.ID
+		i.report.Stats[KindResource].Created++
+	}
+	return nil
+}
+
+func (i *importer) importPolicies(ctx context.Context, orgID string, a Archive) error {
+	for _, p := range a.Policies {
+		roleID, roleOK := i.roles[p.Role]
+		resourceType, resourceID, resourceOK := i.remap(p.Resource)
+		principalType, principalID, principalOK := i.remap(p.Principal)
+		if !roleOK || !resourceOK || !principalOK || resourceID == "" || principalID == "" {
+			// e.g. service users which can't be exported with their credentials
+			i.report.Stats[KindPolicy].Skipped++
+			continue
+		}
+
+		// policies are upserted, importing an existing policy makes no changes
+		if _, err := i.policyService.Create(ctx, policy.Policy{
+			RoleID:        roleID,
+			ResourceID:    resourceID,
+			ResourceType:  resourceType,
+			PrincipalID:   principalID,
+			PrincipalType: principalType,
+			Effect:        policy.Effect(p.Effect),
+			Metadata:      metadata.Build(p.Metadata),
+		}); err != nil {
+			return fmt.Errorf("%s %s@%s: %w", p.Resource, p.Role, p.Principal, err)
+		}
+		i.report.Stats[KindPolicy].Created++
+	}
+	return nil
+}
+
+func (i *importer) importPreferences(ctx context.Context, orgID string, a Archive) error {
+	for _, p := range a.Preferences {
+		if _, err := i.preferenceService.Create(ctx, preference.Preference{
+			Name:         p.Name,
+			Value:        p.Value,
+			ResourceID:   orgID,
+			ResourceType: schema.OrganizationNamespace,
+		}); err != nil {
+			return fmt.Errorf("%s: %w", p.Name, err)
+		}
+		i.report.Stats[KindPreference].Created++
+	}
+	return nil
+}
+
+func (i *importer) importDomains(ctx context.Context, orgID string, a Archive) error {
+	currentDomains, err := i.domainService.List(ctx, domain.Filter{OrgID: orgID})
+	if err != nil {
+		return err
+	}
+	domains := map[string]bool{}
+	for _, d := range currentDomains {
+		domains[d.Name] = true
+	}
+	for _, d := range a.Domains {
+		if domains[d.Name] {
+			i.report.Stats[KindDomain].Existing++
+			continue
+		}
+		if _, err := i.domainService.Create(ctx, domain.Domain{
+			OrgID: orgID,
+			Name:  d.Name,
+		}); err != nil {
+			return fmt.Errorf("%s: %w", d.Name, err)
+		}
+		i.report.Stats[KindDomain].Created++
+	}
+	return nil
+}
+
+// remap replaces the id of a namespace:id pair with the id created on import,
+// ok is false if the entity of the id is not part of the archive
+func (i *importer) remap(nsID string) (string, string, bool) {
+	if nsID == "" {
+		return "", "", true
+	}
+	namespace, id, err := schema.SplitNamespaceAndResourceID(nsID)
+	if err != nil {
+		return "", "", false
+	}
+	newID, ok := i.ids[id]
+	if !ok {
+		return "", "", false
+	}
+	return namespace, newID, true
+}
@@ -0,0 +1,318 @@
+package archive
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/domain"
+	"github.com/raystack/frontier/core/group"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/preference"
+	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/core/resource"
+	"github.com/raystack/frontier/core/role"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// memStore keeps entities of an environment, policies are keyed by their
+// fields as they are upserted
+type memStore struct {
+	orgs        map[string]organization.Organization
+	users       map[string]user.User
+	members     map[string][]string
+	roles       map[string]role.Role
+	groups      map[string]group.Group
+	projects    map[string]project.Project
+	resources   map[string]resource.Resource
+	policies    map[string]policy.Policy
+	preferences map[string]preference.Preference
+	domains     map[string]domain.Domain
+}
+
+func newMemStore() *memStore {
+	s := &memStore{
+		orgs:        map[string]organization.Organization{},
+		users:       map[string]user.User{},
+		members:     map[string][]string{},
+		roles:       map[string]role.Role{},
+		groups:      map[string]group.Group{},
+		projects:    map[string]project.Project{},
+		resources:   map[string]resource.Resource{},
+		policies:    map[string]policy.Policy{},
+		preferences: map[string]preference.Preference{},
+		domains:     map[string]domain.Domain{},
+	}
+	s.roles["viewer-id"] = role.Role{ID: "viewer-id", Name: "app_organization_viewer", OrgID: schema.PlatformOrgID.String()}
+	return s
+}
+
+func (s *memStore) service() *Service {
+	return NewService(memOrgService{s}, memUserService{s}, memRoleService{s}, memGroupService{s},
+		memProjectService{s}, memResourceService{s}, memPolicyService{s}, memPreferenceService{s}, memDomainService{s})
+}
+
+type memOrgService struct{ *memStore }
+
+func (m memOrgService) Get(ctx context.Context, idOrName string) (organization.Organization, error) {
+	for _, o := range m.orgs {
+		if o.ID == idOrName || o.Name == idOrName {
+			return o, nil
+		}
+	}
+	return organization.Organization{}, organization.ErrNotExist
+}
+
+func (m memOrgService) Create(ctx context.Context, org organization.Organization) (organization.Organization, error) {
+	org.ID = uuid.NewString()
+	m.orgs[org.ID] = org
+	return org, nil
+}
+
+type memUserService struct{ *memStore }
+
+func (m memUserService) GetByEmail(ctx context.Context, email string) (user.User, error) {
+	for _, u := range m.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return user.User{}, user.ErrNotExist
+}
+
+func (m memUserService) Create(ctx context.Context, u user.User) (user.User, error) {
+	u.ID = uuid.NewString()
+	m.users[u.ID] = u
+	return u, nil
+}
+
+func (m memUserService) ListByOrg(ctx context.Context, orgID string, permissionFilter string) ([]user.User, error) {
+	return m.listMembers(orgID), nil
+}
+
+func (m memUserService) ListByGroup(ctx context.Context, groupID string, permissionFilter string) ([]user.User, error) {
+	return m.listMembers(groupID), nil
+}
+
+func (m memUserService) listMembers(id string) []user.User {
+	var users []user.User
+	for _, userID := range m.members[id] {
+		users = append(users, m.users[userID])
+	}
+	return users
+}
+
+type memRoleService struct{ *memStore }
+
+func (m memRoleService) List(ctx context.Context, f role.Filter) ([]role.Role, error) {
+	var roles []role.Role
+	for _, r := range m.roles {
+		if r.OrgID == f.OrgID {
+			roles = append(roles, r)
+		}
+	}
+	return roles, nil
+}
+
+func (m memRoleService) Upsert(ctx context.Context, r role.Role) (role.Role, error) {
+	r.ID = uuid.NewString()
+	m.roles[r.ID] = r
+	return r, nil
+}
+
+type memGroupService struct{ *memStore }
+
+func (m memGroupService) List(ctx context.Context, flt group.Filter) ([]group.Group, error) {
+	var groups []group.Group
+	for _, g := range m.groups {
+		if g.OrganizationID == flt.OrganizationID {
+			groups = append(groups, g)
+		}
+	}
+	return groups, nil
+}
+
+func (m memGroupService) Create(ctx context.Context, g group.Group) (group.Group, error) {
+	g.ID = uuid.NewString()
+	m.groups[g.ID] = g
+	return g, nil
+}
+
+func (m memGroupService) AddUsers(ctx context.Context, groupID string, userIDs []string) error {
+	m.members[groupID] = append(m.members[groupID], userIDs...)
+	return nil
+}
+
+type memProjectService struct{ *memStore }
+
+func (m memProjectService) List(ctx context.Context, f project.Filter) ([]project.Project, error) {
+	var projects []project.Project
+	for _, p := range m.projects {
+		if p.Organization.ID == f.OrgID {
+			projects = append(projects, p)
+		}
+	}
+	return projects, nil
+}
+
+func (m memProjectService) Create(ctx context.Context, p project.Project) (project.Project, error) {
+	p.ID = uuid.NewString()
+	m.projects[p.ID] = p
+	return p, nil
+}
+
+type memResourceService struct{ *memStore }
+
+func (m memResourceService) List(ctx context.Context, flt resource.Filter) ([]resource.Resource, error) {
+	var resources []resource.Resource
+	for _, r := range m.resources {
+		if r.ProjectID == flt.ProjectID {
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+func (m memResourceService) Create(ctx context.Context, r resource.Resource) (resource.Resource, error) {
+	r.ID = uuid.NewString()
+	m.resources[r.ID] = r
+	return r, nil
+}
+
+type memPolicyService struct{ *memStore }
+
+func (m memPolicyService) List(ctx context.Context, f policy.Filter) ([]policy.Policy, error) {
+	var policies []policy.Policy
+	for _, p := range m.policies {
+		switch {
+		case f.OrgID != "" && p.ResourceID == f.OrgID,
+			f.ProjectID != "" && p.ResourceID == f.ProjectID,
+			f.GroupID != "" && p.ResourceID == f.GroupID,
+			f.PrincipalID != "" && p.PrincipalID == f.PrincipalID:
+			policies = append(policies, p)
+		}
+	}
+	if len(policies) == 0 {
+		return nil, policy.ErrNotExist
+	}
+	return policies, nil
+}
+
+func (m memPolicyService) Create(ctx context.Context, p policy.Policy) (policy.Policy, error) {
+	key := p.RoleID + p.ResourceType + p.ResourceID + p.PrincipalType + p.PrincipalID
+	if current, ok := m.policies[key]; ok {
+		return current, nil
+	}
+	p.ID = uuid.NewString()
+	m.policies[key] = p
+	return p, nil
+}
+
+type memPreferenceService struct{ *memStore }
+
+func (m memPreferenceService) List(ctx context.Context, filter preference.Filter) ([]preference.Preference, error) {
+	var prefs []preference.Preference
+	for _, p := range m.preferences {
+		if p.ResourceID == filter.OrgID {
+			prefs = append(prefs, p)
+		}
+	}
+	return prefs, nil
+}
+
+func (m memPreferenceService) Create(ctx context.Context, p preference.Preference) (preference.Preference, error) {
+	m.preferences[p.ResourceID+p.Name] = p
+	return p, nil
+}
+
+type memDomainService struct{ *memStore }
+
+func (m memDomainService) List(ctx context.Context, flt domain.Filter) ([]domain.Domain, error) {
+	var domains []domain.Domain
+	for _, d := range m.domains {
+		if d.OrgID == flt.OrgID {
+			domains = append(domains, d)
+		}
+	}
+	return domains, nil
+}
+
+func (m memDomainService) Create(ctx context.Context, d domain.Domain) (domain.Domain, error) {
+	d.ID = uuid.NewString()
+	m.domains[d.ID] = d
+	return d, nil
+}
+
+func TestService_ExportImport(t *testing.T) {
+	ctx := context.Background()
+
+	source := newMemStore()
+	org, _ := memOrgService{source}.Create(ctx, organization.Organization{Name: "acme"})
+	john, _ := memUserService{source}.Create(ctx, user.User{Name: "john", Email: "john@acme.org"})
+	source.members[org.ID] = []string{john.ID}
+	admins, _ := memGroupService{source}.Create(ctx, group.Group{Name: "admins", OrganizationID: org.ID})
+	source.members[admins.ID] = []string{john.ID}
+	prj, _ := memProjectService{source}.Create(ctx, project.Project{Name: "web", Organization: org})
+	memResourceService{source}.Create(ctx, resource.Resource{Name: "db", NamespaceID: "compute/instance",
+		ProjectID: prj.ID, PrincipalID: john.ID, PrincipalType: schema.UserPrincipal})
+	memPolicyService{source}.Create(ctx, policy.Policy{RoleID: "viewer-id", ResourceID: org.ID, ResourceType: schema.OrganizationNamespace,
+		PrincipalID: admins.ID, PrincipalType: schema.GroupPrincipal, Effect: policy.Deny})
+	memPolicyService{source}.Create(ctx, policy.Policy{RoleID: "viewer-id", ResourceID: org.ID, ResourceType: schema.OrganizationNamespace,
+		PrincipalID: "some-serviceuser-id", PrincipalType: schema.ServiceUserPrincipal})
+	memDomainService{source}.Create(ctx, domain.Domain{Name: "acme.org", OrgID: org.ID})
+
+	a, err := source.service().Export(ctx, "acme")
+	assert.NoError(t, err)
+	assert.Len(t, a.Users, 1)
+	assert.Equal(t, []string{john.ID}, a.Groups[0].Members)
+	assert.Len(t, a.Policies, 2)
+
+	t.Run("should recreate the organization with remapped ids", func(t *testing.T) {
+		target := newMemStore()
+		report, err := target.service().Import(ctx, a)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, report.Stats[KindOrganization].Created)
+		assert.Equal(t, 1, report.Stats[KindMember].Created)
+		assert.Equal(t, 1, report.Stats[KindResource].Created)
+		assert.Equal(t, 1, report.Stats[KindPolicy].Created)
+		assert.Equal(t, 1, report.Stats[KindPolicy].Skipped)
+		assert.Equal(t, 1, report.Stats[KindDomain].Created)
+
+		var importedUserID, importedGroupID string
+		for _, u := range target.users {
+			importedUserID = u.ID
+		}
+		for _, g := range target.groups {
+			importedGroupID = g.ID
+			assert.Equal(t, report.OrganizationID, g.OrganizationID)
+		}
+		assert.NotEqual(t, john.ID, importedUserID)
+		assert.Equal(t, []string{importedUserID}, target.members[importedGroupID])
+		for _, r := range target.resources {
+			assert.Equal(t, importedUserID, r.PrincipalID)
+		}
+		for _, p := range target.policies {
+			assert.Equal(t, importedGroupID, p.PrincipalID)
+			assert.Equal(t, report.OrganizationID, p.ResourceID)
+			assert.Equal(t, policy.Deny, p.Effect)
+		}
+
+		t.Run("should make no changes when imported again", func(t *testing.T) {
+			report, err := target.service().Import(ctx, a)
+			assert.NoError(t, err)
+			for _, kind := range []string{KindOrganization, KindUser, KindGroup, KindMember, KindProject, KindResource, KindDomain} {
+				assert.Zero(t, report.Stats[kind].Created, kind)
+			}
+			assert.Len(t, target.users, 1)
+			assert.Len(t, target.members[importedGroupID], 1)
+			assert.Len(t, target.policies, 1)
+		})
+	})
+	t.Run("should fail for unknown archive version", func(t *testing.T) {
+		_, err := newMemStore().service().Import(ctx, Archive{Version: 2})
+		assert.ErrorIs(t, err, ErrUnsupported)
+	})
+}
@@ -10,8 +10,12 @@ import (
 var (
 	ErrInvalidDetail = fmt.Errorf("invalid audit details")
 	ErrInvalidID     = fmt.Errorf("group id is invalid")
+	ErrUnsupported   = fmt.Errorf("unsupported")
 )
 
+// AnonymizedActorName replaces the name of actors who were deleted
+const AnonymizedActorName = "deleted user"
+
 type Actor struct {
 	ID   string
 	Type string
@@ -45,12 +49,20 @@ func (e EventName) String() string {
 }
 
 const (
-	UserCreatedEvent        EventName = "app.user.created"
-	UserUpdatedEvent        EventName = "app.user.updated"
-	UserDeletedEvent        EventName = "app.user.deleted"
-	UserListedEvent         EventName = "app.user.listed"
-	ServiceUserCreatedEvent EventName = "app.serviceuser.created"
-	ServiceUserDeletedEvent EventName = "app.serviceuser.deleted"
+	UserCreatedEvent          EventName = "app.user.created"
+	UserUpdatedEvent          EventName = "app.user.updated"
+	UserDeletedEvent          EventName = "app.user.deleted"
+	UserListedEvent           EventName = "app.user.listed"
+	UserEmailChangedEvent     EventName = "app.user.email.changed"
+	UserEmailAddedEvent       EventName = "app.user.email.added"
+	UserEmailRemovedEvent     EventName = "app.user.email.removed"
+	UserIdentityLinkedEvent   EventName = "app.user.identity.linked"
+	UserIdentityUnlinkedEvent EventName = "app.user.identity.unlinked"
+	UserMergedEvent           EventName = "app.user.merged"
+	UserTokenCreatedEvent     EventName = "app.user.token.created"
+	UserTokenRevokedEvent     EventName = "app.user.token.revoked"
+	ServiceUserCreatedEvent   EventName = "app.serviceuser.created"
+	ServiceUserDeletedEvent   EventName = "app.serviceuser.deleted"
 
 	GroupCreatedEvent EventName = "app.group.created"
 	GroupUpdatedEvent EventName = "app.group.updated"
@@ -68,15 +80,30 @@ const (
 	PolicyCreatedEvent EventName = "app.policy.created"
 	PolicyDeletedEvent EventName = "app.policy.deleted"
 
-	OrgCreatedEvent       EventName = "app.organization.created"
-	OrgUpdatedEvent       EventName = "app.organization.updated"
-	OrgDeletedEvent       EventName = "app.organization.deleted"
-	OrgMemberCreatedEvent EventName = "app.organization.member.created"
-	OrgMemberDeletedEvent EventName = "app.organization.member.deleted"
+	OrgCreatedEvent           EventName = "app.organization.created"
+	OrgUpdatedEvent           EventName = "app.organization.updated"
+	OrgDeletedEvent           EventName = "app.organization.deleted"
+	OrgMemberCreatedEvent     EventName = "app.organization.member.created"
+	OrgMemberDeletedEvent     EventName = "app.organization.member.deleted"
+	OrgOwnerTransferredEvent  EventName = "app.organization.owner.transferred"
+	OrgTransferDeclinedEvent  EventName = "app.organization.transfer.declined"
+	OrgExportedEvent          EventName = "app.organization.exported"
+	OrgImportedEvent          EventName = "app.organization.imported"
+	OrgInviteLinkCreatedEvent EventName = "app.organization.invitelink.created"
+	OrgInviteLinkRevokedEvent EventName = "app.organization.invitelink.revoked"
+
+	OrgMailTemplateSetEvent     EventName = "app.organization.mailtemplate.set"
+	OrgMailTemplateDeletedEvent EventName = "app.organization.mailtemplate.deleted"
 
 	ProjectCreatedEvent EventName = "app.project.created"
 	ProjectUpdatedEvent EventName = "app.project.updated"
 	ProjectDeletedEvent EventName = "app.project.deleted"
+	ProjectMovedEvent   EventName = "app.project.moved"
+
+	FolderCreatedEvent EventName = "app.folder.created"
+	FolderUpdatedEvent EventName = "app.folder.updated"
+	FolderDeletedEvent EventName = "app.folder.deleted"
+	FolderMovedEvent   EventName = "app.folder.moved"
 
 	ResourceCreatedEvent EventName = "app.resource.created"
 	ResourceUpdatedEvent EventName = "app.resource.updated"
@@ -97,6 +124,13 @@ func ProjectTarget(id string) Target {
 	}
 }
 
+func FolderTarget(id string) Target {
+	return Target{
+		ID:   id,
+		Type: schema.FolderNamespace,
+	}
+}
+
 func UserTarget(id string) Target {
 	return Target{
 		ID:   id,
@@ -6,6 +6,8 @@ type Filter struct {
 	OrgID  string
 	Source string
 	Action string
+	// ActorID lists logs of the actor across organizations
+	ActorID string
 
 	StartTime time.Time
 	EndTime   time.Time
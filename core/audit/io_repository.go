@@ -3,7 +3,6 @@ package audit
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"io"
 )
 
@@ -21,9 +20,14 @@ func (r WriteOnlyRepository) Create(ctx context.Context, log *Log) error {
 }
 
 func (r WriteOnlyRepository) List(ctx context.Context, filter Filter) ([]Log, error) {
-	return nil, fmt.Errorf("unsupported")
+	return nil, ErrUnsupported
 }
 
 func (r WriteOnlyRepository) GetByID(ctx context.Context, s string) (Log, error) {
-	return Log{}, fmt.Errorf("unsupported")
+	return Log{}, ErrUnsupported
+}
+
+func (r WriteOnlyRepository) AnonymizeActor(ctx context.Context, actorID string) error {
+	// logs are already written out and can't be changed
+	return nil
 }
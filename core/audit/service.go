@@ -8,6 +8,7 @@ type Repository interface {
 	Create(context.Context, *Log) error
 	List(context.Context, Filter) ([]Log, error)
 	GetByID(context.Context, string) (Log, error)
+	AnonymizeActor(ctx context.Context, actorID string) error
 }
 
 type Option func(*Service)
@@ -56,3 +57,9 @@ func (s *Service) List(ctx context.Context, flt Filter) ([]Log, error) {
 func (s *Service) GetByID(ctx context.Context, id string) (Log, error) {
 	return s.repository.GetByID(ctx, id)
 }
+
+// AnonymizeActor strips the identity of the actor from its logs, logs are kept
+// as history of what happened
+func (s *Service) AnonymizeActor(ctx context.Context, actorID string) error {
+	return s.repository.AnonymizeActor(ctx, actorID)
+}
@@ -33,6 +33,9 @@ const (
 	JWTGrantClientAssertion          ClientAssertion = "jwt_grant"
 	ClientCredentialsClientAssertion ClientAssertion = "client_credentials"
 	PassthroughHeaderClientAssertion ClientAssertion = "passthrough_header"
+	// PersonalAccessTokenClientAssertion authenticates a user with a personal access token
+	// sent as a bearer token
+	PersonalAccessTokenClientAssertion ClientAssertion = "personal_access_token"
 )
 
 func (a ClientAssertion) String() string {
@@ -45,6 +48,7 @@ var AllClientAssertions = []ClientAssertion{
 	JWTGrantClientAssertion,
 	ClientCredentialsClientAssertion,
 	PassthroughHeaderClientAssertion,
+	PersonalAccessTokenClientAssertion,
 }
 
 // Flow is a temporary state used to finish login/registration flows
@@ -90,6 +94,10 @@ type RegistrationStartRequest struct {
 	// For most cases it could be host of frontier but in case of proxies, this will be proxy public endpoint.
 	// callback_url should be one of the allowed urls configured at instance level
 	CallbackUrl string
+
+	// LinkUserID links the account of the oidc provider to the user instead of
+	// logging in with it
+	LinkUserID string
 }
 
 type RegistrationFinishRequest struct {
@@ -118,4 +126,8 @@ type Principal struct {
 
 	User        *user.User
 	ServiceUser *serviceuser.ServiceUser
+
+	// Scope narrows what the principal can do on top of its policies, set only
+	// for principals authenticated with a scoped credential or personal access token
+	Scope *serviceuser.Scope
 }
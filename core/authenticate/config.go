@@ -15,6 +15,7 @@ type Config struct {
 	MailOTP    MailOTPConfig         `yaml:"mail_otp" mapstructure:"mail_otp"`
 	MailLink   MailLinkConfig        `yaml:"mail_link" mapstructure:"mail_link"`
 	PassKey    PassKeyConfig         `yaml:"passkey" mapstructure:"passkey"`
+	Device     DeviceConfig          `yaml:"device" mapstructure:"device"`
 }
 
 type TokenConfig struct {
@@ -68,3 +69,12 @@ type PassKeyConfig struct {
 	RPID          string   `yaml:"rpid"`
 	RPOrigins     []string `yaml:"rporigins"`
 }
+
+type DeviceConfig struct {
+	// VerificationURL is the page of the frontend where users enter the code shown
+	// by a device to approve its login
+	VerificationURL string        `yaml:"verification_url" mapstructure:"verification_url"`
+	Validity        time.Duration `yaml:"validity" mapstructure:"validity" default:"10m"`
+	// Interval is the minimum time devices should wait between polls
+	Interval time.Duration `yaml:"interval" mapstructure:"interval" default:"5s"`
+}
@@ -0,0 +1,151 @@
+package authenticate
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/pkg/metadata"
+)
+
+const (
+	// DeviceCodeGrantType is the grant polled by devices until the user approves the login
+	DeviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+	DeviceAuthMethod    = "device"
+
+	// deviceApprovedUserKey stores the user who approved a device flow
+	deviceApprovedUserKey = "device_user_id"
+
+	// userCodeAlphabet has no vowels to avoid forming words and no look alike characters
+	userCodeAlphabet = "BCDFGHJKLMNPQRSTVWXZ"
+	userCodeLength   = 8
+)
+
+var (
+	ErrAuthorizationPending = errors.New("device authorization is pending")
+	ErrInvalidUserCode      = errors.New("invalid or expired user code")
+)
+
+// DeviceAuthorization is returned to a device to start a login, the device shows the
+// UserCode and VerificationURL to the user and polls with DeviceCode until approved
+type DeviceAuthorization struct {
+	DeviceCode      string
+	UserCode        string
+	VerificationURL string
+	ExpiresAt       time.Time
+	Interval        time.Duration
+}
+
+// VerificationURLComplete is the verification url with the user code filled in
+func (d DeviceAuthorization) VerificationURLComplete() string {
+	if d.VerificationURL == "" {
+		return ""
+	}
+	u, err := url.Parse(d.VerificationURL)
+	if err != nil {
+		return d.VerificationURL
+	}
+	q := u.Query()
+	q.Set("user_code", d.UserCode)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// StartDeviceFlow starts a login for devices without a browser, the device code is the
+// id of the flow and the user code is kept as its nonce
+func (s Service) StartDeviceFlow(ctx context.Context) (DeviceAuthorization, error) {
+	userCode, err := generateUserCode()
+	if err != nil {
+		return DeviceAuthorization{}, err
+	}
+	flow := &Flow{
+		ID:        uuid.New(),
+		Method:    DeviceAuthMethod,
+		Nonce:     userCode,
+		CreatedAt: s.Now(),
+		ExpiresAt: s.Now().Add(defaultFlowExp),
+		Metadata:  metadata.Metadata{},
+	}
+	if s.config.Device.Validity != 0 {
+		flow.ExpiresAt = flow.CreatedAt.Add(s.config.Device.Validity)
+	}
+	if err = s.flowRepo.Set(ctx, flow); err != nil {
+		return DeviceAuthorization{}, err
+	}
+	return DeviceAuthorization{
+		DeviceCode:      flow.ID.String(),
+		UserCode:        formatUserCode(userCode),
+		VerificationURL: s.config.Device.VerificationURL,
+		ExpiresAt:       flow.ExpiresAt,
+		Interval:        s.config.Device.Interval,
+	}, nil
+}
+
+// ApproveDeviceFlow logs in the device waiting with the user code as the given user
+func (s Service) ApproveDeviceFlow(ctx context.Context, userCode, userID string) error {
+	flow, err := s.flowRepo.GetByNonce(ctx, DeviceAuthMethod, normalizeUserCode(userCode))
+	if err != nil {
+		if errors.Is(err, ErrFlowInvalid) {
+			return ErrInvalidUserCode
+		}
+		return err
+	}
+	if !flow.IsValid(s.Now()) || flow.Metadata[deviceApprovedUserKey] != nil {
+		return ErrInvalidUserCode
+	}
+	flow.Metadata[deviceApprovedUserKey] = userID
+	return s.flowRepo.Set(ctx, flow)
+}
+
+// FinishDeviceFlow returns the user who approved the device code and consumes the flow,
+// ErrAuthorizationPending is returned until the user approves it
+func (s Service) FinishDeviceFlow(ctx context.Context, deviceCode string) (string, error) {
+	flowID, err := uuid.Parse(deviceCode)
+	if err != nil {
+		return "", ErrFlowInvalid
+	}
+	flow, err := s.flowRepo.Get(ctx, flowID)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrFlowInvalid, err)
+	}
+	if flow.Method != DeviceAuthMethod || !flow.IsValid(s.Now()) {
+		return "", ErrFlowInvalid
+	}
+	userID, ok := flow.Metadata[deviceApprovedUserKey].(string)
+	if !ok || userID == "" {
+		return "", ErrAuthorizationPending
+	}
+	if err = s.consumeFlow(ctx, flow.ID); err != nil {
+		return "", err
+	}
+	return userID, nil
+}
+
+func generateUserCode() (string, error) {
+	var code strings.Builder
+	max := big.NewInt(int64(len(userCodeAlphabet)))
+	for i := 0; i < userCodeLength; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code.WriteByte(userCodeAlphabet[n.Int64()])
+	}
+	return code.String(), nil
+}
+
+// formatUserCode splits the code in halves to make it easier to type
+func formatUserCode(code string) string {
+	return code[:userCodeLength/2] + "-" + code[userCodeLength/2:]
+}
+
+func normalizeUserCode(code string) string {
+	code = strings.ToUpper(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
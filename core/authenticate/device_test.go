@@ -0,0 +1,106 @@
+package authenticate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/authenticate/token"
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/assert"
+)
+
+type memFlowRepository struct {
+	flows map[uuid.UUID]Flow
+}
+
+func (r *memFlowRepository) Set(ctx context.Context, flow *Flow) error {
+	r.flows[flow.ID] = *flow
+	return nil
+}
+
+func (r *memFlowRepository) Get(ctx context.Context, id uuid.UUID) (*Flow, error) {
+	flow, ok := r.flows[id]
+	if !ok {
+		return nil, ErrFlowInvalid
+	}
+	return &flow, nil
+}
+
+func (r *memFlowRepository) GetByNonce(ctx context.Context, method, nonce string) (*Flow, error) {
+	for _, flow := range r.flows {
+		if flow.Method == method && flow.Nonce == nonce {
+			return &flow, nil
+		}
+	}
+	return nil, ErrFlowInvalid
+}
+
+func (r *memFlowRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	delete(r.flows, id)
+	return nil
+}
+
+func (r *memFlowRepository) DeleteExpiredFlows(ctx context.Context) error {
+	return nil
+}
+
+func newDeviceTestService() (*Service, *memFlowRepository) {
+	repo := &memFlowRepository{flows: map[uuid.UUID]Flow{}}
+	config := Config{Device: DeviceConfig{
+		VerificationURL: "https://console.example.com/device",
+		Interval:        5 * time.Second,
+	}}
+	return NewService(log.NewNoop(), config, repo, nil, token.Service{}, nil, nil, nil, nil, nil, nil), repo
+}
+
+func TestService_DeviceFlow(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should log in the device as the user who approved its code", func(t *testing.T) {
+		svc, repo := newDeviceTestService()
+		authorization, err := svc.StartDeviceFlow(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, authorization.UserCode, userCodeLength+1)
+		assert.Equal(t, "https://console.example.com/device?user_code="+authorization.UserCode,
+			authorization.VerificationURLComplete())
+
+		_, err = svc.FinishDeviceFlow(ctx, authorization.DeviceCode)
+		assert.ErrorIs(t, err, ErrAuthorizationPending)
+
+		// codes are accepted in any case and without the separator
+		code := normalizeUserCode(authorization.UserCode)
+		assert.NoError(t, svc.ApproveDeviceFlow(ctx, code[:2]+" "+code[2:], "user-1"))
+
+		userID, err := svc.FinishDeviceFlow(ctx, authorization.DeviceCode)
+		assert.NoError(t, err)
+		assert.Equal(t, "user-1", userID)
+		assert.Empty(t, repo.flows)
+
+		_, err = svc.FinishDeviceFlow(ctx, authorization.DeviceCode)
+		assert.ErrorIs(t, err, ErrFlowInvalid)
+	})
+	t.Run("should not approve a code twice", func(t *testing.T) {
+		svc, _ := newDeviceTestService()
+		authorization, err := svc.StartDeviceFlow(ctx)
+		assert.NoError(t, err)
+
+		assert.NoError(t, svc.ApproveDeviceFlow(ctx, authorization.UserCode, "user-1"))
+		assert.ErrorIs(t, svc.ApproveDeviceFlow(ctx, authorization.UserCode, "user-2"), ErrInvalidUserCode)
+	})
+	t.Run("should fail for unknown or expired codes", func(t *testing.T) {
+		svc, _ := newDeviceTestService()
+		authorization, err := svc.StartDeviceFlow(ctx)
+		assert.NoError(t, err)
+
+		assert.ErrorIs(t, svc.ApproveDeviceFlow(ctx, "BCDF-GHJK", "user-1"), ErrInvalidUserCode)
+		_, err = svc.FinishDeviceFlow(ctx, uuid.NewString())
+		assert.ErrorIs(t, err, ErrFlowInvalid)
+
+		svc.Now = func() time.Time { return time.Now().UTC().Add(time.Hour) }
+		assert.ErrorIs(t, svc.ApproveDeviceFlow(ctx, authorization.UserCode, "user-1"), ErrInvalidUserCode)
+		_, err = svc.FinishDeviceFlow(ctx, authorization.DeviceCode)
+		assert.ErrorIs(t, err, ErrFlowInvalid)
+	})
+}
@@ -0,0 +1,131 @@
+package authenticate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/authenticate/strategy"
+	"github.com/raystack/frontier/core/identity"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/pkg/metadata"
+)
+
+const (
+	// emailChangeUserKey marks a mail otp flow as the verification of a new email
+	// of the user stored under the key
+	emailChangeUserKey = "email_change_user_id"
+	// emailChangeSecondaryKey marks the verified email to be added as a secondary
+	// email instead of replacing the primary email
+	emailChangeSecondaryKey = "email_change_secondary"
+)
+
+// EmailChange is the outcome of a verified email change
+type EmailChange struct {
+	User user.User
+	// Email is the verified email
+	Email string
+	// Secondary is set if the email was added as a secondary email
+	Secondary bool
+}
+
+// StartEmailChange sends an otp to the new email of the user, the email is changed
+// once the otp is submitted with FinishEmailChange
+func (s Service) StartEmailChange(ctx context.Context, userID, email string) (*Flow, error) {
+	return s.startEmailVerification(ctx, userID, email, false)
+}
+
+// StartAddEmail sends an otp to an email to add as a secondary email of the user,
+// the email is added once the otp is submitted with FinishEmailChange
+func (s Service) StartAddEmail(ctx context.Context, userID, email string) (*Flow, error) {
+	return s.startEmailVerification(ctx, userID, email, true)
+}
+
+func (s Service) startEmailVerification(ctx context.Context, userID, email string, secondary bool) (*Flow, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if err := s.checkEmailAvailable(ctx, email); err != nil {
+		return nil, err
+	}
+
+	mailOTPStrat := strategy.NewMailOTP(s.mailService, s.config.MailOTP.Subject, s.config.MailOTP.Body)
+	nonce, err := mailOTPStrat.SendMail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	flow := &Flow{
+		ID:        uuid.New(),
+		Method:    MailOTPAuthMethod.String(),
+		Email:     email,
+		Nonce:     nonce,
+		CreatedAt: s.Now(),
+		ExpiresAt: s.Now().Add(defaultFlowExp),
+		Metadata: metadata.Metadata{
+			emailChangeUserKey:      userID,
+			emailChangeSecondaryKey: secondary,
+		},
+	}
+	if s.config.MailOTP.Validity != 0 {
+		flow.ExpiresAt = flow.CreatedAt.Add(s.config.MailOTP.Validity)
+	}
+	if err = s.flowRepo.Set(ctx, flow); err != nil {
+		return nil, err
+	}
+	return flow, nil
+}
+
+// FinishEmailChange verifies the otp sent by StartEmailChange or StartAddEmail and
+// changes the email of the user or adds the secondary email
+func (s Service) FinishEmailChange(ctx context.Context, userID, state, code string) (EmailChange, error) {
+	flowID, err := uuid.Parse(state)
+	if err != nil {
+		return EmailChange{}, ErrFlowInvalid
+	}
+	flow, err := s.flowRepo.Get(ctx, flowID)
+	if err != nil {
+		return EmailChange{}, fmt.Errorf("%w: %s", ErrFlowInvalid, err)
+	}
+	// a flow can only change the email of the user who started it
+	if flowUserID, _ := flow.Metadata[emailChangeUserKey].(string); flowUserID != userID {
+		return EmailChange{}, ErrFlowInvalid
+	}
+	if err = s.verifyMailOTP(ctx, flow, code); err != nil {
+		return EmailChange{}, err
+	}
+	// email could have been taken since the otp was sent
+	if err = s.checkEmailAvailable(ctx, flow.Email); err != nil {
+		return EmailChange{}, err
+	}
+
+	change := EmailChange{Email: flow.Email}
+	if secondary, _ := flow.Metadata[emailChangeSecondaryKey].(bool); secondary {
+		if _, err = s.identityService.AddEmail(ctx, userID, flow.Email); err != nil {
+			if errors.Is(err, identity.ErrEmailConflict) {
+				return EmailChange{}, user.ErrConflict
+			}
+			return EmailChange{}, err
+		}
+		change.Secondary = true
+		change.User, err = s.userService.GetByID(ctx, userID)
+		return change, err
+	}
+	change.User, err = s.userService.UpdateEmail(ctx, userID, flow.Email)
+	return change, err
+}
+
+// checkEmailAvailable returns user.ErrConflict if the email is the primary or a
+// secondary email of a user
+func (s Service) checkEmailAvailable(ctx context.Context, email string) error {
+	if _, err := s.userService.GetByEmail(ctx, email); err == nil || errors.Is(err, user.ErrDeleted) {
+		return user.ErrConflict
+	} else if !errors.Is(err, user.ErrNotExist) {
+		return err
+	}
+	if _, err := s.identityService.GetEmail(ctx, email); err == nil {
+		return user.ErrConflict
+	} else if !errors.Is(err, identity.ErrEmailNotExist) {
+		return err
+	}
+	return nil
+}
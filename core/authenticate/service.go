@@ -20,6 +20,7 @@ import (
 	"github.com/lestrrat-go/jwx/v2/jwt"
 
 	frontiersession "github.com/raystack/frontier/core/authenticate/session"
+	"github.com/raystack/frontier/core/pat"
 	"github.com/raystack/frontier/core/serviceuser"
 	"github.com/raystack/frontier/internal/bootstrap/schema"
 	"github.com/raystack/frontier/pkg/errors"
@@ -30,12 +31,11 @@ import (
 
 	"github.com/raystack/frontier/pkg/utils"
 
-	"github.com/raystack/frontier/pkg/mailer"
-
 	"github.com/raystack/salt/log"
 
 	"github.com/google/uuid"
 	"github.com/raystack/frontier/core/authenticate/strategy"
+	"github.com/raystack/frontier/core/identity"
 	"github.com/raystack/frontier/core/user"
 	"github.com/raystack/frontier/pkg/str"
 	"github.com/robfig/cron/v3"
@@ -45,6 +45,10 @@ const (
 	defaultFlowExp = time.Minute * 10
 	maxOTPAttempt  = 3
 	otpAttemptKey  = "attempt"
+
+	// linkIdentityUserKey marks an oidc flow as linking the account of the provider
+	// to the user stored under the key
+	linkIdentityUserKey = "link_user_id"
 )
 
 var (
@@ -53,22 +57,43 @@ var (
 	ErrUnsupportedMethod     = errors.New("unsupported authentication method")
 	ErrInvalidMailOTP        = errors.New("invalid mail otp")
 	ErrFlowInvalid           = errors.New("invalid flow or expired")
+	ErrIdentityMismatch      = errors.New("email belongs to a user linked to another account of the provider")
 )
 
 type UserService interface {
 	GetByID(ctx context.Context, id string) (user.User, error)
+	GetByEmail(ctx context.Context, email string) (user.User, error)
 	Create(context.Context, user.User) (user.User, error)
 	Update(ctx context.Context, toUpdate user.User) (user.User, error)
+	UpdateEmail(ctx context.Context, id, email string) (user.User, error)
+}
+
+type IdentityService interface {
+	GetBySubject(ctx context.Context, provider, subject string) (identity.Identity, error)
+	Link(ctx context.Context, identity identity.Identity) (identity.Identity, error)
+	HasProvider(ctx context.Context, userID, provider string) (bool, error)
+	GetEmail(ctx context.Context, email string) (identity.Email, error)
+	AddEmail(ctx context.Context, userID, email string) (identity.Email, error)
 }
 
 type ServiceUserService interface {
-	GetByToken(ctx context.Context, token string) (serviceuser.ServiceUser, error)
-	GetBySecret(ctx context.Context, clientID, clientSecret string) (serviceuser.ServiceUser, error)
+	GetByToken(ctx context.Context, token string) (serviceuser.ServiceUser, serviceuser.Credential, error)
+	GetBySecret(ctx context.Context, clientID, clientSecret string) (serviceuser.ServiceUser, serviceuser.Credential, error)
+}
+
+type DomainService interface {
+	AutoJoin(ctx context.Context, userID string) error
+}
+
+type PATService interface {
+	GetByValue(ctx context.Context, value string) (pat.Token, error)
 }
 
 type FlowRepository interface {
 	Set(ctx context.Context, flow *Flow) error
 	Get(ctx context.Context, id uuid.UUID) (*Flow, error)
+	// GetByNonce returns ErrFlowInvalid if no flow of the method has the nonce
+	GetByNonce(ctx context.Context, method, nonce string) (*Flow, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	DeleteExpiredFlows(ctx context.Context) error
 }
@@ -82,43 +107,55 @@ type Service struct {
 	cron                 *cron.Cron
 	flowRepo             FlowRepository
 	userService          UserService
+	identityService      IdentityService
 	config               Config
-	mailDialer           mailer.Dialer
+	mailService          strategy.MailSender
 	Now                  func() time.Time
 	internalTokenService token.Service
 	sessionService       SessionService
 	serviceUserService   ServiceUserService
+	patService           PATService
+	domainService        DomainService
 	webAuth              *webauthn.WebAuthn
 }
 
 func NewService(logger log.Logger, config Config, flowRepo FlowRepository,
-	mailDialer mailer.Dialer, tokenService token.Service, sessionService SessionService,
-	userService UserService, serviceUserService ServiceUserService, webAuthConfig *webauthn.WebAuthn) *Service {
+	mailService strategy.MailSender, tokenService token.Service, sessionService SessionService,
+	userService UserService, identityService IdentityService, serviceUserService ServiceUserService,
+	patService PATService, webAuthConfig *webauthn.WebAuthn) *Service {
 	r := &Service{
-		log:         logger,
-		cron:        cron.New(),
-		flowRepo:    flowRepo,
-		userService: userService,
-		config:      config,
-		mailDialer:  mailDialer,
+		log:             logger,
+		cron:            cron.New(),
+		flowRepo:        flowRepo,
+		userService:     userService,
+		identityService: identityService,
+		config:          config,
+		mailService:     mailService,
 		Now: func() time.Time {
 			return time.Now().UTC()
 		},
 		internalTokenService: tokenService,
 		sessionService:       sessionService,
 		serviceUserService:   serviceUserService,
+		patService:           patService,
 		webAuth:              webAuthConfig,
 	}
 	return r
 }
 
+// SetDomainService sets the service adding new users to the organizations of
+// their email domain, it's set after creation as it depends on this service
+func (s *Service) SetDomainService(domainService DomainService) {
+	s.domainService = domainService
+}
+
 func (s Service) SupportedStrategies() []string {
 	// add here strategies like mail link once implemented
 	var strategies = []string{}
 	for name := range s.config.OIDCConfig {
 		strategies = append(strategies, name)
 	}
-	if s.mailDialer != nil {
+	if s.mailService != nil {
 		strategies = append(strategies, MailOTPAuthMethod.String(), MailLinkAuthMethod.String())
 	}
 	if s.webAuth != nil {
@@ -160,6 +197,10 @@ func (s Service) StartFlow(ctx context.Context, request RegistrationStartRequest
 	if !utils.Contains(s.SupportedStrategies(), request.Method) {
 		return nil, ErrUnsupportedMethod
 	}
+	if _, ok := s.config.OIDCConfig[request.Method]; request.LinkUserID != "" && !ok {
+		// only accounts of oidc providers can be linked
+		return nil, ErrUnsupportedMethod
+	}
 	flow := &Flow{
 		ID:        uuid.New(),
 		Method:    request.Method,
@@ -175,6 +216,9 @@ func (s Service) StartFlow(ctx context.Context, request RegistrationStartRequest
 	if request.Method == PassKeyAuthMethod.String() {
 		needRegistration := false
 		loggedInUser, err := s.userService.GetByID(ctx, request.Email)
+		if errors.Is(err, user.ErrDeleted) {
+			return nil, err
+		}
 		if err != nil {
 			needRegistration = true
 		} else {
@@ -203,8 +247,8 @@ func (s Service) StartFlow(ctx context.Context, request RegistrationStartRequest
 	}
 
 	if request.Method == MailOTPAuthMethod.String() {
-		mailLinkStrat := strategy.NewMailOTP(s.mailDialer, s.config.MailOTP.Subject, s.config.MailOTP.Body)
-		nonce, err := mailLinkStrat.SendMail(request.Email)
+		mailLinkStrat := strategy.NewMailOTP(s.mailService, s.config.MailOTP.Subject, s.config.MailOTP.Body)
+		nonce, err := mailLinkStrat.SendMail(ctx, request.Email)
 		if err != nil {
 			return nil, err
 		}
@@ -228,8 +272,8 @@ func (s Service) StartFlow(ctx context.Context, request RegistrationStartRequest
 	}
 
 	if request.Method == MailLinkAuthMethod.String() {
-		mailLinkStrat := strategy.NewMailLink(s.mailDialer, request.CallbackUrl, s.config.MailLink.Subject, s.config.MailLink.Body)
-		nonce, err := mailLinkStrat.SendMail(flow.ID.String(), request.Email)
+		mailLinkStrat := strategy.NewMailLink(s.mailService, request.CallbackUrl, s.config.MailLink.Subject, s.config.MailLink.Body)
+		nonce, err := mailLinkStrat.SendMail(ctx, flow.ID.String(), request.Email)
 		if err != nil {
 			return nil, err
 		}
@@ -269,6 +313,9 @@ func (s Service) StartFlow(ctx context.Context, request RegistrationStartRequest
 
 		flow.StartURL = endpoint
 		flow.Nonce = nonce
+		if request.LinkUserID != "" {
+			flow.Metadata[linkIdentityUserKey] = request.LinkUserID
+		}
 		if oidcConfig.Validity != 0 {
 			flow.ExpiresAt = flow.CreatedAt.Add(oidcConfig.Validity)
 		}
@@ -327,10 +374,31 @@ func (s Service) applyMailOTP(ctx context.Context, request RegistrationFinishReq
 	if err != nil {
 		return nil, fmt.Errorf("invalid state for mail otp: %w", err)
 	}
-	if !flow.IsValid(s.Now()) {
+	if _, ok := flow.Metadata[emailChangeUserKey]; ok {
+		// otp of an email change can't be used to login
 		return nil, ErrFlowInvalid
 	}
-	if flow.Nonce != request.Code {
+	if err = s.verifyMailOTP(ctx, flow, request.Code); err != nil {
+		return nil, err
+	}
+
+	newUser, err := s.getOrCreateUser(ctx, flow.Email, "")
+	if err != nil {
+		return nil, err
+	}
+	return &RegistrationFinishResponse{
+		User: newUser,
+		Flow: flow,
+	}, nil
+}
+
+// verifyMailOTP checks the code against the otp sent for the flow and consumes
+// the flow once the code matches
+func (s Service) verifyMailOTP(ctx context.Context, flow *Flow, code string) error {
+	if !flow.IsValid(s.Now()) {
+		return ErrFlowInvalid
+	}
+	if flow.Nonce != code {
 		// avoid brute forcing otp
 		attemptInt := 0
 		if attempts, ok := flow.Metadata[otpAttemptKey]; ok {
@@ -338,30 +406,22 @@ func (s Service) applyMailOTP(ctx context.Context, request RegistrationFinishReq
 		}
 		if attemptInt < maxOTPAttempt {
 			flow.Metadata[otpAttemptKey] = attemptInt + 1
-			if err = s.flowRepo.Set(ctx, flow); err != nil {
-				return nil, fmt.Errorf("failed to process flow code missmatch")
+			if err := s.flowRepo.Set(ctx, flow); err != nil {
+				return fmt.Errorf("failed to process flow code missmatch")
 			}
 		} else {
-			if err = s.consumeFlow(ctx, flowID); err != nil {
-				return nil, fmt.Errorf("failed to process flow code missmatch")
+			if err := s.consumeFlow(ctx, flow.ID); err != nil {
+				return fmt.Errorf("failed to process flow code missmatch")
 			}
 		}
-		return nil, ErrInvalidMailOTP
+		return ErrInvalidMailOTP
 	}
 
 	// consume this flow
-	if err = s.consumeFlow(ctx, flow.ID); err != nil {
-		return nil, fmt.Errorf("failed to successfully register via otp: %w", err)
+	if err := s.consumeFlow(ctx, flow.ID); err != nil {
+		return fmt.Errorf("failed to successfully register via otp: %w", err)
 	}
-
-	newUser, err := s.getOrCreateUser(ctx, flow.Email, "")
-	if err != nil {
-		return nil, err
-	}
-	return &RegistrationFinishResponse{
-		User: newUser,
-		Flow: flow,
-	}, nil
+	return nil
 }
 
 func (s Service) startPassKeyRegisterMethod(ctx context.Context, flow *Flow) (*RegistrationStartResponse, error) {
@@ -646,8 +706,32 @@ func (s Service) applyOIDC(ctx context.Context, request RegistrationFinishReques
 		return nil, err
 	}
 
+	// link the account to the logged in user who started the flow
+	if linkUserID, ok := flow.Metadata[linkIdentityUserKey].(string); ok && linkUserID != "" {
+		linkedUser, err := s.userService.GetByID(ctx, linkUserID)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = s.identityService.Link(ctx, identity.Identity{
+			UserID:   linkedUser.ID,
+			Provider: flow.Method,
+			Subject:  oauthProfile.Subject,
+			Email:    oauthProfile.Email,
+		}); err != nil {
+			return nil, err
+		}
+		audit.GetAuditor(ctx, schema.PlatformOrgID.String()).LogWithAttrs(audit.UserIdentityLinkedEvent,
+			audit.UserTarget(linkedUser.ID), map[string]string{
+				"provider": flow.Method,
+			})
+		return &RegistrationFinishResponse{
+			User: linkedUser,
+			Flow: flow,
+		}, nil
+	}
+
 	// register a new user
-	newUser, err := s.getOrCreateUser(ctx, oauthProfile.Email, oauthProfile.Name)
+	newUser, err := s.getOrCreateOIDCUser(ctx, flow.Method, oauthProfile)
 	if err != nil {
 		return nil, err
 	}
@@ -685,6 +769,50 @@ func (s Service) consumeFlow(ctx context.Context, id uuid.UUID) error {
 	return s.flowRepo.Delete(ctx, id)
 }
 
+// getOrCreateOIDCUser matches the user by the subject id of the provider account
+// first, providers may recycle emails so an email is only matched if the user
+// hasn't linked another account of the same provider
+func (s Service) getOrCreateOIDCUser(ctx context.Context, provider string, profile *strategy.UserInfo) (user.User, error) {
+	linked, err := s.identityService.GetBySubject(ctx, provider, profile.Subject)
+	if err == nil {
+		linkedUser, err := s.userService.GetByID(ctx, linked.UserID)
+		if err != nil {
+			return user.User{}, err
+		}
+		if linked.Email != strings.ToLower(profile.Email) {
+			// keep the email the account was last seen with
+			linked.Email = profile.Email
+			if _, err = s.identityService.Link(ctx, linked); err != nil {
+				return user.User{}, err
+			}
+		}
+		return linkedUser, nil
+	} else if !errors.Is(err, identity.ErrNotExist) {
+		return user.User{}, err
+	}
+
+	oidcUser, err := s.getOrCreateUser(ctx, profile.Email, profile.Name)
+	if err != nil {
+		return user.User{}, err
+	}
+	hasProvider, err := s.identityService.HasProvider(ctx, oidcUser.ID, provider)
+	if err != nil {
+		return user.User{}, err
+	}
+	if hasProvider {
+		return user.User{}, ErrIdentityMismatch
+	}
+	if _, err = s.identityService.Link(ctx, identity.Identity{
+		UserID:   oidcUser.ID,
+		Provider: provider,
+		Subject:  profile.Subject,
+		Email:    profile.Email,
+	}); err != nil {
+		return user.User{}, err
+	}
+	return oidcUser, nil
+}
+
 func (s Service) getOrCreateUser(ctx context.Context, email, title string) (user.User, error) {
 	// create a new user based on email if it doesn't exist
 	existingUser, err := s.userService.GetByID(ctx, email)
@@ -695,6 +823,15 @@ func (s Service) getOrCreateUser(ctx context.Context, email, title string) (user
 		// for registered users every time the login?
 		return existingUser, nil
 	}
+	if errors.Is(err, user.ErrDeleted) {
+		// the email can't be registered again while the deleted user can be restored
+		return user.User{}, err
+	}
+
+	// secondary emails login to the user they belong to
+	if secondaryEmail, err := s.identityService.GetEmail(ctx, email); err == nil {
+		return s.userService.GetByID(ctx, secondaryEmail.UserID)
+	}
 
 	// register a new user
 	newUser, err := s.userService.Create(ctx, user.User{
@@ -707,6 +844,14 @@ func (s Service) getOrCreateUser(ctx context.Context, email, title string) (user
 	}
 	audit.GetAuditor(ctx, schema.PlatformOrgID.String()).
 		Log(audit.UserCreatedEvent, audit.UserTarget(newUser.ID))
+
+	// add the user to organizations of its email domain, the login doesn't
+	// fail if it can't be added
+	if s.domainService != nil {
+		if err := s.domainService.AutoJoin(ctx, newUser.ID); err != nil {
+			s.log.Warn("failed to auto join organizations of the user domain", "user_id", newUser.ID, "err", err)
+		}
+	}
 	return newUser, nil
 }
 
@@ -745,6 +890,12 @@ func (s Service) GetPrincipal(ctx context.Context, assertions ...ClientAssertion
 
 	// check for token
 	userToken, tokenOK := GetTokenFromContext(ctx)
+	if tokenOK && strings.HasPrefix(userToken, pat.TokenPrefix) {
+		if !slices.Contains[[]ClientAssertion](assertions, PersonalAccessTokenClientAssertion) {
+			return Principal{}, errors.ErrUnauthenticated
+		}
+		return s.getPersonalTokenPrincipal(ctx, userToken)
+	}
 	if tokenOK {
 		insecureJWT, err := jwt.ParseInsecure([]byte(userToken))
 		if err != nil {
@@ -779,13 +930,9 @@ func (s Service) GetPrincipal(ctx context.Context, assertions ...ClientAssertion
 
 		// extract user from token if it's a service user
 		if slices.Contains[[]ClientAssertion](assertions, JWTGrantClientAssertion) {
-			serviceUser, err := s.serviceUserService.GetByToken(ctx, userToken)
+			serviceUser, cred, err := s.serviceUserService.GetByToken(ctx, userToken)
 			if err == nil {
-				return Principal{
-					ID:          serviceUser.ID,
-					Type:        schema.ServiceUserPrincipal,
-					ServiceUser: &serviceUser,
-				}, nil
+				return serviceUserPrincipal(serviceUser, cred.Scope), nil
 			}
 			if err != nil {
 				s.log.Debug("failed to parse as user token ", "err", err)
@@ -810,13 +957,9 @@ func (s Service) GetPrincipal(ctx context.Context, assertions ...ClientAssertion
 			clientID, clientSecret := userSecretParts[0], userSecretParts[1]
 
 			// extract user from secret if it's a service user
-			serviceUser, err := s.serviceUserService.GetBySecret(ctx, clientID, clientSecret)
+			serviceUser, cred, err := s.serviceUserService.GetBySecret(ctx, clientID, clientSecret)
 			if err == nil {
-				return Principal{
-					ID:          serviceUser.ID,
-					Type:        schema.ServiceUserPrincipal,
-					ServiceUser: &serviceUser,
-				}, nil
+				return serviceUserPrincipal(serviceUser, cred.Scope), nil
 			}
 			if err != nil {
 				s.log.Debug("failed to parse as user token ", "err", err)
@@ -843,6 +986,46 @@ func (s Service) GetPrincipal(ctx context.Context, assertions ...ClientAssertion
 	return Principal{}, errors.ErrUnauthenticated
 }
 
+// getPersonalTokenPrincipal returns the owner of a personal access token narrowed to its scope
+func (s Service) getPersonalTokenPrincipal(ctx context.Context, value string) (Principal, error) {
+	token, err := s.patService.GetByValue(ctx, value)
+	if err != nil {
+		s.log.Debug("failed to verify personal access token", "err", err)
+		return Principal{}, errors.ErrUnauthenticated
+	}
+	currentUser, err := s.userService.GetByID(ctx, token.UserID)
+	if err != nil {
+		return Principal{}, err
+	}
+	if currentUser.State == user.Disabled {
+		return Principal{}, errors.ErrUnauthenticated
+	}
+	principal := Principal{
+		ID:   currentUser.ID,
+		Type: schema.UserPrincipal,
+		User: &currentUser,
+	}
+	if !token.Scope.IsEmpty() {
+		scope := token.Scope
+		principal.Scope = &scope
+	}
+	return principal, nil
+}
+
+// serviceUserPrincipal builds the principal of a service user authenticated
+// with a credential or trust, scoping it if the credential or trust is scoped
+func serviceUserPrincipal(serviceUser serviceuser.ServiceUser, scope serviceuser.Scope) Principal {
+	principal := Principal{
+		ID:          serviceUser.ID,
+		Type:        schema.ServiceUserPrincipal,
+		ServiceUser: &serviceUser,
+	}
+	if !scope.IsEmpty() {
+		principal.Scope = &scope
+	}
+	return principal
+}
+
 func (s Service) Close() {
 	s.cron.Stop()
 }
@@ -22,6 +22,7 @@ var (
 type Repository interface {
 	Set(ctx context.Context, session *Session) error
 	Get(ctx context.Context, id uuid.UUID) (*Session, error)
+	List(ctx context.Context, userID string) ([]*Session, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	DeleteExpiredSessions(ctx context.Context) error
 	UpdateValidity(ctx context.Context, id uuid.UUID, validity time.Duration) error
@@ -67,6 +68,11 @@ func (s Service) Delete(ctx context.Context, sessionID uuid.UUID) error {
 	return s.repo.Delete(ctx, sessionID)
 }
 
+// ListByUser lists sessions of the user including expired ones not yet cleaned up
+func (s Service) ListByUser(ctx context.Context, userID string) ([]*Session, error) {
+	return s.repo.List(ctx, userID)
+}
+
 func (s Service) ExtractFromContext(ctx context.Context) (*Session, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
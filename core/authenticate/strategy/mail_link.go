@@ -1,14 +1,11 @@
 package strategy
 
 import (
-	"bytes"
+	"context"
 	"fmt"
-	"html/template"
 	"strings"
-	"time"
 
-	"github.com/raystack/frontier/pkg/mailer"
-	"gopkg.in/mail.v2"
+	"github.com/raystack/frontier/core/email"
 )
 
 const (
@@ -18,48 +15,41 @@ const (
 // MailLink sends a mail with a one time password link to user's email id.
 // On successful verification, it creates a session
 type MailLink struct {
-	dialer  mailer.Dialer
+	sender  MailSender
 	subject string
 	body    string
-	Now     func() time.Time
 	host    string
 }
 
-func NewMailLink(d mailer.Dialer, host, subject, body string) *MailLink {
+// NewMailLink creates the strategy, subject and body are the template used
+// if no mail_link template is set
+func NewMailLink(sender MailSender, host, subject, body string) *MailLink {
 	return &MailLink{
 		host:    host,
-		dialer:  d,
+		sender:  sender,
 		subject: subject,
 		body:    body,
-		Now: func() time.Time {
-			return time.Now().UTC()
-		},
 	}
 }
 
-// SendMail sends a mail with a one time password embedded link to user's email id
-func (m MailLink) SendMail(id, to string) (string, error) {
+// SendMail queues a mail with a one time password embedded link to user's email id
+func (m MailLink) SendMail(ctx context.Context, id, to string) (string, error) {
 	otp := GenerateNonceFromLetters(otpLen, otpLetterRunes)
-	t, err := template.New("body").Parse(m.body)
-	if err != nil {
-		return "", fmt.Errorf("failed to parse email template: %w", err)
-	}
-	var tpl bytes.Buffer
-
 	link := fmt.Sprintf("%s?strategy_name=%s&code=%s&state=%s", strings.TrimRight(m.host, "/"), MailLinkAuthMethod, otp, id)
-	err = t.Execute(&tpl, map[string]string{
-		"Link": link,
+	_, err := m.sender.Send(ctx, email.Request{
+		Template: email.TemplateMailLink,
+		To:       []string{to},
+		Data: map[string]string{
+			"Link": link,
+		},
+		Default: email.Template{
+			Name:     email.TemplateMailLink,
+			Subject:  m.subject,
+			HTMLBody: m.body,
+		},
 	})
 	if err != nil {
-		return "", fmt.Errorf("failed to parse email template: %w", err)
+		return "", err
 	}
-
-	//TODO(kushsharma): apply rest of the headers
-	msg := mail.NewMessage()
-	msg.SetHeader("From", m.dialer.FromHeader())
-	msg.SetHeader("To", to)
-	msg.SetHeader("Subject", m.subject)
-	msg.SetBody("text/html", tpl.String())
-	msg.SetDateHeader("Date", m.Now())
-	return otp, m.dialer.DialAndSend(msg)
+	return otp, nil
 }
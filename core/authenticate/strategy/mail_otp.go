@@ -1,14 +1,10 @@
 package strategy
 
 import (
-	"bytes"
-	"fmt"
-	"html/template"
+	"context"
 	"math/rand"
-	"time"
 
-	"github.com/raystack/frontier/pkg/mailer"
-	"gopkg.in/mail.v2"
+	"github.com/raystack/frontier/core/email"
 )
 
 const (
@@ -20,49 +16,48 @@ var (
 	otpLen         = 6
 )
 
+// MailSender queues mails rendered with the templates of the mail service
+type MailSender interface {
+	Send(ctx context.Context, req email.Request) (email.Mail, error)
+}
+
 // MailOTP sends a mail with a one time password to user's email id
 // and verifies the OTP. On successful verification, it creates a session
 type MailOTP struct {
-	dialer  mailer.Dialer
+	sender  MailSender
 	subject string
 	body    string
-	Now     func() time.Time
 }
 
-func NewMailOTP(d mailer.Dialer, subject, body string) *MailOTP {
+// NewMailOTP creates the strategy, subject and body are the template used
+// if no mail_otp template is set
+func NewMailOTP(sender MailSender, subject, body string) *MailOTP {
 	return &MailOTP{
-		dialer:  d,
+		sender:  sender,
 		subject: subject,
 		body:    body,
-		Now: func() time.Time {
-			return time.Now().UTC()
-		},
 	}
 }
 
-// SendMail sends a mail with a one time password embedded link to user's email id
-func (m MailOTP) SendMail(to string) (string, error) {
+// SendMail queues a mail with a one time password to user's email id
+func (m MailOTP) SendMail(ctx context.Context, to string) (string, error) {
 	otp := GenerateNonceFromLetters(otpLen, otpLetterRunes)
-	t, err := template.New("body").Parse(m.body)
-	if err != nil {
-		return "", fmt.Errorf("failed to parse email template: %w", err)
-	}
-	var tpl bytes.Buffer
-	err = t.Execute(&tpl, map[string]string{
-		"Otp": otp,
+	_, err := m.sender.Send(ctx, email.Request{
+		Template: email.TemplateMailOTP,
+		To:       []string{to},
+		Data: map[string]string{
+			"Otp": otp,
+		},
+		Default: email.Template{
+			Name:     email.TemplateMailOTP,
+			Subject:  m.subject,
+			HTMLBody: m.body,
+		},
 	})
 	if err != nil {
-		return "", fmt.Errorf("failed to parse email template: %w", err)
+		return "", err
 	}
-
-	//TODO(kushsharma): apply rest of the headers
-	msg := mail.NewMessage()
-	msg.SetHeader("From", m.dialer.FromHeader())
-	msg.SetHeader("To", to)
-	msg.SetHeader("Subject", m.subject)
-	msg.SetBody("text/html", tpl.String())
-	msg.SetDateHeader("Date", m.Now())
-	return otp, m.dialer.DialAndSend(msg)
+	return otp, nil
 }
 
 func GenerateNonceFromLetters(length int, letterRunes []rune) string {
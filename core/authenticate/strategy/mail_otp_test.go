@@ -1,54 +1,32 @@
 package strategy
 
 import (
-	"bytes"
+	"context"
 	"math/rand"
-	"strings"
 	"testing"
-	"time"
 
-	"github.com/raystack/frontier/pkg/mailer"
-	"github.com/raystack/frontier/pkg/mailer/mocks"
-	"github.com/stretchr/testify/mock"
-	"gopkg.in/mail.v2"
+	"github.com/raystack/frontier/core/email"
+	"github.com/stretchr/testify/assert"
 )
 
-var mockDate = time.Date(2023, 10, 6, 0, 0, 0, 0, time.UTC)
-
-func mock1(t *testing.T) *mocks.Dialer {
-	t.Helper()
-
-	wantMsg := "MIME-Version: 1.0\r\n" +
-		"From: frontier@acme.org\r\n" +
-		"To: test@acme.org\r\n" +
-		"Subject: auth otp\r\n" +
-		"Date: " + mockDate.Format(time.RFC1123Z) + "\r\n" +
-		"Content-Type: text/html; charset=UTF-8\r\n" +
-		"Content-Transfer-Encoding: quoted-printable\r\n" +
-		"\r\n" +
-		`here is the otp, use it: 7GAPMQ`
+type memSender struct {
+	requests []email.Request
+	err      error
+}
 
-	mockDialer1 := &mocks.Dialer{}
-	mockDialer1.EXPECT().FromHeader().Return("frontier@acme.org")
-	mockDialer1.On("DialAndSend", mock.MatchedBy(func(m *mail.Message) bool {
-		buf := new(bytes.Buffer)
-		_, err := m.WriteTo(buf)
-		if err != nil {
-			return false
-		}
-		return compareBodies(t, buf.String(), wantMsg)
-	})).Return(nil)
-	return mockDialer1
+func (s *memSender) Send(ctx context.Context, req email.Request) (email.Mail, error) {
+	if s.err != nil {
+		return email.Mail{}, s.err
+	}
+	s.requests = append(s.requests, req)
+	return email.Mail{To: req.To}, nil
 }
 
 func TestMailOTP_SendMail(t *testing.T) {
-	mockDialer1 := mock1(t)
-	defer mockDialer1.AssertExpectations(t)
-
 	type fields struct {
-		dialer  mailer.Dialer
 		subject string
 		body    string
+		err     error
 	}
 	type args struct {
 		to string
@@ -61,9 +39,8 @@ func TestMailOTP_SendMail(t *testing.T) {
 		wantErr bool
 	}{
 		{
-			name: "should send mail",
+			name: "should queue mail",
 			fields: fields{
-				dialer:  mockDialer1,
 				subject: "auth otp",
 				body:    "here is the otp, use it: {{.Otp}}",
 			},
@@ -73,19 +50,25 @@ func TestMailOTP_SendMail(t *testing.T) {
 			want:    "7GAPMQ",
 			wantErr: false,
 		},
+		{
+			name: "should return error if mail can't be queued",
+			fields: fields{
+				subject: "auth otp",
+				body:    "here is the otp, use it: {{.Otp}}",
+				err:     email.ErrInvalidTemplate,
+			},
+			args: args{
+				to: "test@acme.org",
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			rand.Seed(1)
-			m := MailOTP{
-				dialer:  tt.fields.dialer,
-				subject: tt.fields.subject,
-				body:    tt.fields.body,
-				Now: func() time.Time {
-					return mockDate
-				},
-			}
-			got, err := m.SendMail(tt.args.to)
+			sender := &memSender{err: tt.fields.err}
+			m := NewMailOTP(sender, tt.fields.subject, tt.fields.body)
+			got, err := m.SendMail(context.Background(), tt.args.to)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("SendMail() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -93,61 +76,16 @@ func TestMailOTP_SendMail(t *testing.T) {
 			if got != tt.want {
 				t.Errorf("SendMail() got = %v, want %v", got, tt.want)
 			}
-		})
-	}
-}
-
-func compareBodies(t *testing.T, got, want string) bool {
-	t.Helper()
-	// We cannot do a simple comparison since the ordering of headers' fields
-	// is random.
-	gotLines := strings.Split(got, "\r\n")
-	wantLines := strings.Split(want, "\r\n")
-
-	// We only test for too many lines, missing lines are tested after
-	if len(gotLines) > len(wantLines) {
-		t.Fatalf("Message has too many lines, \ngot %d:\n%s\nwant %d:\n%s", len(gotLines), got, len(wantLines), want)
-		return false
-	}
-
-	isInHeader := true
-	headerStart := 0
-	for i, line := range wantLines {
-		if line == gotLines[i] {
-			if line == "" {
-				isInHeader = false
-			} else if !isInHeader && len(line) > 2 && line[:2] == "--" {
-				isInHeader = true
-				headerStart = i + 1
-			}
-			continue
-		}
-
-		if !isInHeader {
-			missingLine(t, line, got, want)
-			return false
-		}
-
-		isMissing := true
-		for j := headerStart; j < len(gotLines); j++ {
-			if gotLines[j] == "" {
-				break
-			}
-			if gotLines[j] == line {
-				isMissing = false
-				break
+			if tt.wantErr {
+				return
 			}
-		}
-		if isMissing {
-			missingLine(t, line, got, want)
-			return false
-		}
+			assert.Len(t, sender.requests, 1)
+			req := sender.requests[0]
+			assert.Equal(t, email.TemplateMailOTP, req.Template)
+			assert.Equal(t, []string{tt.args.to}, req.To)
+			assert.Equal(t, map[string]string{"Otp": tt.want}, req.Data)
+			assert.Equal(t, tt.fields.subject, req.Default.Subject)
+			assert.Equal(t, tt.fields.body, req.Default.HTMLBody)
+		})
 	}
-
-	return true
-}
-
-func missingLine(t *testing.T, line, got, want string) {
-	t.Helper()
-	t.Fatalf("Missing line %q\ngot:\n%s\nwant:\n%s", line, got, want)
 }
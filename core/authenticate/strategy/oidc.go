@@ -21,8 +21,10 @@ type OIDC struct {
 }
 
 type UserInfo struct {
-	Name  string
-	Email string
+	// Subject is the stable id of the account at the provider
+	Subject string
+	Name    string
+	Email   string
 }
 
 func NewRelyingPartyOIDC(clientId string, clientSecret string, redirectUrl string) *OIDC {
@@ -97,8 +99,9 @@ func (g *OIDC) GetUser(ctx context.Context, token *oauth2.Token) (*UserInfo, err
 		return nil, errors.New("invalid email")
 	}
 	user := &UserInfo{
-		Name:  baseUser.Profile,
-		Email: baseUser.Email,
+		Subject: baseUser.Subject,
+		Name:    baseUser.Profile,
+		Email:   baseUser.Email,
 	}
 
 	// try few fields which are possibly contain name of the user
@@ -0,0 +1,20 @@
+package deleter
+
+import "time"
+
+type Config struct {
+	// GracePeriod is how long a soft deleted organization, project or user can be
+	// restored before it's purged along with everything nested under it
+	GracePeriod time.Duration `yaml:"grace_period" mapstructure:"grace_period" default:"720h"`
+
+	// RequireConfirmation rejects deletes of organizations, projects and users which
+	// don't carry the confirmation token returned by a dry run of the same delete
+	RequireConfirmation bool `yaml:"require_confirmation" mapstructure:"require_confirmation"`
+
+	// ConfirmationTTL is how long the confirmation token of a dry run can be used for
+	ConfirmationTTL time.Duration `yaml:"confirmation_ttl" mapstructure:"confirmation_ttl" default:"15m"`
+
+	// ConfirmationKey signs confirmation tokens, it has to be the same across instances
+	// and the session hash secret key is used if it's not set
+	ConfirmationKey string `yaml:"confirmation_key" mapstructure:"confirmation_key"`
+}
@@ -0,0 +1,8 @@
+package deleter
+
+import "errors"
+
+var (
+	ErrInvalidConfirmation = errors.New("confirmation token doesn't match the impact of the delete, run the dry run again")
+	ErrConfirmationExpired = errors.New("confirmation token has expired, run the dry run again")
+)
@@ -0,0 +1,74 @@
+package deleter
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/raystack/frontier/core/relation"
+)
+
+// Report lists ids of everything a cascade delete would remove
+type Report struct {
+	Organizations []string `json:"organizations,omitempty"`
+	Projects      []string `json:"projects,omitempty"`
+	Resources     []string `json:"resources,omitempty"`
+	Folders       []string `json:"folders,omitempty"`
+	Groups        []string `json:"groups,omitempty"`
+	Roles         []string `json:"roles,omitempty"`
+	Policies      []string `json:"policies,omitempty"`
+	Invitations   []string `json:"invitations,omitempty"`
+	Users         []string `json:"users,omitempty"`
+	Relations     []string `json:"relations,omitempty"`
+
+	// objects are looked up for relations once the cascade is walked
+	objects []relation.Object
+}
+
+// add records the id of an object removed by the cascade
+func (r *Report) add(ids *[]string, namespace, id string) {
+	*ids = append(*ids, id)
+	r.objects = append(r.objects, relation.Object{ID: id, Namespace: namespace})
+}
+
+// sort orders ids of each kind so reports of the same cascade are equal
+func (r *Report) sort() {
+	for _, ids := range [][]string{r.Organizations, r.Projects, r.Resources, r.Folders,
+		r.Groups, r.Roles, r.Policies, r.Invitations, r.Users, r.Relations} {
+		sort.Strings(ids)
+	}
+}
+
+// sign returns a confirmation token of the report valid until expiry, it's a mac
+// of the expiry and the report so it can't be forged or extended by the caller
+func (r Report) sign(key []byte, expiry time.Time) string {
+	raw, _ := json.Marshal(r)
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(strconv.FormatInt(expiry.Unix(), 10)))
+	mac.Write(raw)
+	return strconv.FormatInt(expiry.Unix(), 10) + "." + hex.EncodeToString(mac.Sum(nil))
+}
+
+// verify checks the confirmation token was signed for the report and hasn't expired
+func (r Report) verify(key []byte, token string) error {
+	expiryUnix, _, ok := strings.Cut(token, ".")
+	if !ok {
+		return ErrInvalidConfirmation
+	}
+	expiry, err := strconv.ParseInt(expiryUnix, 10, 64)
+	if err != nil {
+		return ErrInvalidConfirmation
+	}
+	if time.Now().After(time.Unix(expiry, 0)) {
+		return ErrConfirmationExpired
+	}
+	if !hmac.Equal([]byte(token), []byte(r.sign(key, time.Unix(expiry, 0)))) {
+		return ErrInvalidConfirmation
+	}
+	return nil
+}
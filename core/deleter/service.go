@@ -4,8 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"time"
 
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/user"
 	"github.com/raystack/frontier/pkg/utils"
+	"github.com/raystack/salt/log"
+	"github.com/robfig/cron/v3"
 
 	"github.com/raystack/frontier/internal/bootstrap/schema"
 
@@ -15,6 +21,7 @@ import (
 	"github.com/raystack/frontier/core/policy"
 	"github.com/raystack/frontier/core/role"
 
+	"github.com/raystack/frontier/core/folder"
 	"github.com/raystack/frontier/core/group"
 
 	"github.com/raystack/frontier/core/organization"
@@ -22,16 +29,28 @@ import (
 	"github.com/raystack/frontier/core/resource"
 )
 
+const (
+	purgeSchedule = "0 * * * *" // every hour
+	purgePageSize = 100
+)
+
 type ProjectService interface {
+	Get(ctx context.Context, idOrName string) (project.Project, error)
 	List(ctx context.Context, flt project.Filter) ([]project.Project, error)
 	DeleteModel(ctx context.Context, id string) error
+	SoftDelete(ctx context.Context, id string) error
+	Restore(ctx context.Context, id string) error
 }
 
 type OrganizationService interface {
 	Get(ctx context.Context, id string) (organization.Organization, error)
+	List(ctx context.Context, flt organization.Filter) ([]organization.Organization, error)
 	DeleteModel(ctx context.Context, id string) error
 	RemoveUsers(ctx context.Context, orgID string, userIDs []string) error
 	ListByUser(ctx context.Context, userID string) ([]organization.Organization, error)
+	SoftDelete(ctx context.Context, id string) error
+	Restore(ctx context.Context, id string) error
+	CheckOwnersLeft(ctx context.Context, orgID string, userIDs []string) error
 }
 
 type RoleService interface {
@@ -54,6 +73,8 @@ type GroupService interface {
 	List(ctx context.Context, flt group.Filter) ([]group.Group, error)
 	Delete(ctx context.Context, id string) error
 	RemoveUsers(ctx context.Context, groupID string, userIDs []string) error
+	ListByUser(ctx context.Context, userID string, flt group.Filter) ([]group.Group, error)
+	CheckOwnersLeft(ctx context.Context, groupID string, userIDs []string) error
 }
 
 type InvitationService interface {
@@ -61,8 +82,27 @@ type InvitationService interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 }
 
+type FolderService interface {
+	List(ctx context.Context, flt folder.Filter) ([]folder.Folder, error)
+	DeleteModel(ctx context.Context, id string) error
+}
+
 type UserService interface {
+	GetByID(ctx context.Context, id string) (user.User, error)
+	List(ctx context.Context, flt user.Filter) ([]user.User, error)
 	Delete(ctx context.Context, id string) error
+	SoftDelete(ctx context.Context, id string) error
+	Restore(ctx context.Context, id string) error
+}
+
+type RelationService interface {
+	GetRelationsByFields(ctx context.Context, rel relation.Relation) ([]relation.Relation, error)
+	Suspend(ctx context.Context, rel relation.Relation) error
+	Resume(ctx context.Context, rel relation.Relation) error
+}
+
+type AuditService interface {
+	AnonymizeActor(ctx context.Context, actorID string) error
 }
 
 type Service struct {
@@ -74,12 +114,22 @@ type Service struct {
 	roleService       RoleService
 	invitationService InvitationService
 	userService       UserService
+	folderService     FolderService
+	relationService   RelationService
+	auditService      AuditService
+	gracePeriod       time.Duration
+	requireConfirm    bool
+	confirmTTL        time.Duration
+	confirmKey        []byte
+	cron              *cron.Cron
+	log               log.Logger
 }
 
-func NewCascadeDeleter(orgService OrganizationService, projService ProjectService,
+func NewCascadeDeleter(logger log.Logger, cfg Config, orgService OrganizationService, projService ProjectService,
 	resService ResourceService, groupService GroupService,
 	policyService PolicyService, roleService RoleService,
-	invitationService InvitationService, userService UserService) *Service {
+	invitationService InvitationService, userService UserService,
+	folderService FolderService, relationService RelationService, auditService AuditService) *Service {
 	return &Service{
 		projService:       projService,
 		orgService:        orgService,
@@ -89,10 +139,25 @@ func NewCascadeDeleter(orgService OrganizationService, projService ProjectServic
 		roleService:       roleService,
 		invitationService: invitationService,
 		userService:       userService,
+		folderService:     folderService,
+		relationService:   relationService,
+		auditService:      auditService,
+		gracePeriod:       cfg.GracePeriod,
+		requireConfirm:    cfg.RequireConfirmation,
+		confirmTTL:        cfg.ConfirmationTTL,
+		confirmKey:        []byte(cfg.ConfirmationKey),
+		cron:              cron.New(),
+		log:               logger,
 	}
 }
 
 func (d Service) DeleteProject(ctx context.Context, id string) error {
+	return d.deleteProject(ctx, id, nil)
+}
+
+// deleteProject runs the cascade delete of a project, deletes are only recorded in
+// the report if one is passed
+func (d Service) deleteProject(ctx context.Context, id string, report *Report) error {
 	// delete all related resources first
 	resources, err := d.resService.List(ctx, resource.Filter{
 		ProjectID: id,
@@ -101,15 +166,29 @@ func (d Service) DeleteProject(ctx context.Context, id string) error {
 		return err
 	}
 	for _, r := range resources {
+		if report != nil {
+			report.add(&report.Resources, r.NamespaceID, r.ID)
+			continue
+		}
 		if err = d.resService.Delete(ctx, r.NamespaceID, r.ID); err != nil {
 			return fmt.Errorf("failed to delete project while deleting a resource[%s]: %w", r.Name, err)
 		}
 	}
 
+	if report != nil {
+		report.add(&report.Projects, schema.ProjectNamespace, id)
+		return nil
+	}
 	return d.projService.DeleteModel(ctx, id)
 }
 
 func (d Service) DeleteOrganization(ctx context.Context, id string) error {
+	return d.deleteOrganization(ctx, id, nil)
+}
+
+// deleteOrganization runs the cascade delete of an organization, deletes are only
+// recorded in the report if one is passed
+func (d Service) deleteOrganization(ctx context.Context, id string, report *Report) error {
 	// delete all policies
 	policies, err := d.policyService.List(ctx, policy.Filter{
 		OrgID: id,
@@ -118,30 +197,51 @@ func (d Service) DeleteOrganization(ctx context.Context, id string) error {
 		return err
 	}
 	for _, p := range policies {
+		if report != nil {
+			report.add(&report.Policies, schema.RoleBindingNamespace, p.ID)
+			continue
+		}
 		if err = d.policyService.Delete(ctx, p.ID); err != nil {
 			return fmt.Errorf("failed to delete org while deleting a policy[%s]: %w", p.ID, err)
 		}
 	}
 
-	// delete all related projects first
-	projects, err := d.projService.List(ctx, project.Filter{
-		OrgID: id,
-	})
+	// delete all related projects first, soft deleted ones included
+	projects, err := d.listProjects(ctx, id, "", project.Disabled, project.Deleted)
 	if err != nil {
 		return err
 	}
 	for _, p := range projects {
-		if err = d.DeleteProject(ctx, p.ID); err != nil {
+		if err = d.deleteProject(ctx, p.ID, report); err != nil {
 			return fmt.Errorf("failed to delete org while deleting a project[%s]: %w", p.Name, err)
 		}
 	}
 
+	// delete all folders once projects are gone, nested ones first
+	folders, err := d.folderService.List(ctx, folder.Filter{OrgID: id})
+	if err != nil {
+		return err
+	}
+	for _, f := range sortFoldersByDepth(folders) {
+		if report != nil {
+			report.add(&report.Folders, schema.FolderNamespace, f.ID)
+			continue
+		}
+		if err = d.folderService.DeleteModel(ctx, f.ID); err != nil {
+			return fmt.Errorf("failed to delete org while deleting a folder[%s]: %w", f.Name, err)
+		}
+	}
+
 	// delete all related groups
 	groups, err := d.groupService.List(ctx, group.Filter{OrganizationID: id})
 	if err != nil {
 		return err
 	}
 	for _, g := range groups {
+		if report != nil {
+			report.add(&report.Groups, schema.GroupNamespace, g.ID)
+			continue
+		}
 		if err = d.groupService.Delete(ctx, g.ID); err != nil {
 			return fmt.Errorf("failed to delete org while deleting a group[%s]: %w", g.Name, err)
 		}
@@ -155,6 +255,10 @@ func (d Service) DeleteOrganization(ctx context.Context, id string) error {
 		return err
 	}
 	for _, p := range roles {
+		if report != nil {
+			report.add(&report.Roles, schema.RoleNamespace, p.ID)
+			continue
+		}
 		if err = d.roleService.Delete(ctx, p.ID); err != nil {
 			return fmt.Errorf("failed to delete org while deleting a role[%s]: %w", p.Name, err)
 		}
@@ -166,16 +270,49 @@ func (d Service) DeleteOrganization(ctx context.Context, id string) error {
 		return err
 	}
 	for _, i := range invitations {
+		if report != nil {
+			report.add(&report.Invitations, schema.InvitationNamespace, i.ID.String())
+			continue
+		}
 		if err = d.invitationService.Delete(ctx, i.ID); err != nil {
 			return fmt.Errorf("failed to delete org while deleting a invitation[%s]: %w", i.ID, err)
 		}
 	}
 
+	if report != nil {
+		report.add(&report.Organizations, schema.OrganizationNamespace, id)
+		return nil
+	}
 	return d.orgService.DeleteModel(ctx, id)
 }
 
+// sortFoldersByDepth orders folders so that nested folders come before their parents
+func sortFoldersByDepth(folders []folder.Folder) []folder.Folder {
+	parents := make(map[string]string, len(folders))
+	for _, f := range folders {
+		parents[f.ID] = f.ParentID
+	}
+	depth := func(id string) int {
+		d := 0
+		for parentID := parents[id]; parentID != "" && d < len(folders); parentID = parents[parentID] {
+			d++
+		}
+		return d
+	}
+
+	sorted := append([]folder.Folder{}, folders...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return depth(sorted[i].ID) > depth(sorted[j].ID)
+	})
+	return sorted
+}
+
 // RemoveUsersFromOrg removes users from an organization as members
 func (d Service) RemoveUsersFromOrg(ctx context.Context, orgID string, userIDs []string) error {
+	return d.removeUsersFromOrg(ctx, orgID, userIDs, nil)
+}
+
+func (d Service) removeUsersFromOrg(ctx context.Context, orgID string, userIDs []string, report *Report) error {
 	var err error
 
 	orgProjects, err := d.projService.List(ctx, project.Filter{
@@ -198,6 +335,16 @@ func (d Service) RemoveUsersFromOrg(ctx context.Context, orgID string, userIDs [
 		return g.ID
 	})
 
+	// users can't be removed if they are the last owners of the org or its groups
+	if err = d.orgService.CheckOwnersLeft(ctx, orgID, userIDs); err != nil {
+		return err
+	}
+	for _, g := range orgGroups {
+		if err = d.groupService.CheckOwnersLeft(ctx, g.ID, userIDs); err != nil {
+			return fmt.Errorf("%w: %s", err, g.Name)
+		}
+	}
+
 	for _, userID := range userIDs {
 		userPolicies, policyErr := d.policyService.List(ctx, policy.Filter{
 			PrincipalID:   userID,
@@ -214,6 +361,10 @@ func (d Service) RemoveUsersFromOrg(ctx context.Context, orgID string, userIDs [
 			case schema.ProjectNamespace:
 				// delete project level policies
 				if utils.Contains(orgProjectIDs, pol.ResourceID) {
+					if report != nil {
+						report.add(&report.Policies, schema.RoleBindingNamespace, pol.ID)
+						continue
+					}
 					if policyErr := d.policyService.Delete(ctx, pol.ID); policyErr != nil {
 						err = errors.Join(err, policyErr)
 					}
@@ -221,11 +372,20 @@ func (d Service) RemoveUsersFromOrg(ctx context.Context, orgID string, userIDs [
 			case schema.GroupNamespace:
 				// delete group level policies
 				if utils.Contains(orgGroupIDs, pol.ResourceID) {
+					if report != nil {
+						report.add(&report.Policies, schema.RoleBindingNamespace, pol.ID)
+						continue
+					}
 					if groupErr := d.groupService.RemoveUsers(ctx, pol.ResourceID, []string{userID}); groupErr != nil {
 						err = errors.Join(err, groupErr)
 					}
 				}
-			case schema.PlatformNamespace, schema.OrganizationNamespace:
+			case schema.OrganizationNamespace:
+				// org level policies are deleted along with the membership
+				if report != nil && pol.ResourceID == orgID {
+					report.add(&report.Policies, schema.RoleBindingNamespace, pol.ID)
+				}
+			case schema.PlatformNamespace:
 				// do nothing
 			default:
 				// delete resource level policies
@@ -235,6 +395,10 @@ func (d Service) RemoveUsersFromOrg(ctx context.Context, orgID string, userIDs [
 						err = errors.Join(err, resErr)
 					} else if userResource.ProjectID != "" && utils.Contains(orgProjectIDs, userResource.ProjectID) {
 						// if the resource belong to org project, delete access
+						if report != nil {
+							report.add(&report.Policies, schema.RoleBindingNamespace, pol.ID)
+							continue
+						}
 						if policyErr := d.policyService.Delete(ctx, pol.ID); policyErr != nil {
 							err = errors.Join(err, policyErr)
 						}
@@ -247,20 +411,393 @@ func (d Service) RemoveUsersFromOrg(ctx context.Context, orgID string, userIDs [
 		// abort if any error occurred
 		return err
 	}
+	if report != nil {
+		return nil
+	}
 
 	// remove user from org
 	return d.orgService.RemoveUsers(ctx, orgID, userIDs)
 }
 
 func (d Service) DeleteUser(ctx context.Context, userID string) error {
+	return d.deleteUser(ctx, userID, nil)
+}
+
+func (d Service) deleteUser(ctx context.Context, userID string, report *Report) error {
 	userOrgs, err := d.orgService.ListByUser(ctx, userID)
 	if err != nil {
 		return err
 	}
 	for _, org := range userOrgs {
-		if err = d.RemoveUsersFromOrg(ctx, org.ID, []string{userID}); err != nil {
+		if err = d.removeUsersFromOrg(ctx, org.ID, []string{userID}, report); err != nil {
 			return fmt.Errorf("failed to delete user from org[%s]: %w", org.Name, err)
 		}
 	}
-	return d.userService.Delete(ctx, userID)
+	if report != nil {
+		report.add(&report.Users, schema.UserPrincipal, userID)
+		return nil
+	}
+	if err = d.userService.Delete(ctx, userID); err != nil {
+		return err
+	}
+	// audit logs are kept as history but no longer point to the user
+	return d.auditService.AnonymizeActor(ctx, userID)
+}
+
+// OrganizationImpact walks the cascade delete of an organization without removing
+// anything and reports what would be removed
+func (d Service) OrganizationImpact(ctx context.Context, id string) (Report, error) {
+	org, err := d.orgService.Get(ctx, id)
+	if err != nil {
+		return Report{}, err
+	}
+	report := &Report{}
+	if err := d.deleteOrganization(ctx, org.ID, report); err != nil {
+		return Report{}, err
+	}
+	return d.withRelations(ctx, report)
+}
+
+// ProjectImpact walks the cascade delete of a project without removing anything
+// and reports what would be removed
+func (d Service) ProjectImpact(ctx context.Context, id string) (Report, error) {
+	proj, err := d.projService.Get(ctx, id)
+	if err != nil {
+		return Report{}, err
+	}
+	report := &Report{}
+	if err := d.deleteProject(ctx, proj.ID, report); err != nil {
+		return Report{}, err
+	}
+	return d.withRelations(ctx, report)
+}
+
+// UserImpact walks the cascade delete of a user without removing anything and
+// reports what would be removed
+func (d Service) UserImpact(ctx context.Context, id string) (Report, error) {
+	usr, err := d.userService.GetByID(ctx, id)
+	if err != nil {
+		return Report{}, err
+	}
+	report := &Report{}
+	if err := d.deleteUser(ctx, usr.ID, report); err != nil {
+		return Report{}, err
+	}
+	return d.withRelations(ctx, report)
+}
+
+// ConfirmationRequired tells if deletes can only go ahead with the token of a dry run
+func (d Service) ConfirmationRequired() bool {
+	return d.requireConfirm
+}
+
+// ConfirmationToken signs the report of a dry run, the delete can be confirmed with
+// the token until the confirmation ttl is over
+func (d Service) ConfirmationToken(report Report) string {
+	return report.sign(d.confirmKey, time.Now().Add(d.confirmTTL))
+}
+
+// CheckConfirmation makes sure the token was returned by a dry run of a delete with
+// the same impact as the report and hasn't expired yet
+func (d Service) CheckConfirmation(token string, report Report) error {
+	return report.verify(d.confirmKey, token)
+}
+
+// withRelations adds relations of every object in the report where it's either
+// the object or the subject of the relation
+func (d Service) withRelations(ctx context.Context, report *Report) (Report, error) {
+	seen := map[string]bool{}
+	for _, obj := range report.objects {
+		for _, flt := range []relation.Relation{
+			{Object: obj},
+			{Subject: relation.Subject{ID: obj.ID, Namespace: obj.Namespace}},
+		} {
+			rels, err := d.relationService.GetRelationsByFields(ctx, flt)
+			if err != nil && !errors.Is(err, relation.ErrNotExist) {
+				return Report{}, err
+			}
+			for _, rel := range rels {
+				if !seen[rel.ID] {
+					seen[rel.ID] = true
+					report.Relations = append(report.Relations, rel.ID)
+				}
+			}
+		}
+	}
+	report.sort()
+	return *report, nil
+}
+
+// SoftDeleteProject suspends relations of the project and its resources and marks
+// it as deleted, the project is purged once the grace period is over
+func (d Service) SoftDeleteProject(ctx context.Context, id string) error {
+	if err := d.toggleProjectRelations(ctx, id, d.relationService.Suspend); err != nil {
+		return err
+	}
+	return d.projService.SoftDelete(ctx, id)
+}
+
+// RestoreProject brings back a soft deleted project along with its relations,
+// project.ErrNotExist is returned if the project isn't soft deleted
+func (d Service) RestoreProject(ctx context.Context, id string) error {
+	if err := d.projService.Restore(ctx, id); err != nil {
+		return err
+	}
+	if err := d.toggleProjectRelations(ctx, id, d.relationService.Resume); err != nil {
+		// keep it deleted so the restore can be retried
+		return errors.Join(err, d.projService.SoftDelete(ctx, id))
+	}
+	return nil
+}
+
+// SoftDeleteOrganization suspends relations of the organization, its groups, projects
+// and resources and marks it as deleted, nothing is removed until the organization
+// is purged once the grace period is over
+func (d Service) SoftDeleteOrganization(ctx context.Context, id string) error {
+	if err := d.toggleOrganizationRelations(ctx, id, d.relationService.Suspend); err != nil {
+		return err
+	}
+	return d.orgService.SoftDelete(ctx, id)
+}
+
+// RestoreOrganization brings back a soft deleted organization along with its relations,
+// projects which were soft deleted on their own stay deleted. organization.ErrNotExist
+// is returned if the organization isn't soft deleted
+func (d Service) RestoreOrganization(ctx context.Context, id string) error {
+	if err := d.orgService.Restore(ctx, id); err != nil {
+		return err
+	}
+	if err := d.toggleOrganizationRelations(ctx, id, d.relationService.Resume); err != nil {
+		// keep it deleted so the restore can be retried
+		return errors.Join(err, d.orgService.SoftDelete(ctx, id))
+	}
+	return nil
+}
+
+// SoftDeleteUser suspends all relations of the user and marks it as deleted, the user
+// is purged once the grace period is over
+func (d Service) SoftDeleteUser(ctx context.Context, userID string) error {
+	if err := d.checkOwnersLeft(ctx, userID); err != nil {
+		return err
+	}
+	if err := d.relationService.Suspend(ctx, userRelations(userID)); err != nil {
+		return err
+	}
+	return d.userService.SoftDelete(ctx, userID)
+}
+
+// RestoreUser brings back a soft deleted user along with its relations,
+// user.ErrNotExist is returned if the user isn't soft deleted
+func (d Service) RestoreUser(ctx context.Context, userID string) error {
+	if err := d.userService.Restore(ctx, userID); err != nil {
+		return err
+	}
+	if err := d.relationService.Resume(ctx, userRelations(userID)); err != nil {
+		// keep it deleted so the restore can be retried
+		return errors.Join(err, d.userService.SoftDelete(ctx, userID))
+	}
+	return nil
+}
+
+// InitPurge starts a cron job that runs every hour to purge soft deleted organizations,
+// projects and users whose grace period is over
+func (d Service) InitPurge(ctx context.Context) error {
+	_, err := d.cron.AddFunc(purgeSchedule, func() {
+		if err := d.Purge(ctx); err != nil {
+			d.log.Warn("error purging deleted entities", "err", err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	d.cron.Start()
+	return nil
+}
+
+func (d Service) Close() {
+	d.cron.Stop()
+}
+
+// Purge runs the cascade delete for organizations, projects and users which were
+// soft deleted before the grace period
+func (d Service) Purge(ctx context.Context) error {
+	deletedBefore := time.Now().Add(-d.gracePeriod)
+	var err error
+
+	projects, listErr := d.projService.List(ctx, project.Filter{State: project.Deleted})
+	if listErr != nil {
+		return listErr
+	}
+	for _, p := range projects {
+		if p.DeletedAt.After(deletedBefore) {
+			continue
+		}
+		if purgeErr := d.DeleteProject(ctx, p.ID); purgeErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to purge project[%s]: %w", p.Name, purgeErr))
+		}
+	}
+
+	orgs, listErr := d.orgService.List(ctx, organization.Filter{State: organization.Deleted})
+	if listErr != nil {
+		return errors.Join(err, listErr)
+	}
+	for _, o := range orgs {
+		if o.DeletedAt.After(deletedBefore) {
+			continue
+		}
+		if purgeErr := d.DeleteOrganization(ctx, o.ID); purgeErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to purge org[%s]: %w", o.Name, purgeErr))
+		}
+	}
+
+	var users []user.User
+	for page := int32(1); ; page++ {
+		pageUsers, listErr := d.userService.List(ctx, user.Filter{
+			State: user.Deleted,
+			Limit: purgePageSize,
+			Page:  page,
+		})
+		if listErr != nil {
+			return errors.Join(err, listErr)
+		}
+		users = append(users, pageUsers...)
+		if len(pageUsers) < purgePageSize {
+			break
+		}
+	}
+	for _, u := range users {
+		if u.DeletedAt.After(deletedBefore) {
+			continue
+		}
+		// cascade looks up orgs of the user in the authz engine, relations have to be
+		// back in place for it to remove the user from them
+		purgeErr := d.relationService.Resume(ctx, userRelations(u.ID))
+		if purgeErr == nil {
+			purgeErr = d.DeleteUser(ctx, u.ID)
+		}
+		if purgeErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to purge user[%s]: %w", u.Name, purgeErr))
+		}
+	}
+	return err
+}
+
+// toggleProjectRelations suspends or resumes relations of a project and its resources
+func (d Service) toggleProjectRelations(ctx context.Context, id string,
+	toggle func(ctx context.Context, rel relation.Relation) error) error {
+	resources, err := d.resService.List(ctx, resource.Filter{
+		ProjectID: id,
+	})
+	if err != nil {
+		return err
+	}
+	for _, r := range resources {
+		if err = toggle(ctx, relation.Relation{Object: relation.Object{
+			ID:        r.ID,
+			Namespace: r.NamespaceID,
+		}}); err != nil {
+			return fmt.Errorf("failed to update relations of a resource[%s]: %w", r.Name, err)
+		}
+	}
+
+	return toggle(ctx, relation.Relation{Object: relation.Object{
+		ID:        id,
+		Namespace: schema.ProjectNamespace,
+	}})
+}
+
+// toggleOrganizationRelations suspends or resumes relations of an organization and
+// everything nested under it, soft deleted projects are left untouched
+func (d Service) toggleOrganizationRelations(ctx context.Context, id string,
+	toggle func(ctx context.Context, rel relation.Relation) error) error {
+	projects, err := d.listProjects(ctx, id, "", project.Disabled)
+	if err != nil {
+		return err
+	}
+	for _, p := range projects {
+		if err = d.toggleProjectRelations(ctx, p.ID, toggle); err != nil {
+			return fmt.Errorf("failed to update relations of a project[%s]: %w", p.Name, err)
+		}
+	}
+
+	folders, err := d.folderService.List(ctx, folder.Filter{OrgID: id})
+	if err != nil {
+		return err
+	}
+	for _, f := range folders {
+		if err = toggle(ctx, relation.Relation{Object: relation.Object{
+			ID:        f.ID,
+			Namespace: schema.FolderNamespace,
+		}}); err != nil {
+			return fmt.Errorf("failed to update relations of a folder[%s]: %w", f.Name, err)
+		}
+	}
+
+	for _, state := range []group.State{"", group.Disabled} {
+		groups, err := d.groupService.List(ctx, group.Filter{OrganizationID: id, State: state})
+		if err != nil {
+			return err
+		}
+		for _, g := range groups {
+			if err = toggle(ctx, relation.Relation{Object: relation.Object{
+				ID:        g.ID,
+				Namespace: schema.GroupNamespace,
+			}}); err != nil {
+				return fmt.Errorf("failed to update relations of a group[%s]: %w", g.Name, err)
+			}
+		}
+	}
+
+	return toggle(ctx, relation.Relation{Object: relation.Object{
+		ID:        id,
+		Namespace: schema.OrganizationNamespace,
+	}})
+}
+
+// listProjects lists projects of an organization in any of the given states, empty
+// state lists enabled projects
+func (d Service) listProjects(ctx context.Context, orgID string, states ...project.State) ([]project.Project, error) {
+	var projects []project.Project
+	for _, state := range states {
+		stateProjects, err := d.projService.List(ctx, project.Filter{
+			OrgID: orgID,
+			State: state,
+		})
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, stateProjects...)
+	}
+	return projects, nil
+}
+
+// checkOwnersLeft makes sure the user isn't the last owner of any of its orgs or groups
+func (d Service) checkOwnersLeft(ctx context.Context, userID string) error {
+	userOrgs, err := d.orgService.ListByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, org := range userOrgs {
+		if err = d.orgService.CheckOwnersLeft(ctx, org.ID, []string{userID}); err != nil {
+			return fmt.Errorf("%w: %s", err, org.Name)
+		}
+	}
+
+	userGroups, err := d.groupService.ListByUser(ctx, userID, group.Filter{})
+	if err != nil {
+		return err
+	}
+	for _, g := range userGroups {
+		if err = d.groupService.CheckOwnersLeft(ctx, g.ID, []string{userID}); err != nil {
+			return fmt.Errorf("%w: %s", err, g.Name)
+		}
+	}
+	return nil
+}
+
+func userRelations(userID string) relation.Relation {
+	return relation.Relation{Subject: relation.Subject{
+		ID:        userID,
+		Namespace: schema.UserPrincipal,
+	}}
 }
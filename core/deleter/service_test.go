@@ -0,0 +1,513 @@
+package deleter
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/folder"
+	"github.com/raystack/frontier/core/group"
+	"github.com/raystack/frontier/core/invitation"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/resource"
+	"github.com/raystack/frontier/core/role"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/assert"
+)
+
+// memStore keeps entities of an environment, relations are tracked by the id
+// of the object or subject they were suspended for
+type memStore struct {
+	orgs       map[string]organization.Organization
+	projects   map[string]project.Project
+	resources  map[string]resource.Resource
+	groups     map[string]group.Group
+	users      map[string]user.User
+	members    map[string][]string
+	suspended  map[string]bool
+	anonymized []string
+	resumeErr  error
+}
+
+func newMemStore() *memStore {
+	return &memStore{
+		orgs:      map[string]organization.Organization{},
+		projects:  map[string]project.Project{},
+		resources: map[string]resource.Resource{},
+		groups:    map[string]group.Group{},
+		users:     map[string]user.User{},
+		members:   map[string][]string{},
+		suspended: map[string]bool{},
+	}
+}
+
+func (s *memStore) service(gracePeriod time.Duration) *Service {
+	return NewCascadeDeleter(log.NewNoop(), Config{GracePeriod: gracePeriod}, memOrgService{s}, memProjectService{s},
+		memResourceService{s}, memGroupService{s}, memPolicyService{}, memRoleService{},
+		memInvitationService{}, memUserService{s}, memFolderService{}, memRelationService{s}, memAuditService{s})
+}
+
+type memOrgService struct{ *memStore }
+
+func (m memOrgService) Get(ctx context.Context, id string) (organization.Organization, error) {
+	o, ok := m.orgs[id]
+	if !ok || o.State == organization.Deleted {
+		return organization.Organization{}, organization.ErrNotExist
+	}
+	return o, nil
+}
+
+func (m memOrgService) List(ctx context.Context, flt organization.Filter) ([]organization.Organization, error) {
+	var orgs []organization.Organization
+	for _, o := range m.orgs {
+		if o.State == flt.State {
+			orgs = append(orgs, o)
+		}
+	}
+	return orgs, nil
+}
+
+func (m memOrgService) DeleteModel(ctx context.Context, id string) error {
+	delete(m.orgs, id)
+	delete(m.members, id)
+	return nil
+}
+
+func (m memOrgService) RemoveUsers(ctx context.Context, orgID string, userIDs []string) error {
+	var members []string
+	for _, id := range m.members[orgID] {
+		if id != userIDs[0] {
+			members = append(members, id)
+		}
+	}
+	m.members[orgID] = members
+	return nil
+}
+
+func (m memOrgService) ListByUser(ctx context.Context, userID string) ([]organization.Organization, error) {
+	var orgs []organization.Organization
+	for orgID, members := range m.members {
+		for _, id := range members {
+			if id == userID {
+				orgs = append(orgs, m.orgs[orgID])
+			}
+		}
+	}
+	return orgs, nil
+}
+
+func (m memOrgService) SoftDelete(ctx context.Context, id string) error {
+	o := m.orgs[id]
+	o.State, o.DeletedAt = organization.Deleted, time.Now()
+	m.orgs[id] = o
+	return nil
+}
+
+func (m memOrgService) Restore(ctx context.Context, id string) error {
+	o, ok := m.orgs[id]
+	if !ok || o.State != organization.Deleted {
+		return organization.ErrNotExist
+	}
+	o.State, o.DeletedAt = organization.Enabled, time.Time{}
+	m.orgs[id] = o
+	return nil
+}
+
+func (m memOrgService) CheckOwnersLeft(ctx context.Context, orgID string, userIDs []string) error {
+	if len(m.members[orgID]) == 1 {
+		return organization.ErrLastOwner
+	}
+	return nil
+}
+
+type memProjectService struct{ *memStore }
+
+func (m memProjectService) Get(ctx context.Context, id string) (project.Project, error) {
+	p, ok := m.projects[id]
+	if !ok || p.State == project.Deleted {
+		return project.Project{}, project.ErrNotExist
+	}
+	return p, nil
+}
+
+func (m memProjectService) List(ctx context.Context, flt project.Filter) ([]project.Project, error) {
+	state := flt.State
+	if state == "" {
+		state = project.Enabled
+	}
+	var projects []project.Project
+	for _, p := range m.projects {
+		if p.State == state && (flt.OrgID == "" || p.Organization.ID == flt.OrgID) {
+			projects = append(projects, p)
+		}
+	}
+	return projects, nil
+}
+
+func (m memProjectService) DeleteModel(ctx context.Context, id string) error {
+	delete(m.projects, id)
+	return nil
+}
+
+func (m memProjectService) SoftDelete(ctx context.Context, id string) error {
+	p := m.projects[id]
+	p.State, p.DeletedAt = project.Deleted, time.Now()
+	m.projects[id] = p
+	return nil
+}
+
+func (m memProjectService) Restore(ctx context.Context, id string) error {
+	p, ok := m.projects[id]
+	if !ok || p.State != project.Deleted {
+		return project.ErrNotExist
+	}
+	p.State, p.DeletedAt = project.Enabled, time.Time{}
+	m.projects[id] = p
+	return nil
+}
+
+type memResourceService struct{ *memStore }
+
+func (m memResourceService) List(ctx context.Context, flt resource.Filter) ([]resource.Resource, error) {
+	var resources []resource.Resource
+	for _, r := range m.resources {
+		if r.ProjectID == flt.ProjectID {
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+func (m memResourceService) Delete(ctx context.Context, namespaceID, id string) error {
+	delete(m.resources, id)
+	return nil
+}
+
+func (m memResourceService) Get(ctx context.Context, id string) (resource.Resource, error) {
+	r, ok := m.resources[id]
+	if !ok {
+		return resource.Resource{}, resource.ErrNotExist
+	}
+	return r, nil
+}
+
+type memGroupService struct{ *memStore }
+
+func (m memGroupService) List(ctx context.Context, flt group.Filter) ([]group.Group, error) {
+	state := flt.State
+	if state == "" {
+		state = group.Enabled
+	}
+	var groups []group.Group
+	for _, g := range m.groups {
+		if g.OrganizationID == flt.OrganizationID && g.State == state {
+			groups = append(groups, g)
+		}
+	}
+	return groups, nil
+}
+
+func (m memGroupService) Delete(ctx context.Context, id string) error {
+	delete(m.groups, id)
+	return nil
+}
+
+func (m memGroupService) RemoveUsers(ctx context.Context, groupID string, userIDs []string) error {
+	return nil
+}
+
+func (m memGroupService) ListByUser(ctx context.Context, userID string, flt group.Filter) ([]group.Group, error) {
+	return nil, nil
+}
+
+func (m memGroupService) CheckOwnersLeft(ctx context.Context, groupID string, userIDs []string) error {
+	return nil
+}
+
+type memUserService struct{ *memStore }
+
+func (m memUserService) GetByID(ctx context.Context, id string) (user.User, error) {
+	u, ok := m.users[id]
+	if !ok || u.State == user.Deleted {
+		return user.User{}, user.ErrNotExist
+	}
+	return u, nil
+}
+
+func (m memUserService) List(ctx context.Context, flt user.Filter) ([]user.User, error) {
+	var users []user.User
+	if flt.Page > 1 {
+		return users, nil
+	}
+	for _, u := range m.users {
+		if u.State == flt.State {
+			users = append(users, u)
+		}
+	}
+	return users, nil
+}
+
+func (m memUserService) Delete(ctx context.Context, id string) error {
+	delete(m.users, id)
+	return nil
+}
+
+func (m memUserService) SoftDelete(ctx context.Context, id string) error {
+	u := m.users[id]
+	u.State, u.DeletedAt = user.Deleted, time.Now()
+	m.users[id] = u
+	return nil
+}
+
+func (m memUserService) Restore(ctx context.Context, id string) error {
+	u, ok := m.users[id]
+	if !ok || u.State != user.Deleted {
+		return user.ErrNotExist
+	}
+	u.State, u.DeletedAt = user.Enabled, time.Time{}
+	m.users[id] = u
+	return nil
+}
+
+type memRelationService struct{ *memStore }
+
+func (m memRelationService) GetRelationsByFields(ctx context.Context, rel relation.Relation) ([]relation.Relation, error) {
+	return nil, nil
+}
+
+func (m memRelationService) Suspend(ctx context.Context, rel relation.Relation) error {
+	m.suspended[rel.Object.ID+rel.Subject.ID] = true
+	return nil
+}
+
+func (m memRelationService) Resume(ctx context.Context, rel relation.Relation) error {
+	if m.resumeErr != nil {
+		return m.resumeErr
+	}
+	delete(m.suspended, rel.Object.ID+rel.Subject.ID)
+	return nil
+}
+
+type memAuditService struct{ *memStore }
+
+func (m memAuditService) AnonymizeActor(ctx context.Context, actorID string) error {
+	m.anonymized = append(m.anonymized, actorID)
+	return nil
+}
+
+type memPolicyService struct{}
+
+func (memPolicyService) List(ctx context.Context, flt policy.Filter) ([]policy.Policy, error) {
+	return nil, nil
+}
+
+func (memPolicyService) Delete(ctx context.Context, id string) error {
+	return nil
+}
+
+type memRoleService struct{}
+
+func (memRoleService) List(ctx context.Context, flt role.Filter) ([]role.Role, error) {
+	return nil, nil
+}
+
+func (memRoleService) Delete(ctx context.Context, id string) error {
+	return nil
+}
+
+type memInvitationService struct{}
+
+func (memInvitationService) List(ctx context.Context, flt invitation.Filter) ([]invitation.Invitation, error) {
+	return nil, nil
+}
+
+func (memInvitationService) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+type memFolderService struct{}
+
+func (memFolderService) List(ctx context.Context, flt folder.Filter) ([]folder.Folder, error) {
+	return nil, nil
+}
+
+func (memFolderService) DeleteModel(ctx context.Context, id string) error {
+	return nil
+}
+
+// seed creates an org with a group, a project holding a resource and two members
+func (s *memStore) seed() {
+	s.orgs["org-id"] = organization.Organization{ID: "org-id", Name: "acme", State: organization.Enabled}
+	s.groups["group-id"] = group.Group{ID: "group-id", OrganizationID: "org-id", State: group.Enabled}
+	s.projects["project-id"] = project.Project{ID: "project-id", Name: "web", State: project.Enabled,
+		Organization: organization.Organization{ID: "org-id"}}
+	s.resources["resource-id"] = resource.Resource{ID: "resource-id", ProjectID: "project-id", NamespaceID: "compute/instance"}
+	s.users["john-id"] = user.User{ID: "john-id", Name: "john", State: user.Enabled}
+	s.users["jane-id"] = user.User{ID: "jane-id", Name: "jane", State: user.Enabled}
+	s.members["org-id"] = []string{"john-id", "jane-id"}
+}
+
+func TestService_SoftDeleteOrganization(t *testing.T) {
+	ctx := context.Background()
+	s := newMemStore()
+	s.seed()
+	svc := s.service(time.Hour)
+
+	assert.NoError(t, svc.SoftDeleteOrganization(ctx, "org-id"))
+	assert.Equal(t, organization.Deleted, s.orgs["org-id"].State)
+	assert.Equal(t, map[string]bool{"org-id": true, "group-id": true, "project-id": true, "resource-id": true}, s.suspended)
+	// nothing is removed until the org is purged
+	assert.Len(t, s.projects, 1)
+	assert.Len(t, s.groups, 1)
+
+	assert.NoError(t, svc.RestoreOrganization(ctx, "org-id"))
+	assert.Equal(t, organization.Enabled, s.orgs["org-id"].State)
+	assert.Empty(t, s.suspended)
+
+	t.Run("should return not exist if org isn't soft deleted", func(t *testing.T) {
+		assert.ErrorIs(t, svc.RestoreOrganization(ctx, "org-id"), organization.ErrNotExist)
+	})
+	t.Run("should keep project deleted on its own when org is restored", func(t *testing.T) {
+		assert.NoError(t, svc.SoftDeleteProject(ctx, "project-id"))
+		assert.NoError(t, svc.SoftDeleteOrganization(ctx, "org-id"))
+		assert.NoError(t, svc.RestoreOrganization(ctx, "org-id"))
+		assert.Equal(t, project.Deleted, s.projects["project-id"].State)
+		assert.Equal(t, map[string]bool{"project-id": true, "resource-id": true}, s.suspended)
+	})
+}
+
+func TestService_RestoreOrganization(t *testing.T) {
+	ctx := context.Background()
+	s := newMemStore()
+	s.seed()
+	svc := s.service(time.Hour)
+	assert.NoError(t, svc.SoftDeleteOrganization(ctx, "org-id"))
+
+	t.Run("should keep org deleted if relations can't be resumed", func(t *testing.T) {
+		s.resumeErr = errors.New("some error")
+		assert.ErrorIs(t, svc.RestoreOrganization(ctx, "org-id"), s.resumeErr)
+		assert.Equal(t, organization.Deleted, s.orgs["org-id"].State)
+	})
+	t.Run("should restore org once relations can be resumed", func(t *testing.T) {
+		s.resumeErr = nil
+		assert.NoError(t, svc.RestoreOrganization(ctx, "org-id"))
+		assert.Equal(t, organization.Enabled, s.orgs["org-id"].State)
+		assert.Empty(t, s.suspended)
+	})
+}
+
+func TestService_SoftDeleteProject(t *testing.T) {
+	ctx := context.Background()
+	s := newMemStore()
+	s.seed()
+	svc := s.service(time.Hour)
+
+	assert.NoError(t, svc.SoftDeleteProject(ctx, "project-id"))
+	assert.Equal(t, project.Deleted, s.projects["project-id"].State)
+	assert.Equal(t, map[string]bool{"project-id": true, "resource-id": true}, s.suspended)
+	assert.Len(t, s.resources, 1)
+
+	assert.NoError(t, svc.RestoreProject(ctx, "project-id"))
+	assert.Equal(t, project.Enabled, s.projects["project-id"].State)
+	assert.Empty(t, s.suspended)
+	assert.ErrorIs(t, svc.RestoreProject(ctx, "project-id"), project.ErrNotExist)
+}
+
+func TestService_SoftDeleteUser(t *testing.T) {
+	ctx := context.Background()
+	s := newMemStore()
+	s.seed()
+	svc := s.service(time.Hour)
+
+	assert.NoError(t, svc.SoftDeleteUser(ctx, "john-id"))
+	assert.Equal(t, user.Deleted, s.users["john-id"].State)
+	assert.Equal(t, map[string]bool{"john-id": true}, s.suspended)
+
+	t.Run("should not soft delete the last owner of an org", func(t *testing.T) {
+		s.members["org-id"] = []string{"jane-id"}
+		assert.ErrorIs(t, svc.SoftDeleteUser(ctx, "jane-id"), organization.ErrLastOwner)
+		assert.Equal(t, user.Enabled, s.users["jane-id"].State)
+	})
+
+	assert.NoError(t, svc.RestoreUser(ctx, "john-id"))
+	assert.Equal(t, user.Enabled, s.users["john-id"].State)
+	assert.Empty(t, s.suspended)
+	assert.ErrorIs(t, svc.RestoreUser(ctx, "john-id"), user.ErrNotExist)
+}
+
+func TestService_Purge(t *testing.T) {
+	ctx := context.Background()
+	s := newMemStore()
+	s.seed()
+	svc := s.service(time.Hour)
+
+	assert.NoError(t, svc.SoftDeleteOrganization(ctx, "org-id"))
+	assert.NoError(t, svc.SoftDeleteUser(ctx, "john-id"))
+
+	t.Run("should keep entities within the grace period", func(t *testing.T) {
+		assert.NoError(t, svc.Purge(ctx))
+		assert.Len(t, s.orgs, 1)
+		assert.Len(t, s.users, 2)
+	})
+	t.Run("should cascade delete entities once the grace period is over", func(t *testing.T) {
+		o := s.orgs["org-id"]
+		o.DeletedAt = time.Now().Add(-2 * time.Hour)
+		s.orgs["org-id"] = o
+		u := s.users["john-id"]
+		u.DeletedAt = time.Now().Add(-2 * time.Hour)
+		s.users["john-id"] = u
+
+		assert.NoError(t, svc.Purge(ctx))
+		assert.Empty(t, s.orgs)
+		assert.Empty(t, s.projects)
+		assert.Empty(t, s.resources)
+		assert.Empty(t, s.groups)
+		assert.Equal(t, []string{"jane-id"}, keys(s.users))
+		assert.Equal(t, []string{"john-id"}, s.anonymized)
+	})
+}
+
+func TestService_CheckConfirmation(t *testing.T) {
+	report := Report{Organizations: []string{"org-id"}, Projects: []string{"project-id"}}
+	svc := NewCascadeDeleter(log.NewNoop(), Config{ConfirmationTTL: time.Minute, ConfirmationKey: "some-key"},
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	token := svc.ConfirmationToken(report)
+
+	t.Run("should accept a token of a dry run with the same impact", func(t *testing.T) {
+		assert.NoError(t, svc.CheckConfirmation(token, report))
+	})
+	t.Run("should reject a token if impact changed", func(t *testing.T) {
+		changed := Report{Organizations: []string{"org-id"}}
+		assert.ErrorIs(t, svc.CheckConfirmation(token, changed), ErrInvalidConfirmation)
+	})
+	t.Run("should reject a token signed with another key", func(t *testing.T) {
+		other := NewCascadeDeleter(log.NewNoop(), Config{ConfirmationTTL: time.Minute, ConfirmationKey: "other-key"},
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		assert.ErrorIs(t, other.CheckConfirmation(token, report), ErrInvalidConfirmation)
+	})
+	t.Run("should reject a token with an extended expiry", func(t *testing.T) {
+		_, mac, _ := strings.Cut(token, ".")
+		extended := strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10) + "." + mac
+		assert.ErrorIs(t, svc.CheckConfirmation(extended, report), ErrInvalidConfirmation)
+	})
+	t.Run("should reject an expired token", func(t *testing.T) {
+		expired := report.sign([]byte("some-key"), time.Now().Add(-time.Second))
+		assert.ErrorIs(t, svc.CheckConfirmation(expired, report), ErrConfirmationExpired)
+	})
+}
+
+func keys[T any](m map[string]T) []string {
+	var ks []string
+	for k := range m {
+		ks = append(ks, k)
+	}
+	return ks
+}
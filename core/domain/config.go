@@ -0,0 +1,25 @@
+package domain
+
+import "time"
+
+type Config struct {
+	// Servers are DNS servers as host:port used to lookup verification records,
+	// the system resolver is used if not set
+	Servers []string `yaml:"servers" mapstructure:"servers"`
+
+	// DoHURL is a DNS-over-HTTPS endpoint used to lookup verification records
+	// instead of DNS servers if set
+	DoHURL string `yaml:"doh_url" mapstructure:"doh_url"`
+
+	// LookupTimeout is how long a lookup of verification records can take
+	LookupTimeout time.Duration `yaml:"lookup_timeout" mapstructure:"lookup_timeout" default:"10s"`
+
+	// HTTPVerification allows domains to be verified with the token served at
+	// https://<domain>/.well-known/frontier-challenge.txt
+	HTTPVerification bool `yaml:"http_verification" mapstructure:"http_verification" default:"false"`
+
+	// ReverifySchedule is the cron schedule of the job checking verified domains
+	// still have their verification token, domains which lost it are set to
+	// pending. The job doesn't run if not set
+	ReverifySchedule string `yaml:"reverify_schedule" mapstructure:"reverify_schedule" default:"30 0 * * *"`
+}
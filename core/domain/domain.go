@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"strings"
 	"time"
 )
 
@@ -31,6 +32,47 @@ type Domain struct {
 	OrgID     string
 	Token     string
 	State     Status
+	AutoJoin  AutoJoin
 	UpdatedAt time.Time
 	CreatedAt time.Time
 }
+
+// AutoJoin is the policy to add users of a verified domain to its organization
+// on their first login, users of subdomains of the domain are added as well
+type AutoJoin struct {
+	Enabled bool `json:"enabled"`
+	// RoleIDs are assigned to the users at organization level
+	RoleIDs  []string `json:"role_ids,omitempty"`
+	GroupIDs []string `json:"group_ids,omitempty"`
+	// Exclusions are email addresses or subdomains whose users aren't added
+	Exclusions []string `json:"exclusions,omitempty"`
+}
+
+// Matches returns true if a user with the email is added to the organization
+// of the domain by its auto join policy
+func (d Domain) Matches(email string) bool {
+	if !d.AutoJoin.Enabled || d.State != Verified {
+		return false
+	}
+	email = strings.ToLower(email)
+	userDomain := extractDomainFromEmail(email)
+	if !isSubdomain(userDomain, strings.ToLower(d.Name)) {
+		return false
+	}
+	for _, exclusion := range d.AutoJoin.Exclusions {
+		exclusion = strings.ToLower(strings.TrimSpace(exclusion))
+		if strings.Contains(exclusion, "@") {
+			if exclusion == email {
+				return false
+			}
+		} else if isSubdomain(userDomain, exclusion) {
+			return false
+		}
+	}
+	return true
+}
+
+// isSubdomain returns true if name is the domain or one of its subdomains
+func isSubdomain(name, domain string) bool {
+	return name != "" && (name == domain || strings.HasSuffix(name, "."+domain))
+}
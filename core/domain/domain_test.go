@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomain_Matches(t *testing.T) {
+	dmn := Domain{
+		Name:  "acme.com",
+		State: Verified,
+		AutoJoin: AutoJoin{
+			Enabled:    true,
+			Exclusions: []string{"ceo@acme.com", "contractors.acme.com"},
+		},
+	}
+	tests := []struct {
+		name  string
+		dmn   Domain
+		email string
+		want  bool
+	}{
+		{
+			name:  "should match users of the domain",
+			dmn:   dmn,
+			email: "John@Acme.com",
+			want:  true,
+		},
+		{
+			name:  "should match users of a subdomain",
+			dmn:   dmn,
+			email: "john@eng.acme.com",
+			want:  true,
+		},
+		{
+			name:  "should not match a domain ending with the same name",
+			dmn:   dmn,
+			email: "john@notacme.com",
+			want:  false,
+		},
+		{
+			name:  "should not match an excluded address",
+			dmn:   dmn,
+			email: "ceo@acme.com",
+			want:  false,
+		},
+		{
+			name:  "should not match users of an excluded subdomain",
+			dmn:   dmn,
+			email: "jane@eu.contractors.acme.com",
+			want:  false,
+		},
+		{
+			name:  "should not match when auto join is disabled",
+			dmn:   Domain{Name: "acme.com", State: Verified},
+			email: "john@acme.com",
+			want:  false,
+		},
+		{
+			name:  "should not match a pending domain",
+			dmn:   Domain{Name: "acme.com", State: Pending, AutoJoin: AutoJoin{Enabled: true}},
+			email: "john@acme.com",
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.dmn.Matches(tt.email))
+		})
+	}
+}
@@ -9,4 +9,5 @@ var (
 	ErrDomainsMisMatch   = errors.New("user domain does not match the organization domain")
 	ErrInvalidId         = errors.New("invalid domain id")
 	ErrDuplicateKey      = errors.New("domain name already exists for that organization")
+	ErrInvalidAutoJoin   = errors.New("invalid auto join policy")
 )
@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// ChallengeSubdomain is the subdomain the verification TXT record of a domain
+// can be added to instead of the domain itself
+const ChallengeSubdomain = "_frontier-challenge"
+
+// maxDNSMessageSize limits the size of a DNS-over-HTTPS response read
+const maxDNSMessageSize = 65535
+
+// Resolver looks up TXT records of a domain, ErrInvalidDomain is returned if
+// the domain doesn't exist
+type Resolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// NewResolver returns the resolver of the config, a DNS-over-HTTPS resolver if
+// its url is set, one querying the configured DNS servers or else the system one
+func NewResolver(config Config) Resolver {
+	if config.DoHURL != "" {
+		return &DoHResolver{
+			URL:    config.DoHURL,
+			Client: &http.Client{Timeout: config.LookupTimeout},
+		}
+	}
+	if len(config.Servers) > 0 {
+		return NewServerResolver(config.Servers)
+	}
+	return netResolver{resolver: net.DefaultResolver}
+}
+
+// netResolver looks up records with a go resolver
+type netResolver struct {
+	resolver *net.Resolver
+}
+
+func (r netResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	records, err := r.resolver.LookupTXT(ctx, name)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidDomain, err)
+		}
+		return nil, err
+	}
+	return records, nil
+}
+
+// ServerResolver looks up records with the DNS servers, a server is only
+// queried if the ones before it couldn't be reached
+type ServerResolver struct {
+	resolvers []netResolver
+}
+
+func NewServerResolver(servers []string) *ServerResolver {
+	r := &ServerResolver{}
+	for _, server := range servers {
+		server := server
+		r.resolvers = append(r.resolvers, netResolver{resolver: &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, network, server)
+			},
+		}})
+	}
+	return r
+}
+
+func (r *ServerResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	var lookupErr error
+	for _, resolver := range r.resolvers {
+		records, err := resolver.LookupTXT(ctx, name)
+		if err == nil || errors.Is(err, ErrInvalidDomain) {
+			return records, err
+		}
+		lookupErr = errors.Join(lookupErr, err)
+	}
+	return nil, lookupErr
+}
+
+// DoHResolver looks up records with DNS-over-HTTPS as described in RFC 8484
+type DoHResolver struct {
+	URL    string
+	Client *http.Client
+}
+
+func (r *DoHResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	qname, err := dnsmessage.NewName(dnsName(name))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDomain, err)
+	}
+	query := dnsmessage.Message{
+		Header: dnsmessage.Header{RecursionDesired: true},
+		Questions: []dnsmessage.Question{{
+			Name:  qname,
+			Type:  dnsmessage.TypeTXT,
+			Class: dnsmessage.ClassINET,
+		}},
+	}
+	body, err := query.Pack()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/dns-message")
+	req.Header.Set("Accept", "application/dns-message")
+	resp, err := r.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("dns-over-https lookup failed with status %d", resp.StatusCode)
+	}
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, maxDNSMessageSize))
+	if err != nil {
+		return nil, err
+	}
+	return parseTXTAnswer(raw)
+}
+
+// parseTXTAnswer reads the TXT records of a DNS response
+func parseTXTAnswer(raw []byte) ([]string, error) {
+	var msg dnsmessage.Message
+	if err := msg.Unpack(raw); err != nil {
+		return nil, err
+	}
+	switch msg.RCode {
+	case dnsmessage.RCodeSuccess:
+	case dnsmessage.RCodeNameError:
+		return nil, ErrInvalidDomain
+	default:
+		return nil, fmt.Errorf("dns lookup failed: %s", msg.RCode)
+	}
+
+	var records []string
+	for _, answer := range msg.Answers {
+		if txt, ok := answer.Body.(*dnsmessage.TXTResource); ok {
+			// a record longer than 255 bytes is split into several strings
+			records = append(records, strings.Join(txt.TXT, ""))
+		}
+	}
+	return records, nil
+}
+
+// dnsName returns the fully qualified name of a domain
+func dnsName(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
@@ -4,15 +4,20 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base64"
+	"errors"
 	"fmt"
-	"net"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/raystack/salt/log"
 
+	"github.com/raystack/frontier/core/audit"
 	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/group"
 	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/role"
 	"github.com/raystack/frontier/core/user"
 	"github.com/raystack/frontier/internal/bootstrap/schema"
 	"github.com/robfig/cron/v3"
@@ -28,12 +33,31 @@ type OrgService interface {
 	Get(ctx context.Context, id string) (organization.Organization, error)
 }
 
+type GroupService interface {
+	Get(ctx context.Context, id string) (group.Group, error)
+	AddMember(ctx context.Context, groupID string, principal authenticate.Principal) error
+}
+
+type PolicyService interface {
+	Create(ctx context.Context, policy policy.Policy) (policy.Policy, error)
+}
+
+type RoleService interface {
+	Get(ctx context.Context, id string) (role.Role, error)
+}
+
 type Service struct {
-	repository  Repository
-	userService UserService
-	orgService  OrgService
-	cron        *cron.Cron
-	log         log.Logger
+	config        Config
+	resolver      Resolver
+	httpClient    *http.Client
+	repository    Repository
+	userService   UserService
+	orgService    OrgService
+	groupService  GroupService
+	policyService PolicyService
+	roleService   RoleService
+	cron          *cron.Cron
+	log           log.Logger
 }
 
 const (
@@ -43,13 +67,20 @@ const (
 	refreshTime        = "0 0 * * *"        // Once a day at midnight (UTC)
 )
 
-func NewService(logger log.Logger, repository Repository, userService UserService, orgService OrgService) *Service {
+func NewService(logger log.Logger, config Config, repository Repository, userService UserService, orgService OrgService,
+	groupService GroupService, policyService PolicyService, roleService RoleService) *Service {
 	return &Service{
-		repository:  repository,
-		userService: userService,
-		orgService:  orgService,
-		cron:        cron.New(),
-		log:         logger,
+		config:        config,
+		resolver:      NewResolver(config),
+		httpClient:    newChallengeClient(config.LookupTimeout),
+		repository:    repository,
+		userService:   userService,
+		orgService:    orgService,
+		groupService:  groupService,
+		policyService: policyService,
+		roleService:   roleService,
+		cron:          cron.New(),
+		log:           logger,
 	}
 }
 
@@ -81,6 +112,9 @@ func (s Service) Create(ctx context.Context, domain Domain) (Domain, error) {
 	}
 
 	domain.OrgID = orgResp.ID // in case the orgName is provided in the request, replace with the orgID
+	if err = s.validateAutoJoin(ctx, domain.OrgID, domain.AutoJoin); err != nil {
+		return Domain{}, err
+	}
 	domain.Token = fmt.Sprintf(DNSChallenge, txtRecord)
 	var domainResp Domain
 	if domainResp, err = s.repository.Create(ctx, domain); err != nil {
@@ -90,33 +124,98 @@ func (s Service) Create(ctx context.Context, domain Domain) (Domain, error) {
 	return domainResp, nil
 }
 
-// VerifyDomain checks if the TXT record for the domain matches the token generated by Frontier for the domain verification
+// VerifyDomain checks if the TXT record of the domain or of its challenge
+// subdomain matches the token generated by Frontier for the domain verification,
+// or if the domain serves the token at WellKnownPath when HTTP verification is enabled
 func (s Service) VerifyDomain(ctx context.Context, id string) (Domain, error) {
 	domain, err := s.repository.Get(ctx, id)
 	if err != nil {
 		return Domain{}, ErrNotExist
 	}
 
-	txtRecords, err := net.LookupTXT(domain.Name)
+	verified, err := s.hasProof(ctx, domain)
 	if err != nil {
-		if strings.Contains(err.Error(), "no such host") {
-			return Domain{}, ErrInvalidDomain
-		}
 		return Domain{}, err
 	}
+	if !verified {
+		return domain, ErrTXTrecordNotFound
+	}
+	domain.State = Verified
+	return s.repository.Update(ctx, domain)
+}
 
-	for _, txtRecord := range txtRecords {
-		if strings.TrimSpace(txtRecord) == strings.TrimSpace(domain.Token) {
-			domain.State = Verified
-			domain, err = s.repository.Update(ctx, domain)
-			if err != nil {
-				return Domain{}, err
+// hasProof checks if the verification token of the domain can be found. An
+// error is returned if it couldn't be checked, ErrInvalidDomain if the domain
+// doesn't exist
+func (s Service) hasProof(ctx context.Context, dmn Domain) (bool, error) {
+	if s.config.LookupTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.config.LookupTimeout)
+		defer cancel()
+	}
+
+	var proofErr error
+	domainMissing := false
+	for _, name := range []string{ChallengeSubdomain + "." + dmn.Name, dmn.Name} {
+		records, err := s.resolver.LookupTXT(ctx, name)
+		if err != nil {
+			switch {
+			case !errors.Is(err, ErrInvalidDomain):
+				proofErr = errors.Join(proofErr, err)
+			case name == dmn.Name:
+				domainMissing = true
 			}
-			return domain, nil
+			continue
+		}
+		for _, record := range records {
+			if strings.TrimSpace(record) == strings.TrimSpace(dmn.Token) {
+				return true, nil
+			}
+		}
+	}
+	if s.config.HTTPVerification {
+		found, err := s.hasChallengeFile(ctx, dmn)
+		if found {
+			return true, nil
+		}
+		if err != nil {
+			proofErr = errors.Join(proofErr, err)
 		}
 	}
 
-	return domain, ErrTXTrecordNotFound
+	if proofErr != nil {
+		return false, proofErr
+	}
+	if domainMissing {
+		return false, ErrInvalidDomain
+	}
+	return false, nil
+}
+
+// Reverify checks verified domains still have their verification token, domains
+// which lost it are set to pending and have to be verified again before they
+// expire. Domains which couldn't be checked are left verified.
+func (s Service) Reverify(ctx context.Context) error {
+	domains, err := s.repository.List(ctx, Filter{State: Verified})
+	if err != nil {
+		return err
+	}
+	for _, dmn := range domains {
+		verified, err := s.hasProof(ctx, dmn)
+		if err != nil && !errors.Is(err, ErrInvalidDomain) {
+			s.log.Warn("error reverifying domain", "domain", dmn.Name, "org_id", dmn.OrgID, "err", err)
+			continue
+		}
+		if verified {
+			continue
+		}
+		dmn.State = Pending
+		if _, err := s.repository.Update(ctx, dmn); err != nil {
+			return err
+		}
+		s.log.Info("domain lost its verification", "domain", dmn.Name, "org_id", dmn.OrgID)
+	}
+	return nil
 }
 
 // Join an organization as a member if the user domain matches the org whitelisted domains
@@ -172,6 +271,119 @@ func (s Service) Join(ctx context.Context, orgID string, userId string) error {
 	return ErrDomainsMisMatch
 }
 
+// UpdateAutoJoin sets the auto join policy of an organization's domain
+func (s Service) UpdateAutoJoin(ctx context.Context, id string, autoJoin AutoJoin) (Domain, error) {
+	domain, err := s.repository.Get(ctx, id)
+	if err != nil {
+		return Domain{}, ErrNotExist
+	}
+	if err = s.validateAutoJoin(ctx, domain.OrgID, autoJoin); err != nil {
+		return Domain{}, err
+	}
+	domain.AutoJoin = autoJoin
+	return s.repository.Update(ctx, domain)
+}
+
+// validateAutoJoin checks the groups of the auto join policy belong to the
+// organization and its roles can be assigned on it
+func (s Service) validateAutoJoin(ctx context.Context, orgID string, autoJoin AutoJoin) error {
+	for _, groupID := range autoJoin.GroupIDs {
+		grp, err := s.groupService.Get(ctx, groupID)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidAutoJoin, err)
+		}
+		if grp.OrganizationID != orgID {
+			return fmt.Errorf("%w: group %s isn't part of the organization", ErrInvalidAutoJoin, groupID)
+		}
+	}
+	for _, roleID := range autoJoin.RoleIDs {
+		rl, err := s.roleService.Get(ctx, roleID)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidAutoJoin, err)
+		}
+		if !rl.IsOrgRole(orgID) {
+			return fmt.Errorf("%w: role %s can't be assigned at organization level", ErrInvalidAutoJoin, roleID)
+		}
+	}
+	return nil
+}
+
+// AutoJoin adds a user to the organizations of verified domains matching its
+// email whose auto join policy allows it, along with the groups and roles of
+// the policy. Organizations the user is already a member of are skipped.
+func (s Service) AutoJoin(ctx context.Context, userID string) error {
+	currUser, err := s.userService.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	userDomain := strings.ToLower(extractDomainFromEmail(currUser.Email))
+	if userDomain == "" {
+		return user.ErrInvalidEmail
+	}
+	userOrgs, err := s.orgService.ListByUser(ctx, currUser.ID)
+	if err != nil {
+		return err
+	}
+	joined := map[string]bool{}
+	for _, org := range userOrgs {
+		joined[org.ID] = true
+	}
+
+	// policies of parent domains apply to subdomains as well
+	var joinErr error
+	labels := strings.Split(userDomain, ".")
+	for i := 0; i < len(labels)-1; i++ {
+		domains, err := s.repository.List(ctx, Filter{
+			Name:  strings.Join(labels[i:], "."),
+			State: Verified,
+		})
+		if err != nil {
+			return err
+		}
+		for _, dmn := range domains {
+			if joined[dmn.OrgID] || !dmn.Matches(currUser.Email) {
+				continue
+			}
+			if err := s.autoJoin(ctx, dmn, currUser.ID); err != nil {
+				joinErr = errors.Join(joinErr, fmt.Errorf("org %s: %w", dmn.OrgID, err))
+				continue
+			}
+			joined[dmn.OrgID] = true
+		}
+	}
+	return joinErr
+}
+
+func (s Service) autoJoin(ctx context.Context, dmn Domain, userID string) error {
+	principal := authenticate.Principal{
+		ID:   userID,
+		Type: schema.UserPrincipal,
+	}
+	if err := s.orgService.AddMember(ctx, dmn.OrgID, schema.MemberRelationName, principal); err != nil {
+		return err
+	}
+	for _, groupID := range dmn.AutoJoin.GroupIDs {
+		if err := s.groupService.AddMember(ctx, groupID, principal); err != nil {
+			return err
+		}
+	}
+	for _, roleID := range dmn.AutoJoin.RoleIDs {
+		if _, err := s.policyService.Create(ctx, policy.Policy{
+			RoleID:        roleID,
+			ResourceID:    dmn.OrgID,
+			ResourceType:  schema.OrganizationNamespace,
+			PrincipalID:   userID,
+			PrincipalType: schema.UserPrincipal,
+		}); err != nil {
+			return err
+		}
+	}
+	audit.GetAuditor(ctx, dmn.OrgID).LogWithAttrs(audit.OrgMemberCreatedEvent, audit.UserTarget(userID), map[string]string{
+		"via": "domain_auto_join",
+	})
+	return nil
+}
+
 func (s Service) ListJoinableOrgsByDomain(ctx context.Context, email string) ([]string, error) {
 	domain := extractDomainFromEmail(email)
 	domains, err := s.repository.List(ctx, Filter{
@@ -210,7 +422,8 @@ func (s Service) ListJoinableOrgsByDomain(ctx context.Context, email string) ([]
 	return orgIDs, nil
 }
 
-// InitDomainVerification starts a cron job that runs once a day to delete expired domain requests which are still in pending state after 7 days of creation
+// InitDomainVerification starts a cron job that runs once a day to delete expired domain requests which are still in pending state after 7 days,
+// and one to reverify verified domains if scheduled
 func (s Service) InitDomainVerification(ctx context.Context) error {
 	_, err := s.cron.AddFunc(refreshTime, func() {
 		if err := s.repository.DeleteExpiredDomainRequests(ctx); err != nil {
@@ -220,6 +433,15 @@ func (s Service) InitDomainVerification(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if s.config.ReverifySchedule != "" {
+		if _, err := s.cron.AddFunc(s.config.ReverifySchedule, func() {
+			if err := s.Reverify(ctx); err != nil {
+				s.log.Warn("error reverifying domains", "err", err)
+			}
+		}); err != nil {
+			return err
+		}
+	}
 	s.cron.Start()
 	return nil
 }
@@ -0,0 +1,253 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/raystack/frontier/core/role"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// testDNSServer answers TXT queries of its records, other names don't exist
+type testDNSServer struct {
+	records map[string][]string
+}
+
+func (d *testDNSServer) answer(t *testing.T, raw []byte) []byte {
+	t.Helper()
+	var query dnsmessage.Message
+	if err := query.Unpack(raw); err != nil {
+		t.Fatal(err)
+	}
+	resp := dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: query.ID, Response: true, RecursionAvailable: true},
+		Questions: query.Questions,
+	}
+	question := query.Questions[0]
+	records, ok := d.records[strings.TrimSuffix(question.Name.String(), ".")]
+	if !ok {
+		resp.RCode = dnsmessage.RCodeNameError
+	}
+	for _, record := range records {
+		resp.Answers = append(resp.Answers, dnsmessage.Resource{
+			Header: dnsmessage.ResourceHeader{Name: question.Name, Type: dnsmessage.TypeTXT, Class: dnsmessage.ClassINET, TTL: 60},
+			Body:   &dnsmessage.TXTResource{TXT: []string{record}},
+		})
+	}
+	packed, err := resp.Pack()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return packed
+}
+
+// serveUDP starts the DNS server on a local udp port and returns its address
+func (d *testDNSServer) serveUDP(t *testing.T) string {
+	t.Helper()
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			_, _ = conn.WriteTo(d.answer(t, buf[:n]), addr)
+		}
+	}()
+	return conn.LocalAddr().String()
+}
+
+// serveDoH starts the DNS server as a DNS-over-HTTPS endpoint
+func (d *testDNSServer) serveDoH(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/dns-message")
+		_, _ = io.Copy(w, bytes.NewReader(d.answer(t, raw)))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+type memRepository struct {
+	Repository
+	domains map[string]Domain
+}
+
+func (r *memRepository) Get(ctx context.Context, id string) (Domain, error) {
+	dmn, ok := r.domains[id]
+	if !ok {
+		return Domain{}, ErrNotExist
+	}
+	return dmn, nil
+}
+
+func (r *memRepository) Update(ctx context.Context, dmn Domain) (Domain, error) {
+	r.domains[dmn.ID] = dmn
+	return dmn, nil
+}
+
+func (r *memRepository) List(ctx context.Context, flt Filter) ([]Domain, error) {
+	var domains []Domain
+	for _, d := range r.domains {
+		if flt.State == "" || d.State == flt.State {
+			domains = append(domains, d)
+		}
+	}
+	return domains, nil
+}
+
+func TestResolver_LookupTXT(t *testing.T) {
+	dns := &testDNSServer{records: map[string][]string{
+		"_frontier-challenge.raystack.org": {"token"},
+	}}
+	doh := dns.serveDoH(t)
+	tests := []struct {
+		name     string
+		resolver Resolver
+	}{
+		{
+			name:     "should lookup records with dns servers",
+			resolver: NewServerResolver([]string{"127.0.0.1:1", dns.serveUDP(t)}),
+		},
+		{
+			name:     "should lookup records with dns-over-https",
+			resolver: &DoHResolver{URL: doh.URL, Client: doh.Client()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			records, err := tt.resolver.LookupTXT(ctx, "_frontier-challenge.raystack.org")
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"token"}, records)
+
+			_, err = tt.resolver.LookupTXT(ctx, "missing.raystack.org")
+			assert.ErrorIs(t, err, ErrInvalidDomain)
+		})
+	}
+}
+
+func TestService_VerifyDomain(t *testing.T) {
+	dns := &testDNSServer{records: map[string][]string{
+		"raystack.org":                     {"v=spf1 -all"},
+		"_frontier-challenge.raystack.org": {"_frontier-domain-verification=token"},
+		"gotocompany.com":                  {"v=spf1 -all"},
+	}}
+	repo := &memRepository{domains: map[string]Domain{
+		"1": {ID: "1", Name: "raystack.org", Token: "_frontier-domain-verification=token", State: Pending},
+		"2": {ID: "2", Name: "gotocompany.com", Token: "_frontier-domain-verification=token", State: Pending},
+		"3": {ID: "3", Name: "missing.org", Token: "_frontier-domain-verification=token", State: Pending},
+	}}
+	s := NewService(log.NewNoop(), Config{Servers: []string{dns.serveUDP(t)}, LookupTimeout: 5 * time.Second},
+		repo, nil, nil, nil, nil, nil)
+
+	t.Run("should verify a domain with a record of its challenge subdomain", func(t *testing.T) {
+		dmn, err := s.VerifyDomain(context.Background(), "1")
+		assert.NoError(t, err)
+		assert.Equal(t, Verified, dmn.State)
+	})
+	t.Run("should not verify a domain without the record", func(t *testing.T) {
+		_, err := s.VerifyDomain(context.Background(), "2")
+		assert.ErrorIs(t, err, ErrTXTrecordNotFound)
+		_, err = s.VerifyDomain(context.Background(), "3")
+		assert.ErrorIs(t, err, ErrInvalidDomain)
+	})
+	t.Run("should verify a domain serving the token at the well known path", func(t *testing.T) {
+		srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != WellKnownPath {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte("_frontier-domain-verification=token\n"))
+		}))
+		defer srv.Close()
+		// the certificate of the test server is valid for example.com
+		dns.records["example.com"] = []string{"v=spf1 -all"}
+		repo.domains["4"] = Domain{ID: "4", Name: "example.com", Token: "_frontier-domain-verification=token", State: Pending}
+		client := srv.Client()
+		transport := client.Transport.(*http.Transport).Clone()
+		transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, srv.Listener.Addr().String())
+		}
+		client.Transport = transport
+
+		_, err := s.VerifyDomain(context.Background(), "4")
+		assert.ErrorIs(t, err, ErrTXTrecordNotFound)
+
+		s.config.HTTPVerification = true
+		s.httpClient = client
+		dmn, err := s.VerifyDomain(context.Background(), "4")
+		assert.NoError(t, err)
+		assert.Equal(t, Verified, dmn.State)
+		s.config.HTTPVerification = false
+	})
+	t.Run("should set domains which lost their record to pending", func(t *testing.T) {
+		delete(dns.records, "_frontier-challenge.raystack.org")
+		repo.domains["5"] = Domain{ID: "5", Name: "gotocompany.com", Token: "other", State: Verified}
+		dns.records["_frontier-challenge.gotocompany.com"] = []string{"other"}
+
+		assert.NoError(t, s.Reverify(context.Background()))
+		assert.Equal(t, Pending, repo.domains["1"].State)
+		assert.Equal(t, Verified, repo.domains["5"].State)
+	})
+}
+
+type memRoles map[string]role.Role
+
+func (r memRoles) Get(ctx context.Context, id string) (role.Role, error) {
+	rl, ok := r[id]
+	if !ok {
+		return role.Role{}, role.ErrNotExist
+	}
+	return rl, nil
+}
+
+func TestService_UpdateAutoJoin(t *testing.T) {
+	repo := &memRepository{domains: map[string]Domain{
+		"1": {ID: "1", OrgID: "org-1", Name: "raystack.org", State: Verified},
+	}}
+	roles := memRoles{
+		"org-viewer": {ID: "org-viewer", OrgID: schema.PlatformOrgID.String(), Scopes: []string{schema.OrganizationNamespace}},
+		"other-org":  {ID: "other-org", OrgID: "org-2", Scopes: []string{schema.OrganizationNamespace}},
+		"project":    {ID: "project", OrgID: schema.PlatformOrgID.String(), Scopes: []string{schema.ProjectNamespace}},
+	}
+	s := NewService(log.NewNoop(), Config{}, repo, nil, nil, nil, nil, roles)
+
+	t.Run("should set an auto join policy with roles of the organization", func(t *testing.T) {
+		dmn, err := s.UpdateAutoJoin(context.Background(), "1", AutoJoin{Enabled: true, RoleIDs: []string{"org-viewer"}})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"org-viewer"}, dmn.AutoJoin.RoleIDs)
+	})
+	t.Run("should not set an auto join policy with roles which can't be assigned on the organization", func(t *testing.T) {
+		for _, roleID := range []string{"missing", "other-org", "project"} {
+			_, err := s.UpdateAutoJoin(context.Background(), "1", AutoJoin{Enabled: true, RoleIDs: []string{roleID}})
+			assert.ErrorIs(t, err, ErrInvalidAutoJoin, roleID)
+		}
+		assert.Equal(t, []string{"org-viewer"}, repo.domains["1"].AutoJoin.RoleIDs)
+	})
+}
+
+func TestChallengeClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+	_, err := newChallengeClient(time.Second).Get(srv.URL)
+	assert.ErrorIs(t, err, errPrivateAddress)
+}
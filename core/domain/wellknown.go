@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// WellKnownPath is where a domain can serve its verification token over https
+// when HTTP verification is enabled
+const WellKnownPath = "/.well-known/frontier-challenge.txt"
+
+// maxChallengeFileSize limits the size of a verification file read
+const maxChallengeFileSize = 4096
+
+var errPrivateAddress = errors.New("verification file can't be fetched from a private address")
+
+// newChallengeClient returns a client to fetch verification files of domains,
+// it doesn't follow redirects and refuses to connect to loopback and private
+// addresses as domain names are chosen by users
+func newChallengeClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+				ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() {
+				return errPrivateAddress
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:               http.ProxyFromEnvironment,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// hasChallengeFile checks if the domain serves its verification token at
+// WellKnownPath. A domain which can't be reached doesn't serve it, an error is
+// only returned if the domain timed out or failed to serve the file
+func (s Service) hasChallengeFile(ctx context.Context, dmn Domain) (bool, error) {
+	fileURL := url.URL{Scheme: "https", Host: dmn.Name, Path: WellKnownPath}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL.String(), nil)
+	if err != nil {
+		return false, nil
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return false, err
+		}
+		return false, nil
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusOK:
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return false, fmt.Errorf("verification file fetch failed with status %d", resp.StatusCode)
+	default:
+		return false, nil
+	}
+
+	scanner := bufio.NewScanner(io.LimitReader(resp.Body, maxChallengeFileSize))
+	for scanner.Scan() {
+		if strings.TrimSpace(scanner.Text()) == strings.TrimSpace(dmn.Token) {
+			return true, nil
+		}
+	}
+	return false, scanner.Err()
+}
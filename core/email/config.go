@@ -0,0 +1,27 @@
+package email
+
+import "time"
+
+type Config struct {
+	// PollInterval is how often the queue is checked for mails to send, queued
+	// mails are sent right away as well
+	PollInterval time.Duration `yaml:"poll_interval" mapstructure:"poll_interval" default:"10s"`
+
+	// RateLimit is how many mails an instance sends per second at most, sending
+	// isn't limited if not set
+	RateLimit int `yaml:"rate_limit" mapstructure:"rate_limit" default:"10"`
+
+	// MaxAttempts is how many times sending a mail is tried before it's failed
+	MaxAttempts int `yaml:"max_attempts" mapstructure:"max_attempts" default:"5"`
+
+	// RetryBackoff is how long to wait before sending a mail again after it
+	// failed, it's doubled on every attempt
+	RetryBackoff time.Duration `yaml:"retry_backoff" mapstructure:"retry_backoff" default:"30s"`
+
+	// Retention is how long sent and failed mails are kept, they're kept
+	// forever if not set
+	Retention time.Duration `yaml:"retention" mapstructure:"retention" default:"168h"`
+
+	// DefaultLocale is the locale of templates without one
+	DefaultLocale string `yaml:"default_locale" mapstructure:"default_locale" default:"en"`
+}
@@ -0,0 +1,11 @@
+package email
+
+import "errors"
+
+var (
+	ErrNotExist         = errors.New("mail doesn't exist")
+	ErrTemplateNotExist = errors.New("mail template doesn't exist")
+	ErrInvalidTemplate  = errors.New("invalid mail template")
+	ErrInvalidDetail    = errors.New("invalid mail detail")
+	ErrInvalidUUID      = errors.New("invalid syntax of uuid")
+)
@@ -0,0 +1,45 @@
+package email
+
+import (
+	"context"
+	"strings"
+)
+
+type localeKey struct{}
+
+// WithLocale sets the locale mails sent in the context are rendered in
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+func LocaleFromContext(ctx context.Context) string {
+	locale, _ := ctx.Value(localeKey{}).(string)
+	return locale
+}
+
+// ParseAcceptLanguage returns the preferred locale of an Accept-Language header
+// value, e.g. "fr-ca" of "fr-CA,fr;q=0.9,en;q=0.8"
+func ParseAcceptLanguage(val string) string {
+	tag := strings.Split(val, ",")[0]
+	tag = strings.TrimSpace(strings.Split(tag, ";")[0])
+	if tag == "*" {
+		return ""
+	}
+	return strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+}
+
+// localeCandidates returns the locales to look templates up in for a locale,
+// from the most to the least specific, e.g. "fr-ca", "fr" and ""
+func localeCandidates(locale string) []string {
+	locale = strings.ToLower(locale)
+	var candidates []string
+	for locale != "" {
+		candidates = append(candidates, locale)
+		i := strings.LastIndex(locale, "-")
+		if i < 0 {
+			break
+		}
+		locale = locale[:i]
+	}
+	return append(candidates, "")
+}
@@ -0,0 +1,51 @@
+package email
+
+import (
+	"context"
+	"time"
+)
+
+type Status string
+
+const (
+	// StatusQueued mails are waiting to be sent, or to be retried
+	StatusQueued Status = "queued"
+	StatusSent   Status = "sent"
+	// StatusFailed mails couldn't be sent within max attempts
+	StatusFailed Status = "failed"
+)
+
+type QueueRepository interface {
+	Create(ctx context.Context, mail Mail) (Mail, error)
+	Get(ctx context.Context, id string) (Mail, error)
+	List(ctx context.Context, flt MailFilter) ([]Mail, error)
+	// Claim returns queued mails due to be sent and counts an attempt of each,
+	// they aren't claimed again until the lease is over
+	Claim(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]Mail, error)
+	Update(ctx context.Context, mail Mail) (Mail, error)
+	// DeleteBefore deletes sent and failed mails last updated before the time
+	DeleteBefore(ctx context.Context, before time.Time) error
+}
+
+// Mail is a rendered mail queued to be sent
+type Mail struct {
+	ID       string
+	Template string
+	OrgID    string
+	Locale   string
+	To       []string
+	Message  Message
+	Status   Status
+	Attempts int
+	// LastError is why the last attempt to send the mail failed
+	LastError     string
+	NextAttemptAt time.Time
+	SentAt        time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type MailFilter struct {
+	Status Status
+	Limit  int
+}
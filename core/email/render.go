@@ -0,0 +1,99 @@
+package email
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+
+	"golang.org/x/net/html"
+)
+
+// Render executes the template with the data
+func (t Template) Render(data map[string]string) (Message, error) {
+	subject, err := renderText(t.Subject, data)
+	if err != nil {
+		return Message{}, err
+	}
+	htmlBody, err := renderHTML(t.HTMLBody, data)
+	if err != nil {
+		return Message{}, err
+	}
+	textBody := htmlToText(htmlBody)
+	if t.TextBody != "" {
+		if textBody, err = renderText(t.TextBody, data); err != nil {
+			return Message{}, err
+		}
+	}
+	return Message{
+		Subject:  subject,
+		HTMLBody: htmlBody,
+		TextBody: textBody,
+	}, nil
+}
+
+func renderHTML(body string, data map[string]string) (string, error) {
+	t, err := htmltemplate.New("body").Parse(body)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err = t.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func renderText(body string, data map[string]string) (string, error) {
+	t, err := texttemplate.New("body").Parse(body)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err = t.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// blockElements start a new line in the plain text of an html body
+var blockElements = map[string]bool{
+	"br": true, "p": true, "div": true, "h1": true, "h2": true, "h3": true,
+	"h4": true, "h5": true, "h6": true, "li": true, "tr": true,
+}
+
+// htmlToText returns the text of an html body, with a line for each block
+func htmlToText(body string) string {
+	var buf strings.Builder
+	tokenizer := html.NewTokenizer(strings.NewReader(body))
+	skip := false
+	for {
+		switch tt := tokenizer.Next(); tt {
+		case html.ErrorToken:
+			return tidyText(buf.String())
+		case html.TextToken:
+			if !skip {
+				buf.Write(tokenizer.Text())
+			}
+		case html.StartTagToken, html.EndTagToken, html.SelfClosingTagToken:
+			name, _ := tokenizer.TagName()
+			switch tag := string(name); {
+			case tag == "style" || tag == "script":
+				skip = tt == html.StartTagToken
+			case blockElements[tag]:
+				buf.WriteString("\n")
+			}
+		}
+	}
+}
+
+// tidyText trims the lines of a text and drops blank lines
+func tidyText(text string) string {
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
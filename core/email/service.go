@@ -0,0 +1,322 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/raystack/frontier/pkg/mailer"
+	"github.com/raystack/salt/log"
+	"gopkg.in/mail.v2"
+)
+
+const (
+	// batchSize is how many mails are claimed at once
+	batchSize = 20
+	// lease is how long a claimed mail isn't claimed again, a mail claimed by
+	// a stopped instance is sent again once its lease is over
+	lease = time.Minute * 5
+)
+
+// Request to send a mail rendered with a template
+type Request struct {
+	// Template is the name of the template to render the mail with
+	Template string
+	// OrgID picks the templates of the org over the ones of the platform
+	OrgID string
+	// Locale picks templates of the locale, it's the locale of the context
+	// if not set
+	Locale string
+	To     []string
+	Data   map[string]string
+	// Default is used if no template of the name is set, a default registered
+	// for the name is used if it's empty
+	Default Template
+}
+
+type Service struct {
+	log       log.Logger
+	config    Config
+	dialer    mailer.Dialer
+	templates TemplateRepository
+	queue     QueueRepository
+	defaults  map[string]Template
+
+	notify chan struct{}
+	stop   chan struct{}
+	wg     *sync.WaitGroup
+	Now    func() time.Time
+}
+
+func NewService(logger log.Logger, config Config, dialer mailer.Dialer,
+	templates TemplateRepository, queue QueueRepository) *Service {
+	return &Service{
+		log:       logger,
+		config:    config,
+		dialer:    dialer,
+		templates: templates,
+		queue:     queue,
+		defaults:  map[string]Template{},
+		notify:    make(chan struct{}, 1),
+		stop:      make(chan struct{}),
+		wg:        &sync.WaitGroup{},
+		Now: func() time.Time {
+			return time.Now().UTC()
+		},
+	}
+}
+
+// RegisterDefault sets the template used if no template of its name is set,
+// it should be called before mails are sent
+func (s Service) RegisterDefault(tmpl Template) {
+	s.defaults[tmpl.Name] = tmpl
+}
+
+// Send renders the mail and queues it to be sent, the mail is sent in
+// background so a failing mail server doesn't fail the caller
+func (s Service) Send(ctx context.Context, req Request) (Mail, error) {
+	if strings.TrimSpace(req.Template) == "" || len(req.To) == 0 {
+		return Mail{}, ErrInvalidDetail
+	}
+	locale := s.locale(ctx, req.Locale)
+	tmpl, err := s.resolve(ctx, req.Template, req.OrgID, locale, req.Default)
+	if err != nil {
+		return Mail{}, err
+	}
+	msg, err := tmpl.Render(req.Data)
+	if err != nil {
+		return Mail{}, fmt.Errorf("%w: %s", ErrInvalidTemplate, err)
+	}
+
+	queued, err := s.queue.Create(ctx, Mail{
+		Template:      req.Template,
+		OrgID:         req.OrgID,
+		Locale:        locale,
+		To:            req.To,
+		Message:       msg,
+		Status:        StatusQueued,
+		NextAttemptAt: s.Now(),
+	})
+	if err != nil {
+		return Mail{}, err
+	}
+	// wake the worker up instead of waiting for the next poll
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+	return queued, nil
+}
+
+// Preview renders the template the org would send mails of the name and locale
+// with, using sample data
+func (s Service) Preview(ctx context.Context, name, orgID, locale string) (Message, error) {
+	tmpl, err := s.resolve(ctx, name, orgID, s.locale(ctx, locale), Template{})
+	if err != nil {
+		return Message{}, err
+	}
+	msg, err := tmpl.Render(SampleData[name])
+	if err != nil {
+		return Message{}, fmt.Errorf("%w: %s", ErrInvalidTemplate, err)
+	}
+	return msg, nil
+}
+
+// SetTemplate creates or replaces the template of an org, or of the platform
+// if the org isn't set
+func (s Service) SetTemplate(ctx context.Context, tmpl Template) (Template, error) {
+	tmpl.Name = strings.TrimSpace(tmpl.Name)
+	tmpl.Locale = strings.ToLower(strings.TrimSpace(tmpl.Locale))
+	if tmpl.Name == "" || strings.TrimSpace(tmpl.Subject) == "" || strings.TrimSpace(tmpl.HTMLBody) == "" {
+		return Template{}, fmt.Errorf("%w: name, subject and html body are required", ErrInvalidTemplate)
+	}
+	// templates are checked with sample data so broken ones are rejected
+	// before a mail is rendered with them
+	if _, err := tmpl.Render(SampleData[tmpl.Name]); err != nil {
+		return Template{}, fmt.Errorf("%w: %s", ErrInvalidTemplate, err)
+	}
+	return s.templates.Set(ctx, tmpl)
+}
+
+// ListTemplates returns templates set for the org, or for the platform if the
+// org isn't set
+func (s Service) ListTemplates(ctx context.Context, orgID string) ([]Template, error) {
+	return s.templates.List(ctx, TemplateFilter{OrgID: orgID})
+}
+
+func (s Service) DeleteTemplate(ctx context.Context, name, orgID, locale string) error {
+	return s.templates.Delete(ctx, name, orgID, strings.ToLower(locale))
+}
+
+func (s Service) GetMail(ctx context.Context, id string) (Mail, error) {
+	return s.queue.Get(ctx, id)
+}
+
+func (s Service) ListMails(ctx context.Context, flt MailFilter) ([]Mail, error) {
+	return s.queue.List(ctx, flt)
+}
+
+func (s Service) locale(ctx context.Context, locale string) string {
+	if locale == "" {
+		locale = LocaleFromContext(ctx)
+	}
+	if locale == "" {
+		locale = s.config.DefaultLocale
+	}
+	return strings.ToLower(locale)
+}
+
+// resolve picks the template to render a mail with, templates of the org are
+// picked over the ones of the platform, and templates of the locale over
+// the ones of its language or of no locale
+func (s Service) resolve(ctx context.Context, name, orgID, locale string, fallback Template) (Template, error) {
+	tmpls, err := s.templates.List(ctx, TemplateFilter{
+		Name:     name,
+		OrgID:    orgID,
+		Platform: true,
+	})
+	if err != nil {
+		return Template{}, err
+	}
+	owners := []string{""}
+	if orgID != "" {
+		owners = []string{orgID, ""}
+	}
+	for _, owner := range owners {
+		for _, candidate := range localeCandidates(locale) {
+			for _, tmpl := range tmpls {
+				if tmpl.OrgID == owner && tmpl.Locale == candidate {
+					return tmpl, nil
+				}
+			}
+		}
+	}
+
+	if fallback.HTMLBody != "" {
+		return fallback, nil
+	}
+	if tmpl, ok := s.defaults[name]; ok {
+		return tmpl, nil
+	}
+	return Template{}, fmt.Errorf("%w: %s", ErrTemplateNotExist, name)
+}
+
+// InitQueue starts the worker which sends queued mails until Close is called,
+// the worker deletes sent and failed mails after retention as well
+func (s Service) InitQueue(ctx context.Context) error {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.work(ctx)
+	}()
+	return nil
+}
+
+func (s Service) Close() {
+	close(s.stop)
+	s.wg.Wait()
+}
+
+func (s Service) work(ctx context.Context) {
+	lastCleanup := time.Time{}
+	for {
+		if _, err := s.ProcessQueue(ctx); err != nil {
+			s.log.Warn("failed to process mail queue", "err", err)
+		}
+		if s.config.Retention > 0 && time.Since(lastCleanup) > time.Hour {
+			if err := s.queue.DeleteBefore(ctx, s.Now().Add(-s.config.Retention)); err != nil {
+				s.log.Warn("failed to delete old mails", "err", err)
+			}
+			lastCleanup = time.Now()
+		}
+
+		select {
+		case <-s.stop:
+			return
+		case <-ctx.Done():
+			return
+		case <-s.notify:
+		case <-time.After(s.config.PollInterval):
+		}
+	}
+}
+
+// ProcessQueue sends queued mails due to be sent, it returns how many of them
+// were sent
+func (s Service) ProcessQueue(ctx context.Context) (int, error) {
+	var interval time.Duration
+	if s.config.RateLimit > 0 {
+		interval = time.Second / time.Duration(s.config.RateLimit)
+	}
+
+	sent := 0
+	for {
+		mails, err := s.queue.Claim(ctx, s.Now(), lease, batchSize)
+		if err != nil {
+			return sent, err
+		}
+		if len(mails) == 0 {
+			return sent, nil
+		}
+		for idx, m := range mails {
+			if idx > 0 && interval > 0 {
+				select {
+				case <-s.stop:
+					// claimed mails are sent again after their lease
+					return sent, nil
+				case <-ctx.Done():
+					return sent, ctx.Err()
+				case <-time.After(interval):
+				}
+			}
+			if s.deliver(ctx, m) {
+				sent++
+			}
+		}
+	}
+}
+
+// deliver sends the mail and saves the result of the attempt, false is
+// returned if it wasn't sent
+func (s Service) deliver(ctx context.Context, m Mail) bool {
+	err := s.dialer.DialAndSend(s.message(m))
+	if err == nil {
+		m.Status = StatusSent
+		m.LastError = ""
+		m.SentAt = s.Now()
+	} else {
+		m.LastError = err.Error()
+		if m.Attempts >= s.config.MaxAttempts {
+			m.Status = StatusFailed
+		} else {
+			m.NextAttemptAt = s.Now().Add(s.backoff(m.Attempts))
+		}
+		s.log.Warn("failed to send mail", "id", m.ID, "attempts", m.Attempts, "err", err)
+	}
+	if _, updateErr := s.queue.Update(ctx, m); updateErr != nil {
+		s.log.Warn("failed to update mail", "id", m.ID, "err", updateErr)
+	}
+	return err == nil
+}
+
+// backoff returns how long to wait before the next attempt after the given
+// number of attempts failed
+func (s Service) backoff(attempts int) time.Duration {
+	wait := s.config.RetryBackoff
+	for i := 1; i < attempts && wait < time.Hour*24; i++ {
+		wait *= 2
+	}
+	return wait
+}
+
+func (s Service) message(m Mail) *mail.Message {
+	msg := mail.NewMessage()
+	msg.SetHeader("From", s.dialer.FromHeader())
+	msg.SetHeader("To", m.To...)
+	msg.SetHeader("Subject", m.Message.Subject)
+	msg.SetBody("text/plain", m.Message.TextBody)
+	msg.AddAlternative("text/html", m.Message.HTMLBody)
+	return msg
+}
@@ -0,0 +1,447 @@
+package email
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/raystack/frontier/pkg/mailer"
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/assert"
+)
+
+type memTemplateRepository struct {
+	templates []Template
+}
+
+func (r *memTemplateRepository) Set(ctx context.Context, tmpl Template) (Template, error) {
+	for i, t := range r.templates {
+		if t.Name == tmpl.Name && t.OrgID == tmpl.OrgID && t.Locale == tmpl.Locale {
+			r.templates[i] = tmpl
+			return tmpl, nil
+		}
+	}
+	r.templates = append(r.templates, tmpl)
+	return tmpl, nil
+}
+
+func (r *memTemplateRepository) List(ctx context.Context, flt TemplateFilter) ([]Template, error) {
+	var tmpls []Template
+	for _, t := range r.templates {
+		if flt.Name != "" && t.Name != flt.Name {
+			continue
+		}
+		if t.OrgID == flt.OrgID || (flt.Platform && t.OrgID == "") {
+			tmpls = append(tmpls, t)
+		}
+	}
+	return tmpls, nil
+}
+
+func (r *memTemplateRepository) Delete(ctx context.Context, name, orgID, locale string) error {
+	for i, t := range r.templates {
+		if t.Name == name && t.OrgID == orgID && t.Locale == locale {
+			r.templates = append(r.templates[:i], r.templates[i+1:]...)
+			return nil
+		}
+	}
+	return ErrTemplateNotExist
+}
+
+type memQueueRepository struct {
+	mails []Mail
+}
+
+func (r *memQueueRepository) Create(ctx context.Context, m Mail) (Mail, error) {
+	m.ID = strconv.Itoa(len(r.mails) + 1)
+	r.mails = append(r.mails, m)
+	return m, nil
+}
+
+func (r *memQueueRepository) Get(ctx context.Context, id string) (Mail, error) {
+	for _, m := range r.mails {
+		if m.ID == id {
+			return m, nil
+		}
+	}
+	return Mail{}, ErrNotExist
+}
+
+func (r *memQueueRepository) List(ctx context.Context, flt MailFilter) ([]Mail, error) {
+	var mails []Mail
+	for _, m := range r.mails {
+		if flt.Status == "" || m.Status == flt.Status {
+			mails = append(mails, m)
+		}
+	}
+	return mails, nil
+}
+
+func (r *memQueueRepository) Claim(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]Mail, error) {
+	var mails []Mail
+	for i, m := range r.mails {
+		if len(mails) == limit {
+			break
+		}
+		if m.Status != StatusQueued || m.NextAttemptAt.After(now) {
+			continue
+		}
+		r.mails[i].Attempts++
+		r.mails[i].NextAttemptAt = now.Add(lease)
+		mails = append(mails, r.mails[i])
+	}
+	return mails, nil
+}
+
+func (r *memQueueRepository) Update(ctx context.Context, m Mail) (Mail, error) {
+	for i := range r.mails {
+		if r.mails[i].ID == m.ID {
+			r.mails[i] = m
+			return m, nil
+		}
+	}
+	return Mail{}, ErrNotExist
+}
+
+func (r *memQueueRepository) DeleteBefore(ctx context.Context, before time.Time) error {
+	return nil
+}
+
+// smtpServer is a local stand-in for a mail server, it rejects mails while
+// failures are left
+type smtpServer struct {
+	listener net.Listener
+	tls      *tls.Config
+
+	mu       sync.Mutex
+	failures int
+	received []string
+}
+
+func newSMTPServer(t *testing.T, failures int) *smtpServer {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	der, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}, &x509.Certificate{SerialNumber: big.NewInt(1)}, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	s := &smtpServer{
+		listener: listener,
+		tls: &tls.Config{Certificates: []tls.Certificate{{
+			Certificate: [][]byte{der},
+			PrivateKey:  key,
+		}}},
+		failures: failures,
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	t.Cleanup(func() {
+		listener.Close()
+	})
+	return s
+}
+
+func (s *smtpServer) dialer() mailer.Dialer {
+	addr := s.listener.Addr().(*net.TCPAddr)
+	return mailer.NewDialerImpl("127.0.0.1", addr.Port, "", "", true, map[string]string{
+		"from": "frontier@raystack.org",
+	})
+}
+
+func (s *smtpServer) messages() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.received...)
+}
+
+func (s *smtpServer) serve(conn net.Conn) {
+	defer conn.Close()
+	rw := bufio.NewReadWriter(bufio.NewReader(conn), bufio.NewWriter(conn))
+	reply := func(format string, args ...any) {
+		fmt.Fprintf(rw, format+"\r\n", args...)
+		rw.Flush()
+	}
+	reply("220 localhost ESMTP")
+	for {
+		line, err := rw.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.TrimSpace(line))
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"):
+			reply("250-localhost\r\n250 STARTTLS")
+		case cmd == "STARTTLS":
+			reply("220 ready to start tls")
+			tlsConn := tls.Server(conn, s.tls)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+			rw = bufio.NewReadWriter(bufio.NewReader(conn), bufio.NewWriter(conn))
+		case strings.HasPrefix(cmd, "MAIL"), strings.HasPrefix(cmd, "RCPT"), cmd == "RSET", cmd == "NOOP":
+			reply("250 ok")
+		case cmd == "DATA":
+			reply("354 go ahead")
+			var data strings.Builder
+			for {
+				line, err := rw.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(line)
+			}
+			s.mu.Lock()
+			if s.failures > 0 {
+				s.failures--
+				s.mu.Unlock()
+				reply("451 try again later")
+				continue
+			}
+			s.received = append(s.received, data.String())
+			s.mu.Unlock()
+			reply("250 queued")
+		case cmd == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func newTestService(dialer mailer.Dialer, config Config) (*Service, *memTemplateRepository, *memQueueRepository) {
+	templates := &memTemplateRepository{}
+	queue := &memQueueRepository{}
+	s := NewService(log.NewNoop(), config, dialer, templates, queue)
+	s.RegisterDefault(Template{
+		Name:     TemplateMailOTP,
+		Subject:  "Your code",
+		HTMLBody: "<p>Use {{.Otp}} to login</p>",
+	})
+	return s, templates, queue
+}
+
+func TestService_Send(t *testing.T) {
+	ctx := context.Background()
+	s, templates, queue := newTestService(nil, Config{DefaultLocale: "en"})
+	for _, tmpl := range []Template{
+		{Name: TemplateInvitation, Subject: "platform", HTMLBody: "<p>platform {{.Organization}}</p>"},
+		{Name: TemplateInvitation, Locale: "fr", Subject: "plateforme", HTMLBody: "<p>plateforme</p>"},
+		{Name: TemplateInvitation, OrgID: "org-1", Subject: "org", HTMLBody: "<p>org</p>"},
+		{Name: TemplateInvitation, OrgID: "org-1", Locale: "de", Subject: "organisation", HTMLBody: "<p>organisation</p>"},
+	} {
+		_, err := templates.Set(ctx, tmpl)
+		assert.NoError(t, err)
+	}
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		req         Request
+		wantSubject string
+		wantErr     error
+	}{
+		{
+			name:        "should pick template of the org in the locale of its language",
+			ctx:         WithLocale(ctx, "de-ch"),
+			req:         Request{Template: TemplateInvitation, OrgID: "org-1", To: []string{"john@raystack.org"}},
+			wantSubject: "organisation",
+		},
+		{
+			name:        "should pick template of the org over the platform of the locale",
+			ctx:         WithLocale(ctx, "fr"),
+			req:         Request{Template: TemplateInvitation, OrgID: "org-1", To: []string{"john@raystack.org"}},
+			wantSubject: "org",
+		},
+		{
+			name:        "should pick template of the platform in the locale",
+			ctx:         ctx,
+			req:         Request{Template: TemplateInvitation, OrgID: "org-2", Locale: "fr-CA", To: []string{"john@raystack.org"}},
+			wantSubject: "plateforme",
+		},
+		{
+			name:        "should pick template of the platform without locale",
+			ctx:         ctx,
+			req:         Request{Template: TemplateInvitation, To: []string{"john@raystack.org"}, Data: map[string]string{"Organization": "Raystack"}},
+			wantSubject: "platform",
+		},
+		{
+			name: "should pick default of the request over the registered default",
+			ctx:  ctx,
+			req: Request{Template: TemplateMailOTP, To: []string{"john@raystack.org"}, Default: Template{
+				Subject:  "Login code",
+				HTMLBody: "{{.Otp}}",
+			}},
+			wantSubject: "Login code",
+		},
+		{
+			name:        "should pick registered default",
+			ctx:         ctx,
+			req:         Request{Template: TemplateMailOTP, To: []string{"john@raystack.org"}},
+			wantSubject: "Your code",
+		},
+		{
+			name:    "should fail if template doesn't exist",
+			ctx:     ctx,
+			req:     Request{Template: "unknown", To: []string{"john@raystack.org"}},
+			wantErr: ErrTemplateNotExist,
+		},
+		{
+			name:    "should fail without recipients",
+			ctx:     ctx,
+			req:     Request{Template: TemplateMailOTP},
+			wantErr: ErrInvalidDetail,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.Send(tt.ctx, tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSubject, got.Message.Subject)
+			assert.Equal(t, StatusQueued, got.Status)
+		})
+	}
+
+	queued, err := queue.Get(ctx, "4")
+	assert.NoError(t, err)
+	assert.Equal(t, "<p>platform Raystack</p>", queued.Message.HTMLBody)
+	assert.Equal(t, "platform Raystack", queued.Message.TextBody)
+	assert.Equal(t, "en", queued.Locale)
+}
+
+func TestService_SetTemplate(t *testing.T) {
+	ctx := context.Background()
+	s, _, _ := newTestService(nil, Config{})
+
+	_, err := s.SetTemplate(ctx, Template{Name: TemplateMailOTP, Subject: "code", HTMLBody: "{{.Otp"})
+	assert.ErrorIs(t, err, ErrInvalidTemplate)
+	_, err = s.SetTemplate(ctx, Template{Name: TemplateMailOTP, HTMLBody: "{{.Otp}}"})
+	assert.ErrorIs(t, err, ErrInvalidTemplate)
+
+	_, err = s.SetTemplate(ctx, Template{
+		Name:     TemplateMailOTP,
+		OrgID:    "org-1",
+		Locale:   "FR",
+		Subject:  "Votre code",
+		HTMLBody: "<style>p {}</style><h1>Bonjour</h1><p>Votre code est <b>{{.Otp}}</b></p>",
+	})
+	assert.NoError(t, err)
+
+	msg, err := s.Preview(ctx, TemplateMailOTP, "org-1", "fr-be")
+	assert.NoError(t, err)
+	assert.Equal(t, "Votre code", msg.Subject)
+	assert.Equal(t, "Bonjour\nVotre code est 7GAPMQ", msg.TextBody)
+
+	msg, err = s.Preview(ctx, TemplateMailOTP, "org-1", "en")
+	assert.NoError(t, err)
+	assert.Equal(t, "Your code", msg.Subject)
+
+	assert.NoError(t, s.DeleteTemplate(ctx, TemplateMailOTP, "org-1", "FR"))
+	assert.ErrorIs(t, s.DeleteTemplate(ctx, TemplateMailOTP, "org-1", "fr"), ErrTemplateNotExist)
+}
+
+func TestService_ProcessQueue(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	t.Run("should retry mails until the mail server accepts them", func(t *testing.T) {
+		server := newSMTPServer(t, 1)
+		s, _, queue := newTestService(server.dialer(), Config{MaxAttempts: 3, RetryBackoff: time.Minute})
+		s.Now = func() time.Time { return now }
+
+		queued, err := s.Send(ctx, Request{Template: TemplateMailOTP, To: []string{"john@raystack.org"}, Data: map[string]string{"Otp": "7GAPMQ"}})
+		assert.NoError(t, err)
+
+		sent, err := s.ProcessQueue(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, sent)
+		got, _ := queue.Get(ctx, queued.ID)
+		assert.Equal(t, StatusQueued, got.Status)
+		assert.Equal(t, 1, got.Attempts)
+		assert.Contains(t, got.LastError, "451")
+		assert.Equal(t, now.Add(time.Minute), got.NextAttemptAt)
+
+		// not retried before the backoff is over
+		sent, err = s.ProcessQueue(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, sent)
+
+		s.Now = func() time.Time { return now.Add(time.Minute) }
+		sent, err = s.ProcessQueue(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, sent)
+		got, _ = queue.Get(ctx, queued.ID)
+		assert.Equal(t, StatusSent, got.Status)
+		assert.Equal(t, 2, got.Attempts)
+		assert.Empty(t, got.LastError)
+
+		messages := server.messages()
+		assert.Len(t, messages, 1)
+		assert.Contains(t, messages[0], "To: john@raystack.org")
+		assert.Contains(t, messages[0], "Subject: Your code")
+		assert.Contains(t, messages[0], "Content-Type: text/plain")
+		assert.Contains(t, messages[0], "Content-Type: text/html")
+		assert.Contains(t, messages[0], "Use 7GAPMQ to login")
+	})
+	t.Run("should fail mails after max attempts", func(t *testing.T) {
+		server := newSMTPServer(t, 2)
+		s, _, queue := newTestService(server.dialer(), Config{MaxAttempts: 2, RetryBackoff: time.Minute})
+		s.Now = func() time.Time { return now }
+
+		queued, err := s.Send(ctx, Request{Template: TemplateMailOTP, To: []string{"john@raystack.org"}})
+		assert.NoError(t, err)
+		_, err = s.ProcessQueue(ctx)
+		assert.NoError(t, err)
+		s.Now = func() time.Time { return now.Add(time.Hour) }
+		_, err = s.ProcessQueue(ctx)
+		assert.NoError(t, err)
+
+		got, _ := queue.Get(ctx, queued.ID)
+		assert.Equal(t, StatusFailed, got.Status)
+		assert.Equal(t, 2, got.Attempts)
+		assert.Empty(t, server.messages())
+	})
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	assert.Equal(t, "fr-ca", ParseAcceptLanguage("fr-CA,fr;q=0.9,en;q=0.8"))
+	assert.Equal(t, "de", ParseAcceptLanguage(" de ; q=1"))
+	assert.Equal(t, "", ParseAcceptLanguage("*"))
+	assert.Equal(t, []string{"pt-br", "pt", ""}, localeCandidates("pt-BR"))
+}
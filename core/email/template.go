@@ -0,0 +1,84 @@
+package email
+
+import (
+	"context"
+	"time"
+)
+
+// names of the templates of mails sent by frontier
+const (
+	TemplateMailOTP            = "mail_otp"
+	TemplateMailLink           = "mail_link"
+	TemplateInvitation         = "invitation"
+	TemplateInvitationReminder = "invitation_reminder"
+	TemplateCredentialExpiry   = "credential_expiry"
+)
+
+// SampleData is the data templates are previewed with, it has the fields each
+// template is rendered with
+var SampleData = map[string]map[string]string{
+	TemplateMailOTP: {
+		"Otp": "7GAPMQ",
+	},
+	TemplateMailLink: {
+		"Link": "https://frontier.example.com/callback?strategy_name=maillink&code=7GAPMQ&state=1",
+	},
+	TemplateInvitation: {
+		"UserID":         "john.doe@raystack.org",
+		"Organization":   "Raystack",
+		"OrganizationID": "2e73f4a2-3763-4dc6-a00e-7a9aebeaa971",
+		"InviteID":       "4e76f4a2-3763-4dc6-b00e-7a9a5beaa923",
+		"ExpiresAt":      "Mon, 02 Jan 2026 15:04:05 UTC",
+	},
+	TemplateInvitationReminder: {
+		"UserID":         "john.doe@raystack.org",
+		"Organization":   "Raystack",
+		"OrganizationID": "2e73f4a2-3763-4dc6-a00e-7a9aebeaa971",
+		"InviteID":       "4e76f4a2-3763-4dc6-b00e-7a9a5beaa923",
+		"ExpiresAt":      "Mon, 02 Jan 2026 15:04:05 UTC",
+	},
+	TemplateCredentialExpiry: {
+		"Title":         "deploy key",
+		"CredentialID":  "2e45f4a2-1539-4df6-a70e-7a9aebeaa952",
+		"ServiceUser":   "ci",
+		"ServiceUserID": "4e76f4a2-3763-4dc6-b00e-7a9a5beaa923",
+		"ExpiresAt":     "Mon, 02 Jan 2026 15:04:05 UTC",
+	},
+}
+
+type TemplateRepository interface {
+	Set(ctx context.Context, tmpl Template) (Template, error)
+	List(ctx context.Context, flt TemplateFilter) ([]Template, error)
+	Delete(ctx context.Context, name, orgID, locale string) error
+}
+
+// Template of a mail, it overrides the default template of its name for an
+// organization, or for all of them if it doesn't belong to one. A template
+// with a locale is only used for mails of that locale.
+type Template struct {
+	Name string
+	// OrgID is empty for a template of the platform
+	OrgID string
+	// Locale is empty for the template used if there's none of the locale
+	Locale   string
+	Subject  string
+	HTMLBody string
+	// TextBody is the plain text part of the mail, it's generated from the
+	// html body if not set
+	TextBody  string
+	UpdatedAt time.Time
+}
+
+type TemplateFilter struct {
+	Name  string
+	OrgID string
+	// Platform lists templates of the platform as well as of the org
+	Platform bool
+}
+
+// Message is a rendered mail
+type Message struct {
+	Subject  string `json:"subject"`
+	HTMLBody string `json:"html_body"`
+	TextBody string `json:"text_body"`
+}
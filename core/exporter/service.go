@@ -0,0 +1,194 @@
+package exporter
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/raystack/frontier/core/audit"
+	"github.com/raystack/frontier/core/authenticate/session"
+	"github.com/raystack/frontier/core/group"
+	"github.com/raystack/frontier/core/identity"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/preference"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/raystack/frontier/pkg/metadata"
+)
+
+type UserService interface {
+	GetByID(ctx context.Context, id string) (user.User, error)
+}
+
+type OrganizationService interface {
+	ListByUser(ctx context.Context, userID string) ([]organization.Organization, error)
+}
+
+type GroupService interface {
+	ListByUser(ctx context.Context, userID string, flt group.Filter) ([]group.Group, error)
+}
+
+type PolicyService interface {
+	List(ctx context.Context, flt policy.Filter) ([]policy.Policy, error)
+}
+
+type SessionService interface {
+	ListByUser(ctx context.Context, userID string) ([]*session.Session, error)
+}
+
+type PreferenceService interface {
+	List(ctx context.Context, flt preference.Filter) ([]preference.Preference, error)
+}
+
+type IdentityService interface {
+	List(ctx context.Context, userID string) ([]identity.Identity, error)
+	ListEmails(ctx context.Context, userID string) ([]identity.Email, error)
+}
+
+type AuditService interface {
+	List(ctx context.Context, flt audit.Filter) ([]audit.Log, error)
+}
+
+// exportedSession leaves out the id of the session as it's a credential
+type exportedSession struct {
+	AuthenticatedAt time.Time
+	ExpiresAt       time.Time
+	CreatedAt       time.Time
+	Metadata        metadata.Metadata
+}
+
+// Service collects what frontier holds about a user into an archive the user
+// can download
+type Service struct {
+	userService       UserService
+	orgService        OrganizationService
+	groupService      GroupService
+	policyService     PolicyService
+	sessionService    SessionService
+	preferenceService PreferenceService
+	identityService   IdentityService
+	auditService      AuditService
+}
+
+func NewService(userService UserService, orgService OrganizationService, groupService GroupService,
+	policyService PolicyService, sessionService SessionService, preferenceService PreferenceService,
+	identityService IdentityService, auditService AuditService) *Service {
+	return &Service{
+		userService:       userService,
+		orgService:        orgService,
+		groupService:      groupService,
+		policyService:     policyService,
+		sessionService:    sessionService,
+		preferenceService: preferenceService,
+		identityService:   identityService,
+		auditService:      auditService,
+	}
+}
+
+// Export builds a zip archive with a json file for each kind of data of the user:
+// profile, memberships, policies, sessions, preferences, linked identities and
+// audit logs where the user is the actor
+func (s Service) Export(ctx context.Context, userID string) ([]byte, error) {
+	usr, err := s.userService.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	files := map[string]any{
+		"profile.json": usr,
+	}
+
+	orgs, err := s.orgService.ListByUser(ctx, usr.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	files["organizations.json"] = orgs
+
+	groups, err := s.groupService.ListByUser(ctx, usr.ID, group.Filter{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %w", err)
+	}
+	files["groups.json"] = groups
+
+	policies, err := s.policyService.List(ctx, policy.Filter{
+		PrincipalID:   usr.ID,
+		PrincipalType: schema.UserPrincipal,
+	})
+	if err != nil && !errors.Is(err, policy.ErrNotExist) {
+		return nil, fmt.Errorf("failed to list policies: %w", err)
+	}
+	files["policies.json"] = policies
+
+	sessions, err := s.sessionService.ListByUser(ctx, usr.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	exportedSessions := make([]exportedSession, 0, len(sessions))
+	for _, sess := range sessions {
+		exportedSessions = append(exportedSessions, exportedSession{
+			AuthenticatedAt: sess.AuthenticatedAt,
+			ExpiresAt:       sess.ExpiresAt,
+			CreatedAt:       sess.CreatedAt,
+			Metadata:        sess.Metadata,
+		})
+	}
+	files["sessions.json"] = exportedSessions
+
+	preferences, err := s.preferenceService.List(ctx, preference.Filter{UserID: usr.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list preferences: %w", err)
+	}
+	files["preferences.json"] = preferences
+
+	identities, err := s.identityService.List(ctx, usr.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list identities: %w", err)
+	}
+	files["identities.json"] = identities
+
+	emails, err := s.identityService.ListEmails(ctx, usr.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list emails: %w", err)
+	}
+	files["emails.json"] = emails
+
+	// audit logs can only be read back if they are kept in the database
+	logs, err := s.auditService.List(ctx, audit.Filter{ActorID: usr.ID})
+	if err != nil && !errors.Is(err, audit.ErrUnsupported) {
+		return nil, fmt.Errorf("failed to list audit logs: %w", err)
+	}
+	files["audit_logs.json"] = logs
+
+	return archive(files)
+}
+
+// archive writes each value as an indented json file of a zip archive
+func archive(files map[string]any) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	zipWriter := zip.NewWriter(buf)
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		w, err := zipWriter.Create(name)
+		if err != nil {
+			return nil, err
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(files[name]); err != nil {
+			return nil, err
+		}
+	}
+	if err := zipWriter.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,13 @@
+package folder
+
+import "errors"
+
+var (
+	ErrNotExist      = errors.New("folder doesn't exist")
+	ErrInvalidUUID   = errors.New("invalid syntax of uuid")
+	ErrInvalidID     = errors.New("folder id is invalid")
+	ErrConflict      = errors.New("folder already exist")
+	ErrInvalidDetail = errors.New("invalid folder detail")
+	ErrCyclicParent  = errors.New("folder can't be moved inside itself")
+	ErrNotEmpty      = errors.New("folder still contains projects or folders")
+)
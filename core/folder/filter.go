@@ -0,0 +1,10 @@
+package folder
+
+type Filter struct {
+	OrgID string
+
+	// ParentID lists folders nested directly under the given folder
+	ParentID string
+	// TopLevel lists folders sitting directly under the organization
+	TopLevel bool
+}
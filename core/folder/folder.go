@@ -0,0 +1,35 @@
+package folder
+
+import (
+	"context"
+	"time"
+
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/raystack/frontier/pkg/metadata"
+)
+
+var OwnerRole = schema.RoleFolderOwner
+
+type Repository interface {
+	GetByID(ctx context.Context, id string) (Folder, error)
+	GetByIDs(ctx context.Context, ids []string) ([]Folder, error)
+	Create(ctx context.Context, folder Folder) (Folder, error)
+	List(ctx context.Context, f Filter) ([]Folder, error)
+	UpdateByID(ctx context.Context, toUpdate Folder) (Folder, error)
+	SetParent(ctx context.Context, id, parentID string) error
+	Delete(ctx context.Context, id string) error
+}
+
+// Folder groups projects and other folders of an organization,
+// permissions granted on a folder are inherited by everything nested under it
+type Folder struct {
+	ID       string
+	Name     string
+	Title    string
+	OrgID    string
+	ParentID string // empty if folder sits directly under the organization
+	Metadata metadata.Metadata
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
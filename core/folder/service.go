@@ -0,0 +1,255 @@
+package folder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+)
+
+type RelationService interface {
+	Create(ctx context.Context, rel relation.Relation) (relation.Relation, error)
+	Delete(ctx context.Context, rel relation.Relation) error
+	LookupResources(ctx context.Context, rel relation.Relation) ([]string, error)
+	GetRelationsByFields(ctx context.Context, rel relation.Relation) ([]relation.Relation, error)
+}
+
+type PolicyService interface {
+	Create(ctx context.Context, policy policy.Policy) (policy.Policy, error)
+}
+
+type AuthnService interface {
+	GetPrincipal(ctx context.Context, via ...authenticate.ClientAssertion) (authenticate.Principal, error)
+}
+
+type Service struct {
+	repository      Repository
+	relationService RelationService
+	policyService   PolicyService
+	authnService    AuthnService
+}
+
+func NewService(repository Repository, relationService RelationService,
+	policyService PolicyService, authnService AuthnService) *Service {
+	return &Service{
+		repository:      repository,
+		relationService: relationService,
+		policyService:   policyService,
+		authnService:    authnService,
+	}
+}
+
+func (s Service) Get(ctx context.Context, id string) (Folder, error) {
+	return s.repository.GetByID(ctx, id)
+}
+
+func (s Service) GetByIDs(ctx context.Context, ids []string) ([]Folder, error) {
+	return s.repository.GetByIDs(ctx, ids)
+}
+
+func (s Service) List(ctx context.Context, f Filter) ([]Folder, error) {
+	return s.repository.List(ctx, f)
+}
+
+// ListByUser returns folders the user can view, including the ones
+// visible because of access on a parent folder or the organization
+func (s Service) ListByUser(ctx context.Context, principalID, principalType string, flt Filter) ([]Folder, error) {
+	folderIDs, err := s.relationService.LookupResources(ctx, relation.Relation{
+		Object: relation.Object{
+			Namespace: schema.FolderNamespace,
+		},
+		Subject: relation.Subject{
+			ID:        principalID,
+			Namespace: principalType,
+		},
+		RelationName: schema.GetPermission,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(folderIDs) == 0 {
+		return []Folder{}, nil
+	}
+
+	folders, err := s.repository.GetByIDs(ctx, folderIDs)
+	if err != nil {
+		return nil, err
+	}
+	var filtered []Folder
+	for _, f := range folders {
+		if flt.OrgID != "" && f.OrgID != flt.OrgID {
+			continue
+		}
+		if flt.ParentID != "" && f.ParentID != flt.ParentID {
+			continue
+		}
+		if flt.TopLevel && f.ParentID != "" {
+			continue
+		}
+		filtered = append(filtered, f)
+	}
+	return filtered, nil
+}
+
+func (s Service) Create(ctx context.Context, f Folder) (Folder, error) {
+	currentPrincipal, err := s.authnService.GetPrincipal(ctx)
+	if err != nil {
+		return Folder{}, err
+	}
+	if f.ParentID != "" {
+		parent, err := s.repository.GetByID(ctx, f.ParentID)
+		if err != nil {
+			return Folder{}, err
+		}
+		if parent.OrgID != f.OrgID {
+			return Folder{}, fmt.Errorf("parent folder belongs to another org: %w", ErrInvalidDetail)
+		}
+	}
+
+	newFolder, err := s.repository.Create(ctx, f)
+	if err != nil {
+		return Folder{}, err
+	}
+
+	if err = s.addFolderToOrg(ctx, newFolder); err != nil {
+		return Folder{}, err
+	}
+	if newFolder.ParentID != "" {
+		if err = s.addFolderToParent(ctx, newFolder.ID, newFolder.ParentID); err != nil {
+			return Folder{}, err
+		}
+	}
+
+	// make user administrator of the folder
+	if _, err = s.policyService.Create(ctx, policy.Policy{
+		RoleID:        OwnerRole,
+		ResourceID:    newFolder.ID,
+		ResourceType:  schema.FolderNamespace,
+		PrincipalID:   currentPrincipal.ID,
+		PrincipalType: currentPrincipal.Type,
+	}); err != nil {
+		return Folder{}, fmt.Errorf("failed to create owner policy for folder %s: %w", newFolder.ID, err)
+	}
+	return newFolder, nil
+}
+
+// Update changes details of the folder, use Move to change its parent
+func (s Service) Update(ctx context.Context, f Folder) (Folder, error) {
+	return s.repository.UpdateByID(ctx, f)
+}
+
+// Move nests the folder under a new parent folder of the same organization,
+// an empty parentID moves it directly under the organization
+func (s Service) Move(ctx context.Context, id, parentID string) (Folder, error) {
+	existing, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return Folder{}, err
+	}
+	if existing.ParentID == parentID {
+		return existing, nil
+	}
+
+	if parentID != "" {
+		// new parent should not be the folder itself or nested inside it
+		for ancestorID := parentID; ancestorID != ""; {
+			if ancestorID == existing.ID {
+				return Folder{}, ErrCyclicParent
+			}
+			ancestor, err := s.repository.GetByID(ctx, ancestorID)
+			if err != nil {
+				return Folder{}, err
+			}
+			if ancestor.OrgID != existing.OrgID {
+				return Folder{}, fmt.Errorf("parent folder belongs to another org: %w", ErrInvalidDetail)
+			}
+			ancestorID = ancestor.ParentID
+		}
+	}
+
+	if err = s.repository.SetParent(ctx, existing.ID, parentID); err != nil {
+		return Folder{}, err
+	}
+	if err = s.relationService.Delete(ctx, relation.Relation{
+		Object: relation.Object{
+			ID:        existing.ID,
+			Namespace: schema.FolderNamespace,
+		},
+		RelationName: schema.ParentRelationName,
+	}); err != nil && !errors.Is(err, relation.ErrNotExist) {
+		return Folder{}, err
+	}
+	if parentID != "" {
+		if err = s.addFolderToParent(ctx, existing.ID, parentID); err != nil {
+			return Folder{}, err
+		}
+	}
+
+	existing.ParentID = parentID
+	return existing, nil
+}
+
+// Delete removes an empty folder, projects and nested folders should be
+// moved out or deleted first
+func (s Service) Delete(ctx context.Context, id string) error {
+	children, err := s.relationService.GetRelationsByFields(ctx, relation.Relation{
+		Subject: relation.Subject{
+			ID:        id,
+			Namespace: schema.FolderNamespace,
+		},
+	})
+	if err != nil && !errors.Is(err, relation.ErrNotExist) {
+		return err
+	}
+	for _, child := range children {
+		if child.RelationName == schema.FolderRelationName || child.RelationName == schema.ParentRelationName {
+			return ErrNotEmpty
+		}
+	}
+	return s.DeleteModel(ctx, id)
+}
+
+// DeleteModel doesn't delete the nested folders and projects, only itself
+func (s Service) DeleteModel(ctx context.Context, id string) error {
+	// delete all relations where folder is an object
+	if err := s.relationService.Delete(ctx, relation.Relation{Object: relation.Object{
+		ID:        id,
+		Namespace: schema.FolderNamespace,
+	}}); err != nil && !errors.Is(err, relation.ErrNotExist) {
+		return err
+	}
+	return s.repository.Delete(ctx, id)
+}
+
+func (s Service) addFolderToOrg(ctx context.Context, f Folder) error {
+	_, err := s.relationService.Create(ctx, relation.Relation{
+		Object: relation.Object{
+			ID:        f.ID,
+			Namespace: schema.FolderNamespace,
+		},
+		Subject: relation.Subject{
+			ID:        f.OrgID,
+			Namespace: schema.OrganizationNamespace,
+		},
+		RelationName: schema.OrganizationRelationName,
+	})
+	return err
+}
+
+func (s Service) addFolderToParent(ctx context.Context, id, parentID string) error {
+	_, err := s.relationService.Create(ctx, relation.Relation{
+		Object: relation.Object{
+			ID:        id,
+			Namespace: schema.FolderNamespace,
+		},
+		Subject: relation.Subject{
+			ID:        parentID,
+			Namespace: schema.FolderNamespace,
+		},
+		RelationName: schema.ParentRelationName,
+	})
+	return err
+}
@@ -0,0 +1,253 @@
+package folder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+const testOrgID = "9f256f86-31a3-11ec-8d3d-0242ac130003"
+
+type memRepository struct {
+	folders map[string]Folder
+}
+
+func (r *memRepository) GetByID(ctx context.Context, id string) (Folder, error) {
+	f, ok := r.folders[id]
+	if !ok {
+		return Folder{}, ErrNotExist
+	}
+	return f, nil
+}
+
+func (r *memRepository) GetByIDs(ctx context.Context, ids []string) ([]Folder, error) {
+	var folders []Folder
+	for _, id := range ids {
+		if f, ok := r.folders[id]; ok {
+			folders = append(folders, f)
+		}
+	}
+	return folders, nil
+}
+
+func (r *memRepository) Create(ctx context.Context, f Folder) (Folder, error) {
+	f.ID = uuid.NewString()
+	r.folders[f.ID] = f
+	return f, nil
+}
+
+func (r *memRepository) List(ctx context.Context, flt Filter) ([]Folder, error) {
+	var folders []Folder
+	for _, f := range r.folders {
+		if flt.OrgID == "" || f.OrgID == flt.OrgID {
+			folders = append(folders, f)
+		}
+	}
+	return folders, nil
+}
+
+func (r *memRepository) UpdateByID(ctx context.Context, f Folder) (Folder, error) {
+	existing, ok := r.folders[f.ID]
+	if !ok {
+		return Folder{}, ErrNotExist
+	}
+	existing.Name, existing.Title, existing.Metadata = f.Name, f.Title, f.Metadata
+	r.folders[f.ID] = existing
+	return existing, nil
+}
+
+func (r *memRepository) SetParent(ctx context.Context, id, parentID string) error {
+	f, ok := r.folders[id]
+	if !ok {
+		return ErrNotExist
+	}
+	f.ParentID = parentID
+	r.folders[id] = f
+	return nil
+}
+
+func (r *memRepository) Delete(ctx context.Context, id string) error {
+	delete(r.folders, id)
+	return nil
+}
+
+// memRelationService matches relations on the fields set in the filter
+type memRelationService struct {
+	rels []relation.Relation
+}
+
+func (s *memRelationService) Create(ctx context.Context, rel relation.Relation) (relation.Relation, error) {
+	s.rels = append(s.rels, rel)
+	return rel, nil
+}
+
+func (s *memRelationService) Delete(ctx context.Context, rel relation.Relation) error {
+	var kept []relation.Relation
+	for _, r := range s.rels {
+		if !matches(r, rel) {
+			kept = append(kept, r)
+		}
+	}
+	s.rels = kept
+	return nil
+}
+
+func (s *memRelationService) LookupResources(ctx context.Context, rel relation.Relation) ([]string, error) {
+	return nil, nil
+}
+
+func (s *memRelationService) GetRelationsByFields(ctx context.Context, rel relation.Relation) ([]relation.Relation, error) {
+	var rels []relation.Relation
+	for _, r := range s.rels {
+		if matches(r, rel) {
+			rels = append(rels, r)
+		}
+	}
+	return rels, nil
+}
+
+// parentOf returns the folder bound as parent of the given folder
+func (s *memRelationService) parentOf(id string) []string {
+	var parents []string
+	for _, r := range s.rels {
+		if r.Object.ID == id && r.RelationName == schema.ParentRelationName {
+			parents = append(parents, r.Subject.ID)
+		}
+	}
+	return parents
+}
+
+func matches(r, flt relation.Relation) bool {
+	return (flt.Object.ID == "" || r.Object.ID == flt.Object.ID) &&
+		(flt.Object.Namespace == "" || r.Object.Namespace == flt.Object.Namespace) &&
+		(flt.Subject.ID == "" || r.Subject.ID == flt.Subject.ID) &&
+		(flt.Subject.Namespace == "" || r.Subject.Namespace == flt.Subject.Namespace) &&
+		(flt.RelationName == "" || r.RelationName == flt.RelationName)
+}
+
+type memPolicyService struct {
+	policies []policy.Policy
+}
+
+func (s *memPolicyService) Create(ctx context.Context, pol policy.Policy) (policy.Policy, error) {
+	s.policies = append(s.policies, pol)
+	return pol, nil
+}
+
+type memAuthnService struct{}
+
+func (memAuthnService) GetPrincipal(ctx context.Context, via ...authenticate.ClientAssertion) (authenticate.Principal, error) {
+	return authenticate.Principal{ID: "user-1", Type: schema.UserPrincipal}, nil
+}
+
+func newTestService() (*Service, *memRepository, *memRelationService, *memPolicyService) {
+	repo := &memRepository{folders: map[string]Folder{}}
+	relations := &memRelationService{}
+	policies := &memPolicyService{}
+	return NewService(repo, relations, policies, memAuthnService{}), repo, relations, policies
+}
+
+func TestService_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should bind folder to org and parent and make creator the owner", func(t *testing.T) {
+		svc, _, relations, policies := newTestService()
+		parent, err := svc.Create(ctx, Folder{Name: "finance", OrgID: testOrgID})
+		assert.NoError(t, err)
+		child, err := svc.Create(ctx, Folder{Name: "payroll", OrgID: testOrgID, ParentID: parent.ID})
+		assert.NoError(t, err)
+
+		orgRels, err := relations.GetRelationsByFields(ctx, relation.Relation{
+			Object:       relation.Object{ID: child.ID},
+			RelationName: schema.OrganizationRelationName,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, orgRels, 1)
+		assert.Equal(t, []string{parent.ID}, relations.parentOf(child.ID))
+		assert.Len(t, policies.policies, 2)
+		assert.Equal(t, OwnerRole, policies.policies[1].RoleID)
+		assert.Equal(t, child.ID, policies.policies[1].ResourceID)
+	})
+	t.Run("should fail if parent folder belongs to another org", func(t *testing.T) {
+		svc, _, _, _ := newTestService()
+		parent, err := svc.Create(ctx, Folder{Name: "finance", OrgID: uuid.NewString()})
+		assert.NoError(t, err)
+		_, err = svc.Create(ctx, Folder{Name: "payroll", OrgID: testOrgID, ParentID: parent.ID})
+		assert.ErrorIs(t, err, ErrInvalidDetail)
+	})
+	t.Run("should fail if parent folder doesn't exist", func(t *testing.T) {
+		svc, _, _, _ := newTestService()
+		_, err := svc.Create(ctx, Folder{Name: "payroll", OrgID: testOrgID, ParentID: uuid.NewString()})
+		assert.ErrorIs(t, err, ErrNotExist)
+	})
+}
+
+func TestService_Move(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should replace the parent relation of the folder", func(t *testing.T) {
+		svc, repo, relations, _ := newTestService()
+		a, err := svc.Create(ctx, Folder{Name: "a", OrgID: testOrgID})
+		assert.NoError(t, err)
+		b, err := svc.Create(ctx, Folder{Name: "b", OrgID: testOrgID})
+		assert.NoError(t, err)
+		c, err := svc.Create(ctx, Folder{Name: "c", OrgID: testOrgID, ParentID: a.ID})
+		assert.NoError(t, err)
+
+		moved, err := svc.Move(ctx, c.ID, b.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, b.ID, moved.ParentID)
+		assert.Equal(t, b.ID, repo.folders[c.ID].ParentID)
+		assert.Equal(t, []string{b.ID}, relations.parentOf(c.ID))
+
+		moved, err = svc.Move(ctx, c.ID, "")
+		assert.NoError(t, err)
+		assert.Empty(t, moved.ParentID)
+		assert.Empty(t, relations.parentOf(c.ID))
+	})
+	t.Run("should fail if folder is moved inside itself", func(t *testing.T) {
+		svc, _, _, _ := newTestService()
+		a, err := svc.Create(ctx, Folder{Name: "a", OrgID: testOrgID})
+		assert.NoError(t, err)
+		b, err := svc.Create(ctx, Folder{Name: "b", OrgID: testOrgID, ParentID: a.ID})
+		assert.NoError(t, err)
+
+		_, err = svc.Move(ctx, a.ID, b.ID)
+		assert.ErrorIs(t, err, ErrCyclicParent)
+		_, err = svc.Move(ctx, a.ID, a.ID)
+		assert.ErrorIs(t, err, ErrCyclicParent)
+	})
+	t.Run("should fail if new parent belongs to another org", func(t *testing.T) {
+		svc, _, _, _ := newTestService()
+		a, err := svc.Create(ctx, Folder{Name: "a", OrgID: testOrgID})
+		assert.NoError(t, err)
+		other, err := svc.Create(ctx, Folder{Name: "other", OrgID: uuid.NewString()})
+		assert.NoError(t, err)
+
+		_, err = svc.Move(ctx, a.ID, other.ID)
+		assert.ErrorIs(t, err, ErrInvalidDetail)
+	})
+}
+
+func TestService_Delete(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, relations, _ := newTestService()
+	a, err := svc.Create(ctx, Folder{Name: "a", OrgID: testOrgID})
+	assert.NoError(t, err)
+	b, err := svc.Create(ctx, Folder{Name: "b", OrgID: testOrgID, ParentID: a.ID})
+	assert.NoError(t, err)
+
+	assert.ErrorIs(t, svc.Delete(ctx, a.ID), ErrNotEmpty)
+	assert.Contains(t, repo.folders, a.ID)
+
+	assert.NoError(t, svc.Delete(ctx, b.ID))
+	assert.NoError(t, svc.Delete(ctx, a.ID))
+	assert.Empty(t, repo.folders)
+	assert.Empty(t, relations.rels)
+}
@@ -11,4 +11,5 @@ var (
 	ErrListingGroupRelations = errors.New("error while listing relations")
 	ErrFetchingUsers         = errors.New("error while fetching users")
 	ErrFetchingGroups        = errors.New("error while fetching groups")
+	ErrLastOwner             = errors.New("group must have at least one owner")
 )
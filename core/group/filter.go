@@ -1,8 +1,13 @@
 package group
 
+import "github.com/raystack/frontier/pkg/pagination"
+
 type Filter struct {
 	// only one filter gets applied at a time
 
 	OrganizationID string
 	State          State
+
+	// Pagination is optional, all items are listed if not set
+	Pagination *pagination.Pagination
 }
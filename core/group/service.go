@@ -11,6 +11,7 @@ import (
 	"github.com/raystack/frontier/core/authenticate"
 
 	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/raystack/frontier/pkg/utils"
 
 	"github.com/raystack/frontier/core/relation"
 )
@@ -276,6 +277,10 @@ func (s Service) AddUsers(ctx context.Context, groupID string, userIDs []string)
 
 // RemoveUsers removes users from a group as members
 func (s Service) RemoveUsers(ctx context.Context, groupID string, userIDs []string) error {
+	if err := s.CheckOwnersLeft(ctx, groupID, userIDs); err != nil {
+		return err
+	}
+
 	var err error
 	for _, userID := range userIDs {
 		// remove all access via policies
@@ -328,3 +333,83 @@ func (s Service) Delete(ctx context.Context, id string) error {
 
 	return s.repository.Delete(ctx, id)
 }
+
+// owner is a grant of group ownership to a user, either the owner relation or a
+// policy of the owner role in which case policyID is set
+type owner struct {
+	userID   string
+	policyID string
+}
+
+func (s Service) listOwners(ctx context.Context, groupID string) ([]owner, error) {
+	userIDs, err := s.relationService.LookupSubjects(ctx, relation.Relation{
+		Object: relation.Object{
+			ID:        groupID,
+			Namespace: schema.GroupNamespace,
+		},
+		Subject: relation.Subject{
+			Namespace: schema.UserPrincipal,
+		},
+		RelationName: schema.OwnerRelationName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	owners := utils.Map(userIDs, func(id string) owner {
+		return owner{userID: id}
+	})
+
+	policies, err := s.policyService.List(ctx, policy.Filter{
+		GroupID:       groupID,
+		RoleID:        schema.GroupOwnerRole,
+		PrincipalType: schema.UserPrincipal,
+	})
+	if err != nil && !errors.Is(err, policy.ErrNotExist) {
+		return nil, err
+	}
+	for _, pol := range policies {
+		owners = append(owners, owner{userID: pol.PrincipalID, policyID: pol.ID})
+	}
+	return owners, nil
+}
+
+// ensureOwnerLeft fails if a group with owners is left with none once the removed
+// grants are gone
+func ensureOwnerLeft(owners []owner, removed func(o owner) bool) error {
+	if len(owners) == 0 {
+		return nil
+	}
+	for _, o := range owners {
+		if !removed(o) {
+			return nil
+		}
+	}
+	return ErrLastOwner
+}
+
+// CheckOwnersLeft returns ErrLastOwner if removing the users leaves the group
+// without an owner
+func (s Service) CheckOwnersLeft(ctx context.Context, groupID string, userIDs []string) error {
+	owners, err := s.listOwners(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	return ensureOwnerLeft(owners, func(o owner) bool {
+		return utils.Contains(userIDs, o.userID)
+	})
+}
+
+// CheckPolicyDelete returns ErrLastOwner if deleting the policy leaves its group
+// without an owner
+func (s Service) CheckPolicyDelete(ctx context.Context, pol policy.Policy) error {
+	if pol.ResourceType != schema.GroupNamespace {
+		return nil
+	}
+	owners, err := s.listOwners(ctx, pol.ResourceID)
+	if err != nil {
+		return err
+	}
+	return ensureOwnerLeft(owners, func(o owner) bool {
+		return o.policyID == pol.ID
+	})
+}
@@ -0,0 +1,12 @@
+package identity
+
+import "errors"
+
+var (
+	ErrNotExist      = errors.New("identity doesn't exist")
+	ErrConflict      = errors.New("identity is linked to another user")
+	ErrInvalidDetail = errors.New("invalid identity detail")
+
+	ErrEmailNotExist = errors.New("email doesn't exist")
+	ErrEmailConflict = errors.New("email is used by another user")
+)
@@ -0,0 +1,41 @@
+package identity
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	Create(ctx context.Context, identity Identity) (Identity, error)
+	GetBySubject(ctx context.Context, provider, subject string) (Identity, error)
+	List(ctx context.Context, userID string) ([]Identity, error)
+	Delete(ctx context.Context, userID, id string) error
+}
+
+type EmailRepository interface {
+	Create(ctx context.Context, email Email) (Email, error)
+	Get(ctx context.Context, email string) (Email, error)
+	List(ctx context.Context, userID string) ([]Email, error)
+	Delete(ctx context.Context, userID, email string) error
+}
+
+// Identity links a user to the account of an external identity provider, the
+// account is matched by the stable subject id the provider assigns to it
+type Identity struct {
+	ID       string
+	UserID   string
+	Provider string
+	Subject  string
+	// Email is the email of the account at the provider when it was last used
+	Email     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Email is a verified secondary email of a user, it can be used to login in
+// place of the primary email of the user
+type Email struct {
+	UserID    string
+	Email     string
+	CreatedAt time.Time
+}
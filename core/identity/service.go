@@ -0,0 +1,84 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+type Service struct {
+	repository      Repository
+	emailRepository EmailRepository
+}
+
+func NewService(repository Repository, emailRepository EmailRepository) *Service {
+	return &Service{
+		repository:      repository,
+		emailRepository: emailRepository,
+	}
+}
+
+func (s Service) GetBySubject(ctx context.Context, provider, subject string) (Identity, error) {
+	return s.repository.GetBySubject(ctx, provider, subject)
+}
+
+func (s Service) List(ctx context.Context, userID string) ([]Identity, error) {
+	return s.repository.List(ctx, userID)
+}
+
+// Link links the account of a provider to the user, ErrConflict is returned if
+// the account is linked to another user. Linking an account again refreshes
+// the email it was last seen with
+func (s Service) Link(ctx context.Context, identity Identity) (Identity, error) {
+	if identity.UserID == "" || identity.Provider == "" || identity.Subject == "" {
+		return Identity{}, ErrInvalidDetail
+	}
+	identity.Email = strings.ToLower(identity.Email)
+	existing, err := s.repository.GetBySubject(ctx, identity.Provider, identity.Subject)
+	if err == nil && existing.UserID != identity.UserID {
+		return Identity{}, ErrConflict
+	} else if err != nil && !errors.Is(err, ErrNotExist) {
+		return Identity{}, err
+	}
+	return s.repository.Create(ctx, identity)
+}
+
+// HasProvider checks if the user has linked an account of the provider
+func (s Service) HasProvider(ctx context.Context, userID, provider string) (bool, error) {
+	identities, err := s.repository.List(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	for _, identity := range identities {
+		if identity.Provider == provider {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s Service) Unlink(ctx context.Context, userID, id string) error {
+	return s.repository.Delete(ctx, userID, id)
+}
+
+// GetEmail returns the secondary email and the user it belongs to
+func (s Service) GetEmail(ctx context.Context, email string) (Email, error) {
+	return s.emailRepository.Get(ctx, strings.ToLower(email))
+}
+
+func (s Service) ListEmails(ctx context.Context, userID string) ([]Email, error) {
+	return s.emailRepository.List(ctx, userID)
+}
+
+// AddEmail adds a secondary email to the user, callers are expected to have
+// verified the email and checked it's not the primary email of a user
+func (s Service) AddEmail(ctx context.Context, userID, email string) (Email, error) {
+	return s.emailRepository.Create(ctx, Email{
+		UserID: userID,
+		Email:  strings.ToLower(strings.TrimSpace(email)),
+	})
+}
+
+func (s Service) RemoveEmail(ctx context.Context, userID, email string) error {
+	return s.emailRepository.Delete(ctx, userID, strings.ToLower(email))
+}
@@ -1,14 +1,37 @@
 package invitation
 
+import "time"
+
 type Config struct {
 	// WithRoles if set to true will allow roles to be passed in invitation, when the user accepts the
 	// invite, the role will be assigned to the user
 	WithRoles bool `yaml:"with_roles" mapstructure:"with_roles" default:"false"`
 
 	MailTemplate MailTemplateConfig `yaml:"mail_template" mapstructure:"mail_template"`
+
+	// ResendInterval is how long to wait before a pending invitation can be sent again
+	ResendInterval time.Duration `yaml:"resend_interval" mapstructure:"resend_interval" default:"10m"`
+
+	// MaxResends is how many times a pending invitation can be sent again, resends
+	// aren't limited if not set
+	MaxResends int `yaml:"max_resends" mapstructure:"max_resends" default:"5"`
+
+	// ReminderBefore is how long before a pending invitation expires the user is
+	// reminded of it, no reminders are sent if not set
+	ReminderBefore time.Duration `yaml:"reminder_before" mapstructure:"reminder_before" default:"48h"`
+
+	ReminderMailTemplate ReminderMailTemplateConfig `yaml:"reminder_mail_template" mapstructure:"reminder_mail_template"`
+
+	// LinkMaxLifetime is the longest lifetime an invite link can be created with
+	LinkMaxLifetime time.Duration `yaml:"link_max_lifetime" mapstructure:"link_max_lifetime" default:"720h"`
 }
 
 type MailTemplateConfig struct {
 	Subject string `yaml:"subject" mapstructure:"subject" default:"You have been invited to join an organization"`
 	Body    string `yaml:"body" mapstructure:"body" default:"<div>Hi {{.UserID}},</div><br><p>You have been invited to join an organization: {{.Organization}}. Login to your account to accept the invitation.</p><br><div>Thanks,<br>Team Frontier</div>"`
 }
+
+type ReminderMailTemplateConfig struct {
+	Subject string `yaml:"subject" mapstructure:"subject" default:"Your invitation to join an organization is about to expire"`
+	Body    string `yaml:"body" mapstructure:"body" default:"<div>Hi {{.UserID}},</div><br><p>Your invitation to join the organization {{.Organization}} expires at {{.ExpiresAt}}. Login to your account to accept the invitation.</p><br><div>Thanks,<br>Team Frontier</div>"`
+}
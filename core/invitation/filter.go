@@ -1,6 +1,18 @@
 package invitation
 
+import (
+	"time"
+
+	"github.com/raystack/frontier/pkg/pagination"
+)
+
 type Filter struct {
 	OrgID  string
 	UserID string
+	Status Status
+	// ExpiresBefore lists invitations which expire before the time
+	ExpiresBefore time.Time
+
+	// Pagination is optional, all items are listed if not set
+	Pagination *pagination.Pagination
 }
@@ -9,13 +9,34 @@ import (
 )
 
 var (
-	ErrNotFound = errors.New("invitation not found")
+	ErrNotFound    = errors.New("invitation not found")
+	ErrExpired     = errors.New("invitation has expired")
+	ErrNotPending  = errors.New("invitation is not pending")
+	ErrResendLimit = errors.New("invitation can't be resent yet")
+
+	ErrLinkNotFound      = errors.New("invite link not found")
+	ErrInvalidLink       = errors.New("invite link is invalid or expired")
+	ErrInvalidLinkDetail = errors.New("invalid invite link detail")
+	ErrLinkExhausted     = errors.New("invite link reached its max uses")
+	ErrDomainNotAllowed  = errors.New("email domain isn't allowed by the invite link")
 )
 
 const (
 	DefaultExpiryDuration = 24 * time.Hour * 7
 )
 
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusAccepted Status = "accepted"
+	StatusDeclined Status = "declined"
+	StatusRevoked  Status = "revoked"
+	// StatusExpired isn't stored, a pending invitation is expired once its
+	// expiry passes until the cleanup job deletes it
+	StatusExpired Status = "expired"
+)
+
 type Invitation struct {
 	ID        uuid.UUID
 	UserID    string
@@ -25,4 +46,25 @@ type Invitation struct {
 	Metadata  metadata.Metadata
 	CreatedAt time.Time
 	ExpiresAt time.Time
+
+	Status    Status
+	UpdatedAt time.Time
+	// SentAt is when the invitation mail was last sent
+	SentAt      time.Time
+	ResendCount int
+	// RemindedAt is when the user was reminded of the invitation before it expires
+	RemindedAt time.Time
+}
+
+// IsExpired returns true if the invitation can no longer be accepted
+func (i Invitation) IsExpired(now time.Time) bool {
+	return !i.ExpiresAt.IsZero() && !now.Before(i.ExpiresAt)
+}
+
+// withStatus sets the status of a pending invitation to expired if it is
+func (i Invitation) withStatus(now time.Time) Invitation {
+	if i.Status == StatusPending && i.IsExpired(now) {
+		i.Status = StatusExpired
+	}
+	return i
 }
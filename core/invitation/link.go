@@ -0,0 +1,72 @@
+package invitation
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// LinkPrefix marks invite link tokens apart from other tokens
+const LinkPrefix = "fil_"
+
+type LinkRepository interface {
+	Create(ctx context.Context, link Link) (Link, error)
+	List(ctx context.Context, orgID string) ([]Link, error)
+	Get(ctx context.Context, id string) (Link, error)
+	GetBySecretHash(ctx context.Context, secretHash string) (Link, error)
+	Delete(ctx context.Context, id string) error
+	// Use reserves a use of the link and returns it with the use counted,
+	// ErrLinkExhausted is returned once it reached its max uses
+	Use(ctx context.Context, id string) (Link, error)
+	// Release gives back a use reserved with Use
+	Release(ctx context.Context, id string) error
+}
+
+// Link lets any user holding its token join an organization, with the groups
+// and roles of the link, until it expires or reaches its max uses
+type Link struct {
+	ID       string
+	OrgID    string
+	GroupIDs []string
+	RoleIDs  []string
+	// Domains restricts the link to users with an email of one of the domains
+	Domains []string
+	// MaxUses is how many users can join with the link, not limited if not set
+	MaxUses   int
+	Uses      int
+	CreatedBy string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	// SecretHash is the sha256 hash of the token, the token itself is only
+	// returned once when it's created
+	SecretHash string
+
+	// Value is the token, only set when it's created
+	Value string
+}
+
+func (l Link) IsExpired(now time.Time) bool {
+	return !l.ExpiresAt.After(now)
+}
+
+func (l Link) IsExhausted() bool {
+	return l.MaxUses > 0 && l.Uses >= l.MaxUses
+}
+
+// AllowsEmail returns true if a user with the email can join with the link
+func (l Link) AllowsEmail(email string) bool {
+	if len(l.Domains) == 0 {
+		return true
+	}
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	emailDomain := strings.ToLower(email[at+1:])
+	for _, domain := range l.Domains {
+		if strings.ToLower(domain) == emailDomain {
+			return true
+		}
+	}
+	return false
+}
@@ -1,30 +1,37 @@
 package invitation
 
 import (
-	"bytes"
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"errors"
 	"fmt"
-	"html/template"
+	"strings"
+	"time"
 
 	"github.com/raystack/frontier/pkg/logger"
+	"github.com/robfig/cron/v3"
 	"go.uber.org/zap"
 
-	"github.com/mcuadros/go-defaults"
 	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/email"
 	"github.com/raystack/frontier/core/policy"
 	"github.com/raystack/frontier/core/preference"
-	"gopkg.in/mail.v2"
 
-	"github.com/raystack/frontier/pkg/mailer"
 	"github.com/raystack/frontier/pkg/str"
 
 	"github.com/google/uuid"
 	"github.com/raystack/frontier/core/group"
 	"github.com/raystack/frontier/core/organization"
 	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/role"
 	"github.com/raystack/frontier/core/user"
 	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/raystack/frontier/pkg/utils"
+)
+
+const (
+	expirySchedule = "15 * * * *" // every hour
 )
 
 type Repository interface {
@@ -60,52 +67,93 @@ type PolicyService interface {
 	Create(ctx context.Context, policy policy.Policy) (policy.Policy, error)
 }
 
+type MailService interface {
+	Send(ctx context.Context, req email.Request) (email.Mail, error)
+}
+
+type RoleService interface {
+	Get(ctx context.Context, id string) (role.Role, error)
+}
+
 type PreferencesService interface {
 	LoadPlatformPreferences(ctx context.Context) (map[string]string, error)
 }
 
 type Service struct {
-	dialer          mailer.Dialer
+	config          Config
+	mailService     MailService
 	repo            Repository
+	linkRepo        LinkRepository
 	orgSvc          OrganizationService
 	groupSvc        GroupService
 	userService     UserService
 	relationService RelationService
 	policyService   PolicyService
+	roleService     RoleService
 	prefService     PreferencesService
+	cron            *cron.Cron
+	Now             func() time.Time
 }
 
-func NewService(dialer mailer.Dialer, repo Repository,
+func NewService(config Config, mailService MailService, repo Repository, linkRepo LinkRepository,
 	orgSvc OrganizationService, grpSvc GroupService,
 	userService UserService, relService RelationService,
-	policyService PolicyService, prefService PreferencesService) *Service {
+	policyService PolicyService, roleService RoleService, prefService PreferencesService) *Service {
 	return &Service{
-		dialer:          dialer,
+		config:          config,
+		mailService:     mailService,
 		repo:            repo,
+		linkRepo:        linkRepo,
 		orgSvc:          orgSvc,
 		groupSvc:        grpSvc,
 		userService:     userService,
 		relationService: relService,
 		policyService:   policyService,
+		roleService:     roleService,
 		prefService:     prefService,
+		cron:            cron.New(),
+		Now: func() time.Time {
+			return time.Now().UTC()
+		},
 	}
 }
 
 func (s Service) List(ctx context.Context, flt Filter) ([]Invitation, error) {
-	return s.repo.List(ctx, flt)
+	invites, err := s.repo.List(ctx, flt)
+	if err != nil {
+		return nil, err
+	}
+	for i := range invites {
+		invites[i] = invites[i].withStatus(s.Now())
+	}
+	return invites, nil
 }
 
+// ListByUser returns the invitations a user can still accept
 func (s Service) ListByUser(ctx context.Context, id string) ([]Invitation, error) {
-	return s.repo.ListByUser(ctx, id)
+	invites, err := s.repo.ListByUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	var pending []Invitation
+	for _, invite := range invites {
+		if invite = invite.withStatus(s.Now()); invite.Status == StatusPending {
+			pending = append(pending, invite)
+		}
+	}
+	return pending, nil
 }
 
 func (s Service) Get(ctx context.Context, id uuid.UUID) (Invitation, error) {
-	return s.repo.Get(ctx, id)
+	invite, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return Invitation{}, err
+	}
+	return invite.withStatus(s.Now()), nil
 }
 
 func (s Service) getConfig(ctx context.Context) *Config {
-	c := &Config{}
-	defaults.SetDefaults(c)
+	c := s.config
 	prefs, err := s.prefService.LoadPlatformPreferences(ctx)
 	if err != nil {
 		logger.Ctx(ctx).Error("failed to load platform preferences for invitation", zap.Error(err))
@@ -114,7 +162,7 @@ func (s Service) getConfig(ctx context.Context) *Config {
 	c.WithRoles = prefs[preference.PlatformInviteWithRoles] == "true"
 	c.MailTemplate.Subject = prefs[preference.PlatformInviteMailSubject]
 	c.MailTemplate.Body = prefs[preference.PlatformInviteMailBody]
-	return c
+	return &c
 }
 
 func (s Service) Create(ctx context.Context, invitation Invitation) (Invitation, error) {
@@ -144,6 +192,24 @@ func (s Service) Create(ctx context.Context, invitation Invitation) (Invitation,
 		return invitation, fmt.Errorf("user %s is already a member of organization %s", invitation.UserID, invitation.OrgID)
 	}
 
+	// a pending invitation of the user is sent again instead of inviting them twice
+	pendingInvites, err := s.repo.List(ctx, Filter{
+		OrgID:  org.ID,
+		UserID: invitation.UserID,
+		Status: StatusPending,
+	})
+	if err != nil {
+		return Invitation{}, err
+	}
+	if len(pendingInvites) > 0 {
+		invite := pendingInvites[0]
+		invite.GroupIDs = invitation.GroupIDs
+		invite.RoleIDs = invitation.RoleIDs
+		return s.resend(ctx, conf, org, invite)
+	}
+
+	invitation.Status = StatusPending
+	invitation.SentAt = s.Now()
 	invitation, err = s.repo.Set(ctx, invitation)
 	if err != nil {
 		return Invitation{}, err
@@ -155,30 +221,61 @@ func (s Service) Create(ctx context.Context, invitation Invitation) (Invitation,
 	}
 
 	// notify user
-	t, err := template.New("body").Parse(conf.MailTemplate.Body)
-	if err != nil {
-		return Invitation{}, fmt.Errorf("failed to parse email template: %w", err)
-	}
-	var tpl bytes.Buffer
-	err = t.Execute(&tpl, map[string]string{
-		"UserID":         invitation.UserID,
-		"Organization":   org.Name,
-		"OrganizationID": org.ID,
-		"InviteID":       invitation.ID.String(),
-	})
+	if err = s.sendMail(ctx, email.TemplateInvitation, conf.MailTemplate.Subject, conf.MailTemplate.Body, org, invitation); err != nil {
+		return invitation, err
+	}
+	return invitation, nil
+}
+
+// resend mails a pending invitation again and extends its expiry, an invitation
+// can be resent once every resend interval and at most max resends times
+func (s Service) resend(ctx context.Context, conf *Config, org organization.Organization, invite Invitation) (Invitation, error) {
+	now := s.Now()
+	if conf.MaxResends > 0 && invite.ResendCount >= conf.MaxResends {
+		return Invitation{}, fmt.Errorf("%w: invitation was resent %d times", ErrResendLimit, invite.ResendCount)
+	}
+	if nextSend := invite.SentAt.Add(conf.ResendInterval); now.Before(nextSend) {
+		return Invitation{}, fmt.Errorf("%w: try again after %s", ErrResendLimit, nextSend.Format(time.RFC3339))
+	}
+
+	invite.ResendCount++
+	invite.SentAt = now
+	invite.RemindedAt = time.Time{}
+	invite.ExpiresAt = now.Add(DefaultExpiryDuration)
+	invite, err := s.repo.Set(ctx, invite)
 	if err != nil {
-		return Invitation{}, fmt.Errorf("failed to parse email template: %w", err)
+		return Invitation{}, err
+	}
+	if err = s.sendMail(ctx, email.TemplateInvitation, conf.MailTemplate.Subject, conf.MailTemplate.Body, org, invite); err != nil {
+		return invite, err
 	}
+	return invite, nil
+}
 
-	msg := mail.NewMessage()
-	msg.SetHeader("From", s.dialer.FromHeader())
-	msg.SetHeader("To", invitation.UserID)
-	msg.SetHeader("Subject", conf.MailTemplate.Subject)
-	msg.SetBody("text/html", tpl.String())
-	if err := s.dialer.DialAndSend(msg); err != nil {
-		return invitation, err
+// sendMail queues a mail of the invitation rendered with the template of the
+// name, subject and body are used if no template of the name is set
+func (s Service) sendMail(ctx context.Context, name, subject, body string, org organization.Organization, invite Invitation) error {
+	_, err := s.mailService.Send(ctx, email.Request{
+		Template: name,
+		OrgID:    org.ID,
+		To:       []string{invite.UserID},
+		Data: map[string]string{
+			"UserID":         invite.UserID,
+			"Organization":   org.Name,
+			"OrganizationID": org.ID,
+			"InviteID":       invite.ID.String(),
+			"ExpiresAt":      invite.ExpiresAt.Format(time.RFC1123),
+		},
+		Default: email.Template{
+			Name:     name,
+			Subject:  subject,
+			HTMLBody: body,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send invitation mail: %w", err)
 	}
-	return invitation, nil
+	return nil
 }
 
 func (s Service) createRelations(ctx context.Context, invitationID uuid.UUID, orgID, userEmail string) error {
@@ -213,20 +310,76 @@ func (s Service) createRelations(ctx context.Context, invitationID uuid.UUID, or
 	return nil
 }
 
+// Delete deletes the invitation and all of its relations
 func (s Service) Delete(ctx context.Context, id uuid.UUID) error {
 	err := s.relationService.Delete(ctx, relation.Relation{
 		Object: relation.Object{
 			ID:        id.String(),
 			Namespace: schema.InvitationNamespace,
 		},
-		RelationName: schema.OrganizationRelationName,
 	})
-	if err != nil {
+	if err != nil && !errors.Is(err, relation.ErrNotExist) {
 		return fmt.Errorf("failed to delete relation for invitation: %w", err)
 	}
 	return s.repo.Delete(ctx, id)
 }
 
+// Decline is used by the invited user to turn down a pending invitation
+func (s Service) Decline(ctx context.Context, id uuid.UUID) error {
+	invite, err := s.getPending(ctx, id)
+	if err != nil {
+		return err
+	}
+	return s.close(ctx, invite, StatusDeclined)
+}
+
+// Revoke withdraws a pending invitation so it can no longer be accepted
+func (s Service) Revoke(ctx context.Context, id uuid.UUID) error {
+	invite, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if invite.Status != StatusPending && invite.Status != StatusExpired {
+		return fmt.Errorf("%w: invitation is %s", ErrNotPending, invite.Status)
+	}
+	return s.close(ctx, invite, StatusRevoked)
+}
+
+func (s Service) getPending(ctx context.Context, id uuid.UUID) (Invitation, error) {
+	invite, err := s.Get(ctx, id)
+	if err != nil {
+		return Invitation{}, err
+	}
+	switch invite.Status {
+	case StatusPending:
+		return invite, nil
+	case StatusExpired:
+		return Invitation{}, ErrExpired
+	default:
+		return Invitation{}, fmt.Errorf("%w: invitation is %s", ErrNotPending, invite.Status)
+	}
+}
+
+// close records the final status of an invitation, the invited user loses access
+// to it while the organization can still see it until it's cleaned up
+func (s Service) close(ctx context.Context, invite Invitation, status Status) error {
+	invite.Status = status
+	if _, err := s.repo.Set(ctx, invite); err != nil {
+		return err
+	}
+	err := s.relationService.Delete(ctx, relation.Relation{
+		Object: relation.Object{
+			ID:        invite.ID.String(),
+			Namespace: schema.InvitationNamespace,
+		},
+		RelationName: schema.UserRelationName,
+	})
+	if err != nil && !errors.Is(err, relation.ErrNotExist) {
+		return fmt.Errorf("failed to delete relation for invitation: %w", err)
+	}
+	return nil
+}
+
 // check if user is already part of the organization that the invitation is created for
 func (s Service) isUserOrgMember(ctx context.Context, orgID, userID string) (user.User, bool, error) {
 	userOb, err := s.userService.GetByID(ctx, userID)
@@ -251,20 +404,29 @@ func (s Service) isUserOrgMember(ctx context.Context, orgID, userID string) (use
 
 // Accept invites a user to an organization
 func (s Service) Accept(ctx context.Context, id uuid.UUID) error {
-	invite, err := s.Get(ctx, id)
+	invite, err := s.getPending(ctx, id)
 	if err != nil {
 		return err
 	}
 
+	if err = s.join(ctx, invite.OrgID, invite.UserID, invite.GroupIDs, invite.RoleIDs); err != nil {
+		return err
+	}
+	return s.close(ctx, invite, StatusAccepted)
+}
+
+// join adds the user to the organization, its groups and assigns it the roles
+// at org level, memberships the user already has are skipped
+func (s Service) join(ctx context.Context, orgID, userID string, groupIDs, roleIDs []string) error {
 	// check if user is already a member of the organization
 	// if yes, check if any other part of the invitation applies like group membership
-	userOb, userOrgMember, err := s.isUserOrgMember(ctx, invite.OrgID, invite.UserID)
+	userOb, userOrgMember, err := s.isUserOrgMember(ctx, orgID, userID)
 	if err != nil {
 		return err
 	}
 	if !userOrgMember {
 		// if not, add user to the organization
-		if err = s.orgSvc.AddMember(ctx, invite.OrgID, schema.MemberRelationName, authenticate.Principal{
+		if err = s.orgSvc.AddMember(ctx, orgID, schema.MemberRelationName, authenticate.Principal{
 			ID:   userOb.ID,
 			Type: schema.UserPrincipal,
 		}); err != nil {
@@ -273,12 +435,12 @@ func (s Service) Accept(ctx context.Context, id uuid.UUID) error {
 	}
 
 	// check if the invitation has a group membership
-	if len(invite.GroupIDs) > 0 {
+	if len(groupIDs) > 0 {
 		userGroups, err := s.groupSvc.ListByUser(ctx, userOb.ID, group.Filter{})
 		if err != nil {
 			return err
 		}
-		for _, groupID := range invite.GroupIDs {
+		for _, groupID := range groupIDs {
 			// check if group id is valid
 			grp, err := s.groupSvc.Get(ctx, groupID)
 			if err != nil {
@@ -307,13 +469,13 @@ func (s Service) Accept(ctx context.Context, id uuid.UUID) error {
 
 	// check if invitation has a list of roles which we want to assign to the user at org level
 	var roleErr error
-	if len(invite.RoleIDs) > 0 {
+	if len(roleIDs) > 0 {
 		conf := s.getConfig(ctx)
 		if conf.WithRoles {
-			for _, inviteRoleID := range invite.RoleIDs {
+			for _, inviteRoleID := range roleIDs {
 				if _, err := s.policyService.Create(ctx, policy.Policy{
 					RoleID:        inviteRoleID,
-					ResourceID:    invite.OrgID,
+					ResourceID:    orgID,
 					ResourceType:  schema.OrganizationNamespace,
 					PrincipalID:   userOb.ID,
 					PrincipalType: schema.UserPrincipal,
@@ -326,7 +488,214 @@ func (s Service) Accept(ctx context.Context, id uuid.UUID) error {
 			}
 		}
 	}
+	return nil
+}
+
+// InitInvitationExpiry starts a cron job that runs every hour to remind users
+// of invitations about to expire and delete expired invitations
+func (s Service) InitInvitationExpiry(ctx context.Context) error {
+	_, err := s.cron.AddFunc(expirySchedule, func() {
+		if err := s.NotifyExpiring(ctx); err != nil {
+			logger.Ctx(ctx).Warn("error reminding users of expiring invitations", zap.Error(err))
+		}
+		if err := s.DeleteExpired(ctx); err != nil {
+			logger.Ctx(ctx).Warn("error deleting expired invitations", zap.Error(err))
+		}
+	})
+	if err != nil {
+		return err
+	}
+	s.cron.Start()
+	return nil
+}
+
+func (s Service) Close() {
+	s.cron.Stop()
+}
+
+// NotifyExpiring mails users of pending invitations expiring within the reminder
+// period, a user is reminded once per invitation mail
+func (s Service) NotifyExpiring(ctx context.Context) error {
+	if s.config.ReminderBefore <= 0 {
+		return nil
+	}
+	invites, err := s.repo.List(ctx, Filter{
+		Status:        StatusPending,
+		ExpiresBefore: s.Now().Add(s.config.ReminderBefore),
+	})
+	if err != nil {
+		return err
+	}
+
+	var notifyErr error
+	for _, invite := range invites {
+		if !invite.RemindedAt.IsZero() || invite.IsExpired(s.Now()) {
+			continue
+		}
+		org, err := s.orgSvc.Get(ctx, invite.OrgID)
+		if err != nil {
+			notifyErr = errors.Join(notifyErr, err)
+			continue
+		}
+		if err := s.sendMail(ctx, email.TemplateInvitationReminder, s.config.ReminderMailTemplate.Subject, s.config.ReminderMailTemplate.Body, org, invite); err != nil {
+			notifyErr = errors.Join(notifyErr, fmt.Errorf("failed to remind user of invitation[%s]: %w", invite.ID, err))
+			continue
+		}
+		invite.RemindedAt = s.Now()
+		if _, err := s.repo.Set(ctx, invite); err != nil {
+			notifyErr = errors.Join(notifyErr, err)
+		}
+	}
+	return notifyErr
+}
+
+// DeleteExpired deletes invitations past their expiry with their relations,
+// invitations which were accepted, declined or revoked are kept until then
+func (s Service) DeleteExpired(ctx context.Context) error {
+	invites, err := s.repo.List(ctx, Filter{
+		ExpiresBefore: s.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	var deleteErr error
+	for _, invite := range invites {
+		if err := s.Delete(ctx, invite.ID); err != nil {
+			deleteErr = errors.Join(deleteErr, fmt.Errorf("failed to delete invitation[%s]: %w", invite.ID, err))
+		}
+	}
+	return deleteErr
+}
+
+// CreateLink generates an invite link of the organization, the token is
+// returned in Value and only its hash is stored
+func (s Service) CreateLink(ctx context.Context, link Link) (Link, error) {
+	org, err := s.orgSvc.Get(ctx, link.OrgID)
+	if err != nil {
+		return Link{}, fmt.Errorf("invalid organization: %w", err)
+	}
+	link.OrgID = org.ID
+	if conf := s.getConfig(ctx); !conf.WithRoles {
+		// clear roles if not allowed at instance level
+		link.RoleIDs = nil
+	}
+
+	now := s.Now()
+	if link.ExpiresAt.IsZero() {
+		expiry := DefaultExpiryDuration
+		if s.config.LinkMaxLifetime > 0 && s.config.LinkMaxLifetime < expiry {
+			expiry = s.config.LinkMaxLifetime
+		}
+		link.ExpiresAt = now.Add(expiry)
+	}
+	if link.MaxUses < 0 || link.IsExpired(now) ||
+		(s.config.LinkMaxLifetime > 0 && link.ExpiresAt.After(now.Add(s.config.LinkMaxLifetime))) {
+		return Link{}, ErrInvalidLinkDetail
+	}
+	for _, groupID := range link.GroupIDs {
+		grp, err := s.groupSvc.Get(ctx, groupID)
+		if err != nil {
+			return Link{}, err
+		}
+		if grp.OrganizationID != org.ID {
+			return Link{}, fmt.Errorf("%w: group %s isn't part of the organization", ErrInvalidLinkDetail, groupID)
+		}
+	}
+	for i, roleID := range link.RoleIDs {
+		rl, err := s.roleService.Get(ctx, roleID)
+		if err != nil {
+			if errors.Is(err, role.ErrNotExist) || errors.Is(err, role.ErrInvalidID) {
+				return Link{}, fmt.Errorf("%w: role %s doesn't exist", ErrInvalidLinkDetail, roleID)
+			}
+			return Link{}, err
+		}
+		if !rl.IsOrgRole(org.ID) {
+			return Link{}, fmt.Errorf("%w: role %s can't be assigned at organization level", ErrInvalidLinkDetail, roleID)
+		}
+		link.RoleIDs[i] = rl.ID
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return Link{}, err
+	}
+	value := LinkPrefix + base64.RawURLEncoding.EncodeToString(secret)
+	link.SecretHash = utils.HashToken(value)
+
+	created, err := s.linkRepo.Create(ctx, link)
+	if err != nil {
+		return Link{}, err
+	}
+	created.Value = value
+	return created, nil
+}
+
+func (s Service) ListLinks(ctx context.Context, orgID string) ([]Link, error) {
+	return s.linkRepo.List(ctx, orgID)
+}
+
+// RevokeLink deletes the invite link of the organization, it can't be used to
+// join anymore
+func (s Service) RevokeLink(ctx context.Context, orgID, id string) error {
+	link, err := s.linkRepo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if link.OrgID != orgID {
+		return ErrLinkNotFound
+	}
+	return s.linkRepo.Delete(ctx, id)
+}
+
+// JoinWithLink adds the user to the organization of the invite link, the same
+// way as accepting an invitation. A use of the link is reserved before a user,
+// who isn't a member of the organization already, joins it and given back if
+// joining fails
+func (s Service) JoinWithLink(ctx context.Context, orgID, value, userID string) error {
+	if !strings.HasPrefix(value, LinkPrefix) {
+		return ErrInvalidLink
+	}
+	link, err := s.linkRepo.GetBySecretHash(ctx, utils.HashToken(value))
+	if err != nil {
+		if errors.Is(err, ErrLinkNotFound) {
+			return ErrInvalidLink
+		}
+		return err
+	}
+	if link.OrgID != orgID || link.IsExpired(s.Now()) {
+		return ErrInvalidLink
+	}
+	// links of a disabled organization can't be used until it's enabled again
+	if _, err := s.orgSvc.Get(ctx, link.OrgID); err != nil {
+		return err
+	}
+
+	userOb, err := s.userService.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !link.AllowsEmail(userOb.Email) {
+		return ErrDomainNotAllowed
+	}
+	_, userOrgMember, err := s.isUserOrgMember(ctx, orgID, userOb.ID)
+	if err != nil {
+		return err
+	}
+	if userOrgMember {
+		return s.join(ctx, orgID, userOb.ID, link.GroupIDs, link.RoleIDs)
+	}
 
-	// delete the invitation
-	return s.Delete(ctx, id)
+	// the use is reserved in the same statement checking max uses so
+	// concurrent joins can't use the link more often than allowed
+	if _, err = s.linkRepo.Use(ctx, link.ID); err != nil {
+		return err
+	}
+	if err = s.join(ctx, orgID, userOb.ID, link.GroupIDs, link.RoleIDs); err != nil {
+		if releaseErr := s.linkRepo.Release(ctx, link.ID); releaseErr != nil {
+			return errors.Join(err, fmt.Errorf("failed to release use of invite link[%s]: %w", link.ID, releaseErr))
+		}
+		return err
+	}
+	return nil
 }
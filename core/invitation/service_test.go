@@ -0,0 +1,412 @@
+package invitation
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/email"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/preference"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/role"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type memRepository struct {
+	invites map[uuid.UUID]Invitation
+}
+
+func (r *memRepository) Set(ctx context.Context, invite Invitation) (Invitation, error) {
+	if invite.Status == "" {
+		invite.Status = StatusPending
+	}
+	if invite.ExpiresAt.IsZero() {
+		invite.ExpiresAt = time.Now().Add(DefaultExpiryDuration)
+	}
+	r.invites[invite.ID] = invite
+	return invite, nil
+}
+
+func (r *memRepository) List(ctx context.Context, flt Filter) ([]Invitation, error) {
+	var invites []Invitation
+	for _, i := range r.invites {
+		if (flt.OrgID == "" || i.OrgID == flt.OrgID) &&
+			(flt.UserID == "" || i.UserID == flt.UserID) &&
+			(flt.Status == "" || i.Status == flt.Status) &&
+			(flt.ExpiresBefore.IsZero() || !i.ExpiresAt.After(flt.ExpiresBefore)) {
+			invites = append(invites, i)
+		}
+	}
+	return invites, nil
+}
+
+func (r *memRepository) ListByUser(ctx context.Context, id string) ([]Invitation, error) {
+	return r.List(ctx, Filter{UserID: id})
+}
+
+func (r *memRepository) Get(ctx context.Context, id uuid.UUID) (Invitation, error) {
+	invite, ok := r.invites[id]
+	if !ok {
+		return Invitation{}, ErrNotFound
+	}
+	return invite, nil
+}
+
+func (r *memRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	delete(r.invites, id)
+	return nil
+}
+
+type memOrgService struct {
+	OrganizationService
+}
+
+func (memOrgService) Get(ctx context.Context, id string) (organization.Organization, error) {
+	return organization.Organization{ID: id, Name: "acme"}, nil
+}
+
+type memUserService struct{}
+
+func (memUserService) GetByID(ctx context.Context, id string) (user.User, error) {
+	return user.User{}, user.ErrNotExist
+}
+
+type memRelationService struct {
+	rels []relation.Relation
+}
+
+func (s *memRelationService) Create(ctx context.Context, rel relation.Relation) (relation.Relation, error) {
+	s.rels = append(s.rels, rel)
+	return rel, nil
+}
+
+func (s *memRelationService) Delete(ctx context.Context, rel relation.Relation) error {
+	var kept []relation.Relation
+	for _, r := range s.rels {
+		if r.Object.ID != rel.Object.ID || (rel.RelationName != "" && r.RelationName != rel.RelationName) {
+			kept = append(kept, r)
+		}
+	}
+	s.rels = kept
+	return nil
+}
+
+type memPreferenceService struct{}
+
+func (memPreferenceService) LoadPlatformPreferences(ctx context.Context) (map[string]string, error) {
+	return map[string]string{}, nil
+}
+
+type memMailService struct {
+	sent []email.Request
+}
+
+func (m *memMailService) Send(ctx context.Context, req email.Request) (email.Mail, error) {
+	m.sent = append(m.sent, req)
+	return email.Mail{To: req.To}, nil
+}
+
+func newTestService(config Config) (*Service, *memRepository, *memRelationService, *memMailService) {
+	repo := &memRepository{invites: map[uuid.UUID]Invitation{}}
+	relService := &memRelationService{}
+	mailService := &memMailService{}
+	s := NewService(config, mailService, repo, nil, memOrgService{}, nil, memUserService{},
+		relService, nil, nil, memPreferenceService{})
+	return s, repo, relService, mailService
+}
+
+func TestService_Create(t *testing.T) {
+	t.Run("should resend a pending invitation no more than allowed", func(t *testing.T) {
+		now := time.Now().UTC()
+		s, repo, _, mailService := newTestService(Config{ResendInterval: time.Hour, MaxResends: 1})
+		s.Now = func() time.Time { return now }
+		invite, err := s.Create(context.Background(), Invitation{UserID: "john@raystack.org", OrgID: "org-1"})
+		assert.NoError(t, err)
+		assert.Equal(t, StatusPending, invite.Status)
+		assert.Len(t, mailService.sent, 1)
+
+		_, err = s.Create(context.Background(), Invitation{UserID: "john@raystack.org", OrgID: "org-1"})
+		assert.ErrorIs(t, err, ErrResendLimit)
+
+		now = now.Add(time.Hour)
+		resent, err := s.Create(context.Background(), Invitation{UserID: "john@raystack.org", OrgID: "org-1", GroupIDs: []string{"group-1"}})
+		assert.NoError(t, err)
+		assert.Equal(t, invite.ID, resent.ID)
+		assert.Equal(t, 1, resent.ResendCount)
+		assert.Equal(t, []string{"group-1"}, resent.GroupIDs)
+		assert.Equal(t, now.Add(DefaultExpiryDuration), resent.ExpiresAt)
+		assert.Len(t, repo.invites, 1)
+		assert.Len(t, mailService.sent, 2)
+
+		now = now.Add(time.Hour)
+		_, err = s.Create(context.Background(), Invitation{UserID: "john@raystack.org", OrgID: "org-1"})
+		assert.ErrorIs(t, err, ErrResendLimit)
+	})
+}
+
+func TestService_Decline(t *testing.T) {
+	t.Run("should decline a pending invitation and remove the access of the user", func(t *testing.T) {
+		s, repo, relService, _ := newTestService(Config{})
+		invite, err := s.Create(context.Background(), Invitation{UserID: "john@raystack.org", OrgID: "org-1"})
+		assert.NoError(t, err)
+		assert.Len(t, relService.rels, 2)
+
+		assert.NoError(t, s.Decline(context.Background(), invite.ID))
+		assert.Equal(t, StatusDeclined, repo.invites[invite.ID].Status)
+		assert.Len(t, relService.rels, 1)
+		assert.Equal(t, schema.OrganizationRelationName, relService.rels[0].RelationName)
+
+		assert.ErrorIs(t, s.Decline(context.Background(), invite.ID), ErrNotPending)
+		assert.ErrorIs(t, s.Accept(context.Background(), invite.ID), ErrNotPending)
+	})
+	t.Run("should not accept or decline an expired invitation", func(t *testing.T) {
+		s, _, _, _ := newTestService(Config{})
+		invite, err := s.Create(context.Background(), Invitation{
+			UserID:    "john@raystack.org",
+			OrgID:     "org-1",
+			ExpiresAt: time.Now().Add(-time.Minute),
+		})
+		assert.NoError(t, err)
+
+		got, err := s.Get(context.Background(), invite.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, StatusExpired, got.Status)
+		assert.ErrorIs(t, s.Accept(context.Background(), invite.ID), ErrExpired)
+		assert.ErrorIs(t, s.Decline(context.Background(), invite.ID), ErrExpired)
+	})
+}
+
+func TestService_Expiry(t *testing.T) {
+	s, repo, relService, mailService := newTestService(Config{ReminderBefore: 48 * time.Hour})
+	expiring, err := s.Create(context.Background(), Invitation{
+		UserID:    "john@raystack.org",
+		OrgID:     "org-1",
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	assert.NoError(t, err)
+	_, err = s.Create(context.Background(), Invitation{UserID: "jane@raystack.org", OrgID: "org-1"})
+	assert.NoError(t, err)
+	expired, err := s.Create(context.Background(), Invitation{
+		UserID:    "jim@raystack.org",
+		OrgID:     "org-1",
+		ExpiresAt: time.Now().Add(-time.Hour),
+	})
+	assert.NoError(t, err)
+	mailService.sent = nil
+
+	t.Run("should remind users of expiring invitations once", func(t *testing.T) {
+		assert.NoError(t, s.NotifyExpiring(context.Background()))
+		assert.NoError(t, s.NotifyExpiring(context.Background()))
+		assert.Len(t, mailService.sent, 1)
+		assert.Equal(t, []string{expiring.UserID}, mailService.sent[0].To)
+		assert.Equal(t, email.TemplateInvitationReminder, mailService.sent[0].Template)
+		assert.False(t, repo.invites[expiring.ID].RemindedAt.IsZero())
+	})
+	t.Run("should delete expired invitations with their relations", func(t *testing.T) {
+		assert.NoError(t, s.DeleteExpired(context.Background()))
+		assert.Len(t, repo.invites, 2)
+		assert.NotContains(t, repo.invites, expired.ID)
+		for _, rel := range relService.rels {
+			assert.NotEqual(t, expired.ID.String(), rel.Object.ID)
+		}
+		assert.Len(t, relService.rels, 4)
+	})
+}
+
+type memLinkRepository struct {
+	links map[string]Link
+}
+
+func (r *memLinkRepository) Create(ctx context.Context, link Link) (Link, error) {
+	link.ID = uuid.NewString()
+	r.links[link.ID] = link
+	return link, nil
+}
+
+func (r *memLinkRepository) List(ctx context.Context, orgID string) ([]Link, error) {
+	var links []Link
+	for _, l := range r.links {
+		if l.OrgID == orgID {
+			links = append(links, l)
+		}
+	}
+	return links, nil
+}
+
+func (r *memLinkRepository) Get(ctx context.Context, id string) (Link, error) {
+	link, ok := r.links[id]
+	if !ok {
+		return Link{}, ErrLinkNotFound
+	}
+	return link, nil
+}
+
+func (r *memLinkRepository) GetBySecretHash(ctx context.Context, secretHash string) (Link, error) {
+	for _, l := range r.links {
+		if l.SecretHash == secretHash {
+			return l, nil
+		}
+	}
+	return Link{}, ErrLinkNotFound
+}
+
+func (r *memLinkRepository) Delete(ctx context.Context, id string) error {
+	delete(r.links, id)
+	return nil
+}
+
+func (r *memLinkRepository) Use(ctx context.Context, id string) (Link, error) {
+	link := r.links[id]
+	if link.IsExhausted() {
+		return Link{}, ErrLinkExhausted
+	}
+	link.Uses++
+	r.links[id] = link
+	return link, nil
+}
+
+func (r *memLinkRepository) Release(ctx context.Context, id string) error {
+	link := r.links[id]
+	if link.Uses > 0 {
+		link.Uses--
+	}
+	r.links[id] = link
+	return nil
+}
+
+type memMemberOrgService struct {
+	memOrgService
+	members  map[string]bool
+	disabled bool
+	addErr   error
+}
+
+func (s *memMemberOrgService) Get(ctx context.Context, id string) (organization.Organization, error) {
+	if s.disabled {
+		return organization.Organization{}, organization.ErrDisabled
+	}
+	return s.memOrgService.Get(ctx, id)
+}
+
+func (s *memMemberOrgService) AddMember(ctx context.Context, orgID, relationName string, principal authenticate.Principal) error {
+	if s.addErr != nil {
+		return s.addErr
+	}
+	s.members[principal.ID] = true
+	return nil
+}
+
+func (s *memMemberOrgService) ListByUser(ctx context.Context, userID string) ([]organization.Organization, error) {
+	if s.members[userID] {
+		return []organization.Organization{{ID: "org-1"}}, nil
+	}
+	return nil, nil
+}
+
+type memUsers map[string]user.User
+
+func (u memUsers) GetByID(ctx context.Context, id string) (user.User, error) {
+	usr, ok := u[id]
+	if !ok {
+		return user.User{}, user.ErrNotExist
+	}
+	return usr, nil
+}
+
+func TestService_JoinWithLink(t *testing.T) {
+	users := memUsers{
+		"john": {ID: "john", Email: "john@raystack.org"},
+		"jane": {ID: "jane", Email: "jane@raystack.org"},
+		"jim":  {ID: "jim", Email: "jim@example.com"},
+	}
+	linkRepo := &memLinkRepository{links: map[string]Link{}}
+	orgSvc := &memMemberOrgService{members: map[string]bool{}}
+	s := NewService(Config{LinkMaxLifetime: 24 * time.Hour}, &memMailService{}, nil, linkRepo, orgSvc, nil, users,
+		&memRelationService{}, nil, nil, memPreferenceService{})
+
+	_, err := s.CreateLink(context.Background(), Link{OrgID: "org-1", ExpiresAt: time.Now().Add(48 * time.Hour)})
+	assert.ErrorIs(t, err, ErrInvalidLinkDetail)
+
+	link, err := s.CreateLink(context.Background(), Link{OrgID: "org-1", MaxUses: 1, Domains: []string{"raystack.org"}})
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(link.Value, LinkPrefix))
+	assert.NotEqual(t, link.Value, linkRepo.links[link.ID].SecretHash)
+
+	t.Run("should only let users of the allowed domains join", func(t *testing.T) {
+		assert.ErrorIs(t, s.JoinWithLink(context.Background(), "org-1", link.Value, "jim"), ErrDomainNotAllowed)
+	})
+	t.Run("should not join with a link of a disabled organization", func(t *testing.T) {
+		orgSvc.disabled = true
+		defer func() { orgSvc.disabled = false }()
+		assert.ErrorIs(t, s.JoinWithLink(context.Background(), "org-1", link.Value, "john"), organization.ErrDisabled)
+		assert.Equal(t, 0, linkRepo.links[link.ID].Uses)
+	})
+	t.Run("should give back the use when joining fails", func(t *testing.T) {
+		orgSvc.addErr = errors.New("failed to add member")
+		defer func() { orgSvc.addErr = nil }()
+		assert.ErrorIs(t, s.JoinWithLink(context.Background(), "org-1", link.Value, "john"), orgSvc.addErr)
+		assert.False(t, orgSvc.members["john"])
+		assert.Equal(t, 0, linkRepo.links[link.ID].Uses)
+	})
+	t.Run("should not count a use when a member joins again", func(t *testing.T) {
+		assert.NoError(t, s.JoinWithLink(context.Background(), "org-1", link.Value, "john"))
+		assert.True(t, orgSvc.members["john"])
+		assert.NoError(t, s.JoinWithLink(context.Background(), "org-1", link.Value, "john"))
+		assert.Equal(t, 1, linkRepo.links[link.ID].Uses)
+	})
+	t.Run("should not join once the link is exhausted or revoked", func(t *testing.T) {
+		assert.ErrorIs(t, s.JoinWithLink(context.Background(), "org-1", link.Value, "jane"), ErrLinkExhausted)
+		assert.ErrorIs(t, s.JoinWithLink(context.Background(), "org-2", link.Value, "jane"), ErrInvalidLink)
+		assert.ErrorIs(t, s.RevokeLink(context.Background(), "org-2", link.ID), ErrLinkNotFound)
+		assert.NoError(t, s.RevokeLink(context.Background(), "org-1", link.ID))
+		assert.ErrorIs(t, s.JoinWithLink(context.Background(), "org-1", link.Value, "jane"), ErrInvalidLink)
+	})
+}
+
+type memPreferences map[string]string
+
+func (p memPreferences) LoadPlatformPreferences(ctx context.Context) (map[string]string, error) {
+	return p, nil
+}
+
+type memRoles map[string]role.Role
+
+func (r memRoles) Get(ctx context.Context, id string) (role.Role, error) {
+	rl, ok := r[id]
+	if !ok {
+		return role.Role{}, role.ErrNotExist
+	}
+	return rl, nil
+}
+
+func TestService_CreateLink(t *testing.T) {
+	roles := memRoles{
+		"org-viewer": {ID: "org-viewer", OrgID: schema.PlatformOrgID.String(), Scopes: []string{schema.OrganizationNamespace}},
+		"org-custom": {ID: "org-custom", OrgID: "org-1", Scopes: []string{schema.OrganizationNamespace}},
+		"other-org":  {ID: "other-org", OrgID: "org-2", Scopes: []string{schema.OrganizationNamespace}},
+		"project":    {ID: "project", OrgID: schema.PlatformOrgID.String(), Scopes: []string{schema.ProjectNamespace}},
+	}
+	linkRepo := &memLinkRepository{links: map[string]Link{}}
+	s := NewService(Config{}, &memMailService{}, nil, linkRepo, memOrgService{}, nil, memUsers{},
+		&memRelationService{}, nil, roles, memPreferences{preference.PlatformInviteWithRoles: "true"})
+
+	t.Run("should create a link with roles of the organization", func(t *testing.T) {
+		link, err := s.CreateLink(context.Background(), Link{OrgID: "org-1", RoleIDs: []string{"org-viewer", "org-custom"}})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"org-viewer", "org-custom"}, linkRepo.links[link.ID].RoleIDs)
+	})
+	t.Run("should not create a link with roles which can't be assigned on the organization", func(t *testing.T) {
+		for _, roleID := range []string{"missing", "other-org", "project"} {
+			_, err := s.CreateLink(context.Background(), Link{OrgID: "org-1", RoleIDs: []string{roleID}})
+			assert.ErrorIs(t, err, ErrInvalidLinkDetail, roleID)
+		}
+		assert.Len(t, linkRepo.links, 1)
+	})
+}
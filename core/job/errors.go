@@ -0,0 +1,12 @@
+package job
+
+import "errors"
+
+var (
+	ErrNotExist      = errors.New("job doesn't exist")
+	ErrInvalidUUID   = errors.New("invalid syntax of uuid")
+	ErrInvalidID     = errors.New("job id is invalid")
+	ErrInvalidDetail = errors.New("invalid job detail")
+	ErrUnknownKind   = errors.New("no handler registered for job kind")
+	ErrFinished      = errors.New("job is already finished")
+)
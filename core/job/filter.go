@@ -0,0 +1,7 @@
+package job
+
+type Filter struct {
+	Kind      string
+	State     State
+	CreatedBy string
+}
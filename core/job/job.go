@@ -0,0 +1,95 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/raystack/frontier/pkg/metadata"
+)
+
+type Repository interface {
+	Create(ctx context.Context, job Job) (Job, error)
+	GetByID(ctx context.Context, id string) (Job, error)
+	List(ctx context.Context, flt Filter) ([]Job, error)
+	// Claim marks the oldest pending job, or a running job which stopped
+	// reporting progress, as running and returns it. ErrNotExist is
+	// returned if there is nothing to run.
+	Claim(ctx context.Context, staleAfter time.Duration) (Job, error)
+	// Update saves the progress of a running job, ErrFinished is returned
+	// if the job is not running anymore e.g. it was cancelled
+	Update(ctx context.Context, job Job) (Job, error)
+	Cancel(ctx context.Context, id string) (Job, error)
+}
+
+type State string
+
+const (
+	StatePending   State = "pending"
+	StateRunning   State = "running"
+	StateCompleted State = "completed"
+	StateFailed    State = "failed"
+	StateCancelled State = "cancelled"
+)
+
+func (s State) IsFinished() bool {
+	return s == StateCompleted || s == StateFailed || s == StateCancelled
+}
+
+type ItemState string
+
+const (
+	ItemStatePending   ItemState = "pending"
+	ItemStateSucceeded ItemState = "succeeded"
+	ItemStateFailed    ItemState = "failed"
+)
+
+// Item is a unit of work of a job e.g. a user to add in an organization,
+// items are processed independently and a failed item doesn't fail the job
+type Item struct {
+	Key   string    `json:"key"`
+	State ItemState `json:"state"`
+	Error string    `json:"error,omitempty"`
+}
+
+// Job is a batch of items processed in background by the handler
+// registered for its kind
+type Job struct {
+	ID      string
+	Kind    string
+	State   State
+	Payload metadata.Metadata
+	Items   []Item
+	// Error is set if the job failed before processing all items
+	Error     string
+	CreatedBy string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewItems builds pending items from keys, duplicate and empty keys are dropped
+func NewItems(keys []string) []Item {
+	var items []Item
+	seen := map[string]bool{}
+	for _, key := range keys {
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		items = append(items, Item{Key: key, State: ItemStatePending})
+	}
+	return items
+}
+
+// Progress returns count of items processed successfully and failed
+func (j Job) Progress() (succeeded int, failed int) {
+	for _, item := range j.Items {
+		switch item.State {
+		case ItemStateSucceeded:
+			succeeded++
+		case ItemStateFailed:
+			failed++
+		}
+	}
+	return succeeded, failed
+}
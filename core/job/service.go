@@ -0,0 +1,186 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/raystack/frontier/pkg/metadata"
+	"github.com/raystack/salt/log"
+)
+
+const (
+	// MaxItems caps the number of items accepted in a single job
+	MaxItems = 10000
+
+	workers      = 4
+	pollInterval = time.Second * 5
+	// progress is saved after every batch of items, a running job which
+	// hasn't saved progress for staleAfter is assumed to be abandoned by a
+	// stopped instance and is picked again by another worker
+	batchSize  = 100
+	staleAfter = time.Minute * 10
+)
+
+// Handler processes a single item of a job, returned error is recorded
+// against the item
+type Handler func(ctx context.Context, payload metadata.Metadata, key string) error
+
+type Service struct {
+	repository Repository
+	handlers   map[string]Handler
+	log        log.Logger
+
+	stop chan struct{}
+	wg   *sync.WaitGroup
+}
+
+func NewService(logger log.Logger, repository Repository) *Service {
+	return &Service{
+		repository: repository,
+		handlers:   map[string]Handler{},
+		log:        logger,
+		stop:       make(chan struct{}),
+		wg:         &sync.WaitGroup{},
+	}
+}
+
+// RegisterHandler sets the handler for items of jobs of a kind,
+// it should be called before workers are started
+func (s Service) RegisterHandler(kind string, handler Handler) {
+	s.handlers[kind] = handler
+}
+
+// Create queues a job, it is picked by the first available worker
+func (s Service) Create(ctx context.Context, job Job) (Job, error) {
+	if _, ok := s.handlers[job.Kind]; !ok {
+		return Job{}, fmt.Errorf("%w: %s", ErrUnknownKind, job.Kind)
+	}
+	if len(job.Items) == 0 || len(job.Items) > MaxItems {
+		return Job{}, fmt.Errorf("%w: a job should have between 1 and %d items", ErrInvalidDetail, MaxItems)
+	}
+	job.State = StatePending
+	return s.repository.Create(ctx, job)
+}
+
+func (s Service) Get(ctx context.Context, id string) (Job, error) {
+	return s.repository.GetByID(ctx, id)
+}
+
+func (s Service) List(ctx context.Context, flt Filter) ([]Job, error) {
+	return s.repository.List(ctx, flt)
+}
+
+// Cancel stops a pending or running job, items processed before
+// cancellation are not reverted
+func (s Service) Cancel(ctx context.Context, id string) (Job, error) {
+	return s.repository.Cancel(ctx, id)
+}
+
+// InitWorkers starts the worker pool which runs queued jobs until Close is called
+func (s Service) InitWorkers(ctx context.Context) error {
+	for i := 0; i < workers; i++ {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.work(ctx)
+		}()
+	}
+	return nil
+}
+
+func (s Service) Close() {
+	close(s.stop)
+	s.wg.Wait()
+}
+
+func (s Service) work(ctx context.Context) {
+	for {
+		job, err := s.repository.Claim(ctx, staleAfter)
+		if err == nil {
+			s.run(ctx, job)
+			continue
+		}
+		if !errors.Is(err, ErrNotExist) {
+			s.log.Warn("failed to claim job", "err", err)
+		}
+
+		select {
+		case <-s.stop:
+			return
+		case <-ctx.Done():
+			return
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+func (s Service) run(ctx context.Context, job Job) {
+	handler, ok := s.handlers[job.Kind]
+	if !ok {
+		job.State = StateFailed
+		job.Error = fmt.Sprintf("%s: %s", ErrUnknownKind.Error(), job.Kind)
+		if _, err := s.repository.Update(ctx, job); err != nil {
+			s.log.Warn("failed to update job", "id", job.ID, "err", err)
+		}
+		return
+	}
+
+	processed := 0
+	for idx, item := range job.Items {
+		// items processed before a restart are not run again
+		if item.State != ItemStatePending {
+			continue
+		}
+		if s.stopping(ctx) {
+			// saved progress is resumed by the next worker picking the job
+			job.State = StatePending
+			s.save(context.WithoutCancel(ctx), job)
+			return
+		}
+
+		if err := handler(ctx, job.Payload, item.Key); err != nil {
+			job.Items[idx].State = ItemStateFailed
+			job.Items[idx].Error = err.Error()
+		} else {
+			job.Items[idx].State = ItemStateSucceeded
+		}
+
+		processed++
+		if processed%batchSize == 0 {
+			if !s.save(ctx, job) {
+				return
+			}
+		}
+	}
+
+	job.State = StateCompleted
+	s.save(ctx, job)
+}
+
+func (s Service) stopping(ctx context.Context) bool {
+	select {
+	case <-s.stop:
+		return true
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+// save stores the progress, false is returned if the job should not be
+// processed further
+func (s Service) save(ctx context.Context, job Job) bool {
+	if _, err := s.repository.Update(ctx, job); err != nil {
+		if errors.Is(err, ErrFinished) {
+			s.log.Info("job stopped", "id", job.ID)
+		} else {
+			s.log.Warn("failed to update job", "id", job.ID, "err", err)
+		}
+		return false
+	}
+	return true
+}
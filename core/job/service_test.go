@@ -0,0 +1,267 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/pkg/metadata"
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/assert"
+)
+
+// memRepository keeps jobs in memory, it follows the claim and update
+// rules of the postgres repository
+type memRepository struct {
+	mu   sync.Mutex
+	jobs map[string]Job
+}
+
+func newMemRepository() *memRepository {
+	return &memRepository{jobs: map[string]Job{}}
+}
+
+func (r *memRepository) Create(ctx context.Context, job Job) (Job, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job.ID = uuid.NewString()
+	job.CreatedAt = time.Now()
+	job.UpdatedAt = job.CreatedAt
+	r.jobs[job.ID] = job
+	return job, nil
+}
+
+func (r *memRepository) GetByID(ctx context.Context, id string) (Job, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job, ok := r.jobs[id]
+	if !ok {
+		return Job{}, ErrNotExist
+	}
+	return job, nil
+}
+
+func (r *memRepository) List(ctx context.Context, flt Filter) ([]Job, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var jobs []Job
+	for _, job := range r.jobs {
+		if flt.State == "" || job.State == flt.State {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs, nil
+}
+
+func (r *memRepository) Claim(ctx context.Context, staleAfter time.Duration) (Job, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var claimable []Job
+	for _, job := range r.jobs {
+		if job.State == StatePending ||
+			(job.State == StateRunning && job.UpdatedAt.Before(time.Now().Add(-staleAfter))) {
+			claimable = append(claimable, job)
+		}
+	}
+	if len(claimable) == 0 {
+		return Job{}, ErrNotExist
+	}
+	sort.Slice(claimable, func(i, j int) bool {
+		return claimable[i].CreatedAt.Before(claimable[j].CreatedAt)
+	})
+	job := claimable[0]
+	job.State = StateRunning
+	job.UpdatedAt = time.Now()
+	r.jobs[job.ID] = job
+	return job, nil
+}
+
+func (r *memRepository) Update(ctx context.Context, job Job) (Job, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if current, ok := r.jobs[job.ID]; !ok || current.State != StateRunning {
+		return Job{}, ErrFinished
+	}
+	job.Items = append([]Item(nil), job.Items...)
+	job.UpdatedAt = time.Now()
+	r.jobs[job.ID] = job
+	return job, nil
+}
+
+func (r *memRepository) Cancel(ctx context.Context, id string) (Job, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job, ok := r.jobs[id]
+	if !ok {
+		return Job{}, ErrNotExist
+	}
+	if job.State.IsFinished() {
+		return Job{}, ErrFinished
+	}
+	job.State = StateCancelled
+	r.jobs[id] = job
+	return job, nil
+}
+
+// claim queues a job and marks it running as a worker would
+func (r *memRepository) claim(t *testing.T, job Job) Job {
+	created, err := r.Create(context.Background(), job)
+	assert.NoError(t, err)
+	created.State = StateRunning
+	r.jobs[created.ID] = created
+	return created
+}
+
+var errUnknownUser = errors.New("unknown user")
+
+func failUnknownUsers(ctx context.Context, payload metadata.Metadata, key string) error {
+	if key == "unknown" {
+		return errUnknownUser
+	}
+	return nil
+}
+
+func TestService_Create(t *testing.T) {
+	repo := newMemRepository()
+	s := NewService(log.NewNoop(), repo)
+	s.RegisterHandler("add_users", failUnknownUsers)
+
+	t.Run("should queue a pending job", func(t *testing.T) {
+		got, err := s.Create(context.Background(), Job{Kind: "add_users", Items: NewItems([]string{"john"})})
+		assert.NoError(t, err)
+		assert.Equal(t, StatePending, got.State)
+	})
+	t.Run("should return error for kind without handler", func(t *testing.T) {
+		_, err := s.Create(context.Background(), Job{Kind: "remove_users", Items: NewItems([]string{"john"})})
+		assert.ErrorIs(t, err, ErrUnknownKind)
+	})
+	t.Run("should return error for job without items", func(t *testing.T) {
+		_, err := s.Create(context.Background(), Job{Kind: "add_users"})
+		assert.ErrorIs(t, err, ErrInvalidDetail)
+	})
+}
+
+func TestService_run(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should process all items and record failures", func(t *testing.T) {
+		repo := newMemRepository()
+		s := NewService(log.NewNoop(), repo)
+		s.RegisterHandler("add_users", failUnknownUsers)
+
+		j := repo.claim(t, Job{Kind: "add_users", Items: NewItems([]string{"john", "unknown", "jane"})})
+		s.run(ctx, j)
+
+		got, _ := repo.GetByID(ctx, j.ID)
+		assert.Equal(t, StateCompleted, got.State)
+		assert.Equal(t, []Item{
+			{Key: "john", State: ItemStateSucceeded},
+			{Key: "unknown", State: ItemStateFailed, Error: errUnknownUser.Error()},
+			{Key: "jane", State: ItemStateSucceeded},
+		}, got.Items)
+	})
+	t.Run("should not run items processed before a restart again", func(t *testing.T) {
+		repo := newMemRepository()
+		s := NewService(log.NewNoop(), repo)
+		var processed []string
+		s.RegisterHandler("add_users", func(ctx context.Context, payload metadata.Metadata, key string) error {
+			processed = append(processed, key)
+			return nil
+		})
+
+		j := repo.claim(t, Job{Kind: "add_users", Items: []Item{
+			{Key: "john", State: ItemStateSucceeded},
+			{Key: "unknown", State: ItemStateFailed, Error: errUnknownUser.Error()},
+			{Key: "jane", State: ItemStatePending},
+		}})
+		s.run(ctx, j)
+
+		assert.Equal(t, []string{"jane"}, processed)
+		got, _ := repo.GetByID(ctx, j.ID)
+		assert.Equal(t, StateCompleted, got.State)
+		assert.Equal(t, ItemStateFailed, got.Items[1].State)
+	})
+	t.Run("should stop processing a job cancelled while running", func(t *testing.T) {
+		repo := newMemRepository()
+		s := NewService(log.NewNoop(), repo)
+
+		var keys []string
+		for i := 0; i < batchSize*2; i++ {
+			keys = append(keys, fmt.Sprintf("user-%d", i))
+		}
+		j := repo.claim(t, Job{Kind: "add_users", Items: NewItems(keys)})
+		processed := 0
+		s.RegisterHandler("add_users", func(ctx context.Context, payload metadata.Metadata, key string) error {
+			processed++
+			if processed == 10 {
+				_, err := repo.Cancel(ctx, j.ID)
+				assert.NoError(t, err)
+			}
+			return nil
+		})
+		s.run(ctx, j)
+
+		// cancellation is noticed when progress of the first batch is saved
+		assert.Equal(t, batchSize, processed)
+		got, _ := repo.GetByID(ctx, j.ID)
+		assert.Equal(t, StateCancelled, got.State)
+	})
+	t.Run("should queue the job again if the service is stopping", func(t *testing.T) {
+		repo := newMemRepository()
+		s := NewService(log.NewNoop(), repo)
+		s.RegisterHandler("add_users", failUnknownUsers)
+		close(s.stop)
+
+		j := repo.claim(t, Job{Kind: "add_users", Items: NewItems([]string{"john"})})
+		s.run(ctx, j)
+
+		got, _ := repo.GetByID(ctx, j.ID)
+		assert.Equal(t, StatePending, got.State)
+		assert.Equal(t, ItemStatePending, got.Items[0].State)
+	})
+	t.Run("should fail job of a kind without handler", func(t *testing.T) {
+		repo := newMemRepository()
+		s := NewService(log.NewNoop(), repo)
+
+		j := repo.claim(t, Job{Kind: "remove_users", Items: NewItems([]string{"john"})})
+		s.run(ctx, j)
+
+		got, _ := repo.GetByID(ctx, j.ID)
+		assert.Equal(t, StateFailed, got.State)
+		assert.Contains(t, got.Error, ErrUnknownKind.Error())
+	})
+}
+
+func TestService_InitWorkers(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemRepository()
+	s := NewService(log.NewNoop(), repo)
+	s.RegisterHandler("add_users", failUnknownUsers)
+
+	// a job left running by a stopped instance and one still reporting progress
+	stale := repo.claim(t, Job{Kind: "add_users", Items: []Item{
+		{Key: "john", State: ItemStateSucceeded},
+		{Key: "jane", State: ItemStatePending},
+	}})
+	stale.UpdatedAt = time.Now().Add(-2 * staleAfter)
+	repo.jobs[stale.ID] = stale
+	active := repo.claim(t, Job{Kind: "add_users", Items: NewItems([]string{"john"})})
+
+	assert.NoError(t, s.InitWorkers(ctx))
+	assert.Eventually(t, func() bool {
+		got, _ := repo.GetByID(ctx, stale.ID)
+		return got.State == StateCompleted
+	}, time.Second, time.Millisecond*10)
+	s.Close()
+
+	got, _ := repo.GetByID(ctx, stale.ID)
+	assert.Equal(t, ItemStateSucceeded, got.Items[1].State)
+	got, _ = repo.GetByID(ctx, active.ID)
+	assert.Equal(t, StateRunning, got.State)
+	assert.Equal(t, ItemStatePending, got.Items[0].State)
+}
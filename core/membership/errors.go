@@ -0,0 +1,7 @@
+package membership
+
+import "errors"
+
+var (
+	ErrLastGroupOwner = errors.New("group must have at least one owner")
+)
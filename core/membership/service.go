@@ -0,0 +1,140 @@
+package membership
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+
+	"github.com/raystack/frontier/core/audit"
+	"github.com/raystack/frontier/core/job"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/raystack/frontier/pkg/metadata"
+	"github.com/raystack/frontier/pkg/str"
+)
+
+const (
+	AddOrganizationUsersJob = "organization_add_users"
+	AddGroupUsersJob        = "group_add_users"
+	RemoveGroupUsersJob     = "group_remove_users"
+
+	orgIDKey              = "org_id"
+	groupIDKey            = "group_id"
+	createMissingUsersKey = "create_missing_users"
+)
+
+type OrganizationService interface {
+	AddUsers(ctx context.Context, orgID string, userID []string) error
+}
+
+type GroupService interface {
+	AddUsers(ctx context.Context, groupID string, userID []string) error
+	RemoveUsers(ctx context.Context, groupID string, userID []string) error
+}
+
+type UserService interface {
+	GetByID(ctx context.Context, id string) (user.User, error)
+	Create(ctx context.Context, user user.User) (user.User, error)
+	ListByGroup(ctx context.Context, groupID string, capability string) ([]user.User, error)
+}
+
+// RegisterJobs registers handlers of jobs queued by async membership
+// requests, each item is a user id or email
+func RegisterJobs(jobService *job.Service, orgService OrganizationService,
+	groupService GroupService, userService UserService) {
+	jobService.RegisterHandler(AddOrganizationUsersJob, func(ctx context.Context, payload metadata.Metadata, key string) error {
+		userID, err := resolveUser(ctx, userService, payload, key)
+		if err != nil {
+			return err
+		}
+		orgID := fmt.Sprint(payload[orgIDKey])
+		audit.GetAuditor(ctx, orgID).Log(audit.OrgMemberCreatedEvent, audit.UserTarget(userID))
+		return orgService.AddUsers(ctx, orgID, []string{userID})
+	})
+	jobService.RegisterHandler(AddGroupUsersJob, func(ctx context.Context, payload metadata.Metadata, key string) error {
+		userID, err := resolveUser(ctx, userService, payload, key)
+		if err != nil {
+			return err
+		}
+		return groupService.AddUsers(ctx, fmt.Sprint(payload[groupIDKey]), []string{userID})
+	})
+	jobService.RegisterHandler(RemoveGroupUsersJob, func(ctx context.Context, payload metadata.Metadata, key string) error {
+		usr, err := userService.GetByID(ctx, key)
+		if err != nil {
+			return err
+		}
+		groupID := fmt.Sprint(payload[groupIDKey])
+		owners, err := userService.ListByGroup(ctx, groupID, schema.DeletePermission)
+		if err != nil {
+			return err
+		}
+		if len(owners) == 1 && owners[0].ID == usr.ID {
+			return ErrLastGroupOwner
+		}
+		return groupService.RemoveUsers(ctx, groupID, []string{usr.ID})
+	})
+}
+
+// NewAddOrganizationUsersJob builds a job adding users of keys to an organization
+func NewAddOrganizationUsersJob(orgID string, keys []string, createMissingUsers bool, createdBy string) job.Job {
+	return job.Job{
+		Kind: AddOrganizationUsersJob,
+		Payload: metadata.Metadata{
+			orgIDKey:              orgID,
+			createMissingUsersKey: createMissingUsers,
+		},
+		Items:     job.NewItems(keys),
+		CreatedBy: createdBy,
+	}
+}
+
+// NewAddGroupUsersJob builds a job adding users of keys to a group
+func NewAddGroupUsersJob(groupID string, keys []string, createMissingUsers bool, createdBy string) job.Job {
+	return job.Job{
+		Kind: AddGroupUsersJob,
+		Payload: metadata.Metadata{
+			groupIDKey:            groupID,
+			createMissingUsersKey: createMissingUsers,
+		},
+		Items:     job.NewItems(keys),
+		CreatedBy: createdBy,
+	}
+}
+
+// NewRemoveGroupUsersJob builds a job removing users of keys from a group,
+// owners are checked for each user when the job runs
+func NewRemoveGroupUsersJob(groupID string, keys []string, createdBy string) job.Job {
+	return job.Job{
+		Kind: RemoveGroupUsersJob,
+		Payload: metadata.Metadata{
+			groupIDKey: groupID,
+		},
+		Items:     job.NewItems(keys),
+		CreatedBy: createdBy,
+	}
+}
+
+// resolveUser returns id of the user of an id or email, users of unknown
+// emails are created if requested by the job
+func resolveUser(ctx context.Context, userService UserService, payload metadata.Metadata, key string) (string, error) {
+	usr, err := userService.GetByID(ctx, key)
+	if err == nil {
+		return usr.ID, nil
+	}
+	createMissing, _ := payload[createMissingUsersKey].(bool)
+	if !errors.Is(err, user.ErrNotExist) || !createMissing {
+		return "", err
+	}
+	if _, parseErr := mail.ParseAddress(key); parseErr != nil {
+		return "", err
+	}
+	usr, err = userService.Create(ctx, user.User{
+		Email: key,
+		Name:  str.GenerateUserSlug(key),
+	})
+	if err != nil {
+		return "", err
+	}
+	return usr.ID, nil
+}
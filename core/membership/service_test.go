@@ -0,0 +1,69 @@
+package membership
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/pkg/metadata"
+	"github.com/stretchr/testify/assert"
+)
+
+type memUserService struct {
+	users map[string]user.User
+}
+
+func (m memUserService) GetByID(ctx context.Context, id string) (user.User, error) {
+	for _, u := range m.users {
+		if u.ID == id || u.Email == id {
+			return u, nil
+		}
+	}
+	return user.User{}, user.ErrNotExist
+}
+
+func (m memUserService) Create(ctx context.Context, u user.User) (user.User, error) {
+	u.ID = uuid.NewString()
+	m.users[u.ID] = u
+	return u, nil
+}
+
+func (m memUserService) ListByGroup(ctx context.Context, groupID string, capability string) ([]user.User, error) {
+	return nil, nil
+}
+
+func TestResolveUser(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should return id of an existing user", func(t *testing.T) {
+		users := memUserService{users: map[string]user.User{}}
+		john, _ := users.Create(ctx, user.User{Email: "john@acme.org"})
+
+		got, err := resolveUser(ctx, users, nil, "john@acme.org")
+		assert.NoError(t, err)
+		assert.Equal(t, john.ID, got)
+	})
+	t.Run("should create user of unknown email if requested", func(t *testing.T) {
+		users := memUserService{users: map[string]user.User{}}
+		job := NewAddOrganizationUsersJob("some-org-id", []string{"john@acme.org"}, true, "")
+
+		got, err := resolveUser(ctx, users, job.Payload, "john@acme.org")
+		assert.NoError(t, err)
+		assert.Equal(t, "john_acme_org", users.users[got].Name)
+	})
+	t.Run("should not create user of unknown email if not requested", func(t *testing.T) {
+		users := memUserService{users: map[string]user.User{}}
+		job := NewAddOrganizationUsersJob("some-org-id", []string{"john@acme.org"}, false, "")
+
+		_, err := resolveUser(ctx, users, job.Payload, "john@acme.org")
+		assert.ErrorIs(t, err, user.ErrNotExist)
+		assert.Empty(t, users.users)
+	})
+	t.Run("should not create user of an unknown id", func(t *testing.T) {
+		users := memUserService{users: map[string]user.User{}}
+
+		_, err := resolveUser(ctx, users, metadata.Metadata{createMissingUsersKey: true}, uuid.NewString())
+		assert.ErrorIs(t, err, user.ErrNotExist)
+	})
+}
@@ -0,0 +1,7 @@
+package merger
+
+import "errors"
+
+var (
+	ErrSameUser = errors.New("user can't be merged into itself")
+)
@@ -0,0 +1,226 @@
+package merger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/preference"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/resource"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+)
+
+type UserService interface {
+	GetByID(ctx context.Context, id string) (user.User, error)
+	Disable(ctx context.Context, id string) error
+}
+
+type RelationService interface {
+	Create(ctx context.Context, rel relation.Relation) (relation.Relation, error)
+	Delete(ctx context.Context, rel relation.Relation) error
+	GetRelationsByFields(ctx context.Context, rel relation.Relation) ([]relation.Relation, error)
+}
+
+type PolicyService interface {
+	List(ctx context.Context, flt policy.Filter) ([]policy.Policy, error)
+	Create(ctx context.Context, pol policy.Policy) (policy.Policy, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type ResourceService interface {
+	List(ctx context.Context, flt resource.Filter) ([]resource.Resource, error)
+	UpdatePrincipal(ctx context.Context, id, principalID, principalType string) error
+}
+
+type PreferenceService interface {
+	List(ctx context.Context, flt preference.Filter) ([]preference.Preference, error)
+	Create(ctx context.Context, pref preference.Preference) (preference.Preference, error)
+}
+
+// Summary counts what a merge moved from one user to another
+type Summary struct {
+	Policies    int
+	Relations   int
+	Resources   int
+	Preferences int
+}
+
+// Service merges accounts of a person who ended up with more than one user
+type Service struct {
+	userService       UserService
+	relationService   RelationService
+	policyService     PolicyService
+	resourceService   ResourceService
+	preferenceService PreferenceService
+}
+
+func NewService(userService UserService, relationService RelationService, policyService PolicyService,
+	resourceService ResourceService, preferenceService PreferenceService) *Service {
+	return &Service{
+		userService:       userService,
+		relationService:   relationService,
+		policyService:     policyService,
+		resourceService:   resourceService,
+		preferenceService: preferenceService,
+	}
+}
+
+// Merge moves policies, relations, resources and preferences of the source user
+// to the target user and disables the source. Memberships of orgs and groups and
+// ownership of service users are held in relations and move along with them.
+// Platform relations like superuser access aren't moved.
+func (s Service) Merge(ctx context.Context, sourceID, targetID string) (Summary, error) {
+	var summary Summary
+	source, err := s.userService.GetByID(ctx, sourceID)
+	if err != nil {
+		return summary, err
+	}
+	target, err := s.userService.GetByID(ctx, targetID)
+	if err != nil {
+		return summary, err
+	}
+	if source.ID == target.ID {
+		return summary, ErrSameUser
+	}
+
+	if summary.Policies, err = s.movePolicies(ctx, source.ID, target.ID); err != nil {
+		return summary, fmt.Errorf("moving policies: %w", err)
+	}
+	if summary.Relations, err = s.moveRelations(ctx, source.ID, target.ID); err != nil {
+		return summary, fmt.Errorf("moving relations: %w", err)
+	}
+	if summary.Resources, err = s.moveResources(ctx, source.ID, target.ID); err != nil {
+		return summary, fmt.Errorf("moving resources: %w", err)
+	}
+	if summary.Preferences, err = s.copyPreferences(ctx, source.ID, target.ID); err != nil {
+		return summary, fmt.Errorf("copying preferences: %w", err)
+	}
+	return summary, s.userService.Disable(ctx, source.ID)
+}
+
+// movePolicies recreates policies of the source for the target, policies the
+// target already has are left as is
+func (s Service) movePolicies(ctx context.Context, sourceID, targetID string) (int, error) {
+	policies, err := s.policyService.List(ctx, policy.Filter{
+		PrincipalID:   sourceID,
+		PrincipalType: schema.UserPrincipal,
+	})
+	if err != nil && !errors.Is(err, policy.ErrNotExist) {
+		return 0, err
+	}
+	for _, pol := range policies {
+		if _, err := s.policyService.Create(ctx, policy.Policy{
+			RoleID:        pol.RoleID,
+			ResourceID:    pol.ResourceID,
+			ResourceType:  pol.ResourceType,
+			PrincipalID:   targetID,
+			PrincipalType: schema.UserPrincipal,
+			Effect:        pol.Effect,
+			Metadata:      pol.Metadata,
+		}); err != nil {
+			return 0, err
+		}
+		if err := s.policyService.Delete(ctx, pol.ID); err != nil {
+			return 0, err
+		}
+	}
+	return len(policies), nil
+}
+
+// moveRelations points relations with the source as subject to the target, role
+// bindings are moved with their policies
+func (s Service) moveRelations(ctx context.Context, sourceID, targetID string) (int, error) {
+	relations, err := s.relationService.GetRelationsByFields(ctx, relation.Relation{
+		Subject: relation.Subject{
+			ID:        sourceID,
+			Namespace: schema.UserPrincipal,
+		},
+	})
+	if err != nil && !errors.Is(err, relation.ErrNotExist) {
+		return 0, err
+	}
+
+	moved := 0
+	for _, rel := range relations {
+		if rel.Object.Namespace == schema.PlatformNamespace ||
+			rel.Object.Namespace == schema.RoleBindingNamespace {
+			continue
+		}
+		if _, err := s.relationService.Create(ctx, relation.Relation{
+			Object: rel.Object,
+			Subject: relation.Subject{
+				ID:              targetID,
+				Namespace:       schema.UserPrincipal,
+				SubRelationName: rel.Subject.SubRelationName,
+			},
+			RelationName: rel.RelationName,
+		}); err != nil {
+			return 0, err
+		}
+		if err := s.relationService.Delete(ctx, relation.Relation{
+			Object:       rel.Object,
+			Subject:      rel.Subject,
+			RelationName: rel.RelationName,
+		}); err != nil {
+			return 0, err
+		}
+		moved++
+	}
+	return moved, nil
+}
+
+// moveResources makes the target the principal of resources created by the source
+func (s Service) moveResources(ctx context.Context, sourceID, targetID string) (int, error) {
+	resources, err := s.resourceService.List(ctx, resource.Filter{UserID: sourceID})
+	if err != nil && !errors.Is(err, resource.ErrNotExist) {
+		return 0, err
+	}
+	for _, res := range resources {
+		if err := s.resourceService.UpdatePrincipal(ctx, res.ID, targetID, schema.UserPrincipal); err != nil {
+			return 0, err
+		}
+	}
+	return len(resources), nil
+}
+
+// copyPreferences sets preferences of the source the target hasn't set
+func (s Service) copyPreferences(ctx context.Context, sourceID, targetID string) (int, error) {
+	sourcePrefs, err := s.preferenceService.List(ctx, preference.Filter{
+		ResourceID:   sourceID,
+		ResourceType: schema.UserPrincipal,
+	})
+	if err != nil {
+		return 0, err
+	}
+	targetPrefs, err := s.preferenceService.List(ctx, preference.Filter{
+		ResourceID:   targetID,
+		ResourceType: schema.UserPrincipal,
+	})
+	if err != nil {
+		return 0, err
+	}
+	isSet := map[string]bool{}
+	for _, pref := range targetPrefs {
+		isSet[pref.Name] = true
+	}
+
+	copied := 0
+	for _, pref := range sourcePrefs {
+		if isSet[pref.Name] {
+			continue
+		}
+		if _, err := s.preferenceService.Create(ctx, preference.Preference{
+			Name:         pref.Name,
+			Value:        pref.Value,
+			ResourceID:   targetID,
+			ResourceType: schema.UserPrincipal,
+		}); err != nil {
+			return 0, err
+		}
+		copied++
+	}
+	return copied, nil
+}
@@ -9,4 +9,8 @@ var (
 	ErrConflict      = errors.New("org already exist")
 	ErrInvalidDetail = errors.New("invalid org detail")
 	ErrDisabled      = errors.New("org is disabled")
+	ErrLastOwner     = errors.New("org must have at least one owner")
+	ErrNotOwner      = errors.New("user is not an owner of the org")
+
+	ErrTransferNotExist = errors.New("ownership transfer doesn't exist")
 )
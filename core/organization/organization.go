@@ -18,6 +18,8 @@ func (s State) String() string {
 const (
 	Enabled  State = "enabled"
 	Disabled State = "disabled"
+	// Deleted organizations are tombstoned until restored or purged
+	Deleted State = "deleted"
 
 	AdminPermission = schema.UpdatePermission
 	AdminRole       = schema.OwnerRelationName
@@ -32,6 +34,14 @@ type Repository interface {
 	UpdateByID(ctx context.Context, org Organization) (Organization, error)
 	UpdateByName(ctx context.Context, org Organization) (Organization, error)
 	SetState(ctx context.Context, id string, state State) error
+	Restore(ctx context.Context, id string) error
+	Delete(ctx context.Context, id string) error
+}
+
+// TransferRepository stores ownership transfers waiting to be accepted
+type TransferRepository interface {
+	Create(ctx context.Context, transfer Transfer) (Transfer, error)
+	Get(ctx context.Context, id string) (Transfer, error)
 	Delete(ctx context.Context, id string) error
 }
 
@@ -44,4 +54,15 @@ type Organization struct {
 	Avatar    string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	DeletedAt time.Time
+}
+
+// Transfer hands over ownership of an organization from one user to another,
+// it takes effect once the new owner accepts it
+type Transfer struct {
+	ID         string
+	OrgID      string
+	FromUserID string
+	ToUserID   string
+	CreatedAt  time.Time
 }
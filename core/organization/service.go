@@ -21,6 +21,7 @@ import (
 type RelationService interface {
 	Create(ctx context.Context, rel relation.Relation) (relation.Relation, error)
 	LookupResources(ctx context.Context, rel relation.Relation) ([]string, error)
+	LookupSubjects(ctx context.Context, rel relation.Relation) ([]string, error)
 	Delete(ctx context.Context, rel relation.Relation) error
 }
 
@@ -43,36 +44,42 @@ type PreferencesService interface {
 }
 
 type Service struct {
-	repository      Repository
-	relationService RelationService
-	userService     UserService
-	authnService    AuthnService
-	policyService   PolicyService
-	prefService     PreferencesService
+	repository         Repository
+	transferRepository TransferRepository
+	relationService    RelationService
+	userService        UserService
+	authnService       AuthnService
+	policyService      PolicyService
+	prefService        PreferencesService
 }
 
-func NewService(repository Repository, relationService RelationService,
+func NewService(repository Repository, transferRepository TransferRepository, relationService RelationService,
 	userService UserService, authnService AuthnService, policyService PolicyService,
 	prefService PreferencesService) *Service {
 	return &Service{
-		repository:      repository,
-		relationService: relationService,
-		userService:     userService,
-		authnService:    authnService,
-		policyService:   policyService,
-		prefService:     prefService,
+		repository:         repository,
+		transferRepository: transferRepository,
+		relationService:    relationService,
+		userService:        userService,
+		authnService:       authnService,
+		policyService:      policyService,
+		prefService:        prefService,
 	}
 }
 
 // Get returns an enabled organization by id or name. Will return `org is disabled` error if the organization is disabled
+// and `org doesn't exist` error if the organization is deleted
 func (s Service) Get(ctx context.Context, idOrName string) (Organization, error) {
 	if utils.IsValidUUID(idOrName) {
 		orgResp, err := s.repository.GetByID(ctx, idOrName)
 		if err != nil {
 			return Organization{}, err
 		}
-		if orgResp.State == Disabled {
+		switch orgResp.State {
+		case Disabled:
 			return Organization{}, ErrDisabled
+		case Deleted:
+			return Organization{}, ErrNotExist
 		}
 		return orgResp, nil
 	}
@@ -81,13 +88,16 @@ func (s Service) Get(ctx context.Context, idOrName string) (Organization, error)
 	if err != nil {
 		return Organization{}, err
 	}
-	if orgResp.State == Disabled {
+	switch orgResp.State {
+	case Disabled:
 		return Organization{}, ErrDisabled
+	case Deleted:
+		return Organization{}, ErrNotExist
 	}
 	return orgResp, nil
 }
 
-// GetRaw returns an organization(both enabled and disabled) by id or name
+// GetRaw returns an organization(enabled, disabled or deleted) by id or name
 func (s Service) GetRaw(ctx context.Context, idOrName string) (Organization, error) {
 	if utils.IsValidUUID(idOrName) {
 		return s.repository.GetByID(ctx, idOrName)
@@ -230,6 +240,10 @@ func (s Service) AddUsers(ctx context.Context, orgID string, userIDs []string) e
 // it doesn't remove user access to projects or other resources provided
 // by policies, don't call directly, use cascade deleter
 func (s Service) RemoveUsers(ctx context.Context, orgID string, userIDs []string) error {
+	if err := s.CheckOwnersLeft(ctx, orgID, userIDs); err != nil {
+		return err
+	}
+
 	var err error
 	for _, userID := range userIDs {
 		// remove all access via policies
@@ -272,6 +286,17 @@ func (s Service) Disable(ctx context.Context, id string) error {
 	return s.repository.SetState(ctx, id, Disabled)
 }
 
+// SoftDelete marks the organization as deleted, it can be restored until purged
+// by the cascade deleter
+func (s Service) SoftDelete(ctx context.Context, id string) error {
+	return s.repository.SetState(ctx, id, Deleted)
+}
+
+// Restore enables a soft deleted organization
+func (s Service) Restore(ctx context.Context, id string) error {
+	return s.repository.Restore(ctx, id)
+}
+
 // DeleteModel doesn't delete the nested resource, only itself
 func (s Service) DeleteModel(ctx context.Context, id string) error {
 	if err := s.relationService.Delete(ctx, relation.Relation{Object: relation.Object{
@@ -282,3 +307,203 @@ func (s Service) DeleteModel(ctx context.Context, id string) error {
 	}
 	return s.repository.Delete(ctx, id)
 }
+
+// owner is a grant of organization ownership to a user, either the owner relation
+// or a policy of the owner role in which case policyID is set
+type owner struct {
+	userID   string
+	policyID string
+}
+
+func (s Service) listOwners(ctx context.Context, orgID string) ([]owner, error) {
+	userIDs, err := s.relationService.LookupSubjects(ctx, relation.Relation{
+		Object: relation.Object{
+			ID:        orgID,
+			Namespace: schema.OrganizationNamespace,
+		},
+		Subject: relation.Subject{
+			Namespace: schema.UserPrincipal,
+		},
+		RelationName: schema.OwnerRelationName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	owners := utils.Map(userIDs, func(id string) owner {
+		return owner{userID: id}
+	})
+
+	policies, err := s.policyService.List(ctx, policy.Filter{
+		OrgID:         orgID,
+		RoleID:        schema.RoleOrganizationOwner,
+		PrincipalType: schema.UserPrincipal,
+	})
+	if err != nil && !errors.Is(err, policy.ErrNotExist) {
+		return nil, err
+	}
+	for _, pol := range policies {
+		owners = append(owners, owner{userID: pol.PrincipalID, policyID: pol.ID})
+	}
+	return owners, nil
+}
+
+// ensureOwnerLeft fails if an organization with owners is left with none once
+// the removed grants are gone, organizations without owners are repaired by admins
+func ensureOwnerLeft(owners []owner, removed func(o owner) bool) error {
+	if len(owners) == 0 {
+		return nil
+	}
+	for _, o := range owners {
+		if !removed(o) {
+			return nil
+		}
+	}
+	return ErrLastOwner
+}
+
+// ListOwnerIDs returns ids of users owning the organization via the owner relation
+// or a policy of the owner role
+func (s Service) ListOwnerIDs(ctx context.Context, orgID string) ([]string, error) {
+	owners, err := s.listOwners(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	var userIDs []string
+	for _, o := range owners {
+		if !utils.Contains(userIDs, o.userID) {
+			userIDs = append(userIDs, o.userID)
+		}
+	}
+	return userIDs, nil
+}
+
+// CheckOwnersLeft returns ErrLastOwner if removing the users leaves the organization
+// without an owner
+func (s Service) CheckOwnersLeft(ctx context.Context, orgID string, userIDs []string) error {
+	owners, err := s.listOwners(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	return ensureOwnerLeft(owners, func(o owner) bool {
+		return utils.Contains(userIDs, o.userID)
+	})
+}
+
+// CheckPolicyDelete returns ErrLastOwner if deleting the policy leaves its
+// organization without an owner
+func (s Service) CheckPolicyDelete(ctx context.Context, pol policy.Policy) error {
+	if pol.ResourceType != schema.OrganizationNamespace {
+		return nil
+	}
+	owners, err := s.listOwners(ctx, pol.ResourceID)
+	if err != nil {
+		return err
+	}
+	return ensureOwnerLeft(owners, func(o owner) bool {
+		return o.policyID == pol.ID
+	})
+}
+
+// AddOwner makes the user an owner of the organization
+func (s Service) AddOwner(ctx context.Context, orgID, userID string) error {
+	return s.AddMember(ctx, orgID, schema.OwnerRelationName, authenticate.Principal{
+		ID:   userID,
+		Type: schema.UserPrincipal,
+	})
+}
+
+// RequestTransfer starts handing over ownership of the organization from an owner
+// to another user, the transfer takes effect once the new owner accepts it
+func (s Service) RequestTransfer(ctx context.Context, orgID, fromUserID, toUserID string) (Transfer, error) {
+	ownerIDs, err := s.ListOwnerIDs(ctx, orgID)
+	if err != nil {
+		return Transfer{}, err
+	}
+	if !utils.Contains(ownerIDs, fromUserID) {
+		return Transfer{}, ErrNotOwner
+	}
+
+	toUser, err := s.userService.GetByID(ctx, toUserID)
+	if err != nil {
+		return Transfer{}, err
+	}
+	if toUser.ID == fromUserID {
+		return Transfer{}, ErrInvalidDetail
+	}
+	return s.transferRepository.Create(ctx, Transfer{
+		OrgID:      orgID,
+		FromUserID: fromUserID,
+		ToUserID:   toUser.ID,
+	})
+}
+
+// AcceptTransfer makes the user an owner of the organization in place of the owner
+// who requested the transfer, the previous owner stays a member
+func (s Service) AcceptTransfer(ctx context.Context, orgID, id, userID string) (Transfer, error) {
+	transfer, err := s.transferRepository.Get(ctx, id)
+	if err != nil {
+		return Transfer{}, err
+	}
+	if transfer.OrgID != orgID || transfer.ToUserID != userID {
+		return Transfer{}, ErrTransferNotExist
+	}
+
+	if err = s.AddOwner(ctx, transfer.OrgID, transfer.ToUserID); err != nil {
+		return Transfer{}, err
+	}
+	if err = s.AddMember(ctx, transfer.OrgID, schema.MemberRelationName, authenticate.Principal{
+		ID:   transfer.FromUserID,
+		Type: schema.UserPrincipal,
+	}); err != nil {
+		return Transfer{}, err
+	}
+	if err = s.removeOwner(ctx, transfer.OrgID, transfer.FromUserID); err != nil {
+		return Transfer{}, err
+	}
+	return transfer, s.transferRepository.Delete(ctx, transfer.ID)
+}
+
+// DeclineTransfer drops a transfer requested for the user, ownership stays with the
+// owner who requested it
+func (s Service) DeclineTransfer(ctx context.Context, orgID, id, userID string) (Transfer, error) {
+	transfer, err := s.transferRepository.Get(ctx, id)
+	if err != nil {
+		return Transfer{}, err
+	}
+	if transfer.OrgID != orgID || transfer.ToUserID != userID {
+		return Transfer{}, ErrTransferNotExist
+	}
+	return transfer, s.transferRepository.Delete(ctx, transfer.ID)
+}
+
+// removeOwner removes the owner relation and owner policies of the user
+func (s Service) removeOwner(ctx context.Context, orgID, userID string) error {
+	if err := s.relationService.Delete(ctx, relation.Relation{
+		Object: relation.Object{
+			ID:        orgID,
+			Namespace: schema.OrganizationNamespace,
+		},
+		Subject: relation.Subject{
+			ID:        userID,
+			Namespace: schema.UserPrincipal,
+		},
+		RelationName: schema.OwnerRelationName,
+	}); err != nil && !errors.Is(err, relation.ErrNotExist) {
+		return err
+	}
+
+	policies, err := s.policyService.List(ctx, policy.Filter{
+		OrgID:       orgID,
+		RoleID:      schema.RoleOrganizationOwner,
+		PrincipalID: userID,
+	})
+	if err != nil && !errors.Is(err, policy.ErrNotExist) {
+		return err
+	}
+	for _, pol := range policies {
+		if err = s.policyService.Delete(ctx, pol.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+package pat
+
+import "time"
+
+type Config struct {
+	// DefaultLifetime is how long a token is valid for if it's created without an expiry
+	DefaultLifetime time.Duration `yaml:"default_lifetime" mapstructure:"default_lifetime" default:"720h"`
+	// MaxLifetime is the longest a token can be valid for
+	MaxLifetime time.Duration `yaml:"max_lifetime" mapstructure:"max_lifetime" default:"8760h"`
+}
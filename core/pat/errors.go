@@ -0,0 +1,10 @@
+package pat
+
+import "errors"
+
+var (
+	ErrNotExist      = errors.New("personal access token doesn't exist")
+	ErrExpired       = errors.New("personal access token is expired")
+	ErrInvalidToken  = errors.New("invalid personal access token")
+	ErrInvalidDetail = errors.New("invalid personal access token detail")
+)
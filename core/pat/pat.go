@@ -0,0 +1,48 @@
+package pat
+
+import (
+	"context"
+	"time"
+
+	"github.com/raystack/frontier/core/serviceuser"
+)
+
+// TokenPrefix marks personal access tokens apart from jwts sent in the same header
+const TokenPrefix = "fpt_"
+
+type Repository interface {
+	Create(ctx context.Context, token Token) (Token, error)
+	List(ctx context.Context, flt Filter) ([]Token, error)
+	Get(ctx context.Context, id string) (Token, error)
+	GetBySecretHash(ctx context.Context, secretHash string) (Token, error)
+	Delete(ctx context.Context, id string) error
+	UpdateUsage(ctx context.Context, id string, usedAt time.Time) error
+}
+
+// Token is a long-lived credential of a user for scripts and tools, it
+// authenticates as the user narrowed to its scope
+type Token struct {
+	ID     string
+	UserID string
+	Title  string
+	// SecretHash is the sha256 hash of the token, the token itself is only
+	// returned once when it's created
+	SecretHash string
+	Scope      serviceuser.Scope
+	ExpiresAt  time.Time
+	LastUsedAt time.Time
+	CreatedAt  time.Time
+
+	// Value is the token, only set when it's created
+	Value string
+}
+
+func (t Token) IsExpired(now time.Time) bool {
+	return !t.ExpiresAt.After(now)
+}
+
+type Filter struct {
+	UserID string
+	// OrgID lists tokens scoped to the org
+	OrgID string
+}
@@ -0,0 +1,106 @@
+package pat
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/raystack/frontier/pkg/utils"
+	"github.com/raystack/salt/log"
+)
+
+// usageInterval is how often usage of a token is recorded at most
+const usageInterval = time.Minute
+
+type Service struct {
+	log        log.Logger
+	config     Config
+	repository Repository
+	Now        func() time.Time
+}
+
+func NewService(logger log.Logger, config Config, repository Repository) *Service {
+	return &Service{
+		log:        logger,
+		config:     config,
+		repository: repository,
+		Now: func() time.Time {
+			return time.Now().UTC()
+		},
+	}
+}
+
+// Create generates a token for the user, the token is returned in Value and
+// only its hash is stored
+func (s Service) Create(ctx context.Context, token Token) (Token, error) {
+	token.Title = strings.TrimSpace(token.Title)
+	if token.UserID == "" || token.Title == "" {
+		return Token{}, ErrInvalidDetail
+	}
+	now := s.Now()
+	if token.ExpiresAt.IsZero() {
+		token.ExpiresAt = now.Add(s.config.DefaultLifetime)
+	}
+	if token.IsExpired(now) || (s.config.MaxLifetime > 0 && token.ExpiresAt.After(now.Add(s.config.MaxLifetime))) {
+		return Token{}, ErrInvalidDetail
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return Token{}, err
+	}
+	value := TokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+	token.SecretHash = utils.HashToken(value)
+
+	created, err := s.repository.Create(ctx, token)
+	if err != nil {
+		return Token{}, err
+	}
+	created.Value = value
+	return created, nil
+}
+
+func (s Service) List(ctx context.Context, flt Filter) ([]Token, error) {
+	return s.repository.List(ctx, flt)
+}
+
+func (s Service) Get(ctx context.Context, id string) (Token, error) {
+	return s.repository.Get(ctx, id)
+}
+
+// Revoke deletes the token, it's rejected right away
+func (s Service) Revoke(ctx context.Context, id string) error {
+	return s.repository.Delete(ctx, id)
+}
+
+// GetByValue returns the token if it's valid and records its usage
+func (s Service) GetByValue(ctx context.Context, value string) (Token, error) {
+	if !strings.HasPrefix(value, TokenPrefix) {
+		return Token{}, ErrInvalidToken
+	}
+	token, err := s.repository.GetBySecretHash(ctx, utils.HashToken(value))
+	if err != nil {
+		if errors.Is(err, ErrNotExist) {
+			return Token{}, ErrInvalidToken
+		}
+		return Token{}, err
+	}
+	now := s.Now()
+	if token.IsExpired(now) {
+		return Token{}, ErrExpired
+	}
+
+	// usage is written in the background to keep it off the request path
+	if now.Sub(token.LastUsedAt) >= usageInterval {
+		go func(ctx context.Context) {
+			if err := s.repository.UpdateUsage(ctx, token.ID, now); err != nil {
+				s.log.Warn("failed to update usage of personal access token", "id", token.ID, "err", err)
+			}
+		}(context.WithoutCancel(ctx))
+		token.LastUsedAt = now
+	}
+	return token, nil
+}
@@ -1,5 +1,7 @@
 package policy
 
+import "github.com/raystack/frontier/pkg/pagination"
+
 type Filter struct {
 	PrincipalType string
 	PrincipalID   string
@@ -7,4 +9,8 @@ type Filter struct {
 	ProjectID     string
 	GroupID       string
 	RoleID        string
+	Effect        Effect
+
+	// Pagination is optional, all items are listed if not set
+	Pagination *pagination.Pagination
 }
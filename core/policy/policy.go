@@ -14,6 +14,20 @@ type Repository interface {
 	Delete(ctx context.Context, id string) error
 }
 
+type Effect string
+
+func (e Effect) String() string {
+	return string(e)
+}
+
+const (
+	// Allow grants permissions of the role to the principal
+	Allow Effect = "allow"
+	// Deny takes away permissions of the role from the principal
+	// even if they are granted by other policies
+	Deny Effect = "deny"
+)
+
 type Policy struct {
 	ID            string
 	RoleID        string
@@ -21,6 +35,7 @@ type Policy struct {
 	ResourceType  string `json:"resource_type"`
 	PrincipalID   string `json:"principal_id"`
 	PrincipalType string `json:"principal_type"`
+	Effect        Effect `json:"effect"`
 	Metadata      metadata.Metadata
 
 	CreatedAt time.Time
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/raystack/frontier/core/role"
+	"github.com/raystack/frontier/pkg/utils"
 
 	"github.com/raystack/frontier/core/relation"
 	"github.com/raystack/frontier/internal/bootstrap/schema"
@@ -37,6 +38,14 @@ func (s Service) Get(ctx context.Context, id string) (Policy, error) {
 }
 
 func (s Service) List(ctx context.Context, f Filter) ([]Policy, error) {
+	if f.RoleID != "" && !utils.IsValidUUID(f.RoleID) {
+		// get role id if it was passed by name
+		policyRole, err := s.roleService.Get(ctx, f.RoleID)
+		if err != nil {
+			return nil, err
+		}
+		f.RoleID = policyRole.ID
+	}
 	return s.repository.List(ctx, f)
 }
 
@@ -48,6 +57,14 @@ func (s Service) Create(ctx context.Context, policy Policy) (Policy, error) {
 	}
 	policy.RoleID = policyRole.ID
 
+	switch policy.Effect {
+	case "":
+		policy.Effect = Allow
+	case Allow, Deny:
+	default:
+		return Policy{}, ErrInvalidDetail
+	}
+
 	createdPolicy, err := s.repository.Upsert(ctx, policy)
 	if err != nil {
 		return Policy{}, err
@@ -109,7 +126,12 @@ func (s Service) AssignRole(ctx context.Context, pol Policy) error {
 		return err
 	}
 
-	// bind policy to resource
+	// bind policy to resource, deny policies are bound with a separate
+	// relation which is excluded from the permissions of the resource
+	bindingRelationName := schema.RoleGrantRelationName
+	if pol.Effect == Deny {
+		bindingRelationName = schema.RoleDenyRelationName
+	}
 	_, err = s.relationService.Create(ctx, relation.Relation{
 		Object: relation.Object{
 			ID:        pol.ResourceID,
@@ -119,7 +141,7 @@ func (s Service) AssignRole(ctx context.Context, pol Policy) error {
 			ID:        pol.ID,
 			Namespace: schema.RoleBindingNamespace,
 		},
-		RelationName: schema.RoleGrantRelationName,
+		RelationName: bindingRelationName,
 	})
 	if err != nil {
 		return err
@@ -132,6 +154,7 @@ func (s Service) ListForUser(ctx context.Context, userID, objectNamespace, objec
 	flt := Filter{
 		PrincipalType: schema.UserPrincipal,
 		PrincipalID:   userID,
+		Effect:        Allow,
 	}
 	switch objectNamespace {
 	case schema.OrganizationNamespace:
@@ -0,0 +1,156 @@
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/role"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// memRepository upserts policies on the same unique key as the postgres repository
+type memRepository struct {
+	policies []Policy
+}
+
+func (r *memRepository) Get(ctx context.Context, id string) (Policy, error) {
+	for _, p := range r.policies {
+		if p.ID == id {
+			return p, nil
+		}
+	}
+	return Policy{}, ErrNotExist
+}
+
+func (r *memRepository) List(ctx context.Context, f Filter) ([]Policy, error) {
+	var policies []Policy
+	for _, p := range r.policies {
+		if (f.PrincipalID == "" || p.PrincipalID == f.PrincipalID) &&
+			(f.PrincipalType == "" || p.PrincipalType == f.PrincipalType) &&
+			(f.OrgID == "" || p.ResourceID == f.OrgID) &&
+			(f.RoleID == "" || p.RoleID == f.RoleID) &&
+			(f.Effect == "" || p.Effect == f.Effect) {
+			policies = append(policies, p)
+		}
+	}
+	return policies, nil
+}
+
+func (r *memRepository) Upsert(ctx context.Context, pol Policy) (Policy, error) {
+	for _, p := range r.policies {
+		if p.RoleID == pol.RoleID && p.ResourceID == pol.ResourceID && p.ResourceType == pol.ResourceType &&
+			p.PrincipalID == pol.PrincipalID && p.PrincipalType == pol.PrincipalType && p.Effect == pol.Effect {
+			return p, nil
+		}
+	}
+	pol.ID = uuid.NewString()
+	r.policies = append(r.policies, pol)
+	return pol, nil
+}
+
+func (r *memRepository) Delete(ctx context.Context, id string) error {
+	for i, p := range r.policies {
+		if p.ID == id {
+			r.policies = append(r.policies[:i], r.policies[i+1:]...)
+			return nil
+		}
+	}
+	return ErrNotExist
+}
+
+type memRelationService struct {
+	rels []relation.Relation
+}
+
+func (s *memRelationService) Create(ctx context.Context, rel relation.Relation) (relation.Relation, error) {
+	s.rels = append(s.rels, rel)
+	return rel, nil
+}
+
+func (s *memRelationService) Delete(ctx context.Context, rel relation.Relation) error {
+	return nil
+}
+
+// bindings returns the relation names policies are bound to the resource with
+func (s *memRelationService) bindings(resourceID string) map[string]string {
+	bindings := map[string]string{}
+	for _, rel := range s.rels {
+		if rel.Object.ID == resourceID && rel.Subject.Namespace == schema.RoleBindingNamespace {
+			bindings[rel.Subject.ID] = rel.RelationName
+		}
+	}
+	return bindings
+}
+
+type memRoleService struct{}
+
+func (memRoleService) Get(ctx context.Context, id string) (role.Role, error) {
+	return role.Role{ID: id, Name: id}, nil
+}
+
+func TestService_Create(t *testing.T) {
+	ctx := context.Background()
+	toCreate := Policy{
+		RoleID:        uuid.NewString(),
+		ResourceID:    uuid.NewString(),
+		ResourceType:  schema.OrganizationNamespace,
+		PrincipalID:   uuid.NewString(),
+		PrincipalType: schema.UserPrincipal,
+	}
+
+	t.Run("should create a separate deny policy for a principal already allowed the role", func(t *testing.T) {
+		relations := &memRelationService{}
+		svc := NewService(&memRepository{}, relations, memRoleService{})
+
+		allowed, err := svc.Create(ctx, toCreate)
+		assert.NoError(t, err)
+		assert.Equal(t, Allow, allowed.Effect)
+
+		deny := toCreate
+		deny.Effect = Deny
+		denied, err := svc.Create(ctx, deny)
+		assert.NoError(t, err)
+		assert.Equal(t, Deny, denied.Effect)
+		assert.NotEqual(t, allowed.ID, denied.ID)
+
+		assert.Equal(t, map[string]string{
+			allowed.ID: schema.RoleGrantRelationName,
+			denied.ID:  schema.RoleDenyRelationName,
+		}, relations.bindings(toCreate.ResourceID))
+	})
+	t.Run("should fail for an unknown effect", func(t *testing.T) {
+		svc := NewService(&memRepository{}, &memRelationService{}, memRoleService{})
+		invalid := toCreate
+		invalid.Effect = "maybe"
+		_, err := svc.Create(ctx, invalid)
+		assert.ErrorIs(t, err, ErrInvalidDetail)
+	})
+}
+
+func TestService_ListForUser(t *testing.T) {
+	ctx := context.Background()
+	svc := NewService(&memRepository{}, &memRelationService{}, memRoleService{})
+	orgID, userID := uuid.NewString(), uuid.NewString()
+
+	viewer := Policy{
+		RoleID:        "viewer",
+		ResourceID:    orgID,
+		ResourceType:  schema.OrganizationNamespace,
+		PrincipalID:   userID,
+		PrincipalType: schema.UserPrincipal,
+	}
+	_, err := svc.Create(ctx, viewer)
+	assert.NoError(t, err)
+	owner := viewer
+	owner.RoleID = "owner"
+	owner.Effect = Deny
+	_, err = svc.Create(ctx, owner)
+	assert.NoError(t, err)
+
+	roles, err := svc.ListForUser(ctx, userID, schema.OrganizationNamespace, orgID)
+	assert.NoError(t, err)
+	assert.Equal(t, []role.Role{{ID: "viewer", Name: "viewer"}}, roles)
+}
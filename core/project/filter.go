@@ -1,8 +1,16 @@
 package project
 
+import "github.com/raystack/frontier/pkg/pagination"
+
 type Filter struct {
 	// only one filter gets applied at a time
 
-	OrgID string
-	State State
+	OrgID    string
+	FolderID string
+	State    State
+	// IncludeDeleted lists soft deleted entities along with the ones in State
+	IncludeDeleted bool
+
+	// Pagination is optional, all items are listed if not set
+	Pagination *pagination.Pagination
 }
@@ -19,6 +19,8 @@ func (s State) String() string {
 const (
 	Enabled  State = "enabled"
 	Disabled State = "disabled"
+	// Deleted projects are tombstoned until restored or purged
+	Deleted State = "deleted"
 )
 
 var AdminPermission = schema.DeletePermission
@@ -35,6 +37,8 @@ type Repository interface {
 	UpdateByName(ctx context.Context, toUpdate Project) (Project, error)
 	Delete(ctx context.Context, id string) error
 	SetState(ctx context.Context, id string, state State) error
+	Restore(ctx context.Context, id string) error
+	SetFolder(ctx context.Context, id, folderID string) error
 }
 
 type Project struct {
@@ -42,9 +46,11 @@ type Project struct {
 	Name         string
 	Title        string
 	Organization organization.Organization
+	FolderID     string // empty if project sits directly under the organization
 	State        State
 	Metadata     metadata.Metadata
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	DeletedAt time.Time
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/raystack/frontier/core/folder"
 	"github.com/raystack/frontier/core/group"
 
 	"github.com/raystack/frontier/core/serviceuser"
@@ -49,6 +50,10 @@ type GroupService interface {
 	GetByIDs(ctx context.Context, ids []string) ([]group.Group, error)
 }
 
+type FolderService interface {
+	Get(ctx context.Context, id string) (folder.Folder, error)
+}
+
 type Service struct {
 	repository      Repository
 	relationService RelationService
@@ -57,11 +62,12 @@ type Service struct {
 	policyService   PolicyService
 	authnService    AuthnService
 	groupService    GroupService
+	folderService   FolderService
 }
 
 func NewService(repository Repository, relationService RelationService, userService UserService,
 	policyService PolicyService, authnService AuthnService, suserService ServiceuserService,
-	groupService GroupService) *Service {
+	groupService GroupService, folderService FolderService) *Service {
 	return &Service{
 		repository:      repository,
 		relationService: relationService,
@@ -70,6 +76,7 @@ func NewService(repository Repository, relationService RelationService, userServ
 		authnService:    authnService,
 		suserService:    suserService,
 		groupService:    groupService,
+		folderService:   folderService,
 	}
 }
 
@@ -89,6 +96,11 @@ func (s Service) Create(ctx context.Context, prj Project) (Project, error) {
 	if err != nil {
 		return Project{}, err
 	}
+	if prj.FolderID != "" {
+		if err = s.validateFolder(ctx, prj.FolderID, prj.Organization.ID); err != nil {
+			return Project{}, err
+		}
+	}
 
 	newProject, err := s.repository.Create(ctx, prj)
 	if err != nil {
@@ -98,6 +110,11 @@ func (s Service) Create(ctx context.Context, prj Project) (Project, error) {
 	if err = s.addProjectToOrg(ctx, newProject, prj.Organization.ID); err != nil {
 		return Project{}, err
 	}
+	if newProject.FolderID != "" {
+		if err = s.addProjectToFolder(ctx, newProject.ID, newProject.FolderID); err != nil {
+			return Project{}, err
+		}
+	}
 
 	// make user administrator of the project
 	if _, err = s.policyService.Create(ctx, policy.Policy{
@@ -256,6 +273,70 @@ func (s Service) addProjectToOrg(ctx context.Context, prj Project, orgID string)
 	return nil
 }
 
+// Move places the project inside a folder of its organization,
+// an empty folderID moves it directly under the organization
+func (s Service) Move(ctx context.Context, id, folderID string) (Project, error) {
+	existing, err := s.Get(ctx, id)
+	if err != nil {
+		return Project{}, err
+	}
+	if existing.FolderID == folderID {
+		return existing, nil
+	}
+	if folderID != "" {
+		if err = s.validateFolder(ctx, folderID, existing.Organization.ID); err != nil {
+			return Project{}, err
+		}
+	}
+
+	if err = s.repository.SetFolder(ctx, existing.ID, folderID); err != nil {
+		return Project{}, err
+	}
+	if err = s.relationService.Delete(ctx, relation.Relation{
+		Object: relation.Object{
+			ID:        existing.ID,
+			Namespace: schema.ProjectNamespace,
+		},
+		RelationName: schema.FolderRelationName,
+	}); err != nil && !errors.Is(err, relation.ErrNotExist) {
+		return Project{}, err
+	}
+	if folderID != "" {
+		if err = s.addProjectToFolder(ctx, existing.ID, folderID); err != nil {
+			return Project{}, err
+		}
+	}
+
+	existing.FolderID = folderID
+	return existing, nil
+}
+
+func (s Service) validateFolder(ctx context.Context, folderID, orgID string) error {
+	prjFolder, err := s.folderService.Get(ctx, folderID)
+	if err != nil {
+		return err
+	}
+	if prjFolder.OrgID != orgID {
+		return fmt.Errorf("folder belongs to another org: %w", ErrInvalidDetail)
+	}
+	return nil
+}
+
+func (s Service) addProjectToFolder(ctx context.Context, id, folderID string) error {
+	_, err := s.relationService.Create(ctx, relation.Relation{
+		Object: relation.Object{
+			ID:        id,
+			Namespace: schema.ProjectNamespace,
+		},
+		Subject: relation.Subject{
+			ID:        folderID,
+			Namespace: schema.FolderNamespace,
+		},
+		RelationName: schema.FolderRelationName,
+	})
+	return err
+}
+
 func (s Service) Enable(ctx context.Context, id string) error {
 	return s.repository.SetState(ctx, id, Enabled)
 }
@@ -264,6 +345,17 @@ func (s Service) Disable(ctx context.Context, id string) error {
 	return s.repository.SetState(ctx, id, Disabled)
 }
 
+// SoftDelete marks the project as deleted, it can be restored until purged
+// by the cascade deleter
+func (s Service) SoftDelete(ctx context.Context, id string) error {
+	return s.repository.SetState(ctx, id, Deleted)
+}
+
+// Restore enables a soft deleted project
+func (s Service) Restore(ctx context.Context, id string) error {
+	return s.repository.Restore(ctx, id)
+}
+
 // DeleteModel doesn't delete the nested resource, only itself
 func (s Service) DeleteModel(ctx context.Context, id string) error {
 	// delete all relations where resource is an object
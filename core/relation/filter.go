@@ -0,0 +1,8 @@
+package relation
+
+import "github.com/raystack/frontier/pkg/pagination"
+
+type Filter struct {
+	// Pagination is optional, all relations are listed if not set
+	Pagination *pagination.Pagination
+}
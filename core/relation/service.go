@@ -41,8 +41,8 @@ func (s Service) Create(ctx context.Context, rel Relation) (Relation, error) {
 	return createdRelation, nil
 }
 
-func (s Service) List(ctx context.Context) ([]Relation, error) {
-	return s.repository.List(ctx)
+func (s Service) List(ctx context.Context, flt Filter) ([]Relation, error) {
+	return s.repository.List(ctx, flt)
 }
 
 func (s Service) GetRelationsByFields(ctx context.Context, rel Relation) ([]Relation, error) {
@@ -66,6 +66,37 @@ func (s Service) Delete(ctx context.Context, rel Relation) error {
 	return nil
 }
 
+// Suspend removes the relations matching the fields from the authz engine but
+// keeps them in store, suspended relations are added back with Resume
+func (s Service) Suspend(ctx context.Context, rel Relation) error {
+	fetchedRels, err := s.GetRelationsByFields(ctx, rel)
+	if err != nil {
+		return err
+	}
+
+	for _, fetchedRel := range fetchedRels {
+		if err = s.authzRepository.Delete(ctx, fetchedRel); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Resume adds the stored relations matching the fields back to the authz engine
+func (s Service) Resume(ctx context.Context, rel Relation) error {
+	fetchedRels, err := s.GetRelationsByFields(ctx, rel)
+	if err != nil {
+		return err
+	}
+
+	for _, fetchedRel := range fetchedRels {
+		if err = s.authzRepository.Add(ctx, fetchedRel); err != nil {
+			return fmt.Errorf("%w: %s", ErrCreatingRelationInAuthzEngine, err.Error())
+		}
+	}
+	return nil
+}
+
 func (s Service) CheckPermission(ctx context.Context, rel Relation) (bool, error) {
 	return s.authzRepository.Check(ctx, rel)
 }
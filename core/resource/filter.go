@@ -1,8 +1,13 @@
 package resource
 
+import "github.com/raystack/frontier/pkg/pagination"
+
 type Filter struct {
 	ProjectID     string
 	UserID        string
 	ServiceUserID string
 	NamespaceID   string
+
+	// Pagination is optional, all items are listed if not set
+	Pagination *pagination.Pagination
 }
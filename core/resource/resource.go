@@ -16,6 +16,7 @@ type Repository interface {
 	Create(ctx context.Context, resource Resource) (Resource, error)
 	List(ctx context.Context, flt Filter) ([]Resource, error)
 	Update(ctx context.Context, resource Resource) (Resource, error)
+	UpdatePrincipal(ctx context.Context, id, principalID, principalType string) error
 	Delete(ctx context.Context, id string) error
 }
 
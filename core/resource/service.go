@@ -9,7 +9,9 @@ import (
 	"github.com/raystack/frontier/core/authenticate"
 
 	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/permission"
 	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/core/serviceuser"
 	"github.com/raystack/frontier/pkg/utils"
 
 	"github.com/raystack/frontier/core/relation"
@@ -125,6 +127,12 @@ func (s Service) Update(ctx context.Context, resource Resource) (Resource, error
 	return s.repository.Update(ctx, resource)
 }
 
+// UpdatePrincipal changes the principal stored as creator of the resource, owner
+// relations of the resource are left as is
+func (s Service) UpdatePrincipal(ctx context.Context, id, principalID, principalType string) error {
+	return s.repository.UpdatePrincipal(ctx, id, principalID, principalType)
+}
+
 func (s Service) AddProjectToResource(ctx context.Context, projectID string, res Resource) error {
 	rel := relation.Relation{
 		Object: relation.Object{
@@ -162,7 +170,7 @@ func (s Service) AddResourceOwner(ctx context.Context, res Resource) error {
 }
 
 func (s Service) CheckAuthz(ctx context.Context, check Check) (bool, error) {
-	relSubject, err := s.buildRelationSubject(ctx, check.Subject)
+	relSubject, scope, err := s.buildRelationSubject(ctx, check.Subject)
 	if err != nil {
 		return false, err
 	}
@@ -172,6 +180,12 @@ func (s Service) CheckAuthz(ctx context.Context, check Check) (bool, error) {
 		return false, err
 	}
 
+	if scope != nil {
+		if allowed, err := s.ScopeAllows(ctx, *scope, relObject, check.Permission); err != nil || !allowed {
+			return false, err
+		}
+	}
+
 	return s.relationService.CheckPermission(ctx, relation.Relation{
 		Subject:      relSubject,
 		Object:       relObject,
@@ -179,20 +193,61 @@ func (s Service) CheckAuthz(ctx context.Context, check Check) (bool, error) {
 	})
 }
 
-func (s Service) buildRelationSubject(ctx context.Context, sub relation.Subject) (relation.Subject, error) {
+// buildRelationSubject returns the subject of the check along with the scope of
+// the principal if the check is made for the current principal
+func (s Service) buildRelationSubject(ctx context.Context, sub relation.Subject) (relation.Subject, *serviceuser.Scope, error) {
 	// use existing if passed in request
 	if sub.ID != "" && sub.Namespace != "" {
-		return sub, nil
+		return sub, nil, nil
 	}
 
 	principal, err := s.authnService.GetPrincipal(ctx)
 	if err != nil {
-		return relation.Subject{}, err
+		return relation.Subject{}, nil, err
 	}
 	return relation.Subject{
 		ID:        principal.ID,
 		Namespace: principal.Type,
-	}, nil
+	}, principal.Scope, nil
+}
+
+// ScopeAllows checks if a permission on the object is within the scope of a
+// credential, it narrows the policies of the principal and doesn't grant
+// anything by itself
+func (s Service) ScopeAllows(ctx context.Context, scope serviceuser.Scope, obj relation.Object, permissionName string) (bool, error) {
+	slug := permission.ParsePermissionName(permission.AddNamespaceIfRequired(obj.Namespace, permissionName))
+	if !scope.AllowsPermission(slug) {
+		return false, nil
+	}
+	if len(scope.ProjectIDs) == 0 && scope.OrgID == "" {
+		return true, nil
+	}
+
+	var projectID, orgID string
+	switch {
+	case obj.Namespace == schema.OrganizationNamespace:
+		org, err := s.orgService.Get(ctx, obj.ID)
+		if err != nil {
+			return false, err
+		}
+		orgID = org.ID
+	case obj.Namespace == schema.ProjectNamespace:
+		projectID = obj.ID
+	case !schema.IsSystemNamespace(obj.Namespace):
+		res, err := s.Get(ctx, obj.ID)
+		if err != nil {
+			return false, err
+		}
+		projectID = res.ProjectID
+	}
+	if projectID != "" {
+		proj, err := s.projectService.Get(ctx, projectID)
+		if err != nil {
+			return false, err
+		}
+		projectID, orgID = proj.ID, proj.Organization.ID
+	}
+	return scope.AllowsProject(projectID) && scope.AllowsOrg(orgID), nil
 }
 
 func (s Service) buildRelationObject(ctx context.Context, obj relation.Object) (relation.Object, error) {
@@ -229,25 +284,51 @@ func (s Service) buildRelationObject(ctx context.Context, obj relation.Object) (
 }
 
 func (s Service) BatchCheck(ctx context.Context, checks []Check) ([]relation.CheckPair, error) {
+	// results are returned in the order of checks
+	results := make([]relation.CheckPair, len(checks))
 	var relations []relation.Relation
-	for _, check := range checks {
+	var relationIdx []int
+	for idx, check := range checks {
 		// we can parallelize this to speed up the process
 		relObject, err := s.buildRelationObject(ctx, check.Object)
 		if err != nil {
 			return nil, err
 		}
 
-		relSubject, err := s.buildRelationSubject(ctx, check.Subject)
+		relSubject, scope, err := s.buildRelationSubject(ctx, check.Subject)
 		if err != nil {
 			return nil, err
 		}
-		relations = append(relations, relation.Relation{
+		rel := relation.Relation{
 			Subject:      relSubject,
 			Object:       relObject,
 			RelationName: check.Permission,
-		})
+		}
+		if scope != nil {
+			allowed, err := s.ScopeAllows(ctx, *scope, relObject, check.Permission)
+			if err != nil {
+				return nil, err
+			}
+			if !allowed {
+				// out of scope checks are denied without asking the authz engine
+				results[idx] = relation.CheckPair{Relation: rel, Status: false}
+				continue
+			}
+		}
+		relations = append(relations, rel)
+		relationIdx = append(relationIdx, idx)
+	}
+	if len(relations) == 0 {
+		return results, nil
+	}
+	checkPairs, err := s.relationService.BatchCheckPermission(ctx, relations)
+	if err != nil {
+		return nil, err
+	}
+	for i, pair := range checkPairs {
+		results[relationIdx[i]] = pair
 	}
-	return s.relationService.BatchCheckPermission(ctx, relations)
+	return results, nil
 }
 
 func (s Service) Delete(ctx context.Context, namespaceID, id string) error {
@@ -0,0 +1,66 @@
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/resource"
+	"github.com/raystack/frontier/core/serviceuser"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// allowingRelationService allows every relation it is asked to check
+type allowingRelationService struct {
+	resource.RelationService
+	checked []relation.Relation
+}
+
+func (s *allowingRelationService) BatchCheckPermission(ctx context.Context, relations []relation.Relation) ([]relation.CheckPair, error) {
+	s.checked = append(s.checked, relations...)
+	pairs := make([]relation.CheckPair, 0, len(relations))
+	for _, rel := range relations {
+		pairs = append(pairs, relation.CheckPair{Relation: rel, Status: true})
+	}
+	return pairs, nil
+}
+
+type principalAuthnService struct {
+	principal authenticate.Principal
+}
+
+func (s principalAuthnService) GetPrincipal(ctx context.Context, via ...authenticate.ClientAssertion) (authenticate.Principal, error) {
+	return s.principal, nil
+}
+
+func TestService_BatchCheck(t *testing.T) {
+	relationService := &allowingRelationService{}
+	authnService := principalAuthnService{principal: authenticate.Principal{
+		ID:    uuid.NewString(),
+		Type:  schema.ServiceUserPrincipal,
+		Scope: &serviceuser.Scope{Permissions: []string{"app_project_get"}},
+	}}
+	svc := resource.NewService(nil, nil, relationService, authnService, nil, nil)
+
+	projectID := uuid.NewString()
+	var checks []resource.Check
+	for _, perm := range []string{"update", "get", "delete", "get"} {
+		checks = append(checks, resource.Check{
+			Object:     relation.Object{ID: projectID, Namespace: schema.ProjectNamespace},
+			Permission: perm,
+		})
+	}
+
+	pairs, err := svc.BatchCheck(context.Background(), checks)
+	assert.NoError(t, err)
+	assert.Len(t, relationService.checked, 2)
+	var got []bool
+	for i, pair := range pairs {
+		assert.Equal(t, checks[i].Permission, pair.Relation.RelationName)
+		got = append(got, pair.Status)
+	}
+	assert.Equal(t, []bool{false, true, false, true}, got)
+}
@@ -7,4 +7,6 @@ var (
 	ErrInvalidID     = errors.New("role id is invalid")
 	ErrConflict      = errors.New("role name already exist")
 	ErrInvalidDetail = errors.New("invalid role detail")
+	ErrCyclicParent  = errors.New("role inheritance contains a cycle")
+	ErrParentInUse   = errors.New("role is inherited by other roles")
 )
@@ -3,4 +3,7 @@ package role
 type Filter struct {
 	OrgID  string
 	Scopes []string
+
+	// ParentID filters roles which directly inherit from the given role
+	ParentID string
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/raystack/frontier/internal/bootstrap/schema"
 	"github.com/raystack/frontier/pkg/metadata"
 )
 
@@ -35,7 +36,22 @@ type Role struct {
 	Permissions []string
 	State       State
 	Scopes      []string // used for filtering
+	Parents     []string // ids of roles whose permissions are inherited
 	Metadata    metadata.Metadata
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
+
+// IsOrgRole checks if the role is defined for the platform or the organization
+// and can be assigned on it
+func (r Role) IsOrgRole(orgID string) bool {
+	if r.OrgID != orgID && r.OrgID != schema.PlatformOrgID.String() {
+		return false
+	}
+	for _, scope := range r.Scopes {
+		if scope == schema.OrganizationNamespace {
+			return true
+		}
+	}
+	return false
+}
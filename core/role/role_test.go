@@ -0,0 +1,42 @@
+package role
+
+import (
+	"testing"
+
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRole_IsOrgRole(t *testing.T) {
+	tests := []struct {
+		name string
+		role Role
+		want bool
+	}{
+		{
+			name: "should assign a platform role scoped to organizations",
+			role: Role{OrgID: schema.PlatformOrgID.String(), Scopes: []string{schema.OrganizationNamespace}},
+			want: true,
+		},
+		{
+			name: "should assign a custom role of the organization",
+			role: Role{OrgID: testOrgID, Scopes: []string{schema.ProjectNamespace, schema.OrganizationNamespace}},
+			want: true,
+		},
+		{
+			name: "should not assign a role of another organization",
+			role: Role{OrgID: "other-org", Scopes: []string{schema.OrganizationNamespace}},
+			want: false,
+		},
+		{
+			name: "should not assign a role scoped to projects only",
+			role: Role{OrgID: schema.PlatformOrgID.String(), Scopes: []string{schema.ProjectNamespace}},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.role.IsOrgRole(testOrgID))
+		})
+	}
+}
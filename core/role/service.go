@@ -2,6 +2,7 @@ package role
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/raystack/frontier/pkg/utils"
@@ -44,19 +45,159 @@ func (s Service) Upsert(ctx context.Context, toCreate Role) (Role, error) {
 		}
 	}
 
+	// upsert could be overriding an existing role with same name
+	var existingPermissions []string
+	existingRole, err := s.repository.GetByName(ctx, toCreate.OrgID, toCreate.Name)
+	if err != nil && !errors.Is(err, ErrNotExist) {
+		return Role{}, err
+	}
+	if err == nil {
+		toCreate.ID = existingRole.ID
+		if existingPermissions, err = s.effectivePermissions(ctx, existingRole); err != nil {
+			return Role{}, err
+		}
+	}
+	if toCreate.Parents, err = s.resolveParents(ctx, toCreate); err != nil {
+		return Role{}, err
+	}
+
 	createdRole, err := s.repository.Upsert(ctx, toCreate)
 	if err != nil {
 		return Role{}, err
 	}
 
 	// create relation between role and permissions
-	if err := s.createRolePermissionRelation(ctx, createdRole.ID, createdRole.Permissions); err != nil {
+	if err := s.syncRolePermissions(ctx, createdRole, existingPermissions); err != nil {
 		return Role{}, err
 	}
 
 	return createdRole, nil
 }
 
+// resolveParents converts parent role ids or names to ids and makes sure
+// inheriting from them will not form a cycle
+func (s Service) resolveParents(ctx context.Context, rl Role) ([]string, error) {
+	var parentIDs []string
+	for _, parentRef := range rl.Parents {
+		var parent Role
+		var err error
+		if utils.IsValidUUID(parentRef) {
+			parent, err = s.repository.Get(ctx, parentRef)
+		} else {
+			// prefer org roles over platform roles with same name
+			parent, err = s.repository.GetByName(ctx, rl.OrgID, parentRef)
+			if errors.Is(err, ErrNotExist) {
+				parent, err = s.repository.GetByName(ctx, "", parentRef)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parent %s: %w", parentRef, err)
+		}
+		if parent.OrgID != rl.OrgID && parent.OrgID != schema.PlatformOrgID.String() {
+			return nil, fmt.Errorf("parent %s belongs to another org: %w", parentRef, ErrInvalidDetail)
+		}
+		if !utils.Contains(parentIDs, parent.ID) {
+			parentIDs = append(parentIDs, parent.ID)
+		}
+	}
+
+	if rl.ID != "" {
+		if err := s.checkCycle(ctx, rl.ID, parentIDs, map[string]bool{}); err != nil {
+			return nil, err
+		}
+	}
+	return parentIDs, nil
+}
+
+// checkCycle walks up the inheritance chain and fails if roleID is reachable
+func (s Service) checkCycle(ctx context.Context, roleID string, parentIDs []string, visited map[string]bool) error {
+	for _, parentID := range parentIDs {
+		if parentID == roleID {
+			return ErrCyclicParent
+		}
+		if visited[parentID] {
+			continue
+		}
+		visited[parentID] = true
+
+		parent, err := s.repository.Get(ctx, parentID)
+		if err != nil {
+			return err
+		}
+		if err := s.checkCycle(ctx, roleID, parent.Parents, visited); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// effectivePermissions returns permissions of the role including the ones
+// inherited from all of its ancestors
+func (s Service) effectivePermissions(ctx context.Context, rl Role) ([]string, error) {
+	permissions := append([]string{}, rl.Permissions...)
+	visited := map[string]bool{rl.ID: true}
+	queue := append([]string{}, rl.Parents...)
+	for len(queue) > 0 {
+		parentID := queue[0]
+		queue = queue[1:]
+		if visited[parentID] {
+			continue
+		}
+		visited[parentID] = true
+
+		parent, err := s.repository.Get(ctx, parentID)
+		if err != nil {
+			return nil, err
+		}
+		for _, perm := range parent.Permissions {
+			if !utils.Contains(permissions, perm) {
+				permissions = append(permissions, perm)
+			}
+		}
+		queue = append(queue, parent.Parents...)
+	}
+	return permissions, nil
+}
+
+// syncRolePermissions materializes effective permissions of the role as relations,
+// removes the ones which are no longer granted and does the same for every
+// role inheriting from it
+func (s Service) syncRolePermissions(ctx context.Context, rl Role, previousPermissions []string) error {
+	permissions, err := s.effectivePermissions(ctx, rl)
+	if err != nil {
+		return err
+	}
+
+	var permissionsToDelete []string
+	for _, perm := range previousPermissions {
+		if !utils.Contains(permissions, perm) {
+			permissionsToDelete = append(permissionsToDelete, perm)
+		}
+	}
+	if err := s.deleteRolePermissionRelation(ctx, rl.ID, permissionsToDelete); err != nil {
+		return err
+	}
+	if err := s.createRolePermissionRelation(ctx, rl.ID, permissions); err != nil {
+		return err
+	}
+
+	children, err := s.repository.List(ctx, Filter{ParentID: rl.ID})
+	if err != nil {
+		return err
+	}
+	for _, child := range children {
+		// children could only have lost what the parent lost
+		childPermissions, err := s.effectivePermissions(ctx, child)
+		if err != nil {
+			return err
+		}
+		if err := s.syncRolePermissions(ctx, child, append(childPermissions, permissionsToDelete...)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s Service) createRolePermissionRelation(ctx context.Context, roleID string, permissions []string) error {
 	// create relation between role and permissions
 	// for example for each permission:
@@ -164,30 +305,43 @@ func (s Service) Update(ctx context.Context, toUpdate Role) (Role, error) {
 	if err != nil {
 		return Role{}, err
 	}
-
-	// figure out what to delete from permission relation
-	var permissionsToDelete []string
-	for _, perm := range existingRole.Permissions {
-		if !utils.Contains(toUpdate.Permissions, perm) {
-			permissionsToDelete = append(permissionsToDelete, perm)
-		}
+	existingPermissions, err := s.effectivePermissions(ctx, existingRole)
+	if err != nil {
+		return Role{}, err
 	}
 
-	// delete relation between role and permissions
-	if err := s.deleteRolePermissionRelation(ctx, existingRole.ID, permissionsToDelete); err != nil {
+	// keep inheritance as it is unless asked to change
+	toUpdate.ID = existingRole.ID
+	toUpdate.OrgID = existingRole.OrgID
+	if toUpdate.Parents == nil {
+		toUpdate.Parents = existingRole.Parents
+	}
+	if toUpdate.Parents, err = s.resolveParents(ctx, toUpdate); err != nil {
 		return Role{}, err
 	}
 
-	// create relation between role and permissions
-	if err := s.createRolePermissionRelation(ctx, existingRole.ID, toUpdate.Permissions); err != nil {
+	// update in db
+	updatedRole, err := s.repository.Update(ctx, toUpdate)
+	if err != nil {
 		return Role{}, err
 	}
 
-	// update in db
-	return s.repository.Update(ctx, toUpdate)
+	// recompute relation between role and permissions
+	if err := s.syncRolePermissions(ctx, updatedRole, existingPermissions); err != nil {
+		return Role{}, err
+	}
+	return updatedRole, nil
 }
 
 func (s Service) Delete(ctx context.Context, id string) error {
+	children, err := s.repository.List(ctx, Filter{ParentID: id})
+	if err != nil {
+		return err
+	}
+	if len(children) > 0 {
+		return ErrParentInUse
+	}
+
 	if err := s.relationService.Delete(ctx, relation.Relation{Object: relation.Object{
 		ID:        id,
 		Namespace: schema.RoleNamespace,
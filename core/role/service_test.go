@@ -0,0 +1,230 @@
+package role
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/permission"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/raystack/frontier/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+const testOrgID = "9f256f86-31a3-11ec-8d3d-0242ac130003"
+
+type memRepository struct {
+	roles map[string]Role
+}
+
+func (r *memRepository) Get(ctx context.Context, id string) (Role, error) {
+	rl, ok := r.roles[id]
+	if !ok {
+		return Role{}, ErrNotExist
+	}
+	return rl, nil
+}
+
+func (r *memRepository) GetByName(ctx context.Context, orgID, name string) (Role, error) {
+	if orgID == "" {
+		orgID = schema.PlatformOrgID.String()
+	}
+	for _, rl := range r.roles {
+		if rl.OrgID == orgID && rl.Name == name {
+			return rl, nil
+		}
+	}
+	return Role{}, ErrNotExist
+}
+
+func (r *memRepository) List(ctx context.Context, f Filter) ([]Role, error) {
+	var roles []Role
+	for _, rl := range r.roles {
+		if f.ParentID != "" && !utils.Contains(rl.Parents, f.ParentID) {
+			continue
+		}
+		roles = append(roles, rl)
+	}
+	return roles, nil
+}
+
+func (r *memRepository) Upsert(ctx context.Context, rl Role) (Role, error) {
+	if rl.ID == "" {
+		rl.ID = uuid.NewString()
+	}
+	r.roles[rl.ID] = rl
+	return rl, nil
+}
+
+func (r *memRepository) Update(ctx context.Context, rl Role) (Role, error) {
+	if _, ok := r.roles[rl.ID]; !ok {
+		return Role{}, ErrNotExist
+	}
+	r.roles[rl.ID] = rl
+	return rl, nil
+}
+
+func (r *memRepository) Delete(ctx context.Context, id string) error {
+	delete(r.roles, id)
+	return nil
+}
+
+// memRelationService keeps role#permission relations of user principals
+type memRelationService struct {
+	granted map[string]map[string]bool
+}
+
+func (s *memRelationService) Create(ctx context.Context, rel relation.Relation) (relation.Relation, error) {
+	if rel.Subject.Namespace != schema.UserPrincipal {
+		return rel, nil
+	}
+	if s.granted[rel.Object.ID] == nil {
+		s.granted[rel.Object.ID] = map[string]bool{}
+	}
+	s.granted[rel.Object.ID][rel.RelationName] = true
+	return rel, nil
+}
+
+func (s *memRelationService) Delete(ctx context.Context, rel relation.Relation) error {
+	if rel.RelationName == "" {
+		delete(s.granted, rel.Object.ID)
+		return nil
+	}
+	delete(s.granted[rel.Object.ID], rel.RelationName)
+	return nil
+}
+
+func (s *memRelationService) permissions(roleID string) []string {
+	var perms []string
+	for perm := range s.granted[roleID] {
+		perms = append(perms, perm)
+	}
+	sort.Strings(perms)
+	return perms
+}
+
+type memPermissionService struct{}
+
+func (memPermissionService) Get(ctx context.Context, id string) (permission.Permission, error) {
+	name := strings.TrimPrefix(id, "app_organization_")
+	return permission.Permission{
+		Name:        name,
+		NamespaceID: schema.OrganizationNamespace,
+		Slug:        schema.FQPermissionNameFromNamespace(schema.OrganizationNamespace, name),
+	}, nil
+}
+
+func newTestService() (*Service, *memRepository, *memRelationService) {
+	repo := &memRepository{roles: map[string]Role{}}
+	relations := &memRelationService{granted: map[string]map[string]bool{}}
+	return NewService(repo, relations, memPermissionService{}), repo, relations
+}
+
+func TestService_Upsert(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should grant permissions inherited from parents by name", func(t *testing.T) {
+		svc, _, relations := newTestService()
+		viewer, err := svc.Upsert(ctx, Role{Name: "viewer", OrgID: testOrgID, Permissions: []string{"app_organization_get"}})
+		assert.NoError(t, err)
+		manager, err := svc.Upsert(ctx, Role{Name: "manager", OrgID: testOrgID, Permissions: []string{"app_organization_update"},
+			Parents: []string{"viewer"}})
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{viewer.ID}, manager.Parents)
+		assert.Equal(t, []string{"app_organization_get", "app_organization_update"}, relations.permissions(manager.ID))
+	})
+	t.Run("should fail if parent belongs to another org", func(t *testing.T) {
+		svc, _, _ := newTestService()
+		other, err := svc.Upsert(ctx, Role{Name: "viewer", OrgID: uuid.NewString(), Permissions: []string{"app_organization_get"}})
+		assert.NoError(t, err)
+		_, err = svc.Upsert(ctx, Role{Name: "manager", OrgID: testOrgID, Permissions: []string{"app_organization_update"},
+			Parents: []string{other.ID}})
+		assert.ErrorIs(t, err, ErrInvalidDetail)
+	})
+	t.Run("should fail if parent doesn't exist", func(t *testing.T) {
+		svc, _, _ := newTestService()
+		_, err := svc.Upsert(ctx, Role{Name: "manager", OrgID: testOrgID, Permissions: []string{"app_organization_update"},
+			Parents: []string{"viewer"}})
+		assert.ErrorIs(t, err, ErrNotExist)
+	})
+}
+
+func TestService_Update(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should fail if parents form a cycle", func(t *testing.T) {
+		svc, _, _ := newTestService()
+		a, err := svc.Upsert(ctx, Role{Name: "a", OrgID: testOrgID, Permissions: []string{"app_organization_get"}})
+		assert.NoError(t, err)
+		b, err := svc.Upsert(ctx, Role{Name: "b", OrgID: testOrgID, Permissions: []string{"app_organization_get"}, Parents: []string{a.ID}})
+		assert.NoError(t, err)
+		c, err := svc.Upsert(ctx, Role{Name: "c", OrgID: testOrgID, Permissions: []string{"app_organization_get"}, Parents: []string{b.ID}})
+		assert.NoError(t, err)
+
+		_, err = svc.Update(ctx, Role{ID: a.ID, Name: "a", Permissions: []string{"app_organization_get"}, Parents: []string{c.ID}})
+		assert.ErrorIs(t, err, ErrCyclicParent)
+		_, err = svc.Update(ctx, Role{ID: a.ID, Name: "a", Permissions: []string{"app_organization_get"}, Parents: []string{a.ID}})
+		assert.ErrorIs(t, err, ErrCyclicParent)
+	})
+	t.Run("should recompute inherited permissions of children when a parent changes", func(t *testing.T) {
+		svc, _, relations := newTestService()
+		viewer, err := svc.Upsert(ctx, Role{Name: "viewer", OrgID: testOrgID, Permissions: []string{"app_organization_get"}})
+		assert.NoError(t, err)
+		manager, err := svc.Upsert(ctx, Role{Name: "manager", OrgID: testOrgID, Permissions: []string{"app_organization_update"},
+			Parents: []string{viewer.ID}})
+		assert.NoError(t, err)
+		owner, err := svc.Upsert(ctx, Role{Name: "owner", OrgID: testOrgID, Permissions: []string{"app_organization_delete"},
+			Parents: []string{manager.ID}})
+		assert.NoError(t, err)
+
+		_, err = svc.Update(ctx, Role{ID: viewer.ID, Name: "viewer", Permissions: []string{"app_organization_administer"}})
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{"app_organization_administer"}, relations.permissions(viewer.ID))
+		assert.Equal(t, []string{"app_organization_administer", "app_organization_update"}, relations.permissions(manager.ID))
+		assert.Equal(t, []string{"app_organization_administer", "app_organization_delete", "app_organization_update"},
+			relations.permissions(owner.ID))
+	})
+	t.Run("should keep parents unless asked to change and drop them with an empty list", func(t *testing.T) {
+		svc, _, relations := newTestService()
+		viewer, err := svc.Upsert(ctx, Role{Name: "viewer", OrgID: testOrgID, Permissions: []string{"app_organization_get"}})
+		assert.NoError(t, err)
+		manager, err := svc.Upsert(ctx, Role{Name: "manager", OrgID: testOrgID, Permissions: []string{"app_organization_update"},
+			Parents: []string{viewer.ID}})
+		assert.NoError(t, err)
+
+		updated, err := svc.Update(ctx, Role{ID: manager.ID, Name: "manager", Permissions: []string{"app_organization_update"}})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{viewer.ID}, updated.Parents)
+
+		updated, err = svc.Update(ctx, Role{ID: manager.ID, Name: "manager", Permissions: []string{"app_organization_update"},
+			Parents: []string{}})
+		assert.NoError(t, err)
+		assert.Empty(t, updated.Parents)
+		assert.Equal(t, []string{"app_organization_update"}, relations.permissions(manager.ID))
+	})
+}
+
+func TestService_Delete(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, relations := newTestService()
+	viewer, err := svc.Upsert(ctx, Role{Name: "viewer", OrgID: testOrgID, Permissions: []string{"app_organization_get"}})
+	assert.NoError(t, err)
+	manager, err := svc.Upsert(ctx, Role{Name: "manager", OrgID: testOrgID, Permissions: []string{"app_organization_update"},
+		Parents: []string{viewer.ID}})
+	assert.NoError(t, err)
+
+	assert.ErrorIs(t, svc.Delete(ctx, viewer.ID), ErrParentInUse)
+	_, err = repo.Get(ctx, viewer.ID)
+	assert.NoError(t, err)
+
+	assert.NoError(t, svc.Delete(ctx, manager.ID))
+	assert.Empty(t, relations.permissions(manager.ID))
+	assert.NoError(t, svc.Delete(ctx, viewer.ID))
+	_, err = repo.Get(ctx, viewer.ID)
+	assert.ErrorIs(t, err, ErrNotExist)
+}
@@ -0,0 +1,134 @@
+package serviceuser
+
+import (
+	"container/list"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// secretCache is a bounded cache of verified client secrets, it lets repeated
+// requests of a service user skip the db lookup and the slow hash comparison.
+// Entries are keyed on a keyed hash of the credential id and secret, so neither
+// the secret nor a hash which could be brute forced offline is kept in memory
+type secretCache struct {
+	mu      sync.Mutex
+	hashKey []byte
+	ttl     time.Duration
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+	now     func() time.Time
+}
+
+type secretCacheEntry struct {
+	key         string
+	serviceUser ServiceUser
+	credential  Credential
+	expiresAt   time.Time
+}
+
+// newSecretCache returns a cache keeping at most size entries for ttl, nil is
+// returned if either is not set which disables caching
+func newSecretCache(ttl time.Duration, size int) *secretCache {
+	if ttl <= 0 || size <= 0 {
+		return nil
+	}
+	hashKey := make([]byte, 32)
+	if _, err := rand.Read(hashKey); err != nil {
+		return nil
+	}
+	return &secretCache{
+		hashKey: hashKey,
+		ttl:     ttl,
+		size:    size,
+		order:   list.New(),
+		entries: map[string]*list.Element{},
+		now:     time.Now,
+	}
+}
+
+// Key returns the cache key of the secret of a credential
+func (c *secretCache) Key(credID, secret string) string {
+	if c == nil {
+		return ""
+	}
+	mac := hmac.New(sha256.New, c.hashKey)
+	mac.Write([]byte(credID))
+	mac.Write([]byte{0})
+	mac.Write([]byte(secret))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (c *secretCache) Get(key string) (ServiceUser, Credential, bool) {
+	if c == nil {
+		return ServiceUser{}, Credential{}, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return ServiceUser{}, Credential{}, false
+	}
+	entry := elem.Value.(*secretCacheEntry)
+	if c.now().After(entry.expiresAt) {
+		c.remove(elem)
+		return ServiceUser{}, Credential{}, false
+	}
+	c.order.MoveToFront(elem)
+	return entry.serviceUser, entry.credential, true
+}
+
+// Set adds the verified credential, an existing entry is updated without
+// extending how long it's cached for
+func (c *secretCache) Set(key string, serviceUser ServiceUser, cred Credential) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*secretCacheEntry)
+		entry.serviceUser = serviceUser
+		entry.credential = cred
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&secretCacheEntry{
+		key:         key,
+		serviceUser: serviceUser,
+		credential:  cred,
+		expiresAt:   c.now().Add(c.ttl),
+	})
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+// Invalidate removes all entries of the credentials
+func (c *secretCache) Invalidate(credIDs ...string) {
+	if c == nil || len(credIDs) == 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for elem := c.order.Front(); elem != nil; {
+		next := elem.Next()
+		entry := elem.Value.(*secretCacheEntry)
+		for _, id := range credIDs {
+			if entry.credential.ID == id {
+				c.remove(elem)
+				break
+			}
+		}
+		elem = next
+	}
+}
+
+func (c *secretCache) remove(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*secretCacheEntry).key)
+}
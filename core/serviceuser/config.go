@@ -0,0 +1,37 @@
+package serviceuser
+
+import "time"
+
+type Config struct {
+	// ExpiryNotice is how long before a credential expires its owners are mailed
+	ExpiryNotice time.Duration `yaml:"expiry_notice" mapstructure:"expiry_notice" default:"168h"`
+
+	// StaleAfter deletes credentials which weren't used for this long, credentials
+	// are kept until they expire if not set
+	StaleAfter time.Duration `yaml:"stale_after" mapstructure:"stale_after"`
+
+	MailTemplate MailTemplateConfig `yaml:"mail_template" mapstructure:"mail_template"`
+
+	// SecretHash is the hash of new client secrets, bcrypt or sha256. Secrets hashed
+	// with a different scheme are rehashed the next time they are used
+	SecretHash string `yaml:"secret_hash" mapstructure:"secret_hash" default:"bcrypt"`
+
+	SecretCache SecretCacheConfig `yaml:"secret_cache" mapstructure:"secret_cache"`
+
+	// JWKSCacheTTL is how long key sets of trusted issuers are cached for
+	JWKSCacheTTL time.Duration `yaml:"jwks_cache_ttl" mapstructure:"jwks_cache_ttl" default:"10m"`
+}
+
+type SecretCacheConfig struct {
+	// TTL is how long a verified client secret is trusted without checking the
+	// db, a deleted secret stays valid on other instances for at most this long.
+	// Caching is disabled if not set
+	TTL time.Duration `yaml:"ttl" mapstructure:"ttl" default:"5m"`
+	// Size is the max number of cached secrets
+	Size int `yaml:"size" mapstructure:"size" default:"10000"`
+}
+
+type MailTemplateConfig struct {
+	Subject string `yaml:"subject" mapstructure:"subject" default:"A service user credential is about to expire"`
+	Body    string `yaml:"body" mapstructure:"body" default:"<div>Hi,</div><br><p>Credential {{.Title}} ({{.CredentialID}}) of service user {{.ServiceUser}} expires at {{.ExpiresAt}}. Create a new credential before then to avoid an outage.</p><br><div>Thanks,<br>Team Frontier</div>"`
+}
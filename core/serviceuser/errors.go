@@ -3,12 +3,22 @@ package serviceuser
 import "errors"
 
 var (
-	ErrNotExist     = errors.New("service user doesn't exist")
-	ErrCredNotExist = errors.New("service user credential doesn't exist")
-	ErrInvalidCred  = errors.New("service user credential is invalid")
-	ErrInvalidID    = errors.New("service user id is invalid")
-	ErrInvalidKeyID = errors.New("service user key is invalid")
-	ErrConflict     = errors.New("service user already exist")
-	ErrEmptyKey     = errors.New("empty key")
-	ErrDisabled     = errors.New("service user is disabled")
+	ErrNotExist      = errors.New("service user doesn't exist")
+	ErrCredNotExist  = errors.New("service user credential doesn't exist")
+	ErrInvalidCred   = errors.New("service user credential is invalid")
+	ErrInvalidID     = errors.New("service user id is invalid")
+	ErrInvalidKeyID  = errors.New("service user key is invalid")
+	ErrConflict      = errors.New("service user already exist")
+	ErrEmptyKey      = errors.New("empty key")
+	ErrDisabled      = errors.New("service user is disabled")
+	ErrCredExpired   = errors.New("service user credential is expired")
+	ErrInvalidScope  = errors.New("service user credential scope is invalid")
+	ErrInvalidExpiry = errors.New("service user credential expiry is invalid")
+
+	ErrInvalidPublicKey = errors.New("service user public key is invalid")
+
+	ErrTrustNotExist  = errors.New("service user trust doesn't exist")
+	ErrInvalidTrust   = errors.New("service user trust is invalid")
+	ErrUntrustedToken = errors.New("token isn't trusted by a service user")
+	ErrAmbiguousTrust = errors.New("token is trusted by more than one service user")
 )
@@ -1,9 +1,23 @@
 package serviceuser
 
+import (
+	"time"
+
+	"github.com/raystack/frontier/pkg/pagination"
+)
+
 type Filter struct {
 	ServiceUserID string
 	OrgID         string
 	IsKey         bool
 	IsSecret      bool
 	State         State
+
+	// ExpiresBefore lists credentials with an expiry before the time
+	ExpiresBefore time.Time
+	// UsedBefore lists credentials last used, or created if never used, before the time
+	UsedBefore time.Time
+
+	// Pagination is optional, all items are listed if not set
+	Pagination *pagination.Pagination
 }
@@ -0,0 +1,107 @@
+package serviceuser
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+)
+
+const (
+	// jwksFetchTimeout bounds how long a request waits on a remote key set
+	jwksFetchTimeout = 10 * time.Second
+	// jwksMinRefresh is how often a key set is fetched at most when a token
+	// is signed with a key missing from it
+	jwksMinRefresh = time.Minute
+)
+
+var errPrivateAddress = errors.New("key set can't be fetched from a private address")
+
+// newKeySetClient returns a client to fetch remote key sets, it doesn't follow
+// redirects or use proxies and refuses to connect to loopback and private
+// addresses as key set urls are chosen by users
+func newKeySetClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+				ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() {
+				return errPrivateAddress
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// jwksCache keeps remote key sets for ttl so verifying a token doesn't fetch
+// them on every request, a set is refetched early if a token is signed with a
+// key it doesn't have yet since the issuer may have rotated its keys
+type jwksCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	client  *http.Client
+	entries map[string]jwksCacheEntry
+	now     func() time.Time
+}
+
+type jwksCacheEntry struct {
+	set       jwk.Set
+	fetchedAt time.Time
+}
+
+func newJWKSCache(ttl time.Duration) *jwksCache {
+	return &jwksCache{
+		ttl:     ttl,
+		client:  newKeySetClient(jwksFetchTimeout),
+		entries: map[string]jwksCacheEntry{},
+		now:     time.Now,
+	}
+}
+
+// Get returns the key set at url, kid is the key a token is signed with. A
+// stale set is returned if it can't be fetched
+func (c *jwksCache) Get(ctx context.Context, url, kid string) (jwk.Set, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[url]
+	c.mu.Unlock()
+
+	now := c.now()
+	age := now.Sub(entry.fetchedAt)
+	if ok && age < c.ttl {
+		if _, found := entry.set.LookupKeyID(kid); found || kid == "" || age < jwksMinRefresh {
+			return entry.set, nil
+		}
+	}
+
+	set, err := jwk.Fetch(ctx, url, jwk.WithHTTPClient(c.client))
+	if err != nil {
+		if ok {
+			return entry.set, nil
+		}
+		return nil, err
+	}
+	c.mu.Lock()
+	c.entries[url] = jwksCacheEntry{set: set, fetchedAt: now}
+	c.mu.Unlock()
+	return set, nil
+}
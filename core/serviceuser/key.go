@@ -0,0 +1,94 @@
+package serviceuser
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+)
+
+const (
+	ECKeyType      = "sv_ec"
+	Ed25519KeyType = "sv_ed25519"
+	// JWKSKeyType is the type of a key whose public keys are fetched from a url
+	JWKSKeyType = "sv_jwks"
+
+	// minRSAKeyBits is the smallest uploaded rsa key accepted
+	minRSAKeyBits = 2048
+)
+
+// ParsePublicKey parses a public key generated by the client as a JWK or PEM,
+// PEM can also be base64 encoded
+func ParsePublicKey(raw string) (jwk.Key, error) {
+	raw = strings.TrimSpace(raw)
+	data := []byte(raw)
+	var key jwk.Key
+	var err error
+	switch {
+	case strings.HasPrefix(raw, "{"):
+		key, err = jwk.ParseKey(data)
+	case strings.HasPrefix(raw, "-----BEGIN"):
+		key, err = jwk.ParseKey(data, jwk.WithPEM(true))
+	default:
+		if data, err = base64.StdEncoding.DecodeString(raw); err == nil {
+			if !bytes.HasPrefix(bytes.TrimSpace(data), []byte("-----BEGIN")) {
+				return nil, fmt.Errorf("%w: not a jwk or pem", ErrInvalidPublicKey)
+			}
+			key, err = jwk.ParseKey(data, jwk.WithPEM(true))
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPublicKey, err)
+	}
+	if err := validatePublicKey(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// validatePublicKey accepts rsa keys of at least minRSAKeyBits, ec keys and
+// ed25519 keys, private keys are rejected as frontier must never hold them
+func validatePublicKey(key jwk.Key) error {
+	switch k := key.(type) {
+	case jwk.RSAPrivateKey, jwk.ECDSAPrivateKey, jwk.OKPPrivateKey:
+		return fmt.Errorf("%w: a private key was sent", ErrInvalidPublicKey)
+	case jwk.RSAPublicKey:
+		var raw rsa.PublicKey
+		if err := k.Raw(&raw); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidPublicKey, err)
+		}
+		if raw.N.BitLen() < minRSAKeyBits {
+			return fmt.Errorf("%w: rsa key must be at least %d bits", ErrInvalidPublicKey, minRSAKeyBits)
+		}
+	case jwk.ECDSAPublicKey:
+	case jwk.OKPPublicKey:
+		if k.Crv() != jwa.Ed25519 {
+			return fmt.Errorf("%w: unsupported curve %s", ErrInvalidPublicKey, k.Crv())
+		}
+	default:
+		return fmt.Errorf("%w: unsupported key type %s", ErrInvalidPublicKey, key.KeyType())
+	}
+	return nil
+}
+
+// KeyType returns the type of a key credential
+func KeyType(cred Credential) string {
+	if cred.JWKSURL != "" {
+		return JWKSKeyType
+	}
+	if cred.PublicKey != nil {
+		if key, ok := cred.PublicKey.Key(0); ok {
+			switch key.KeyType() {
+			case jwa.EC:
+				return ECKeyType
+			case jwa.OKP:
+				return Ed25519KeyType
+			}
+		}
+	}
+	return DefaultKeyType
+}
@@ -0,0 +1,149 @@
+package serviceuser
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func encodePublicKeyPEM(t *testing.T, pub interface{}) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func TestParsePublicKey(t *testing.T) {
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	edPub, edPriv, _ := ed25519.GenerateKey(rand.Reader)
+	smallRSAKey, _ := rsa.GenerateKey(rand.Reader, 1024)
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	rsaJWK, _ := jwk.FromRaw(rsaKey.Public())
+	rsaJWKJson, _ := json.Marshal(rsaJWK)
+	privJWK, _ := jwk.FromRaw(edPriv)
+	privJWKJson, _ := json.Marshal(privJWK)
+
+	tests := []struct {
+		name    string
+		raw     string
+		want    jwa.KeyType
+		wantErr bool
+	}{
+		{
+			name: "should parse an rsa jwk",
+			raw:  string(rsaJWKJson),
+			want: jwa.RSA,
+		},
+		{
+			name: "should parse a base64 encoded ec pem",
+			raw:  base64.StdEncoding.EncodeToString([]byte(encodePublicKeyPEM(t, ecKey.Public()))),
+			want: jwa.EC,
+		},
+		{
+			name: "should parse an ed25519 pem",
+			raw:  encodePublicKeyPEM(t, edPub),
+			want: jwa.OKP,
+		},
+		{
+			name:    "should reject a private key",
+			raw:     string(privJWKJson),
+			wantErr: true,
+		},
+		{
+			name:    "should reject a small rsa key",
+			raw:     encodePublicKeyPEM(t, smallRSAKey.Public()),
+			wantErr: true,
+		},
+		{
+			name:    "should reject a value which isn't a key",
+			raw:     base64.StdEncoding.EncodeToString([]byte("not a key")),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePublicKey(tt.raw)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidPublicKey)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got.KeyType())
+		})
+	}
+}
+
+func TestService_GetByTokenWithClientKeys(t *testing.T) {
+	signToken := func(t *testing.T, key interface{}, alg jwa.SignatureAlgorithm, kid, iss string) string {
+		t.Helper()
+		tok, err := jwt.NewBuilder().Issuer(iss).Subject("su-1").
+			IssuedAt(time.Now()).Expiration(time.Now().Add(time.Hour)).Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		headers := jws.NewHeaders()
+		_ = headers.Set(jws.KeyIDKey, kid)
+		signed, err := jwt.Sign(tok, jwt.WithKey(alg, key, jws.WithProtectedHeaders(headers)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(signed)
+	}
+
+	t.Run("should verify a token signed with an uploaded ed25519 key", func(t *testing.T) {
+		s, _ := newTestService(t, Config{})
+		edPub, edPriv, _ := ed25519.GenerateKey(rand.Reader)
+		pubKey, err := ParsePublicKey(encodePublicKeyPEM(t, edPub))
+		assert.NoError(t, err)
+		set := jwk.NewSet()
+		_ = set.AddKey(pubKey)
+		cred, err := s.CreateKey(context.Background(), Credential{ServiceUserID: "su-1", PublicKey: set})
+		assert.NoError(t, err)
+		assert.Empty(t, cred.PrivateKey)
+		assert.Equal(t, Ed25519KeyType, KeyType(cred))
+
+		svUser, _, err := s.GetByToken(context.Background(), signToken(t, edPriv, jwa.EdDSA, cred.ID, ""))
+		assert.NoError(t, err)
+		assert.Equal(t, "su-1", svUser.ID)
+
+		_, otherPriv, _ := ed25519.GenerateKey(rand.Reader)
+		_, _, err = s.GetByToken(context.Background(), signToken(t, otherPriv, jwa.EdDSA, cred.ID, ""))
+		assert.Error(t, err)
+	})
+	t.Run("should verify a token signed with a key of a key set url", func(t *testing.T) {
+		issuer := newTestIssuer(t)
+		credRepo := &memCredentialRepository{creds: map[string]Credential{}}
+		repo := memRepository{users: map[string]ServiceUser{"su-1": {ID: "su-1"}}}
+		s := NewService(log.NewNoop(), Config{JWKSCacheTTL: time.Hour}, repo, credRepo, nil, nil, nil, nil)
+		s.jwksCache.client = issuer.server.Client()
+		cred, err := s.CreateKey(context.Background(), Credential{ServiceUserID: "su-1", JWKSURL: issuer.server.URL})
+		assert.NoError(t, err)
+		assert.Equal(t, JWKSKeyType, KeyType(cred))
+
+		svUser, _, err := s.GetByToken(context.Background(), signToken(t, issuer.key, jwa.RS256, "key-1", cred.ID))
+		assert.NoError(t, err)
+		assert.Equal(t, "su-1", svUser.ID)
+
+		// the token has to name the credential the key set belongs to
+		_, _, err = s.GetByToken(context.Background(), signToken(t, issuer.key, jwa.RS256, "key-1", "other"))
+		assert.Error(t, err)
+	})
+}
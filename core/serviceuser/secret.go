@@ -0,0 +1,53 @@
+package serviceuser
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// SecretHashBcrypt hashes client secrets with bcrypt
+	SecretHashBcrypt = "bcrypt"
+	// SecretHashSHA256 hashes client secrets with sha256, secrets are 256 bit
+	// random values which can't be brute forced so a slow kdf adds nothing but latency
+	SecretHashSHA256 = "sha256"
+
+	sha256HashPrefix = "$sha256$"
+	bcryptCost       = 14
+)
+
+// hashSecret hashes the secret with the scheme, bcrypt is used if the scheme is unknown
+func hashSecret(scheme string, secret []byte) ([]byte, error) {
+	if scheme == SecretHashSHA256 {
+		sum := sha256.Sum256(secret)
+		return []byte(sha256HashPrefix + hex.EncodeToString(sum[:])), nil
+	}
+	return bcrypt.GenerateFromPassword(secret, bcryptCost)
+}
+
+// secretHashScheme returns the scheme a secret hash was created with
+func secretHashScheme(hash []byte) string {
+	if bytes.HasPrefix(hash, []byte(sha256HashPrefix)) {
+		return SecretHashSHA256
+	}
+	return SecretHashBcrypt
+}
+
+// compareSecret checks the secret against a hash of any of the schemes
+func compareSecret(hash, secret []byte) error {
+	if secretHashScheme(hash) == SecretHashSHA256 {
+		expected, err := hashSecret(SecretHashSHA256, secret)
+		if err != nil {
+			return err
+		}
+		if subtle.ConstantTimeCompare(hash, expected) != 1 {
+			return ErrInvalidCred
+		}
+		return nil
+	}
+	return bcrypt.CompareHashAndPassword(hash, secret)
+}
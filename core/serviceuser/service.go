@@ -4,17 +4,33 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
+	"net"
+	"strings"
+	"time"
 
-	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 
 	"github.com/google/uuid"
 	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
 	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/raystack/frontier/core/email"
 	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/user"
 	"github.com/raystack/frontier/internal/bootstrap/schema"
 	"github.com/raystack/frontier/pkg/utils"
+	"github.com/raystack/salt/log"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	expirySchedule = "30 * * * *" // every hour
+	// usageInterval is how often usage of a credential is recorded at most
+	usageInterval = time.Minute
 )
 
 type Repository interface {
@@ -30,6 +46,16 @@ type CredentialRepository interface {
 	Create(ctx context.Context, credential Credential) (Credential, error)
 	Get(ctx context.Context, id string) (Credential, error)
 	Delete(ctx context.Context, id string) error
+	UpdateUsage(ctx context.Context, id string, usedAt time.Time, ip string) error
+	SetExpiryNotified(ctx context.Context, id string) error
+	UpdateSecretHash(ctx context.Context, id string, secretHash []byte) error
+}
+
+type TrustRepository interface {
+	List(ctx context.Context, flt TrustFilter) ([]Trust, error)
+	Create(ctx context.Context, trust Trust) (Trust, error)
+	Get(ctx context.Context, id string) (Trust, error)
+	Delete(ctx context.Context, id string) error
 }
 
 type RelationService interface {
@@ -38,17 +64,46 @@ type RelationService interface {
 	LookupSubjects(ctx context.Context, rel relation.Relation) ([]string, error)
 }
 
+type MailService interface {
+	Send(ctx context.Context, req email.Request) (email.Mail, error)
+}
+
+type UserService interface {
+	GetByIDs(ctx context.Context, userIDs []string) ([]user.User, error)
+}
+
 type Service struct {
-	repo       Repository
-	credRepo   CredentialRepository
-	relService RelationService
+	repo        Repository
+	credRepo    CredentialRepository
+	trustRepo   TrustRepository
+	relService  RelationService
+	userService UserService
+	mailService MailService
+	config      Config
+	secretCache *secretCache
+	jwksCache   *jwksCache
+	cron        *cron.Cron
+	log         log.Logger
+	Now         func() time.Time
 }
 
-func NewService(repo Repository, credRepo CredentialRepository, relService RelationService) *Service {
+func NewService(logger log.Logger, config Config, repo Repository, credRepo CredentialRepository,
+	trustRepo TrustRepository, relService RelationService, userService UserService, mailService MailService) *Service {
 	return &Service{
-		repo:       repo,
-		credRepo:   credRepo,
-		relService: relService,
+		repo:        repo,
+		credRepo:    credRepo,
+		trustRepo:   trustRepo,
+		relService:  relService,
+		userService: userService,
+		mailService: mailService,
+		config:      config,
+		secretCache: newSecretCache(config.SecretCache.TTL, config.SecretCache.Size),
+		jwksCache:   newJWKSCache(config.JWKSCacheTTL),
+		cron:        cron.New(),
+		log:         logger,
+		Now: func() time.Time {
+			return time.Now().UTC()
+		},
 	}
 }
 
@@ -136,6 +191,7 @@ func (s Service) Delete(ctx context.Context, id string) error {
 		if err := s.credRepo.Delete(ctx, cred.ID); err != nil {
 			return err
 		}
+		s.secretCache.Invalidate(cred.ID)
 	}
 
 	// delete all of serviceuser relationships
@@ -158,10 +214,25 @@ func (s Service) ListKeys(ctx context.Context, serviceUserID string) ([]Credenti
 	})
 }
 
-// CreateKey creates a key pair for the service user
+// CreateKey creates a key pair for the service user, or registers the public
+// key or key set url of a key pair the client generated
 func (s Service) CreateKey(ctx context.Context, credential Credential) (Credential, error) {
+	if credential.IsExpired(s.Now()) {
+		return Credential{}, ErrInvalidExpiry
+	}
 	credential.ID = uuid.New().String()
 
+	if credential.JWKSURL != "" {
+		if !strings.HasPrefix(credential.JWKSURL, "https://") {
+			return Credential{}, fmt.Errorf("%w: jwks url must be https", ErrInvalidPublicKey)
+		}
+		credential.PublicKey = nil
+		return s.credRepo.Create(ctx, credential)
+	}
+	if credential.PublicKey != nil {
+		return s.createUploadedKey(ctx, credential)
+	}
+
 	// generate public/private key pair
 	newJWK, err := utils.CreateJWKWithKID(credential.ID)
 	if err != nil {
@@ -191,30 +262,74 @@ func (s Service) CreateKey(ctx context.Context, credential Credential) (Credenti
 	return createdCred, nil
 }
 
+// createUploadedKey registers the public key of a key pair the client generated,
+// the key id is replaced with the credential id which tokens have to be signed with
+func (s Service) createUploadedKey(ctx context.Context, credential Credential) (Credential, error) {
+	if credential.PublicKey.Len() != 1 {
+		return Credential{}, fmt.Errorf("%w: a single key is required", ErrInvalidPublicKey)
+	}
+	key, _ := credential.PublicKey.Key(0)
+	if err := validatePublicKey(key); err != nil {
+		return Credential{}, err
+	}
+	key, err := key.Clone()
+	if err != nil {
+		return Credential{}, err
+	}
+	if err := key.Set(jwk.KeyIDKey, credential.ID); err != nil {
+		return Credential{}, err
+	}
+	if err := key.Set(jwk.KeyUsageKey, "sig"); err != nil {
+		return Credential{}, err
+	}
+	publicKeySet := jwk.NewSet()
+	if err := publicKeySet.AddKey(key); err != nil {
+		return Credential{}, err
+	}
+	credential.PublicKey = publicKeySet
+	return s.credRepo.Create(ctx, credential)
+}
+
+// GetKey returns a key credential, keys of a key set url are fetched
 func (s Service) GetKey(ctx context.Context, credID string) (Credential, error) {
 	cred, err := s.credRepo.Get(ctx, credID)
 	if err != nil {
 		return Credential{}, err
 	}
-	if len(cred.SecretHash) > 0 || cred.PublicKey == nil || cred.PublicKey.Len() == 0 {
+	if len(cred.SecretHash) > 0 {
+		return Credential{}, ErrCredNotExist
+	}
+	if cred.JWKSURL != "" {
+		if cred.PublicKey, err = s.jwksCache.Get(ctx, cred.JWKSURL, ""); err != nil {
+			return Credential{}, fmt.Errorf("failed to fetch keys of %s: %w", cred.JWKSURL, err)
+		}
+	}
+	if cred.PublicKey == nil || cred.PublicKey.Len() == 0 {
 		return Credential{}, ErrCredNotExist
 	}
 	return cred, err
 }
 
 func (s Service) DeleteKey(ctx context.Context, credID string) error {
-	return s.credRepo.Delete(ctx, credID)
+	if err := s.credRepo.Delete(ctx, credID); err != nil {
+		return err
+	}
+	s.secretCache.Invalidate(credID)
+	return nil
 }
 
 // CreateSecret creates a secret for the service user
 func (s Service) CreateSecret(ctx context.Context, credential Credential) (Secret, error) {
+	if credential.IsExpired(s.Now()) {
+		return Secret{}, ErrInvalidExpiry
+	}
 	// generate a random secret
 	secretBytes := make([]byte, 32)
 	if _, err := io.ReadFull(rand.Reader, secretBytes); err != nil {
 		return Secret{}, err
 	}
 	secretString := base64.RawStdEncoding.EncodeToString(secretBytes)
-	if sHash, err := bcrypt.GenerateFromPassword([]byte(secretString), 14); err != nil {
+	if sHash, err := hashSecret(s.config.SecretHash, []byte(secretString)); err != nil {
 		return Secret{}, err
 	} else {
 		credential.SecretHash = sHash
@@ -243,40 +358,382 @@ func (s Service) ListSecret(ctx context.Context, serviceUserID string) ([]Creden
 }
 
 // GetBySecret matches the secret with the secret hash stored in the database of the service user
-// and if the secret matches, returns the service user
-func (s Service) GetBySecret(ctx context.Context, credID string, credSecret string) (ServiceUser, error) {
+// and if the secret matches, returns the service user along with the credential
+func (s Service) GetBySecret(ctx context.Context, credID string, credSecret string) (ServiceUser, Credential, error) {
+	cacheKey := s.secretCache.Key(credID, credSecret)
+	if svUser, cred, ok := s.secretCache.Get(cacheKey); ok {
+		cred, err := s.useCredential(ctx, cred)
+		if err != nil {
+			return ServiceUser{}, Credential{}, err
+		}
+		s.secretCache.Set(cacheKey, svUser, cred)
+		return svUser, cred, nil
+	}
+
 	cred, err := s.credRepo.Get(ctx, credID)
 	if err != nil {
-		return ServiceUser{}, err
+		return ServiceUser{}, Credential{}, err
 	}
 	if len(cred.SecretHash) <= 0 {
-		return ServiceUser{}, ErrInvalidCred
+		return ServiceUser{}, Credential{}, ErrInvalidCred
+	}
+	if err := compareSecret(cred.SecretHash, []byte(credSecret)); err != nil {
+		return ServiceUser{}, Credential{}, ErrInvalidCred
+	}
+	s.rehashSecret(ctx, cred, credSecret)
+
+	svUser, cred, err := s.getByCredential(ctx, cred)
+	if err != nil {
+		return ServiceUser{}, Credential{}, err
+	}
+	s.secretCache.Set(cacheKey, svUser, cred)
+	return svUser, cred, nil
+}
+
+// rehashSecret moves a verified secret to the configured hash scheme
+func (s Service) rehashSecret(ctx context.Context, cred Credential, credSecret string) {
+	if s.config.SecretHash == "" || secretHashScheme(cred.SecretHash) == s.config.SecretHash {
+		return
+	}
+	sHash, err := hashSecret(s.config.SecretHash, []byte(credSecret))
+	if err == nil {
+		err = s.credRepo.UpdateSecretHash(ctx, cred.ID, sHash)
+	}
+	if err != nil {
+		s.log.Warn("failed to rehash service user secret", "id", cred.ID, "err", err)
+	}
+}
+
+// GetByToken returns the service user along with the credential by verifying the token.
+// The credential is named by the kid of the token, or for a credential with a key set
+// url by the iss claim as the kid names a key of the set
+func (s Service) GetByToken(ctx context.Context, token string) (ServiceUser, Credential, error) {
+	insecureToken, err := jwt.ParseInsecure([]byte(token))
+	if err != nil {
+		return ServiceUser{}, Credential{}, fmt.Errorf("invalid serviceuser token: %w", err)
+	}
+	var tokenKID string
+	if val, ok := insecureToken.Get(jwk.KeyIDKey); ok {
+		tokenKID, _ = val.(string)
+	}
+	if msg, err := jws.Parse([]byte(token)); err == nil && len(msg.Signatures()) > 0 {
+		if kid := msg.Signatures()[0].ProtectedHeaders().KeyID(); kid != "" {
+			tokenKID = kid
+		}
+	}
+	if tokenKID == "" {
+		return ServiceUser{}, Credential{}, fmt.Errorf("invalid key id from token")
+	}
+
+	cred, err := s.credRepo.Get(ctx, tokenKID)
+	if err != nil && insecureToken.Issuer() != "" {
+		if cred, err = s.credRepo.Get(ctx, insecureToken.Issuer()); err == nil && cred.JWKSURL == "" {
+			err = ErrCredNotExist
+		}
+	}
+	if err != nil {
+		return ServiceUser{}, Credential{}, fmt.Errorf("credential invalid of kid %s: %w", tokenKID, err)
+	}
+
+	keySet := cred.PublicKey
+	if cred.JWKSURL != "" {
+		if keySet, err = s.jwksCache.Get(ctx, cred.JWKSURL, tokenKID); err != nil {
+			return ServiceUser{}, Credential{}, fmt.Errorf("failed to fetch keys of %s: %w", cred.JWKSURL, err)
+		}
+	}
+
+	// verify token, uploaded keys may not name their algorithm
+	_, err = jwt.Parse([]byte(token), jwt.WithKeySet(keySet, jws.WithInferAlgorithmFromKey(true)))
+	if err != nil {
+		return ServiceUser{}, Credential{}, fmt.Errorf("invalid serviceuser token: %w", err)
+	}
+	return s.getByCredential(ctx, cred)
+}
+
+// getByCredential returns the service user of a verified credential if it hasn't
+// expired and records its usage
+func (s Service) getByCredential(ctx context.Context, cred Credential) (ServiceUser, Credential, error) {
+	if cred.IsExpired(s.Now()) {
+		return ServiceUser{}, Credential{}, ErrCredExpired
+	}
+	svUser, err := s.repo.GetByID(ctx, cred.ServiceUserID)
+	if err != nil {
+		return ServiceUser{}, Credential{}, err
+	}
+	cred, err = s.useCredential(ctx, cred)
+	if err != nil {
+		return ServiceUser{}, Credential{}, err
+	}
+	return svUser, cred, nil
+}
+
+// useCredential rejects an expired credential and records its usage, the
+// credential is returned with its last usage updated if it was recorded
+func (s Service) useCredential(ctx context.Context, cred Credential) (Credential, error) {
+	now := s.Now()
+	if cred.IsExpired(now) {
+		return Credential{}, ErrCredExpired
+	}
+
+	// usage is written in the background to keep it off the request path and
+	// at most once every usageInterval to keep busy credentials from hammering the db
+	if now.Sub(cred.LastUsedAt) >= usageInterval {
+		ip := clientIP(ctx)
+		go func(ctx context.Context) {
+			if err := s.credRepo.UpdateUsage(ctx, cred.ID, now, ip); err != nil {
+				s.log.Warn("failed to update usage of service user credential", "id", cred.ID, "err", err)
+			}
+		}(context.WithoutCancel(ctx))
+		cred.LastUsedAt = now
+		cred.LastUsedIP = ip
+	}
+	return cred, nil
+}
+
+// clientIP returns the address of the caller, the first address of x-forwarded-for
+// is used for requests coming through the gateway
+func clientIP(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if forwarded := md.Get("x-forwarded-for"); len(forwarded) > 0 {
+			return strings.TrimSpace(strings.Split(forwarded[0], ",")[0])
+		}
 	}
-	if err := bcrypt.CompareHashAndPassword(cred.SecretHash, []byte(credSecret)); err != nil {
-		return ServiceUser{}, ErrInvalidCred
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+	return ""
+}
+
+// CreateTrust lets tokens of an external issuer matching the trust conditions
+// authenticate as the service user
+func (s Service) CreateTrust(ctx context.Context, trust Trust) (Trust, error) {
+	if err := trust.Validate(); err != nil {
+		return Trust{}, err
+	}
+	if trust.IsExpired(s.Now()) {
+		return Trust{}, ErrInvalidExpiry
+	}
+	if _, err := s.repo.GetByID(ctx, trust.ServiceUserID); err != nil {
+		return Trust{}, err
 	}
-	return s.repo.GetByID(ctx, cred.ServiceUserID)
+	return s.trustRepo.Create(ctx, trust)
+}
+
+func (s Service) ListTrusts(ctx context.Context, serviceUserID string) ([]Trust, error) {
+	return s.trustRepo.List(ctx, TrustFilter{
+		ServiceUserID: serviceUserID,
+	})
+}
+
+func (s Service) GetTrust(ctx context.Context, id string) (Trust, error) {
+	return s.trustRepo.Get(ctx, id)
+}
+
+func (s Service) DeleteTrust(ctx context.Context, id string) error {
+	return s.trustRepo.Delete(ctx, id)
 }
 
-// GetByToken returns the service user by verifying the token
-func (s Service) GetByToken(ctx context.Context, token string) (ServiceUser, error) {
+// GetByExternalToken returns the service user trusting a token of an external
+// issuer along with the trust it matched. If serviceUserID is set only trusts of
+// that service user are checked, it's required if more than one service user
+// trusts the token
+func (s Service) GetByExternalToken(ctx context.Context, token, serviceUserID string) (ServiceUser, Trust, error) {
 	insecureToken, err := jwt.ParseInsecure([]byte(token))
+	if err != nil || insecureToken.Issuer() == "" {
+		return ServiceUser{}, Trust{}, ErrUntrustedToken
+	}
+	trusts, err := s.trustRepo.List(ctx, TrustFilter{
+		ServiceUserID: serviceUserID,
+		Issuer:        insecureToken.Issuer(),
+	})
+	if err != nil {
+		return ServiceUser{}, Trust{}, err
+	}
+
+	var matched []Trust
+	for _, trust := range trusts {
+		if trust.IsExpired(s.Now()) {
+			continue
+		}
+		verifiedToken, err := s.verifyExternalToken(ctx, trust, token)
+		if err != nil {
+			s.log.Debug("token not verified by service user trust", "id", trust.ID, "err", err)
+			continue
+		}
+		if !trust.Matches(ctx, verifiedToken) {
+			continue
+		}
+		if len(matched) > 0 && matched[0].ServiceUserID != trust.ServiceUserID {
+			return ServiceUser{}, Trust{}, ErrAmbiguousTrust
+		}
+		matched = append(matched, trust)
+	}
+	if len(matched) == 0 {
+		return ServiceUser{}, Trust{}, ErrUntrustedToken
+	}
+
+	svUser, err := s.repo.GetByID(ctx, matched[0].ServiceUserID)
+	if err != nil {
+		return ServiceUser{}, Trust{}, err
+	}
+	if svUser.State == Disabled.String() {
+		return ServiceUser{}, Trust{}, ErrDisabled
+	}
+	return svUser, matched[0], nil
+}
+
+// verifyExternalToken checks the signature, issuer, audience and lifetime of
+// the token with the keys of the trust
+func (s Service) verifyExternalToken(ctx context.Context, trust Trust, token string) (jwt.Token, error) {
+	keySet := trust.JWKS
+	if trust.JWKSURL != "" {
+		var kid string
+		if msg, err := jws.Parse([]byte(token)); err == nil && len(msg.Signatures()) > 0 {
+			kid = msg.Signatures()[0].ProtectedHeaders().KeyID()
+		}
+		var err error
+		if keySet, err = s.jwksCache.Get(ctx, trust.JWKSURL, kid); err != nil {
+			return nil, fmt.Errorf("failed to fetch keys of %s: %w", trust.JWKSURL, err)
+		}
+	}
+
+	opts := []jwt.ParseOption{
+		jwt.WithKeySet(keySet, jws.WithInferAlgorithmFromKey(true)),
+		jwt.WithIssuer(trust.Issuer),
+		jwt.WithAudience(trust.Audience),
+		jwt.WithRequiredClaim(jwt.ExpirationKey),
+		jwt.WithClock(jwt.ClockFunc(s.Now)),
+		jwt.WithAcceptableSkew(time.Minute),
+	}
+	return jwt.Parse([]byte(token), opts...)
+}
+
+// InitCredentialExpiry starts a cron job that runs every hour to notify owners
+// of credentials about to expire and delete expired and stale credentials
+func (s Service) InitCredentialExpiry(ctx context.Context) error {
+	_, err := s.cron.AddFunc(expirySchedule, func() {
+		if err := s.NotifyExpiring(ctx); err != nil {
+			s.log.Warn("error notifying expiring service user credentials", "err", err)
+		}
+		if err := s.DeleteExpired(ctx); err != nil {
+			s.log.Warn("error deleting expired service user credentials", "err", err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	s.cron.Start()
+	return nil
+}
+
+func (s Service) Close() {
+	s.cron.Stop()
+}
+
+// NotifyExpiring mails users who can manage the service user of each credential
+// expiring within the notice period, owners are only notified once per credential
+func (s Service) NotifyExpiring(ctx context.Context) error {
+	creds, err := s.credRepo.List(ctx, Filter{
+		ExpiresBefore: s.Now().Add(s.config.ExpiryNotice),
+	})
+	if err != nil {
+		return err
+	}
+
+	var notifyErr error
+	for _, cred := range creds {
+		if !cred.ExpiryNotifiedAt.IsZero() || cred.IsExpired(s.Now()) {
+			continue
+		}
+		if err := s.notifyOwners(ctx, cred); err != nil {
+			notifyErr = errors.Join(notifyErr, fmt.Errorf("failed to notify owners of credential[%s]: %w", cred.ID, err))
+			continue
+		}
+		if err := s.credRepo.SetExpiryNotified(ctx, cred.ID); err != nil {
+			notifyErr = errors.Join(notifyErr, err)
+		}
+	}
+	return notifyErr
+}
+
+func (s Service) notifyOwners(ctx context.Context, cred Credential) error {
+	svUser, err := s.repo.GetByID(ctx, cred.ServiceUserID)
+	if err != nil {
+		return err
+	}
+	ownerIDs, err := s.relService.LookupSubjects(ctx, relation.Relation{
+		Object: relation.Object{
+			ID:        svUser.ID,
+			Namespace: schema.ServiceUserPrincipal,
+		},
+		Subject: relation.Subject{
+			Namespace: schema.UserPrincipal,
+		},
+		RelationName: schema.ManagePermission,
+	})
 	if err != nil {
-		return ServiceUser{}, fmt.Errorf("invalid serviceuser token: %w", err)
+		return err
 	}
-	tokenKID, ok := insecureToken.Get(jwk.KeyIDKey)
-	if !ok {
-		return ServiceUser{}, fmt.Errorf("invalid key id from token")
+	if len(ownerIDs) == 0 {
+		return nil
 	}
-	cred, err := s.credRepo.Get(ctx, tokenKID.(string))
+	owners, err := s.userService.GetByIDs(ctx, ownerIDs)
 	if err != nil {
-		return ServiceUser{}, fmt.Errorf("credential invalid of kid %s: %w", tokenKID.(string), err)
+		return err
 	}
 
-	// verify token
-	_, err = jwt.Parse([]byte(token), jwt.WithKeySet(cred.PublicKey))
+	_, err = s.mailService.Send(ctx, email.Request{
+		Template: email.TemplateCredentialExpiry,
+		OrgID:    svUser.OrgID,
+		To: utils.Map(owners, func(u user.User) string {
+			return u.Email
+		}),
+		Data: map[string]string{
+			"Title":         cred.Title,
+			"CredentialID":  cred.ID,
+			"ServiceUser":   svUser.Title,
+			"ServiceUserID": svUser.ID,
+			"ExpiresAt":     cred.ExpiresAt.Format(time.RFC1123),
+		},
+		Default: email.Template{
+			Name:     email.TemplateCredentialExpiry,
+			Subject:  s.config.MailTemplate.Subject,
+			HTMLBody: s.config.MailTemplate.Body,
+		},
+	})
+	return err
+}
+
+// DeleteExpired deletes credentials which expired, and credentials which weren't
+// used for longer than the stale period if it's set
+func (s Service) DeleteExpired(ctx context.Context) error {
+	creds, err := s.credRepo.List(ctx, Filter{
+		ExpiresBefore: s.Now(),
+	})
 	if err != nil {
-		return ServiceUser{}, fmt.Errorf("invalid serviceuser token: %w", err)
+		return err
+	}
+	if s.config.StaleAfter > 0 {
+		staleCreds, err := s.credRepo.List(ctx, Filter{
+			UsedBefore: s.Now().Add(-s.config.StaleAfter),
+		})
+		if err != nil {
+			return err
+		}
+		creds = append(creds, staleCreds...)
+	}
+
+	var deleteErr error
+	for _, cred := range creds {
+		if err := s.credRepo.Delete(ctx, cred.ID); err != nil && !errors.Is(err, ErrCredNotExist) {
+			deleteErr = errors.Join(deleteErr, fmt.Errorf("failed to delete credential[%s]: %w", cred.ID, err))
+			continue
+		}
+		s.secretCache.Invalidate(cred.ID)
+		s.log.Info("deleted service user credential", "id", cred.ID, "serviceuser_id", cred.ServiceUserID)
 	}
-	return s.repo.GetByID(ctx, cred.ServiceUserID)
+	return deleteErr
 }
@@ -0,0 +1,212 @@
+package serviceuser
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/assert"
+)
+
+type memRepository struct {
+	Repository
+	users map[string]ServiceUser
+}
+
+func (r memRepository) GetByID(ctx context.Context, id string) (ServiceUser, error) {
+	if u, ok := r.users[id]; ok {
+		return u, nil
+	}
+	return ServiceUser{}, ErrNotExist
+}
+
+type memCredentialRepository struct {
+	CredentialRepository
+	mu    sync.Mutex
+	creds map[string]Credential
+	gets  int
+}
+
+func (r *memCredentialRepository) Create(ctx context.Context, cred Credential) (Credential, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.creds[cred.ID] = cred
+	return cred, nil
+}
+
+func (r *memCredentialRepository) Get(ctx context.Context, id string) (Credential, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.gets++
+	if c, ok := r.creds[id]; ok {
+		return c, nil
+	}
+	return Credential{}, ErrCredNotExist
+}
+
+func (r *memCredentialRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.creds, id)
+	return nil
+}
+
+func (r *memCredentialRepository) UpdateUsage(ctx context.Context, id string, usedAt time.Time, ip string) error {
+	return nil
+}
+
+func (r *memCredentialRepository) UpdateSecretHash(ctx context.Context, id string, secretHash []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c := r.creds[id]
+	c.SecretHash = secretHash
+	r.creds[id] = c
+	return nil
+}
+
+func newTestService(t testing.TB, config Config) (*Service, *memCredentialRepository) {
+	t.Helper()
+	credRepo := &memCredentialRepository{creds: map[string]Credential{}}
+	repo := memRepository{users: map[string]ServiceUser{
+		"su-1": {ID: "su-1", Title: "batch"},
+	}}
+	return NewService(log.NewNoop(), config, repo, credRepo, nil, nil, nil, nil), credRepo
+}
+
+func createTestSecret(t testing.TB, s *Service) Secret {
+	t.Helper()
+	secret, err := s.CreateSecret(context.Background(), Credential{
+		ID:            "cred-1",
+		ServiceUserID: "su-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return secret
+}
+
+func TestService_CreateSecret(t *testing.T) {
+	t.Run("should reject an expiry in the past", func(t *testing.T) {
+		s, _ := newTestService(t, Config{})
+		_, err := s.CreateSecret(context.Background(), Credential{
+			ServiceUserID: "su-1",
+			ExpiresAt:     time.Now().Add(-time.Hour),
+		})
+		assert.ErrorIs(t, err, ErrInvalidExpiry)
+	})
+}
+
+func TestService_GetBySecret(t *testing.T) {
+	t.Run("should verify a secret once while it's cached", func(t *testing.T) {
+		s, credRepo := newTestService(t, Config{
+			SecretHash:  SecretHashSHA256,
+			SecretCache: SecretCacheConfig{TTL: time.Minute, Size: 10},
+		})
+		secret := createTestSecret(t, s)
+
+		for i := 0; i < 3; i++ {
+			svUser, _, err := s.GetBySecret(context.Background(), secret.ID, string(secret.Value))
+			assert.NoError(t, err)
+			assert.Equal(t, "su-1", svUser.ID)
+		}
+		assert.Equal(t, 1, credRepo.gets)
+	})
+	t.Run("should not cache a wrong secret", func(t *testing.T) {
+		s, credRepo := newTestService(t, Config{
+			SecretHash:  SecretHashSHA256,
+			SecretCache: SecretCacheConfig{TTL: time.Minute, Size: 10},
+		})
+		secret := createTestSecret(t, s)
+
+		for i := 0; i < 2; i++ {
+			_, _, err := s.GetBySecret(context.Background(), secret.ID, "wrong")
+			assert.ErrorIs(t, err, ErrInvalidCred)
+		}
+		assert.Equal(t, 2, credRepo.gets)
+	})
+	t.Run("should reject a deleted secret which was cached", func(t *testing.T) {
+		s, _ := newTestService(t, Config{
+			SecretHash:  SecretHashSHA256,
+			SecretCache: SecretCacheConfig{TTL: time.Minute, Size: 10},
+		})
+		secret := createTestSecret(t, s)
+
+		_, _, err := s.GetBySecret(context.Background(), secret.ID, string(secret.Value))
+		assert.NoError(t, err)
+		assert.NoError(t, s.DeleteSecret(context.Background(), secret.ID))
+		_, _, err = s.GetBySecret(context.Background(), secret.ID, string(secret.Value))
+		assert.ErrorIs(t, err, ErrCredNotExist)
+	})
+	t.Run("should reject an expired secret which was cached", func(t *testing.T) {
+		s, _ := newTestService(t, Config{
+			SecretHash:  SecretHashSHA256,
+			SecretCache: SecretCacheConfig{TTL: time.Hour, Size: 10},
+		})
+		secret, err := s.CreateSecret(context.Background(), Credential{
+			ID:            "cred-1",
+			ServiceUserID: "su-1",
+			ExpiresAt:     s.Now().Add(time.Minute),
+		})
+		assert.NoError(t, err)
+
+		_, _, err = s.GetBySecret(context.Background(), secret.ID, string(secret.Value))
+		assert.NoError(t, err)
+		s.Now = func() time.Time {
+			return time.Now().UTC().Add(time.Hour)
+		}
+		_, _, err = s.GetBySecret(context.Background(), secret.ID, string(secret.Value))
+		assert.ErrorIs(t, err, ErrCredExpired)
+	})
+	t.Run("should rehash a verified secret with the configured scheme", func(t *testing.T) {
+		s, credRepo := newTestService(t, Config{SecretHash: SecretHashBcrypt})
+		secret := createTestSecret(t, s)
+		assert.Equal(t, SecretHashBcrypt, secretHashScheme(credRepo.creds[secret.ID].SecretHash))
+
+		s.config.SecretHash = SecretHashSHA256
+		_, _, err := s.GetBySecret(context.Background(), secret.ID, string(secret.Value))
+		assert.NoError(t, err)
+		assert.Equal(t, SecretHashSHA256, secretHashScheme(credRepo.creds[secret.ID].SecretHash))
+
+		_, _, err = s.GetBySecret(context.Background(), secret.ID, string(secret.Value))
+		assert.NoError(t, err)
+	})
+}
+
+func BenchmarkService_GetBySecret(b *testing.B) {
+	benchmarks := []struct {
+		name   string
+		config Config
+	}{
+		{
+			name:   "bcrypt",
+			config: Config{SecretHash: SecretHashBcrypt},
+		},
+		{
+			name:   "sha256",
+			config: Config{SecretHash: SecretHashSHA256},
+		},
+		{
+			name: "bcrypt cached",
+			config: Config{
+				SecretHash:  SecretHashBcrypt,
+				SecretCache: SecretCacheConfig{TTL: time.Hour, Size: 10},
+			},
+		},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			s, _ := newTestService(b, bm.config)
+			secret := createTestSecret(b, s)
+			ctx := context.Background()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, _, err := s.GetBySecret(ctx, secret.ID, string(secret.Value)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
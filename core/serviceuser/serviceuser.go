@@ -5,6 +5,7 @@ import (
 
 	"github.com/lestrrat-go/jwx/v2/jwk"
 	"github.com/raystack/frontier/pkg/metadata"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -44,8 +45,12 @@ type Credential struct {
 	// SecretHash used for basic auth
 	SecretHash []byte
 
-	// PublicKey used for JWT verification using RSA
+	// PublicKey used for JWT verification, it's generated by frontier or
+	// uploaded by the client
 	PublicKey jwk.Set
+	// JWKSURL is fetched for the keys of the credential instead of PublicKey,
+	// it lets clients keep and rotate keys in their own kms
+	JWKSURL string
 	// PrivateKey used for JWT signing using RSA, this is not stored and
 	// only generated and returned when creating a new credential
 	PrivateKey []byte
@@ -54,6 +59,55 @@ type Credential struct {
 	Metadata  metadata.Metadata
 	CreatedAt time.Time
 	UpdatedAt time.Time
+
+	// ExpiresAt is when the credential stops working, it never expires if zero
+	ExpiresAt time.Time
+	// Scope narrows what the service user can do with this credential
+	Scope Scope
+	// LastUsedAt and LastUsedIP are updated when the credential is used to
+	// authenticate, at most once every usageInterval
+	LastUsedAt time.Time
+	LastUsedIP string
+	// ExpiryNotifiedAt is set once owners are told about the upcoming expiry
+	ExpiryNotifiedAt time.Time
+}
+
+// IsExpired checks if the credential has an expiry and it's over
+func (c Credential) IsExpired(now time.Time) bool {
+	return !c.ExpiresAt.IsZero() && !c.ExpiresAt.After(now)
+}
+
+// Scope narrows the permissions a service user has through its policies for
+// requests authenticated with one of its credentials, empty lists don't narrow
+// anything
+type Scope struct {
+	// Permissions are slugs of the allowed permissions like app_project_get
+	Permissions []string `json:"permissions,omitempty"`
+	// ProjectIDs allows checks only on these projects and their resources
+	ProjectIDs []string `json:"project_ids,omitempty"`
+	// OrgID allows checks only on the org, its projects and their resources
+	OrgID string `json:"org_id,omitempty"`
+}
+
+func (s Scope) IsEmpty() bool {
+	return len(s.Permissions) == 0 && len(s.ProjectIDs) == 0 && s.OrgID == ""
+}
+
+// AllowsPermission checks if the permission slug is in scope
+func (s Scope) AllowsPermission(slug string) bool {
+	return len(s.Permissions) == 0 || slices.Contains(s.Permissions, slug)
+}
+
+// AllowsOrg checks if the org is in scope, objects outside of an org are
+// passed with an empty id and are only allowed if the org isn't narrowed
+func (s Scope) AllowsOrg(orgID string) bool {
+	return s.OrgID == "" || s.OrgID == orgID
+}
+
+// AllowsProject checks if the project is in scope, objects outside of a project
+// are passed with an empty id and are only allowed if projects aren't narrowed
+func (s Scope) AllowsProject(projectID string) bool {
+	return len(s.ProjectIDs) == 0 || (projectID != "" && slices.Contains(s.ProjectIDs, projectID))
 }
 
 type Secret struct {
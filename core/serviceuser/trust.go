@@ -0,0 +1,153 @@
+package serviceuser
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+const (
+	// TokenExchangeGrantType is the RFC 8693 grant type used to exchange a token of
+	// an external issuer for an access token of the service user trusting it
+	TokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	// JWTTokenType and IDTokenType are the subject token types accepted by the
+	// exchange, AccessTokenType is the type of the token it issues
+	JWTTokenType    = "urn:ietf:params:oauth:token-type:jwt"
+	IDTokenType     = "urn:ietf:params:oauth:token-type:id_token"
+	AccessTokenType = "urn:ietf:params:oauth:token-type:access_token"
+)
+
+// Trust lets workloads holding a token of an external OIDC issuer, like a
+// kubernetes service account or a CI job, authenticate as the service user
+// without storing one of its credentials
+type Trust struct {
+	ID            string
+	ServiceUserID string
+	Title         string
+
+	// Issuer has to match the iss claim of the token
+	Issuer string
+	// JWKSURL is fetched to get the keys the issuer signs tokens with, JWKS is
+	// used instead if the keys are set statically
+	JWKSURL string
+	JWKS    jwk.Set
+
+	// Subject is matched against the sub claim, a * matches any characters
+	Subject string
+	// Audience has to be one of the aud claim values, it's required so tokens
+	// the issuer mints for other services can't be replayed here
+	Audience string
+	// Claims are additional conditions on claims of the token, each value is
+	// matched like Subject and a claim holding a list matches if any item does
+	Claims map[string]string
+
+	// Scope narrows what the service user can do with exchanged tokens
+	Scope Scope
+	// ExpiresAt is when the trust stops working, it never expires if zero
+	ExpiresAt time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsExpired checks if the trust has an expiry and it's over
+func (t Trust) IsExpired(now time.Time) bool {
+	return !t.ExpiresAt.IsZero() && !t.ExpiresAt.After(now)
+}
+
+// Validate checks the trust can be used to verify tokens
+func (t Trust) Validate() error {
+	if strings.TrimSpace(t.Issuer) == "" {
+		return fmt.Errorf("%w: issuer is required", ErrInvalidTrust)
+	}
+	if t.JWKSURL == "" && (t.JWKS == nil || t.JWKS.Len() == 0) {
+		return fmt.Errorf("%w: jwks url or keys are required", ErrInvalidTrust)
+	}
+	if t.JWKSURL != "" && !strings.HasPrefix(t.JWKSURL, "https://") {
+		return fmt.Errorf("%w: jwks url must be https", ErrInvalidTrust)
+	}
+	// a symmetric key would be readable by anyone who can read the trust and
+	// let them sign tokens of the issuer
+	if t.JWKS != nil {
+		for i := 0; i < t.JWKS.Len(); i++ {
+			if key, ok := t.JWKS.Key(i); ok && key.KeyType() == jwa.OctetSeq {
+				return fmt.Errorf("%w: jwks can't have symmetric keys", ErrInvalidTrust)
+			}
+		}
+	}
+	if strings.TrimSpace(t.Audience) == "" {
+		return fmt.Errorf("%w: audience is required", ErrInvalidTrust)
+	}
+	// without a subject or claim condition any workload of the issuer would
+	// authenticate as the service user
+	if t.Subject == "" && len(t.Claims) == 0 {
+		return fmt.Errorf("%w: subject or claim conditions are required", ErrInvalidTrust)
+	}
+	return nil
+}
+
+// Matches checks the conditions of the trust on a verified token
+func (t Trust) Matches(ctx context.Context, token jwt.Token) bool {
+	if t.Subject != "" && !matchPattern(t.Subject, token.Subject()) {
+		return false
+	}
+	if len(t.Claims) == 0 {
+		return true
+	}
+	claims, err := token.AsMap(ctx)
+	if err != nil {
+		return false
+	}
+	for name, pattern := range t.Claims {
+		if !matchClaim(pattern, claims[name]) {
+			return false
+		}
+	}
+	return true
+}
+
+type TrustFilter struct {
+	ServiceUserID string
+	Issuer        string
+}
+
+func matchClaim(pattern string, value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case []interface{}:
+		for _, item := range v {
+			if matchClaim(pattern, item) {
+				return true
+			}
+		}
+		return false
+	case []string:
+		for _, item := range v {
+			if matchPattern(pattern, item) {
+				return true
+			}
+		}
+		return false
+	default:
+		return matchPattern(pattern, fmt.Sprint(v))
+	}
+}
+
+// matchPattern matches the value against a pattern where * matches any characters
+func matchPattern(pattern, value string) bool {
+	if !strings.Contains(pattern, "*") {
+		return pattern == value
+	}
+	parts := strings.Split(pattern, "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$").MatchString(value)
+}
@@ -0,0 +1,245 @@
+package serviceuser
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/raystack/frontier/pkg/utils"
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/assert"
+)
+
+type memTrustRepository struct {
+	TrustRepository
+	trusts []Trust
+}
+
+func (r memTrustRepository) List(ctx context.Context, flt TrustFilter) ([]Trust, error) {
+	var trusts []Trust
+	for _, t := range r.trusts {
+		if (flt.ServiceUserID == "" || t.ServiceUserID == flt.ServiceUserID) &&
+			(flt.Issuer == "" || t.Issuer == flt.Issuer) {
+			trusts = append(trusts, t)
+		}
+	}
+	return trusts, nil
+}
+
+// testIssuer serves its public keys over https like an OIDC issuer and signs tokens
+type testIssuer struct {
+	mu      sync.Mutex
+	key     jwk.Key
+	server  *httptest.Server
+	fetches int
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	t.Helper()
+	issuer := &testIssuer{}
+	issuer.rotate(t, "key-1")
+	issuer.server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		issuer.mu.Lock()
+		defer issuer.mu.Unlock()
+		issuer.fetches++
+		_ = json.NewEncoder(w).Encode(issuer.publicKeys(t))
+	}))
+	t.Cleanup(issuer.server.Close)
+	return issuer
+}
+
+func (i *testIssuer) rotate(t *testing.T, kid string) {
+	t.Helper()
+	key, err := utils.CreateJWKWithKID(kid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.key = key
+}
+
+func (i *testIssuer) publicKeys(t *testing.T) jwk.Set {
+	t.Helper()
+	pubKey, err := i.key.PublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := jwk.NewSet()
+	_ = set.AddKey(pubKey)
+	return set
+}
+
+func (i *testIssuer) sign(t *testing.T, sub string, claims map[string]interface{}) string {
+	t.Helper()
+	builder := jwt.NewBuilder().
+		Issuer(i.server.URL).
+		Subject(sub).
+		Audience([]string{"frontier"}).
+		IssuedAt(time.Now()).
+		Expiration(time.Now().Add(time.Hour))
+	for k, v := range claims {
+		builder = builder.Claim(k, v)
+	}
+	tok, err := builder.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	signed, err := jwt.Sign(tok, jwt.WithKey(jwa.RS256, i.key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(signed)
+}
+
+// newTrustTestService returns a service fetching keys with a client of the
+// issuer, the default client refuses to connect to the local test server
+func newTrustTestService(issuer *testIssuer, trusts ...Trust) *Service {
+	repo := memRepository{users: map[string]ServiceUser{
+		"su-1": {ID: "su-1", Title: "ci"},
+		"su-2": {ID: "su-2", Title: "deploy"},
+	}}
+	s := NewService(log.NewNoop(), Config{JWKSCacheTTL: time.Hour}, repo, nil,
+		memTrustRepository{trusts: trusts}, nil, nil, nil)
+	s.jwksCache.client = issuer.server.Client()
+	return s
+}
+
+func TestService_GetByExternalToken(t *testing.T) {
+	issuer := newTestIssuer(t)
+	ciTrust := Trust{
+		ID:            "trust-1",
+		ServiceUserID: "su-1",
+		Issuer:        issuer.server.URL,
+		JWKSURL:       issuer.server.URL,
+		Subject:       "system:serviceaccount:ci:*",
+		Audience:      "frontier",
+		Claims:        map[string]string{"groups": "builders"},
+		Scope:         Scope{Permissions: []string{"app_project_get"}},
+	}
+
+	t.Run("should return the service user of a matching trust", func(t *testing.T) {
+		s := newTrustTestService(issuer, ciTrust)
+		token := issuer.sign(t, "system:serviceaccount:ci:runner", map[string]interface{}{
+			"groups": []string{"admins", "builders"},
+		})
+		svUser, trust, err := s.GetByExternalToken(context.Background(), token, "")
+		assert.NoError(t, err)
+		assert.Equal(t, "su-1", svUser.ID)
+		assert.Equal(t, ciTrust.Scope, trust.Scope)
+	})
+	t.Run("should reject a token not matching the conditions", func(t *testing.T) {
+		s := newTrustTestService(issuer, ciTrust)
+		for _, token := range []string{
+			issuer.sign(t, "system:serviceaccount:prod:runner", map[string]interface{}{"groups": "builders"}),
+			issuer.sign(t, "system:serviceaccount:ci:runner", map[string]interface{}{"groups": "admins"}),
+			issuer.sign(t, "system:serviceaccount:ci:runner", nil),
+		} {
+			_, _, err := s.GetByExternalToken(context.Background(), token, "")
+			assert.ErrorIs(t, err, ErrUntrustedToken)
+		}
+	})
+	t.Run("should reject a token of another audience or signed with another key", func(t *testing.T) {
+		trust := ciTrust
+		trust.Audience = "other"
+		s := newTrustTestService(issuer, trust)
+		token := issuer.sign(t, "system:serviceaccount:ci:runner", map[string]interface{}{"groups": "builders"})
+		_, _, err := s.GetByExternalToken(context.Background(), token, "")
+		assert.ErrorIs(t, err, ErrUntrustedToken)
+
+		other := newTestIssuer(t)
+		trust = ciTrust
+		trust.JWKSURL = ""
+		trust.JWKS = other.publicKeys(t)
+		s = newTrustTestService(issuer, trust)
+		_, _, err = s.GetByExternalToken(context.Background(), token, "")
+		assert.ErrorIs(t, err, ErrUntrustedToken)
+	})
+	t.Run("should reject a token trusted by more than one service user unless one is asked for", func(t *testing.T) {
+		deployTrust := ciTrust
+		deployTrust.ID = "trust-2"
+		deployTrust.ServiceUserID = "su-2"
+		s := newTrustTestService(issuer, ciTrust, deployTrust)
+		token := issuer.sign(t, "system:serviceaccount:ci:runner", map[string]interface{}{"groups": "builders"})
+
+		_, _, err := s.GetByExternalToken(context.Background(), token, "")
+		assert.ErrorIs(t, err, ErrAmbiguousTrust)
+		svUser, _, err := s.GetByExternalToken(context.Background(), token, "su-2")
+		assert.NoError(t, err)
+		assert.Equal(t, "su-2", svUser.ID)
+	})
+	t.Run("should reject a token once the trust expired", func(t *testing.T) {
+		trust := ciTrust
+		trust.ExpiresAt = time.Now().Add(-time.Minute)
+		s := newTrustTestService(issuer, trust)
+		token := issuer.sign(t, "system:serviceaccount:ci:runner", map[string]interface{}{"groups": "builders"})
+		_, _, err := s.GetByExternalToken(context.Background(), token, "")
+		assert.ErrorIs(t, err, ErrUntrustedToken)
+	})
+	t.Run("should refuse to fetch keys from a private address", func(t *testing.T) {
+		s := newTrustTestService(issuer, ciTrust)
+		s.jwksCache.client = newKeySetClient(jwksFetchTimeout)
+		token := issuer.sign(t, "system:serviceaccount:ci:runner", map[string]interface{}{"groups": "builders"})
+		_, _, err := s.GetByExternalToken(context.Background(), token, "")
+		assert.ErrorIs(t, err, ErrUntrustedToken)
+	})
+	t.Run("should cache keys and refetch them once the issuer rotates", func(t *testing.T) {
+		s := newTrustTestService(issuer, ciTrust)
+		claims := map[string]interface{}{"groups": "builders"}
+		fetches := issuer.fetches
+		for i := 0; i < 3; i++ {
+			_, _, err := s.GetByExternalToken(context.Background(), issuer.sign(t, "system:serviceaccount:ci:runner", claims), "")
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, fetches+1, issuer.fetches)
+
+		issuer.rotate(t, "key-2")
+		// keys missing from the cached set are only refetched after jwksMinRefresh
+		s.jwksCache.now = func() time.Time {
+			return time.Now().Add(jwksMinRefresh)
+		}
+		_, _, err := s.GetByExternalToken(context.Background(), issuer.sign(t, "system:serviceaccount:ci:runner", claims), "")
+		assert.NoError(t, err)
+		assert.Equal(t, fetches+2, issuer.fetches)
+	})
+}
+
+func TestTrust_Validate(t *testing.T) {
+	valid := Trust{
+		Issuer:   "https://token.actions.githubusercontent.com",
+		JWKSURL:  "https://token.actions.githubusercontent.com/.well-known/jwks",
+		Subject:  "repo:acme/app:*",
+		Audience: "frontier",
+	}
+	assert.NoError(t, valid.Validate())
+
+	plainHTTP := valid
+	plainHTTP.JWKSURL = "http://token.actions.githubusercontent.com/.well-known/jwks"
+	assert.ErrorIs(t, plainHTTP.Validate(), ErrInvalidTrust)
+
+	noAudience := valid
+	noAudience.Audience = ""
+	assert.ErrorIs(t, noAudience.Validate(), ErrInvalidTrust)
+
+	noConditions := valid
+	noConditions.Subject = ""
+	assert.ErrorIs(t, noConditions.Validate(), ErrInvalidTrust)
+
+	symmetricKey, err := jwk.FromRaw([]byte("shared-secret-of-the-issuer"))
+	assert.NoError(t, err)
+	symmetricSet := jwk.NewSet()
+	assert.NoError(t, symmetricSet.AddKey(symmetricKey))
+	staticSymmetric := valid
+	staticSymmetric.JWKSURL = ""
+	staticSymmetric.JWKS = symmetricSet
+	assert.ErrorIs(t, staticSymmetric.Validate(), ErrInvalidTrust)
+}
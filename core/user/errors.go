@@ -1,6 +1,9 @@
 package user
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrNotExist         = errors.New("user doesn't exist")
@@ -15,4 +18,7 @@ var (
 	ErrMissingEmail     = errors.New("user email is missing")
 	ErrInvalidUUID      = errors.New("invalid syntax of uuid")
 	ErrDisabled         = errors.New("user is disabled")
+	// ErrDeleted is returned for emails of soft deleted users, the email can't
+	// be registered again until the user is purged
+	ErrDeleted = fmt.Errorf("%w: user is deleted and can only be restored by an administrator", ErrNotExist)
 )
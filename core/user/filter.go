@@ -1,5 +1,7 @@
 package user
 
+import "github.com/raystack/frontier/pkg/pagination"
+
 type Filter struct {
 	// only one filter gets applied at a time
 
@@ -10,4 +12,9 @@ type Filter struct {
 	OrgID   string
 	GroupID string
 	State   State
+	// IncludeDeleted lists soft deleted users along with the ones in State
+	IncludeDeleted bool
+
+	// Pagination takes precedence over Limit and Page if set
+	Pagination *pagination.Pagination
 }
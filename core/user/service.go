@@ -42,7 +42,7 @@ func NewService(repository Repository, relationRepo RelationService) *Service {
 // GetByID email or slug
 func (s Service) GetByID(ctx context.Context, id string) (User, error) {
 	if isValidEmail(id) {
-		return s.repository.GetByEmail(ctx, id)
+		return s.GetByEmail(ctx, id)
 	}
 	if utils.IsValidUUID(id) {
 		return s.repository.GetByID(ctx, id)
@@ -54,9 +54,18 @@ func (s Service) GetByIDs(ctx context.Context, userIDs []string) ([]User, error)
 	return s.repository.GetByIDs(ctx, userIDs)
 }
 
+// GetByEmail returns ErrDeleted if the email belongs to a soft deleted user
+// so callers don't register it again
 func (s Service) GetByEmail(ctx context.Context, email string) (User, error) {
 	email = strings.ToLower(email)
-	return s.repository.GetByEmail(ctx, email)
+	usr, err := s.repository.GetByEmail(ctx, email)
+	if err != nil {
+		return User{}, err
+	}
+	if usr.State == Deleted {
+		return User{}, ErrDeleted
+	}
+	return usr, nil
 }
 
 func (s Service) Create(ctx context.Context, user User) (User, error) {
@@ -70,6 +79,10 @@ func (s Service) Create(ctx context.Context, user User) (User, error) {
 }
 
 func (s Service) List(ctx context.Context, flt Filter) ([]User, error) {
+	if flt.Pagination != nil {
+		// members of an org or group are paged in db over the stored relations
+		return s.repository.List(ctx, flt)
+	}
 	if flt.OrgID != "" {
 		return s.ListByOrg(ctx, flt.OrgID, schema.MembershipPermission)
 	}
@@ -101,6 +114,21 @@ func (s Service) UpdateByEmail(ctx context.Context, toUpdate User) (User, error)
 	return s.repository.UpdateByEmail(ctx, toUpdate)
 }
 
+// UpdateEmail changes the email of a user by uuid, the email must not be used by
+// another user. Callers are expected to have verified the new email
+func (s Service) UpdateEmail(ctx context.Context, id, email string) (User, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if !isValidEmail(email) {
+		return User{}, ErrInvalidEmail
+	}
+	if _, err := s.repository.GetByEmail(ctx, email); err == nil {
+		return User{}, ErrConflict
+	} else if !errors.Is(err, ErrNotExist) {
+		return User{}, err
+	}
+	return s.repository.SetEmail(ctx, id, email)
+}
+
 func (s Service) Enable(ctx context.Context, id string) error {
 	return s.repository.SetState(ctx, id, Enabled)
 }
@@ -109,6 +137,17 @@ func (s Service) Disable(ctx context.Context, id string) error {
 	return s.repository.SetState(ctx, id, Disabled)
 }
 
+// SoftDelete marks the user as deleted, it can be restored until purged
+// by the cascade deleter
+func (s Service) SoftDelete(ctx context.Context, id string) error {
+	return s.repository.SetState(ctx, id, Deleted)
+}
+
+// Restore enables a soft deleted user
+func (s Service) Restore(ctx context.Context, id string) error {
+	return s.repository.Restore(ctx, id)
+}
+
 // Delete by user uuid
 // don't call this directly, use cascade deleter
 func (s Service) Delete(ctx context.Context, id string) error {
@@ -16,6 +16,8 @@ func (s State) String() string {
 const (
 	Enabled  State = "enabled"
 	Disabled State = "disabled"
+	// Deleted users are tombstoned until restored or purged
+	Deleted State = "deleted"
 )
 
 type Repository interface {
@@ -30,6 +32,8 @@ type Repository interface {
 	UpdateByEmail(ctx context.Context, toUpdate User) (User, error)
 	Delete(ctx context.Context, id string) error
 	SetState(ctx context.Context, id string, state State) error
+	SetEmail(ctx context.Context, id, email string) (User, error)
+	Restore(ctx context.Context, id string) error
 }
 
 type User struct {
@@ -42,6 +46,7 @@ type User struct {
 	Metadata  metadata.Metadata
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	DeletedAt time.Time
 }
 
 type AccessPair struct {
@@ -1,16 +1,24 @@
 package api
 
 import (
+	"github.com/raystack/frontier/core/archive"
 	"github.com/raystack/frontier/core/audit"
 	"github.com/raystack/frontier/core/authenticate"
 	"github.com/raystack/frontier/core/authenticate/session"
 	"github.com/raystack/frontier/core/deleter"
 	"github.com/raystack/frontier/core/domain"
+	"github.com/raystack/frontier/core/email"
+	"github.com/raystack/frontier/core/exporter"
+	"github.com/raystack/frontier/core/folder"
 	"github.com/raystack/frontier/core/group"
+	"github.com/raystack/frontier/core/identity"
 	"github.com/raystack/frontier/core/invitation"
+	"github.com/raystack/frontier/core/job"
+	"github.com/raystack/frontier/core/merger"
 	"github.com/raystack/frontier/core/metaschema"
 	"github.com/raystack/frontier/core/namespace"
 	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/pat"
 	"github.com/raystack/frontier/core/permission"
 	"github.com/raystack/frontier/core/policy"
 	"github.com/raystack/frontier/core/preference"
@@ -44,4 +52,12 @@ type Deps struct {
 	AuditService       *audit.Service
 	DomainService      *domain.Service
 	PreferenceService  *preference.Service
+	FolderService      *folder.Service
+	JobService         *job.Service
+	MergerService      *merger.Service
+	IdentityService    *identity.Service
+	ExporterService    *exporter.Service
+	ArchiveService     *archive.Service
+	PATService         *pat.Service
+	EmailService       *email.Service
 }
@@ -0,0 +1,211 @@
+package v1beta1
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/raystack/frontier/core/audit"
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/merger"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MergerService interface {
+	Merge(ctx context.Context, sourceID, targetID string) (merger.Summary, error)
+}
+
+type ExporterService interface {
+	Export(ctx context.Context, userID string) ([]byte, error)
+}
+
+var (
+	grpcInvalidEmailOTPErr = status.Errorf(codes.InvalidArgument, "email change code is invalid or expired")
+	grpcMergeSameUserErr   = status.Errorf(codes.InvalidArgument, merger.ErrSameUser.Error())
+	grpcScopedAccountErr   = status.Errorf(codes.PermissionDenied, "account can't be managed with a scoped credential")
+)
+
+// ChangeCurrentUserEmail mails an otp to the new email of the current user, the
+// email is changed once the otp is verified with VerifyCurrentUserEmail
+func (h Handler) ChangeCurrentUserEmail(ctx context.Context, request *frontierv1beta1.ChangeCurrentUserEmailRequest) (*frontierv1beta1.ChangeCurrentUserEmailResponse, error) {
+	logger := grpczap.Extract(ctx)
+	principal, err := h.accountOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if principal.User == nil {
+		return nil, grpcUserNotFoundError
+	}
+
+	flow, err := h.authnService.StartEmailChange(ctx, principal.ID, request.GetEmail())
+	if err != nil {
+		logger.Error(err.Error())
+		if errors.Is(err, user.ErrConflict) {
+			return nil, grpcConflictError
+		}
+		return nil, grpcInternalServerError
+	}
+	return &frontierv1beta1.ChangeCurrentUserEmailResponse{State: flow.ID.String()}, nil
+}
+
+// VerifyCurrentUserEmail changes the email of the current user, or adds the secondary
+// email, once the otp mailed by ChangeCurrentUserEmail or AddCurrentUserEmail is verified
+func (h Handler) VerifyCurrentUserEmail(ctx context.Context, request *frontierv1beta1.VerifyCurrentUserEmailRequest) (*frontierv1beta1.VerifyCurrentUserEmailResponse, error) {
+	logger := grpczap.Extract(ctx)
+	principal, err := h.accountOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if principal.User == nil {
+		return nil, grpcUserNotFoundError
+	}
+
+	change, err := h.authnService.FinishEmailChange(ctx, principal.ID, request.GetState(), request.GetCode())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, authenticate.ErrInvalidMailOTP), errors.Is(err, authenticate.ErrFlowInvalid):
+			return nil, grpcInvalidEmailOTPErr
+		case errors.Is(err, user.ErrConflict):
+			return nil, grpcConflictError
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	auditor := audit.GetAuditor(ctx, schema.PlatformOrgID.String())
+	if change.Secondary {
+		auditor.LogWithAttrs(audit.UserEmailAddedEvent, audit.UserTarget(principal.ID), map[string]string{
+			"email": change.Email,
+		})
+	} else {
+		auditor.LogWithAttrs(audit.UserEmailChangedEvent, audit.UserTarget(principal.ID), map[string]string{
+			"from": principal.User.Email,
+			"to":   change.Email,
+		})
+	}
+
+	userPB, err := transformUserToPB(change.User)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	return &frontierv1beta1.VerifyCurrentUserEmailResponse{User: userPB}, nil
+}
+
+// MergeUser moves everything of a user to the target user and disables it
+func (h Handler) MergeUser(ctx context.Context, request *frontierv1beta1.MergeUserRequest) (*frontierv1beta1.MergeUserResponse, error) {
+	logger := grpczap.Extract(ctx)
+	summary, err := h.mergerService.Merge(ctx, request.GetId(), request.GetTargetId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, user.ErrNotExist), errors.Is(err, user.ErrInvalidID), errors.Is(err, user.ErrInvalidEmail):
+			return nil, grpcUserNotFoundError
+		case errors.Is(err, merger.ErrSameUser):
+			return nil, grpcMergeSameUserErr
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+	audit.GetAuditor(ctx, schema.PlatformOrgID.String()).LogWithAttrs(audit.UserMergedEvent, audit.UserTarget(request.GetId()), map[string]string{
+		"into":        request.GetTargetId(),
+		"policies":    strconv.Itoa(summary.Policies),
+		"relations":   strconv.Itoa(summary.Relations),
+		"resources":   strconv.Itoa(summary.Resources),
+		"preferences": strconv.Itoa(summary.Preferences),
+	})
+	return &frontierv1beta1.MergeUserResponse{
+		Policies:    int32(summary.Policies),
+		Relations:   int32(summary.Relations),
+		Resources:   int32(summary.Resources),
+		Preferences: int32(summary.Preferences),
+	}, nil
+}
+
+// ExportCurrentUserData returns everything frontier holds about the current user
+// as a zip archive
+func (h Handler) ExportCurrentUserData(ctx context.Context, request *frontierv1beta1.ExportCurrentUserDataRequest) (*frontierv1beta1.ExportCurrentUserDataResponse, error) {
+	logger := grpczap.Extract(ctx)
+	principal, err := h.accountOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if principal.User == nil {
+		return nil, grpcUserNotFoundError
+	}
+
+	archive, err := h.exporterService.Export(ctx, principal.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	return &frontierv1beta1.ExportCurrentUserDataResponse{Archive: archive}, nil
+}
+
+// DeleteCurrentUser soft deletes the current user and logs them out, the user can
+// be restored until it's purged after the grace period
+func (h Handler) DeleteCurrentUser(ctx context.Context, request *frontierv1beta1.DeleteCurrentUserRequest) (*frontierv1beta1.DeleteCurrentUserResponse, error) {
+	logger := grpczap.Extract(ctx)
+	principal, err := h.accountOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if principal.User == nil {
+		return nil, grpcUserNotFoundError
+	}
+
+	report, token, err := h.checkDeleteImpact(ctx, principal.ID, request.GetDryRun(), request.GetConfirmationToken(),
+		h.deleterService.UserImpact, user.ErrNotExist, grpcUserNotFoundError)
+	if err != nil {
+		return nil, err
+	}
+	if request.GetDryRun() {
+		return &frontierv1beta1.DeleteCurrentUserResponse{Report: report, ConfirmationToken: token}, nil
+	}
+	if err := h.deleterService.SoftDeleteUser(ctx, principal.ID); err != nil {
+		logger.Error(err.Error())
+		if ownerErr := lastOwnerError(err); ownerErr != nil {
+			return nil, ownerErr
+		}
+		return nil, grpcInternalServerError
+	}
+	if err := h.endCurrentSession(ctx); err != nil {
+		return nil, err
+	}
+	return &frontierv1beta1.DeleteCurrentUserResponse{}, nil
+}
+
+// accountOwner returns the current principal if it can manage its own account, a
+// scoped credential only reaches resources in its scope and never the account itself
+func (h Handler) accountOwner(ctx context.Context) (authenticate.Principal, error) {
+	principal, err := h.GetLoggedInPrincipal(ctx)
+	if err != nil {
+		return authenticate.Principal{}, err
+	}
+	if principal.Scope != nil {
+		return authenticate.Principal{}, grpcScopedAccountErr
+	}
+	return principal, nil
+}
+
+// endCurrentSession logs out the current user once they deleted themselves
+func (h Handler) endCurrentSession(ctx context.Context) error {
+	logger := grpczap.Extract(ctx)
+	if sessionID, err := h.getLoggedInSessionID(ctx); err == nil {
+		if err = h.sessionService.Delete(ctx, sessionID); err != nil {
+			logger.Error(err.Error())
+			return grpcInternalServerError
+		}
+	}
+	if err := deleteCookieHeaders(ctx); err != nil {
+		logger.Error(err.Error())
+		return grpcInternalServerError
+	}
+	return nil
+}
@@ -0,0 +1,329 @@
+package v1beta1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/authenticate"
+	frontiersession "github.com/raystack/frontier/core/authenticate/session"
+	"github.com/raystack/frontier/core/merger"
+	"github.com/raystack/frontier/core/serviceuser"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/api/v1beta1/mocks"
+	"github.com/raystack/frontier/pkg/server/consts"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	grpcmetadata "google.golang.org/grpc/metadata"
+)
+
+func TestHandler_ChangeCurrentUserEmail(t *testing.T) {
+	currentUser := user.User{
+		ID:    uuid.New().String(),
+		Email: "jane@acme.org",
+		Title: "jane",
+	}
+	flowID := uuid.New()
+	tests := []struct {
+		name    string
+		setup   func(as *mocks.AuthnService)
+		headers grpcmetadata.MD
+		want    *frontierv1beta1.ChangeCurrentUserEmailResponse
+		wantErr error
+	}{
+		{
+			name: "should mail an otp to the new email and return state of the change",
+			setup: func(as *mocks.AuthnService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: currentUser.ID, User: &currentUser}, nil)
+				as.EXPECT().StartEmailChange(mock.Anything, currentUser.ID, "jane.doe@acme.org").
+					Return(&authenticate.Flow{ID: flowID}, nil)
+			},
+			headers: grpcmetadata.MD{},
+			want:    &frontierv1beta1.ChangeCurrentUserEmailResponse{State: flowID.String()},
+		},
+		{
+			name: "should return conflict if new email is used by another user",
+			setup: func(as *mocks.AuthnService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: currentUser.ID, User: &currentUser}, nil)
+				as.EXPECT().StartEmailChange(mock.Anything, currentUser.ID, "jane.doe@acme.org").
+					Return(nil, user.ErrConflict)
+			},
+			headers: grpcmetadata.MD{},
+			wantErr: grpcConflictError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAuthnSvc := new(mocks.AuthnService)
+			if tt.setup != nil {
+				tt.setup(mockAuthnSvc)
+			}
+			h := Handler{
+				authnService: mockAuthnSvc,
+			}
+
+			ctx := grpcmetadata.NewIncomingContext(context.Background(), tt.headers)
+			got, err := h.ChangeCurrentUserEmail(ctx, &frontierv1beta1.ChangeCurrentUserEmailRequest{
+				Email: "jane.doe@acme.org",
+			})
+			assert.EqualValues(t, tt.want, got)
+			assert.EqualValues(t, tt.wantErr, err)
+			mockAuthnSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_VerifyCurrentUserEmail(t *testing.T) {
+	currentUser := user.User{
+		ID:    uuid.New().String(),
+		Email: "jane@acme.org",
+		Title: "jane",
+	}
+	flowID := uuid.New()
+	tests := []struct {
+		name      string
+		setup     func(as *mocks.AuthnService)
+		code      string
+		wantEmail string
+		wantErr   error
+	}{
+		{
+			name: "should change the email once otp is verified",
+			setup: func(as *mocks.AuthnService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: currentUser.ID, User: &currentUser}, nil)
+				as.EXPECT().FinishEmailChange(mock.Anything, currentUser.ID, flowID.String(), "123456").
+					Return(authenticate.EmailChange{
+						User:  user.User{ID: currentUser.ID, Email: "jane.doe@acme.org"},
+						Email: "jane.doe@acme.org",
+					}, nil)
+			},
+			code:      "123456",
+			wantEmail: "jane.doe@acme.org",
+		},
+		{
+			name: "should return invalid argument if otp doesn't match",
+			setup: func(as *mocks.AuthnService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: currentUser.ID, User: &currentUser}, nil)
+				as.EXPECT().FinishEmailChange(mock.Anything, currentUser.ID, flowID.String(), "000000").
+					Return(authenticate.EmailChange{}, authenticate.ErrInvalidMailOTP)
+			},
+			code:    "000000",
+			wantErr: grpcInvalidEmailOTPErr,
+		},
+		{
+			name: "should return conflict if email was taken since the otp was sent",
+			setup: func(as *mocks.AuthnService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: currentUser.ID, User: &currentUser}, nil)
+				as.EXPECT().FinishEmailChange(mock.Anything, currentUser.ID, flowID.String(), "123456").
+					Return(authenticate.EmailChange{}, user.ErrConflict)
+			},
+			code:    "123456",
+			wantErr: grpcConflictError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAuthnSvc := new(mocks.AuthnService)
+			if tt.setup != nil {
+				tt.setup(mockAuthnSvc)
+			}
+			h := Handler{
+				authnService: mockAuthnSvc,
+			}
+
+			got, err := h.VerifyCurrentUserEmail(context.Background(), &frontierv1beta1.VerifyCurrentUserEmailRequest{
+				State: flowID.String(),
+				Code:  tt.code,
+			})
+			assert.EqualValues(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.wantEmail, got.GetUser().GetEmail())
+			}
+		})
+	}
+}
+
+func TestHandler_MergeUser(t *testing.T) {
+	sourceID := uuid.New().String()
+	targetID := uuid.New().String()
+	tests := []struct {
+		name     string
+		setup    func(ms *mocks.MergerService)
+		targetID string
+		want     *frontierv1beta1.MergeUserResponse
+		wantErr  error
+	}{
+		{
+			name: "should merge the user into the target and return what was moved",
+			setup: func(ms *mocks.MergerService) {
+				ms.EXPECT().Merge(mock.Anything, sourceID, targetID).Return(merger.Summary{Policies: 2, Relations: 3}, nil)
+			},
+			targetID: targetID,
+			want:     &frontierv1beta1.MergeUserResponse{Policies: 2, Relations: 3},
+		},
+		{
+			name: "should return not found if target user doesn't exist",
+			setup: func(ms *mocks.MergerService) {
+				ms.EXPECT().Merge(mock.Anything, sourceID, targetID).Return(merger.Summary{}, user.ErrNotExist)
+			},
+			targetID: targetID,
+			wantErr:  grpcUserNotFoundError,
+		},
+		{
+			name: "should return invalid argument if user is merged into itself",
+			setup: func(ms *mocks.MergerService) {
+				ms.EXPECT().Merge(mock.Anything, sourceID, sourceID).Return(merger.Summary{}, merger.ErrSameUser)
+			},
+			targetID: sourceID,
+			wantErr:  grpcMergeSameUserErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockMergerSvc := new(mocks.MergerService)
+			if tt.setup != nil {
+				tt.setup(mockMergerSvc)
+			}
+			h := Handler{
+				mergerService: mockMergerSvc,
+			}
+
+			got, err := h.MergeUser(context.Background(), &frontierv1beta1.MergeUserRequest{Id: sourceID, TargetId: tt.targetID})
+			assert.EqualValues(t, tt.want, got)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_ExportCurrentUserData(t *testing.T) {
+	currentUser := user.User{
+		ID:    uuid.New().String(),
+		Email: "jane@acme.org",
+		Title: "jane",
+	}
+	tests := []struct {
+		name    string
+		setup   func(as *mocks.AuthnService, es *mocks.ExporterService)
+		want    *frontierv1beta1.ExportCurrentUserDataResponse
+		wantErr error
+	}{
+		{
+			name: "should return the data export of the user",
+			setup: func(as *mocks.AuthnService, es *mocks.ExporterService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: currentUser.ID, User: &currentUser}, nil)
+				es.EXPECT().Export(mock.Anything, currentUser.ID).Return([]byte("archive"), nil)
+			},
+			want: &frontierv1beta1.ExportCurrentUserDataResponse{Archive: []byte("archive")},
+		},
+		{
+			name: "should return not found for a service user",
+			setup: func(as *mocks.AuthnService, es *mocks.ExporterService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: uuid.New().String()}, nil)
+			},
+			wantErr: grpcUserNotFoundError,
+		},
+		{
+			name: "should return internal error if export fails",
+			setup: func(as *mocks.AuthnService, es *mocks.ExporterService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: currentUser.ID, User: &currentUser}, nil)
+				es.EXPECT().Export(mock.Anything, currentUser.ID).Return(nil, assert.AnError)
+			},
+			wantErr: grpcInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAuthnSvc := new(mocks.AuthnService)
+			mockExporterSvc := new(mocks.ExporterService)
+			if tt.setup != nil {
+				tt.setup(mockAuthnSvc, mockExporterSvc)
+			}
+			h := Handler{
+				authnService:    mockAuthnSvc,
+				exporterService: mockExporterSvc,
+			}
+
+			got, err := h.ExportCurrentUserData(context.Background(), &frontierv1beta1.ExportCurrentUserDataRequest{})
+			assert.EqualValues(t, tt.want, got)
+			assert.EqualValues(t, tt.wantErr, err)
+			mockExporterSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_DeleteCurrentUser(t *testing.T) {
+	currentUser := user.User{
+		ID:    uuid.New().String(),
+		Email: "jane@acme.org",
+	}
+	sessionID := uuid.New()
+	tests := []struct {
+		name       string
+		setup      func(as *mocks.AuthnService, ds *mocks.CascadeDeleter, ss *mocks.SessionService)
+		wantErr    error
+		wantHeader []string
+	}{
+		{
+			name: "should delete the current user and end their session",
+			setup: func(as *mocks.AuthnService, ds *mocks.CascadeDeleter, ss *mocks.SessionService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: currentUser.ID, User: &currentUser}, nil)
+				ds.EXPECT().ConfirmationRequired().Return(false)
+				ds.EXPECT().SoftDeleteUser(mock.Anything, currentUser.ID).Return(nil)
+				ss.EXPECT().ExtractFromContext(mock.Anything).Return(&frontiersession.Session{
+					ID:              sessionID,
+					UserID:          currentUser.ID,
+					AuthenticatedAt: time.Now(),
+					ExpiresAt:       time.Now().Add(time.Hour),
+				}, nil)
+				ss.EXPECT().Delete(mock.Anything, sessionID).Return(nil)
+			},
+			wantHeader: []string{"true"},
+		},
+		{
+			name: "should keep the session if the user can't be deleted",
+			setup: func(as *mocks.AuthnService, ds *mocks.CascadeDeleter, ss *mocks.SessionService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: currentUser.ID, User: &currentUser}, nil)
+				ds.EXPECT().ConfirmationRequired().Return(false)
+				ds.EXPECT().SoftDeleteUser(mock.Anything, currentUser.ID).Return(assert.AnError)
+			},
+			wantErr: grpcInternalServerError,
+		},
+		{
+			name: "should not delete the user with a scoped personal access token",
+			setup: func(as *mocks.AuthnService, ds *mocks.CascadeDeleter, ss *mocks.SessionService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{
+					ID:    currentUser.ID,
+					User:  &currentUser,
+					Scope: &serviceuser.Scope{Permissions: []string{"app_project_get"}},
+				}, nil)
+			},
+			wantErr: grpcScopedAccountErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAuthnSvc := new(mocks.AuthnService)
+			mockDeleterSvc := new(mocks.CascadeDeleter)
+			mockSessionSvc := new(mocks.SessionService)
+			if tt.setup != nil {
+				tt.setup(mockAuthnSvc, mockDeleterSvc, mockSessionSvc)
+			}
+			h := Handler{
+				authnService:   mockAuthnSvc,
+				deleterService: mockDeleterSvc,
+				sessionService: mockSessionSvc,
+			}
+
+			stream := &headerStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			_, err := h.DeleteCurrentUser(ctx, &frontierv1beta1.DeleteCurrentUserRequest{})
+			assert.EqualValues(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantHeader, stream.header.Get(consts.SessionDeleteGatewayKey))
+			mockDeleterSvc.AssertExpectations(t)
+			mockSessionSvc.AssertExpectations(t)
+		})
+	}
+}
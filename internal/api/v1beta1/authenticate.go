@@ -22,6 +22,8 @@ import (
 	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	"github.com/raystack/frontier/core/authenticate"
 	frontiersession "github.com/raystack/frontier/core/authenticate/session"
+	"github.com/raystack/frontier/core/identity"
+	"github.com/raystack/frontier/core/serviceuser"
 	"github.com/raystack/frontier/core/user"
 	"github.com/raystack/frontier/pkg/errors"
 	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
@@ -41,6 +43,12 @@ type AuthnService interface {
 	InitFlows(ctx context.Context) error
 	SanitizeReturnToURL(url string) string
 	SanitizeCallbackURL(url string) string
+	StartEmailChange(ctx context.Context, userID, email string) (*authenticate.Flow, error)
+	StartAddEmail(ctx context.Context, userID, email string) (*authenticate.Flow, error)
+	FinishEmailChange(ctx context.Context, userID, state, code string) (authenticate.EmailChange, error)
+	StartDeviceFlow(ctx context.Context) (authenticate.DeviceAuthorization, error)
+	ApproveDeviceFlow(ctx context.Context, userCode, userID string) error
+	FinishDeviceFlow(ctx context.Context, deviceCode string) (string, error)
 }
 
 type SessionService interface {
@@ -56,8 +64,12 @@ func (h Handler) Authenticate(ctx context.Context, request *frontierv1beta1.Auth
 	callbackURL := h.authnService.SanitizeCallbackURL(request.GetCallbackUrl())
 
 	// check if user is already logged in
+	var linkUserID string
 	session, err := h.sessionService.ExtractFromContext(ctx)
-	if err == nil && session.IsValid(time.Now().UTC()) {
+	if err == nil && session.IsValid(time.Now().UTC()) && request.GetLink() {
+		// logged in users link accounts of other providers
+		linkUserID = session.UserID
+	} else if err == nil && session.IsValid(time.Now().UTC()) {
 		// already logged in, set location header for return to?
 		if len(returnToURL) != 0 {
 			if err = setRedirectHeaders(ctx, returnToURL); err != nil {
@@ -76,9 +88,16 @@ func (h Handler) Authenticate(ctx context.Context, request *frontierv1beta1.Auth
 		ReturnToURL: returnToURL,
 		CallbackUrl: callbackURL,
 		Email:       request.GetEmail(),
+		LinkUserID:  linkUserID,
 	})
 	if err != nil {
 		logger.Error(err.Error())
+		if linkUserID != "" && errors.Is(err, authenticate.ErrUnsupportedMethod) {
+			return nil, status.Error(codes.InvalidArgument, "only accounts of oidc providers can be linked")
+		}
+		if errors.Is(err, user.ErrDeleted) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -136,7 +155,16 @@ func (h Handler) AuthCallback(ctx context.Context, request *frontierv1beta1.Auth
 	})
 	if err != nil {
 		logger.Error(err.Error())
-		return nil, status.Error(codes.Internal, err.Error())
+		switch {
+		case errors.Is(err, authenticate.ErrIdentityMismatch):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, identity.ErrConflict):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		case errors.Is(err, user.ErrDeleted):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
 	}
 
 	// registration/login complete, build a session
@@ -222,6 +250,10 @@ func (h Handler) AuthToken(ctx context.Context, request *frontierv1beta1.AuthTok
 		if request.GetAssertion() != "" {
 			existingMD.Set(consts.UserTokenGatewayKey, request.GetAssertion())
 		}
+	case authenticate.DeviceCodeGrantType:
+		return h.deviceAccessToken(ctx, request.GetDeviceCode())
+	case serviceuser.TokenExchangeGrantType:
+		return h.exchangeServiceUserToken(ctx, request)
 	}
 	ctx = metadata.NewIncomingContext(ctx, existingMD)
 
@@ -235,7 +267,7 @@ func (h Handler) AuthToken(ctx context.Context, request *frontierv1beta1.AuthTok
 		return nil, err
 	}
 
-	token, err := h.getAccessToken(ctx, principal.ID)
+	token, err := h.getAccessToken(ctx, principal)
 	if err != nil {
 		logger.Error(err.Error())
 		return nil, status.Error(codes.Internal, err.Error())
@@ -251,6 +283,82 @@ func (h Handler) AuthToken(ctx context.Context, request *frontierv1beta1.AuthTok
 	}, nil
 }
 
+// deviceAccessToken logs in a device once the user approved its device code, a session
+// is created for the device to refresh the access token like browser logins
+func (h Handler) deviceAccessToken(ctx context.Context, deviceCode string) (*frontierv1beta1.AuthTokenResponse, error) {
+	logger := grpczap.Extract(ctx)
+	userID, err := h.authnService.FinishDeviceFlow(ctx, deviceCode)
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, authenticate.ErrAuthorizationPending):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, authenticate.ErrFlowInvalid):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	session, err := h.sessionService.Create(ctx, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	if err = setCookieHeaders(ctx, session.ID.String()); err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	token, err := h.getAccessToken(ctx, authenticate.Principal{ID: userID, Type: schema.UserPrincipal})
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	return &frontierv1beta1.AuthTokenResponse{
+		AccessToken: string(token),
+		TokenType:   "Bearer",
+	}, nil
+}
+
+func (h Handler) AuthDeviceCode(ctx context.Context, request *frontierv1beta1.AuthDeviceCodeRequest) (*frontierv1beta1.AuthDeviceCodeResponse, error) {
+	logger := grpczap.Extract(ctx)
+	authorization, err := h.authnService.StartDeviceFlow(ctx)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	return &frontierv1beta1.AuthDeviceCodeResponse{
+		DeviceCode:              authorization.DeviceCode,
+		UserCode:                authorization.UserCode,
+		VerificationUri:         authorization.VerificationURL,
+		VerificationUriComplete: authorization.VerificationURLComplete(),
+		ExpiresIn:               int64(time.Until(authorization.ExpiresAt).Seconds()),
+		Interval:                int64(authorization.Interval.Seconds()),
+	}, nil
+}
+
+func (h Handler) ApproveAuthDevice(ctx context.Context, request *frontierv1beta1.ApproveAuthDeviceRequest) (*frontierv1beta1.ApproveAuthDeviceResponse, error) {
+	logger := grpczap.Extract(ctx)
+	principal, err := h.GetLoggedInPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if principal.User == nil {
+		return nil, status.Error(codes.PermissionDenied, "only users can approve a device login")
+	}
+
+	if err := h.authnService.ApproveDeviceFlow(ctx, request.GetUserCode(), principal.ID); err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, authenticate.ErrInvalidUserCode):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+	return &frontierv1beta1.ApproveAuthDeviceResponse{}, nil
+}
+
 func (h Handler) getLoggedInSessionID(ctx context.Context) (uuid.UUID, error) {
 	session, err := h.sessionService.ExtractFromContext(ctx)
 	if err == nil && session.IsValid(time.Now().UTC()) {
@@ -277,8 +385,9 @@ func (h Handler) GetLoggedInPrincipal(ctx context.Context, via ...authenticate.C
 }
 
 // getAccessToken generates a jwt access token with user/org details
-func (h Handler) getAccessToken(ctx context.Context, principalID string) ([]byte, error) {
+func (h Handler) getAccessToken(ctx context.Context, principal authenticate.Principal) ([]byte, error) {
 	logger := grpczap.Extract(ctx)
+	principalID := principal.ID
 	// get orgs a user belongs to
 	orgs, err := h.orgService.ListByUser(ctx, principalID)
 	if err != nil {
@@ -292,6 +401,11 @@ func (h Handler) getAccessToken(ctx context.Context, principalID string) ([]byte
 	customClaims := map[string]string{
 		"org_ids": strings.Join(orgIds, ","),
 	}
+	if principal.Scope != nil {
+		// services behind frontier verifying the token should narrow it the same way
+		customClaims["scope_permissions"] = strings.Join(principal.Scope.Permissions, ",")
+		customClaims["scope_project_ids"] = strings.Join(principal.Scope.ProjectIDs, ",")
+	}
 
 	// find selected project id
 	if md, ok := metadata.FromIncomingContext(ctx); ok {
@@ -0,0 +1,32 @@
+package v1beta1
+
+import (
+	"context"
+	"errors"
+
+	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/raystack/frontier/core/job"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// submitBulkJob queues a job for the items of an async membership request
+// and returns its id
+func (h Handler) submitBulkJob(ctx context.Context, newJob func(createdBy string) job.Job) (string, error) {
+	logger := grpczap.Extract(ctx)
+
+	principal, err := h.GetLoggedInPrincipal(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	createdJob, err := h.jobService.Create(ctx, newJob(principal.ID))
+	if err != nil {
+		logger.Error(err.Error())
+		if errors.Is(err, job.ErrInvalidDetail) {
+			return "", status.Error(codes.InvalidArgument, err.Error())
+		}
+		return "", grpcInternalServerError
+	}
+	return createdJob.ID, nil
+}
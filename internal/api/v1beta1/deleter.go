@@ -2,24 +2,49 @@ package v1beta1
 
 import (
 	"context"
+	"errors"
 
 	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/raystack/frontier/core/deleter"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/core/user"
 	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 type CascadeDeleter interface {
-	DeleteProject(ctx context.Context, id string) error
-	DeleteOrganization(ctx context.Context, id string) error
+	SoftDeleteProject(ctx context.Context, id string) error
+	RestoreProject(ctx context.Context, id string) error
+	SoftDeleteOrganization(ctx context.Context, id string) error
+	RestoreOrganization(ctx context.Context, id string) error
 	RemoveUsersFromOrg(ctx context.Context, orgID string, userIDs []string) error
-	DeleteUser(ctx context.Context, userID string) error
+	SoftDeleteUser(ctx context.Context, userID string) error
+	RestoreUser(ctx context.Context, userID string) error
+	OrganizationImpact(ctx context.Context, id string) (deleter.Report, error)
+	ProjectImpact(ctx context.Context, id string) (deleter.Report, error)
+	UserImpact(ctx context.Context, id string) (deleter.Report, error)
+	ConfirmationRequired() bool
+	ConfirmationToken(report deleter.Report) string
+	CheckConfirmation(token string, report deleter.Report) error
 }
 
 func (h Handler) DeleteProject(ctx context.Context, request *frontierv1beta1.DeleteProjectRequest) (*frontierv1beta1.DeleteProjectResponse, error) {
 	logger := grpczap.Extract(ctx)
-	if err := h.deleterService.DeleteProject(ctx, request.GetId()); err != nil {
+	report, token, err := h.checkDeleteImpact(ctx, request.GetId(), request.GetDryRun(), request.GetConfirmationToken(),
+		h.deleterService.ProjectImpact, project.ErrNotExist, grpcProjectNotFoundErr)
+	if err != nil {
+		return nil, err
+	}
+	if request.GetDryRun() {
+		return &frontierv1beta1.DeleteProjectResponse{Report: report, ConfirmationToken: token}, nil
+	}
+	if err := h.deleterService.SoftDeleteProject(ctx, request.GetId()); err != nil {
 		logger.Error(err.Error())
+		if errors.Is(err, project.ErrNotExist) {
+			return nil, grpcProjectNotFoundErr
+		}
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 	return &frontierv1beta1.DeleteProjectResponse{}, nil
@@ -27,9 +52,103 @@ func (h Handler) DeleteProject(ctx context.Context, request *frontierv1beta1.Del
 
 func (h Handler) DeleteOrganization(ctx context.Context, request *frontierv1beta1.DeleteOrganizationRequest) (*frontierv1beta1.DeleteOrganizationResponse, error) {
 	logger := grpczap.Extract(ctx)
-	if err := h.deleterService.DeleteOrganization(ctx, request.GetId()); err != nil {
+	report, token, err := h.checkDeleteImpact(ctx, request.GetId(), request.GetDryRun(), request.GetConfirmationToken(),
+		h.deleterService.OrganizationImpact, organization.ErrNotExist, grpcOrgNotFoundErr)
+	if err != nil {
+		return nil, err
+	}
+	if request.GetDryRun() {
+		return &frontierv1beta1.DeleteOrganizationResponse{Report: report, ConfirmationToken: token}, nil
+	}
+	if err := h.deleterService.SoftDeleteOrganization(ctx, request.GetId()); err != nil {
 		logger.Error(err.Error())
+		if errors.Is(err, organization.ErrNotExist) {
+			return nil, grpcOrgNotFoundErr
+		}
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 	return &frontierv1beta1.DeleteOrganizationResponse{}, nil
 }
+
+// checkDeleteImpact returns the impact of a delete along with the token to confirm it
+// if it's a dry run, otherwise it makes sure the confirmation token sent with the
+// delete, if any, was signed for the same impact and hasn't expired
+func (h Handler) checkDeleteImpact(ctx context.Context, id string, dryRun bool, token string,
+	impact func(ctx context.Context, id string) (deleter.Report, error),
+	errNotExist, grpcNotFoundErr error) (*frontierv1beta1.DeleteReport, string, error) {
+	logger := grpczap.Extract(ctx)
+
+	if !dryRun && token == "" {
+		if h.deleterService.ConfirmationRequired() {
+			return nil, "", status.Error(codes.FailedPrecondition, "confirmation token of a dry run is required")
+		}
+		return nil, "", nil
+	}
+
+	report, err := impact(ctx, id)
+	if err != nil {
+		logger.Error(err.Error())
+		if errors.Is(err, errNotExist) {
+			return nil, "", grpcNotFoundErr
+		}
+		return nil, "", grpcInternalServerError
+	}
+	if !dryRun {
+		if err := h.deleterService.CheckConfirmation(token, report); err != nil {
+			return nil, "", status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, "", nil
+	}
+	return transformDeleteReportToPB(report), h.deleterService.ConfirmationToken(report), nil
+}
+
+func (h Handler) RestoreOrganization(ctx context.Context, request *frontierv1beta1.RestoreOrganizationRequest) (*frontierv1beta1.RestoreOrganizationResponse, error) {
+	logger := grpczap.Extract(ctx)
+	if err := h.deleterService.RestoreOrganization(ctx, request.GetId()); err != nil {
+		logger.Error(err.Error())
+		if errors.Is(err, organization.ErrNotExist) {
+			return nil, grpcOrgNotFoundErr
+		}
+		return nil, grpcInternalServerError
+	}
+	return &frontierv1beta1.RestoreOrganizationResponse{}, nil
+}
+
+func (h Handler) RestoreProject(ctx context.Context, request *frontierv1beta1.RestoreProjectRequest) (*frontierv1beta1.RestoreProjectResponse, error) {
+	logger := grpczap.Extract(ctx)
+	if err := h.deleterService.RestoreProject(ctx, request.GetId()); err != nil {
+		logger.Error(err.Error())
+		if errors.Is(err, project.ErrNotExist) {
+			return nil, grpcProjectNotFoundErr
+		}
+		return nil, grpcInternalServerError
+	}
+	return &frontierv1beta1.RestoreProjectResponse{}, nil
+}
+
+func (h Handler) RestoreUser(ctx context.Context, request *frontierv1beta1.RestoreUserRequest) (*frontierv1beta1.RestoreUserResponse, error) {
+	logger := grpczap.Extract(ctx)
+	if err := h.deleterService.RestoreUser(ctx, request.GetId()); err != nil {
+		logger.Error(err.Error())
+		if errors.Is(err, user.ErrNotExist) {
+			return nil, grpcUserNotFoundError
+		}
+		return nil, grpcInternalServerError
+	}
+	return &frontierv1beta1.RestoreUserResponse{}, nil
+}
+
+func transformDeleteReportToPB(report deleter.Report) *frontierv1beta1.DeleteReport {
+	return &frontierv1beta1.DeleteReport{
+		Organizations: report.Organizations,
+		Projects:      report.Projects,
+		Resources:     report.Resources,
+		Folders:       report.Folders,
+		Groups:        report.Groups,
+		Roles:         report.Roles,
+		Policies:      report.Policies,
+		Invitations:   report.Invitations,
+		Users:         report.Users,
+		Relations:     report.Relations,
+	}
+}
@@ -5,6 +5,10 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/raystack/frontier/core/deleter"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/core/user"
 	"github.com/raystack/frontier/internal/api/v1beta1/mocks"
 	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
 	"github.com/stretchr/testify/assert"
@@ -24,7 +28,8 @@ func TestHandler_DeleteProject(t *testing.T) {
 		{
 			name: "should return success if deleted by id return nil error",
 			setup: func(as *mocks.CascadeDeleter) {
-				as.EXPECT().DeleteProject(mock.Anything, "some-id").Return(nil)
+				as.EXPECT().ConfirmationRequired().Return(false)
+				as.EXPECT().SoftDeleteProject(mock.Anything, "some-id").Return(nil)
 			},
 			req: &frontierv1beta1.DeleteProjectRequest{
 				Id: "some-id",
@@ -35,7 +40,8 @@ func TestHandler_DeleteProject(t *testing.T) {
 		{
 			name: "should return error if deleter service encounters an error",
 			setup: func(as *mocks.CascadeDeleter) {
-				as.EXPECT().DeleteProject(mock.Anything, "some-id").Return(errors.New("some error"))
+				as.EXPECT().ConfirmationRequired().Return(false)
+				as.EXPECT().SoftDeleteProject(mock.Anything, "some-id").Return(errors.New("some error"))
 			},
 			req: &frontierv1beta1.DeleteProjectRequest{
 				Id: "some-id",
@@ -43,6 +49,17 @@ func TestHandler_DeleteProject(t *testing.T) {
 			want:    nil,
 			wantErr: status.Errorf(codes.Internal, errors.New("some error").Error()),
 		},
+		{
+			name: "should return failed precondition if deletes require confirmation",
+			setup: func(as *mocks.CascadeDeleter) {
+				as.EXPECT().ConfirmationRequired().Return(true)
+			},
+			req: &frontierv1beta1.DeleteProjectRequest{
+				Id: "some-id",
+			},
+			want:    nil,
+			wantErr: status.Error(codes.FailedPrecondition, "confirmation token of a dry run is required"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -69,7 +86,8 @@ func TestHandler_DeleteOrganization(t *testing.T) {
 		{
 			name: "should return success if deleted by id return nil error",
 			setup: func(as *mocks.CascadeDeleter) {
-				as.EXPECT().DeleteOrganization(mock.Anything, "some-id").Return(nil)
+				as.EXPECT().ConfirmationRequired().Return(false)
+				as.EXPECT().SoftDeleteOrganization(mock.Anything, "some-id").Return(nil)
 			},
 			req: &frontierv1beta1.DeleteOrganizationRequest{
 				Id: "some-id",
@@ -80,7 +98,8 @@ func TestHandler_DeleteOrganization(t *testing.T) {
 		{
 			name: "should return error if deleter service encounters an error",
 			setup: func(as *mocks.CascadeDeleter) {
-				as.EXPECT().DeleteOrganization(mock.Anything, "some-id").Return(errors.New("some_error"))
+				as.EXPECT().ConfirmationRequired().Return(false)
+				as.EXPECT().SoftDeleteOrganization(mock.Anything, "some-id").Return(errors.New("some_error"))
 			},
 			req: &frontierv1beta1.DeleteOrganizationRequest{
 				Id: "some-id",
@@ -102,3 +121,195 @@ func TestHandler_DeleteOrganization(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_RestoreOrganization(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(as *mocks.CascadeDeleter)
+		want    *frontierv1beta1.RestoreOrganizationResponse
+		wantErr error
+	}{
+		{
+			name: "should restore a soft deleted org",
+			setup: func(as *mocks.CascadeDeleter) {
+				as.EXPECT().RestoreOrganization(mock.Anything, "some-id").Return(nil)
+			},
+			want: &frontierv1beta1.RestoreOrganizationResponse{},
+		},
+		{
+			name: "should return not found if org isn't soft deleted",
+			setup: func(as *mocks.CascadeDeleter) {
+				as.EXPECT().RestoreOrganization(mock.Anything, "some-id").Return(organization.ErrNotExist)
+			},
+			wantErr: grpcOrgNotFoundErr,
+		},
+		{
+			name: "should return internal error if deleter service encounters an error",
+			setup: func(as *mocks.CascadeDeleter) {
+				as.EXPECT().RestoreOrganization(mock.Anything, "some-id").Return(errors.New("some_error"))
+			},
+			wantErr: grpcInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDelOrg := new(mocks.CascadeDeleter)
+			if tt.setup != nil {
+				tt.setup(mockDelOrg)
+			}
+			mockDel := Handler{deleterService: mockDelOrg}
+			resp, err := mockDel.RestoreOrganization(context.Background(), &frontierv1beta1.RestoreOrganizationRequest{
+				Id: "some-id",
+			})
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_RestoreProject(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(as *mocks.CascadeDeleter)
+		want    *frontierv1beta1.RestoreProjectResponse
+		wantErr error
+	}{
+		{
+			name: "should restore a soft deleted project",
+			setup: func(as *mocks.CascadeDeleter) {
+				as.EXPECT().RestoreProject(mock.Anything, "some-id").Return(nil)
+			},
+			want: &frontierv1beta1.RestoreProjectResponse{},
+		},
+		{
+			name: "should return not found if project isn't soft deleted",
+			setup: func(as *mocks.CascadeDeleter) {
+				as.EXPECT().RestoreProject(mock.Anything, "some-id").Return(project.ErrNotExist)
+			},
+			wantErr: grpcProjectNotFoundErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDelProject := new(mocks.CascadeDeleter)
+			if tt.setup != nil {
+				tt.setup(mockDelProject)
+			}
+			mockDel := Handler{deleterService: mockDelProject}
+			resp, err := mockDel.RestoreProject(context.Background(), &frontierv1beta1.RestoreProjectRequest{
+				Id: "some-id",
+			})
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_RestoreUser(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(as *mocks.CascadeDeleter)
+		want    *frontierv1beta1.RestoreUserResponse
+		wantErr error
+	}{
+		{
+			name: "should restore a soft deleted user",
+			setup: func(as *mocks.CascadeDeleter) {
+				as.EXPECT().RestoreUser(mock.Anything, "some-id").Return(nil)
+			},
+			want: &frontierv1beta1.RestoreUserResponse{},
+		},
+		{
+			name: "should return not found if user isn't soft deleted",
+			setup: func(as *mocks.CascadeDeleter) {
+				as.EXPECT().RestoreUser(mock.Anything, "some-id").Return(user.ErrNotExist)
+			},
+			wantErr: grpcUserNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDelUser := new(mocks.CascadeDeleter)
+			if tt.setup != nil {
+				tt.setup(mockDelUser)
+			}
+			mockDel := Handler{deleterService: mockDelUser}
+			resp, err := mockDel.RestoreUser(context.Background(), &frontierv1beta1.RestoreUserRequest{
+				Id: "some-id",
+			})
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_DeleteOrganizationImpact(t *testing.T) {
+	report := deleter.Report{
+		Organizations: []string{"some-id"},
+		Projects:      []string{"project-id"},
+		Relations:     []string{"relation-id"},
+	}
+	tests := []struct {
+		name    string
+		setup   func(as *mocks.CascadeDeleter)
+		req     *frontierv1beta1.DeleteOrganizationRequest
+		want    *frontierv1beta1.DeleteOrganizationResponse
+		wantErr error
+	}{
+		{
+			name: "should report impact along with a confirmation token without deleting if it's a dry run",
+			setup: func(as *mocks.CascadeDeleter) {
+				as.EXPECT().OrganizationImpact(mock.Anything, "some-id").Return(report, nil)
+				as.EXPECT().ConfirmationToken(report).Return("some-token")
+			},
+			req: &frontierv1beta1.DeleteOrganizationRequest{Id: "some-id", DryRun: true},
+			want: &frontierv1beta1.DeleteOrganizationResponse{
+				Report: &frontierv1beta1.DeleteReport{
+					Organizations: []string{"some-id"},
+					Projects:      []string{"project-id"},
+					Relations:     []string{"relation-id"},
+				},
+				ConfirmationToken: "some-token",
+			},
+		},
+		{
+			name: "should return not found if org doesn't exist in a dry run",
+			setup: func(as *mocks.CascadeDeleter) {
+				as.EXPECT().OrganizationImpact(mock.Anything, "some-id").Return(deleter.Report{}, organization.ErrNotExist)
+			},
+			req:     &frontierv1beta1.DeleteOrganizationRequest{Id: "some-id", DryRun: true},
+			wantErr: grpcOrgNotFoundErr,
+		},
+		{
+			name: "should delete if confirmation token matches the impact",
+			setup: func(as *mocks.CascadeDeleter) {
+				as.EXPECT().OrganizationImpact(mock.Anything, "some-id").Return(report, nil)
+				as.EXPECT().CheckConfirmation("some-token", report).Return(nil)
+				as.EXPECT().SoftDeleteOrganization(mock.Anything, "some-id").Return(nil)
+			},
+			req:  &frontierv1beta1.DeleteOrganizationRequest{Id: "some-id", ConfirmationToken: "some-token"},
+			want: &frontierv1beta1.DeleteOrganizationResponse{},
+		},
+		{
+			name: "should return failed precondition if confirmation token is invalid",
+			setup: func(as *mocks.CascadeDeleter) {
+				as.EXPECT().OrganizationImpact(mock.Anything, "some-id").Return(report, nil)
+				as.EXPECT().CheckConfirmation("some-token", report).Return(deleter.ErrConfirmationExpired)
+			},
+			req:     &frontierv1beta1.DeleteOrganizationRequest{Id: "some-id", ConfirmationToken: "some-token"},
+			wantErr: status.Error(codes.FailedPrecondition, deleter.ErrConfirmationExpired.Error()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDelOrg := new(mocks.CascadeDeleter)
+			if tt.setup != nil {
+				tt.setup(mockDelOrg)
+			}
+			mockDel := Handler{deleterService: mockDelOrg}
+			resp, err := mockDel.DeleteOrganization(context.Background(), tt.req)
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
@@ -29,6 +29,7 @@ type DomainService interface {
 	Create(ctx context.Context, toCreate domain.Domain) (domain.Domain, error)
 	VerifyDomain(ctx context.Context, id string) (domain.Domain, error)
 	Join(ctx context.Context, orgID string, userID string) error
+	UpdateAutoJoin(ctx context.Context, id string, autoJoin domain.AutoJoin) (domain.Domain, error)
 }
 
 func (h Handler) CreateOrganizationDomain(ctx context.Context, request *frontierv1beta1.CreateOrganizationDomainRequest) (*frontierv1beta1.CreateOrganizationDomainResponse, error) {
@@ -47,14 +48,17 @@ func (h Handler) CreateOrganizationDomain(ctx context.Context, request *frontier
 	}
 
 	dmn, err := h.domainService.Create(ctx, domain.Domain{
-		OrgID: orgResp.ID,
-		Name:  request.GetDomain(),
+		OrgID:    orgResp.ID,
+		Name:     request.GetDomain(),
+		AutoJoin: autoJoinFromPB(request.GetAutoJoin()),
 	})
 	if err != nil {
 		logger.Error(err.Error())
-		switch err {
-		case domain.ErrDuplicateKey:
+		switch {
+		case errors.Is(err, domain.ErrDuplicateKey):
 			return nil, grpcDomainAlreadyExistsErr
+		case errors.Is(err, domain.ErrInvalidAutoJoin):
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
 		default:
 			return nil, grpcInternalServerError
 		}
@@ -221,6 +225,7 @@ func (h Handler) ListOrganizationDomains(ctx context.Context, request *frontierv
 			State:     d.State.String(),
 			CreatedAt: timestamppb.New(d.CreatedAt),
 			UpdatedAt: timestamppb.New(d.UpdatedAt),
+			AutoJoin:  transformAutoJoinToPB(d.AutoJoin),
 		})
 	}
 
@@ -236,5 +241,6 @@ func transformDomainToPB(from domain.Domain) frontierv1beta1.Domain {
 		State:     from.State.String(),
 		CreatedAt: timestamppb.New(from.CreatedAt),
 		UpdatedAt: timestamppb.New(from.UpdatedAt),
+		AutoJoin:  transformAutoJoinToPB(from.AutoJoin),
 	}
 }
@@ -0,0 +1,78 @@
+package v1beta1
+
+import (
+	"context"
+	"errors"
+
+	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/raystack/frontier/core/domain"
+	"github.com/raystack/frontier/core/organization"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UpdateOrganizationDomainAutoJoin sets the auto join policy of a domain of the org
+func (h Handler) UpdateOrganizationDomainAutoJoin(ctx context.Context, request *frontierv1beta1.UpdateOrganizationDomainAutoJoinRequest) (*frontierv1beta1.UpdateOrganizationDomainAutoJoinResponse, error) {
+	logger := grpczap.Extract(ctx)
+	orgResp, err := h.orgService.Get(ctx, request.GetOrgId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, organization.ErrDisabled):
+			return nil, grpcOrgDisabledErr
+		case errors.Is(err, organization.ErrNotExist):
+			return nil, grpcOrgNotFoundErr
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	dmn, err := h.domainService.Get(ctx, request.GetId())
+	if err != nil {
+		logger.Error(err.Error())
+		if errors.Is(err, domain.ErrNotExist) {
+			return nil, grpcDomainNotFoundErr
+		}
+		return nil, grpcInternalServerError
+	}
+	if dmn.OrgID != orgResp.ID {
+		return nil, grpcDomainNotFoundErr
+	}
+
+	dmn, err = h.domainService.UpdateAutoJoin(ctx, dmn.ID, autoJoinFromPB(request.GetAutoJoin()))
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, domain.ErrInvalidAutoJoin):
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		case errors.Is(err, domain.ErrNotExist):
+			return nil, grpcDomainNotFoundErr
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+	domainPB := transformDomainToPB(dmn)
+	return &frontierv1beta1.UpdateOrganizationDomainAutoJoinResponse{Domain: &domainPB}, nil
+}
+
+func autoJoinFromPB(from *frontierv1beta1.DomainAutoJoin) domain.AutoJoin {
+	if from == nil {
+		return domain.AutoJoin{}
+	}
+	return domain.AutoJoin{
+		Enabled:    from.GetEnabled(),
+		RoleIDs:    from.GetRoleIds(),
+		GroupIDs:   from.GetGroupIds(),
+		Exclusions: from.GetExclusions(),
+	}
+}
+
+func transformAutoJoinToPB(from domain.AutoJoin) *frontierv1beta1.DomainAutoJoin {
+	return &frontierv1beta1.DomainAutoJoin{
+		Enabled:    from.Enabled,
+		RoleIds:    from.RoleIDs,
+		GroupIds:   from.GroupIDs,
+		Exclusions: from.Exclusions,
+	}
+}
@@ -14,6 +14,8 @@ import (
 	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -48,6 +50,7 @@ var (
 		State:     domain.Verified.String(),
 		CreatedAt: timestamppb.New(time.Time{}),
 		UpdatedAt: timestamppb.New(time.Time{}),
+		AutoJoin:  &frontierv1beta1.DomainAutoJoin{},
 	}
 )
 
@@ -382,6 +385,74 @@ func TestHandler_JoinOrganization(t *testing.T) {
 	}
 }
 
+func TestHandler_UpdateOrganizationDomainAutoJoin(t *testing.T) {
+	autoJoin := domain.AutoJoin{
+		Enabled:    true,
+		GroupIDs:   []string{"group-1", "group-2"},
+		Exclusions: []string{"ceo@raystack.org", "contractors.raystack.org"},
+	}
+	tests := []struct {
+		name    string
+		setup   func(ds *mocks.DomainService)
+		want    *frontierv1beta1.DomainAutoJoin
+		wantErr error
+	}{
+		{
+			name: "should return error when domain belongs to another org",
+			setup: func(ds *mocks.DomainService) {
+				ds.EXPECT().Get(mock.Anything, testDomainID1).Return(domain.Domain{ID: testDomainID1, OrgID: uuid.NewString()}, nil)
+			},
+			wantErr: grpcDomainNotFoundErr,
+		},
+		{
+			name: "should return error when a group of the policy isn't part of the org",
+			setup: func(ds *mocks.DomainService) {
+				ds.EXPECT().Get(mock.Anything, testDomainID1).Return(testDomainMap[testDomainID1], nil)
+				ds.EXPECT().UpdateAutoJoin(mock.Anything, testDomainID1, autoJoin).Return(domain.Domain{}, domain.ErrInvalidAutoJoin)
+			},
+			wantErr: status.Errorf(codes.InvalidArgument, domain.ErrInvalidAutoJoin.Error()),
+		},
+		{
+			name: "should update the auto join policy of the domain",
+			setup: func(ds *mocks.DomainService) {
+				dmn := testDomainMap[testDomainID1]
+				ds.EXPECT().Get(mock.Anything, testDomainID1).Return(dmn, nil)
+				dmn.AutoJoin = autoJoin
+				ds.EXPECT().UpdateAutoJoin(mock.Anything, testDomainID1, autoJoin).Return(dmn, nil)
+			},
+			want: &frontierv1beta1.DomainAutoJoin{
+				Enabled:    true,
+				GroupIds:   []string{"group-1", "group-2"},
+				Exclusions: []string{"ceo@raystack.org", "contractors.raystack.org"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os := &mocks.OrganizationService{}
+			ds := &mocks.DomainService{}
+			os.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
+			tt.setup(ds)
+			h := Handler{
+				orgService:    os,
+				domainService: ds,
+			}
+			got, err := h.UpdateOrganizationDomainAutoJoin(context.Background(), &frontierv1beta1.UpdateOrganizationDomainAutoJoinRequest{
+				OrgId: testOrgID,
+				Id:    testDomainID1,
+				AutoJoin: &frontierv1beta1.DomainAutoJoin{
+					Enabled:    true,
+					GroupIds:   []string{"group-1", "group-2"},
+					Exclusions: []string{"ceo@raystack.org", "contractors.raystack.org"},
+				},
+			})
+			assert.EqualValues(t, tt.wantErr, err)
+			assert.EqualValues(t, tt.want, got.GetDomain().GetAutoJoin())
+		})
+	}
+}
+
 func TestHandler_ListOrganizationDomains(t *testing.T) {
 	tests := []struct {
 		name    string
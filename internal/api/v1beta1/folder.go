@@ -0,0 +1,297 @@
+package v1beta1
+
+import (
+	"context"
+	"errors"
+
+	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/raystack/frontier/core/audit"
+	"github.com/raystack/frontier/core/folder"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/pkg/metadata"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	grpcFolderNotFoundErr = status.Errorf(codes.NotFound, "folder doesn't exist")
+	grpcFolderNotEmptyErr = status.Errorf(codes.FailedPrecondition, "folder still contains projects or folders")
+)
+
+type FolderService interface {
+	Get(ctx context.Context, id string) (folder.Folder, error)
+	List(ctx context.Context, f folder.Filter) ([]folder.Folder, error)
+	Create(ctx context.Context, f folder.Folder) (folder.Folder, error)
+	Update(ctx context.Context, f folder.Folder) (folder.Folder, error)
+	Move(ctx context.Context, id, parentID string) (folder.Folder, error)
+	Delete(ctx context.Context, id string) error
+}
+
+func (h Handler) ListOrganizationFolders(ctx context.Context, request *frontierv1beta1.ListOrganizationFoldersRequest) (*frontierv1beta1.ListOrganizationFoldersResponse, error) {
+	logger := grpczap.Extract(ctx)
+	orgResp, err := h.orgService.Get(ctx, request.GetOrgId())
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcFolderErr(err)
+	}
+
+	folders, err := h.folderService.List(ctx, folder.Filter{
+		OrgID:    orgResp.ID,
+		ParentID: request.GetParentId(),
+		TopLevel: request.GetTopLevel(),
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, folder.ErrInvalidUUID):
+			return nil, grpcBadBodyError
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	var foldersPB []*frontierv1beta1.Folder
+	for _, f := range folders {
+		folderPB, err := transformFolderToPB(f)
+		if err != nil {
+			logger.Error(err.Error())
+			return nil, grpcInternalServerError
+		}
+		foldersPB = append(foldersPB, folderPB)
+	}
+	return &frontierv1beta1.ListOrganizationFoldersResponse{Folders: foldersPB}, nil
+}
+
+func (h Handler) CreateFolder(ctx context.Context, request *frontierv1beta1.CreateFolderRequest) (*frontierv1beta1.CreateFolderResponse, error) {
+	logger := grpczap.Extract(ctx)
+	if request.GetBody() == nil {
+		return nil, grpcBadBodyError
+	}
+	orgResp, err := h.orgService.Get(ctx, request.GetOrgId())
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcFolderErr(err)
+	}
+
+	newFolder, err := h.folderService.Create(ctx, folder.Folder{
+		Name:     request.GetBody().GetName(),
+		Title:    request.GetBody().GetTitle(),
+		OrgID:    orgResp.ID,
+		ParentID: request.GetBody().GetParentId(),
+		Metadata: metadata.Build(request.GetBody().GetMetadata().AsMap()),
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, folder.ErrInvalidDetail),
+			errors.Is(err, folder.ErrInvalidUUID),
+			errors.Is(err, folder.ErrNotExist):
+			return nil, grpcBadBodyError
+		case errors.Is(err, folder.ErrConflict):
+			return nil, grpcConflictError
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	folderPB, err := transformFolderToPB(newFolder)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	audit.GetAuditor(ctx, orgResp.ID).Log(audit.FolderCreatedEvent, audit.FolderTarget(newFolder.ID))
+	return &frontierv1beta1.CreateFolderResponse{Folder: folderPB}, nil
+}
+
+func (h Handler) GetFolder(ctx context.Context, request *frontierv1beta1.GetFolderRequest) (*frontierv1beta1.GetFolderResponse, error) {
+	logger := grpczap.Extract(ctx)
+	fetchedFolder, err := h.getOrgFolder(ctx, request.GetOrgId(), request.GetId())
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcFolderErr(err)
+	}
+
+	folderPB, err := transformFolderToPB(fetchedFolder)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	return &frontierv1beta1.GetFolderResponse{Folder: folderPB}, nil
+}
+
+func (h Handler) UpdateFolder(ctx context.Context, request *frontierv1beta1.UpdateFolderRequest) (*frontierv1beta1.UpdateFolderResponse, error) {
+	logger := grpczap.Extract(ctx)
+	if request.GetBody() == nil {
+		return nil, grpcBadBodyError
+	}
+	existing, err := h.getOrgFolder(ctx, request.GetOrgId(), request.GetId())
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcFolderErr(err)
+	}
+
+	updatedFolder, err := h.folderService.Update(ctx, folder.Folder{
+		ID:       existing.ID,
+		Name:     request.GetBody().GetName(),
+		Title:    request.GetBody().GetTitle(),
+		Metadata: metadata.Build(request.GetBody().GetMetadata().AsMap()),
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, folder.ErrInvalidDetail):
+			return nil, grpcBadBodyError
+		case errors.Is(err, folder.ErrConflict):
+			return nil, grpcConflictError
+		default:
+			return nil, grpcFolderErr(err)
+		}
+	}
+
+	folderPB, err := transformFolderToPB(updatedFolder)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	audit.GetAuditor(ctx, existing.OrgID).Log(audit.FolderUpdatedEvent, audit.FolderTarget(updatedFolder.ID))
+	return &frontierv1beta1.UpdateFolderResponse{Folder: folderPB}, nil
+}
+
+func (h Handler) MoveFolder(ctx context.Context, request *frontierv1beta1.MoveFolderRequest) (*frontierv1beta1.MoveFolderResponse, error) {
+	logger := grpczap.Extract(ctx)
+	existing, err := h.getOrgFolder(ctx, request.GetOrgId(), request.GetId())
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcFolderErr(err)
+	}
+
+	movedFolder, err := h.folderService.Move(ctx, existing.ID, request.GetParentId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, folder.ErrCyclicParent):
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		case errors.Is(err, folder.ErrInvalidDetail),
+			errors.Is(err, folder.ErrInvalidUUID),
+			errors.Is(err, folder.ErrNotExist):
+			// the folder was checked above, so a missing one is the new parent
+			return nil, grpcBadBodyError
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	folderPB, err := transformFolderToPB(movedFolder)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	audit.GetAuditor(ctx, existing.OrgID).LogWithAttrs(audit.FolderMovedEvent, audit.FolderTarget(movedFolder.ID),
+		map[string]string{
+			"parent_id": movedFolder.ParentID,
+		})
+	return &frontierv1beta1.MoveFolderResponse{Folder: folderPB}, nil
+}
+
+func (h Handler) DeleteFolder(ctx context.Context, request *frontierv1beta1.DeleteFolderRequest) (*frontierv1beta1.DeleteFolderResponse, error) {
+	logger := grpczap.Extract(ctx)
+	existing, err := h.getOrgFolder(ctx, request.GetOrgId(), request.GetId())
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcFolderErr(err)
+	}
+
+	if err := h.folderService.Delete(ctx, existing.ID); err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, folder.ErrNotEmpty):
+			return nil, grpcFolderNotEmptyErr
+		default:
+			return nil, grpcFolderErr(err)
+		}
+	}
+
+	audit.GetAuditor(ctx, existing.OrgID).Log(audit.FolderDeletedEvent, audit.FolderTarget(existing.ID))
+	return &frontierv1beta1.DeleteFolderResponse{}, nil
+}
+
+func (h Handler) ListFolderProjects(ctx context.Context, request *frontierv1beta1.ListFolderProjectsRequest) (*frontierv1beta1.ListFolderProjectsResponse, error) {
+	logger := grpczap.Extract(ctx)
+	existing, err := h.getOrgFolder(ctx, request.GetOrgId(), request.GetId())
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcFolderErr(err)
+	}
+
+	projects, err := h.projectService.List(ctx, project.Filter{
+		OrgID:    existing.OrgID,
+		FolderID: existing.ID,
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+
+	var projectsPB []*frontierv1beta1.Project
+	for _, prj := range projects {
+		projectPB, err := transformProjectToPB(prj)
+		if err != nil {
+			logger.Error(err.Error())
+			return nil, grpcInternalServerError
+		}
+		projectsPB = append(projectsPB, projectPB)
+	}
+	return &frontierv1beta1.ListFolderProjectsResponse{Projects: projectsPB}, nil
+}
+
+// getOrgFolder fetches the folder only if it belongs to the organization
+func (h Handler) getOrgFolder(ctx context.Context, orgID, id string) (folder.Folder, error) {
+	orgResp, err := h.orgService.Get(ctx, orgID)
+	if err != nil {
+		return folder.Folder{}, err
+	}
+	fetchedFolder, err := h.folderService.Get(ctx, id)
+	if err != nil {
+		return folder.Folder{}, err
+	}
+	if fetchedFolder.OrgID != orgResp.ID {
+		return folder.Folder{}, folder.ErrNotExist
+	}
+	return fetchedFolder, nil
+}
+
+func grpcFolderErr(err error) error {
+	switch {
+	case errors.Is(err, organization.ErrDisabled):
+		return grpcOrgDisabledErr
+	case errors.Is(err, organization.ErrNotExist):
+		return grpcOrgNotFoundErr
+	case errors.Is(err, folder.ErrNotExist),
+		errors.Is(err, folder.ErrInvalidUUID),
+		errors.Is(err, folder.ErrInvalidID):
+		return grpcFolderNotFoundErr
+	default:
+		return grpcInternalServerError
+	}
+}
+
+func transformFolderToPB(f folder.Folder) (*frontierv1beta1.Folder, error) {
+	metaData, err := f.Metadata.ToStructPB()
+	if err != nil {
+		return nil, err
+	}
+
+	return &frontierv1beta1.Folder{
+		Id:        f.ID,
+		Name:      f.Name,
+		Title:     f.Title,
+		OrgId:     f.OrgID,
+		ParentId:  f.ParentID,
+		Metadata:  metaData,
+		CreatedAt: timestamppb.New(f.CreatedAt),
+		UpdatedAt: timestamppb.New(f.UpdatedAt),
+	}, nil
+}
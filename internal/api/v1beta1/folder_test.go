@@ -0,0 +1,333 @@
+package v1beta1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/raystack/frontier/core/folder"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/internal/api/v1beta1/mocks"
+	"github.com/raystack/frontier/pkg/metadata"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	testFolderID       = "b1c1bb3e-0fd0-4bb8-9d8c-3b4b2b5a0f11"
+	testParentFolderID = "3cfd5e53-59cf-4e0f-9c1e-2c0a9c3b1d22"
+	testFolder         = folder.Folder{
+		ID:       testFolderID,
+		Name:     "finance",
+		Title:    "Finance",
+		OrgID:    testOrgID,
+		Metadata: metadata.Metadata{},
+	}
+	testFolderPB = &frontierv1beta1.Folder{
+		Id:        testFolderID,
+		Name:      "finance",
+		Title:     "Finance",
+		OrgId:     testOrgID,
+		Metadata:  &structpb.Struct{Fields: map[string]*structpb.Value{}},
+		CreatedAt: timestamppb.New(time.Time{}),
+		UpdatedAt: timestamppb.New(time.Time{}),
+	}
+)
+
+func TestHandler_CreateFolder(t *testing.T) {
+	table := []struct {
+		title string
+		setup func(os *mocks.OrganizationService, fs *mocks.FolderService)
+		req   *frontierv1beta1.CreateFolderRequest
+		want  *frontierv1beta1.CreateFolderResponse
+		err   error
+	}{
+		{
+			title: "should return bad body error if body is missing",
+			req:   &frontierv1beta1.CreateFolderRequest{OrgId: testOrgID},
+			err:   grpcBadBodyError,
+		},
+		{
+			title: "should return not found error if org doesn't exist",
+			setup: func(os *mocks.OrganizationService, fs *mocks.FolderService) {
+				os.EXPECT().Get(mock.Anything, testOrgID).Return(organization.Organization{}, organization.ErrNotExist)
+			},
+			req: &frontierv1beta1.CreateFolderRequest{OrgId: testOrgID, Body: &frontierv1beta1.FolderRequestBody{Name: "finance"}},
+			err: grpcOrgNotFoundErr,
+		},
+		{
+			title: "should return bad body error if parent folder doesn't exist",
+			setup: func(os *mocks.OrganizationService, fs *mocks.FolderService) {
+				os.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
+				fs.EXPECT().Create(mock.Anything, folder.Folder{
+					Name:     "finance",
+					OrgID:    testOrgID,
+					ParentID: testParentFolderID,
+					Metadata: metadata.Metadata{},
+				}).Return(folder.Folder{}, folder.ErrNotExist)
+			},
+			req: &frontierv1beta1.CreateFolderRequest{OrgId: testOrgID, Body: &frontierv1beta1.FolderRequestBody{
+				Name:     "finance",
+				ParentId: testParentFolderID,
+			}},
+			err: grpcBadBodyError,
+		},
+		{
+			title: "should return conflict error if folder name already exists",
+			setup: func(os *mocks.OrganizationService, fs *mocks.FolderService) {
+				os.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
+				fs.EXPECT().Create(mock.Anything, folder.Folder{
+					Name:     "finance",
+					OrgID:    testOrgID,
+					Metadata: metadata.Metadata{},
+				}).Return(folder.Folder{}, folder.ErrConflict)
+			},
+			req: &frontierv1beta1.CreateFolderRequest{OrgId: testOrgID, Body: &frontierv1beta1.FolderRequestBody{Name: "finance"}},
+			err: grpcConflictError,
+		},
+		{
+			title: "should create folder under the org",
+			setup: func(os *mocks.OrganizationService, fs *mocks.FolderService) {
+				os.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
+				fs.EXPECT().Create(mock.Anything, folder.Folder{
+					Name:     "finance",
+					Title:    "Finance",
+					OrgID:    testOrgID,
+					Metadata: metadata.Metadata{},
+				}).Return(testFolder, nil)
+			},
+			req: &frontierv1beta1.CreateFolderRequest{OrgId: testOrgID, Body: &frontierv1beta1.FolderRequestBody{
+				Name:  "finance",
+				Title: "Finance",
+			}},
+			want: &frontierv1beta1.CreateFolderResponse{Folder: testFolderPB},
+		},
+	}
+	for _, tt := range table {
+		t.Run(tt.title, func(t *testing.T) {
+			mockOrgService := mocks.NewOrganizationService(t)
+			mockFolderService := mocks.NewFolderService(t)
+			if tt.setup != nil {
+				tt.setup(mockOrgService, mockFolderService)
+			}
+			h := Handler{orgService: mockOrgService, folderService: mockFolderService}
+			got, err := h.CreateFolder(context.Background(), tt.req)
+			assert.EqualValues(t, tt.want, got)
+			assert.EqualValues(t, tt.err, err)
+		})
+	}
+}
+
+func TestHandler_GetFolder(t *testing.T) {
+	otherOrgID := "4d726cf5-52f6-46f1-9c87-1a79f29e3abf"
+	table := []struct {
+		title string
+		setup func(os *mocks.OrganizationService, fs *mocks.FolderService)
+		req   *frontierv1beta1.GetFolderRequest
+		want  *frontierv1beta1.GetFolderResponse
+		err   error
+	}{
+		{
+			title: "should return not found error if folder doesn't exist",
+			setup: func(os *mocks.OrganizationService, fs *mocks.FolderService) {
+				os.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
+				fs.EXPECT().Get(mock.Anything, testFolderID).Return(folder.Folder{}, folder.ErrNotExist)
+			},
+			req: &frontierv1beta1.GetFolderRequest{OrgId: testOrgID, Id: testFolderID},
+			err: grpcFolderNotFoundErr,
+		},
+		{
+			title: "should return not found error if folder belongs to another org",
+			setup: func(os *mocks.OrganizationService, fs *mocks.FolderService) {
+				os.EXPECT().Get(mock.Anything, otherOrgID).Return(organization.Organization{ID: otherOrgID}, nil)
+				fs.EXPECT().Get(mock.Anything, testFolderID).Return(testFolder, nil)
+			},
+			req: &frontierv1beta1.GetFolderRequest{OrgId: otherOrgID, Id: testFolderID},
+			err: grpcFolderNotFoundErr,
+		},
+		{
+			title: "should return folder",
+			setup: func(os *mocks.OrganizationService, fs *mocks.FolderService) {
+				os.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
+				fs.EXPECT().Get(mock.Anything, testFolderID).Return(testFolder, nil)
+			},
+			req:  &frontierv1beta1.GetFolderRequest{OrgId: testOrgID, Id: testFolderID},
+			want: &frontierv1beta1.GetFolderResponse{Folder: testFolderPB},
+		},
+	}
+	for _, tt := range table {
+		t.Run(tt.title, func(t *testing.T) {
+			mockOrgService := mocks.NewOrganizationService(t)
+			mockFolderService := mocks.NewFolderService(t)
+			if tt.setup != nil {
+				tt.setup(mockOrgService, mockFolderService)
+			}
+			h := Handler{orgService: mockOrgService, folderService: mockFolderService}
+			got, err := h.GetFolder(context.Background(), tt.req)
+			assert.EqualValues(t, tt.want, got)
+			assert.EqualValues(t, tt.err, err)
+		})
+	}
+}
+
+func TestHandler_MoveFolder(t *testing.T) {
+	table := []struct {
+		title string
+		setup func(os *mocks.OrganizationService, fs *mocks.FolderService)
+		req   *frontierv1beta1.MoveFolderRequest
+		want  *frontierv1beta1.MoveFolderResponse
+		err   error
+	}{
+		{
+			title: "should return bad body error if new parent doesn't exist",
+			setup: func(os *mocks.OrganizationService, fs *mocks.FolderService) {
+				os.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
+				fs.EXPECT().Get(mock.Anything, testFolderID).Return(testFolder, nil)
+				fs.EXPECT().Move(mock.Anything, testFolderID, testParentFolderID).Return(folder.Folder{}, folder.ErrNotExist)
+			},
+			req: &frontierv1beta1.MoveFolderRequest{OrgId: testOrgID, Id: testFolderID, ParentId: testParentFolderID},
+			err: grpcBadBodyError,
+		},
+		{
+			title: "should move folder under the new parent",
+			setup: func(os *mocks.OrganizationService, fs *mocks.FolderService) {
+				os.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
+				fs.EXPECT().Get(mock.Anything, testFolderID).Return(testFolder, nil)
+				moved := testFolder
+				moved.ParentID = testParentFolderID
+				fs.EXPECT().Move(mock.Anything, testFolderID, testParentFolderID).Return(moved, nil)
+			},
+			req: &frontierv1beta1.MoveFolderRequest{OrgId: testOrgID, Id: testFolderID, ParentId: testParentFolderID},
+			want: &frontierv1beta1.MoveFolderResponse{Folder: &frontierv1beta1.Folder{
+				Id:        testFolderID,
+				Name:      "finance",
+				Title:     "Finance",
+				OrgId:     testOrgID,
+				ParentId:  testParentFolderID,
+				Metadata:  &structpb.Struct{Fields: map[string]*structpb.Value{}},
+				CreatedAt: timestamppb.New(time.Time{}),
+				UpdatedAt: timestamppb.New(time.Time{}),
+			}},
+		},
+	}
+	for _, tt := range table {
+		t.Run(tt.title, func(t *testing.T) {
+			mockOrgService := mocks.NewOrganizationService(t)
+			mockFolderService := mocks.NewFolderService(t)
+			if tt.setup != nil {
+				tt.setup(mockOrgService, mockFolderService)
+			}
+			h := Handler{orgService: mockOrgService, folderService: mockFolderService}
+			got, err := h.MoveFolder(context.Background(), tt.req)
+			assert.EqualValues(t, tt.want, got)
+			assert.EqualValues(t, tt.err, err)
+		})
+	}
+}
+
+func TestHandler_DeleteFolder(t *testing.T) {
+	table := []struct {
+		title string
+		setup func(os *mocks.OrganizationService, fs *mocks.FolderService)
+		req   *frontierv1beta1.DeleteFolderRequest
+		want  *frontierv1beta1.DeleteFolderResponse
+		err   error
+	}{
+		{
+			title: "should return failed precondition error if folder is not empty",
+			setup: func(os *mocks.OrganizationService, fs *mocks.FolderService) {
+				os.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
+				fs.EXPECT().Get(mock.Anything, testFolderID).Return(testFolder, nil)
+				fs.EXPECT().Delete(mock.Anything, testFolderID).Return(folder.ErrNotEmpty)
+			},
+			req: &frontierv1beta1.DeleteFolderRequest{OrgId: testOrgID, Id: testFolderID},
+			err: grpcFolderNotEmptyErr,
+		},
+		{
+			title: "should delete an empty folder",
+			setup: func(os *mocks.OrganizationService, fs *mocks.FolderService) {
+				os.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
+				fs.EXPECT().Get(mock.Anything, testFolderID).Return(testFolder, nil)
+				fs.EXPECT().Delete(mock.Anything, testFolderID).Return(nil)
+			},
+			req:  &frontierv1beta1.DeleteFolderRequest{OrgId: testOrgID, Id: testFolderID},
+			want: &frontierv1beta1.DeleteFolderResponse{},
+		},
+	}
+	for _, tt := range table {
+		t.Run(tt.title, func(t *testing.T) {
+			mockOrgService := mocks.NewOrganizationService(t)
+			mockFolderService := mocks.NewFolderService(t)
+			if tt.setup != nil {
+				tt.setup(mockOrgService, mockFolderService)
+			}
+			h := Handler{orgService: mockOrgService, folderService: mockFolderService}
+			got, err := h.DeleteFolder(context.Background(), tt.req)
+			assert.EqualValues(t, tt.want, got)
+			assert.EqualValues(t, tt.err, err)
+		})
+	}
+}
+
+func TestHandler_MoveProject(t *testing.T) {
+	table := []struct {
+		title string
+		setup func(ps *mocks.ProjectService)
+		req   *frontierv1beta1.MoveProjectRequest
+		want  *frontierv1beta1.MoveProjectResponse
+		err   error
+	}{
+		{
+			title: "should return not found error if project doesn't exist",
+			setup: func(ps *mocks.ProjectService) {
+				ps.EXPECT().Move(mock.Anything, testProjectID, testFolderID).Return(project.Project{}, project.ErrNotExist)
+			},
+			req: &frontierv1beta1.MoveProjectRequest{Id: testProjectID, FolderId: testFolderID},
+			err: grpcProjectNotFoundErr,
+		},
+		{
+			title: "should return bad body error if folder belongs to another org",
+			setup: func(ps *mocks.ProjectService) {
+				ps.EXPECT().Move(mock.Anything, testProjectID, testFolderID).Return(project.Project{}, project.ErrInvalidDetail)
+			},
+			req: &frontierv1beta1.MoveProjectRequest{Id: testProjectID, FolderId: testFolderID},
+			err: grpcBadBodyError,
+		},
+		{
+			title: "should move project into the folder",
+			setup: func(ps *mocks.ProjectService) {
+				moved := testProjectMap[testProjectID]
+				moved.FolderID = testFolderID
+				ps.EXPECT().Move(mock.Anything, testProjectID, testFolderID).Return(moved, nil)
+			},
+			req: &frontierv1beta1.MoveProjectRequest{Id: testProjectID, FolderId: testFolderID},
+			want: &frontierv1beta1.MoveProjectResponse{Project: &frontierv1beta1.Project{
+				Id:       testProjectID,
+				Name:     "prj-1",
+				OrgId:    testOrgID,
+				FolderId: testFolderID,
+				Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"email": structpb.NewStringValue("org1@org1.com"),
+				}},
+				CreatedAt: timestamppb.New(time.Time{}),
+				UpdatedAt: timestamppb.New(time.Time{}),
+			}},
+		},
+	}
+	for _, tt := range table {
+		t.Run(tt.title, func(t *testing.T) {
+			mockProjectService := mocks.NewProjectService(t)
+			if tt.setup != nil {
+				tt.setup(mockProjectService)
+			}
+			h := Handler{projectService: mockProjectService}
+			got, err := h.MoveProject(context.Background(), tt.req)
+			assert.EqualValues(t, tt.want, got)
+			assert.EqualValues(t, tt.err, err)
+		})
+	}
+}
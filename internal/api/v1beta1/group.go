@@ -2,12 +2,15 @@ package v1beta1
 
 import (
 	"context"
+	"strings"
 
 	"github.com/raystack/frontier/core/role"
 	"github.com/raystack/frontier/pkg/utils"
 	"go.uber.org/zap"
 
 	"github.com/raystack/frontier/core/audit"
+	"github.com/raystack/frontier/core/job"
+	"github.com/raystack/frontier/core/membership"
 	"github.com/raystack/frontier/internal/bootstrap/schema"
 
 	"github.com/raystack/frontier/pkg/str"
@@ -19,6 +22,7 @@ import (
 
 	"github.com/raystack/frontier/core/group"
 	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/policy"
 	"github.com/raystack/frontier/core/user"
 
 	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
@@ -39,6 +43,7 @@ type GroupService interface {
 	Enable(ctx context.Context, id string) error
 	Disable(ctx context.Context, id string) error
 	Delete(ctx context.Context, id string) error
+	CheckPolicyDelete(ctx context.Context, pol policy.Policy) error
 }
 
 var (
@@ -50,13 +55,18 @@ func (h Handler) ListGroups(ctx context.Context, request *frontierv1beta1.ListGr
 	logger := grpczap.Extract(ctx)
 
 	var groups []*frontierv1beta1.Group
+	page, err := paginationFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
 	groupList, err := h.groupService.List(ctx, group.Filter{
 		OrganizationID: request.GetOrgId(),
 		State:          group.State(request.GetState()),
+		Pagination:     page,
 	})
 	if err != nil {
 		logger.Error(err.Error())
-		return nil, grpcInternalServerError
+		return nil, grpcListErr(err)
 	}
 
 	for _, v := range groupList {
@@ -69,7 +79,10 @@ func (h Handler) ListGroups(ctx context.Context, request *frontierv1beta1.ListGr
 		groups = append(groups, &groupPB)
 	}
 
-	return &frontierv1beta1.ListGroupsResponse{Groups: groups}, nil
+	return &frontierv1beta1.ListGroupsResponse{
+		Groups:        groups,
+		NextPageToken: page.NextPageToken(),
+	}, nil
 }
 
 func (h Handler) ListOrganizationGroups(ctx context.Context, request *frontierv1beta1.ListOrganizationGroupsRequest) (*frontierv1beta1.ListOrganizationGroupsResponse, error) {
@@ -88,13 +101,18 @@ func (h Handler) ListOrganizationGroups(ctx context.Context, request *frontierv1
 	}
 
 	var groups []*frontierv1beta1.Group
+	page, err := paginationFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
 	groupList, err := h.groupService.List(ctx, group.Filter{
 		OrganizationID: orgResp.ID,
 		State:          group.State(request.GetState()),
+		Pagination:     page,
 	})
 	if err != nil {
 		logger.Error(err.Error())
-		return nil, grpcInternalServerError
+		return nil, grpcListErr(err)
 	}
 
 	for _, v := range groupList {
@@ -107,7 +125,10 @@ func (h Handler) ListOrganizationGroups(ctx context.Context, request *frontierv1
 		groups = append(groups, &groupPB)
 	}
 
-	return &frontierv1beta1.ListOrganizationGroupsResponse{Groups: groups}, nil
+	return &frontierv1beta1.ListOrganizationGroupsResponse{
+		Groups:        groups,
+		NextPageToken: page.NextPageToken(),
+	}, nil
 }
 
 func (h Handler) CreateGroup(ctx context.Context, request *frontierv1beta1.CreateGroupRequest) (*frontierv1beta1.CreateGroupResponse, error) {
@@ -349,6 +370,16 @@ func (h Handler) AddGroupUsers(ctx context.Context, request *frontierv1beta1.Add
 		}
 	}
 
+	if request.GetAsync() {
+		jobID, err := h.submitBulkJob(ctx, func(createdBy string) job.Job {
+			return membership.NewAddGroupUsersJob(request.GetId(), request.GetUserIds(), request.GetCreateMissingUsers(), createdBy)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &frontierv1beta1.AddGroupUsersResponse{JobId: jobID}, nil
+	}
+
 	if err := h.groupService.AddUsers(ctx, request.GetId(), request.GetUserIds()); err != nil {
 		logger.Error(err.Error())
 		return nil, grpcInternalServerError
@@ -371,6 +402,18 @@ func (h Handler) RemoveGroupUser(ctx context.Context, request *frontierv1beta1.R
 		}
 	}
 
+	// user_id of an async request is a comma separated list of user ids or emails,
+	// owners are checked for each user when the job runs
+	if request.GetAsync() {
+		jobID, err := h.submitBulkJob(ctx, func(createdBy string) job.Job {
+			return membership.NewRemoveGroupUsersJob(request.GetId(), strings.Split(request.GetUserId(), ","), createdBy)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &frontierv1beta1.RemoveGroupUserResponse{JobId: jobID}, nil
+	}
+
 	// before deleting the user, check if the user is the only owner of the group
 	owners, err := h.userService.ListByGroup(ctx, request.GetId(), schema.DeletePermission)
 	if err != nil {
@@ -384,6 +427,9 @@ func (h Handler) RemoveGroupUser(ctx context.Context, request *frontierv1beta1.R
 	// delete the user
 	if err := h.groupService.RemoveUsers(ctx, request.GetId(), []string{request.GetUserId()}); err != nil {
 		logger.Error(err.Error())
+		if errors.Is(err, group.ErrLastOwner) {
+			return nil, grpcMinOwnerCounrErr
+		}
 		return nil, grpcInternalServerError
 	}
 	return &frontierv1beta1.RemoveGroupUserResponse{}, nil
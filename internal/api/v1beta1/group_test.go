@@ -66,6 +66,7 @@ func TestHandler_ListGroups(t *testing.T) {
 			name: "should return empty groups if query param org_id is not uuid",
 			setup: func(gs *mocks.GroupService) {
 				gs.EXPECT().List(mock.Anything, group.Filter{
+					Pagination:     defaultPage(),
 					OrganizationID: "some-id",
 				}).Return([]group.Group{}, nil)
 			},
@@ -81,6 +82,7 @@ func TestHandler_ListGroups(t *testing.T) {
 			name: "should return empty groups if query param org_id is not exist",
 			setup: func(gs *mocks.GroupService) {
 				gs.EXPECT().List(mock.Anything, group.Filter{
+					Pagination:     defaultPage(),
 					OrganizationID: randomID,
 				}).Return([]group.Group{}, nil)
 			},
@@ -99,7 +101,7 @@ func TestHandler_ListGroups(t *testing.T) {
 				for _, u := range testGroupMap {
 					testGroupList = append(testGroupList, u)
 				}
-				gs.EXPECT().List(mock.Anything, group.Filter{}).Return(testGroupList, nil)
+				gs.EXPECT().List(mock.Anything, group.Filter{Pagination: defaultPage()}).Return(testGroupList, nil)
 			},
 			request: &frontierv1beta1.ListGroupsRequest{},
 			want: &frontierv1beta1.ListGroupsResponse{
@@ -128,6 +130,7 @@ func TestHandler_ListGroups(t *testing.T) {
 					testGroupList = append(testGroupList, u)
 				}
 				gs.EXPECT().List(mock.Anything, group.Filter{
+					Pagination:     defaultPage(),
 					OrganizationID: "9f256f86-31a3-11ec-8d3d-0242ac130003",
 				}).Return(testGroupList, nil)
 			},
@@ -156,6 +159,7 @@ func TestHandler_ListGroups(t *testing.T) {
 			name: "should return an error if Group service return some error ",
 			setup: func(gs *mocks.GroupService) {
 				gs.EXPECT().List(mock.Anything, group.Filter{
+					Pagination:     defaultPage(),
 					OrganizationID: "9f256f86-31a3-11ec-8d3d-0242ac130003",
 				}).Return(nil, errors.New("test-error"))
 			},
@@ -169,6 +173,7 @@ func TestHandler_ListGroups(t *testing.T) {
 			name: "should return error while traversing group list if key is integer type",
 			setup: func(gs *mocks.GroupService) {
 				gs.EXPECT().List(mock.Anything, group.Filter{
+					Pagination:     defaultPage(),
 					OrganizationID: "some-id",
 				}).Return([]group.Group{
 					{
@@ -1047,6 +1052,7 @@ func TestHandler_ListOrganizationGroups(t *testing.T) {
 			setup: func(gs *mocks.GroupService, os *mocks.OrganizationService) {
 				os.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
 				gs.EXPECT().List(mock.Anything, group.Filter{
+					Pagination:     defaultPage(),
 					OrganizationID: testOrgID,
 				}).Return([]group.Group{}, nil)
 			},
@@ -1067,6 +1073,7 @@ func TestHandler_ListOrganizationGroups(t *testing.T) {
 					testGroupList = append(testGroupList, u)
 				}
 				gs.EXPECT().List(mock.Anything, group.Filter{
+					Pagination:     defaultPage(),
 					OrganizationID: testOrgID,
 				}).Return(testGroupList, nil)
 			},
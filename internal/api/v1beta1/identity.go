@@ -0,0 +1,142 @@
+package v1beta1
+
+import (
+	"context"
+	"errors"
+
+	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/raystack/frontier/core/audit"
+	"github.com/raystack/frontier/core/identity"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type IdentityService interface {
+	List(ctx context.Context, userID string) ([]identity.Identity, error)
+	Unlink(ctx context.Context, userID, id string) error
+	ListEmails(ctx context.Context, userID string) ([]identity.Email, error)
+	RemoveEmail(ctx context.Context, userID, email string) error
+}
+
+var (
+	grpcIdentityNotFoundErr = status.Errorf(codes.NotFound, identity.ErrNotExist.Error())
+	grpcEmailNotFoundErr    = status.Errorf(codes.NotFound, identity.ErrEmailNotExist.Error())
+)
+
+func (h Handler) ListCurrentUserIdentities(ctx context.Context, request *frontierv1beta1.ListCurrentUserIdentitiesRequest) (*frontierv1beta1.ListCurrentUserIdentitiesResponse, error) {
+	logger := grpczap.Extract(ctx)
+	principal, err := h.GetLoggedInPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := h.identityService.List(ctx, principal.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	var identitiesPB []*frontierv1beta1.UserIdentity
+	for _, ident := range identities {
+		identitiesPB = append(identitiesPB, transformIdentityToPB(ident))
+	}
+	return &frontierv1beta1.ListCurrentUserIdentitiesResponse{Identities: identitiesPB}, nil
+}
+
+func (h Handler) UnlinkCurrentUserIdentity(ctx context.Context, request *frontierv1beta1.UnlinkCurrentUserIdentityRequest) (*frontierv1beta1.UnlinkCurrentUserIdentityResponse, error) {
+	logger := grpczap.Extract(ctx)
+	principal, err := h.accountOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.identityService.Unlink(ctx, principal.ID, request.GetId()); err != nil {
+		logger.Error(err.Error())
+		if errors.Is(err, identity.ErrNotExist) {
+			return nil, grpcIdentityNotFoundErr
+		}
+		return nil, grpcInternalServerError
+	}
+	audit.GetAuditor(ctx, schema.PlatformOrgID.String()).LogWithAttrs(audit.UserIdentityUnlinkedEvent, audit.UserTarget(principal.ID), map[string]string{
+		"identity": request.GetId(),
+	})
+	return &frontierv1beta1.UnlinkCurrentUserIdentityResponse{}, nil
+}
+
+// AddCurrentUserEmail mails an otp to an email to add as a secondary email of the
+// current user, the email is added once the otp is verified with VerifyCurrentUserEmail
+func (h Handler) AddCurrentUserEmail(ctx context.Context, request *frontierv1beta1.AddCurrentUserEmailRequest) (*frontierv1beta1.AddCurrentUserEmailResponse, error) {
+	logger := grpczap.Extract(ctx)
+	principal, err := h.accountOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if principal.User == nil {
+		return nil, grpcUserNotFoundError
+	}
+
+	flow, err := h.authnService.StartAddEmail(ctx, principal.ID, request.GetEmail())
+	if err != nil {
+		logger.Error(err.Error())
+		if errors.Is(err, user.ErrConflict) {
+			return nil, grpcConflictError
+		}
+		return nil, grpcInternalServerError
+	}
+	return &frontierv1beta1.AddCurrentUserEmailResponse{State: flow.ID.String()}, nil
+}
+
+func (h Handler) ListCurrentUserEmails(ctx context.Context, request *frontierv1beta1.ListCurrentUserEmailsRequest) (*frontierv1beta1.ListCurrentUserEmailsResponse, error) {
+	logger := grpczap.Extract(ctx)
+	principal, err := h.GetLoggedInPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	emails, err := h.identityService.ListEmails(ctx, principal.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	var emailsPB []*frontierv1beta1.UserEmail
+	for _, email := range emails {
+		emailsPB = append(emailsPB, &frontierv1beta1.UserEmail{
+			Email:     email.Email,
+			CreatedAt: timestamppb.New(email.CreatedAt),
+		})
+	}
+	return &frontierv1beta1.ListCurrentUserEmailsResponse{Emails: emailsPB}, nil
+}
+
+func (h Handler) RemoveCurrentUserEmail(ctx context.Context, request *frontierv1beta1.RemoveCurrentUserEmailRequest) (*frontierv1beta1.RemoveCurrentUserEmailResponse, error) {
+	logger := grpczap.Extract(ctx)
+	principal, err := h.accountOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.identityService.RemoveEmail(ctx, principal.ID, request.GetEmail()); err != nil {
+		logger.Error(err.Error())
+		if errors.Is(err, identity.ErrEmailNotExist) {
+			return nil, grpcEmailNotFoundErr
+		}
+		return nil, grpcInternalServerError
+	}
+	audit.GetAuditor(ctx, schema.PlatformOrgID.String()).LogWithAttrs(audit.UserEmailRemovedEvent, audit.UserTarget(principal.ID), map[string]string{
+		"email": request.GetEmail(),
+	})
+	return &frontierv1beta1.RemoveCurrentUserEmailResponse{}, nil
+}
+
+func transformIdentityToPB(ident identity.Identity) *frontierv1beta1.UserIdentity {
+	return &frontierv1beta1.UserIdentity{
+		Id:        ident.ID,
+		Provider:  ident.Provider,
+		Email:     ident.Email,
+		CreatedAt: timestamppb.New(ident.CreatedAt),
+		UpdatedAt: timestamppb.New(ident.UpdatedAt),
+	}
+}
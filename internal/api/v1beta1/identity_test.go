@@ -0,0 +1,211 @@
+package v1beta1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/identity"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/api/v1beta1/mocks"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_ListCurrentUserIdentities(t *testing.T) {
+	currentUser := user.User{
+		ID:    uuid.New().String(),
+		Email: "jane@acme.org",
+		Title: "jane",
+	}
+	identityID := uuid.New().String()
+	tests := []struct {
+		name          string
+		setup         func(as *mocks.AuthnService, is *mocks.IdentityService)
+		wantErr       error
+		wantProviders []string
+	}{
+		{
+			name: "should return linked identities of the user",
+			setup: func(as *mocks.AuthnService, is *mocks.IdentityService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: currentUser.ID, User: &currentUser}, nil)
+				is.EXPECT().List(mock.Anything, currentUser.ID).Return([]identity.Identity{
+					{ID: identityID, UserID: currentUser.ID, Provider: "google", Subject: "1234", Email: "jane@gmail.com"},
+				}, nil)
+			},
+			wantProviders: []string{"google"},
+		},
+		{
+			name: "should return internal error if identities can't be listed",
+			setup: func(as *mocks.AuthnService, is *mocks.IdentityService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: currentUser.ID, User: &currentUser}, nil)
+				is.EXPECT().List(mock.Anything, currentUser.ID).Return(nil, assert.AnError)
+			},
+			wantErr: grpcInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAuthnSvc := new(mocks.AuthnService)
+			mockIdentitySvc := new(mocks.IdentityService)
+			if tt.setup != nil {
+				tt.setup(mockAuthnSvc, mockIdentitySvc)
+			}
+			h := Handler{
+				authnService:    mockAuthnSvc,
+				identityService: mockIdentitySvc,
+			}
+
+			got, err := h.ListCurrentUserIdentities(context.Background(), &frontierv1beta1.ListCurrentUserIdentitiesRequest{})
+			assert.EqualValues(t, tt.wantErr, err)
+			var providers []string
+			for _, ident := range got.GetIdentities() {
+				providers = append(providers, ident.GetProvider())
+			}
+			assert.Equal(t, tt.wantProviders, providers)
+			mockIdentitySvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_UnlinkCurrentUserIdentity(t *testing.T) {
+	currentUser := user.User{
+		ID:    uuid.New().String(),
+		Email: "jane@acme.org",
+	}
+	identityID := uuid.New().String()
+	tests := []struct {
+		name    string
+		setup   func(as *mocks.AuthnService, is *mocks.IdentityService)
+		wantErr error
+	}{
+		{
+			name: "should unlink the identity of the user",
+			setup: func(as *mocks.AuthnService, is *mocks.IdentityService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: currentUser.ID, User: &currentUser}, nil)
+				is.EXPECT().Unlink(mock.Anything, currentUser.ID, identityID).Return(nil)
+			},
+		},
+		{
+			name: "should return not found if identity isn't linked to the user",
+			setup: func(as *mocks.AuthnService, is *mocks.IdentityService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: currentUser.ID, User: &currentUser}, nil)
+				is.EXPECT().Unlink(mock.Anything, currentUser.ID, identityID).Return(identity.ErrNotExist)
+			},
+			wantErr: grpcIdentityNotFoundErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAuthnSvc := new(mocks.AuthnService)
+			mockIdentitySvc := new(mocks.IdentityService)
+			if tt.setup != nil {
+				tt.setup(mockAuthnSvc, mockIdentitySvc)
+			}
+			h := Handler{
+				authnService:    mockAuthnSvc,
+				identityService: mockIdentitySvc,
+			}
+
+			_, err := h.UnlinkCurrentUserIdentity(context.Background(), &frontierv1beta1.UnlinkCurrentUserIdentityRequest{Id: identityID})
+			assert.EqualValues(t, tt.wantErr, err)
+			mockIdentitySvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_AddCurrentUserEmail(t *testing.T) {
+	currentUser := user.User{
+		ID:    uuid.New().String(),
+		Email: "jane@acme.org",
+	}
+	flowID := uuid.New()
+	tests := []struct {
+		name    string
+		setup   func(as *mocks.AuthnService)
+		want    *frontierv1beta1.AddCurrentUserEmailResponse
+		wantErr error
+	}{
+		{
+			name: "should mail an otp to verify the secondary email",
+			setup: func(as *mocks.AuthnService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: currentUser.ID, User: &currentUser}, nil)
+				as.EXPECT().StartAddEmail(mock.Anything, currentUser.ID, "jane@raystack.org").
+					Return(&authenticate.Flow{ID: flowID}, nil)
+			},
+			want: &frontierv1beta1.AddCurrentUserEmailResponse{State: flowID.String()},
+		},
+		{
+			name: "should return conflict if email is used by another user",
+			setup: func(as *mocks.AuthnService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: currentUser.ID, User: &currentUser}, nil)
+				as.EXPECT().StartAddEmail(mock.Anything, currentUser.ID, "jane@raystack.org").
+					Return(nil, user.ErrConflict)
+			},
+			wantErr: grpcConflictError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAuthnSvc := new(mocks.AuthnService)
+			if tt.setup != nil {
+				tt.setup(mockAuthnSvc)
+			}
+			h := Handler{
+				authnService: mockAuthnSvc,
+			}
+
+			got, err := h.AddCurrentUserEmail(context.Background(), &frontierv1beta1.AddCurrentUserEmailRequest{Email: "jane@raystack.org"})
+			assert.EqualValues(t, tt.want, got)
+			assert.EqualValues(t, tt.wantErr, err)
+			mockAuthnSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_RemoveCurrentUserEmail(t *testing.T) {
+	currentUser := user.User{
+		ID:    uuid.New().String(),
+		Email: "jane@acme.org",
+	}
+	tests := []struct {
+		name    string
+		setup   func(as *mocks.AuthnService, is *mocks.IdentityService)
+		wantErr error
+	}{
+		{
+			name: "should remove the secondary email of the user",
+			setup: func(as *mocks.AuthnService, is *mocks.IdentityService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: currentUser.ID, User: &currentUser}, nil)
+				is.EXPECT().RemoveEmail(mock.Anything, currentUser.ID, "jane@raystack.org").Return(nil)
+			},
+		},
+		{
+			name: "should return not found if email isn't a secondary email of the user",
+			setup: func(as *mocks.AuthnService, is *mocks.IdentityService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: currentUser.ID, User: &currentUser}, nil)
+				is.EXPECT().RemoveEmail(mock.Anything, currentUser.ID, "jane@raystack.org").Return(identity.ErrEmailNotExist)
+			},
+			wantErr: grpcEmailNotFoundErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAuthnSvc := new(mocks.AuthnService)
+			mockIdentitySvc := new(mocks.IdentityService)
+			if tt.setup != nil {
+				tt.setup(mockAuthnSvc, mockIdentitySvc)
+			}
+			h := Handler{
+				authnService:    mockAuthnSvc,
+				identityService: mockIdentitySvc,
+			}
+
+			_, err := h.RemoveCurrentUserEmail(context.Background(), &frontierv1beta1.RemoveCurrentUserEmailRequest{Email: "jane@raystack.org"})
+			assert.EqualValues(t, tt.wantErr, err)
+			mockIdentitySvc.AssertExpectations(t)
+		})
+	}
+}
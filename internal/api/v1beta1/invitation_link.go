@@ -0,0 +1,196 @@
+package v1beta1
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/raystack/frontier/core/audit"
+	"github.com/raystack/frontier/core/invitation"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var grpcInviteLinkNotFoundErr = status.Errorf(codes.NotFound, invitation.ErrLinkNotFound.Error())
+
+func (h Handler) CreateOrganizationInviteLink(ctx context.Context, request *frontierv1beta1.CreateOrganizationInviteLinkRequest) (*frontierv1beta1.CreateOrganizationInviteLinkResponse, error) {
+	logger := grpczap.Extract(ctx)
+	orgResp, err := h.orgService.Get(ctx, request.GetOrgId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, organization.ErrDisabled):
+			return nil, grpcOrgDisabledErr
+		case errors.Is(err, organization.ErrNotExist):
+			return nil, grpcOrgNotFoundErr
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+	principal, err := h.GetLoggedInPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	link := invitation.Link{
+		OrgID:    orgResp.ID,
+		GroupIDs: request.GetGroupIds(),
+		RoleIDs:  request.GetRoleIds(),
+		MaxUses:  int(request.GetMaxUses()),
+	}
+	for _, domain := range request.GetDomains() {
+		if domain = strings.TrimSpace(domain); domain != "" {
+			link.Domains = append(link.Domains, domain)
+		}
+	}
+	if request.GetExpiresAt() != nil {
+		link.ExpiresAt = request.GetExpiresAt().AsTime()
+	}
+	if principal.Type == schema.UserPrincipal {
+		link.CreatedBy = principal.ID
+	}
+
+	createdLink, err := h.invitationService.CreateLink(ctx, link)
+	if err != nil {
+		logger.Error(err.Error())
+		if errors.Is(err, invitation.ErrInvalidLinkDetail) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		return nil, grpcInternalServerError
+	}
+	audit.GetAuditor(ctx, orgResp.ID).LogWithAttrs(audit.OrgInviteLinkCreatedEvent, audit.OrgTarget(orgResp.ID), map[string]string{
+		"link_id":  createdLink.ID,
+		"max_uses": strconv.Itoa(createdLink.MaxUses),
+	})
+	return &frontierv1beta1.CreateOrganizationInviteLinkResponse{
+		Link: transformInviteLinkToPB(createdLink),
+	}, nil
+}
+
+func (h Handler) ListOrganizationInviteLinks(ctx context.Context, request *frontierv1beta1.ListOrganizationInviteLinksRequest) (*frontierv1beta1.ListOrganizationInviteLinksResponse, error) {
+	logger := grpczap.Extract(ctx)
+	orgResp, err := h.orgService.Get(ctx, request.GetOrgId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, organization.ErrDisabled):
+			return nil, grpcOrgDisabledErr
+		case errors.Is(err, organization.ErrNotExist):
+			return nil, grpcOrgNotFoundErr
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	links, err := h.invitationService.ListLinks(ctx, orgResp.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	var linksPB []*frontierv1beta1.InviteLink
+	for _, link := range links {
+		linksPB = append(linksPB, transformInviteLinkToPB(link))
+	}
+	return &frontierv1beta1.ListOrganizationInviteLinksResponse{
+		Links: linksPB,
+	}, nil
+}
+
+// DeleteOrganizationInviteLink revokes the invite link, users can't join with it anymore
+func (h Handler) DeleteOrganizationInviteLink(ctx context.Context, request *frontierv1beta1.DeleteOrganizationInviteLinkRequest) (*frontierv1beta1.DeleteOrganizationInviteLinkResponse, error) {
+	logger := grpczap.Extract(ctx)
+	orgResp, err := h.orgService.Get(ctx, request.GetOrgId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, organization.ErrDisabled):
+			return nil, grpcOrgDisabledErr
+		case errors.Is(err, organization.ErrNotExist):
+			return nil, grpcOrgNotFoundErr
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	if err := h.invitationService.RevokeLink(ctx, orgResp.ID, request.GetId()); err != nil {
+		if errors.Is(err, invitation.ErrLinkNotFound) {
+			return nil, grpcInviteLinkNotFoundErr
+		}
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	audit.GetAuditor(ctx, orgResp.ID).LogWithAttrs(audit.OrgInviteLinkRevokedEvent, audit.OrgTarget(orgResp.ID), map[string]string{
+		"link_id": request.GetId(),
+	})
+	return &frontierv1beta1.DeleteOrganizationInviteLinkResponse{}, nil
+}
+
+// JoinWithInviteLink adds the current user to the organization with the groups
+// and roles of the invite link
+func (h Handler) JoinWithInviteLink(ctx context.Context, request *frontierv1beta1.JoinWithInviteLinkRequest) (*frontierv1beta1.JoinWithInviteLinkResponse, error) {
+	logger := grpczap.Extract(ctx)
+	orgResp, err := h.orgService.Get(ctx, request.GetOrgId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, organization.ErrDisabled):
+			return nil, grpcOrgDisabledErr
+		case errors.Is(err, organization.ErrNotExist):
+			return nil, grpcOrgNotFoundErr
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+	principal, err := h.GetLoggedInPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if principal.Type != schema.UserPrincipal {
+		return nil, grpcPermissionDenied
+	}
+
+	if err := h.invitationService.JoinWithLink(ctx, orgResp.ID, request.GetToken(), principal.ID); err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, invitation.ErrInvalidLink):
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		case errors.Is(err, invitation.ErrLinkExhausted):
+			return nil, status.Errorf(codes.ResourceExhausted, err.Error())
+		case errors.Is(err, organization.ErrDisabled):
+			return nil, grpcOrgDisabledErr
+		case errors.Is(err, invitation.ErrDomainNotAllowed):
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		case errors.Is(err, user.ErrNotExist):
+			return nil, grpcUserNotFoundError
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+	audit.GetAuditor(ctx, orgResp.ID).LogWithAttrs(audit.OrgMemberCreatedEvent, audit.UserTarget(principal.ID), map[string]string{
+		"via": "invite_link",
+	})
+	return &frontierv1beta1.JoinWithInviteLinkResponse{}, nil
+}
+
+func transformInviteLinkToPB(link invitation.Link) *frontierv1beta1.InviteLink {
+	return &frontierv1beta1.InviteLink{
+		Id:        link.ID,
+		OrgId:     link.OrgID,
+		GroupIds:  link.GroupIDs,
+		RoleIds:   link.RoleIDs,
+		Domains:   link.Domains,
+		MaxUses:   int32(link.MaxUses),
+		Uses:      int32(link.Uses),
+		CreatedBy: link.CreatedBy,
+		ExpiresAt: timestamppb.New(link.ExpiresAt),
+		CreatedAt: timestamppb.New(link.CreatedAt),
+		Token:     link.Value,
+	}
+}
@@ -0,0 +1,141 @@
+package v1beta1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/invitation"
+	"github.com/raystack/frontier/internal/api/v1beta1/mocks"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestHandler_CreateOrganizationInviteLink(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	tests := []struct {
+		name      string
+		setup     func(is *mocks.InvitationService)
+		request   *frontierv1beta1.CreateOrganizationInviteLinkRequest
+		wantErr   error
+		wantToken string
+	}{
+		{
+			name: "should create an invite link and return its token once",
+			setup: func(is *mocks.InvitationService) {
+				is.EXPECT().CreateLink(mock.Anything, invitation.Link{
+					OrgID:     testOrgID,
+					RoleIDs:   []string{"app_organization_viewer"},
+					Domains:   []string{"raystack.org"},
+					MaxUses:   10,
+					CreatedBy: testUserID,
+					ExpiresAt: expiresAt,
+				}).Return(invitation.Link{ID: "link-id", OrgID: testOrgID, MaxUses: 10, Value: "fil_token"}, nil)
+			},
+			request: &frontierv1beta1.CreateOrganizationInviteLinkRequest{
+				OrgId:     testOrgID,
+				RoleIds:   []string{"app_organization_viewer"},
+				Domains:   []string{" raystack.org ", ""},
+				MaxUses:   10,
+				ExpiresAt: timestamppb.New(expiresAt),
+			},
+			wantToken: "fil_token",
+		},
+		{
+			name: "should return invalid argument if the link detail is invalid",
+			setup: func(is *mocks.InvitationService) {
+				is.EXPECT().CreateLink(mock.Anything, mock.Anything).Return(invitation.Link{}, invitation.ErrInvalidLinkDetail)
+			},
+			request: &frontierv1beta1.CreateOrganizationInviteLinkRequest{
+				OrgId:   testOrgID,
+				RoleIds: []string{"app_project_owner"},
+			},
+			wantErr: status.Errorf(codes.InvalidArgument, invitation.ErrInvalidLinkDetail.Error()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os := &mocks.OrganizationService{}
+			as := &mocks.AuthnService{}
+			is := &mocks.InvitationService{}
+			os.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
+			as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{
+				ID:   testUserID,
+				Type: schema.UserPrincipal,
+			}, nil)
+			tt.setup(is)
+			h := Handler{
+				orgService:        os,
+				authnService:      as,
+				invitationService: is,
+			}
+			got, err := h.CreateOrganizationInviteLink(context.Background(), tt.request)
+			assert.EqualValues(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantToken, got.GetLink().GetToken())
+		})
+	}
+}
+
+func TestHandler_JoinWithInviteLink(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(is *mocks.InvitationService)
+		want    *frontierv1beta1.JoinWithInviteLinkResponse
+		wantErr error
+	}{
+		{
+			name: "should return error when invite link reached its max uses",
+			setup: func(is *mocks.InvitationService) {
+				is.EXPECT().JoinWithLink(mock.Anything, testOrgID, "fil_token", testUserID).Return(invitation.ErrLinkExhausted)
+			},
+			want:    nil,
+			wantErr: status.Errorf(codes.ResourceExhausted, invitation.ErrLinkExhausted.Error()),
+		},
+		{
+			name: "should return error when email domain isn't allowed by the invite link",
+			setup: func(is *mocks.InvitationService) {
+				is.EXPECT().JoinWithLink(mock.Anything, testOrgID, "fil_token", testUserID).Return(invitation.ErrDomainNotAllowed)
+			},
+			want:    nil,
+			wantErr: status.Errorf(codes.PermissionDenied, invitation.ErrDomainNotAllowed.Error()),
+		},
+		{
+			name: "should join org with the invite link",
+			setup: func(is *mocks.InvitationService) {
+				is.EXPECT().JoinWithLink(mock.Anything, testOrgID, "fil_token", testUserID).Return(nil)
+			},
+			want: &frontierv1beta1.JoinWithInviteLinkResponse{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os := &mocks.OrganizationService{}
+			as := &mocks.AuthnService{}
+			is := &mocks.InvitationService{}
+			os.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
+			as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{
+				ID:   testUserID,
+				Type: schema.UserPrincipal,
+			}, nil)
+			tt.setup(is)
+			h := Handler{
+				orgService:        os,
+				authnService:      as,
+				invitationService: is,
+			}
+			got, err := h.JoinWithInviteLink(context.Background(), &frontierv1beta1.JoinWithInviteLinkRequest{
+				OrgId: testOrgID,
+				Token: "fil_token",
+			})
+			assert.EqualValues(t, tt.wantErr, err)
+			assert.EqualValues(t, tt.want, got)
+		})
+	}
+}
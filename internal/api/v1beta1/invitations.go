@@ -17,7 +17,10 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-var grpcInvitationNotFoundError = status.Error(codes.NotFound, "invitation not found")
+var (
+	grpcInvitationNotFoundError = status.Error(codes.NotFound, "invitation not found")
+	grpcInvitationExpiredError  = status.Error(codes.FailedPrecondition, "invitation has expired")
+)
 
 type InvitationService interface {
 	Get(ctx context.Context, id uuid.UUID) (invitation.Invitation, error)
@@ -25,7 +28,13 @@ type InvitationService interface {
 	ListByUser(ctx context.Context, userID string) ([]invitation.Invitation, error)
 	Create(ctx context.Context, inv invitation.Invitation) (invitation.Invitation, error)
 	Accept(ctx context.Context, id uuid.UUID) error
+	Decline(ctx context.Context, id uuid.UUID) error
+	Revoke(ctx context.Context, id uuid.UUID) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	CreateLink(ctx context.Context, link invitation.Link) (invitation.Link, error)
+	ListLinks(ctx context.Context, orgID string) ([]invitation.Link, error)
+	RevokeLink(ctx context.Context, orgID, id string) error
+	JoinWithLink(ctx context.Context, orgID, token, userID string) error
 }
 
 func (h Handler) ListOrganizationInvitations(ctx context.Context, request *frontierv1beta1.ListOrganizationInvitationsRequest) (*frontierv1beta1.ListOrganizationInvitationsResponse, error) {
@@ -43,12 +52,20 @@ func (h Handler) ListOrganizationInvitations(ctx context.Context, request *front
 		}
 	}
 
+	page, err := paginationFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
 	invite, err := h.invitationService.List(ctx, invitation.Filter{
-		OrgID:  orgResp.ID,
-		UserID: request.GetUserId(),
+		OrgID:      orgResp.ID,
+		UserID:     request.GetUserId(),
+		Pagination: page,
 	})
 	if err != nil {
 		logger.Error(err.Error())
+		if isInvalidPaginationErr(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 	var pbinvs []*frontierv1beta1.Invitation
@@ -61,7 +78,8 @@ func (h Handler) ListOrganizationInvitations(ctx context.Context, request *front
 		pbinvs = append(pbinvs, pbInv)
 	}
 	return &frontierv1beta1.ListOrganizationInvitationsResponse{
-		Invitations: pbinvs,
+		Invitations:   pbinvs,
+		NextPageToken: page.NextPageToken(),
 	}, nil
 }
 
@@ -165,6 +183,9 @@ func (h Handler) CreateOrganizationInvitation(ctx context.Context, request *fron
 		})
 		if err != nil {
 			logger.Error(err.Error())
+			if errors.Is(err, invitation.ErrResendLimit) {
+				return nil, status.Error(codes.ResourceExhausted, err.Error())
+			}
 			return nil, status.Errorf(codes.Internal, err.Error())
 		}
 		createdInvitations = append(createdInvitations, inv)
@@ -247,6 +268,10 @@ func (h Handler) AcceptOrganizationInvitation(ctx context.Context, request *fron
 		switch {
 		case errors.Is(err, invitation.ErrNotFound):
 			return nil, grpcInvitationNotFoundError
+		case errors.Is(err, invitation.ErrExpired):
+			return nil, grpcInvitationExpiredError
+		case errors.Is(err, invitation.ErrNotPending):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
 		case errors.Is(err, user.ErrNotExist):
 			return nil, grpcUserNotFoundError
 		default:
@@ -256,6 +281,43 @@ func (h Handler) AcceptOrganizationInvitation(ctx context.Context, request *fron
 	return &frontierv1beta1.AcceptOrganizationInvitationResponse{}, nil
 }
 
+func (h Handler) DeclineOrganizationInvitation(ctx context.Context, request *frontierv1beta1.DeclineOrganizationInvitationRequest) (*frontierv1beta1.DeclineOrganizationInvitationResponse, error) {
+	logger := grpczap.Extract(ctx)
+	_, err := h.orgService.Get(ctx, request.GetOrgId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, organization.ErrDisabled):
+			return nil, grpcOrgDisabledErr
+		case errors.Is(err, organization.ErrNotExist):
+			return nil, grpcOrgNotFoundErr
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	inviteID, err := uuid.Parse(request.GetId())
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcBadBodyError
+	}
+
+	if err := h.invitationService.Decline(ctx, inviteID); err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, invitation.ErrNotFound):
+			return nil, grpcInvitationNotFoundError
+		case errors.Is(err, invitation.ErrExpired):
+			return nil, grpcInvitationExpiredError
+		case errors.Is(err, invitation.ErrNotPending):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+	return &frontierv1beta1.DeclineOrganizationInvitationResponse{}, nil
+}
+
 func (h Handler) DeleteOrganizationInvitation(ctx context.Context, request *frontierv1beta1.DeleteOrganizationInvitationRequest) (*frontierv1beta1.DeleteOrganizationInvitationResponse, error) {
 	logger := grpczap.Extract(ctx)
 	_, err := h.orgService.Get(ctx, request.GetOrgId())
@@ -277,10 +339,33 @@ func (h Handler) DeleteOrganizationInvitation(ctx context.Context, request *fron
 		return nil, grpcBadBodyError
 	}
 
-	if err := h.invitationService.Delete(ctx, inviteID); err != nil {
+	inv, err := h.invitationService.Get(ctx, inviteID)
+	if err != nil {
 		logger.Error(err.Error())
+		if errors.Is(err, invitation.ErrNotFound) {
+			return nil, grpcInvitationNotFoundError
+		}
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
+	// a pending invitation is revoked so its status is kept until it expires,
+	// it's deleted once it's closed
+	switch {
+	case inv.Status == invitation.StatusPending || inv.Status == invitation.StatusExpired:
+		err = h.invitationService.Revoke(ctx, inviteID)
+	default:
+		err = h.invitationService.Delete(ctx, inviteID)
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, invitation.ErrExpired):
+			return nil, grpcInvitationExpiredError
+		case errors.Is(err, invitation.ErrNotPending):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+	}
 	return &frontierv1beta1.DeleteOrganizationInvitationResponse{}, nil
 }
 
@@ -299,5 +384,6 @@ func transformInvitationToPB(inv invitation.Invitation) (*frontierv1beta1.Invita
 		Metadata:  metaData,
 		CreatedAt: timestamppb.New(inv.CreatedAt),
 		ExpiresAt: timestamppb.New(inv.ExpiresAt),
+		Status:    string(inv.Status),
 	}, nil
 }
@@ -69,7 +69,8 @@ func TestHandler_ListOrganizationInvitations(t *testing.T) {
 				context.Background()
 				os.EXPECT().Get(mock.AnythingOfType("context.backgroundCtx"), testOrgID).Return(testOrgMap[testOrgID], nil)
 				is.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), invitation.Filter{
-					OrgID: testOrgID,
+					Pagination: defaultPage(),
+					OrgID:      testOrgID,
 				}).Return(nil, errors.New("new-error"))
 			},
 			request: &frontierv1beta1.ListOrganizationInvitationsRequest{
@@ -89,7 +90,8 @@ func TestHandler_ListOrganizationInvitations(t *testing.T) {
 					}
 				}
 				is.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), invitation.Filter{
-					OrgID: testOrgID,
+					Pagination: defaultPage(),
+					OrgID:      testOrgID,
 				}).Return(testInvitationList, nil)
 			},
 			request: &frontierv1beta1.ListOrganizationInvitationsRequest{
@@ -458,6 +460,19 @@ func TestHandler_AcceptOrganizationInvitation(t *testing.T) {
 			want:    nil,
 			wantErr: grpcUserNotFoundError,
 		},
+		{
+			name: "should return an error if the invitation expired",
+			setup: func(is *mocks.InvitationService, us *mocks.UserService, gs *mocks.GroupService, os *mocks.OrganizationService) {
+				os.EXPECT().Get(mock.AnythingOfType("context.backgroundCtx"), testOrgID).Return(testOrgMap[testOrgID], nil)
+				is.EXPECT().Accept(mock.AnythingOfType("context.backgroundCtx"), testInvitation1ID).Return(invitation.ErrExpired)
+			},
+			request: &frontierv1beta1.AcceptOrganizationInvitationRequest{
+				Id:    testInvitation1ID.String(),
+				OrgId: testOrgID,
+			},
+			want:    nil,
+			wantErr: grpcInvitationExpiredError,
+		},
 		{
 			name: "should return an internal error if unable to accept invitation",
 			setup: func(is *mocks.InvitationService, us *mocks.UserService, gs *mocks.GroupService, os *mocks.OrganizationService) {
@@ -515,6 +530,10 @@ func TestHandler_AcceptOrganizationInvitation(t *testing.T) {
 
 func TestHandler_DeleteOrganizationInvitation(t *testing.T) {
 	randomOrgID := uuid.New().String()
+	pendingInvite := testInvitationMap[testInvitation1ID.String()]
+	pendingInvite.Status = invitation.StatusPending
+	declinedInvite := pendingInvite
+	declinedInvite.Status = invitation.StatusDeclined
 	tests := []struct {
 		name    string
 		setup   func(is *mocks.InvitationService, os *mocks.OrganizationService)
@@ -523,10 +542,11 @@ func TestHandler_DeleteOrganizationInvitation(t *testing.T) {
 		wantErr error
 	}{
 		{
-			name: "should return an internal server error if invitation service fails to delete the invite",
+			name: "should return an internal server error if invitation service fails to revoke the invite",
 			setup: func(is *mocks.InvitationService, os *mocks.OrganizationService) {
 				os.EXPECT().Get(mock.AnythingOfType("context.backgroundCtx"), randomOrgID).Return(testOrgMap[randomOrgID], nil)
-				is.EXPECT().Delete(mock.AnythingOfType("context.backgroundCtx"), testInvitation1ID).Return(errors.New("test error"))
+				is.EXPECT().Get(mock.AnythingOfType("context.backgroundCtx"), testInvitation1ID).Return(pendingInvite, nil)
+				is.EXPECT().Revoke(mock.AnythingOfType("context.backgroundCtx"), testInvitation1ID).Return(errors.New("test error"))
 			},
 			request: &frontierv1beta1.DeleteOrganizationInvitationRequest{
 				Id:    testInvitation1ID.String(),
@@ -536,9 +556,24 @@ func TestHandler_DeleteOrganizationInvitation(t *testing.T) {
 			wantErr: status.Error(codes.Internal, "test error"),
 		},
 		{
-			name: "should delete an invitation on success",
+			name: "should revoke a pending invitation deleted by the organization",
+			setup: func(is *mocks.InvitationService, os *mocks.OrganizationService) {
+				os.EXPECT().Get(mock.AnythingOfType("context.backgroundCtx"), randomOrgID).Return(testOrgMap[randomOrgID], nil)
+				is.EXPECT().Get(mock.AnythingOfType("context.backgroundCtx"), testInvitation1ID).Return(pendingInvite, nil)
+				is.EXPECT().Revoke(mock.AnythingOfType("context.backgroundCtx"), testInvitation1ID).Return(nil)
+			},
+			request: &frontierv1beta1.DeleteOrganizationInvitationRequest{
+				Id:    testInvitation1ID.String(),
+				OrgId: randomOrgID,
+			},
+			want:    &frontierv1beta1.DeleteOrganizationInvitationResponse{},
+			wantErr: nil,
+		},
+		{
+			name: "should delete an invitation which is no longer pending",
 			setup: func(is *mocks.InvitationService, os *mocks.OrganizationService) {
 				os.EXPECT().Get(mock.AnythingOfType("context.backgroundCtx"), randomOrgID).Return(testOrgMap[randomOrgID], nil)
+				is.EXPECT().Get(mock.AnythingOfType("context.backgroundCtx"), testInvitation1ID).Return(declinedInvite, nil)
 				is.EXPECT().Delete(mock.AnythingOfType("context.backgroundCtx"), testInvitation1ID).Return(nil)
 			},
 			request: &frontierv1beta1.DeleteOrganizationInvitationRequest{
@@ -571,3 +606,58 @@ func TestHandler_DeleteOrganizationInvitation(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_DeclineOrganizationInvitation(t *testing.T) {
+	randomOrgID := uuid.New().String()
+	tests := []struct {
+		name    string
+		setup   func(is *mocks.InvitationService, os *mocks.OrganizationService)
+		want    *frontierv1beta1.DeclineOrganizationInvitationResponse
+		wantErr error
+	}{
+		{
+			name: "should decline a pending invitation",
+			setup: func(is *mocks.InvitationService, os *mocks.OrganizationService) {
+				os.EXPECT().Get(mock.AnythingOfType("context.backgroundCtx"), randomOrgID).Return(testOrgMap[randomOrgID], nil)
+				is.EXPECT().Decline(mock.AnythingOfType("context.backgroundCtx"), testInvitation1ID).Return(nil)
+			},
+			want: &frontierv1beta1.DeclineOrganizationInvitationResponse{},
+		},
+		{
+			name: "should return an error if the invitation has expired",
+			setup: func(is *mocks.InvitationService, os *mocks.OrganizationService) {
+				os.EXPECT().Get(mock.AnythingOfType("context.backgroundCtx"), randomOrgID).Return(testOrgMap[randomOrgID], nil)
+				is.EXPECT().Decline(mock.AnythingOfType("context.backgroundCtx"), testInvitation1ID).Return(invitation.ErrExpired)
+			},
+			wantErr: grpcInvitationExpiredError,
+		},
+		{
+			name: "should return not found if the invitation doesn't exist",
+			setup: func(is *mocks.InvitationService, os *mocks.OrganizationService) {
+				os.EXPECT().Get(mock.AnythingOfType("context.backgroundCtx"), randomOrgID).Return(testOrgMap[randomOrgID], nil)
+				is.EXPECT().Decline(mock.AnythingOfType("context.backgroundCtx"), testInvitation1ID).Return(invitation.ErrNotFound)
+			},
+			wantErr: grpcInvitationNotFoundError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := &mocks.InvitationService{}
+			os := &mocks.OrganizationService{}
+			if tt.setup != nil {
+				tt.setup(is, os)
+			}
+			h := &Handler{
+				invitationService: is,
+				orgService:        os,
+			}
+			got, err := h.DeclineOrganizationInvitation(context.Background(), &frontierv1beta1.DeclineOrganizationInvitationRequest{
+				Id:    testInvitation1ID.String(),
+				OrgId: randomOrgID,
+			})
+			assert.EqualValues(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
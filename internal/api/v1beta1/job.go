@@ -0,0 +1,123 @@
+package v1beta1
+
+import (
+	"context"
+	"errors"
+
+	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/raystack/frontier/core/job"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	grpcJobNotFoundErr = status.Errorf(codes.NotFound, "job doesn't exist")
+	grpcJobFinishedErr = status.Errorf(codes.FailedPrecondition, "job is already finished")
+)
+
+type JobService interface {
+	Create(ctx context.Context, job job.Job) (job.Job, error)
+	Get(ctx context.Context, id string) (job.Job, error)
+	List(ctx context.Context, flt job.Filter) ([]job.Job, error)
+	Cancel(ctx context.Context, id string) (job.Job, error)
+}
+
+func (h Handler) GetJob(ctx context.Context, request *frontierv1beta1.GetJobRequest) (*frontierv1beta1.GetJobResponse, error) {
+	j, err := h.getPrincipalJob(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &frontierv1beta1.GetJobResponse{Job: transformJobToPB(j)}, nil
+}
+
+func (h Handler) CancelJob(ctx context.Context, request *frontierv1beta1.CancelJobRequest) (*frontierv1beta1.CancelJobResponse, error) {
+	logger := grpczap.Extract(ctx)
+	if _, err := h.getPrincipalJob(ctx, request.GetId()); err != nil {
+		return nil, err
+	}
+
+	j, err := h.jobService.Cancel(ctx, request.GetId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, job.ErrNotExist):
+			return nil, grpcJobNotFoundErr
+		case errors.Is(err, job.ErrFinished):
+			return nil, grpcJobFinishedErr
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+	return &frontierv1beta1.CancelJobResponse{Job: transformJobToPB(j)}, nil
+}
+
+func (h Handler) ListJobs(ctx context.Context, request *frontierv1beta1.ListJobsRequest) (*frontierv1beta1.ListJobsResponse, error) {
+	logger := grpczap.Extract(ctx)
+	jobs, err := h.jobService.List(ctx, job.Filter{
+		Kind:  request.GetKind(),
+		State: job.State(request.GetState()),
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+
+	var jobsPB []*frontierv1beta1.Job
+	for _, j := range jobs {
+		jobsPB = append(jobsPB, transformJobToPB(j))
+	}
+	return &frontierv1beta1.ListJobsResponse{Jobs: jobsPB}, nil
+}
+
+// getPrincipalJob returns a job if it was created by the current principal,
+// superusers can access jobs of all principals
+func (h Handler) getPrincipalJob(ctx context.Context, id string) (job.Job, error) {
+	logger := grpczap.Extract(ctx)
+	principal, err := h.GetLoggedInPrincipal(ctx)
+	if err != nil {
+		return job.Job{}, err
+	}
+
+	j, err := h.jobService.Get(ctx, id)
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, job.ErrNotExist), errors.Is(err, job.ErrInvalidUUID), errors.Is(err, job.ErrInvalidID):
+			return job.Job{}, grpcJobNotFoundErr
+		default:
+			return job.Job{}, grpcInternalServerError
+		}
+	}
+	if j.CreatedBy != principal.ID {
+		if err := h.IsSuperUser(ctx); err != nil {
+			return job.Job{}, grpcJobNotFoundErr
+		}
+	}
+	return j, nil
+}
+
+func transformJobToPB(j job.Job) *frontierv1beta1.Job {
+	succeeded, failed := j.Progress()
+	var items []*frontierv1beta1.JobItem
+	for _, item := range j.Items {
+		items = append(items, &frontierv1beta1.JobItem{
+			Key:   item.Key,
+			State: string(item.State),
+			Error: item.Error,
+		})
+	}
+	return &frontierv1beta1.Job{
+		Id:        j.ID,
+		Kind:      j.Kind,
+		State:     string(j.State),
+		Items:     items,
+		Error:     j.Error,
+		CreatedBy: j.CreatedBy,
+		Succeeded: int32(succeeded),
+		Failed:    int32(failed),
+		CreatedAt: timestamppb.New(j.CreatedAt),
+		UpdatedAt: timestamppb.New(j.UpdatedAt),
+	}
+}
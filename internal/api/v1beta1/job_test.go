@@ -0,0 +1,222 @@
+package v1beta1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/job"
+	"github.com/raystack/frontier/core/membership"
+	"github.com/raystack/frontier/internal/api/v1beta1/mocks"
+	"github.com/raystack/frontier/pkg/metadata"
+	"github.com/raystack/frontier/pkg/utils"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcmetadata "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type headerStream struct {
+	grpc.ServerTransportStream
+	header grpcmetadata.MD
+}
+
+func (s *headerStream) SetHeader(md grpcmetadata.MD) error {
+	s.header = grpcmetadata.Join(s.header, md)
+	return nil
+}
+
+func TestHandler_AddOrganizationUsersAsync(t *testing.T) {
+	someJobID := utils.NewString()
+	someUserID := utils.NewString()
+	tests := []struct {
+		name    string
+		setup   func(os *mocks.OrganizationService, as *mocks.AuthnService, js *mocks.JobService)
+		request *frontierv1beta1.AddOrganizationUsersRequest
+		want    *frontierv1beta1.AddOrganizationUsersResponse
+		wantErr error
+	}{
+		{
+			name: "should queue a job and return its id",
+			setup: func(os *mocks.OrganizationService, as *mocks.AuthnService, js *mocks.JobService) {
+				os.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: someUserID}, nil)
+				js.EXPECT().Create(mock.Anything, job.Job{
+					Kind: membership.AddOrganizationUsersJob,
+					Payload: metadata.Metadata{
+						"org_id":               testOrgID,
+						"create_missing_users": true,
+					},
+					Items: []job.Item{
+						{Key: "john@acme.org", State: job.ItemStatePending},
+						{Key: "jane@acme.org", State: job.ItemStatePending},
+					},
+					CreatedBy: someUserID,
+				}).Return(job.Job{ID: someJobID}, nil)
+			},
+			request: &frontierv1beta1.AddOrganizationUsersRequest{
+				Id:                 testOrgID,
+				UserIds:            []string{"john@acme.org", "jane@acme.org", "john@acme.org"},
+				Async:              true,
+				CreateMissingUsers: true,
+			},
+			want: &frontierv1beta1.AddOrganizationUsersResponse{JobId: someJobID},
+		},
+		{
+			name: "should return invalid argument if job has no items",
+			setup: func(os *mocks.OrganizationService, as *mocks.AuthnService, js *mocks.JobService) {
+				os.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: someUserID}, nil)
+				js.EXPECT().Create(mock.Anything, mock.Anything).Return(job.Job{}, job.ErrInvalidDetail)
+			},
+			request: &frontierv1beta1.AddOrganizationUsersRequest{
+				Id:    testOrgID,
+				Async: true,
+			},
+			wantErr: status.Error(codes.InvalidArgument, job.ErrInvalidDetail.Error()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOrgSvc := new(mocks.OrganizationService)
+			mockAuthnSvc := new(mocks.AuthnService)
+			mockJobSvc := new(mocks.JobService)
+			if tt.setup != nil {
+				tt.setup(mockOrgSvc, mockAuthnSvc, mockJobSvc)
+			}
+			h := Handler{
+				orgService:   mockOrgSvc,
+				authnService: mockAuthnSvc,
+				jobService:   mockJobSvc,
+			}
+			got, err := h.AddOrganizationUsers(context.Background(), tt.request)
+			assert.EqualValues(t, tt.wantErr, err)
+			assert.EqualValues(t, tt.want, got)
+		})
+	}
+}
+
+func TestHandler_GetJob(t *testing.T) {
+	someJobID := utils.NewString()
+	someUserID := utils.NewString()
+	tests := []struct {
+		name    string
+		setup   func(as *mocks.AuthnService, us *mocks.UserService, js *mocks.JobService)
+		want    *frontierv1beta1.GetJobResponse
+		wantErr error
+	}{
+		{
+			name: "should return job created by the current user",
+			setup: func(as *mocks.AuthnService, us *mocks.UserService, js *mocks.JobService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: someUserID}, nil)
+				js.EXPECT().Get(mock.Anything, someJobID).Return(job.Job{
+					ID:        someJobID,
+					Kind:      membership.AddOrganizationUsersJob,
+					State:     job.StateRunning,
+					CreatedBy: someUserID,
+					Items: []job.Item{
+						{Key: "john@acme.org", State: job.ItemStateSucceeded},
+						{Key: "jane@acme.org", State: job.ItemStateFailed, Error: "user doesn't exist"},
+					},
+				}, nil)
+			},
+			want: &frontierv1beta1.GetJobResponse{Job: &frontierv1beta1.Job{
+				Id:        someJobID,
+				Kind:      membership.AddOrganizationUsersJob,
+				State:     string(job.StateRunning),
+				CreatedBy: someUserID,
+				Items: []*frontierv1beta1.JobItem{
+					{Key: "john@acme.org", State: string(job.ItemStateSucceeded)},
+					{Key: "jane@acme.org", State: string(job.ItemStateFailed), Error: "user doesn't exist"},
+				},
+				Succeeded: 1,
+				Failed:    1,
+				CreatedAt: timestamppb.New(time.Time{}),
+				UpdatedAt: timestamppb.New(time.Time{}),
+			}},
+		},
+		{
+			name: "should return not found for job of another user",
+			setup: func(as *mocks.AuthnService, us *mocks.UserService, js *mocks.JobService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: someUserID}, nil)
+				js.EXPECT().Get(mock.Anything, someJobID).Return(job.Job{ID: someJobID, CreatedBy: utils.NewString()}, nil)
+				us.EXPECT().IsSudo(mock.Anything, someUserID).Return(false, nil)
+			},
+			wantErr: grpcJobNotFoundErr,
+		},
+		{
+			name: "should return not found if job doesn't exist",
+			setup: func(as *mocks.AuthnService, us *mocks.UserService, js *mocks.JobService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: someUserID}, nil)
+				js.EXPECT().Get(mock.Anything, someJobID).Return(job.Job{}, job.ErrNotExist)
+			},
+			wantErr: grpcJobNotFoundErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAuthnSvc := new(mocks.AuthnService)
+			mockUserSvc := new(mocks.UserService)
+			mockJobSvc := new(mocks.JobService)
+			if tt.setup != nil {
+				tt.setup(mockAuthnSvc, mockUserSvc, mockJobSvc)
+			}
+			h := Handler{
+				authnService: mockAuthnSvc,
+				userService:  mockUserSvc,
+				jobService:   mockJobSvc,
+			}
+			got, err := h.GetJob(context.Background(), &frontierv1beta1.GetJobRequest{Id: someJobID})
+			assert.EqualValues(t, tt.wantErr, err)
+			assert.EqualValues(t, tt.want, got)
+		})
+	}
+}
+
+func TestHandler_CancelJob(t *testing.T) {
+	someJobID := utils.NewString()
+	someUserID := utils.NewString()
+	tests := []struct {
+		name    string
+		setup   func(as *mocks.AuthnService, js *mocks.JobService)
+		wantErr error
+	}{
+		{
+			name: "should cancel job created by the current user",
+			setup: func(as *mocks.AuthnService, js *mocks.JobService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: someUserID}, nil)
+				js.EXPECT().Get(mock.Anything, someJobID).Return(job.Job{ID: someJobID, CreatedBy: someUserID}, nil)
+				js.EXPECT().Cancel(mock.Anything, someJobID).Return(job.Job{ID: someJobID, State: job.StateCancelled}, nil)
+			},
+		},
+		{
+			name: "should return failed precondition if job is finished",
+			setup: func(as *mocks.AuthnService, js *mocks.JobService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: someUserID}, nil)
+				js.EXPECT().Get(mock.Anything, someJobID).Return(job.Job{ID: someJobID, CreatedBy: someUserID}, nil)
+				js.EXPECT().Cancel(mock.Anything, someJobID).Return(job.Job{}, job.ErrFinished)
+			},
+			wantErr: grpcJobFinishedErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAuthnSvc := new(mocks.AuthnService)
+			mockJobSvc := new(mocks.JobService)
+			if tt.setup != nil {
+				tt.setup(mockAuthnSvc, mockJobSvc)
+			}
+			h := Handler{
+				authnService: mockAuthnSvc,
+				jobService:   mockJobSvc,
+			}
+			_, err := h.CancelJob(context.Background(), &frontierv1beta1.CancelJobRequest{Id: someJobID})
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
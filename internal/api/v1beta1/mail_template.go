@@ -0,0 +1,265 @@
+package v1beta1
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/raystack/frontier/core/audit"
+	"github.com/raystack/frontier/core/email"
+	"github.com/raystack/frontier/core/organization"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type EmailService interface {
+	SetTemplate(ctx context.Context, tmpl email.Template) (email.Template, error)
+	ListTemplates(ctx context.Context, orgID string) ([]email.Template, error)
+	DeleteTemplate(ctx context.Context, name, orgID, locale string) error
+	Preview(ctx context.Context, name, orgID, locale string) (email.Message, error)
+	ListMails(ctx context.Context, flt email.MailFilter) ([]email.Mail, error)
+}
+
+// defaultMailsLimit is how many of the latest mails are listed if the request
+// doesn't set a page size
+const defaultMailsLimit = 50
+
+var grpcMailTemplateNotFoundErr = status.Errorf(codes.NotFound, email.ErrTemplateNotExist.Error())
+
+func (h Handler) ListOrganizationMailTemplates(ctx context.Context, request *frontierv1beta1.ListOrganizationMailTemplatesRequest) (*frontierv1beta1.ListOrganizationMailTemplatesResponse, error) {
+	orgID, err := h.mailTemplateOrgID(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	templates, err := h.listMailTemplates(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return &frontierv1beta1.ListOrganizationMailTemplatesResponse{Templates: templates}, nil
+}
+
+func (h Handler) SetOrganizationMailTemplate(ctx context.Context, request *frontierv1beta1.SetOrganizationMailTemplateRequest) (*frontierv1beta1.SetOrganizationMailTemplateResponse, error) {
+	orgID, err := h.mailTemplateOrgID(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := h.setMailTemplate(ctx, email.Template{
+		Name:     strings.TrimSpace(request.GetName()),
+		OrgID:    orgID,
+		Locale:   strings.ToLower(strings.TrimSpace(request.GetLocale())),
+		Subject:  request.GetSubject(),
+		HTMLBody: request.GetHtmlBody(),
+		TextBody: request.GetTextBody(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	audit.GetAuditor(ctx, orgID).LogWithAttrs(audit.OrgMailTemplateSetEvent, audit.OrgTarget(orgID), map[string]string{
+		"name":   tmpl.GetName(),
+		"locale": tmpl.GetLocale(),
+	})
+	return &frontierv1beta1.SetOrganizationMailTemplateResponse{Template: tmpl}, nil
+}
+
+func (h Handler) DeleteOrganizationMailTemplate(ctx context.Context, request *frontierv1beta1.DeleteOrganizationMailTemplateRequest) (*frontierv1beta1.DeleteOrganizationMailTemplateResponse, error) {
+	orgID, err := h.mailTemplateOrgID(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	name, locale := strings.TrimSpace(request.GetName()), strings.ToLower(strings.TrimSpace(request.GetLocale()))
+	if err := h.deleteMailTemplate(ctx, name, orgID, locale); err != nil {
+		return nil, err
+	}
+	audit.GetAuditor(ctx, orgID).LogWithAttrs(audit.OrgMailTemplateDeletedEvent, audit.OrgTarget(orgID), map[string]string{
+		"name":   name,
+		"locale": locale,
+	})
+	return &frontierv1beta1.DeleteOrganizationMailTemplateResponse{}, nil
+}
+
+func (h Handler) PreviewOrganizationMailTemplate(ctx context.Context, request *frontierv1beta1.PreviewOrganizationMailTemplateRequest) (*frontierv1beta1.PreviewOrganizationMailTemplateResponse, error) {
+	orgID, err := h.mailTemplateOrgID(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	msg, err := h.previewMailTemplate(ctx, request.GetName(), orgID, request.GetLocale())
+	if err != nil {
+		return nil, err
+	}
+	return &frontierv1beta1.PreviewOrganizationMailTemplateResponse{Message: msg}, nil
+}
+
+func (h Handler) ListMailTemplates(ctx context.Context, request *frontierv1beta1.ListMailTemplatesRequest) (*frontierv1beta1.ListMailTemplatesResponse, error) {
+	templates, err := h.listMailTemplates(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	return &frontierv1beta1.ListMailTemplatesResponse{Templates: templates}, nil
+}
+
+func (h Handler) SetMailTemplate(ctx context.Context, request *frontierv1beta1.SetMailTemplateRequest) (*frontierv1beta1.SetMailTemplateResponse, error) {
+	tmpl, err := h.setMailTemplate(ctx, email.Template{
+		Name:     strings.TrimSpace(request.GetName()),
+		Locale:   strings.ToLower(strings.TrimSpace(request.GetLocale())),
+		Subject:  request.GetSubject(),
+		HTMLBody: request.GetHtmlBody(),
+		TextBody: request.GetTextBody(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &frontierv1beta1.SetMailTemplateResponse{Template: tmpl}, nil
+}
+
+func (h Handler) DeleteMailTemplate(ctx context.Context, request *frontierv1beta1.DeleteMailTemplateRequest) (*frontierv1beta1.DeleteMailTemplateResponse, error) {
+	name, locale := strings.TrimSpace(request.GetName()), strings.ToLower(strings.TrimSpace(request.GetLocale()))
+	if err := h.deleteMailTemplate(ctx, name, "", locale); err != nil {
+		return nil, err
+	}
+	return &frontierv1beta1.DeleteMailTemplateResponse{}, nil
+}
+
+func (h Handler) PreviewMailTemplate(ctx context.Context, request *frontierv1beta1.PreviewMailTemplateRequest) (*frontierv1beta1.PreviewMailTemplateResponse, error) {
+	msg, err := h.previewMailTemplate(ctx, request.GetName(), "", request.GetLocale())
+	if err != nil {
+		return nil, err
+	}
+	return &frontierv1beta1.PreviewMailTemplateResponse{Message: msg}, nil
+}
+
+// ListMails returns the delivery status of the latest mails of the status
+func (h Handler) ListMails(ctx context.Context, request *frontierv1beta1.ListMailsRequest) (*frontierv1beta1.ListMailsResponse, error) {
+	logger := grpczap.Extract(ctx)
+	mailStatus := email.Status(strings.ToLower(strings.TrimSpace(request.GetStatus())))
+	switch mailStatus {
+	case email.StatusQueued, email.StatusSent, email.StatusFailed:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "%s: unknown mail status %s", email.ErrInvalidDetail.Error(), request.GetStatus())
+	}
+	limit := int(request.GetPageSize())
+	if limit <= 0 {
+		limit = defaultMailsLimit
+	}
+
+	mails, err := h.emailService.ListMails(ctx, email.MailFilter{
+		Status: mailStatus,
+		Limit:  limit,
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	var mailsPB []*frontierv1beta1.Mail
+	for _, m := range mails {
+		mailsPB = append(mailsPB, transformMailToPB(m))
+	}
+	return &frontierv1beta1.ListMailsResponse{Mails: mailsPB}, nil
+}
+
+// mailTemplateOrgID returns the id of the org mail templates are managed for,
+// the org can be referred to by its name as well
+func (h Handler) mailTemplateOrgID(ctx context.Context, idOrName string) (string, error) {
+	org, err := h.orgService.Get(ctx, idOrName)
+	if err != nil {
+		grpczap.Extract(ctx).Error(err.Error())
+		switch {
+		case errors.Is(err, organization.ErrDisabled):
+			return "", grpcOrgDisabledErr
+		case errors.Is(err, organization.ErrNotExist):
+			return "", grpcOrgNotFoundErr
+		default:
+			return "", grpcInternalServerError
+		}
+	}
+	return org.ID, nil
+}
+
+// listMailTemplates returns mail templates of the org, or of the platform if
+// the org is empty
+func (h Handler) listMailTemplates(ctx context.Context, orgID string) ([]*frontierv1beta1.MailTemplate, error) {
+	tmpls, err := h.emailService.ListTemplates(ctx, orgID)
+	if err != nil {
+		grpczap.Extract(ctx).Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	var tmplsPB []*frontierv1beta1.MailTemplate
+	for _, tmpl := range tmpls {
+		tmplsPB = append(tmplsPB, transformMailTemplateToPB(tmpl))
+	}
+	return tmplsPB, nil
+}
+
+func (h Handler) setMailTemplate(ctx context.Context, tmpl email.Template) (*frontierv1beta1.MailTemplate, error) {
+	saved, err := h.emailService.SetTemplate(ctx, tmpl)
+	if err != nil {
+		if errors.Is(err, email.ErrInvalidTemplate) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		grpczap.Extract(ctx).Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	return transformMailTemplateToPB(saved), nil
+}
+
+func (h Handler) deleteMailTemplate(ctx context.Context, name, orgID, locale string) error {
+	if err := h.emailService.DeleteTemplate(ctx, name, orgID, locale); err != nil {
+		if errors.Is(err, email.ErrTemplateNotExist) {
+			return grpcMailTemplateNotFoundErr
+		}
+		grpczap.Extract(ctx).Error(err.Error())
+		return grpcInternalServerError
+	}
+	return nil
+}
+
+// previewMailTemplate renders the template used for mails of the org, or of
+// the platform if the org is empty, with sample data
+func (h Handler) previewMailTemplate(ctx context.Context, name, orgID, locale string) (*frontierv1beta1.MailMessage, error) {
+	msg, err := h.emailService.Preview(ctx, strings.TrimSpace(name), orgID, strings.ToLower(strings.TrimSpace(locale)))
+	if err != nil {
+		switch {
+		case errors.Is(err, email.ErrTemplateNotExist):
+			return nil, grpcMailTemplateNotFoundErr
+		case errors.Is(err, email.ErrInvalidTemplate):
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		grpczap.Extract(ctx).Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	return &frontierv1beta1.MailMessage{
+		Subject:  msg.Subject,
+		HtmlBody: msg.HTMLBody,
+		TextBody: msg.TextBody,
+	}, nil
+}
+
+func transformMailTemplateToPB(tmpl email.Template) *frontierv1beta1.MailTemplate {
+	return &frontierv1beta1.MailTemplate{
+		Name:      tmpl.Name,
+		OrgId:     tmpl.OrgID,
+		Locale:    tmpl.Locale,
+		Subject:   tmpl.Subject,
+		HtmlBody:  tmpl.HTMLBody,
+		TextBody:  tmpl.TextBody,
+		UpdatedAt: optionalTimestamp(tmpl.UpdatedAt),
+	}
+}
+
+func transformMailToPB(m email.Mail) *frontierv1beta1.Mail {
+	return &frontierv1beta1.Mail{
+		Id:            m.ID,
+		Template:      m.Template,
+		OrgId:         m.OrgID,
+		To:            m.To,
+		Subject:       m.Message.Subject,
+		Status:        string(m.Status),
+		Attempts:      int32(m.Attempts),
+		LastError:     m.LastError,
+		NextAttemptAt: optionalTimestamp(m.NextAttemptAt),
+		SentAt:        optionalTimestamp(m.SentAt),
+		CreatedAt:     timestamppb.New(m.CreatedAt),
+	}
+}
@@ -0,0 +1,95 @@
+package v1beta1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raystack/frontier/core/email"
+	"github.com/raystack/frontier/internal/api/v1beta1/mocks"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHandler_SetOrganizationMailTemplate(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(es *mocks.EmailService)
+		wantErr error
+	}{
+		{
+			name: "should return error if the template can't be rendered",
+			setup: func(es *mocks.EmailService) {
+				es.EXPECT().SetTemplate(mock.Anything, mock.Anything).Return(email.Template{}, email.ErrInvalidTemplate)
+			},
+			wantErr: status.Errorf(codes.InvalidArgument, email.ErrInvalidTemplate.Error()),
+		},
+		{
+			name: "should set the template of the org",
+			setup: func(es *mocks.EmailService) {
+				want := email.Template{
+					Name:     "invitation",
+					OrgID:    testOrgID,
+					Locale:   "fr",
+					Subject:  "Invitation",
+					HTMLBody: "<p>Rejoignez {{.Organization}}</p>",
+				}
+				es.EXPECT().SetTemplate(mock.Anything, want).Return(want, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os := &mocks.OrganizationService{}
+			es := &mocks.EmailService{}
+			os.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
+			tt.setup(es)
+			h := Handler{orgService: os, emailService: es}
+			_, err := h.SetOrganizationMailTemplate(context.Background(), &frontierv1beta1.SetOrganizationMailTemplateRequest{
+				Id:       testOrgID,
+				Name:     "invitation",
+				Locale:   "FR",
+				Subject:  "Invitation",
+				HtmlBody: "<p>Rejoignez {{.Organization}}</p>",
+			})
+			assert.EqualValues(t, tt.wantErr, err)
+			es.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_DeleteOrganizationMailTemplate(t *testing.T) {
+	os := &mocks.OrganizationService{}
+	es := &mocks.EmailService{}
+	os.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
+	es.EXPECT().DeleteTemplate(mock.Anything, "invitation", testOrgID, "fr").Return(email.ErrTemplateNotExist)
+	h := Handler{orgService: os, emailService: es}
+
+	_, err := h.DeleteOrganizationMailTemplate(context.Background(), &frontierv1beta1.DeleteOrganizationMailTemplateRequest{
+		Id:     testOrgID,
+		Name:   "invitation",
+		Locale: "FR",
+	})
+	assert.EqualValues(t, grpcMailTemplateNotFoundErr, err)
+}
+
+func TestHandler_ListMails(t *testing.T) {
+	t.Run("should return error for an unknown status", func(t *testing.T) {
+		h := Handler{emailService: &mocks.EmailService{}}
+		_, err := h.ListMails(context.Background(), &frontierv1beta1.ListMailsRequest{Status: "bounced"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("should list the latest failed mails", func(t *testing.T) {
+		es := &mocks.EmailService{}
+		es.EXPECT().ListMails(mock.Anything, email.MailFilter{Status: email.StatusFailed, Limit: defaultMailsLimit}).Return([]email.Mail{
+			{ID: "mail-1", Template: email.TemplateInvitation, Status: email.StatusFailed, Attempts: 3, LastError: "connection refused"},
+		}, nil)
+		h := Handler{emailService: es}
+		got, err := h.ListMails(context.Background(), &frontierv1beta1.ListMailsRequest{Status: "failed"})
+		assert.NoError(t, err)
+		assert.Len(t, got.GetMails(), 1)
+		assert.Equal(t, "connection refused", got.GetMails()[0].GetLastError())
+	})
+}
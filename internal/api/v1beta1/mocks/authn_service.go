@@ -25,6 +25,50 @@ func (_m *AuthnService) EXPECT() *AuthnService_Expecter {
 	return &AuthnService_Expecter{mock: &_m.Mock}
 }
 
+// ApproveDeviceFlow provides a mock function with given fields: ctx, userCode, userID
+func (_m *AuthnService) ApproveDeviceFlow(ctx context.Context, userCode string, userID string) error {
+	ret := _m.Called(ctx, userCode, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userCode, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthnService_ApproveDeviceFlow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveDeviceFlow'
+type AuthnService_ApproveDeviceFlow_Call struct {
+	*mock.Call
+}
+
+// ApproveDeviceFlow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userCode string
+//   - userID string
+func (_e *AuthnService_Expecter) ApproveDeviceFlow(ctx interface{}, userCode interface{}, userID interface{}) *AuthnService_ApproveDeviceFlow_Call {
+	return &AuthnService_ApproveDeviceFlow_Call{Call: _e.mock.On("ApproveDeviceFlow", ctx, userCode, userID)}
+}
+
+func (_c *AuthnService_ApproveDeviceFlow_Call) Run(run func(ctx context.Context, userCode string, userID string)) *AuthnService_ApproveDeviceFlow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AuthnService_ApproveDeviceFlow_Call) Return(_a0 error) *AuthnService_ApproveDeviceFlow_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthnService_ApproveDeviceFlow_Call) RunAndReturn(run func(context.Context, string, string) error) *AuthnService_ApproveDeviceFlow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BuildToken provides a mock function with given fields: ctx, principalID, metadata
 func (_m *AuthnService) BuildToken(ctx context.Context, principalID string, metadata map[string]string) ([]byte, error) {
 	ret := _m.Called(ctx, principalID, metadata)
@@ -81,6 +125,114 @@ func (_c *AuthnService_BuildToken_Call) RunAndReturn(run func(context.Context, s
 	return _c
 }
 
+// FinishDeviceFlow provides a mock function with given fields: ctx, deviceCode
+func (_m *AuthnService) FinishDeviceFlow(ctx context.Context, deviceCode string) (string, error) {
+	ret := _m.Called(ctx, deviceCode)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, deviceCode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, deviceCode)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, deviceCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthnService_FinishDeviceFlow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishDeviceFlow'
+type AuthnService_FinishDeviceFlow_Call struct {
+	*mock.Call
+}
+
+// FinishDeviceFlow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceCode string
+func (_e *AuthnService_Expecter) FinishDeviceFlow(ctx interface{}, deviceCode interface{}) *AuthnService_FinishDeviceFlow_Call {
+	return &AuthnService_FinishDeviceFlow_Call{Call: _e.mock.On("FinishDeviceFlow", ctx, deviceCode)}
+}
+
+func (_c *AuthnService_FinishDeviceFlow_Call) Run(run func(ctx context.Context, deviceCode string)) *AuthnService_FinishDeviceFlow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthnService_FinishDeviceFlow_Call) Return(_a0 string, _a1 error) *AuthnService_FinishDeviceFlow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthnService_FinishDeviceFlow_Call) RunAndReturn(run func(context.Context, string) (string, error)) *AuthnService_FinishDeviceFlow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishEmailChange provides a mock function with given fields: ctx, userID, state, code
+func (_m *AuthnService) FinishEmailChange(ctx context.Context, userID string, state string, code string) (authenticate.EmailChange, error) {
+	ret := _m.Called(ctx, userID, state, code)
+
+	var r0 authenticate.EmailChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (authenticate.EmailChange, error)); ok {
+		return rf(ctx, userID, state, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) authenticate.EmailChange); ok {
+		r0 = rf(ctx, userID, state, code)
+	} else {
+		r0 = ret.Get(0).(authenticate.EmailChange)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, userID, state, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthnService_FinishEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishEmailChange'
+type AuthnService_FinishEmailChange_Call struct {
+	*mock.Call
+}
+
+// FinishEmailChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - state string
+//   - code string
+func (_e *AuthnService_Expecter) FinishEmailChange(ctx interface{}, userID interface{}, state interface{}, code interface{}) *AuthnService_FinishEmailChange_Call {
+	return &AuthnService_FinishEmailChange_Call{Call: _e.mock.On("FinishEmailChange", ctx, userID, state, code)}
+}
+
+func (_c *AuthnService_FinishEmailChange_Call) Run(run func(ctx context.Context, userID string, state string, code string)) *AuthnService_FinishEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *AuthnService_FinishEmailChange_Call) Return(_a0 authenticate.EmailChange, _a1 error) *AuthnService_FinishEmailChange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthnService_FinishEmailChange_Call) RunAndReturn(run func(context.Context, string, string, string) (authenticate.EmailChange, error)) *AuthnService_FinishEmailChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FinishFlow provides a mock function with given fields: ctx, request
 func (_m *AuthnService) FinishFlow(ctx context.Context, request authenticate.RegistrationFinishRequest) (*authenticate.RegistrationFinishResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -373,6 +525,170 @@ func (_c *AuthnService_SanitizeReturnToURL_Call) RunAndReturn(run func(string) s
 	return _c
 }
 
+// StartAddEmail provides a mock function with given fields: ctx, userID, email
+func (_m *AuthnService) StartAddEmail(ctx context.Context, userID string, email string) (*authenticate.Flow, error) {
+	ret := _m.Called(ctx, userID, email)
+
+	var r0 *authenticate.Flow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*authenticate.Flow, error)); ok {
+		return rf(ctx, userID, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *authenticate.Flow); ok {
+		r0 = rf(ctx, userID, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*authenticate.Flow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthnService_StartAddEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartAddEmail'
+type AuthnService_StartAddEmail_Call struct {
+	*mock.Call
+}
+
+// StartAddEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - email string
+func (_e *AuthnService_Expecter) StartAddEmail(ctx interface{}, userID interface{}, email interface{}) *AuthnService_StartAddEmail_Call {
+	return &AuthnService_StartAddEmail_Call{Call: _e.mock.On("StartAddEmail", ctx, userID, email)}
+}
+
+func (_c *AuthnService_StartAddEmail_Call) Run(run func(ctx context.Context, userID string, email string)) *AuthnService_StartAddEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AuthnService_StartAddEmail_Call) Return(_a0 *authenticate.Flow, _a1 error) *AuthnService_StartAddEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthnService_StartAddEmail_Call) RunAndReturn(run func(context.Context, string, string) (*authenticate.Flow, error)) *AuthnService_StartAddEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartDeviceFlow provides a mock function with given fields: ctx
+func (_m *AuthnService) StartDeviceFlow(ctx context.Context) (authenticate.DeviceAuthorization, error) {
+	ret := _m.Called(ctx)
+
+	var r0 authenticate.DeviceAuthorization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (authenticate.DeviceAuthorization, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) authenticate.DeviceAuthorization); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(authenticate.DeviceAuthorization)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthnService_StartDeviceFlow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartDeviceFlow'
+type AuthnService_StartDeviceFlow_Call struct {
+	*mock.Call
+}
+
+// StartDeviceFlow is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AuthnService_Expecter) StartDeviceFlow(ctx interface{}) *AuthnService_StartDeviceFlow_Call {
+	return &AuthnService_StartDeviceFlow_Call{Call: _e.mock.On("StartDeviceFlow", ctx)}
+}
+
+func (_c *AuthnService_StartDeviceFlow_Call) Run(run func(ctx context.Context)) *AuthnService_StartDeviceFlow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AuthnService_StartDeviceFlow_Call) Return(_a0 authenticate.DeviceAuthorization, _a1 error) *AuthnService_StartDeviceFlow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthnService_StartDeviceFlow_Call) RunAndReturn(run func(context.Context) (authenticate.DeviceAuthorization, error)) *AuthnService_StartDeviceFlow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartEmailChange provides a mock function with given fields: ctx, userID, email
+func (_m *AuthnService) StartEmailChange(ctx context.Context, userID string, email string) (*authenticate.Flow, error) {
+	ret := _m.Called(ctx, userID, email)
+
+	var r0 *authenticate.Flow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*authenticate.Flow, error)); ok {
+		return rf(ctx, userID, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *authenticate.Flow); ok {
+		r0 = rf(ctx, userID, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*authenticate.Flow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthnService_StartEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartEmailChange'
+type AuthnService_StartEmailChange_Call struct {
+	*mock.Call
+}
+
+// StartEmailChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - email string
+func (_e *AuthnService_Expecter) StartEmailChange(ctx interface{}, userID interface{}, email interface{}) *AuthnService_StartEmailChange_Call {
+	return &AuthnService_StartEmailChange_Call{Call: _e.mock.On("StartEmailChange", ctx, userID, email)}
+}
+
+func (_c *AuthnService_StartEmailChange_Call) Run(run func(ctx context.Context, userID string, email string)) *AuthnService_StartEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AuthnService_StartEmailChange_Call) Return(_a0 *authenticate.Flow, _a1 error) *AuthnService_StartEmailChange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthnService_StartEmailChange_Call) RunAndReturn(run func(context.Context, string, string) (*authenticate.Flow, error)) *AuthnService_StartEmailChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartFlow provides a mock function with given fields: ctx, request
 func (_m *AuthnService) StartFlow(ctx context.Context, request authenticate.RegistrationStartRequest) (*authenticate.RegistrationStartResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	deleter "github.com/raystack/frontier/core/deleter"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,8 +23,284 @@ func (_m *CascadeDeleter) EXPECT() *CascadeDeleter_Expecter {
 	return &CascadeDeleter_Expecter{mock: &_m.Mock}
 }
 
-// DeleteOrganization provides a mock function with given fields: ctx, id
-func (_m *CascadeDeleter) DeleteOrganization(ctx context.Context, id string) error {
+// CheckConfirmation provides a mock function with given fields: token, report
+func (_m *CascadeDeleter) CheckConfirmation(token string, report deleter.Report) error {
+	ret := _m.Called(token, report)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, deleter.Report) error); ok {
+		r0 = rf(token, report)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CascadeDeleter_CheckConfirmation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckConfirmation'
+type CascadeDeleter_CheckConfirmation_Call struct {
+	*mock.Call
+}
+
+// CheckConfirmation is a helper method to define mock.On call
+//   - token string
+//   - report deleter.Report
+func (_e *CascadeDeleter_Expecter) CheckConfirmation(token interface{}, report interface{}) *CascadeDeleter_CheckConfirmation_Call {
+	return &CascadeDeleter_CheckConfirmation_Call{Call: _e.mock.On("CheckConfirmation", token, report)}
+}
+
+func (_c *CascadeDeleter_CheckConfirmation_Call) Run(run func(token string, report deleter.Report)) *CascadeDeleter_CheckConfirmation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(deleter.Report))
+	})
+	return _c
+}
+
+func (_c *CascadeDeleter_CheckConfirmation_Call) Return(_a0 error) *CascadeDeleter_CheckConfirmation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CascadeDeleter_CheckConfirmation_Call) RunAndReturn(run func(string, deleter.Report) error) *CascadeDeleter_CheckConfirmation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfirmationRequired provides a mock function with given fields:
+func (_m *CascadeDeleter) ConfirmationRequired() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// CascadeDeleter_ConfirmationRequired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmationRequired'
+type CascadeDeleter_ConfirmationRequired_Call struct {
+	*mock.Call
+}
+
+// ConfirmationRequired is a helper method to define mock.On call
+func (_e *CascadeDeleter_Expecter) ConfirmationRequired() *CascadeDeleter_ConfirmationRequired_Call {
+	return &CascadeDeleter_ConfirmationRequired_Call{Call: _e.mock.On("ConfirmationRequired")}
+}
+
+func (_c *CascadeDeleter_ConfirmationRequired_Call) Run(run func()) *CascadeDeleter_ConfirmationRequired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CascadeDeleter_ConfirmationRequired_Call) Return(_a0 bool) *CascadeDeleter_ConfirmationRequired_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CascadeDeleter_ConfirmationRequired_Call) RunAndReturn(run func() bool) *CascadeDeleter_ConfirmationRequired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfirmationToken provides a mock function with given fields: report
+func (_m *CascadeDeleter) ConfirmationToken(report deleter.Report) string {
+	ret := _m.Called(report)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(deleter.Report) string); ok {
+		r0 = rf(report)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// CascadeDeleter_ConfirmationToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmationToken'
+type CascadeDeleter_ConfirmationToken_Call struct {
+	*mock.Call
+}
+
+// ConfirmationToken is a helper method to define mock.On call
+//   - report deleter.Report
+func (_e *CascadeDeleter_Expecter) ConfirmationToken(report interface{}) *CascadeDeleter_ConfirmationToken_Call {
+	return &CascadeDeleter_ConfirmationToken_Call{Call: _e.mock.On("ConfirmationToken", report)}
+}
+
+func (_c *CascadeDeleter_ConfirmationToken_Call) Run(run func(report deleter.Report)) *CascadeDeleter_ConfirmationToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(deleter.Report))
+	})
+	return _c
+}
+
+func (_c *CascadeDeleter_ConfirmationToken_Call) Return(_a0 string) *CascadeDeleter_ConfirmationToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CascadeDeleter_ConfirmationToken_Call) RunAndReturn(run func(deleter.Report) string) *CascadeDeleter_ConfirmationToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrganizationImpact provides a mock function with given fields: ctx, id
+func (_m *CascadeDeleter) OrganizationImpact(ctx context.Context, id string) (deleter.Report, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 deleter.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (deleter.Report, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) deleter.Report); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(deleter.Report)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CascadeDeleter_OrganizationImpact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrganizationImpact'
+type CascadeDeleter_OrganizationImpact_Call struct {
+	*mock.Call
+}
+
+// OrganizationImpact is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *CascadeDeleter_Expecter) OrganizationImpact(ctx interface{}, id interface{}) *CascadeDeleter_OrganizationImpact_Call {
+	return &CascadeDeleter_OrganizationImpact_Call{Call: _e.mock.On("OrganizationImpact", ctx, id)}
+}
+
+func (_c *CascadeDeleter_OrganizationImpact_Call) Run(run func(ctx context.Context, id string)) *CascadeDeleter_OrganizationImpact_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CascadeDeleter_OrganizationImpact_Call) Return(_a0 deleter.Report, _a1 error) *CascadeDeleter_OrganizationImpact_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CascadeDeleter_OrganizationImpact_Call) RunAndReturn(run func(context.Context, string) (deleter.Report, error)) *CascadeDeleter_OrganizationImpact_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProjectImpact provides a mock function with given fields: ctx, id
+func (_m *CascadeDeleter) ProjectImpact(ctx context.Context, id string) (deleter.Report, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 deleter.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (deleter.Report, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) deleter.Report); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(deleter.Report)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CascadeDeleter_ProjectImpact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProjectImpact'
+type CascadeDeleter_ProjectImpact_Call struct {
+	*mock.Call
+}
+
+// ProjectImpact is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *CascadeDeleter_Expecter) ProjectImpact(ctx interface{}, id interface{}) *CascadeDeleter_ProjectImpact_Call {
+	return &CascadeDeleter_ProjectImpact_Call{Call: _e.mock.On("ProjectImpact", ctx, id)}
+}
+
+func (_c *CascadeDeleter_ProjectImpact_Call) Run(run func(ctx context.Context, id string)) *CascadeDeleter_ProjectImpact_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CascadeDeleter_ProjectImpact_Call) Return(_a0 deleter.Report, _a1 error) *CascadeDeleter_ProjectImpact_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CascadeDeleter_ProjectImpact_Call) RunAndReturn(run func(context.Context, string) (deleter.Report, error)) *CascadeDeleter_ProjectImpact_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveUsersFromOrg provides a mock function with given fields: ctx, orgID, userIDs
+func (_m *CascadeDeleter) RemoveUsersFromOrg(ctx context.Context, orgID string, userIDs []string) error {
+	ret := _m.Called(ctx, orgID, userIDs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = rf(ctx, orgID, userIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CascadeDeleter_RemoveUsersFromOrg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUsersFromOrg'
+type CascadeDeleter_RemoveUsersFromOrg_Call struct {
+	*mock.Call
+}
+
+// RemoveUsersFromOrg is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID string
+//   - userIDs []string
+func (_e *CascadeDeleter_Expecter) RemoveUsersFromOrg(ctx interface{}, orgID interface{}, userIDs interface{}) *CascadeDeleter_RemoveUsersFromOrg_Call {
+	return &CascadeDeleter_RemoveUsersFromOrg_Call{Call: _e.mock.On("RemoveUsersFromOrg", ctx, orgID, userIDs)}
+}
+
+func (_c *CascadeDeleter_RemoveUsersFromOrg_Call) Run(run func(ctx context.Context, orgID string, userIDs []string)) *CascadeDeleter_RemoveUsersFromOrg_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *CascadeDeleter_RemoveUsersFromOrg_Call) Return(_a0 error) *CascadeDeleter_RemoveUsersFromOrg_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CascadeDeleter_RemoveUsersFromOrg_Call) RunAndReturn(run func(context.Context, string, []string) error) *CascadeDeleter_RemoveUsersFromOrg_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreOrganization provides a mock function with given fields: ctx, id
+func (_m *CascadeDeleter) RestoreOrganization(ctx context.Context, id string) error {
 	ret := _m.Called(ctx, id)
 
 	var r0 error
@@ -35,37 +313,37 @@ func (_m *CascadeDeleter) DeleteOrganization(ctx context.Context, id string) err
 	return r0
 }
 
-// CascadeDeleter_DeleteOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrganization'
-type CascadeDeleter_DeleteOrganization_Call struct {
+// CascadeDeleter_RestoreOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreOrganization'
+type CascadeDeleter_RestoreOrganization_Call struct {
 	*mock.Call
 }
 
-// DeleteOrganization is a helper method to define mock.On call
+// RestoreOrganization is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *CascadeDeleter_Expecter) DeleteOrganization(ctx interface{}, id interface{}) *CascadeDeleter_DeleteOrganization_Call {
-	return &CascadeDeleter_DeleteOrganization_Call{Call: _e.mock.On("DeleteOrganization", ctx, id)}
+func (_e *CascadeDeleter_Expecter) RestoreOrganization(ctx interface{}, id interface{}) *CascadeDeleter_RestoreOrganization_Call {
+	return &CascadeDeleter_RestoreOrganization_Call{Call: _e.mock.On("RestoreOrganization", ctx, id)}
 }
 
-func (_c *CascadeDeleter_DeleteOrganization_Call) Run(run func(ctx context.Context, id string)) *CascadeDeleter_DeleteOrganization_Call {
+func (_c *CascadeDeleter_RestoreOrganization_Call) Run(run func(ctx context.Context, id string)) *CascadeDeleter_RestoreOrganization_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *CascadeDeleter_DeleteOrganization_Call) Return(_a0 error) *CascadeDeleter_DeleteOrganization_Call {
+func (_c *CascadeDeleter_RestoreOrganization_Call) Return(_a0 error) *CascadeDeleter_RestoreOrganization_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *CascadeDeleter_DeleteOrganization_Call) RunAndReturn(run func(context.Context, string) error) *CascadeDeleter_DeleteOrganization_Call {
+func (_c *CascadeDeleter_RestoreOrganization_Call) RunAndReturn(run func(context.Context, string) error) *CascadeDeleter_RestoreOrganization_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteProject provides a mock function with given fields: ctx, id
-func (_m *CascadeDeleter) DeleteProject(ctx context.Context, id string) error {
+// RestoreProject provides a mock function with given fields: ctx, id
+func (_m *CascadeDeleter) RestoreProject(ctx context.Context, id string) error {
 	ret := _m.Called(ctx, id)
 
 	var r0 error
@@ -78,37 +356,37 @@ func (_m *CascadeDeleter) DeleteProject(ctx context.Context, id string) error {
 	return r0
 }
 
-// CascadeDeleter_DeleteProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProject'
-type CascadeDeleter_DeleteProject_Call struct {
+// CascadeDeleter_RestoreProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreProject'
+type CascadeDeleter_RestoreProject_Call struct {
 	*mock.Call
 }
 
-// DeleteProject is a helper method to define mock.On call
+// RestoreProject is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *CascadeDeleter_Expecter) DeleteProject(ctx interface{}, id interface{}) *CascadeDeleter_DeleteProject_Call {
-	return &CascadeDeleter_DeleteProject_Call{Call: _e.mock.On("DeleteProject", ctx, id)}
+func (_e *CascadeDeleter_Expecter) RestoreProject(ctx interface{}, id interface{}) *CascadeDeleter_RestoreProject_Call {
+	return &CascadeDeleter_RestoreProject_Call{Call: _e.mock.On("RestoreProject", ctx, id)}
 }
 
-func (_c *CascadeDeleter_DeleteProject_Call) Run(run func(ctx context.Context, id string)) *CascadeDeleter_DeleteProject_Call {
+func (_c *CascadeDeleter_RestoreProject_Call) Run(run func(ctx context.Context, id string)) *CascadeDeleter_RestoreProject_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *CascadeDeleter_DeleteProject_Call) Return(_a0 error) *CascadeDeleter_DeleteProject_Call {
+func (_c *CascadeDeleter_RestoreProject_Call) Return(_a0 error) *CascadeDeleter_RestoreProject_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *CascadeDeleter_DeleteProject_Call) RunAndReturn(run func(context.Context, string) error) *CascadeDeleter_DeleteProject_Call {
+func (_c *CascadeDeleter_RestoreProject_Call) RunAndReturn(run func(context.Context, string) error) *CascadeDeleter_RestoreProject_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteUser provides a mock function with given fields: ctx, userID
-func (_m *CascadeDeleter) DeleteUser(ctx context.Context, userID string) error {
+// RestoreUser provides a mock function with given fields: ctx, userID
+func (_m *CascadeDeleter) RestoreUser(ctx context.Context, userID string) error {
 	ret := _m.Called(ctx, userID)
 
 	var r0 error
@@ -121,42 +399,42 @@ func (_m *CascadeDeleter) DeleteUser(ctx context.Context, userID string) error {
 	return r0
 }
 
-// CascadeDeleter_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
-type CascadeDeleter_DeleteUser_Call struct {
+// CascadeDeleter_RestoreUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreUser'
+type CascadeDeleter_RestoreUser_Call struct {
 	*mock.Call
 }
 
-// DeleteUser is a helper method to define mock.On call
+// RestoreUser is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID string
-func (_e *CascadeDeleter_Expecter) DeleteUser(ctx interface{}, userID interface{}) *CascadeDeleter_DeleteUser_Call {
-	return &CascadeDeleter_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, userID)}
+func (_e *CascadeDeleter_Expecter) RestoreUser(ctx interface{}, userID interface{}) *CascadeDeleter_RestoreUser_Call {
+	return &CascadeDeleter_RestoreUser_Call{Call: _e.mock.On("RestoreUser", ctx, userID)}
 }
 
-func (_c *CascadeDeleter_DeleteUser_Call) Run(run func(ctx context.Context, userID string)) *CascadeDeleter_DeleteUser_Call {
+func (_c *CascadeDeleter_RestoreUser_Call) Run(run func(ctx context.Context, userID string)) *CascadeDeleter_RestoreUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *CascadeDeleter_DeleteUser_Call) Return(_a0 error) *CascadeDeleter_DeleteUser_Call {
+func (_c *CascadeDeleter_RestoreUser_Call) Return(_a0 error) *CascadeDeleter_RestoreUser_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *CascadeDeleter_DeleteUser_Call) RunAndReturn(run func(context.Context, string) error) *CascadeDeleter_DeleteUser_Call {
+func (_c *CascadeDeleter_RestoreUser_Call) RunAndReturn(run func(context.Context, string) error) *CascadeDeleter_RestoreUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveUsersFromOrg provides a mock function with given fields: ctx, orgID, userIDs
-func (_m *CascadeDeleter) RemoveUsersFromOrg(ctx context.Context, orgID string, userIDs []string) error {
-	ret := _m.Called(ctx, orgID, userIDs)
+// SoftDeleteOrganization provides a mock function with given fields: ctx, id
+func (_m *CascadeDeleter) SoftDeleteOrganization(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
-		r0 = rf(ctx, orgID, userIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -164,32 +442,170 @@ func (_m *CascadeDeleter) RemoveUsersFromOrg(ctx context.Context, orgID string,
 	return r0
 }
 
-// CascadeDeleter_RemoveUsersFromOrg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUsersFromOrg'
-type CascadeDeleter_RemoveUsersFromOrg_Call struct {
+// CascadeDeleter_SoftDeleteOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SoftDeleteOrganization'
+type CascadeDeleter_SoftDeleteOrganization_Call struct {
 	*mock.Call
 }
 
-// RemoveUsersFromOrg is a helper method to define mock.On call
+// SoftDeleteOrganization is a helper method to define mock.On call
 //   - ctx context.Context
-//   - orgID string
-//   - userIDs []string
-func (_e *CascadeDeleter_Expecter) RemoveUsersFromOrg(ctx interface{}, orgID interface{}, userIDs interface{}) *CascadeDeleter_RemoveUsersFromOrg_Call {
-	return &CascadeDeleter_RemoveUsersFromOrg_Call{Call: _e.mock.On("RemoveUsersFromOrg", ctx, orgID, userIDs)}
+//   - id string
+func (_e *CascadeDeleter_Expecter) SoftDeleteOrganization(ctx interface{}, id interface{}) *CascadeDeleter_SoftDeleteOrganization_Call {
+	return &CascadeDeleter_SoftDeleteOrganization_Call{Call: _e.mock.On("SoftDeleteOrganization", ctx, id)}
 }
 
-func (_c *CascadeDeleter_RemoveUsersFromOrg_Call) Run(run func(ctx context.Context, orgID string, userIDs []string)) *CascadeDeleter_RemoveUsersFromOrg_Call {
+func (_c *CascadeDeleter_SoftDeleteOrganization_Call) Run(run func(ctx context.Context, id string)) *CascadeDeleter_SoftDeleteOrganization_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *CascadeDeleter_RemoveUsersFromOrg_Call) Return(_a0 error) *CascadeDeleter_RemoveUsersFromOrg_Call {
+func (_c *CascadeDeleter_SoftDeleteOrganization_Call) Return(_a0 error) *CascadeDeleter_SoftDeleteOrganization_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *CascadeDeleter_RemoveUsersFromOrg_Call) RunAndReturn(run func(context.Context, string, []string) error) *CascadeDeleter_RemoveUsersFromOrg_Call {
+func (_c *CascadeDeleter_SoftDeleteOrganization_Call) RunAndReturn(run func(context.Context, string) error) *CascadeDeleter_SoftDeleteOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SoftDeleteProject provides a mock function with given fields: ctx, id
+func (_m *CascadeDeleter) SoftDeleteProject(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CascadeDeleter_SoftDeleteProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SoftDeleteProject'
+type CascadeDeleter_SoftDeleteProject_Call struct {
+	*mock.Call
+}
+
+// SoftDeleteProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *CascadeDeleter_Expecter) SoftDeleteProject(ctx interface{}, id interface{}) *CascadeDeleter_SoftDeleteProject_Call {
+	return &CascadeDeleter_SoftDeleteProject_Call{Call: _e.mock.On("SoftDeleteProject", ctx, id)}
+}
+
+func (_c *CascadeDeleter_SoftDeleteProject_Call) Run(run func(ctx context.Context, id string)) *CascadeDeleter_SoftDeleteProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CascadeDeleter_SoftDeleteProject_Call) Return(_a0 error) *CascadeDeleter_SoftDeleteProject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CascadeDeleter_SoftDeleteProject_Call) RunAndReturn(run func(context.Context, string) error) *CascadeDeleter_SoftDeleteProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SoftDeleteUser provides a mock function with given fields: ctx, userID
+func (_m *CascadeDeleter) SoftDeleteUser(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CascadeDeleter_SoftDeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SoftDeleteUser'
+type CascadeDeleter_SoftDeleteUser_Call struct {
+	*mock.Call
+}
+
+// SoftDeleteUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *CascadeDeleter_Expecter) SoftDeleteUser(ctx interface{}, userID interface{}) *CascadeDeleter_SoftDeleteUser_Call {
+	return &CascadeDeleter_SoftDeleteUser_Call{Call: _e.mock.On("SoftDeleteUser", ctx, userID)}
+}
+
+func (_c *CascadeDeleter_SoftDeleteUser_Call) Run(run func(ctx context.Context, userID string)) *CascadeDeleter_SoftDeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CascadeDeleter_SoftDeleteUser_Call) Return(_a0 error) *CascadeDeleter_SoftDeleteUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CascadeDeleter_SoftDeleteUser_Call) RunAndReturn(run func(context.Context, string) error) *CascadeDeleter_SoftDeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UserImpact provides a mock function with given fields: ctx, id
+func (_m *CascadeDeleter) UserImpact(ctx context.Context, id string) (deleter.Report, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 deleter.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (deleter.Report, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) deleter.Report); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(deleter.Report)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CascadeDeleter_UserImpact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserImpact'
+type CascadeDeleter_UserImpact_Call struct {
+	*mock.Call
+}
+
+// UserImpact is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *CascadeDeleter_Expecter) UserImpact(ctx interface{}, id interface{}) *CascadeDeleter_UserImpact_Call {
+	return &CascadeDeleter_UserImpact_Call{Call: _e.mock.On("UserImpact", ctx, id)}
+}
+
+func (_c *CascadeDeleter_UserImpact_Call) Run(run func(ctx context.Context, id string)) *CascadeDeleter_UserImpact_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CascadeDeleter_UserImpact_Call) Return(_a0 deleter.Report, _a1 error) *CascadeDeleter_UserImpact_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CascadeDeleter_UserImpact_Call) RunAndReturn(run func(context.Context, string) (deleter.Report, error)) *CascadeDeleter_UserImpact_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -325,6 +325,60 @@ func (_c *DomainService_ListJoinableOrgsByDomain_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// UpdateAutoJoin provides a mock function with given fields: ctx, id, autoJoin
+func (_m *DomainService) UpdateAutoJoin(ctx context.Context, id string, autoJoin domain.AutoJoin) (domain.Domain, error) {
+	ret := _m.Called(ctx, id, autoJoin)
+
+	var r0 domain.Domain
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.AutoJoin) (domain.Domain, error)); ok {
+		return rf(ctx, id, autoJoin)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.AutoJoin) domain.Domain); ok {
+		r0 = rf(ctx, id, autoJoin)
+	} else {
+		r0 = ret.Get(0).(domain.Domain)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.AutoJoin) error); ok {
+		r1 = rf(ctx, id, autoJoin)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DomainService_UpdateAutoJoin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAutoJoin'
+type DomainService_UpdateAutoJoin_Call struct {
+	*mock.Call
+}
+
+// UpdateAutoJoin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - autoJoin domain.AutoJoin
+func (_e *DomainService_Expecter) UpdateAutoJoin(ctx interface{}, id interface{}, autoJoin interface{}) *DomainService_UpdateAutoJoin_Call {
+	return &DomainService_UpdateAutoJoin_Call{Call: _e.mock.On("UpdateAutoJoin", ctx, id, autoJoin)}
+}
+
+func (_c *DomainService_UpdateAutoJoin_Call) Run(run func(ctx context.Context, id string, autoJoin domain.AutoJoin)) *DomainService_UpdateAutoJoin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(domain.AutoJoin))
+	})
+	return _c
+}
+
+func (_c *DomainService_UpdateAutoJoin_Call) Return(_a0 domain.Domain, _a1 error) *DomainService_UpdateAutoJoin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DomainService_UpdateAutoJoin_Call) RunAndReturn(run func(context.Context, string, domain.AutoJoin) (domain.Domain, error)) *DomainService_UpdateAutoJoin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // VerifyDomain provides a mock function with given fields: ctx, id
 func (_m *DomainService) VerifyDomain(ctx context.Context, id string) (domain.Domain, error) {
 	ret := _m.Called(ctx, id)
@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	group "github.com/raystack/frontier/core/group"
+	policy "github.com/raystack/frontier/core/policy"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -66,6 +68,49 @@ func (_c *GroupService_AddUsers_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
+// CheckPolicyDelete provides a mock function with given fields: ctx, pol
+func (_m *GroupService) CheckPolicyDelete(ctx context.Context, pol policy.Policy) error {
+	ret := _m.Called(ctx, pol)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, policy.Policy) error); ok {
+		r0 = rf(ctx, pol)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GroupService_CheckPolicyDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckPolicyDelete'
+type GroupService_CheckPolicyDelete_Call struct {
+	*mock.Call
+}
+
+// CheckPolicyDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pol policy.Policy
+func (_e *GroupService_Expecter) CheckPolicyDelete(ctx interface{}, pol interface{}) *GroupService_CheckPolicyDelete_Call {
+	return &GroupService_CheckPolicyDelete_Call{Call: _e.mock.On("CheckPolicyDelete", ctx, pol)}
+}
+
+func (_c *GroupService_CheckPolicyDelete_Call) Run(run func(ctx context.Context, pol policy.Policy)) *GroupService_CheckPolicyDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(policy.Policy))
+	})
+	return _c
+}
+
+func (_c *GroupService_CheckPolicyDelete_Call) Return(_a0 error) *GroupService_CheckPolicyDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GroupService_CheckPolicyDelete_Call) RunAndReturn(run func(context.Context, policy.Policy) error) *GroupService_CheckPolicyDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, grp
 func (_m *GroupService) Create(ctx context.Context, grp group.Group) (group.Group, error) {
 	ret := _m.Called(ctx, grp)
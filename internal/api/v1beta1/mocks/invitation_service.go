@@ -120,6 +120,102 @@ func (_c *InvitationService_Create_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// CreateLink provides a mock function with given fields: ctx, link
+func (_m *InvitationService) CreateLink(ctx context.Context, link invitation.Link) (invitation.Link, error) {
+	ret := _m.Called(ctx, link)
+
+	var r0 invitation.Link
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, invitation.Link) (invitation.Link, error)); ok {
+		return rf(ctx, link)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, invitation.Link) invitation.Link); ok {
+		r0 = rf(ctx, link)
+	} else {
+		r0 = ret.Get(0).(invitation.Link)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, invitation.Link) error); ok {
+		r1 = rf(ctx, link)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvitationService_CreateLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLink'
+type InvitationService_CreateLink_Call struct {
+	*mock.Call
+}
+
+// CreateLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - link invitation.Link
+func (_e *InvitationService_Expecter) CreateLink(ctx interface{}, link interface{}) *InvitationService_CreateLink_Call {
+	return &InvitationService_CreateLink_Call{Call: _e.mock.On("CreateLink", ctx, link)}
+}
+
+func (_c *InvitationService_CreateLink_Call) Run(run func(ctx context.Context, link invitation.Link)) *InvitationService_CreateLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(invitation.Link))
+	})
+	return _c
+}
+
+func (_c *InvitationService_CreateLink_Call) Return(_a0 invitation.Link, _a1 error) *InvitationService_CreateLink_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InvitationService_CreateLink_Call) RunAndReturn(run func(context.Context, invitation.Link) (invitation.Link, error)) *InvitationService_CreateLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Decline provides a mock function with given fields: ctx, id
+func (_m *InvitationService) Decline(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InvitationService_Decline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Decline'
+type InvitationService_Decline_Call struct {
+	*mock.Call
+}
+
+// Decline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *InvitationService_Expecter) Decline(ctx interface{}, id interface{}) *InvitationService_Decline_Call {
+	return &InvitationService_Decline_Call{Call: _e.mock.On("Decline", ctx, id)}
+}
+
+func (_c *InvitationService_Decline_Call) Run(run func(ctx context.Context, id uuid.UUID)) *InvitationService_Decline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InvitationService_Decline_Call) Return(_a0 error) *InvitationService_Decline_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InvitationService_Decline_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *InvitationService_Decline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: ctx, id
 func (_m *InvitationService) Delete(ctx context.Context, id uuid.UUID) error {
 	ret := _m.Called(ctx, id)
@@ -216,6 +312,51 @@ func (_c *InvitationService_Get_Call) RunAndReturn(run func(context.Context, uui
 	return _c
 }
 
+// JoinWithLink provides a mock function with given fields: ctx, orgID, token, userID
+func (_m *InvitationService) JoinWithLink(ctx context.Context, orgID string, token string, userID string) error {
+	ret := _m.Called(ctx, orgID, token, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, orgID, token, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InvitationService_JoinWithLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JoinWithLink'
+type InvitationService_JoinWithLink_Call struct {
+	*mock.Call
+}
+
+// JoinWithLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID string
+//   - token string
+//   - userID string
+func (_e *InvitationService_Expecter) JoinWithLink(ctx interface{}, orgID interface{}, token interface{}, userID interface{}) *InvitationService_JoinWithLink_Call {
+	return &InvitationService_JoinWithLink_Call{Call: _e.mock.On("JoinWithLink", ctx, orgID, token, userID)}
+}
+
+func (_c *InvitationService_JoinWithLink_Call) Run(run func(ctx context.Context, orgID string, token string, userID string)) *InvitationService_JoinWithLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *InvitationService_JoinWithLink_Call) Return(_a0 error) *InvitationService_JoinWithLink_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InvitationService_JoinWithLink_Call) RunAndReturn(run func(context.Context, string, string, string) error) *InvitationService_JoinWithLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // List provides a mock function with given fields: ctx, filter
 func (_m *InvitationService) List(ctx context.Context, filter invitation.Filter) ([]invitation.Invitation, error) {
 	ret := _m.Called(ctx, filter)
@@ -326,6 +467,148 @@ func (_c *InvitationService_ListByUser_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListLinks provides a mock function with given fields: ctx, orgID
+func (_m *InvitationService) ListLinks(ctx context.Context, orgID string) ([]invitation.Link, error) {
+	ret := _m.Called(ctx, orgID)
+
+	var r0 []invitation.Link
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]invitation.Link, error)); ok {
+		return rf(ctx, orgID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []invitation.Link); ok {
+		r0 = rf(ctx, orgID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]invitation.Link)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvitationService_ListLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLinks'
+type InvitationService_ListLinks_Call struct {
+	*mock.Call
+}
+
+// ListLinks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID string
+func (_e *InvitationService_Expecter) ListLinks(ctx interface{}, orgID interface{}) *InvitationService_ListLinks_Call {
+	return &InvitationService_ListLinks_Call{Call: _e.mock.On("ListLinks", ctx, orgID)}
+}
+
+func (_c *InvitationService_ListLinks_Call) Run(run func(ctx context.Context, orgID string)) *InvitationService_ListLinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InvitationService_ListLinks_Call) Return(_a0 []invitation.Link, _a1 error) *InvitationService_ListLinks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InvitationService_ListLinks_Call) RunAndReturn(run func(context.Context, string) ([]invitation.Link, error)) *InvitationService_ListLinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function with given fields: ctx, id
+func (_m *InvitationService) Revoke(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InvitationService_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type InvitationService_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *InvitationService_Expecter) Revoke(ctx interface{}, id interface{}) *InvitationService_Revoke_Call {
+	return &InvitationService_Revoke_Call{Call: _e.mock.On("Revoke", ctx, id)}
+}
+
+func (_c *InvitationService_Revoke_Call) Run(run func(ctx context.Context, id uuid.UUID)) *InvitationService_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InvitationService_Revoke_Call) Return(_a0 error) *InvitationService_Revoke_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InvitationService_Revoke_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *InvitationService_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeLink provides a mock function with given fields: ctx, orgID, id
+func (_m *InvitationService) RevokeLink(ctx context.Context, orgID string, id string) error {
+	ret := _m.Called(ctx, orgID, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, orgID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InvitationService_RevokeLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeLink'
+type InvitationService_RevokeLink_Call struct {
+	*mock.Call
+}
+
+// RevokeLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID string
+//   - id string
+func (_e *InvitationService_Expecter) RevokeLink(ctx interface{}, orgID interface{}, id interface{}) *InvitationService_RevokeLink_Call {
+	return &InvitationService_RevokeLink_Call{Call: _e.mock.On("RevokeLink", ctx, orgID, id)}
+}
+
+func (_c *InvitationService_RevokeLink_Call) Run(run func(ctx context.Context, orgID string, id string)) *InvitationService_RevokeLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *InvitationService_RevokeLink_Call) Return(_a0 error) *InvitationService_RevokeLink_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InvitationService_RevokeLink_Call) RunAndReturn(run func(context.Context, string, string) error) *InvitationService_RevokeLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewInvitationService creates a new instance of InvitationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewInvitationService(t interface {
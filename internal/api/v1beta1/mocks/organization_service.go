@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	organization "github.com/raystack/frontier/core/organization"
+	policy "github.com/raystack/frontier/core/policy"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -22,6 +24,61 @@ func (_m *OrganizationService) EXPECT() *OrganizationService_Expecter {
 	return &OrganizationService_Expecter{mock: &_m.Mock}
 }
 
+// AcceptTransfer provides a mock function with given fields: ctx, orgID, id, userID
+func (_m *OrganizationService) AcceptTransfer(ctx context.Context, orgID string, id string, userID string) (organization.Transfer, error) {
+	ret := _m.Called(ctx, orgID, id, userID)
+
+	var r0 organization.Transfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (organization.Transfer, error)); ok {
+		return rf(ctx, orgID, id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) organization.Transfer); ok {
+		r0 = rf(ctx, orgID, id, userID)
+	} else {
+		r0 = ret.Get(0).(organization.Transfer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, orgID, id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrganizationService_AcceptTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptTransfer'
+type OrganizationService_AcceptTransfer_Call struct {
+	*mock.Call
+}
+
+// AcceptTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID string
+//   - id string
+//   - userID string
+func (_e *OrganizationService_Expecter) AcceptTransfer(ctx interface{}, orgID interface{}, id interface{}, userID interface{}) *OrganizationService_AcceptTransfer_Call {
+	return &OrganizationService_AcceptTransfer_Call{Call: _e.mock.On("AcceptTransfer", ctx, orgID, id, userID)}
+}
+
+func (_c *OrganizationService_AcceptTransfer_Call) Run(run func(ctx context.Context, orgID string, id string, userID string)) *OrganizationService_AcceptTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *OrganizationService_AcceptTransfer_Call) Return(_a0 organization.Transfer, _a1 error) *OrganizationService_AcceptTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrganizationService_AcceptTransfer_Call) RunAndReturn(run func(context.Context, string, string, string) (organization.Transfer, error)) *OrganizationService_AcceptTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddUsers provides a mock function with given fields: ctx, orgID, userID
 func (_m *OrganizationService) AddUsers(ctx context.Context, orgID string, userID []string) error {
 	ret := _m.Called(ctx, orgID, userID)
@@ -66,6 +123,49 @@ func (_c *OrganizationService_AddUsers_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// CheckPolicyDelete provides a mock function with given fields: ctx, pol
+func (_m *OrganizationService) CheckPolicyDelete(ctx context.Context, pol policy.Policy) error {
+	ret := _m.Called(ctx, pol)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, policy.Policy) error); ok {
+		r0 = rf(ctx, pol)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrganizationService_CheckPolicyDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckPolicyDelete'
+type OrganizationService_CheckPolicyDelete_Call struct {
+	*mock.Call
+}
+
+// CheckPolicyDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pol policy.Policy
+func (_e *OrganizationService_Expecter) CheckPolicyDelete(ctx interface{}, pol interface{}) *OrganizationService_CheckPolicyDelete_Call {
+	return &OrganizationService_CheckPolicyDelete_Call{Call: _e.mock.On("CheckPolicyDelete", ctx, pol)}
+}
+
+func (_c *OrganizationService_CheckPolicyDelete_Call) Run(run func(ctx context.Context, pol policy.Policy)) *OrganizationService_CheckPolicyDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(policy.Policy))
+	})
+	return _c
+}
+
+func (_c *OrganizationService_CheckPolicyDelete_Call) Return(_a0 error) *OrganizationService_CheckPolicyDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationService_CheckPolicyDelete_Call) RunAndReturn(run func(context.Context, policy.Policy) error) *OrganizationService_CheckPolicyDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, org
 func (_m *OrganizationService) Create(ctx context.Context, org organization.Organization) (organization.Organization, error) {
 	ret := _m.Called(ctx, org)
@@ -119,6 +219,61 @@ func (_c *OrganizationService_Create_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// DeclineTransfer provides a mock function with given fields: ctx, orgID, id, userID
+func (_m *OrganizationService) DeclineTransfer(ctx context.Context, orgID string, id string, userID string) (organization.Transfer, error) {
+	ret := _m.Called(ctx, orgID, id, userID)
+
+	var r0 organization.Transfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (organization.Transfer, error)); ok {
+		return rf(ctx, orgID, id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) organization.Transfer); ok {
+		r0 = rf(ctx, orgID, id, userID)
+	} else {
+		r0 = ret.Get(0).(organization.Transfer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, orgID, id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrganizationService_DeclineTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeclineTransfer'
+type OrganizationService_DeclineTransfer_Call struct {
+	*mock.Call
+}
+
+// DeclineTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID string
+//   - id string
+//   - userID string
+func (_e *OrganizationService_Expecter) DeclineTransfer(ctx interface{}, orgID interface{}, id interface{}, userID interface{}) *OrganizationService_DeclineTransfer_Call {
+	return &OrganizationService_DeclineTransfer_Call{Call: _e.mock.On("DeclineTransfer", ctx, orgID, id, userID)}
+}
+
+func (_c *OrganizationService_DeclineTransfer_Call) Run(run func(ctx context.Context, orgID string, id string, userID string)) *OrganizationService_DeclineTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *OrganizationService_DeclineTransfer_Call) Return(_a0 organization.Transfer, _a1 error) *OrganizationService_DeclineTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrganizationService_DeclineTransfer_Call) RunAndReturn(run func(context.Context, string, string, string) (organization.Transfer, error)) *OrganizationService_DeclineTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Disable provides a mock function with given fields: ctx, id
 func (_m *OrganizationService) Disable(ctx context.Context, id string) error {
 	ret := _m.Called(ctx, id)
@@ -421,6 +576,61 @@ func (_c *OrganizationService_ListByUser_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// RequestTransfer provides a mock function with given fields: ctx, orgID, fromUserID, toUserID
+func (_m *OrganizationService) RequestTransfer(ctx context.Context, orgID string, fromUserID string, toUserID string) (organization.Transfer, error) {
+	ret := _m.Called(ctx, orgID, fromUserID, toUserID)
+
+	var r0 organization.Transfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (organization.Transfer, error)); ok {
+		return rf(ctx, orgID, fromUserID, toUserID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) organization.Transfer); ok {
+		r0 = rf(ctx, orgID, fromUserID, toUserID)
+	} else {
+		r0 = ret.Get(0).(organization.Transfer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, orgID, fromUserID, toUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrganizationService_RequestTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestTransfer'
+type OrganizationService_RequestTransfer_Call struct {
+	*mock.Call
+}
+
+// RequestTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID string
+//   - fromUserID string
+//   - toUserID string
+func (_e *OrganizationService_Expecter) RequestTransfer(ctx interface{}, orgID interface{}, fromUserID interface{}, toUserID interface{}) *OrganizationService_RequestTransfer_Call {
+	return &OrganizationService_RequestTransfer_Call{Call: _e.mock.On("RequestTransfer", ctx, orgID, fromUserID, toUserID)}
+}
+
+func (_c *OrganizationService_RequestTransfer_Call) Run(run func(ctx context.Context, orgID string, fromUserID string, toUserID string)) *OrganizationService_RequestTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *OrganizationService_RequestTransfer_Call) Return(_a0 organization.Transfer, _a1 error) *OrganizationService_RequestTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrganizationService_RequestTransfer_Call) RunAndReturn(run func(context.Context, string, string, string) (organization.Transfer, error)) *OrganizationService_RequestTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, toUpdate
 func (_m *OrganizationService) Update(ctx context.Context, toUpdate organization.Organization) (organization.Organization, error) {
 	ret := _m.Called(ctx, toUpdate)
@@ -498,6 +498,60 @@ func (_c *ProjectService_ListUsers_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// Move provides a mock function with given fields: ctx, id, folderID
+func (_m *ProjectService) Move(ctx context.Context, id string, folderID string) (project.Project, error) {
+	ret := _m.Called(ctx, id, folderID)
+
+	var r0 project.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (project.Project, error)); ok {
+		return rf(ctx, id, folderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) project.Project); ok {
+		r0 = rf(ctx, id, folderID)
+	} else {
+		r0 = ret.Get(0).(project.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, folderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectService_Move_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Move'
+type ProjectService_Move_Call struct {
+	*mock.Call
+}
+
+// Move is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - folderID string
+func (_e *ProjectService_Expecter) Move(ctx interface{}, id interface{}, folderID interface{}) *ProjectService_Move_Call {
+	return &ProjectService_Move_Call{Call: _e.mock.On("Move", ctx, id, folderID)}
+}
+
+func (_c *ProjectService_Move_Call) Run(run func(ctx context.Context, id string, folderID string)) *ProjectService_Move_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ProjectService_Move_Call) Return(_a0 project.Project, _a1 error) *ProjectService_Move_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectService_Move_Call) RunAndReturn(run func(context.Context, string, string) (project.Project, error)) *ProjectService_Move_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, toUpdate
 func (_m *ProjectService) Update(ctx context.Context, toUpdate project.Project) (project.Project, error) {
 	ret := _m.Called(ctx, toUpdate)
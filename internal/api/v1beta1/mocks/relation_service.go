@@ -171,25 +171,25 @@ func (_c *RelationService_Get_Call) RunAndReturn(run func(context.Context, strin
 	return _c
 }
 
-// List provides a mock function with given fields: ctx
-func (_m *RelationService) List(ctx context.Context) ([]relation.Relation, error) {
-	ret := _m.Called(ctx)
+// List provides a mock function with given fields: ctx, flt
+func (_m *RelationService) List(ctx context.Context, flt relation.Filter) ([]relation.Relation, error) {
+	ret := _m.Called(ctx, flt)
 
 	var r0 []relation.Relation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]relation.Relation, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, relation.Filter) ([]relation.Relation, error)); ok {
+		return rf(ctx, flt)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []relation.Relation); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, relation.Filter) []relation.Relation); ok {
+		r0 = rf(ctx, flt)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]relation.Relation)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, relation.Filter) error); ok {
+		r1 = rf(ctx, flt)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -204,13 +204,14 @@ type RelationService_List_Call struct {
 
 // List is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *RelationService_Expecter) List(ctx interface{}) *RelationService_List_Call {
-	return &RelationService_List_Call{Call: _e.mock.On("List", ctx)}
+//   - flt relation.Filter
+func (_e *RelationService_Expecter) List(ctx interface{}, flt interface{}) *RelationService_List_Call {
+	return &RelationService_List_Call{Call: _e.mock.On("List", ctx, flt)}
 }
 
-func (_c *RelationService_List_Call) Run(run func(ctx context.Context)) *RelationService_List_Call {
+func (_c *RelationService_List_Call) Run(run func(ctx context.Context, flt relation.Filter)) *RelationService_List_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(relation.Filter))
 	})
 	return _c
 }
@@ -220,7 +221,7 @@ func (_c *RelationService_List_Call) Return(_a0 []relation.Relation, _a1 error)
 	return _c
 }
 
-func (_c *RelationService_List_Call) RunAndReturn(run func(context.Context) ([]relation.Relation, error)) *RelationService_List_Call {
+func (_c *RelationService_List_Call) RunAndReturn(run func(context.Context, relation.Filter) ([]relation.Relation, error)) *RelationService_List_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -9,6 +9,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	resource "github.com/raystack/frontier/core/resource"
+
+	serviceuser "github.com/raystack/frontier/core/serviceuser"
 )
 
 // ResourceService is an autogenerated mock type for the ResourceService type
@@ -337,6 +339,61 @@ func (_c *ResourceService_List_Call) RunAndReturn(run func(context.Context, reso
 	return _c
 }
 
+// ScopeAllows provides a mock function with given fields: ctx, scope, obj, permissionName
+func (_m *ResourceService) ScopeAllows(ctx context.Context, scope serviceuser.Scope, obj relation.Object, permissionName string) (bool, error) {
+	ret := _m.Called(ctx, scope, obj, permissionName)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, serviceuser.Scope, relation.Object, string) (bool, error)); ok {
+		return rf(ctx, scope, obj, permissionName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, serviceuser.Scope, relation.Object, string) bool); ok {
+		r0 = rf(ctx, scope, obj, permissionName)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, serviceuser.Scope, relation.Object, string) error); ok {
+		r1 = rf(ctx, scope, obj, permissionName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_ScopeAllows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScopeAllows'
+type ResourceService_ScopeAllows_Call struct {
+	*mock.Call
+}
+
+// ScopeAllows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scope serviceuser.Scope
+//   - obj relation.Object
+//   - permissionName string
+func (_e *ResourceService_Expecter) ScopeAllows(ctx interface{}, scope interface{}, obj interface{}, permissionName interface{}) *ResourceService_ScopeAllows_Call {
+	return &ResourceService_ScopeAllows_Call{Call: _e.mock.On("ScopeAllows", ctx, scope, obj, permissionName)}
+}
+
+func (_c *ResourceService_ScopeAllows_Call) Run(run func(ctx context.Context, scope serviceuser.Scope, obj relation.Object, permissionName string)) *ResourceService_ScopeAllows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(serviceuser.Scope), args[2].(relation.Object), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ResourceService_ScopeAllows_Call) Return(_a0 bool, _a1 error) *ResourceService_ScopeAllows_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_ScopeAllows_Call) RunAndReturn(run func(context.Context, serviceuser.Scope, relation.Object, string) (bool, error)) *ResourceService_ScopeAllows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, _a1
 func (_m *ResourceService) Update(ctx context.Context, _a1 resource.Resource) (resource.Resource, error) {
 	ret := _m.Called(ctx, _a1)
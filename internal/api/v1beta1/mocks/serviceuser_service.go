@@ -181,6 +181,59 @@ func (_c *ServiceUserService_CreateSecret_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// CreateTrust provides a mock function with given fields: ctx, trust
+func (_m *ServiceUserService) CreateTrust(ctx context.Context, trust serviceuser.Trust) (serviceuser.Trust, error) {
+	ret := _m.Called(ctx, trust)
+
+	var r0 serviceuser.Trust
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, serviceuser.Trust) (serviceuser.Trust, error)); ok {
+		return rf(ctx, trust)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, serviceuser.Trust) serviceuser.Trust); ok {
+		r0 = rf(ctx, trust)
+	} else {
+		r0 = ret.Get(0).(serviceuser.Trust)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, serviceuser.Trust) error); ok {
+		r1 = rf(ctx, trust)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceUserService_CreateTrust_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTrust'
+type ServiceUserService_CreateTrust_Call struct {
+	*mock.Call
+}
+
+// CreateTrust is a helper method to define mock.On call
+//   - ctx context.Context
+//   - trust serviceuser.Trust
+func (_e *ServiceUserService_Expecter) CreateTrust(ctx interface{}, trust interface{}) *ServiceUserService_CreateTrust_Call {
+	return &ServiceUserService_CreateTrust_Call{Call: _e.mock.On("CreateTrust", ctx, trust)}
+}
+
+func (_c *ServiceUserService_CreateTrust_Call) Run(run func(ctx context.Context, trust serviceuser.Trust)) *ServiceUserService_CreateTrust_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(serviceuser.Trust))
+	})
+	return _c
+}
+
+func (_c *ServiceUserService_CreateTrust_Call) Return(_a0 serviceuser.Trust, _a1 error) *ServiceUserService_CreateTrust_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceUserService_CreateTrust_Call) RunAndReturn(run func(context.Context, serviceuser.Trust) (serviceuser.Trust, error)) *ServiceUserService_CreateTrust_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: ctx, id
 func (_m *ServiceUserService) Delete(ctx context.Context, id string) error {
 	ret := _m.Called(ctx, id)
@@ -310,6 +363,49 @@ func (_c *ServiceUserService_DeleteSecret_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// DeleteTrust provides a mock function with given fields: ctx, id
+func (_m *ServiceUserService) DeleteTrust(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceUserService_DeleteTrust_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTrust'
+type ServiceUserService_DeleteTrust_Call struct {
+	*mock.Call
+}
+
+// DeleteTrust is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ServiceUserService_Expecter) DeleteTrust(ctx interface{}, id interface{}) *ServiceUserService_DeleteTrust_Call {
+	return &ServiceUserService_DeleteTrust_Call{Call: _e.mock.On("DeleteTrust", ctx, id)}
+}
+
+func (_c *ServiceUserService_DeleteTrust_Call) Run(run func(ctx context.Context, id string)) *ServiceUserService_DeleteTrust_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceUserService_DeleteTrust_Call) Return(_a0 error) *ServiceUserService_DeleteTrust_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceUserService_DeleteTrust_Call) RunAndReturn(run func(context.Context, string) error) *ServiceUserService_DeleteTrust_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: ctx, id
 func (_m *ServiceUserService) Get(ctx context.Context, id string) (serviceuser.ServiceUser, error) {
 	ret := _m.Called(ctx, id)
@@ -363,6 +459,67 @@ func (_c *ServiceUserService_Get_Call) RunAndReturn(run func(context.Context, st
 	return _c
 }
 
+// GetByExternalToken provides a mock function with given fields: ctx, token, serviceUserID
+func (_m *ServiceUserService) GetByExternalToken(ctx context.Context, token string, serviceUserID string) (serviceuser.ServiceUser, serviceuser.Trust, error) {
+	ret := _m.Called(ctx, token, serviceUserID)
+
+	var r0 serviceuser.ServiceUser
+	var r1 serviceuser.Trust
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (serviceuser.ServiceUser, serviceuser.Trust, error)); ok {
+		return rf(ctx, token, serviceUserID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) serviceuser.ServiceUser); ok {
+		r0 = rf(ctx, token, serviceUserID)
+	} else {
+		r0 = ret.Get(0).(serviceuser.ServiceUser)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) serviceuser.Trust); ok {
+		r1 = rf(ctx, token, serviceUserID)
+	} else {
+		r1 = ret.Get(1).(serviceuser.Trust)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, token, serviceUserID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ServiceUserService_GetByExternalToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByExternalToken'
+type ServiceUserService_GetByExternalToken_Call struct {
+	*mock.Call
+}
+
+// GetByExternalToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - serviceUserID string
+func (_e *ServiceUserService_Expecter) GetByExternalToken(ctx interface{}, token interface{}, serviceUserID interface{}) *ServiceUserService_GetByExternalToken_Call {
+	return &ServiceUserService_GetByExternalToken_Call{Call: _e.mock.On("GetByExternalToken", ctx, token, serviceUserID)}
+}
+
+func (_c *ServiceUserService_GetByExternalToken_Call) Run(run func(ctx context.Context, token string, serviceUserID string)) *ServiceUserService_GetByExternalToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceUserService_GetByExternalToken_Call) Return(_a0 serviceuser.ServiceUser, _a1 serviceuser.Trust, _a2 error) *ServiceUserService_GetByExternalToken_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ServiceUserService_GetByExternalToken_Call) RunAndReturn(run func(context.Context, string, string) (serviceuser.ServiceUser, serviceuser.Trust, error)) *ServiceUserService_GetByExternalToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetKey provides a mock function with given fields: ctx, credID
 func (_m *ServiceUserService) GetKey(ctx context.Context, credID string) (serviceuser.Credential, error) {
 	ret := _m.Called(ctx, credID)
@@ -416,6 +573,59 @@ func (_c *ServiceUserService_GetKey_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetTrust provides a mock function with given fields: ctx, id
+func (_m *ServiceUserService) GetTrust(ctx context.Context, id string) (serviceuser.Trust, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 serviceuser.Trust
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (serviceuser.Trust, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) serviceuser.Trust); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(serviceuser.Trust)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceUserService_GetTrust_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrust'
+type ServiceUserService_GetTrust_Call struct {
+	*mock.Call
+}
+
+// GetTrust is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ServiceUserService_Expecter) GetTrust(ctx interface{}, id interface{}) *ServiceUserService_GetTrust_Call {
+	return &ServiceUserService_GetTrust_Call{Call: _e.mock.On("GetTrust", ctx, id)}
+}
+
+func (_c *ServiceUserService_GetTrust_Call) Run(run func(ctx context.Context, id string)) *ServiceUserService_GetTrust_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceUserService_GetTrust_Call) Return(_a0 serviceuser.Trust, _a1 error) *ServiceUserService_GetTrust_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceUserService_GetTrust_Call) RunAndReturn(run func(context.Context, string) (serviceuser.Trust, error)) *ServiceUserService_GetTrust_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // List provides a mock function with given fields: ctx, flt
 func (_m *ServiceUserService) List(ctx context.Context, flt serviceuser.Filter) ([]serviceuser.ServiceUser, error) {
 	ret := _m.Called(ctx, flt)
@@ -636,6 +846,61 @@ func (_c *ServiceUserService_ListSecret_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// ListTrusts provides a mock function with given fields: ctx, serviceUserID
+func (_m *ServiceUserService) ListTrusts(ctx context.Context, serviceUserID string) ([]serviceuser.Trust, error) {
+	ret := _m.Called(ctx, serviceUserID)
+
+	var r0 []serviceuser.Trust
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]serviceuser.Trust, error)); ok {
+		return rf(ctx, serviceUserID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []serviceuser.Trust); ok {
+		r0 = rf(ctx, serviceUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]serviceuser.Trust)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serviceUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceUserService_ListTrusts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTrusts'
+type ServiceUserService_ListTrusts_Call struct {
+	*mock.Call
+}
+
+// ListTrusts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceUserID string
+func (_e *ServiceUserService_Expecter) ListTrusts(ctx interface{}, serviceUserID interface{}) *ServiceUserService_ListTrusts_Call {
+	return &ServiceUserService_ListTrusts_Call{Call: _e.mock.On("ListTrusts", ctx, serviceUserID)}
+}
+
+func (_c *ServiceUserService_ListTrusts_Call) Run(run func(ctx context.Context, serviceUserID string)) *ServiceUserService_ListTrusts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceUserService_ListTrusts_Call) Return(_a0 []serviceuser.Trust, _a1 error) *ServiceUserService_ListTrusts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceUserService_ListTrusts_Call) RunAndReturn(run func(context.Context, string) ([]serviceuser.Trust, error)) *ServiceUserService_ListTrusts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewServiceUserService creates a new instance of ServiceUserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewServiceUserService(t interface {
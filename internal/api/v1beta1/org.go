@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/raystack/frontier/core/audit"
+	"github.com/raystack/frontier/core/job"
+	"github.com/raystack/frontier/core/membership"
 	"github.com/raystack/frontier/pkg/utils"
 
 	"github.com/raystack/frontier/internal/bootstrap/schema"
@@ -17,6 +19,7 @@ import (
 	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 
 	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/policy"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -41,18 +44,27 @@ type OrganizationService interface {
 	AddUsers(ctx context.Context, orgID string, userID []string) error
 	Enable(ctx context.Context, id string) error
 	Disable(ctx context.Context, id string) error
+	RequestTransfer(ctx context.Context, orgID, fromUserID, toUserID string) (organization.Transfer, error)
+	AcceptTransfer(ctx context.Context, orgID, id, userID string) (organization.Transfer, error)
+	DeclineTransfer(ctx context.Context, orgID, id, userID string) (organization.Transfer, error)
+	CheckPolicyDelete(ctx context.Context, pol policy.Policy) error
 }
 
 func (h Handler) ListOrganizations(ctx context.Context, request *frontierv1beta1.ListOrganizationsRequest) (*frontierv1beta1.ListOrganizationsResponse, error) {
 	logger := grpczap.Extract(ctx)
 	var orgs []*frontierv1beta1.Organization
+	page, err := paginationFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
 	orgList, err := h.orgService.List(ctx, organization.Filter{
-		State:  organization.State(request.GetState()),
-		UserID: request.GetUserId(),
+		State:      organization.State(request.GetState()),
+		UserID:     request.GetUserId(),
+		Pagination: page,
 	})
 	if err != nil {
 		logger.Error(err.Error())
-		return nil, grpcInternalServerError
+		return nil, grpcListErr(err)
 	}
 
 	for _, v := range orgList {
@@ -67,6 +79,7 @@ func (h Handler) ListOrganizations(ctx context.Context, request *frontierv1beta1
 
 	return &frontierv1beta1.ListOrganizationsResponse{
 		Organizations: orgs,
+		NextPageToken: page.NextPageToken(),
 	}, nil
 }
 
@@ -74,13 +87,19 @@ func (h Handler) ListAllOrganizations(ctx context.Context, request *frontierv1be
 	logger := grpczap.Extract(ctx)
 
 	var orgs []*frontierv1beta1.Organization
+	page, err := paginationFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
 	orgList, err := h.orgService.List(ctx, organization.Filter{
-		State:  organization.State(request.GetState()),
-		UserID: request.GetUserId(),
+		State:          organization.State(request.GetState()),
+		IncludeDeleted: request.GetIncludeDeleted(),
+		UserID:         request.GetUserId(),
+		Pagination:     page,
 	})
 	if err != nil {
 		logger.Error(err.Error())
-		return nil, grpcInternalServerError
+		return nil, grpcListErr(err)
 	}
 
 	for _, v := range orgList {
@@ -95,6 +114,7 @@ func (h Handler) ListAllOrganizations(ctx context.Context, request *frontierv1be
 
 	return &frontierv1beta1.ListAllOrganizationsResponse{
 		Organizations: orgs,
+		NextPageToken: page.NextPageToken(),
 	}, nil
 }
 
@@ -273,10 +293,22 @@ func (h Handler) ListOrganizationUsers(ctx context.Context, request *frontierv1b
 		permissionFilter = request.GetPermissionFilter()
 	}
 
-	users, err := h.userService.ListByOrg(ctx, orgResp.ID, permissionFilter)
+	page, err := paginationFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	var users []user.User
+	if permissionFilter == schema.MembershipPermission {
+		users, err = h.userService.List(ctx, user.Filter{
+			OrgID:      orgResp.ID,
+			Pagination: page,
+		})
+	} else {
+		users, err = h.userService.ListByOrg(ctx, orgResp.ID, permissionFilter)
+	}
 	if err != nil {
 		logger.Error(err.Error())
-		return nil, grpcInternalServerError
+		return nil, grpcListErr(err)
 	}
 
 	var usersPB []*frontierv1beta1.User
@@ -290,7 +322,10 @@ func (h Handler) ListOrganizationUsers(ctx context.Context, request *frontierv1b
 		usersPB = append(usersPB, u)
 	}
 
-	return &frontierv1beta1.ListOrganizationUsersResponse{Users: usersPB}, nil
+	return &frontierv1beta1.ListOrganizationUsersResponse{
+		Users:         usersPB,
+		NextPageToken: page.NextPageToken(),
+	}, nil
 }
 
 func (h Handler) ListOrganizationServiceUsers(ctx context.Context, request *frontierv1beta1.ListOrganizationServiceUsersRequest) (*frontierv1beta1.ListOrganizationServiceUsersResponse, error) {
@@ -342,12 +377,17 @@ func (h Handler) ListOrganizationProjects(ctx context.Context, request *frontier
 		}
 	}
 
+	page, err := paginationFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
 	projects, err := h.projectService.List(ctx, project.Filter{
-		OrgID: orgResp.ID,
+		OrgID:      orgResp.ID,
+		Pagination: page,
 	})
 	if err != nil {
 		logger.Error(err.Error())
-		return nil, grpcInternalServerError
+		return nil, grpcListErr(err)
 	}
 
 	var projectPB []*frontierv1beta1.Project
@@ -361,7 +401,10 @@ func (h Handler) ListOrganizationProjects(ctx context.Context, request *frontier
 		projectPB = append(projectPB, u)
 	}
 
-	return &frontierv1beta1.ListOrganizationProjectsResponse{Projects: projectPB}, nil
+	return &frontierv1beta1.ListOrganizationProjectsResponse{
+		Projects:      projectPB,
+		NextPageToken: page.NextPageToken(),
+	}, nil
 }
 
 func (h Handler) AddOrganizationUsers(ctx context.Context, request *frontierv1beta1.AddOrganizationUsersRequest) (*frontierv1beta1.AddOrganizationUsersResponse, error) {
@@ -379,6 +422,16 @@ func (h Handler) AddOrganizationUsers(ctx context.Context, request *frontierv1be
 		}
 	}
 
+	if request.GetAsync() {
+		jobID, err := h.submitBulkJob(ctx, func(createdBy string) job.Job {
+			return membership.NewAddOrganizationUsersJob(orgResp.ID, request.GetUserIds(), request.GetCreateMissingUsers(), createdBy)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &frontierv1beta1.AddOrganizationUsersResponse{JobId: jobID}, nil
+	}
+
 	for _, userID := range request.GetUserIds() {
 		audit.GetAuditor(ctx, orgResp.ID).Log(audit.OrgMemberCreatedEvent, audit.UserTarget(userID))
 	}
@@ -416,6 +469,9 @@ func (h Handler) RemoveOrganizationUser(ctx context.Context, request *frontierv1
 
 	if err := h.deleterService.RemoveUsersFromOrg(ctx, orgResp.ID, []string{request.GetUserId()}); err != nil {
 		logger.Error(err.Error())
+		if ownerErr := lastOwnerError(err); ownerErr != nil {
+			return nil, ownerErr
+		}
 		return nil, grpcInternalServerError
 	}
 	audit.GetAuditor(ctx, orgResp.ID).Log(audit.OrgMemberDeletedEvent, audit.UserTarget(request.GetUserId()))
@@ -426,6 +482,9 @@ func (h Handler) EnableOrganization(ctx context.Context, request *frontierv1beta
 	logger := grpczap.Extract(ctx)
 	if err := h.orgService.Enable(ctx, request.GetId()); err != nil {
 		logger.Error(err.Error())
+		if errors.Is(err, organization.ErrNotExist) {
+			return nil, grpcOrgNotFoundErr
+		}
 		return nil, grpcInternalServerError
 	}
 	return &frontierv1beta1.EnableOrganizationResponse{}, nil
@@ -435,6 +494,9 @@ func (h Handler) DisableOrganization(ctx context.Context, request *frontierv1bet
 	logger := grpczap.Extract(ctx)
 	if err := h.orgService.Disable(ctx, request.GetId()); err != nil {
 		logger.Error(err.Error())
+		if errors.Is(err, organization.ErrNotExist) {
+			return nil, grpcOrgNotFoundErr
+		}
 		return nil, grpcInternalServerError
 	}
 	return &frontierv1beta1.DisableOrganizationResponse{}, nil
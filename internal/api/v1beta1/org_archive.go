@@ -0,0 +1,83 @@
+package v1beta1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+
+	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/raystack/frontier/core/archive"
+	"github.com/raystack/frontier/core/audit"
+	"github.com/raystack/frontier/core/organization"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ArchiveService interface {
+	Export(ctx context.Context, orgIDOrName string) (archive.Archive, error)
+	Import(ctx context.Context, a archive.Archive) (archive.Report, error)
+}
+
+func (h Handler) ExportOrganization(ctx context.Context, request *frontierv1beta1.ExportOrganizationRequest) (*frontierv1beta1.ExportOrganizationResponse, error) {
+	logger := grpczap.Extract(ctx)
+	format := request.GetFormat()
+	if format == "" {
+		format = archive.FormatJSON
+	}
+
+	orgArchive, err := h.archiveService.Export(ctx, request.GetId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, organization.ErrNotExist), errors.Is(err, organization.ErrInvalidUUID):
+			return nil, grpcOrgNotFoundErr
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	if err := archive.Write(buf, orgArchive, format); err != nil {
+		logger.Error(err.Error())
+		if errors.Is(err, archive.ErrInvalidFormat) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, grpcInternalServerError
+	}
+	audit.GetAuditor(ctx, orgArchive.Organization.ID).Log(audit.OrgExportedEvent, audit.OrgTarget(orgArchive.Organization.ID))
+	return &frontierv1beta1.ExportOrganizationResponse{Archive: buf.Bytes()}, nil
+}
+
+func (h Handler) ImportOrganization(ctx context.Context, request *frontierv1beta1.ImportOrganizationRequest) (*frontierv1beta1.ImportOrganizationResponse, error) {
+	logger := grpczap.Extract(ctx)
+	orgArchive, err := archive.Read(bytes.NewReader(request.GetArchive()))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	report, err := h.archiveService.Import(ctx, orgArchive)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var stats []*frontierv1beta1.ImportOrganizationStats
+	attrs := map[string]string{}
+	for _, kind := range archive.Kinds {
+		s := report.Stats[kind]
+		stats = append(stats, &frontierv1beta1.ImportOrganizationStats{
+			Kind:     kind,
+			Created:  int32(s.Created),
+			Existing: int32(s.Existing),
+			Skipped:  int32(s.Skipped),
+		})
+		attrs[kind] = strconv.Itoa(s.Created)
+	}
+	audit.GetAuditor(ctx, report.OrganizationID).LogWithAttrs(audit.OrgImportedEvent, audit.OrgTarget(report.OrganizationID), attrs)
+	return &frontierv1beta1.ImportOrganizationResponse{
+		OrgId: report.OrganizationID,
+		Stats: stats,
+	}, nil
+}
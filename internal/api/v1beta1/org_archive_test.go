@@ -0,0 +1,88 @@
+package v1beta1
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/raystack/frontier/core/archive"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/internal/api/v1beta1/mocks"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHandler_ExportOrganization(t *testing.T) {
+	testArchive := archive.Archive{
+		Version:      archive.Version,
+		Organization: archive.Organization{ID: testOrgID, Name: "acme"},
+	}
+	tests := []struct {
+		name     string
+		setup    func(as *mocks.ArchiveService)
+		request  *frontierv1beta1.ExportOrganizationRequest
+		wantCode codes.Code
+	}{
+		{
+			name: "should return the archive in json by default",
+			setup: func(as *mocks.ArchiveService) {
+				as.EXPECT().Export(mock.Anything, "acme").Return(testArchive, nil)
+			},
+			request:  &frontierv1beta1.ExportOrganizationRequest{Id: "acme"},
+			wantCode: codes.OK,
+		},
+		{
+			name: "should return not found if org doesn't exist",
+			setup: func(as *mocks.ArchiveService) {
+				as.EXPECT().Export(mock.Anything, "acme").Return(archive.Archive{}, organization.ErrNotExist)
+			},
+			request:  &frontierv1beta1.ExportOrganizationRequest{Id: "acme"},
+			wantCode: codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			as := new(mocks.ArchiveService)
+			tt.setup(as)
+			h := Handler{archiveService: as}
+			got, err := h.ExportOrganization(context.Background(), tt.request)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				a, err := archive.Read(bytes.NewReader(got.GetArchive()))
+				assert.NoError(t, err)
+				assert.Equal(t, testArchive, a)
+			}
+		})
+	}
+}
+
+func TestHandler_ImportOrganization(t *testing.T) {
+	t.Run("should reject an invalid archive", func(t *testing.T) {
+		h := Handler{archiveService: new(mocks.ArchiveService)}
+		_, err := h.ImportOrganization(context.Background(), &frontierv1beta1.ImportOrganizationRequest{
+			Archive: []byte(`{"version": 2, "organization": {"name": "acme"}}`),
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("should return stats of each kind", func(t *testing.T) {
+		as := new(mocks.ArchiveService)
+		stats := map[string]*archive.Stats{}
+		for _, kind := range archive.Kinds {
+			stats[kind] = &archive.Stats{}
+		}
+		stats[archive.KindUser].Created = 2
+		as.EXPECT().Import(mock.Anything, mock.AnythingOfType("archive.Archive")).
+			Return(archive.Report{OrganizationID: testOrgID, Stats: stats}, nil)
+		h := Handler{archiveService: as}
+		got, err := h.ImportOrganization(context.Background(), &frontierv1beta1.ImportOrganizationRequest{
+			Archive: []byte(`{"version": 1, "organization": {"name": "acme"}}`),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, testOrgID, got.GetOrgId())
+		assert.Len(t, got.GetStats(), len(archive.Kinds))
+		assert.Equal(t, int32(2), got.GetStats()[1].GetCreated())
+	})
+}
@@ -56,7 +56,7 @@ func TestHandler_ListOrganization(t *testing.T) {
 		{
 			title: "should return internal error if org service return some error",
 			setup: func(os *mocks.OrganizationService) {
-				os.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), organization.Filter{}).Return([]organization.Organization{}, errors.New("some error"))
+				os.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), organization.Filter{Pagination: defaultPage()}).Return([]organization.Organization{}, errors.New("some error"))
 			},
 			want: nil,
 			err:  status.Errorf(codes.Internal, ErrInternalServer.Error()),
@@ -68,7 +68,7 @@ func TestHandler_ListOrganization(t *testing.T) {
 				for _, o := range testOrgMap {
 					testOrgList = append(testOrgList, o)
 				}
-				os.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), organization.Filter{}).Return(testOrgList, nil)
+				os.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), organization.Filter{Pagination: defaultPage()}).Return(testOrgList, nil)
 			},
 			want: &frontierv1beta1.ListOrganizationsResponse{Organizations: []*frontierv1beta1.Organization{
 				{
@@ -706,7 +706,7 @@ func TestHandler_ListOrganizationUsers(t *testing.T) {
 				for _, u := range testUserMap {
 					testUserList = append(testUserList, u)
 				}
-				us.EXPECT().ListByOrg(mock.AnythingOfType("context.backgroundCtx"), testOrgID, "membership").Return(testUserList, nil)
+				us.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), user.Filter{OrgID: testOrgID, Pagination: defaultPage()}).Return(testUserList, nil)
 			},
 			request: &frontierv1beta1.ListOrganizationUsersRequest{
 				Id: testOrgID,
@@ -851,7 +851,7 @@ func TestHandler_ListAllOrganizations(t *testing.T) {
 			name: "should return internal error if org service return some error",
 			setup: func(os *mocks.OrganizationService) {
 				os.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"),
-					organization.Filter{}).Return([]organization.Organization{}, errors.New("some error"))
+					organization.Filter{Pagination: defaultPage()}).Return([]organization.Organization{}, errors.New("some error"))
 			},
 			req:     &frontierv1beta1.ListAllOrganizationsRequest{},
 			want:    nil,
@@ -860,7 +860,7 @@ func TestHandler_ListAllOrganizations(t *testing.T) {
 		{
 			name: "should return empty list of orgs if org service return nil error",
 			setup: func(os *mocks.OrganizationService) {
-				os.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), organization.Filter{}).Return([]organization.Organization{}, nil)
+				os.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), organization.Filter{Pagination: defaultPage()}).Return([]organization.Organization{}, nil)
 			},
 			req:     &frontierv1beta1.ListAllOrganizationsRequest{},
 			want:    &frontierv1beta1.ListAllOrganizationsResponse{},
@@ -873,7 +873,7 @@ func TestHandler_ListAllOrganizations(t *testing.T) {
 				for _, o := range testOrgMap {
 					testOrgList = append(testOrgList, o)
 				}
-				os.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), organization.Filter{}).Return(testOrgList, nil)
+				os.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), organization.Filter{Pagination: defaultPage()}).Return(testOrgList, nil)
 			},
 			req: &frontierv1beta1.ListAllOrganizationsRequest{},
 			want: &frontierv1beta1.ListAllOrganizationsResponse{
@@ -1154,7 +1154,7 @@ func TestHandler_ListOrganizationProjects(t *testing.T) {
 			name: "should return error if organization does not exist ",
 			setup: func(ps *mocks.ProjectService, os *mocks.OrganizationService) {
 				os.EXPECT().Get(mock.AnythingOfType("context.backgroundCtx"), "some-org-id").Return(organization.Organization{}, organization.ErrNotExist)
-				ps.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), project.Filter{OrgID: "some-org-id"}).Return([]project.Project{}, organization.ErrNotExist)
+				ps.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), project.Filter{OrgID: "some-org-id", Pagination: defaultPage()}).Return([]project.Project{}, organization.ErrNotExist)
 			},
 			req: &frontierv1beta1.ListOrganizationProjectsRequest{
 				Id: "some-org-id",
@@ -1177,7 +1177,7 @@ func TestHandler_ListOrganizationProjects(t *testing.T) {
 			name: "should return list of projects successfully",
 			setup: func(ps *mocks.ProjectService, os *mocks.OrganizationService) {
 				os.EXPECT().Get(mock.AnythingOfType("context.backgroundCtx"), testOrgMap[testOrgID].Name).Return(testOrgMap[testOrgID], nil)
-				ps.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), project.Filter{OrgID: testOrgID}).Return([]project.Project{
+				ps.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), project.Filter{OrgID: testOrgID, Pagination: defaultPage()}).Return([]project.Project{
 					{
 						ID:   "some-project-id",
 						Name: "some-project-name",
@@ -0,0 +1,176 @@
+package v1beta1
+
+import (
+	"context"
+	"errors"
+
+	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/raystack/frontier/core/audit"
+	"github.com/raystack/frontier/core/group"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	grpcOrgLastOwnerErr     = status.Errorf(codes.FailedPrecondition, "org must have at least one owner, consider transferring the ownership before removing")
+	grpcNotOrgOwnerErr      = status.Errorf(codes.PermissionDenied, "only owners can transfer ownership of an org")
+	grpcTransferNotFoundErr = status.Errorf(codes.NotFound, "ownership transfer doesn't exist")
+)
+
+func (h Handler) TransferOrganizationOwnership(ctx context.Context, request *frontierv1beta1.TransferOrganizationOwnershipRequest) (*frontierv1beta1.TransferOrganizationOwnershipResponse, error) {
+	logger := grpczap.Extract(ctx)
+	orgResp, err := h.orgService.Get(ctx, request.GetId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, organization.ErrDisabled):
+			return nil, grpcOrgDisabledErr
+		case errors.Is(err, organization.ErrNotExist):
+			return nil, grpcOrgNotFoundErr
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	principal, err := h.GetLoggedInPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	transfer, err := h.orgService.RequestTransfer(ctx, orgResp.ID, principal.ID, request.GetUserId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, organization.ErrNotOwner):
+			return nil, grpcNotOrgOwnerErr
+		case errors.Is(err, user.ErrNotExist):
+			return nil, grpcUserNotFoundError
+		case errors.Is(err, organization.ErrInvalidDetail):
+			return nil, grpcBadBodyError
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+	return &frontierv1beta1.TransferOrganizationOwnershipResponse{
+		Transfer: transformOrganizationTransferToPB(transfer),
+	}, nil
+}
+
+func (h Handler) AcceptOrganizationTransfer(ctx context.Context, request *frontierv1beta1.AcceptOrganizationTransferRequest) (*frontierv1beta1.AcceptOrganizationTransferResponse, error) {
+	logger := grpczap.Extract(ctx)
+	orgID, userID, err := h.transferRecipient(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := h.orgService.AcceptTransfer(ctx, orgID, request.GetTransferId(), userID)
+	if err != nil {
+		logger.Error(err.Error())
+		if errors.Is(err, organization.ErrTransferNotExist) {
+			return nil, grpcTransferNotFoundErr
+		}
+		return nil, grpcInternalServerError
+	}
+	audit.GetAuditor(ctx, orgID).LogWithAttrs(audit.OrgOwnerTransferredEvent, audit.UserTarget(transfer.ToUserID), map[string]string{
+		"from": transfer.FromUserID,
+	})
+	return &frontierv1beta1.AcceptOrganizationTransferResponse{}, nil
+}
+
+func (h Handler) DeclineOrganizationTransfer(ctx context.Context, request *frontierv1beta1.DeclineOrganizationTransferRequest) (*frontierv1beta1.DeclineOrganizationTransferResponse, error) {
+	logger := grpczap.Extract(ctx)
+	orgID, userID, err := h.transferRecipient(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := h.orgService.DeclineTransfer(ctx, orgID, request.GetTransferId(), userID)
+	if err != nil {
+		logger.Error(err.Error())
+		if errors.Is(err, organization.ErrTransferNotExist) {
+			return nil, grpcTransferNotFoundErr
+		}
+		return nil, grpcInternalServerError
+	}
+	audit.GetAuditor(ctx, orgID).LogWithAttrs(audit.OrgTransferDeclinedEvent, audit.UserTarget(transfer.ToUserID), map[string]string{
+		"from": transfer.FromUserID,
+	})
+	return &frontierv1beta1.DeclineOrganizationTransferResponse{}, nil
+}
+
+// transferRecipient returns id of the org and of the current user, who has to be the
+// user a transfer of the org was requested for
+func (h Handler) transferRecipient(ctx context.Context, orgIDOrName string) (string, string, error) {
+	logger := grpczap.Extract(ctx)
+	orgResp, err := h.orgService.Get(ctx, orgIDOrName)
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, organization.ErrDisabled):
+			return "", "", grpcOrgDisabledErr
+		case errors.Is(err, organization.ErrNotExist):
+			return "", "", grpcOrgNotFoundErr
+		default:
+			return "", "", grpcInternalServerError
+		}
+	}
+
+	principal, err := h.GetLoggedInPrincipal(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	if principal.Type != schema.UserPrincipal {
+		return "", "", grpcTransferNotFoundErr
+	}
+	return orgResp.ID, principal.ID, nil
+}
+
+// checkOwnerPolicyDelete makes sure deleting the policy doesn't leave an org or
+// group without owners
+func (h Handler) checkOwnerPolicyDelete(ctx context.Context, id string) error {
+	logger := grpczap.Extract(ctx)
+	pol, err := h.policyService.Get(ctx, id)
+	if err != nil {
+		// missing policies are reported by the delete
+		return nil
+	}
+
+	err = h.orgService.CheckPolicyDelete(ctx, pol)
+	if err == nil {
+		err = h.groupService.CheckPolicyDelete(ctx, pol)
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		if ownerErr := lastOwnerError(err); ownerErr != nil {
+			return ownerErr
+		}
+		return grpcInternalServerError
+	}
+	return nil
+}
+
+// lastOwnerError maps errors of removing the last owner of an org or group,
+// it returns nil for any other error
+func lastOwnerError(err error) error {
+	switch {
+	case errors.Is(err, organization.ErrLastOwner):
+		return grpcOrgLastOwnerErr
+	case errors.Is(err, group.ErrLastOwner):
+		return grpcMinOwnerCounrErr
+	}
+	return nil
+}
+
+func transformOrganizationTransferToPB(transfer organization.Transfer) *frontierv1beta1.OrganizationTransfer {
+	return &frontierv1beta1.OrganizationTransfer{
+		Id:         transfer.ID,
+		OrgId:      transfer.OrgID,
+		FromUserId: transfer.FromUserID,
+		ToUserId:   transfer.ToUserID,
+		CreatedAt:  timestamppb.New(transfer.CreatedAt),
+	}
+}
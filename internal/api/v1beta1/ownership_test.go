@@ -0,0 +1,211 @@
+package v1beta1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/group"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/internal/api/v1beta1/mocks"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/raystack/frontier/pkg/utils"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestHandler_TransferOrganizationOwnership(t *testing.T) {
+	someTransferID := utils.NewString()
+	someUserID := utils.NewString()
+	tests := []struct {
+		name    string
+		setup   func(os *mocks.OrganizationService, as *mocks.AuthnService)
+		want    *frontierv1beta1.TransferOrganizationOwnershipResponse
+		wantErr error
+	}{
+		{
+			name: "should request a transfer and return it",
+			setup: func(os *mocks.OrganizationService, as *mocks.AuthnService) {
+				os.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: someUserID}, nil)
+				os.EXPECT().RequestTransfer(mock.Anything, testOrgID, someUserID, "jane@acme.org").
+					Return(organization.Transfer{ID: someTransferID, OrgID: testOrgID, FromUserID: someUserID}, nil)
+			},
+			want: &frontierv1beta1.TransferOrganizationOwnershipResponse{
+				Transfer: &frontierv1beta1.OrganizationTransfer{
+					Id:         someTransferID,
+					OrgId:      testOrgID,
+					FromUserId: someUserID,
+					CreatedAt:  timestamppb.New(time.Time{}),
+				},
+			},
+		},
+		{
+			name: "should return permission denied if caller is not an owner",
+			setup: func(os *mocks.OrganizationService, as *mocks.AuthnService) {
+				os.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: someUserID}, nil)
+				os.EXPECT().RequestTransfer(mock.Anything, testOrgID, someUserID, "jane@acme.org").
+					Return(organization.Transfer{}, organization.ErrNotOwner)
+			},
+			wantErr: grpcNotOrgOwnerErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOrgSvc := new(mocks.OrganizationService)
+			mockAuthnSvc := new(mocks.AuthnService)
+			if tt.setup != nil {
+				tt.setup(mockOrgSvc, mockAuthnSvc)
+			}
+			h := Handler{
+				orgService:   mockOrgSvc,
+				authnService: mockAuthnSvc,
+			}
+
+			got, err := h.TransferOrganizationOwnership(context.Background(), &frontierv1beta1.TransferOrganizationOwnershipRequest{
+				Id:     testOrgID,
+				UserId: "jane@acme.org",
+			})
+			assert.EqualValues(t, tt.want, got)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_AcceptOrganizationTransfer(t *testing.T) {
+	someTransferID := utils.NewString()
+	someUserID := utils.NewString()
+	tests := []struct {
+		name    string
+		setup   func(os *mocks.OrganizationService, as *mocks.AuthnService)
+		want    *frontierv1beta1.AcceptOrganizationTransferResponse
+		wantErr error
+	}{
+		{
+			name: "should accept the transfer of the caller",
+			setup: func(os *mocks.OrganizationService, as *mocks.AuthnService) {
+				os.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: someUserID, Type: schema.UserPrincipal}, nil)
+				os.EXPECT().AcceptTransfer(mock.Anything, testOrgID, someTransferID, someUserID).
+					Return(organization.Transfer{ID: someTransferID, OrgID: testOrgID, ToUserID: someUserID}, nil)
+			},
+			want: &frontierv1beta1.AcceptOrganizationTransferResponse{},
+		},
+		{
+			name: "should return not found if transfer isn't for the caller",
+			setup: func(os *mocks.OrganizationService, as *mocks.AuthnService) {
+				os.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: someUserID, Type: schema.UserPrincipal}, nil)
+				os.EXPECT().AcceptTransfer(mock.Anything, testOrgID, someTransferID, someUserID).
+					Return(organization.Transfer{}, organization.ErrTransferNotExist)
+			},
+			wantErr: grpcTransferNotFoundErr,
+		},
+		{
+			name: "should return not found if caller is a service user",
+			setup: func(os *mocks.OrganizationService, as *mocks.AuthnService) {
+				os.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: someUserID, Type: schema.ServiceUserPrincipal}, nil)
+			},
+			wantErr: grpcTransferNotFoundErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOrgSvc := new(mocks.OrganizationService)
+			mockAuthnSvc := new(mocks.AuthnService)
+			if tt.setup != nil {
+				tt.setup(mockOrgSvc, mockAuthnSvc)
+			}
+			h := Handler{
+				orgService:   mockOrgSvc,
+				authnService: mockAuthnSvc,
+			}
+
+			got, err := h.AcceptOrganizationTransfer(context.Background(), &frontierv1beta1.AcceptOrganizationTransferRequest{
+				Id:         testOrgID,
+				TransferId: someTransferID,
+			})
+			assert.EqualValues(t, tt.want, got)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_DeclineOrganizationTransfer(t *testing.T) {
+	someTransferID := utils.NewString()
+	someUserID := utils.NewString()
+
+	mockOrgSvc := new(mocks.OrganizationService)
+	mockAuthnSvc := new(mocks.AuthnService)
+	mockOrgSvc.EXPECT().Get(mock.Anything, testOrgID).Return(testOrgMap[testOrgID], nil)
+	mockAuthnSvc.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{ID: someUserID, Type: schema.UserPrincipal}, nil)
+	mockOrgSvc.EXPECT().DeclineTransfer(mock.Anything, testOrgID, someTransferID, someUserID).
+		Return(organization.Transfer{ID: someTransferID, OrgID: testOrgID, ToUserID: someUserID}, nil)
+	h := Handler{
+		orgService:   mockOrgSvc,
+		authnService: mockAuthnSvc,
+	}
+
+	got, err := h.DeclineOrganizationTransfer(context.Background(), &frontierv1beta1.DeclineOrganizationTransferRequest{
+		Id:         testOrgID,
+		TransferId: someTransferID,
+	})
+	assert.NoError(t, err)
+	assert.EqualValues(t, &frontierv1beta1.DeclineOrganizationTransferResponse{}, got)
+}
+
+func TestHandler_DeletePolicyOfLastOwner(t *testing.T) {
+	somePolicyID := utils.NewString()
+	pol := policy.Policy{
+		ID:           somePolicyID,
+		ResourceID:   testOrgID,
+		ResourceType: "app/organization",
+	}
+	tests := []struct {
+		name    string
+		setup   func(ps *mocks.PolicyService, os *mocks.OrganizationService, gs *mocks.GroupService)
+		wantErr error
+	}{
+		{
+			name: "should return failed precondition if policy is of the last org owner",
+			setup: func(ps *mocks.PolicyService, os *mocks.OrganizationService, gs *mocks.GroupService) {
+				ps.EXPECT().Get(mock.Anything, somePolicyID).Return(pol, nil)
+				os.EXPECT().CheckPolicyDelete(mock.Anything, pol).Return(organization.ErrLastOwner)
+			},
+			wantErr: grpcOrgLastOwnerErr,
+		},
+		{
+			name: "should return invalid argument if policy is of the last group owner",
+			setup: func(ps *mocks.PolicyService, os *mocks.OrganizationService, gs *mocks.GroupService) {
+				ps.EXPECT().Get(mock.Anything, somePolicyID).Return(pol, nil)
+				os.EXPECT().CheckPolicyDelete(mock.Anything, pol).Return(nil)
+				gs.EXPECT().CheckPolicyDelete(mock.Anything, pol).Return(group.ErrLastOwner)
+			},
+			wantErr: grpcMinOwnerCounrErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPolicySvc := new(mocks.PolicyService)
+			mockOrgSvc := new(mocks.OrganizationService)
+			mockGroupSvc := new(mocks.GroupService)
+			if tt.setup != nil {
+				tt.setup(mockPolicySvc, mockOrgSvc, mockGroupSvc)
+			}
+			h := Handler{
+				policyService: mockPolicySvc,
+				orgService:    mockOrgSvc,
+				groupService:  mockGroupSvc,
+			}
+
+			_, err := h.DeletePolicy(context.Background(), &frontierv1beta1.DeletePolicyRequest{Id: somePolicyID})
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
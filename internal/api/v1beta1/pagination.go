@@ -0,0 +1,41 @@
+package v1beta1
+
+import (
+	"errors"
+
+	"github.com/raystack/frontier/pkg/pagination"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pageRequest is implemented by list requests which are paged, sorted and filtered
+type pageRequest interface {
+	GetPageSize() int32
+	GetPageToken() string
+	GetOrderBy() string
+	GetFilter() string
+}
+
+// paginationFromRequest reads paging, sorting and filter params of a list request,
+// pages default to pagination.DefaultPageSize items and are capped at pagination.MaxPageSize
+func paginationFromRequest(request pageRequest) (*pagination.Pagination, error) {
+	page, err := pagination.New(int(request.GetPageSize()), request.GetPageToken(),
+		request.GetOrderBy(), request.GetFilter())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return page, nil
+}
+
+// grpcListErr maps errors of a paged list request
+func grpcListErr(err error) error {
+	if isInvalidPaginationErr(err) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return grpcInternalServerError
+}
+
+func isInvalidPaginationErr(err error) bool {
+	return errors.Is(err, pagination.ErrInvalidFilter) || errors.Is(err, pagination.ErrInvalidOrderBy) ||
+		errors.Is(err, pagination.ErrInvalidPageToken)
+}
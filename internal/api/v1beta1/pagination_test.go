@@ -0,0 +1,78 @@
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/raystack/frontier/pkg/pagination"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPaginationFromRequest(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   pageRequest
+		want      *pagination.Pagination
+		wantLimit int
+		wantCode  codes.Code
+	}{
+		{
+			name:      "should page with the default size if paging is not requested",
+			request:   &frontierv1beta1.ListOrganizationsRequest{},
+			want:      &pagination.Pagination{OrderBy: pagination.Order{Field: pagination.DefaultOrderField}},
+			wantLimit: pagination.DefaultPageSize,
+		},
+		{
+			name: "should read page size, order and filters from the request",
+			request: &frontierv1beta1.ListOrganizationsRequest{
+				PageSize: 20,
+				OrderBy:  "name desc",
+				Filter:   "state=enabled",
+			},
+			want: &pagination.Pagination{
+				PageSize: 20,
+				OrderBy:  pagination.Order{Field: "name", Desc: true},
+				Filters: []pagination.Filter{
+					{Field: "state", Operator: pagination.OperatorEqual, Value: "enabled"},
+				},
+			},
+			wantLimit: 20,
+		},
+		{
+			name:      "should cap the page size",
+			request:   &frontierv1beta1.ListOrganizationsRequest{PageSize: 5000},
+			want:      &pagination.Pagination{PageSize: 5000, OrderBy: pagination.Order{Field: pagination.DefaultOrderField}},
+			wantLimit: pagination.MaxPageSize,
+		},
+		{
+			name:     "should return invalid argument for bad page token",
+			request:  &frontierv1beta1.ListOrganizationsRequest{PageToken: "some-token"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "should return invalid argument for bad order",
+			request:  &frontierv1beta1.ListOrganizationsRequest{OrderBy: "name sideways"},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := paginationFromRequest(tt.request)
+			assert.Equal(t, tt.want, got)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantLimit, got.Limit())
+		})
+	}
+}
+
+// defaultPage is the page handlers pass on when a list request has no paging params
+func defaultPage() *pagination.Pagination {
+	page, _ := pagination.New(0, "", "", "")
+	return page
+}
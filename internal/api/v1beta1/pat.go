@@ -0,0 +1,230 @@
+package v1beta1
+
+import (
+	"context"
+	"errors"
+
+	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/raystack/frontier/core/audit"
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/pat"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/raystack/frontier/pkg/utils"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PATService interface {
+	Create(ctx context.Context, token pat.Token) (pat.Token, error)
+	List(ctx context.Context, flt pat.Filter) ([]pat.Token, error)
+	Get(ctx context.Context, id string) (pat.Token, error)
+	Revoke(ctx context.Context, id string) error
+}
+
+var (
+	grpcTokenNotFoundErr = status.Errorf(codes.NotFound, pat.ErrNotExist.Error())
+	grpcTokenInvalidErr  = status.Errorf(codes.InvalidArgument, pat.ErrInvalidDetail.Error())
+	grpcScopedTokenErr   = status.Errorf(codes.PermissionDenied, "personal access tokens can't be managed with a scoped credential")
+)
+
+func (h Handler) CreateCurrentUserPersonalToken(ctx context.Context, request *frontierv1beta1.CreateCurrentUserPersonalTokenRequest) (*frontierv1beta1.CreateCurrentUserPersonalTokenResponse, error) {
+	logger := grpczap.Extract(ctx)
+	principal, err := h.tokenOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cred, err := credentialFromRequest(request.GetExpiresAt(), request.GetPermissions(), request.GetProjectIds())
+	if err != nil {
+		return nil, grpcTokenInvalidErr
+	}
+	if orgID := request.GetOrgId(); orgID != "" {
+		if !utils.IsValidUUID(orgID) {
+			return nil, grpcTokenInvalidErr
+		}
+		cred.Scope.OrgID = orgID
+	}
+	token, err := h.patService.Create(ctx, pat.Token{
+		UserID:    principal.ID,
+		Title:     request.GetTitle(),
+		Scope:     cred.Scope,
+		ExpiresAt: cred.ExpiresAt,
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		if errors.Is(err, pat.ErrInvalidDetail) {
+			return nil, grpcTokenInvalidErr
+		}
+		return nil, grpcInternalServerError
+	}
+	audit.GetAuditor(ctx, schema.PlatformOrgID.String()).LogWithAttrs(audit.UserTokenCreatedEvent, audit.UserTarget(principal.ID), map[string]string{
+		"token_id": token.ID,
+		"title":    token.Title,
+	})
+	return &frontierv1beta1.CreateCurrentUserPersonalTokenResponse{
+		Token: transformPersonalTokenToPB(token),
+		Value: token.Value,
+	}, nil
+}
+
+func (h Handler) ListCurrentUserPersonalTokens(ctx context.Context, request *frontierv1beta1.ListCurrentUserPersonalTokensRequest) (*frontierv1beta1.ListCurrentUserPersonalTokensResponse, error) {
+	principal, err := h.tokenOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := h.listPersonalTokens(ctx, pat.Filter{UserID: principal.ID})
+	if err != nil {
+		return nil, err
+	}
+	return &frontierv1beta1.ListCurrentUserPersonalTokensResponse{Tokens: tokens}, nil
+}
+
+func (h Handler) RevokeCurrentUserPersonalToken(ctx context.Context, request *frontierv1beta1.RevokeCurrentUserPersonalTokenRequest) (*frontierv1beta1.RevokeCurrentUserPersonalTokenResponse, error) {
+	logger := grpczap.Extract(ctx)
+	principal, err := h.tokenOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := h.patService.Get(ctx, request.GetId())
+	if err != nil {
+		if errors.Is(err, pat.ErrNotExist) {
+			return nil, grpcTokenNotFoundErr
+		}
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	if token.UserID != principal.ID {
+		return nil, grpcTokenNotFoundErr
+	}
+	if err := h.revokePersonalToken(ctx, token, principal.ID); err != nil {
+		return nil, err
+	}
+	return &frontierv1beta1.RevokeCurrentUserPersonalTokenResponse{}, nil
+}
+
+// ListOrganizationPersonalTokens lists personal access tokens of users narrowed
+// to the org, access is checked by the authorization interceptor
+func (h Handler) ListOrganizationPersonalTokens(ctx context.Context, request *frontierv1beta1.ListOrganizationPersonalTokensRequest) (*frontierv1beta1.ListOrganizationPersonalTokensResponse, error) {
+	orgID, err := h.personalTokenOrg(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := h.listPersonalTokens(ctx, pat.Filter{OrgID: orgID})
+	if err != nil {
+		return nil, err
+	}
+	return &frontierv1beta1.ListOrganizationPersonalTokensResponse{Tokens: tokens}, nil
+}
+
+// RevokeOrganizationPersonalToken revokes a personal access token of a user
+// narrowed to the org, access is checked by the authorization interceptor
+func (h Handler) RevokeOrganizationPersonalToken(ctx context.Context, request *frontierv1beta1.RevokeOrganizationPersonalTokenRequest) (*frontierv1beta1.RevokeOrganizationPersonalTokenResponse, error) {
+	logger := grpczap.Extract(ctx)
+	orgID, err := h.personalTokenOrg(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	principal, err := h.GetLoggedInPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := h.patService.Get(ctx, request.GetTokenId())
+	if err != nil {
+		if errors.Is(err, pat.ErrNotExist) {
+			return nil, grpcTokenNotFoundErr
+		}
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	// admins of an org can only revoke tokens narrowed to it
+	if token.Scope.OrgID != orgID {
+		return nil, grpcTokenNotFoundErr
+	}
+	if err := h.revokePersonalToken(ctx, token, principal.ID); err != nil {
+		return nil, err
+	}
+	return &frontierv1beta1.RevokeOrganizationPersonalTokenResponse{}, nil
+}
+
+// tokenOwner returns the current user if they can manage their personal access tokens
+func (h Handler) tokenOwner(ctx context.Context) (authenticate.Principal, error) {
+	principal, err := h.GetLoggedInPrincipal(ctx)
+	if err != nil {
+		return authenticate.Principal{}, err
+	}
+	if principal.Type != schema.UserPrincipal {
+		return authenticate.Principal{}, grpcPermissionDenied
+	}
+	if principal.Scope != nil {
+		// a scoped token must not be able to mint a broader one
+		return authenticate.Principal{}, grpcScopedTokenErr
+	}
+	return principal, nil
+}
+
+// personalTokenOrg returns the id of an org by its id or name
+func (h Handler) personalTokenOrg(ctx context.Context, idOrName string) (string, error) {
+	logger := grpczap.Extract(ctx)
+	org, err := h.orgService.Get(ctx, idOrName)
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, organization.ErrDisabled):
+			return "", grpcOrgDisabledErr
+		case errors.Is(err, organization.ErrNotExist):
+			return "", grpcOrgNotFoundErr
+		default:
+			return "", grpcInternalServerError
+		}
+	}
+	return org.ID, nil
+}
+
+func (h Handler) listPersonalTokens(ctx context.Context, flt pat.Filter) ([]*frontierv1beta1.PersonalToken, error) {
+	logger := grpczap.Extract(ctx)
+	tokens, err := h.patService.List(ctx, flt)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	var tokensPB []*frontierv1beta1.PersonalToken
+	for _, token := range tokens {
+		tokensPB = append(tokensPB, transformPersonalTokenToPB(token))
+	}
+	return tokensPB, nil
+}
+
+func (h Handler) revokePersonalToken(ctx context.Context, token pat.Token, revokedBy string) error {
+	logger := grpczap.Extract(ctx)
+	if err := h.patService.Revoke(ctx, token.ID); err != nil {
+		if errors.Is(err, pat.ErrNotExist) {
+			return grpcTokenNotFoundErr
+		}
+		logger.Error(err.Error())
+		return grpcInternalServerError
+	}
+	audit.GetAuditor(ctx, schema.PlatformOrgID.String()).LogWithAttrs(audit.UserTokenRevokedEvent, audit.UserTarget(token.UserID), map[string]string{
+		"token_id":   token.ID,
+		"revoked_by": revokedBy,
+	})
+	return nil
+}
+
+func transformPersonalTokenToPB(token pat.Token) *frontierv1beta1.PersonalToken {
+	return &frontierv1beta1.PersonalToken{
+		Id:          token.ID,
+		UserId:      token.UserID,
+		Title:       token.Title,
+		OrgId:       token.Scope.OrgID,
+		Permissions: token.Scope.Permissions,
+		ProjectIds:  token.Scope.ProjectIDs,
+		ExpiresAt:   optionalTimestamp(token.ExpiresAt),
+		LastUsedAt:  optionalTimestamp(token.LastUsedAt),
+		CreatedAt:   timestamppb.New(token.CreatedAt),
+	}
+}
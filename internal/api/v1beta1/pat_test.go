@@ -0,0 +1,219 @@
+package v1beta1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/pat"
+	"github.com/raystack/frontier/core/serviceuser"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/api/v1beta1/mocks"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestHandler_CreateCurrentUserPersonalToken(t *testing.T) {
+	currentUser := user.User{
+		ID:    uuid.New().String(),
+		Email: "jane@acme.org",
+		Title: "jane",
+	}
+	principal := authenticate.Principal{ID: currentUser.ID, Type: schema.UserPrincipal, User: &currentUser}
+	orgID := uuid.New().String()
+	tokenID := uuid.New().String()
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	tests := []struct {
+		name      string
+		setup     func(as *mocks.AuthnService, ps *mocks.PATService)
+		request   *frontierv1beta1.CreateCurrentUserPersonalTokenRequest
+		wantErr   error
+		wantValue string
+	}{
+		{
+			name: "should create a scoped token and return it once",
+			setup: func(as *mocks.AuthnService, ps *mocks.PATService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(principal, nil)
+				ps.EXPECT().Create(mock.Anything, pat.Token{
+					UserID: currentUser.ID,
+					Title:  "deploy script",
+					Scope: serviceuser.Scope{
+						Permissions: []string{"app_project_get"},
+						OrgID:       orgID,
+					},
+					ExpiresAt: expiresAt,
+				}).Return(pat.Token{ID: tokenID, Value: "fpt_secret"}, nil)
+			},
+			request: &frontierv1beta1.CreateCurrentUserPersonalTokenRequest{
+				Title:       "deploy script",
+				ExpiresAt:   timestamppb.New(expiresAt),
+				OrgId:       orgID,
+				Permissions: []string{"app/project:get"},
+			},
+			wantValue: "fpt_secret",
+		},
+		{
+			name: "should not create a token with a scoped credential",
+			setup: func(as *mocks.AuthnService, ps *mocks.PATService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{
+					ID:    currentUser.ID,
+					Type:  schema.UserPrincipal,
+					User:  &currentUser,
+					Scope: &serviceuser.Scope{OrgID: orgID},
+				}, nil)
+			},
+			request: &frontierv1beta1.CreateCurrentUserPersonalTokenRequest{Title: "deploy script"},
+			wantErr: grpcScopedTokenErr,
+		},
+		{
+			name: "should return invalid argument if org isn't an id",
+			setup: func(as *mocks.AuthnService, ps *mocks.PATService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(principal, nil)
+			},
+			request: &frontierv1beta1.CreateCurrentUserPersonalTokenRequest{
+				Title: "deploy script",
+				OrgId: "acme",
+			},
+			wantErr: grpcTokenInvalidErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAuthnSvc := new(mocks.AuthnService)
+			mockPATSvc := new(mocks.PATService)
+			if tt.setup != nil {
+				tt.setup(mockAuthnSvc, mockPATSvc)
+			}
+			h := Handler{
+				authnService: mockAuthnSvc,
+				patService:   mockPATSvc,
+			}
+
+			got, err := h.CreateCurrentUserPersonalToken(context.Background(), tt.request)
+			assert.EqualValues(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantValue, got.GetValue())
+			mockPATSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_RevokeCurrentUserPersonalToken(t *testing.T) {
+	currentUser := user.User{
+		ID:    uuid.New().String(),
+		Email: "jane@acme.org",
+	}
+	principal := authenticate.Principal{ID: currentUser.ID, Type: schema.UserPrincipal, User: &currentUser}
+	tokenID := uuid.New().String()
+	tests := []struct {
+		name    string
+		setup   func(as *mocks.AuthnService, ps *mocks.PATService)
+		wantErr error
+	}{
+		{
+			name: "should revoke a token of the user",
+			setup: func(as *mocks.AuthnService, ps *mocks.PATService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(principal, nil)
+				ps.EXPECT().Get(mock.Anything, tokenID).Return(pat.Token{ID: tokenID, UserID: currentUser.ID}, nil)
+				ps.EXPECT().Revoke(mock.Anything, tokenID).Return(nil)
+			},
+		},
+		{
+			name: "should not revoke a token of another user",
+			setup: func(as *mocks.AuthnService, ps *mocks.PATService) {
+				as.EXPECT().GetPrincipal(mock.Anything).Return(principal, nil)
+				ps.EXPECT().Get(mock.Anything, tokenID).Return(pat.Token{ID: tokenID, UserID: uuid.New().String()}, nil)
+			},
+			wantErr: grpcTokenNotFoundErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAuthnSvc := new(mocks.AuthnService)
+			mockPATSvc := new(mocks.PATService)
+			if tt.setup != nil {
+				tt.setup(mockAuthnSvc, mockPATSvc)
+			}
+			h := Handler{
+				authnService: mockAuthnSvc,
+				patService:   mockPATSvc,
+			}
+
+			_, err := h.RevokeCurrentUserPersonalToken(context.Background(), &frontierv1beta1.RevokeCurrentUserPersonalTokenRequest{Id: tokenID})
+			assert.EqualValues(t, tt.wantErr, err)
+			mockPATSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_RevokeOrganizationPersonalToken(t *testing.T) {
+	currentUser := user.User{
+		ID:    uuid.New().String(),
+		Email: "jane@acme.org",
+	}
+	principal := authenticate.Principal{ID: currentUser.ID, Type: schema.UserPrincipal, User: &currentUser}
+	orgID := uuid.New().String()
+	tokenID := uuid.New().String()
+	tests := []struct {
+		name    string
+		setup   func(as *mocks.AuthnService, os *mocks.OrganizationService, ps *mocks.PATService)
+		wantErr error
+	}{
+		{
+			name: "should revoke a token of another user scoped to the org",
+			setup: func(as *mocks.AuthnService, os *mocks.OrganizationService, ps *mocks.PATService) {
+				os.EXPECT().Get(mock.Anything, orgID).Return(organization.Organization{ID: orgID}, nil)
+				as.EXPECT().GetPrincipal(mock.Anything).Return(principal, nil)
+				ps.EXPECT().Get(mock.Anything, tokenID).Return(pat.Token{
+					ID:     tokenID,
+					UserID: uuid.New().String(),
+					Scope:  serviceuser.Scope{OrgID: orgID},
+				}, nil)
+				ps.EXPECT().Revoke(mock.Anything, tokenID).Return(nil)
+			},
+		},
+		{
+			name: "should not revoke a token scoped to another org",
+			setup: func(as *mocks.AuthnService, os *mocks.OrganizationService, ps *mocks.PATService) {
+				os.EXPECT().Get(mock.Anything, orgID).Return(organization.Organization{ID: orgID}, nil)
+				as.EXPECT().GetPrincipal(mock.Anything).Return(principal, nil)
+				ps.EXPECT().Get(mock.Anything, tokenID).Return(pat.Token{ID: tokenID, UserID: uuid.New().String()}, nil)
+			},
+			wantErr: grpcTokenNotFoundErr,
+		},
+		{
+			name: "should return not found if org doesn't exist",
+			setup: func(as *mocks.AuthnService, os *mocks.OrganizationService, ps *mocks.PATService) {
+				os.EXPECT().Get(mock.Anything, orgID).Return(organization.Organization{}, organization.ErrNotExist)
+			},
+			wantErr: grpcOrgNotFoundErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAuthnSvc := new(mocks.AuthnService)
+			mockOrgSvc := new(mocks.OrganizationService)
+			mockPATSvc := new(mocks.PATService)
+			if tt.setup != nil {
+				tt.setup(mockAuthnSvc, mockOrgSvc, mockPATSvc)
+			}
+			h := Handler{
+				authnService: mockAuthnSvc,
+				orgService:   mockOrgSvc,
+				patService:   mockPATSvc,
+			}
+
+			_, err := h.RevokeOrganizationPersonalToken(context.Background(), &frontierv1beta1.RevokeOrganizationPersonalTokenRequest{
+				Id:      orgID,
+				TokenId: tokenID,
+			})
+			assert.EqualValues(t, tt.wantErr, err)
+			mockPATSvc.AssertExpectations(t)
+		})
+	}
+}
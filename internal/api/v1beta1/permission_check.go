@@ -146,6 +146,16 @@ func (h Handler) IsAuthorized(ctx context.Context, object relation.Object, permi
 		logger.Error(principalErr.Error())
 		return principalErr
 	}
+	if currentUser.Scope != nil {
+		// scoped credentials can only narrow what the principal is allowed to do
+		allowed, err := h.resourceService.ScopeAllows(ctx, *currentUser.Scope, object, permission)
+		if err != nil {
+			return handleAuthErr(ctx, err)
+		}
+		if !allowed {
+			return grpcPermissionDenied
+		}
+	}
 	result, err := h.resourceService.CheckAuthz(ctx, resource.Check{
 		Object: object,
 		Subject: relation.Subject{
@@ -190,6 +200,11 @@ func (h Handler) IsSuperUser(ctx context.Context) error {
 		logger.Error(err.Error())
 		return err
 	}
+	if currentUser.Scope != nil {
+		// admin APIs are outside of every scope, a scoped credential of a
+		// superuser can't be used to reach them
+		return grpcPermissionDenied
+	}
 
 	if ok, err := h.userService.IsSudo(ctx, currentUser.ID); err != nil {
 		return err
@@ -9,6 +9,7 @@ import (
 	"github.com/raystack/frontier/core/resource"
 
 	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/serviceuser"
 	"github.com/raystack/frontier/core/user"
 	"github.com/raystack/frontier/internal/api/v1beta1/mocks"
 	"github.com/raystack/frontier/internal/bootstrap/schema"
@@ -255,3 +256,126 @@ func TestHandler_IsAuthorized(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_IsAuthorizedWithScope(t *testing.T) {
+	scope := serviceuser.Scope{
+		Permissions: []string{"app_project_get"},
+	}
+	object := relation.Object{
+		ID:        "objectID",
+		Namespace: schema.ProjectNamespace,
+	}
+	tests := []struct {
+		name       string
+		setup      func(res *mocks.ResourceService)
+		permission string
+		wantErr    error
+	}{
+		{
+			name: "should deny a permission outside the scope of the credential",
+			setup: func(res *mocks.ResourceService) {
+				res.EXPECT().ScopeAllows(mock.AnythingOfType("context.backgroundCtx"), scope, object, "update").Return(false, nil)
+			},
+			permission: "update",
+			wantErr:    grpcPermissionDenied,
+		},
+		{
+			name: "should check policies of a permission in the scope of the credential",
+			setup: func(res *mocks.ResourceService) {
+				res.EXPECT().ScopeAllows(mock.AnythingOfType("context.backgroundCtx"), scope, object, "get").Return(true, nil)
+				res.EXPECT().CheckAuthz(mock.AnythingOfType("context.backgroundCtx"), resource.Check{
+					Object: object,
+					Subject: relation.Subject{
+						ID:        "subjectID",
+						Namespace: schema.ServiceUserPrincipal,
+					},
+					Permission: "get",
+				}).Return(true, nil)
+			},
+			permission: "get",
+			wantErr:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockResourceSrv := new(mocks.ResourceService)
+			mockAuthnService := new(mocks.AuthnService)
+			mockAuthnService.EXPECT().GetPrincipal(mock.AnythingOfType("context.backgroundCtx")).Return(authenticate.Principal{
+				ID:    "subjectID",
+				Type:  schema.ServiceUserPrincipal,
+				Scope: &scope,
+			}, nil)
+			if tt.setup != nil {
+				tt.setup(mockResourceSrv)
+			}
+			h := Handler{
+				resourceService: mockResourceSrv,
+				authnService:    mockAuthnService,
+			}
+			err := h.IsAuthorized(context.Background(), object, tt.permission)
+			assert.EqualValues(t, tt.wantErr, err)
+			mockResourceSrv.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_IsSuperUser(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal authenticate.Principal
+		setup     func(us *mocks.UserService)
+		wantErr   error
+	}{
+		{
+			name:      "should allow a superuser",
+			principal: authenticate.Principal{ID: "subjectID", Type: schema.UserPrincipal},
+			setup: func(us *mocks.UserService) {
+				us.EXPECT().IsSudo(mock.AnythingOfType("context.backgroundCtx"), "subjectID").Return(true, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:      "should deny a user who isn't a superuser",
+			principal: authenticate.Principal{ID: "subjectID", Type: schema.UserPrincipal},
+			setup: func(us *mocks.UserService) {
+				us.EXPECT().IsSudo(mock.AnythingOfType("context.backgroundCtx"), "subjectID").Return(false, nil)
+			},
+			wantErr: grpcPermissionDenied,
+		},
+		{
+			name: "should deny a scoped credential of a superuser",
+			principal: authenticate.Principal{
+				ID:    "subjectID",
+				Type:  schema.ServiceUserPrincipal,
+				Scope: &serviceuser.Scope{Permissions: []string{"app_organization_get"}},
+			},
+			wantErr: grpcPermissionDenied,
+		},
+		{
+			name: "should deny a scoped personal access token of a superuser",
+			principal: authenticate.Principal{
+				ID:    "subjectID",
+				Type:  schema.UserPrincipal,
+				Scope: &serviceuser.Scope{OrgID: "orgID"},
+			},
+			wantErr: grpcPermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserSrv := new(mocks.UserService)
+			mockAuthnService := new(mocks.AuthnService)
+			mockAuthnService.EXPECT().GetPrincipal(mock.AnythingOfType("context.backgroundCtx")).Return(tt.principal, nil)
+			if tt.setup != nil {
+				tt.setup(mockUserSrv)
+			}
+			h := Handler{
+				userService:  mockUserSrv,
+				authnService: mockAuthnService,
+			}
+			err := h.IsSuperUser(context.Background())
+			assert.EqualValues(t, tt.wantErr, err)
+			mockUserSrv.AssertExpectations(t)
+		})
+	}
+}
@@ -37,15 +37,26 @@ func (h Handler) ListPolicies(ctx context.Context, request *frontierv1beta1.List
 	logger := grpczap.Extract(ctx)
 	var policies []*frontierv1beta1.Policy
 
+	switch policy.Effect(request.GetEffect()) {
+	case "", policy.Allow, policy.Deny:
+	default:
+		return nil, grpcBadBodyError
+	}
+	page, err := paginationFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
 	policyList, err := h.policyService.List(ctx, policy.Filter{
 		OrgID:       request.GetOrgId(),
 		PrincipalID: request.GetUserId(),
 		ProjectID:   request.GetProjectId(),
 		RoleID:      request.GetRoleId(),
+		Effect:      policy.Effect(request.GetEffect()),
+		Pagination:  page,
 	})
 	if err != nil {
 		logger.Error(err.Error())
-		return nil, grpcInternalServerError
+		return nil, grpcListErr(err)
 	}
 
 	for _, p := range policyList {
@@ -58,7 +69,10 @@ func (h Handler) ListPolicies(ctx context.Context, request *frontierv1beta1.List
 		policies = append(policies, policyPB)
 	}
 
-	return &frontierv1beta1.ListPoliciesResponse{Policies: policies}, nil
+	return &frontierv1beta1.ListPoliciesResponse{
+		Policies:      policies,
+		NextPageToken: page.NextPageToken(),
+	}, nil
 }
 
 func (h Handler) CreatePolicy(ctx context.Context, request *frontierv1beta1.CreatePolicyRequest) (*frontierv1beta1.CreatePolicyResponse, error) {
@@ -85,6 +99,7 @@ func (h Handler) CreatePolicy(ctx context.Context, request *frontierv1beta1.Crea
 		ResourceType:  resourceType,
 		PrincipalID:   principalID,
 		PrincipalType: principalType,
+		Effect:        policy.Effect(request.GetBody().GetEffect()),
 		Metadata:      metaDataMap,
 	})
 	if err != nil {
@@ -113,6 +128,7 @@ func (h Handler) CreatePolicy(ctx context.Context, request *frontierv1beta1.Crea
 			"role_id":        newPolicy.RoleID,
 			"principal_id":   newPolicy.PrincipalID,
 			"principal_type": newPolicy.PrincipalType,
+			"effect":         newPolicy.Effect.String(),
 		})
 	return &frontierv1beta1.CreatePolicyResponse{Policy: policyPB}, nil
 }
@@ -149,6 +165,10 @@ func (h Handler) UpdatePolicy(ctx context.Context, request *frontierv1beta1.Upda
 
 func (h Handler) DeletePolicy(ctx context.Context, request *frontierv1beta1.DeletePolicyRequest) (*frontierv1beta1.DeletePolicyResponse, error) {
 	logger := grpczap.Extract(ctx)
+	if err := h.checkOwnerPolicyDelete(ctx, request.GetId()); err != nil {
+		return nil, err
+	}
+
 	err := h.policyService.Delete(ctx, request.GetId())
 	if err != nil {
 		logger.Error(err.Error())
@@ -189,6 +209,7 @@ func transformPolicyToPB(policy policy.Policy) (*frontierv1beta1.Policy, error)
 		RoleId:    policy.RoleID,
 		Resource:  schema.JoinNamespaceAndResourceID(policy.ResourceType, policy.ResourceID),
 		Principal: schema.JoinNamespaceAndResourceID(policy.PrincipalType, policy.PrincipalID),
+		Effect:    policy.Effect.String(),
 		Metadata:  metadata,
 	}
 	if !policy.CreatedAt.IsZero() {
@@ -44,7 +44,7 @@ func TestListPolicies(t *testing.T) {
 		{
 			title: "should return internal error if policy service return some error",
 			setup: func(ps *mocks.PolicyService) {
-				ps.EXPECT().List(mock.Anything, policy.Filter{}).Return([]policy.Policy{}, errors.New("some error"))
+				ps.EXPECT().List(mock.Anything, policy.Filter{Pagination: defaultPage()}).Return([]policy.Policy{}, errors.New("some error"))
 			},
 			want: nil,
 			err:  status.Errorf(codes.Internal, ErrInternalServer.Error()),
@@ -56,7 +56,7 @@ func TestListPolicies(t *testing.T) {
 				for _, p := range testPolicyMap {
 					testPoliciesList = append(testPoliciesList, p)
 				}
-				ps.EXPECT().List(mock.Anything, policy.Filter{}).Return(testPoliciesList, nil)
+				ps.EXPECT().List(mock.Anything, policy.Filter{Pagination: defaultPage()}).Return(testPoliciesList, nil)
 			},
 			want: &frontierv1beta1.ListPoliciesResponse{Policies: []*frontierv1beta1.Policy{
 				{
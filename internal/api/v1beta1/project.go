@@ -18,6 +18,7 @@ import (
 	"github.com/raystack/frontier/pkg/errors"
 	"github.com/raystack/frontier/pkg/metadata"
 
+	"github.com/raystack/frontier/core/folder"
 	"github.com/raystack/frontier/core/organization"
 	"github.com/raystack/frontier/core/project"
 
@@ -36,6 +37,7 @@ type ProjectService interface {
 	List(ctx context.Context, f project.Filter) ([]project.Project, error)
 	ListByUser(ctx context.Context, userID string, flt project.Filter) ([]project.Project, error)
 	Update(ctx context.Context, toUpdate project.Project) (project.Project, error)
+	Move(ctx context.Context, id, folderID string) (project.Project, error)
 	ListUsers(ctx context.Context, id string, permissionFilter string) ([]user.User, error)
 	ListServiceUsers(ctx context.Context, id string, permissionFilter string) ([]serviceuser.ServiceUser, error)
 	ListGroups(ctx context.Context, id string) ([]group.Group, error)
@@ -50,13 +52,19 @@ func (h Handler) ListProjects(
 	logger := grpczap.Extract(ctx)
 
 	var projects []*frontierv1beta1.Project
+	page, err := paginationFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
 	projectList, err := h.projectService.List(ctx, project.Filter{
-		State: project.State(request.GetState()),
-		OrgID: request.GetOrgId(),
+		State:          project.State(request.GetState()),
+		IncludeDeleted: request.GetIncludeDeleted(),
+		OrgID:          request.GetOrgId(),
+		Pagination:     page,
 	})
 	if err != nil {
 		logger.Error(err.Error())
-		return nil, grpcInternalServerError
+		return nil, grpcListErr(err)
 	}
 
 	for _, v := range projectList {
@@ -69,7 +77,10 @@ func (h Handler) ListProjects(
 		projects = append(projects, projectPB)
 	}
 
-	return &frontierv1beta1.ListProjectsResponse{Projects: projects}, nil
+	return &frontierv1beta1.ListProjectsResponse{
+		Projects:      projects,
+		NextPageToken: page.NextPageToken(),
+	}, nil
 }
 
 func (h Handler) CreateProject(
@@ -90,6 +101,7 @@ func (h Handler) CreateProject(
 		Title:        request.GetBody().GetTitle(),
 		Metadata:     metaDataMap,
 		Organization: organization.Organization{ID: request.GetBody().GetOrgId()},
+		FolderID:     request.GetBody().GetFolderId(),
 	}
 	newProject, err := h.projectService.Create(ctx, prj)
 	if err != nil {
@@ -97,7 +109,8 @@ func (h Handler) CreateProject(
 		switch {
 		case errors.Is(err, user.ErrInvalidEmail):
 			return nil, grpcUnauthenticated
-		case errors.Is(err, organization.ErrInvalidUUID), errors.Is(err, project.ErrInvalidDetail):
+		case errors.Is(err, organization.ErrInvalidUUID), errors.Is(err, project.ErrInvalidDetail),
+			errors.Is(err, folder.ErrNotExist), errors.Is(err, folder.ErrInvalidUUID):
 			return nil, grpcBadBodyError
 		case errors.Is(err, project.ErrConflict):
 			return nil, grpcConflictError
@@ -187,6 +200,39 @@ func (h Handler) UpdateProject(
 	return &frontierv1beta1.UpdateProjectResponse{Project: projectPB}, nil
 }
 
+func (h Handler) MoveProject(ctx context.Context, request *frontierv1beta1.MoveProjectRequest) (*frontierv1beta1.MoveProjectResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	movedProject, err := h.projectService.Move(ctx, request.GetId(), request.GetFolderId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, project.ErrNotExist),
+			errors.Is(err, project.ErrInvalidUUID),
+			errors.Is(err, project.ErrInvalidID):
+			return nil, grpcProjectNotFoundErr
+		case errors.Is(err, project.ErrInvalidDetail),
+			errors.Is(err, folder.ErrNotExist),
+			errors.Is(err, folder.ErrInvalidUUID):
+			return nil, grpcBadBodyError
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	projectPB, err := transformProjectToPB(movedProject)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+
+	audit.GetAuditor(ctx, movedProject.Organization.ID).LogWithAttrs(audit.ProjectMovedEvent,
+		audit.ProjectTarget(movedProject.ID), map[string]string{
+			"folder_id": movedProject.FolderID,
+		})
+	return &frontierv1beta1.MoveProjectResponse{Project: projectPB}, nil
+}
+
 func (h Handler) ListProjectAdmins(
 	ctx context.Context,
 	request *frontierv1beta1.ListProjectAdminsRequest,
@@ -408,6 +454,7 @@ func transformProjectToPB(prj project.Project) (*frontierv1beta1.Project, error)
 		Name:      prj.Name,
 		Title:     prj.Title,
 		OrgId:     prj.Organization.ID,
+		FolderId:  prj.FolderID,
 		Metadata:  metaData,
 		CreatedAt: timestamppb.New(prj.CreatedAt),
 		UpdatedAt: timestamppb.New(prj.UpdatedAt),
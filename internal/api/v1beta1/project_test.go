@@ -257,7 +257,7 @@ func TestListProjects(t *testing.T) {
 			title: "should return internal error if project service return some error",
 			req:   &frontierv1beta1.ListProjectsRequest{},
 			setup: func(ps *mocks.ProjectService) {
-				ps.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), project.Filter{}).Return([]project.Project{}, errors.New("some error"))
+				ps.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), project.Filter{Pagination: defaultPage()}).Return([]project.Project{}, errors.New("some error"))
 			},
 			want: nil,
 			err:  grpcInternalServerError,
@@ -272,7 +272,7 @@ func TestListProjects(t *testing.T) {
 					prjs = append(prjs, testProjectMap[projectID])
 				}
 
-				ps.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), project.Filter{}).Return(prjs, nil)
+				ps.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), project.Filter{Pagination: defaultPage()}).Return(prjs, nil)
 			},
 			want: &frontierv1beta1.ListProjectsResponse{Projects: []*frontierv1beta1.Project{
 				{
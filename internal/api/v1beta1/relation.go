@@ -20,7 +20,7 @@ import (
 type RelationService interface {
 	Get(ctx context.Context, id string) (relation.Relation, error)
 	Create(ctx context.Context, rel relation.Relation) (relation.Relation, error)
-	List(ctx context.Context) ([]relation.Relation, error)
+	List(ctx context.Context, flt relation.Filter) ([]relation.Relation, error)
 	Delete(ctx context.Context, rel relation.Relation) error
 }
 
@@ -32,10 +32,16 @@ var (
 func (h Handler) ListRelations(ctx context.Context, request *frontierv1beta1.ListRelationsRequest) (*frontierv1beta1.ListRelationsResponse, error) {
 	logger := grpczap.Extract(ctx)
 	var relations []*frontierv1beta1.Relation
-	relationsList, err := h.relationService.List(ctx)
+	page, err := paginationFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	relationsList, err := h.relationService.List(ctx, relation.Filter{
+		Pagination: page,
+	})
 	if err != nil {
 		logger.Error(err.Error())
-		return nil, grpcInternalServerError
+		return nil, grpcListErr(err)
 	}
 
 	for _, r := range relationsList {
@@ -49,7 +55,8 @@ func (h Handler) ListRelations(ctx context.Context, request *frontierv1beta1.Lis
 	}
 
 	return &frontierv1beta1.ListRelationsResponse{
-		Relations: relations,
+		Relations:     relations,
+		NextPageToken: page.NextPageToken(),
 	}, nil
 }
 
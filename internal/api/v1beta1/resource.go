@@ -13,6 +13,7 @@ import (
 
 	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	"github.com/raystack/frontier/core/resource"
+	"github.com/raystack/frontier/core/serviceuser"
 	"github.com/raystack/frontier/core/user"
 	"github.com/raystack/frontier/pkg/metadata"
 	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
@@ -29,6 +30,7 @@ type ResourceService interface {
 	Delete(ctx context.Context, namespace, id string) error
 	CheckAuthz(ctx context.Context, check resource.Check) (bool, error)
 	BatchCheck(ctx context.Context, checks []resource.Check) ([]relation.CheckPair, error)
+	ScopeAllows(ctx context.Context, scope serviceuser.Scope, obj relation.Object, permissionName string) (bool, error)
 }
 
 var grpcResourceNotFoundErr = status.Errorf(codes.NotFound, "resource doesn't exist")
@@ -37,14 +39,19 @@ func (h Handler) ListResources(ctx context.Context, request *frontierv1beta1.Lis
 	logger := grpczap.Extract(ctx)
 	var resources []*frontierv1beta1.Resource
 	namespaceID := schema.ParseNamespaceAliasIfRequired(request.GetNamespace())
+	page, err := paginationFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
 	filters := resource.Filter{
 		NamespaceID: namespaceID,
 		ProjectID:   request.GetProjectId(),
+		Pagination:  page,
 	}
 	resourcesList, err := h.resourceService.List(ctx, filters)
 	if err != nil {
 		logger.Error(err.Error())
-		return nil, grpcInternalServerError
+		return nil, grpcListErr(err)
 	}
 
 	for _, r := range resourcesList {
@@ -57,7 +64,8 @@ func (h Handler) ListResources(ctx context.Context, request *frontierv1beta1.Lis
 	}
 
 	return &frontierv1beta1.ListResourcesResponse{
-		Resources: resources,
+		Resources:     resources,
+		NextPageToken: page.NextPageToken(),
 	}, nil
 }
 
@@ -66,14 +74,19 @@ func (h Handler) ListProjectResources(ctx context.Context, request *frontierv1be
 
 	var resources []*frontierv1beta1.Resource
 	namespaceID := schema.ParseNamespaceAliasIfRequired(request.GetNamespace())
+	page, err := paginationFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
 	filters := resource.Filter{
 		NamespaceID: namespaceID,
 		ProjectID:   request.GetProjectId(),
+		Pagination:  page,
 	}
 	resourcesList, err := h.resourceService.List(ctx, filters)
 	if err != nil {
 		logger.Error(err.Error())
-		return nil, grpcInternalServerError
+		return nil, grpcListErr(err)
 	}
 
 	for _, r := range resourcesList {
@@ -86,7 +99,8 @@ func (h Handler) ListProjectResources(ctx context.Context, request *frontierv1be
 	}
 
 	return &frontierv1beta1.ListProjectResourcesResponse{
-		Resources: resources,
+		Resources:     resources,
+		NextPageToken: page.NextPageToken(),
 	}, nil
 }
 
@@ -55,7 +55,7 @@ func TestHandler_ListResources(t *testing.T) {
 		{
 			name: "should return internal error if resource service return some error",
 			setup: func(rs *mocks.ResourceService) {
-				rs.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), resource.Filter{}).Return([]resource.Resource{}, errors.New("some error"))
+				rs.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), resource.Filter{Pagination: defaultPage()}).Return([]resource.Resource{}, errors.New("some error"))
 			},
 			request: &frontierv1beta1.ListResourcesRequest{},
 			want:    nil,
@@ -64,7 +64,7 @@ func TestHandler_ListResources(t *testing.T) {
 		{
 			name: "should return resources if resource service return nil error",
 			setup: func(rs *mocks.ResourceService) {
-				rs.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), resource.Filter{}).Return([]resource.Resource{
+				rs.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), resource.Filter{Pagination: defaultPage()}).Return([]resource.Resource{
 					testResource,
 				}, nil)
 			},
@@ -529,7 +529,7 @@ func TestHandler_ListProjectResources(t *testing.T) {
 			name: "should return internal error if resource service return error",
 			setup: func(rs *mocks.ResourceService, ps *mocks.ProjectService) {
 				rs.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"),
-					resource.Filter{ProjectID: testProjectID}).Return(nil, errors.New("error"))
+					resource.Filter{ProjectID: testProjectID, Pagination: defaultPage()}).Return(nil, errors.New("error"))
 			},
 			request: &frontierv1beta1.ListProjectResourcesRequest{
 				ProjectId: testProjectID,
@@ -540,7 +540,7 @@ func TestHandler_ListProjectResources(t *testing.T) {
 		{
 			name: "should return success if resource service return nil",
 			setup: func(rs *mocks.ResourceService, ps *mocks.ProjectService) {
-				rs.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), resource.Filter{ProjectID: testProjectID}).Return([]resource.Resource{}, nil)
+				rs.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), resource.Filter{ProjectID: testProjectID, Pagination: defaultPage()}).Return([]resource.Resource{}, nil)
 			},
 			request: &frontierv1beta1.ListProjectResourcesRequest{
 				ProjectId: testProjectID,
@@ -553,7 +553,7 @@ func TestHandler_ListProjectResources(t *testing.T) {
 		{
 			name: "should return success if resource service return resources",
 			setup: func(rs *mocks.ResourceService, ps *mocks.ProjectService) {
-				rs.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), resource.Filter{ProjectID: testProjectID}).Return([]resource.Resource{testResource}, nil)
+				rs.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), resource.Filter{ProjectID: testProjectID, Pagination: defaultPage()}).Return([]resource.Resource{testResource}, nil)
 			},
 			request: &frontierv1beta1.ListProjectResourcesRequest{
 				ProjectId: testProjectID,
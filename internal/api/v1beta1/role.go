@@ -22,7 +22,10 @@ import (
 	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
 )
 
-var grpcRoleNotFoundErr = status.Errorf(codes.NotFound, "role doesn't exist")
+var (
+	grpcRoleNotFoundErr    = status.Errorf(codes.NotFound, "role doesn't exist")
+	grpcRoleParentInUseErr = status.Errorf(codes.FailedPrecondition, role.ErrParentInUse.Error())
+)
 
 type RoleService interface {
 	Get(ctx context.Context, id string) (role.Role, error)
@@ -106,6 +109,7 @@ func (h Handler) CreateRole(ctx context.Context, request *frontierv1beta1.Create
 		Name:        request.GetBody().GetName(),
 		Permissions: request.GetBody().GetPermissions(),
 		Scopes:      request.GetBody().GetScopes(),
+		Parents:     request.GetBody().GetParents(),
 		Title:       request.GetBody().GetTitle(),
 		OrgID:       schema.PlatformOrgID.String(), // to create a platform wide role
 		Metadata:    metaDataMap,
@@ -116,7 +120,8 @@ func (h Handler) CreateRole(ctx context.Context, request *frontierv1beta1.Create
 		case errors.Is(err, namespace.ErrNotExist),
 			errors.Is(err, permission.ErrNotExist),
 			errors.Is(err, role.ErrInvalidID),
-			errors.Is(err, role.ErrInvalidDetail):
+			errors.Is(err, role.ErrInvalidDetail),
+			errors.Is(err, role.ErrCyclicParent):
 			return nil, grpcBadBodyError
 		case errors.Is(err, role.ErrConflict):
 			return nil, grpcConflictError
@@ -158,13 +163,15 @@ func (h Handler) UpdateRole(ctx context.Context, request *frontierv1beta1.Update
 		Title:       request.GetBody().GetTitle(),
 		Name:        request.GetBody().GetName(),
 		Scopes:      request.GetBody().GetScopes(),
+		Parents:     roleParentsFromPB(request.GetBody()),
 		Permissions: request.GetBody().GetPermissions(),
 		Metadata:    metaDataMap,
 	})
 	if err != nil {
 		logger.Error(err.Error())
 		switch {
-		case errors.Is(err, role.ErrInvalidDetail):
+		case errors.Is(err, role.ErrInvalidDetail),
+			errors.Is(err, role.ErrCyclicParent):
 			return nil, grpcBadBodyError
 		case errors.Is(err, role.ErrInvalidID),
 			errors.Is(err, role.ErrNotExist):
@@ -207,6 +214,7 @@ func (h Handler) CreateOrganizationRole(ctx context.Context, request *frontierv1
 		Name:        request.GetBody().GetName(),
 		Title:       request.GetBody().GetTitle(),
 		Scopes:      request.GetBody().GetScopes(),
+		Parents:     request.GetBody().GetParents(),
 		Permissions: request.GetBody().GetPermissions(),
 		OrgID:       request.GetOrgId(),
 		Metadata:    metaDataMap,
@@ -217,7 +225,8 @@ func (h Handler) CreateOrganizationRole(ctx context.Context, request *frontierv1
 		case errors.Is(err, namespace.ErrNotExist),
 			errors.Is(err, permission.ErrNotExist),
 			errors.Is(err, role.ErrInvalidID),
-			errors.Is(err, role.ErrInvalidDetail):
+			errors.Is(err, role.ErrInvalidDetail),
+			errors.Is(err, role.ErrCyclicParent):
 			return nil, grpcBadBodyError
 		case errors.Is(err, role.ErrConflict):
 			return nil, grpcConflictError
@@ -285,13 +294,15 @@ func (h Handler) UpdateOrganizationRole(ctx context.Context, request *frontierv1
 		Name:        request.GetBody().GetName(),
 		Title:       request.GetBody().GetTitle(),
 		Scopes:      request.GetBody().GetScopes(),
+		Parents:     roleParentsFromPB(request.GetBody()),
 		Permissions: request.GetBody().GetPermissions(),
 		Metadata:    metaDataMap,
 	})
 	if err != nil {
 		logger.Error(err.Error())
 		switch {
-		case errors.Is(err, role.ErrInvalidDetail):
+		case errors.Is(err, role.ErrInvalidDetail),
+			errors.Is(err, role.ErrCyclicParent):
 			return nil, grpcBadBodyError
 		case errors.Is(err, role.ErrInvalidID),
 			errors.Is(err, role.ErrNotExist):
@@ -329,6 +340,8 @@ func (h Handler) DeleteRole(ctx context.Context, request *frontierv1beta1.Delete
 		switch {
 		case errors.Is(err, role.ErrNotExist), errors.Is(err, role.ErrInvalidID):
 			return nil, grpcRoleNotFoundErr
+		case errors.Is(err, role.ErrParentInUse):
+			return nil, grpcRoleParentInUseErr
 		default:
 			return nil, grpcInternalServerError
 		}
@@ -349,6 +362,8 @@ func (h Handler) DeleteOrganizationRole(ctx context.Context, request *frontierv1
 		switch {
 		case errors.Is(err, role.ErrNotExist), errors.Is(err, role.ErrInvalidID):
 			return nil, grpcRoleNotFoundErr
+		case errors.Is(err, role.ErrParentInUse):
+			return nil, grpcRoleParentInUseErr
 		default:
 			return nil, grpcInternalServerError
 		}
@@ -373,6 +388,7 @@ func transformRoleToPB(from role.Role) (frontierv1beta1.Role, error) {
 		Title:       from.Title,
 		Scopes:      from.Scopes,
 		Permissions: from.Permissions,
+		Parents:     from.Parents,
 		OrgId:       from.OrgID,
 		State:       from.State.String(),
 		Metadata:    metaData,
@@ -380,3 +396,15 @@ func transformRoleToPB(from role.Role) (frontierv1beta1.Role, error) {
 		UpdatedAt:   timestamppb.New(from.UpdatedAt),
 	}, nil
 }
+
+// roleParentsFromPB returns nil to keep the parents of a role being updated if
+// none are sent, clear_parents replaces them even with an empty list
+func roleParentsFromPB(body *frontierv1beta1.RoleRequestBody) []string {
+	if body.GetClearParents() {
+		return append([]string{}, body.GetParents()...)
+	}
+	if len(body.GetParents()) == 0 {
+		return nil
+	}
+	return body.GetParents()
+}
@@ -618,6 +618,36 @@ func TestHandler_UpdateOrganizationRole(t *testing.T) {
 			want:    nil,
 			wantErr: grpcConflictError,
 		},
+		{
+			name: "should return bad body error if parents form a cycle",
+			setup: func(rs *mocks.RoleService, ms *mocks.MetaSchemaService) {
+				ms.EXPECT().Validate(mock.AnythingOfType("metadata.Metadata"), roleMetaSchema).Return(nil)
+				rs.EXPECT().Update(mock.AnythingOfType("context.backgroundCtx"), role.Role{
+					ID:          testRoleMap[testRoleID].ID,
+					Name:        testRoleMap[testRoleID].Name,
+					Permissions: testRoleMap[testRoleID].Permissions,
+					OrgID:       testRoleMap[testRoleID].OrgID,
+					Parents:     []string{testRoleID},
+					Metadata:    testRoleMap[testRoleID].Metadata,
+				}).Return(role.Role{}, role.ErrCyclicParent)
+			},
+			request: &frontierv1beta1.UpdateOrganizationRoleRequest{
+				Id:    testRoleMap[testRoleID].ID,
+				OrgId: testRoleMap[testRoleID].OrgID,
+				Body: &frontierv1beta1.RoleRequestBody{
+					Name:        testRoleMap[testRoleID].Name,
+					Permissions: testRoleMap[testRoleID].Permissions,
+					Parents:     []string{testRoleID},
+					Metadata: &structpb.Struct{
+						Fields: map[string]*structpb.Value{
+							"foo": structpb.NewStringValue("bar"),
+						},
+					},
+				},
+			},
+			want:    nil,
+			wantErr: grpcBadBodyError,
+		},
 		{
 			name: "should update role successfully",
 			setup: func(rs *mocks.RoleService, ms *mocks.MetaSchemaService) {
@@ -650,6 +680,40 @@ func TestHandler_UpdateOrganizationRole(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "should remove parents of the role if asked to clear them",
+			setup: func(rs *mocks.RoleService, ms *mocks.MetaSchemaService) {
+				ms.EXPECT().Validate(mock.AnythingOfType("metadata.Metadata"), roleMetaSchema).Return(nil)
+				rs.EXPECT().Update(mock.AnythingOfType("context.backgroundCtx"), role.Role{
+					ID:          testRoleMap[testRoleID].ID,
+					Title:       testRoleMap[testRoleID].Title,
+					Name:        testRoleMap[testRoleID].Name,
+					Permissions: testRoleMap[testRoleID].Permissions,
+					OrgID:       testRoleMap[testRoleID].OrgID,
+					Parents:     []string{},
+					Metadata:    testRoleMap[testRoleID].Metadata,
+				}).Return(testRoleMap[testRoleID], nil)
+			},
+			request: &frontierv1beta1.UpdateOrganizationRoleRequest{
+				Id:    testRoleMap[testRoleID].ID,
+				OrgId: testRoleMap[testRoleID].OrgID,
+				Body: &frontierv1beta1.RoleRequestBody{
+					Name:         testRoleMap[testRoleID].Name,
+					Title:        testRoleMap[testRoleID].Title,
+					Permissions:  testRoleMap[testRoleID].Permissions,
+					ClearParents: true,
+					Metadata: &structpb.Struct{
+						Fields: map[string]*structpb.Value{
+							"foo": structpb.NewStringValue("bar"),
+						},
+					},
+				},
+			},
+			want: &frontierv1beta1.UpdateOrganizationRoleResponse{
+				Role: &testRolePB,
+			},
+			wantErr: nil,
+		},
 	}
 
 	for _, tt := range tests {
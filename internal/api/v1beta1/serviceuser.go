@@ -3,11 +3,17 @@ package v1beta1
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"strings"
+	"time"
 
 	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/lestrrat-go/jwx/v2/jwk"
 	"github.com/raystack/frontier/core/audit"
+	"github.com/raystack/frontier/core/permission"
 	"github.com/raystack/frontier/core/serviceuser"
 	"github.com/raystack/frontier/pkg/metadata"
+	"github.com/raystack/frontier/pkg/utils"
 	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -16,6 +22,11 @@ import (
 
 var grpcServiceUserNotFound = status.Error(codes.NotFound, "service user not found")
 var grpcSvcUserCredNotFound = status.Error(codes.NotFound, "service user credentials not found")
+var (
+	grpcSvcUserCredInvalid       = status.Error(codes.InvalidArgument, serviceuser.ErrInvalidScope.Error())
+	grpcSvcUserCredExpiryInvalid = status.Error(codes.InvalidArgument, serviceuser.ErrInvalidExpiry.Error())
+)
+var grpcSvcUserKeyInvalid = status.Error(codes.InvalidArgument, serviceuser.ErrInvalidPublicKey.Error())
 
 type ServiceUserService interface {
 	List(ctx context.Context, flt serviceuser.Filter) ([]serviceuser.ServiceUser, error)
@@ -30,18 +41,28 @@ type ServiceUserService interface {
 	ListSecret(ctx context.Context, serviceUserID string) ([]serviceuser.Credential, error)
 	DeleteSecret(ctx context.Context, credID string) error
 	ListByOrg(ctx context.Context, orgID string) ([]serviceuser.ServiceUser, error)
+	CreateTrust(ctx context.Context, trust serviceuser.Trust) (serviceuser.Trust, error)
+	ListTrusts(ctx context.Context, serviceUserID string) ([]serviceuser.Trust, error)
+	GetTrust(ctx context.Context, id string) (serviceuser.Trust, error)
+	DeleteTrust(ctx context.Context, id string) error
+	GetByExternalToken(ctx context.Context, token, serviceUserID string) (serviceuser.ServiceUser, serviceuser.Trust, error)
 }
 
 func (h Handler) ListServiceUsers(ctx context.Context, request *frontierv1beta1.ListServiceUsersRequest) (*frontierv1beta1.ListServiceUsersResponse, error) {
 	logger := grpczap.Extract(ctx)
 	var users []*frontierv1beta1.ServiceUser
+	page, err := paginationFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
 	usersList, err := h.serviceUserService.List(ctx, serviceuser.Filter{
-		OrgID: request.GetOrgId(),
-		State: serviceuser.State(request.GetState()),
+		OrgID:      request.GetOrgId(),
+		State:      serviceuser.State(request.GetState()),
+		Pagination: page,
 	})
 	if err != nil {
 		logger.Error(err.Error())
-		return nil, grpcInternalServerError
+		return nil, grpcListErr(err)
 	}
 
 	for _, user := range usersList {
@@ -54,7 +75,8 @@ func (h Handler) ListServiceUsers(ctx context.Context, request *frontierv1beta1.
 	}
 
 	return &frontierv1beta1.ListServiceUsersResponse{
-		Serviceusers: users,
+		Serviceusers:  users,
+		NextPageToken: page.NextPageToken(),
 	}, nil
 }
 
@@ -133,22 +155,32 @@ func (h Handler) DeleteServiceUser(ctx context.Context, request *frontierv1beta1
 func (h Handler) CreateServiceUserKey(ctx context.Context, request *frontierv1beta1.CreateServiceUserKeyRequest) (*frontierv1beta1.CreateServiceUserKeyResponse, error) {
 	logger := grpczap.Extract(ctx)
 
-	svCred, err := h.serviceUserService.CreateKey(ctx, serviceuser.Credential{
-		ServiceUserID: request.GetId(),
-		Title:         request.GetTitle(),
-	})
+	cred, err := credentialFromRequest(request.GetExpiresAt(), request.GetPermissions(), request.GetProjectIds())
+	if err != nil {
+		return nil, err
+	}
+	cred.ServiceUserID = request.GetId()
+	cred.Title = request.GetTitle()
+	if err := keyFromRequest(request, &cred); err != nil {
+		return nil, err
+	}
+	svCred, err := h.serviceUserService.CreateKey(ctx, cred)
 	if err != nil {
 		logger.Error(err.Error())
 		switch {
 		case err == serviceuser.ErrNotExist:
 			return nil, grpcServiceUserNotFound
+		case err == serviceuser.ErrInvalidExpiry:
+			return nil, grpcSvcUserCredExpiryInvalid
+		case errors.Is(err, serviceuser.ErrInvalidPublicKey):
+			return nil, grpcSvcUserKeyInvalid
 		default:
 			return nil, grpcInternalServerError
 		}
 	}
 
 	svKey := &frontierv1beta1.KeyCredential{
-		Type:        serviceuser.DefaultKeyType,
+		Type:        serviceuser.KeyType(svCred),
 		Kid:         svCred.ID,
 		PrincipalId: svCred.ServiceUserID,
 		PrivateKey:  string(svCred.PrivateKey),
@@ -173,10 +205,12 @@ func (h Handler) ListServiceUserKeys(ctx context.Context, request *frontierv1bet
 	}
 
 	for _, svCred := range credList {
-		jwkJson, err := json.Marshal(svCred.PublicKey)
-		if err != nil {
-			logger.Error(err.Error())
-			return nil, grpcInternalServerError
+		var jwkJson []byte
+		if svCred.PublicKey != nil {
+			if jwkJson, err = json.Marshal(svCred.PublicKey); err != nil {
+				logger.Error(err.Error())
+				return nil, grpcInternalServerError
+			}
 		}
 		keys = append(keys, &frontierv1beta1.ServiceUserKey{
 			Id:          svCred.ID,
@@ -184,6 +218,12 @@ func (h Handler) ListServiceUserKeys(ctx context.Context, request *frontierv1bet
 			PrincipalId: svCred.ServiceUserID,
 			PublicKey:   string(jwkJson),
 			CreatedAt:   timestamppb.New(svCred.CreatedAt),
+			ExpiresAt:   optionalTimestamp(svCred.ExpiresAt),
+			LastUsedAt:  optionalTimestamp(svCred.LastUsedAt),
+			LastUsedIp:  svCred.LastUsedIP,
+			Permissions: svCred.Scope.Permissions,
+			ProjectIds:  svCred.Scope.ProjectIDs,
+			JwksUrl:     svCred.JWKSURL,
 		})
 	}
 	return &frontierv1beta1.ListServiceUserKeysResponse{
@@ -232,13 +272,21 @@ func (h Handler) DeleteServiceUserKey(ctx context.Context, request *frontierv1be
 
 func (h Handler) CreateServiceUserSecret(ctx context.Context, request *frontierv1beta1.CreateServiceUserSecretRequest) (*frontierv1beta1.CreateServiceUserSecretResponse, error) {
 	logger := grpczap.Extract(ctx)
-	secret, err := h.serviceUserService.CreateSecret(ctx, serviceuser.Credential{
-		ServiceUserID: request.GetId(),
-		Title:         request.GetTitle(),
-	})
+	cred, err := credentialFromRequest(request.GetExpiresAt(), request.GetPermissions(), request.GetProjectIds())
+	if err != nil {
+		return nil, err
+	}
+	cred.ServiceUserID = request.GetId()
+	cred.Title = request.GetTitle()
+	secret, err := h.serviceUserService.CreateSecret(ctx, cred)
 	if err != nil {
 		logger.Error(err.Error())
-		return nil, grpcInternalServerError
+		switch {
+		case err == serviceuser.ErrInvalidExpiry:
+			return nil, grpcSvcUserCredExpiryInvalid
+		default:
+			return nil, grpcInternalServerError
+		}
 	}
 	return &frontierv1beta1.CreateServiceUserSecretResponse{
 		Secret: &frontierv1beta1.SecretCredential{
@@ -260,9 +308,14 @@ func (h Handler) ListServiceUserSecrets(ctx context.Context, request *frontierv1
 	secretsPB := make([]*frontierv1beta1.SecretCredential, 0, len(credentials))
 	for _, sec := range credentials {
 		secretsPB = append(secretsPB, &frontierv1beta1.SecretCredential{
-			Id:        sec.ID,
-			Title:     sec.Title,
-			CreatedAt: timestamppb.New(sec.CreatedAt),
+			Id:          sec.ID,
+			Title:       sec.Title,
+			CreatedAt:   timestamppb.New(sec.CreatedAt),
+			ExpiresAt:   optionalTimestamp(sec.ExpiresAt),
+			LastUsedAt:  optionalTimestamp(sec.LastUsedAt),
+			LastUsedIp:  sec.LastUsedIP,
+			Permissions: sec.Scope.Permissions,
+			ProjectIds:  sec.Scope.ProjectIDs,
 		})
 	}
 	return &frontierv1beta1.ListServiceUserSecretsResponse{
@@ -280,6 +333,57 @@ func (h Handler) DeleteServiceUserSecret(ctx context.Context, request *frontierv
 	return &frontierv1beta1.DeleteServiceUserSecretResponse{}, nil
 }
 
+// credentialFromRequest returns a credential with the expiry and scope of a request
+func credentialFromRequest(expiresAt *timestamppb.Timestamp, permissions, projectIDs []string) (serviceuser.Credential, error) {
+	cred := serviceuser.Credential{}
+	if expiresAt != nil {
+		if err := expiresAt.CheckValid(); err != nil {
+			return cred, grpcSvcUserCredExpiryInvalid
+		}
+		cred.ExpiresAt = expiresAt.AsTime().UTC()
+	}
+	for _, perm := range permissions {
+		cred.Scope.Permissions = append(cred.Scope.Permissions, permission.ParsePermissionName(perm))
+	}
+	for _, projectID := range projectIDs {
+		if !utils.IsValidUUID(projectID) {
+			return cred, grpcSvcUserCredInvalid
+		}
+		cred.Scope.ProjectIDs = append(cred.Scope.ProjectIDs, projectID)
+	}
+	return cred, nil
+}
+
+// keyFromRequest sets the public key or key set url of a key pair the client
+// generated if either was sent in the request
+func keyFromRequest(request *frontierv1beta1.CreateServiceUserKeyRequest, cred *serviceuser.Credential) error {
+	if val := request.GetPublicKey(); val != "" {
+		key, err := serviceuser.ParsePublicKey(val)
+		if err != nil {
+			return grpcSvcUserKeyInvalid
+		}
+		cred.PublicKey = jwk.NewSet()
+		if err := cred.PublicKey.AddKey(key); err != nil {
+			return grpcSvcUserKeyInvalid
+		}
+	}
+	if val := strings.TrimSpace(request.GetJwksUrl()); val != "" {
+		if cred.PublicKey != nil {
+			return grpcSvcUserKeyInvalid
+		}
+		cred.JWKSURL = val
+	}
+	return nil
+}
+
+// optionalTimestamp returns nil for a zero time instead of the unix epoch
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
 func transformServiceUserToPB(usr serviceuser.ServiceUser) (*frontierv1beta1.ServiceUser, error) {
 	metaData, err := usr.Metadata.ToStructPB()
 	if err != nil {
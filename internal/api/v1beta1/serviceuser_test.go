@@ -82,8 +82,9 @@ func TestHandler_ListServiveUsers(t *testing.T) {
 			request: &frontierv1beta1.ListServiceUsersRequest{},
 			setup: func(su *mocks.ServiceUserService) {
 				su.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), serviceuser.Filter{
-					OrgID: "",
-					State: "",
+					Pagination: defaultPage(),
+					OrgID:      "",
+					State:      "",
 				}).Return(nil, errors.New("error"))
 			},
 			want:    nil,
@@ -93,8 +94,9 @@ func TestHandler_ListServiveUsers(t *testing.T) {
 			name: "Test List Service Users",
 			setup: func(su *mocks.ServiceUserService) {
 				su.EXPECT().List(mock.AnythingOfType("context.backgroundCtx"), serviceuser.Filter{
-					OrgID: "",
-					State: "",
+					Pagination: defaultPage(),
+					OrgID:      "",
+					State:      "",
 				}).Return([]serviceuser.ServiceUser{su1, su2}, nil)
 			},
 			request: &frontierv1beta1.ListServiceUsersRequest{},
@@ -399,6 +401,311 @@ var Key1PB = frontierv1beta1.KeyCredential{
 	Kid:         "1",
 }
 
+func TestHandler_CreateServiceUserKeyWithScope(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	tests := []struct {
+		name    string
+		setup   func(su *mocks.ServiceUserService)
+		request *frontierv1beta1.CreateServiceUserKeyRequest
+		want    *frontierv1beta1.CreateServiceUserKeyResponse
+		wantErr error
+	}{
+		{
+			name: "should return invalid argument error when expiry is not a valid timestamp",
+			request: &frontierv1beta1.CreateServiceUserKeyRequest{
+				ExpiresAt: &timestamppb.Timestamp{Nanos: -1},
+			},
+			want:    nil,
+			wantErr: grpcSvcUserCredExpiryInvalid,
+		},
+		{
+			name: "should return invalid argument error when project is not an id",
+			request: &frontierv1beta1.CreateServiceUserKeyRequest{
+				ProjectIds: []string{"my-project"},
+			},
+			want:    nil,
+			wantErr: grpcSvcUserCredInvalid,
+		},
+		{
+			name: "should return invalid argument error when expiry is in the past",
+			setup: func(su *mocks.ServiceUserService) {
+				su.EXPECT().CreateKey(mock.Anything, mock.AnythingOfType("serviceuser.Credential")).
+					Return(serviceuser.Credential{}, serviceuser.ErrInvalidExpiry)
+			},
+			request: &frontierv1beta1.CreateServiceUserKeyRequest{
+				ExpiresAt: timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			want:    nil,
+			wantErr: grpcSvcUserCredExpiryInvalid,
+		},
+		{
+			name: "should create an expiring key narrowed to the permissions and projects",
+			setup: func(su *mocks.ServiceUserService) {
+				su.EXPECT().CreateKey(mock.Anything, serviceuser.Credential{
+					ServiceUserID: "1",
+					Title:         "title",
+					ExpiresAt:     expiresAt,
+					Scope: serviceuser.Scope{
+						Permissions: []string{"app_project_get", "app_organization_get"},
+						ProjectIDs:  []string{"9f256f86-31a3-11ec-8d3d-0242ac130003"},
+					},
+				}).Return(suKey1PB, nil)
+			},
+			request: &frontierv1beta1.CreateServiceUserKeyRequest{
+				ExpiresAt:   timestamppb.New(expiresAt),
+				Permissions: []string{"app/project:get", "app_organization_get"},
+				ProjectIds:  []string{"9f256f86-31a3-11ec-8d3d-0242ac130003"},
+			},
+			want: &frontierv1beta1.CreateServiceUserKeyResponse{
+				Key: &Key1PB,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should return invalid argument error when the uploaded public key can't be parsed",
+			request: &frontierv1beta1.CreateServiceUserKeyRequest{
+				PublicKey: "bm90IGEga2V5",
+			},
+			want:    nil,
+			wantErr: grpcSvcUserKeyInvalid,
+		},
+		{
+			name: "should register a key set url instead of generating a key",
+			setup: func(su *mocks.ServiceUserService) {
+				su.EXPECT().CreateKey(mock.Anything, serviceuser.Credential{
+					ServiceUserID: "1",
+					Title:         "title",
+					JWKSURL:       "https://kms.acme.org/jwks.json",
+				}).Return(serviceuser.Credential{
+					ID:            "2",
+					ServiceUserID: "1",
+					JWKSURL:       "https://kms.acme.org/jwks.json",
+				}, nil)
+			},
+			request: &frontierv1beta1.CreateServiceUserKeyRequest{
+				JwksUrl: "https://kms.acme.org/jwks.json",
+			},
+			want: &frontierv1beta1.CreateServiceUserKeyResponse{
+				Key: &frontierv1beta1.KeyCredential{
+					Type:        serviceuser.JWKSKeyType,
+					Kid:         "2",
+					PrincipalId: "1",
+				},
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServiveUserSvc := new(mocks.ServiceUserService)
+			if tt.setup != nil {
+				tt.setup(mockServiveUserSvc)
+			}
+			h := Handler{
+				serviceUserService: mockServiveUserSvc,
+			}
+			request := tt.request
+			if request == nil {
+				request = &frontierv1beta1.CreateServiceUserKeyRequest{}
+			}
+			request.Id = "1"
+			request.Title = "title"
+			got, err := h.CreateServiceUserKey(context.Background(), request)
+			assert.EqualValues(t, tt.want, got)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_CreateServiceUserTrust(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(su *mocks.ServiceUserService)
+		request *frontierv1beta1.CreateServiceUserTrustRequest
+		want    *frontierv1beta1.CreateServiceUserTrustResponse
+		wantErr error
+	}{
+		{
+			name: "should register a trust of an external issuer",
+			setup: func(su *mocks.ServiceUserService) {
+				su.EXPECT().CreateTrust(mock.Anything, serviceuser.Trust{
+					ServiceUserID: "1",
+					Title:         "title",
+					Issuer:        "https://token.actions.githubusercontent.com",
+					JWKSURL:       "https://token.actions.githubusercontent.com/.well-known/jwks",
+					Subject:       "repo:acme/app:*",
+					Audience:      "frontier",
+					Claims:        map[string]string{"ref": "refs/heads/main", "workflow": "deploy"},
+					Scope:         serviceuser.Scope{Permissions: []string{"app_project_get"}},
+				}).Return(serviceuser.Trust{
+					ID:            "trust-1",
+					ServiceUserID: "1",
+					Issuer:        "https://token.actions.githubusercontent.com",
+					Audience:      "frontier",
+					CreatedAt:     time.Time{},
+				}, nil)
+			},
+			request: &frontierv1beta1.CreateServiceUserTrustRequest{
+				Issuer:      "https://token.actions.githubusercontent.com",
+				JwksUrl:     "https://token.actions.githubusercontent.com/.well-known/jwks",
+				Subject:     "repo:acme/app:*",
+				Audience:    "frontier",
+				Claims:      map[string]string{"ref": "refs/heads/main", "workflow": "deploy"},
+				Permissions: []string{"app_project_get"},
+			},
+			want: &frontierv1beta1.CreateServiceUserTrustResponse{
+				Trust: &frontierv1beta1.ServiceUserTrust{
+					Id:            "trust-1",
+					ServiceUserId: "1",
+					Issuer:        "https://token.actions.githubusercontent.com",
+					Audience:      "frontier",
+					CreatedAt:     timestamppb.New(time.Time{}),
+				},
+			},
+		},
+		{
+			name: "should return invalid argument error when a claim condition has no name",
+			request: &frontierv1beta1.CreateServiceUserTrustRequest{
+				Issuer:   "https://token.actions.githubusercontent.com",
+				Audience: "frontier",
+				Claims:   map[string]string{" ": "deploy"},
+			},
+			wantErr: grpcSvcUserTrustInvalid,
+		},
+		{
+			name: "should return invalid argument error when a trust has no keys",
+			setup: func(su *mocks.ServiceUserService) {
+				su.EXPECT().CreateTrust(mock.Anything, mock.AnythingOfType("serviceuser.Trust")).
+					Return(serviceuser.Trust{}, serviceuser.ErrInvalidTrust)
+			},
+			request: &frontierv1beta1.CreateServiceUserTrustRequest{
+				Issuer:   "https://token.actions.githubusercontent.com",
+				Subject:  "repo:acme/app:*",
+				Audience: "frontier",
+			},
+			wantErr: grpcSvcUserTrustInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServiveUserSvc := new(mocks.ServiceUserService)
+			if tt.setup != nil {
+				tt.setup(mockServiveUserSvc)
+			}
+			h := Handler{
+				serviceUserService: mockServiveUserSvc,
+			}
+			tt.request.Id = "1"
+			tt.request.Title = "title"
+			got, err := h.CreateServiceUserTrust(context.Background(), tt.request)
+			assert.EqualValues(t, tt.want, got)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_DeleteServiceUserTrust(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(su *mocks.ServiceUserService)
+		wantErr error
+	}{
+		{
+			name: "should delete a trust of the service user",
+			setup: func(su *mocks.ServiceUserService) {
+				su.EXPECT().GetTrust(mock.Anything, "trust-1").Return(serviceuser.Trust{ID: "trust-1", ServiceUserID: "1"}, nil)
+				su.EXPECT().DeleteTrust(mock.Anything, "trust-1").Return(nil)
+			},
+		},
+		{
+			name: "should return not found error when the trust belongs to another service user",
+			setup: func(su *mocks.ServiceUserService) {
+				su.EXPECT().GetTrust(mock.Anything, "trust-1").Return(serviceuser.Trust{ID: "trust-1", ServiceUserID: "2"}, nil)
+			},
+			wantErr: grpcSvcUserTrustNotFound,
+		},
+		{
+			name: "should return not found error when the trust doesn't exist",
+			setup: func(su *mocks.ServiceUserService) {
+				su.EXPECT().GetTrust(mock.Anything, "trust-1").Return(serviceuser.Trust{}, serviceuser.ErrTrustNotExist)
+			},
+			wantErr: grpcSvcUserTrustNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServiveUserSvc := new(mocks.ServiceUserService)
+			if tt.setup != nil {
+				tt.setup(mockServiveUserSvc)
+			}
+			h := Handler{
+				serviceUserService: mockServiveUserSvc,
+			}
+			_, err := h.DeleteServiceUserTrust(context.Background(), &frontierv1beta1.DeleteServiceUserTrustRequest{
+				Id:      "1",
+				TrustId: "trust-1",
+			})
+			assert.EqualValues(t, tt.wantErr, err)
+			mockServiveUserSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_AuthToken_TokenExchange(t *testing.T) {
+	tests := []struct {
+		name             string
+		setup            func(su *mocks.ServiceUserService)
+		subjectTokenType string
+		wantErr          error
+	}{
+		{
+			name:             "should return invalid argument error when subject token type isn't a jwt",
+			subjectTokenType: "urn:ietf:params:oauth:token-type:saml2",
+			wantErr:          grpcSubjectTokenInvalid,
+		},
+		{
+			name: "should return unauthenticated error when no service user trusts the token",
+			setup: func(su *mocks.ServiceUserService) {
+				su.EXPECT().GetByExternalToken(mock.Anything, "external-token", "").
+					Return(serviceuser.ServiceUser{}, serviceuser.Trust{}, serviceuser.ErrUntrustedToken)
+			},
+			subjectTokenType: serviceuser.JWTTokenType,
+			wantErr:          grpcUnauthenticated,
+		},
+		{
+			name: "should return invalid argument error when more than one service user trusts the token",
+			setup: func(su *mocks.ServiceUserService) {
+				su.EXPECT().GetByExternalToken(mock.Anything, "external-token", "").
+					Return(serviceuser.ServiceUser{}, serviceuser.Trust{}, serviceuser.ErrAmbiguousTrust)
+			},
+			subjectTokenType: serviceuser.IDTokenType,
+			wantErr:          grpcSvcUserTrustAmbiguous,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServiveUserSvc := new(mocks.ServiceUserService)
+			if tt.setup != nil {
+				tt.setup(mockServiveUserSvc)
+			}
+			h := Handler{
+				serviceUserService: mockServiveUserSvc,
+			}
+			_, err := h.AuthToken(context.Background(), &frontierv1beta1.AuthTokenRequest{
+				GrantType:        serviceuser.TokenExchangeGrantType,
+				SubjectToken:     "external-token",
+				SubjectTokenType: tt.subjectTokenType,
+			})
+			assert.EqualValues(t, tt.wantErr, err)
+			mockServiveUserSvc.AssertExpectations(t)
+		})
+	}
+}
+
 func TestHandler_ListServiceUserKeys(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -449,6 +756,37 @@ func TestHandler_ListServiceUserKeys(t *testing.T) {
 				},
 			},
 			wantErr: nil,
+		}, {
+			name: "should return expiry, scope and usage of service user keys",
+			setup: func(su *mocks.ServiceUserService) {
+				su.EXPECT().ListKeys(mock.AnythingOfType("context.backgroundCtx"), "1").Return([]serviceuser.Credential{{
+					ID:            "2",
+					ServiceUserID: "1",
+					Title:         "ci",
+					ExpiresAt:     time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+					LastUsedAt:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					LastUsedIP:    "10.0.0.1",
+					Scope:         serviceuser.Scope{Permissions: []string{"app_project_get"}},
+				}}, nil)
+			},
+			request: &frontierv1beta1.ListServiceUserKeysRequest{
+				Id: "1",
+			},
+			want: &frontierv1beta1.ListServiceUserKeysResponse{
+				Keys: []*frontierv1beta1.ServiceUserKey{
+					{
+						Id:          "2",
+						Title:       "ci",
+						PrincipalId: "1",
+						CreatedAt:   timestamppb.New(time.Time{}),
+						ExpiresAt:   timestamppb.New(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)),
+						LastUsedAt:  timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+						LastUsedIp:  "10.0.0.1",
+						Permissions: []string{"app_project_get"},
+					},
+				},
+			},
+			wantErr: nil,
 		},
 	}
 
@@ -680,6 +1018,21 @@ func TestHandler_CreateServiceUserSecret(t *testing.T) {
 			want:    nil,
 			wantErr: grpcInternalServerError,
 		},
+		{
+			name: "should return invalid argument error when expiry is in the past",
+			request: &frontierv1beta1.CreateServiceUserSecretRequest{
+				Id:        "1",
+				ExpiresAt: timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			setup: func(su *mocks.ServiceUserService) {
+				su.EXPECT().CreateSecret(mock.AnythingOfType("context.backgroundCtx"), serviceuser.Credential{
+					ServiceUserID: "1",
+					ExpiresAt:     time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				}).Return(serviceuser.Secret{}, serviceuser.ErrInvalidExpiry)
+			},
+			want:    nil,
+			wantErr: grpcSvcUserCredExpiryInvalid,
+		},
 		{
 			name: "should return service user secret",
 			setup: func(su *mocks.ServiceUserService) {
@@ -0,0 +1,181 @@
+package v1beta1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/serviceuser"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	grpcSvcUserTrustNotFound  = status.Error(codes.NotFound, serviceuser.ErrTrustNotExist.Error())
+	grpcSvcUserTrustInvalid   = status.Error(codes.InvalidArgument, serviceuser.ErrInvalidTrust.Error())
+	grpcSvcUserTrustAmbiguous = status.Error(codes.InvalidArgument, serviceuser.ErrAmbiguousTrust.Error())
+	grpcSubjectTokenInvalid   = status.Errorf(codes.InvalidArgument, "subject_token of type %s or %s is required",
+		serviceuser.JWTTokenType, serviceuser.IDTokenType)
+)
+
+// CreateServiceUserTrust lets tokens of an external issuer matching the trust
+// conditions authenticate as the service user
+func (h Handler) CreateServiceUserTrust(ctx context.Context, request *frontierv1beta1.CreateServiceUserTrustRequest) (*frontierv1beta1.CreateServiceUserTrustResponse, error) {
+	logger := grpczap.Extract(ctx)
+	cred, err := credentialFromRequest(request.GetExpiresAt(), request.GetPermissions(), request.GetProjectIds())
+	if err != nil {
+		return nil, err
+	}
+	trust := serviceuser.Trust{
+		ServiceUserID: request.GetId(),
+		Title:         request.GetTitle(),
+		Issuer:        strings.TrimSpace(request.GetIssuer()),
+		JWKSURL:       strings.TrimSpace(request.GetJwksUrl()),
+		Subject:       strings.TrimSpace(request.GetSubject()),
+		Audience:      strings.TrimSpace(request.GetAudience()),
+		Scope:         cred.Scope,
+		ExpiresAt:     cred.ExpiresAt,
+	}
+	if request.GetJwks() != "" {
+		if trust.JWKS, err = jwk.Parse([]byte(request.GetJwks())); err != nil {
+			return nil, grpcSvcUserTrustInvalid
+		}
+	}
+	for name, pattern := range request.GetClaims() {
+		if strings.TrimSpace(name) == "" {
+			return nil, grpcSvcUserTrustInvalid
+		}
+		if trust.Claims == nil {
+			trust.Claims = map[string]string{}
+		}
+		trust.Claims[strings.TrimSpace(name)] = strings.TrimSpace(pattern)
+	}
+
+	createdTrust, err := h.serviceUserService.CreateTrust(ctx, trust)
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, serviceuser.ErrNotExist), errors.Is(err, serviceuser.ErrInvalidID):
+			return nil, grpcServiceUserNotFound
+		case errors.Is(err, serviceuser.ErrInvalidTrust):
+			return nil, grpcSvcUserTrustInvalid
+		case errors.Is(err, serviceuser.ErrInvalidExpiry):
+			return nil, grpcSvcUserCredExpiryInvalid
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+	return &frontierv1beta1.CreateServiceUserTrustResponse{
+		Trust: transformTrustToPB(createdTrust),
+	}, nil
+}
+
+func (h Handler) ListServiceUserTrusts(ctx context.Context, request *frontierv1beta1.ListServiceUserTrustsRequest) (*frontierv1beta1.ListServiceUserTrustsResponse, error) {
+	logger := grpczap.Extract(ctx)
+	trusts, err := h.serviceUserService.ListTrusts(ctx, request.GetId())
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	var trustsPB []*frontierv1beta1.ServiceUserTrust
+	for _, trust := range trusts {
+		trustsPB = append(trustsPB, transformTrustToPB(trust))
+	}
+	return &frontierv1beta1.ListServiceUserTrustsResponse{
+		Trusts: trustsPB,
+	}, nil
+}
+
+func (h Handler) DeleteServiceUserTrust(ctx context.Context, request *frontierv1beta1.DeleteServiceUserTrustRequest) (*frontierv1beta1.DeleteServiceUserTrustResponse, error) {
+	logger := grpczap.Extract(ctx)
+	trust, err := h.serviceUserService.GetTrust(ctx, request.GetTrustId())
+	if err != nil {
+		if errors.Is(err, serviceuser.ErrTrustNotExist) {
+			return nil, grpcSvcUserTrustNotFound
+		}
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	// access is checked on the service user in the request
+	if trust.ServiceUserID != request.GetId() {
+		return nil, grpcSvcUserTrustNotFound
+	}
+	if err := h.serviceUserService.DeleteTrust(ctx, trust.ID); err != nil {
+		if errors.Is(err, serviceuser.ErrTrustNotExist) {
+			return nil, grpcSvcUserTrustNotFound
+		}
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+	return &frontierv1beta1.DeleteServiceUserTrustResponse{}, nil
+}
+
+// exchangeServiceUserToken returns an access token of the service user trusting
+// the subject token of an external issuer, narrowed to the scope of the trust
+func (h Handler) exchangeServiceUserToken(ctx context.Context, request *frontierv1beta1.AuthTokenRequest) (*frontierv1beta1.AuthTokenResponse, error) {
+	logger := grpczap.Extract(ctx)
+	if request.GetSubjectToken() == "" || (request.GetSubjectTokenType() != serviceuser.JWTTokenType &&
+		request.GetSubjectTokenType() != serviceuser.IDTokenType) {
+		return nil, grpcSubjectTokenInvalid
+	}
+	serviceUser, trust, err := h.serviceUserService.GetByExternalToken(ctx, request.GetSubjectToken(), request.GetServiceUserId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, serviceuser.ErrAmbiguousTrust):
+			return nil, grpcSvcUserTrustAmbiguous
+		case errors.Is(err, serviceuser.ErrUntrustedToken), errors.Is(err, serviceuser.ErrDisabled),
+			errors.Is(err, serviceuser.ErrNotExist):
+			return nil, grpcUnauthenticated
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	principal := authenticate.Principal{
+		ID:          serviceUser.ID,
+		Type:        schema.ServiceUserPrincipal,
+		ServiceUser: &serviceUser,
+	}
+	if !trust.Scope.IsEmpty() {
+		principal.Scope = &trust.Scope
+	}
+	token, err := h.getAccessToken(ctx, principal)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := setUserContextTokenInHeaders(ctx, string(token)); err != nil {
+		logger.Error(fmt.Errorf("error setting token in context: %w", err).Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &frontierv1beta1.AuthTokenResponse{
+		AccessToken:     string(token),
+		TokenType:       "Bearer",
+		IssuedTokenType: serviceuser.AccessTokenType,
+	}, nil
+}
+
+func transformTrustToPB(trust serviceuser.Trust) *frontierv1beta1.ServiceUserTrust {
+	return &frontierv1beta1.ServiceUserTrust{
+		Id:            trust.ID,
+		ServiceUserId: trust.ServiceUserID,
+		Title:         trust.Title,
+		Issuer:        trust.Issuer,
+		JwksUrl:       trust.JWKSURL,
+		Subject:       trust.Subject,
+		Audience:      trust.Audience,
+		Claims:        trust.Claims,
+		Permissions:   trust.Scope.Permissions,
+		ProjectIds:    trust.Scope.ProjectIDs,
+		ExpiresAt:     optionalTimestamp(trust.ExpiresAt),
+		CreatedAt:     timestamppb.New(trust.CreatedAt),
+	}
+}
@@ -52,17 +52,26 @@ func (h Handler) ListUsers(ctx context.Context, request *frontierv1beta1.ListUse
 	auditor := audit.GetAuditor(ctx, request.GetOrgId())
 
 	var users []*frontierv1beta1.User
-	usersList, err := h.userService.List(ctx, user.Filter{
+	page, err := paginationFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	flt := user.Filter{
 		Limit:   request.GetPageSize(),
 		Page:    request.GetPageNum(),
 		Keyword: request.GetKeyword(),
 		OrgID:   request.GetOrgId(),
 		GroupID: request.GetGroupId(),
 		State:   user.State(request.GetState()),
-	})
+	}
+	// deprecated page_num keeps paging by offset
+	if request.GetPageNum() == 0 {
+		flt.Pagination = page
+	}
+	usersList, err := h.userService.List(ctx, flt)
 	if err != nil {
 		logger.Error(err.Error())
-		return nil, grpcInternalServerError
+		return nil, grpcListErr(err)
 	}
 
 	for _, user := range usersList {
@@ -76,25 +85,36 @@ func (h Handler) ListUsers(ctx context.Context, request *frontierv1beta1.ListUse
 
 	auditor.Log(audit.UserListedEvent, audit.OrgTarget(request.GetOrgId()))
 	return &frontierv1beta1.ListUsersResponse{
-		Count: int32(len(users)),
-		Users: users,
+		Count:         int32(len(users)),
+		Users:         users,
+		NextPageToken: page.NextPageToken(),
 	}, nil
 }
 
 func (h Handler) ListAllUsers(ctx context.Context, request *frontierv1beta1.ListAllUsersRequest) (*frontierv1beta1.ListAllUsersResponse, error) {
 	logger := grpczap.Extract(ctx)
 	var users []*frontierv1beta1.User
-	usersList, err := h.userService.List(ctx, user.Filter{
-		Limit:   request.GetPageSize(),
-		Page:    request.GetPageNum(),
-		Keyword: request.GetKeyword(),
-		OrgID:   request.GetOrgId(),
-		GroupID: request.GetGroupId(),
-		State:   user.State(request.GetState()),
-	})
+	page, err := paginationFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	flt := user.Filter{
+		Limit:          request.GetPageSize(),
+		Page:           request.GetPageNum(),
+		Keyword:        request.GetKeyword(),
+		OrgID:          request.GetOrgId(),
+		GroupID:        request.GetGroupId(),
+		State:          user.State(request.GetState()),
+		IncludeDeleted: request.GetIncludeDeleted(),
+	}
+	// deprecated page_num keeps paging by offset
+	if request.GetPageNum() == 0 {
+		flt.Pagination = page
+	}
+	usersList, err := h.userService.List(ctx, flt)
 	if err != nil {
 		logger.Error(err.Error())
-		return nil, grpcInternalServerError
+		return nil, grpcListErr(err)
 	}
 
 	for _, user := range usersList {
@@ -107,8 +127,9 @@ func (h Handler) ListAllUsers(ctx context.Context, request *frontierv1beta1.List
 	}
 
 	return &frontierv1beta1.ListAllUsersResponse{
-		Count: int32(len(users)),
-		Users: users,
+		Count:         int32(len(users)),
+		Users:         users,
+		NextPageToken: page.NextPageToken(),
 	}, nil
 }
 
@@ -334,7 +355,7 @@ func (h Handler) UpdateCurrentUser(ctx context.Context, request *frontierv1beta1
 		return nil, grpcBadBodyError
 	}
 
-	principal, err := h.GetLoggedInPrincipal(ctx)
+	principal, err := h.accountOwner(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -647,6 +668,9 @@ func (h Handler) EnableUser(ctx context.Context, request *frontierv1beta1.Enable
 	logger := grpczap.Extract(ctx)
 	if err := h.userService.Enable(ctx, request.GetId()); err != nil {
 		logger.Error(err.Error())
+		if errors.Is(err, user.ErrNotExist) {
+			return nil, grpcUserNotFoundError
+		}
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 	return &frontierv1beta1.EnableUserResponse{}, nil
@@ -656,6 +680,9 @@ func (h Handler) DisableUser(ctx context.Context, request *frontierv1beta1.Disab
 	logger := grpczap.Extract(ctx)
 	if err := h.userService.Disable(ctx, request.GetId()); err != nil {
 		logger.Error(err.Error())
+		if errors.Is(err, user.ErrNotExist) {
+			return nil, grpcUserNotFoundError
+		}
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 	return &frontierv1beta1.DisableUserResponse{}, nil
@@ -663,8 +690,22 @@ func (h Handler) DisableUser(ctx context.Context, request *frontierv1beta1.Disab
 
 func (h Handler) DeleteUser(ctx context.Context, request *frontierv1beta1.DeleteUserRequest) (*frontierv1beta1.DeleteUserResponse, error) {
 	logger := grpczap.Extract(ctx)
-	if err := h.deleterService.DeleteUser(ctx, request.GetId()); err != nil {
+	report, token, err := h.checkDeleteImpact(ctx, request.GetId(), request.GetDryRun(), request.GetConfirmationToken(),
+		h.deleterService.UserImpact, user.ErrNotExist, grpcUserNotFoundError)
+	if err != nil {
+		return nil, err
+	}
+	if request.GetDryRun() {
+		return &frontierv1beta1.DeleteUserResponse{Report: report, ConfirmationToken: token}, nil
+	}
+	if err := h.deleterService.SoftDeleteUser(ctx, request.GetId()); err != nil {
 		logger.Error(err.Error())
+		if errors.Is(err, user.ErrNotExist) {
+			return nil, grpcUserNotFoundError
+		}
+		if ownerErr := lastOwnerError(err); ownerErr != nil {
+			return nil, ownerErr
+		}
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 	return &frontierv1beta1.DeleteUserResponse{}, nil
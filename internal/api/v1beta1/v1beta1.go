@@ -12,6 +12,7 @@ type Handler struct {
 
 	orgService         OrganizationService
 	projectService     ProjectService
+	folderService      FolderService
 	groupService       GroupService
 	roleService        RoleService
 	policyService      PolicyService
@@ -30,12 +31,20 @@ type Handler struct {
 	auditService       AuditService
 	domainService      DomainService
 	preferenceService  PreferenceService
+	jobService         JobService
+	mergerService      MergerService
+	identityService    IdentityService
+	exporterService    ExporterService
+	archiveService     ArchiveService
+	patService         PATService
+	emailService       EmailService
 }
 
 func Register(s *grpc.Server, deps api.Deps) error {
 	handler := &Handler{
 		orgService:         deps.OrgService,
 		projectService:     deps.ProjectService,
+		folderService:      deps.FolderService,
 		groupService:       deps.GroupService,
 		roleService:        deps.RoleService,
 		policyService:      deps.PolicyService,
@@ -54,6 +63,13 @@ func Register(s *grpc.Server, deps api.Deps) error {
 		auditService:       deps.AuditService,
 		domainService:      deps.DomainService,
 		preferenceService:  deps.PreferenceService,
+		jobService:         deps.JobService,
+		mergerService:      deps.MergerService,
+		identityService:    deps.IdentityService,
+		exporterService:    deps.ExporterService,
+		archiveService:     deps.ArchiveService,
+		patService:         deps.PATService,
+		emailService:       deps.EmailService,
 	}
 	s.RegisterService(&frontierv1beta1.FrontierService_ServiceDesc, handler)
 	s.RegisterService(&frontierv1beta1.AdminService_ServiceDesc, handler)
@@ -105,6 +105,7 @@ func ApplyServiceDefinitionOverAZSchema(serviceDef *schema.ServiceDefinition, ex
 	// this is required to bind roles over application authz hierarchy
 	var relationsForOrg []*azcore.Relation
 	var relationsForProject []*azcore.Relation
+	var relationsForFolder []*azcore.Relation
 	var relationsForRole []*azcore.Relation
 	var relationsForRoleBinding []*azcore.Relation
 
@@ -122,14 +123,19 @@ func ApplyServiceDefinitionOverAZSchema(serviceDef *schema.ServiceDefinition, ex
 		for _, permName := range permissions {
 			fqPermissionName := schema.FQPermissionNameFromNamespace(namespaceName, permName)
 
-			// create permissions
+			// create permissions, each excludes principals denied the permission or the
+			// administer permission granting it so a deny of an administer role takes away all of it
 			{
 				// for resource
-				nsRel, err := aznamespace.Relation(permName, aznamespace.Union(
-					aznamespace.ComputedUserset("owner"),
-					aznamespace.TupleToUserset("project", "app_project_administer"),
-					aznamespace.TupleToUserset("project", fqPermissionName),
-					aznamespace.TupleToUserset("granted", fqPermissionName),
+				nsRel, err := aznamespace.Relation(permName, aznamespace.Exclusion(
+					aznamespace.Rewrite(aznamespace.Union(
+						aznamespace.ComputedUserset("owner"),
+						aznamespace.TupleToUserset("project", "app_project_administer"),
+						aznamespace.TupleToUserset("project", fqPermissionName),
+						aznamespace.TupleToUserset("granted", fqPermissionName),
+					)),
+					aznamespace.TupleToUserset(schema.RoleDenyRelationName, "app_project_administer"),
+					aznamespace.TupleToUserset(schema.RoleDenyRelationName, fqPermissionName),
 				), nil)
 				if err != nil {
 					return nil, err
@@ -138,11 +144,18 @@ func ApplyServiceDefinitionOverAZSchema(serviceDef *schema.ServiceDefinition, ex
 			}
 			{
 				// for org
+				// superuser can't be denied a permission
 				nsRel, err := aznamespace.Relation(fqPermissionName, aznamespace.Union(
-					aznamespace.ComputedUserset("owner"),
 					aznamespace.TupleToUserset("platform", "superuser"),
-					aznamespace.TupleToUserset("granted", "app_organization_administer"),
-					aznamespace.TupleToUserset("granted", fqPermissionName),
+					aznamespace.Rewrite(aznamespace.Exclusion(
+						aznamespace.Rewrite(aznamespace.Union(
+							aznamespace.ComputedUserset("owner"),
+							aznamespace.TupleToUserset("granted", "app_organization_administer"),
+							aznamespace.TupleToUserset("granted", fqPermissionName),
+						)),
+						aznamespace.TupleToUserset(schema.RoleDenyRelationName, "app_organization_administer"),
+						aznamespace.TupleToUserset(schema.RoleDenyRelationName, fqPermissionName),
+					)),
 				), nil)
 				if err != nil {
 					return nil, err
@@ -151,16 +164,38 @@ func ApplyServiceDefinitionOverAZSchema(serviceDef *schema.ServiceDefinition, ex
 			}
 			{
 				// for project
-				nsRel, err := aznamespace.Relation(fqPermissionName, aznamespace.Union(
-					aznamespace.TupleToUserset("org", fqPermissionName),
-					aznamespace.TupleToUserset("granted", "app_project_administer"),
-					aznamespace.TupleToUserset("granted", fqPermissionName),
+				nsRel, err := aznamespace.Relation(fqPermissionName, aznamespace.Exclusion(
+					aznamespace.Rewrite(aznamespace.Union(
+						aznamespace.TupleToUserset("org", fqPermissionName),
+						aznamespace.TupleToUserset("folder", fqPermissionName),
+						aznamespace.TupleToUserset("granted", "app_project_administer"),
+						aznamespace.TupleToUserset("granted", fqPermissionName),
+					)),
+					aznamespace.TupleToUserset(schema.RoleDenyRelationName, "app_project_administer"),
+					aznamespace.TupleToUserset(schema.RoleDenyRelationName, fqPermissionName),
 				), nil)
 				if err != nil {
 					return nil, err
 				}
 				relationsForProject = append(relationsForProject, nsRel)
 			}
+			{
+				// for folder, inherited by nested folders and projects under it
+				nsRel, err := aznamespace.Relation(fqPermissionName, aznamespace.Exclusion(
+					aznamespace.Rewrite(aznamespace.Union(
+						aznamespace.TupleToUserset("org", fqPermissionName),
+						aznamespace.TupleToUserset("parent", fqPermissionName),
+						aznamespace.TupleToUserset("granted", "app_folder_administer"),
+						aznamespace.TupleToUserset("granted", fqPermissionName),
+					)),
+					aznamespace.TupleToUserset(schema.RoleDenyRelationName, "app_folder_administer"),
+					aznamespace.TupleToUserset(schema.RoleDenyRelationName, fqPermissionName),
+				), nil)
+				if err != nil {
+					return nil, err
+				}
+				relationsForFolder = append(relationsForFolder, nsRel)
+			}
 			{
 				// for rolebinding
 				nsRel, err := aznamespace.Relation(fqPermissionName, aznamespace.Intersection(
@@ -197,6 +232,9 @@ func ApplyServiceDefinitionOverAZSchema(serviceDef *schema.ServiceDefinition, ex
 		// attach role binding to service
 		relationsForResource = append(relationsForResource, aznamespace.MustRelation("granted", nil,
 			aznamespace.AllowedRelation(schema.RoleBindingNamespace, generator.Ellipsis)))
+		// attach deny role binding to service
+		relationsForResource = append(relationsForResource, aznamespace.MustRelation(schema.RoleDenyRelationName, nil,
+			aznamespace.AllowedRelation(schema.RoleBindingNamespace, generator.Ellipsis)))
 
 		// prepare a new az definition
 		resourceDef := aznamespace.Namespace(namespaceName, relationsForResource...)
@@ -215,6 +253,9 @@ func ApplyServiceDefinitionOverAZSchema(serviceDef *schema.ServiceDefinition, ex
 			case schema.ProjectNamespace:
 				// populate app/project with service permissions to allow bounding service roles at project level
 				baseDef.Relation = append(baseDef.Relation, relationsForProject...)
+			case schema.FolderNamespace:
+				// populate app/folder with service permissions to allow bounding service roles at folder level
+				baseDef.Relation = append(baseDef.Relation, relationsForFolder...)
 			case schema.RoleBindingNamespace:
 				// populate app/rolebinding with service relations to allow checking service roles with permissions
 				baseDef.Relation = append(baseDef.Relation, relationsForRoleBinding...)
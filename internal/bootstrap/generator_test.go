@@ -7,7 +7,12 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/authzed/spicedb/pkg/development"
+	azcore "github.com/authzed/spicedb/pkg/proto/core/v1"
+	devinterface "github.com/authzed/spicedb/pkg/proto/developer/v1"
+	v1dispatch "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
 	"github.com/authzed/spicedb/pkg/schemadsl/compiler"
+	"github.com/authzed/spicedb/pkg/tuple"
 	"github.com/raystack/frontier/internal/bootstrap"
 	"github.com/raystack/frontier/internal/bootstrap/schema"
 	"github.com/stretchr/testify/assert"
@@ -30,7 +35,7 @@ func TestCompileSchema(t *testing.T) {
 
 	appService, err := bootstrap.BuildServiceDefinitionFromAZSchema(compiledSchema.ObjectDefinitions, "app")
 	assert.NoError(t, err)
-	assert.Len(t, appService.Permissions, 23)
+	assert.Len(t, appService.Permissions, 27)
 }
 
 func TestAddServiceToSchema(t *testing.T) {
@@ -71,3 +76,74 @@ func TestAddServiceToSchema(t *testing.T) {
 	}
 	assert.Equal(t, compiledSchemaZed, authzedSchemaSource)
 }
+
+func TestDenyAdministerSchema(t *testing.T) {
+	tenantName := "frontier"
+	existingSchema, err := compiler.Compile(compiler.InputSchema{
+		Source:       "base_schema.zed",
+		SchemaString: schema.BaseSchemaZed,
+	}, &tenantName)
+	assert.NoError(t, err)
+
+	computeServiceDefinition := schema.ServiceDefinition{}
+	err = yaml.Unmarshal(computeSchemaYaml, &computeServiceDefinition)
+	assert.NoError(t, err)
+	spiceDBDefinitions, err := bootstrap.ApplyServiceDefinitionOverAZSchema(&computeServiceDefinition, existingSchema.ObjectDefinitions)
+	assert.NoError(t, err)
+	authzedSchemaSource, err := bootstrap.PrepareSchemaAsAZSource(spiceDBDefinitions)
+	assert.NoError(t, err)
+
+	// jane is granted and denied the same org admin role, a platform
+	// superuser can't be denied
+	relationships := []string{
+		"app/role:admin#app_organization_administer@app/user:*",
+		"app/role:admin#app_project_administer@app/user:*",
+		"app/organization:acme#platform@app/platform:frontier",
+		"app/platform:frontier#admin@app/user:root",
+		"app/organization:acme#granted@app/rolebinding:allow",
+		"app/rolebinding:allow#bearer@app/user:jane",
+		"app/rolebinding:allow#role@app/role:admin",
+		"app/rolebinding:allow#bearer@app/user:john",
+		"app/project:shop#org@app/organization:acme",
+		"app/project:shop#granted@app/rolebinding:allow",
+		"app/project:shop#denied@app/rolebinding:deny",
+		"app/organization:acme#denied@app/rolebinding:deny",
+		"app/rolebinding:deny#bearer@app/user:jane",
+		"app/rolebinding:deny#bearer@app/user:root",
+		"app/rolebinding:deny#role@app/role:admin",
+	}
+	var tuples []*azcore.RelationTuple
+	for _, rel := range relationships {
+		tuples = append(tuples, tuple.MustParse(rel))
+	}
+	devCtx, devErrs, err := development.NewDevContext(context.Background(), &devinterface.RequestContext{
+		Schema:        authzedSchemaSource,
+		Relationships: tuples,
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, devErrs)
+	defer devCtx.Dispose()
+
+	tests := []struct {
+		resource string
+		subject  string
+		want     bool
+	}{
+		{resource: "app/organization:acme#update", subject: "app/user:john", want: true},
+		{resource: "app/organization:acme#update", subject: "app/user:jane", want: false},
+		{resource: "app/organization:acme#project_delete", subject: "app/user:jane", want: false},
+		{resource: "app/organization:acme#compute_order_get", subject: "app/user:john", want: true},
+		{resource: "app/organization:acme#compute_order_get", subject: "app/user:jane", want: false},
+		{resource: "app/organization:acme#update", subject: "app/user:root", want: true},
+		{resource: "app/project:shop#delete", subject: "app/user:john", want: true},
+		{resource: "app/project:shop#delete", subject: "app/user:jane", want: false},
+		{resource: "app/project:shop#compute_order_get", subject: "app/user:jane", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.resource+"@"+tt.subject, func(t *testing.T) {
+			result, err := development.RunCheck(devCtx, tuple.ParseONR(tt.resource), tuple.ParseSubjectONR(tt.subject), nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, result.Permissionship == v1dispatch.ResourceCheckResult_MEMBER)
+		})
+	}
+}
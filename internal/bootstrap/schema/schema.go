@@ -22,6 +22,7 @@ const (
 	OrganizationNamespace = "app/organization"
 	ProjectNamespace      = "app/project"
 	GroupNamespace        = "app/group"
+	FolderNamespace       = "app/folder"
 	RoleBindingNamespace  = "app/rolebinding"
 	RoleNamespace         = "app/role"
 	InvitationNamespace   = "app/invitation"
@@ -33,10 +34,13 @@ const (
 	UserRelationName         = "user"
 	ProjectRelationName      = "project"
 	GroupRelationName        = "group"
+	FolderRelationName       = "folder"
+	ParentRelationName       = "parent"
 	MemberRelationName       = "member"
 	OwnerRelationName        = "owner"
 	RoleRelationName         = "role"
 	RoleGrantRelationName    = "granted"
+	RoleDenyRelationName     = "denied"
 	RoleBearerRelationName   = "bearer"
 
 	// permissions
@@ -69,11 +73,14 @@ const (
 	SuperUserPrincipal   = "app/superuser"
 
 	// Roles
-	RoleProjectOwner   = "app_project_owner"
-	RoleProjectManager = "app_project_manager"
-	RoleProjectViewer  = "app_project_viewer"
-	GroupOwnerRole     = "app_group_owner"
-	GroupMemberRole    = "app_group_member"
+	RoleOrganizationOwner = "app_organization_owner"
+	RoleProjectOwner      = "app_project_owner"
+	RoleProjectManager    = "app_project_manager"
+	RoleProjectViewer     = "app_project_viewer"
+	GroupOwnerRole        = "app_group_owner"
+	GroupMemberRole       = "app_group_member"
+	RoleFolderOwner       = "app_folder_owner"
+	RoleFolderViewer      = "app_folder_viewer"
 )
 
 var (
@@ -128,6 +135,10 @@ type RoleDefinition struct {
 	Description string   `yaml:"description"`
 	Scopes      []string `yaml:"scopes"`
 	Permissions []string `yaml:"permissions"`
+
+	// Parents are names of roles whose permissions are inherited by this role,
+	// they must be defined before the role itself
+	Parents []string `yaml:"parents"`
 }
 
 // ResourcePermission with which roles will be created. Whenever an action is performed
@@ -212,6 +223,8 @@ func ParseNamespaceAliasIfRequired(n string) string {
 		n = OrganizationNamespace
 	case "project":
 		n = ProjectNamespace
+	case "folder":
+		n = FolderNamespace
 	}
 	return n
 }
@@ -238,7 +251,7 @@ func IsSystemNamespace(namespace string) bool {
 	return namespace == OrganizationNamespace || namespace == ProjectNamespace ||
 		namespace == UserPrincipal || namespace == ServiceUserPrincipal ||
 		namespace == SuperUserPrincipal || namespace == GroupPrincipal ||
-		namespace == PlatformNamespace
+		namespace == PlatformNamespace || namespace == FolderNamespace
 }
 
 // IsValidPermissionName checks if the provided name is a valid permission name
@@ -259,7 +272,7 @@ var PredefinedRoles = []RoleDefinition{
 	// org
 	{
 		Title: "Organization Owner",
-		Name:  "app_organization_owner",
+		Name:  RoleOrganizationOwner,
 		Permissions: []string{
 			"app_organization_administer",
 		},
@@ -345,4 +358,21 @@ var PredefinedRoles = []RoleDefinition{
 		},
 		Scopes: []string{GroupNamespace},
 	},
+	// folder
+	{
+		Title: "Folder Owner",
+		Name:  RoleFolderOwner,
+		Permissions: []string{
+			"app_folder_administer",
+		},
+		Scopes: []string{FolderNamespace},
+	},
+	{
+		Title: "Folder Viewer",
+		Name:  RoleFolderViewer,
+		Permissions: []string{
+			"app_folder_get",
+		},
+		Scopes: []string{FolderNamespace},
+	},
 }
@@ -2,12 +2,15 @@ package bootstrap
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	azcore "github.com/authzed/spicedb/pkg/proto/core/v1"
 
 	"github.com/raystack/frontier/core/namespace"
 	"github.com/raystack/frontier/core/permission"
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/relation"
 	"github.com/raystack/frontier/core/role"
 	"github.com/raystack/frontier/internal/bootstrap/schema"
 )
@@ -23,10 +26,23 @@ type NamespaceService interface {
 type PermissionService interface {
 	List(ctx context.Context, flt permission.Filter) ([]permission.Permission, error)
 	Upsert(ctx context.Context, action permission.Permission) (permission.Permission, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type RelationService interface {
+	ListRelations(ctx context.Context, rel relation.Relation) ([]relation.Relation, error)
+	Delete(ctx context.Context, rel relation.Relation) error
 }
 
 type RoleService interface {
+	Get(ctx context.Context, id string) (role.Role, error)
 	Upsert(ctx context.Context, toCreate role.Role) (role.Role, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type PolicyService interface {
+	List(ctx context.Context, flt policy.Filter) ([]policy.Policy, error)
+	Delete(ctx context.Context, id string) error
 }
 
 type UserService interface {
@@ -56,6 +72,9 @@ type Service struct {
 	permissionService PermissionService
 	authzEngine       AuthzEngine
 	userService       UserService
+	relationService   RelationService
+	policyService     PolicyService
+	versionRepository SchemaVersionRepository
 }
 
 func NewBootstrapService(
@@ -65,7 +84,10 @@ func NewBootstrapService(
 	roleService RoleService,
 	actionService PermissionService,
 	userService UserService,
-	authzEngine AuthzEngine) *Service {
+	authzEngine AuthzEngine,
+	relationService RelationService,
+	policyService PolicyService,
+	versionRepository SchemaVersionRepository) *Service {
 	return &Service{
 		adminConfig:       config,
 		schemaConfig:      schemaConfig,
@@ -74,6 +96,9 @@ func NewBootstrapService(
 		permissionService: actionService,
 		userService:       userService,
 		authzEngine:       authzEngine,
+		relationService:   relationService,
+		policyService:     policyService,
+		versionRepository: versionRepository,
 	}
 }
 
@@ -86,20 +111,214 @@ func (s Service) MigrateSchema(ctx context.Context) error {
 	return s.AppendSchema(ctx, *customServiceDefinition)
 }
 
+// AppendSchema merges the definition over the applied one. Permissions and roles
+// already applied are kept, the ones present in both are taken from the new
+// definition so that updated descriptions and role permissions are applied.
 func (s Service) AppendSchema(ctx context.Context, customServiceDefinition schema.ServiceDefinition) error {
 	// get existing permissions and append to the new definition
 	// this is required to avoid overriding existing permissions in authzed engine
+	existingServiceDefinition, err := s.currentDefinition(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the applied definition is merged first so the new one wins on conflicts, it
+	// carries the roles of the last version as well and taking them over the new
+	// definition would never apply updated role permissions
+	return s.applySchema(ctx, schema.MergeServiceDefinitions(existingServiceDefinition, customServiceDefinition))
+}
+
+// PlanSchema returns the changes appending the definition will make
+// without applying anything over the authz engine or db
+func (s Service) PlanSchema(ctx context.Context, customServiceDefinition schema.ServiceDefinition) (SchemaDiff, error) {
+	existingServiceDefinition, err := s.currentDefinition(ctx)
+	if err != nil {
+		return SchemaDiff{}, err
+	}
+	return s.diffSchema(ctx, existingServiceDefinition,
+		*schema.MergeServiceDefinitions(existingServiceDefinition, customServiceDefinition))
+}
+
+// PlanMigration returns the changes MigrateSchema will make
+func (s Service) PlanMigration(ctx context.Context) (SchemaDiff, error) {
+	customServiceDefinition, err := s.schemaConfig.GetDefinition(ctx)
+	if err != nil {
+		return SchemaDiff{}, err
+	}
+	return s.PlanSchema(ctx, *customServiceDefinition)
+}
+
+// ListSchemaVersions returns all the applied schema versions, oldest first
+func (s Service) ListSchemaVersions(ctx context.Context) ([]SchemaVersion, error) {
+	return s.versionRepository.List(ctx)
+}
+
+// PlanRollback returns the changes rolling back to the version will make
+func (s Service) PlanRollback(ctx context.Context, version int64) (SchemaDiff, error) {
+	target, err := s.versionRepository.Get(ctx, version)
+	if err != nil {
+		return SchemaDiff{}, err
+	}
+	existingServiceDefinition, err := s.currentDefinition(ctx)
+	if err != nil {
+		return SchemaDiff{}, err
+	}
+	return s.diffSchema(ctx, existingServiceDefinition, target.Definition)
+}
+
+// RollbackSchema applies a previously applied schema version, permissions and
+// roles added after it are removed and the roles it had are created again. If
+// removed permissions or roles are still in use by roles, resources or policies,
+// rollback fails unless force is set in which case the orphaned relations and
+// policies are deleted first.
+func (s Service) RollbackSchema(ctx context.Context, version int64, force bool) (SchemaDiff, error) {
+	target, err := s.versionRepository.Get(ctx, version)
+	if err != nil {
+		return SchemaDiff{}, err
+	}
+	existingServiceDefinition, err := s.currentDefinition(ctx)
+	if err != nil {
+		return SchemaDiff{}, err
+	}
+	diff, err := s.diffSchema(ctx, existingServiceDefinition, target.Definition)
+	if err != nil {
+		return SchemaDiff{}, err
+	}
+	if (len(diff.OrphanedRelations) > 0 || len(diff.OrphanedPolicies) > 0) && !force {
+		return diff, ErrOrphanedRelations
+	}
+
+	// roles can't be deleted while policies still grant them
+	for _, pol := range diff.OrphanedPolicies {
+		if err = s.policyService.Delete(ctx, pol.ID); err != nil {
+			return diff, fmt.Errorf("failed to delete orphaned policy %s: %w", pol.ID, err)
+		}
+	}
+	// authz engine rejects a schema which drops relations still holding tuples
+	for _, rel := range diff.OrphanedRelations {
+		if err = s.relationService.Delete(ctx, rel); err != nil {
+			return diff, fmt.Errorf("failed to delete orphaned relation: %w", err)
+		}
+	}
+
+	targetDefinition := target.Definition
+	if err = s.applySchema(ctx, &targetDefinition); err != nil {
+		return diff, err
+	}
+
+	if err = s.deleteRoles(ctx, diff.RemovedRoles); err != nil {
+		return diff, err
+	}
+	// roles of the version are upserted, which reverts modified roles as well
+	if err = s.createRoles(ctx, append(append([]schema.RoleDefinition{}, diff.AddedRoles...), diff.ModifiedRoles...)); err != nil {
+		return diff, err
+	}
+
+	// remove permissions which are no longer part of the schema
+	if len(diff.RemovedPermissions) > 0 {
+		removedSlugs := make([]string, 0, len(diff.RemovedPermissions))
+		for _, perm := range diff.RemovedPermissions {
+			removedSlugs = append(removedSlugs, perm.Slug())
+		}
+		existingPermissions, err := s.permissionService.List(ctx, permission.Filter{Slugs: removedSlugs})
+		if err != nil {
+			return diff, err
+		}
+		for _, perm := range existingPermissions {
+			if err = s.permissionService.Delete(ctx, perm.ID); err != nil {
+				return diff, fmt.Errorf("failed to delete permission %s: %w", perm.Slug, err)
+			}
+		}
+	}
+	return diff, nil
+}
+
+// createRoles creates platform roles, parents are created before the roles
+// inheriting from them as stored versions don't keep the definition order
+func (s Service) createRoles(ctx context.Context, roles []schema.RoleDefinition) error {
+	pending := roles
+	for len(pending) > 0 {
+		pendingNames := make(map[string]bool, len(pending))
+		for _, defRole := range pending {
+			pendingNames[defRole.Name] = true
+		}
+
+		var waiting []schema.RoleDefinition
+		for _, defRole := range pending {
+			if hasPendingParent(defRole, pendingNames) {
+				waiting = append(waiting, defRole)
+				continue
+			}
+			if err := s.createRole(ctx, defaultOrgID, defRole); err != nil {
+				return err
+			}
+		}
+		if len(waiting) == len(pending) {
+			return fmt.Errorf("can't migrate role %s: %w: parents form a cycle", waiting[0].Name, schema.ErrMigration)
+		}
+		pending = waiting
+	}
+	return nil
+}
+
+func hasPendingParent(defRole schema.RoleDefinition, pending map[string]bool) bool {
+	for _, parent := range defRole.Parents {
+		if pending[parent] {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteRoles removes platform roles, a role inheriting from another removed
+// role is deleted before its parent
+func (s Service) deleteRoles(ctx context.Context, roles []schema.RoleDefinition) error {
+	var pending []role.Role
+	for _, defRole := range roles {
+		existing, err := s.roleService.Get(ctx, defRole.Name)
+		if err != nil {
+			if errors.Is(err, role.ErrNotExist) {
+				continue
+			}
+			return err
+		}
+		pending = append(pending, existing)
+	}
+
+	for len(pending) > 0 {
+		var inUse []role.Role
+		for _, r := range pending {
+			if err := s.roleService.Delete(ctx, r.ID); err != nil {
+				if errors.Is(err, role.ErrParentInUse) {
+					inUse = append(inUse, r)
+					continue
+				}
+				return fmt.Errorf("failed to delete role %s: %w", r.Name, err)
+			}
+		}
+		if len(inUse) == len(pending) {
+			// remaining roles are parents of roles which are not part of the rollback
+			return fmt.Errorf("failed to delete role %s: %w", inUse[0].Name, role.ErrParentInUse)
+		}
+		pending = inUse
+	}
+	return nil
+}
+
+// currentDefinition builds the custom service definition applied at the moment,
+// permissions are read from db and roles from the last applied version
+func (s Service) currentDefinition(ctx context.Context) (schema.ServiceDefinition, error) {
 	var existingServiceDefinition schema.ServiceDefinition
 
 	existingPermissions, err := s.permissionService.List(ctx, permission.Filter{})
 	if err != nil {
-		return nil
+		return existingServiceDefinition, err
 	}
 	for _, existingPermission := range existingPermissions {
 		description := ""
 		if existingPermission.Metadata != nil {
-			if v, ok := existingPermission.Metadata["description"]; !ok {
-				description = v.(string)
+			if v, ok := existingPermission.Metadata["description"].(string); ok {
+				description = v
 			}
 		}
 		existingServiceDefinition.Permissions = append(existingServiceDefinition.Permissions, schema.ResourcePermission{
@@ -108,8 +327,88 @@ func (s Service) AppendSchema(ctx context.Context, customServiceDefinition schem
 			Description: description,
 		})
 	}
+	existingServiceDefinition.Permissions = filterDefaultAppNamespacePermissions(existingServiceDefinition.Permissions)
 
-	return s.applySchema(ctx, schema.MergeServiceDefinitions(customServiceDefinition, existingServiceDefinition))
+	latest, err := s.versionRepository.Latest(ctx)
+	if err != nil && !errors.Is(err, ErrSchemaVersionNotExist) {
+		return existingServiceDefinition, err
+	}
+	existingServiceDefinition.Roles = latest.Definition.Roles
+	return existingServiceDefinition, nil
+}
+
+// diffSchema compares definitions and looks up relations in authz engine
+// which will be left without a permission or namespace once applied
+func (s Service) diffSchema(ctx context.Context, from, to schema.ServiceDefinition) (SchemaDiff, error) {
+	from.Permissions = filterDefaultAppNamespacePermissions(from.Permissions)
+	to.Permissions = filterDefaultAppNamespacePermissions(to.Permissions)
+	diff := diffDefinitions(from, to)
+
+	remainingNamespaces := map[string]bool{}
+	for _, perm := range to.Permissions {
+		remainingNamespaces[perm.GetNamespace()] = true
+	}
+	removedNamespaces := map[string]bool{}
+	for _, perm := range diff.RemovedPermissions {
+		if !remainingNamespaces[perm.GetNamespace()] {
+			removedNamespaces[perm.GetNamespace()] = true
+		}
+
+		// roles granting the permission
+		for _, principal := range []string{schema.UserPrincipal, schema.ServiceUserPrincipal} {
+			orphans, err := s.listOrphanedRelations(ctx, relation.Relation{
+				Object:       relation.Object{Namespace: schema.RoleNamespace},
+				Subject:      relation.Subject{Namespace: principal},
+				RelationName: perm.Slug(),
+			})
+			if err != nil {
+				return diff, err
+			}
+			diff.OrphanedRelations = append(diff.OrphanedRelations, orphans...)
+		}
+	}
+
+	// resources of namespaces which no longer exist
+	for ns := range removedNamespaces {
+		orphans, err := s.listOrphanedRelations(ctx, relation.Relation{
+			Object:       relation.Object{Namespace: ns},
+			Subject:      relation.Subject{Namespace: schema.ProjectNamespace},
+			RelationName: schema.ProjectRelationName,
+		})
+		if err != nil {
+			return diff, err
+		}
+		diff.OrphanedRelations = append(diff.OrphanedRelations, orphans...)
+	}
+
+	// policies granting platform roles which no longer exist
+	for _, defRole := range diff.RemovedRoles {
+		existing, err := s.roleService.Get(ctx, defRole.Name)
+		if err != nil {
+			if errors.Is(err, role.ErrNotExist) {
+				continue
+			}
+			return diff, err
+		}
+		policies, err := s.policyService.List(ctx, policy.Filter{RoleID: existing.ID})
+		if err != nil {
+			return diff, fmt.Errorf("failed to list policies of role %s: %w", defRole.Name, err)
+		}
+		diff.OrphanedPolicies = append(diff.OrphanedPolicies, policies...)
+	}
+	return diff, nil
+}
+
+func (s Service) listOrphanedRelations(ctx context.Context, filter relation.Relation) ([]relation.Relation, error) {
+	rels, err := s.relationService.ListRelations(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list relations of %s#%s: %w", filter.Object.Namespace, filter.RelationName, err)
+	}
+	for i := range rels {
+		rels[i].RelationName = filter.RelationName
+		rels[i].Subject.SubRelationName = ""
+	}
+	return rels, nil
 }
 
 // applySchema builds and apply schema over az engine and db
@@ -142,6 +441,14 @@ func (s Service) applySchema(ctx context.Context, customServiceDefinition *schem
 	if err != nil {
 		return fmt.Errorf("BuildServiceDefinitionFromAZSchema : %w", err)
 	}
+	// az schema doesn't carry descriptions, keep the ones of the custom definition
+	descriptions := make(map[string]string, len(customServiceDefinition.Permissions))
+	for _, perm := range customServiceDefinition.Permissions {
+		descriptions[perm.Slug()] = perm.Description
+	}
+	for i, perm := range appServiceDefinition.Permissions {
+		appServiceDefinition.Permissions[i].Description = descriptions[perm.Slug()]
+	}
 	if err = s.migrateAZDefinitionsToDB(ctx, authzedDefinitions); err != nil {
 		return fmt.Errorf("migrateAZDefinitionsToDB : %w", err)
 	}
@@ -154,6 +461,26 @@ func (s Service) applySchema(ctx context.Context, customServiceDefinition *schem
 		return fmt.Errorf("%w: %s", schema.ErrMigration, err.Error())
 	}
 
+	return s.recordSchemaVersion(ctx, *customServiceDefinition, authzedSchemaSource)
+}
+
+// recordSchemaVersion stores the applied definition as a new version
+// unless it is the same as the last applied one
+func (s Service) recordSchemaVersion(ctx context.Context, definition schema.ServiceDefinition, source string) error {
+	latest, err := s.versionRepository.Latest(ctx)
+	if err != nil && !errors.Is(err, ErrSchemaVersionNotExist) {
+		return err
+	}
+	if err == nil && latest.Source == source && isSameDefinition(latest.Definition, definition) {
+		return nil
+	}
+
+	if _, err = s.versionRepository.Create(ctx, SchemaVersion{
+		Definition: normalizeDefinition(definition),
+		Source:     source,
+	}); err != nil {
+		return fmt.Errorf("failed to record schema version: %w", err)
+	}
 	return nil
 }
 
@@ -207,6 +534,7 @@ func (s Service) createRole(ctx context.Context, orgID string, defRole schema.Ro
 		OrgID:       orgID,
 		Permissions: defRole.Permissions,
 		Scopes:      defRole.Scopes,
+		Parents:     defRole.Parents,
 		Metadata: map[string]any{
 			"description": defRole.Description,
 		},
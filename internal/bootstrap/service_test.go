@@ -0,0 +1,414 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/namespace"
+	"github.com/raystack/frontier/core/permission"
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/role"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/raystack/frontier/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type memNamespaceService struct{}
+
+func (memNamespaceService) Upsert(ctx context.Context, ns namespace.Namespace) (namespace.Namespace, error) {
+	return ns, nil
+}
+
+type memPermissionService struct {
+	permissions map[string]permission.Permission
+	listErr     error
+}
+
+func (s *memPermissionService) List(ctx context.Context, flt permission.Filter) ([]permission.Permission, error) {
+	if s.listErr != nil {
+		return nil, s.listErr
+	}
+	var perms []permission.Permission
+	for _, perm := range s.permissions {
+		if len(flt.Slugs) > 0 && !utils.Contains(flt.Slugs, perm.Slug) {
+			continue
+		}
+		perms = append(perms, perm)
+	}
+	return perms, nil
+}
+
+func (s *memPermissionService) Upsert(ctx context.Context, perm permission.Permission) (permission.Permission, error) {
+	perm.Slug = perm.GenerateSlug()
+	perm.ID = perm.Slug
+	s.permissions[perm.Slug] = perm
+	return perm, nil
+}
+
+func (s *memPermissionService) Delete(ctx context.Context, id string) error {
+	delete(s.permissions, id)
+	return nil
+}
+
+// memRoleService keeps platform roles by name, parents are kept as names
+type memRoleService struct {
+	roles map[string]role.Role
+}
+
+func (s *memRoleService) Get(ctx context.Context, id string) (role.Role, error) {
+	for _, r := range s.roles {
+		if r.ID == id || r.Name == id {
+			return r, nil
+		}
+	}
+	return role.Role{}, role.ErrNotExist
+}
+
+func (s *memRoleService) Upsert(ctx context.Context, toCreate role.Role) (role.Role, error) {
+	toCreate.ID = uuid.NewString()
+	s.roles[toCreate.Name] = toCreate
+	return toCreate, nil
+}
+
+func (s *memRoleService) Delete(ctx context.Context, id string) error {
+	existing, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, r := range s.roles {
+		if utils.Contains(r.Parents, existing.Name) {
+			return role.ErrParentInUse
+		}
+	}
+	delete(s.roles, existing.Name)
+	return nil
+}
+
+type memPolicyService struct {
+	policies []policy.Policy
+}
+
+func (s *memPolicyService) List(ctx context.Context, flt policy.Filter) ([]policy.Policy, error) {
+	var policies []policy.Policy
+	for _, p := range s.policies {
+		if flt.RoleID == "" || p.RoleID == flt.RoleID {
+			policies = append(policies, p)
+		}
+	}
+	return policies, nil
+}
+
+func (s *memPolicyService) Delete(ctx context.Context, id string) error {
+	for i, p := range s.policies {
+		if p.ID == id {
+			s.policies = append(s.policies[:i], s.policies[i+1:]...)
+			return nil
+		}
+	}
+	return policy.ErrNotExist
+}
+
+type memRelationService struct {
+	rels []relation.Relation
+}
+
+func (s *memRelationService) ListRelations(ctx context.Context, flt relation.Relation) ([]relation.Relation, error) {
+	var rels []relation.Relation
+	for _, r := range s.rels {
+		if r.Object.Namespace == flt.Object.Namespace && r.Subject.Namespace == flt.Subject.Namespace &&
+			r.RelationName == flt.RelationName {
+			rels = append(rels, r)
+		}
+	}
+	return rels, nil
+}
+
+func (s *memRelationService) Delete(ctx context.Context, rel relation.Relation) error {
+	for i, r := range s.rels {
+		if r == rel {
+			s.rels = append(s.rels[:i], s.rels[i+1:]...)
+			return nil
+		}
+	}
+	return relation.ErrNotExist
+}
+
+type memAuthzEngine struct {
+	source string
+}
+
+func (e *memAuthzEngine) WriteSchema(ctx context.Context, source string) error {
+	e.source = source
+	return nil
+}
+
+type memVersionRepository struct {
+	versions []SchemaVersion
+}
+
+func (r *memVersionRepository) Create(ctx context.Context, version SchemaVersion) (SchemaVersion, error) {
+	version.Version = int64(len(r.versions) + 1)
+	r.versions = append(r.versions, version)
+	return version, nil
+}
+
+func (r *memVersionRepository) Get(ctx context.Context, version int64) (SchemaVersion, error) {
+	for _, v := range r.versions {
+		if v.Version == version {
+			return v, nil
+		}
+	}
+	return SchemaVersion{}, ErrSchemaVersionNotExist
+}
+
+func (r *memVersionRepository) Latest(ctx context.Context) (SchemaVersion, error) {
+	if len(r.versions) == 0 {
+		return SchemaVersion{}, ErrSchemaVersionNotExist
+	}
+	return r.versions[len(r.versions)-1], nil
+}
+
+func (r *memVersionRepository) List(ctx context.Context) ([]SchemaVersion, error) {
+	return r.versions, nil
+}
+
+type testDeps struct {
+	permissions *memPermissionService
+	roles       *memRoleService
+	policies    *memPolicyService
+	relations   *memRelationService
+	versions    *memVersionRepository
+}
+
+func newTestService() (*Service, testDeps) {
+	deps := testDeps{
+		permissions: &memPermissionService{permissions: map[string]permission.Permission{}},
+		roles:       &memRoleService{roles: map[string]role.Role{}},
+		policies:    &memPolicyService{},
+		relations:   &memRelationService{},
+		versions:    &memVersionRepository{},
+	}
+	return NewBootstrapService(AdminConfig{}, nil, memNamespaceService{}, deps.roles, deps.permissions,
+		nil, &memAuthzEngine{}, deps.relations, deps.policies, deps.versions), deps
+}
+
+var (
+	cartViewer = schema.RoleDefinition{
+		Name:        "cart_viewer",
+		Permissions: []string{"potato_cart_get"},
+	}
+	cartManager = schema.RoleDefinition{
+		Name:        "cart_manager",
+		Permissions: []string{"potato_cart_update"},
+		Parents:     []string{"cart_viewer"},
+	}
+)
+
+// setupVersions applies a first version with a get permission and a viewer role,
+// and a second one adding an update permission and a manager role
+func setupVersions(t *testing.T, ctx context.Context, svc *Service) {
+	t.Helper()
+	assert.NoError(t, svc.AppendSchema(ctx, schema.ServiceDefinition{
+		Roles:       []schema.RoleDefinition{cartViewer},
+		Permissions: []schema.ResourcePermission{{Name: "get", Namespace: "potato/cart"}},
+	}))
+	assert.NoError(t, svc.createRole(ctx, defaultOrgID, cartViewer))
+	assert.NoError(t, svc.AppendSchema(ctx, schema.ServiceDefinition{
+		Roles:       []schema.RoleDefinition{cartManager},
+		Permissions: []schema.ResourcePermission{{Name: "update", Namespace: "potato/cart"}},
+	}))
+	assert.NoError(t, svc.createRole(ctx, defaultOrgID, cartManager))
+}
+
+func TestService_AppendSchema(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should keep applied permissions and take common ones from the new definition", func(t *testing.T) {
+		svc, deps := newTestService()
+		assert.NoError(t, svc.AppendSchema(ctx, schema.ServiceDefinition{
+			Permissions: []schema.ResourcePermission{
+				{Name: "get", Namespace: "potato/cart", Description: "old"},
+				{Name: "delete", Namespace: "potato/cart"},
+			},
+		}))
+		assert.NoError(t, svc.AppendSchema(ctx, schema.ServiceDefinition{
+			Permissions: []schema.ResourcePermission{
+				{Name: "get", Namespace: "potato/cart", Description: "new"},
+				{Name: "update", Namespace: "potato/cart"},
+			},
+		}))
+
+		assert.Equal(t, "new", deps.permissions.permissions["potato_cart_get"].Metadata["description"])
+		assert.Contains(t, deps.permissions.permissions, "potato_cart_delete")
+		assert.Contains(t, deps.permissions.permissions, "potato_cart_update")
+		assert.Len(t, deps.versions.versions, 2)
+	})
+	t.Run("should take roles present in both from the new definition", func(t *testing.T) {
+		svc, deps := newTestService()
+		assert.NoError(t, svc.AppendSchema(ctx, schema.ServiceDefinition{
+			Roles: []schema.RoleDefinition{cartViewer},
+			Permissions: []schema.ResourcePermission{
+				{Name: "get", Namespace: "potato/cart"},
+				{Name: "update", Namespace: "potato/cart"},
+			},
+		}))
+		updatedViewer := schema.RoleDefinition{
+			Name:        cartViewer.Name,
+			Permissions: []string{"potato_cart_get", "potato_cart_update"},
+		}
+		assert.NoError(t, svc.AppendSchema(ctx, schema.ServiceDefinition{
+			Roles: []schema.RoleDefinition{updatedViewer},
+		}))
+
+		latest, err := deps.versions.Latest(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []schema.RoleDefinition{updatedViewer}, latest.Definition.Roles)
+	})
+	t.Run("should fail if applied permissions can't be read", func(t *testing.T) {
+		svc, deps := newTestService()
+		deps.permissions.listErr = errors.New("db is down")
+		err := svc.AppendSchema(ctx, schema.ServiceDefinition{
+			Permissions: []schema.ResourcePermission{{Name: "get", Namespace: "potato/cart"}},
+		})
+		assert.ErrorIs(t, err, deps.permissions.listErr)
+		assert.Empty(t, deps.versions.versions)
+	})
+}
+
+func TestService_PlanRollback(t *testing.T) {
+	ctx := context.Background()
+	svc, deps := newTestService()
+	setupVersions(t, ctx, svc)
+
+	managerRole := deps.roles.roles["cart_manager"]
+	grant := relation.Relation{
+		Object:       relation.Object{ID: managerRole.ID, Namespace: schema.RoleNamespace},
+		Subject:      relation.Subject{ID: "*", Namespace: schema.UserPrincipal},
+		RelationName: "potato_cart_update",
+	}
+	viewerGrant := grant
+	viewerGrant.RelationName = "potato_cart_get"
+	deps.relations.rels = []relation.Relation{grant, viewerGrant}
+	managerPolicy := policy.Policy{ID: uuid.NewString(), RoleID: managerRole.ID}
+	deps.policies.policies = []policy.Policy{managerPolicy, {ID: uuid.NewString(), RoleID: deps.roles.roles["cart_viewer"].ID}}
+
+	diff, err := svc.PlanRollback(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []schema.ResourcePermission{{Name: "update", Namespace: "potato/cart"}}, diff.RemovedPermissions)
+	assert.Equal(t, []string{"cart_manager"}, roleNames(diff.RemovedRoles))
+	assert.Equal(t, []relation.Relation{grant}, diff.OrphanedRelations)
+	assert.Equal(t, []policy.Policy{managerPolicy}, diff.OrphanedPolicies)
+}
+
+func TestService_RollbackSchema(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should fail without force if relations or policies would be orphaned", func(t *testing.T) {
+		svc, deps := newTestService()
+		setupVersions(t, ctx, svc)
+		deps.policies.policies = []policy.Policy{{ID: uuid.NewString(), RoleID: deps.roles.roles["cart_manager"].ID}}
+
+		_, err := svc.RollbackSchema(ctx, 1, false)
+		assert.ErrorIs(t, err, ErrOrphanedRelations)
+		assert.Contains(t, deps.permissions.permissions, "potato_cart_update")
+		assert.Contains(t, deps.roles.roles, "cart_manager")
+		assert.Len(t, deps.policies.policies, 1)
+		assert.Len(t, deps.versions.versions, 2)
+	})
+	t.Run("should delete orphans, removed permissions and roles with force", func(t *testing.T) {
+		svc, deps := newTestService()
+		setupVersions(t, ctx, svc)
+		deps.relations.rels = []relation.Relation{{
+			Object:       relation.Object{ID: deps.roles.roles["cart_manager"].ID, Namespace: schema.RoleNamespace},
+			Subject:      relation.Subject{ID: "*", Namespace: schema.UserPrincipal},
+			RelationName: "potato_cart_update",
+		}}
+		deps.policies.policies = []policy.Policy{{ID: uuid.NewString(), RoleID: deps.roles.roles["cart_manager"].ID}}
+
+		_, err := svc.RollbackSchema(ctx, 1, true)
+		assert.NoError(t, err)
+		assert.Empty(t, deps.relations.rels)
+		assert.Empty(t, deps.policies.policies)
+		assert.NotContains(t, deps.permissions.permissions, "potato_cart_update")
+		assert.Contains(t, deps.permissions.permissions, "potato_cart_get")
+		assert.NotContains(t, deps.roles.roles, "cart_manager")
+		assert.Contains(t, deps.roles.roles, "cart_viewer")
+
+		latest, err := deps.versions.Latest(ctx)
+		assert.NoError(t, err)
+		assert.True(t, isSameDefinition(deps.versions.versions[0].Definition, latest.Definition))
+	})
+	t.Run("should delete inheriting roles before their parents", func(t *testing.T) {
+		svc, deps := newTestService()
+		assert.NoError(t, svc.AppendSchema(ctx, schema.ServiceDefinition{
+			Permissions: []schema.ResourcePermission{{Name: "get", Namespace: "potato/cart"}},
+		}))
+		assert.NoError(t, svc.AppendSchema(ctx, schema.ServiceDefinition{
+			Roles: []schema.RoleDefinition{cartViewer, cartManager},
+		}))
+		assert.NoError(t, svc.createRole(ctx, defaultOrgID, cartViewer))
+		assert.NoError(t, svc.createRole(ctx, defaultOrgID, cartManager))
+
+		diff, err := svc.RollbackSchema(ctx, 1, false)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"cart_viewer", "cart_manager"}, roleNames(diff.RemovedRoles))
+		assert.Empty(t, deps.roles.roles)
+	})
+	t.Run("should revert roles modified after the target version", func(t *testing.T) {
+		svc, deps := newTestService()
+		setupVersions(t, ctx, svc)
+		updatedViewer := schema.RoleDefinition{
+			Name:        cartViewer.Name,
+			Permissions: []string{"potato_cart_get", "potato_cart_update"},
+		}
+		assert.NoError(t, svc.AppendSchema(ctx, schema.ServiceDefinition{
+			Roles: []schema.RoleDefinition{updatedViewer},
+		}))
+		assert.NoError(t, svc.createRole(ctx, defaultOrgID, updatedViewer))
+
+		diff, err := svc.PlanRollback(ctx, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"cart_viewer"}, roleNames(diff.ModifiedRoles))
+		assert.False(t, diff.IsEmpty())
+
+		_, err = svc.RollbackSchema(ctx, 2, false)
+		assert.NoError(t, err)
+		assert.Equal(t, cartViewer.Permissions, deps.roles.roles["cart_viewer"].Permissions)
+	})
+	t.Run("should create roles of the target version again", func(t *testing.T) {
+		svc, deps := newTestService()
+		setupVersions(t, ctx, svc)
+		_, err := svc.RollbackSchema(ctx, 1, false)
+		assert.NoError(t, err)
+		assert.NotContains(t, deps.roles.roles, "cart_manager")
+
+		diff, err := svc.RollbackSchema(ctx, 2, false)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"cart_manager"}, roleNames(diff.AddedRoles))
+		assert.Contains(t, deps.roles.roles, "cart_manager")
+		assert.Contains(t, deps.permissions.permissions, "potato_cart_update")
+	})
+}
+
+func TestService_createRoles(t *testing.T) {
+	svc, deps := newTestService()
+	// versions are stored sorted by name, so children may come before parents
+	assert.NoError(t, svc.createRoles(context.Background(), []schema.RoleDefinition{cartManager, cartViewer}))
+	assert.Contains(t, deps.roles.roles, "cart_viewer")
+	assert.Contains(t, deps.roles.roles, "cart_manager")
+
+	cyclic := schema.RoleDefinition{Name: "a", Parents: []string{"b"}}
+	other := schema.RoleDefinition{Name: "b", Parents: []string{"a"}}
+	assert.ErrorIs(t, svc.createRoles(context.Background(), []schema.RoleDefinition{cyclic, other}), schema.ErrMigration)
+}
+
+func roleNames(roles []schema.RoleDefinition) []string {
+	var names []string
+	for _, r := range roles {
+		names = append(names, r.Name)
+	}
+	return names
+}
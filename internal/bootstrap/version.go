@@ -0,0 +1,149 @@
+package bootstrap
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+)
+
+var (
+	ErrSchemaVersionNotExist = errors.New("schema version doesn't exist")
+	ErrOrphanedRelations     = errors.New("schema change will orphan existing relations or policies")
+)
+
+type SchemaVersionRepository interface {
+	Create(ctx context.Context, version SchemaVersion) (SchemaVersion, error)
+	Get(ctx context.Context, version int64) (SchemaVersion, error)
+	Latest(ctx context.Context) (SchemaVersion, error)
+	List(ctx context.Context) ([]SchemaVersion, error)
+}
+
+// SchemaVersion is a snapshot of the custom service definition applied over
+// the authz engine along with the compiled schema written to it
+type SchemaVersion struct {
+	ID         string
+	Version    int64
+	Definition schema.ServiceDefinition
+	Source     string
+	CreatedAt  time.Time
+}
+
+// SchemaDiff describes changes a service definition will make over the applied one
+type SchemaDiff struct {
+	AddedPermissions   []schema.ResourcePermission
+	RemovedPermissions []schema.ResourcePermission
+	AddedRoles         []schema.RoleDefinition
+	RemovedRoles       []schema.RoleDefinition
+	// ModifiedRoles are roles present in both definitions whose permissions or
+	// parents changed, as they are defined in the new definition
+	ModifiedRoles []schema.RoleDefinition
+
+	// OrphanedRelations are existing tuples which refer to removed permissions
+	// or namespaces, they must be deleted before the schema can be written
+	OrphanedRelations []relation.Relation
+	// OrphanedPolicies are existing policies which grant removed roles, they
+	// must be deleted before the roles can be removed
+	OrphanedPolicies []policy.Policy
+}
+
+func (d SchemaDiff) IsEmpty() bool {
+	return len(d.AddedPermissions) == 0 && len(d.RemovedPermissions) == 0 &&
+		len(d.AddedRoles) == 0 && len(d.RemovedRoles) == 0 && len(d.ModifiedRoles) == 0
+}
+
+// normalizeDefinition sorts the definition so that it can be stored and compared
+func normalizeDefinition(def schema.ServiceDefinition) schema.ServiceDefinition {
+	roles := append([]schema.RoleDefinition{}, def.Roles...)
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].Name < roles[j].Name
+	})
+	permissions := append([]schema.ResourcePermission{}, def.Permissions...)
+	sort.Slice(permissions, func(i, j int) bool {
+		return permissions[i].Slug() < permissions[j].Slug()
+	})
+	return schema.ServiceDefinition{
+		Roles:       roles,
+		Permissions: permissions,
+	}
+}
+
+func isSameDefinition(a, b schema.ServiceDefinition) bool {
+	aBytes, err := json.Marshal(normalizeDefinition(a))
+	if err != nil {
+		return false
+	}
+	bBytes, err := json.Marshal(normalizeDefinition(b))
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(aBytes, bBytes)
+}
+
+// diffDefinitions compares permissions and roles of two definitions, orphaned
+// relations are not computed as they require reading the authz engine
+func diffDefinitions(from, to schema.ServiceDefinition) SchemaDiff {
+	var diff SchemaDiff
+	fromPerms := make(map[string]bool, len(from.Permissions))
+	for _, perm := range from.Permissions {
+		fromPerms[perm.Slug()] = true
+	}
+	toPerms := make(map[string]bool, len(to.Permissions))
+	for _, perm := range to.Permissions {
+		toPerms[perm.Slug()] = true
+		if !fromPerms[perm.Slug()] {
+			diff.AddedPermissions = append(diff.AddedPermissions, perm)
+		}
+	}
+	for _, perm := range from.Permissions {
+		if !toPerms[perm.Slug()] {
+			diff.RemovedPermissions = append(diff.RemovedPermissions, perm)
+		}
+	}
+
+	fromRoles := make(map[string]schema.RoleDefinition, len(from.Roles))
+	for _, role := range from.Roles {
+		fromRoles[role.Name] = role
+	}
+	toRoles := make(map[string]bool, len(to.Roles))
+	for _, role := range to.Roles {
+		toRoles[role.Name] = true
+		fromRole, ok := fromRoles[role.Name]
+		if !ok {
+			diff.AddedRoles = append(diff.AddedRoles, role)
+			continue
+		}
+		if !isSameSet(fromRole.Permissions, role.Permissions) || !isSameSet(fromRole.Parents, role.Parents) {
+			diff.ModifiedRoles = append(diff.ModifiedRoles, role)
+		}
+	}
+	for _, role := range from.Roles {
+		if !toRoles[role.Name] {
+			diff.RemovedRoles = append(diff.RemovedRoles, role)
+		}
+	}
+	return diff
+}
+
+// isSameSet checks if both lists hold the same items regardless of their order
+func isSameSet(a, b []string) bool {
+	counts := make(map[string]int, len(a))
+	for _, item := range a {
+		counts[item]++
+	}
+	for _, item := range b {
+		counts[item]--
+	}
+	for _, count := range counts {
+		if count != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,77 @@
+package bootstrap
+
+import (
+	"testing"
+
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffDefinitions(t *testing.T) {
+	from := schema.ServiceDefinition{
+		Roles: []schema.RoleDefinition{{Name: "cart_viewer"}, {Name: "cart_manager"}},
+		Permissions: []schema.ResourcePermission{
+			{Name: "get", Namespace: "potato/cart"},
+			{Name: "update", Namespace: "potato/cart"},
+		},
+	}
+	to := schema.ServiceDefinition{
+		Roles: []schema.RoleDefinition{{Name: "cart_viewer"}, {Name: "cart_owner"}},
+		Permissions: []schema.ResourcePermission{
+			{Name: "get", Namespace: "potato/cart"},
+			{Key: "potato.cart.delete"},
+		},
+	}
+
+	diff := diffDefinitions(from, to)
+	assert.Equal(t, []schema.ResourcePermission{{Key: "potato.cart.delete"}}, diff.AddedPermissions)
+	assert.Equal(t, []schema.ResourcePermission{{Name: "update", Namespace: "potato/cart"}}, diff.RemovedPermissions)
+	assert.Equal(t, []schema.RoleDefinition{{Name: "cart_owner"}}, diff.AddedRoles)
+	assert.Equal(t, []schema.RoleDefinition{{Name: "cart_manager"}}, diff.RemovedRoles)
+	assert.Empty(t, diff.ModifiedRoles)
+	assert.False(t, diff.IsEmpty())
+	assert.True(t, diffDefinitions(to, to).IsEmpty())
+}
+
+func TestDiffDefinitions_ModifiedRoles(t *testing.T) {
+	from := schema.ServiceDefinition{
+		Roles: []schema.RoleDefinition{
+			{Name: "cart_viewer", Permissions: []string{"potato_cart_get", "potato_cart_list"}},
+			{Name: "cart_manager", Permissions: []string{"potato_cart_update"}, Parents: []string{"cart_viewer"}},
+			{Name: "cart_owner", Permissions: []string{"potato_cart_delete"}},
+		},
+	}
+	to := schema.ServiceDefinition{
+		Roles: []schema.RoleDefinition{
+			{Name: "cart_viewer", Permissions: []string{"potato_cart_list", "potato_cart_get"}},
+			{Name: "cart_manager", Permissions: []string{"potato_cart_update"}},
+			{Name: "cart_owner", Permissions: []string{"potato_cart_delete", "potato_cart_update"}},
+		},
+	}
+
+	diff := diffDefinitions(from, to)
+	assert.Equal(t, []schema.RoleDefinition{to.Roles[1], to.Roles[2]}, diff.ModifiedRoles)
+	assert.Empty(t, diff.AddedRoles)
+	assert.Empty(t, diff.RemovedRoles)
+	assert.False(t, diff.IsEmpty())
+}
+
+func TestIsSameDefinition(t *testing.T) {
+	a := schema.ServiceDefinition{
+		Permissions: []schema.ResourcePermission{
+			{Name: "get", Namespace: "potato/cart"},
+			{Name: "update", Namespace: "potato/cart"},
+		},
+	}
+	b := schema.ServiceDefinition{
+		Roles: []schema.RoleDefinition{},
+		Permissions: []schema.ResourcePermission{
+			{Name: "update", Namespace: "potato/cart"},
+			{Name: "get", Namespace: "potato/cart"},
+		},
+	}
+	assert.True(t, isSameDefinition(a, b))
+
+	b.Permissions[0].Description = "updated"
+	assert.False(t, isSameDefinition(a, b))
+}
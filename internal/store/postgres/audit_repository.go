@@ -70,12 +70,17 @@ func (a AuditRepository) Create(ctx context.Context, l *audit.Log) error {
 }
 
 func (a AuditRepository) List(ctx context.Context, flt audit.Filter) ([]audit.Log, error) {
-	if flt.OrgID == "" {
+	if flt.OrgID == "" && flt.ActorID == "" {
 		return nil, errors.New("org id is required")
 	}
 
 	sqlStatement := dialect.From(TABLE_AUDITLOGS)
-	sqlStatement = sqlStatement.Where(goqu.Ex{"org_id": flt.OrgID})
+	if flt.OrgID != "" {
+		sqlStatement = sqlStatement.Where(goqu.Ex{"org_id": flt.OrgID})
+	}
+	if flt.ActorID != "" {
+		sqlStatement = sqlStatement.Where(goqu.L("actor->>'ID'").Eq(flt.ActorID))
+	}
 
 	if flt.Source != "" {
 		sqlStatement = sqlStatement.Where(goqu.Ex{"source": flt.Source})
@@ -149,3 +154,28 @@ func (a AuditRepository) GetByID(ctx context.Context, id string) (audit.Log, err
 	}
 	return logModel.transform()
 }
+
+// AnonymizeActor clears the id and name of the actor in its logs, type of the actor
+// is kept
+func (a AuditRepository) AnonymizeActor(ctx context.Context, actorID string) error {
+	if strings.TrimSpace(actorID) == "" {
+		return audit.ErrInvalidID
+	}
+
+	query, params, err := dialect.Update(TABLE_AUDITLOGS).Set(
+		goqu.Record{
+			"actor": goqu.L("jsonb_build_object('ID', '', 'Type', actor->>'Type', 'Name', ?)", audit.AnonymizedActorName),
+		}).Where(goqu.L("actor->>'ID'").Eq(actorID)).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	if err = a.dbc.WithTimeout(ctx, TABLE_AUDITLOGS, "AnonymizeActor", func(ctx context.Context) error {
+		_, err := a.dbc.ExecContext(ctx, query, params...)
+		return err
+	}); err != nil {
+		err = checkPostgresError(err)
+		return fmt.Errorf("%w: %s", dbErr, err)
+	}
+	return nil
+}
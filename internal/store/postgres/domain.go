@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/raystack/frontier/core/domain"
@@ -12,17 +13,24 @@ type Domain struct {
 	Name      string    `db:"name"`
 	Token     string    `db:"token"`
 	State     string    `db:"state"`
+	AutoJoin  []byte    `db:"auto_join"`
 	UpdatedAt time.Time `db:"updated_at"`
 	CreatedAt time.Time `db:"created_at"`
 }
 
 func (d Domain) transform() domain.Domain {
+	var autoJoin domain.AutoJoin
+	if len(d.AutoJoin) > 0 {
+		// a policy which can't be read is disabled
+		_ = json.Unmarshal(d.AutoJoin, &autoJoin)
+	}
 	return domain.Domain{
 		ID:        d.ID,
 		OrgID:     d.OrgID,
 		Name:      d.Name,
 		Token:     d.Token,
 		State:     domain.Status(d.State),
+		AutoJoin:  autoJoin,
 		UpdatedAt: d.UpdatedAt,
 		CreatedAt: d.CreatedAt,
 	}
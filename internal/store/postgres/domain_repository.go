@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -32,11 +33,16 @@ func NewDomainRepository(logger log.Logger, dbc *db.Client) *DomainRepository {
 }
 
 func (s *DomainRepository) Create(ctx context.Context, toCreate domain.Domain) (domain.Domain, error) {
+	autoJoin, err := json.Marshal(toCreate.AutoJoin)
+	if err != nil {
+		return domain.Domain{}, fmt.Errorf("%w: %s", parseErr, err)
+	}
 	query, params, err := dialect.Insert(TABLE_DOMAINS).Rows(
 		goqu.Record{
-			"org_id": toCreate.OrgID,
-			"name":   toCreate.Name,
-			"token":  toCreate.Token,
+			"org_id":    toCreate.OrgID,
+			"name":      toCreate.Name,
+			"token":     toCreate.Token,
+			"auto_join": autoJoin,
 		}).Returning(&Domain{}).ToSQL()
 	if err != nil {
 		return domain.Domain{}, fmt.Errorf("%w: %s", parseErr, err)
@@ -73,6 +79,10 @@ func (s *DomainRepository) List(ctx context.Context, flt domain.Filter) ([]domai
 		stmt = stmt.Where(goqu.Ex{
 			"org_id": flt.OrgID,
 		})
+	} else if flt.State != "" {
+		stmt = stmt.Where(goqu.Ex{
+			"state": flt.State,
+		})
 	}
 
 	query, params, err := stmt.ToSQL()
@@ -146,10 +156,15 @@ func (s *DomainRepository) Update(ctx context.Context, toUpdate domain.Domain) (
 		return domain.Domain{}, domain.ErrInvalidId
 	}
 
+	autoJoin, err := json.Marshal(toUpdate.AutoJoin)
+	if err != nil {
+		return domain.Domain{}, fmt.Errorf("%w: %s", parseErr, err)
+	}
 	query, params, err := dialect.Update(TABLE_DOMAINS).Set(
 		goqu.Record{
 			"token":      toUpdate.Token,
 			"state":      toUpdate.State,
+			"auto_join":  autoJoin,
 			"updated_at": goqu.L("now()"),
 		}).Where(goqu.Ex{
 		"id": toUpdate.ID,
@@ -177,7 +192,8 @@ func (s *DomainRepository) Update(ctx context.Context, toUpdate domain.Domain) (
 
 func (s *DomainRepository) DeleteExpiredDomainRequests(ctx context.Context) error {
 	query, params, err := dialect.Delete(TABLE_DOMAINS).Where(goqu.Ex{
-		"created_at": goqu.Op{"lte": s.Now().Add(-domain.DefaultTokenExpiry)},
+		// domains which lost their verification are pending again since they were updated
+		"updated_at": goqu.Op{"lte": s.Now().Add(-domain.DefaultTokenExpiry)},
 		"state":      domain.Pending,
 	}).ToSQL()
 	if err != nil {
@@ -2,7 +2,9 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -96,6 +98,31 @@ func (s *FlowRepository) Get(ctx context.Context, id uuid.UUID) (*authenticate.F
 	return flowModel.transformToFlow()
 }
 
+func (s *FlowRepository) GetByNonce(ctx context.Context, method, nonce string) (*authenticate.Flow, error) {
+	var flowModel Flow
+	query, params, err := dialect.From(TABLE_FLOWS).Where(
+		goqu.Ex{
+			"method": method,
+			"nonce":  nonce,
+		},
+	).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	if err = s.dbc.WithTimeout(ctx, TABLE_FLOWS, "GetByNonce", func(ctx context.Context) error {
+		return s.dbc.QueryRowxContext(ctx, query, params...).StructScan(&flowModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, authenticate.ErrFlowInvalid
+		}
+		return nil, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	return flowModel.transformToFlow()
+}
+
 func (s *FlowRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	query, params, err := dialect.Delete(TABLE_FLOWS).
 		Where(
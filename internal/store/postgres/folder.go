@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/raystack/frontier/core/folder"
+)
+
+type Folder struct {
+	ID        string         `db:"id"`
+	Name      string         `db:"name"`
+	Title     sql.NullString `db:"title"`
+	OrgID     string         `db:"org_id"`
+	ParentID  sql.NullString `db:"parent_id"`
+	Metadata  []byte         `db:"metadata"`
+	CreatedAt time.Time      `db:"created_at"`
+	UpdatedAt time.Time      `db:"updated_at"`
+}
+
+func (from Folder) transformToFolder() (folder.Folder, error) {
+	var unmarshalledMetadata map[string]any
+	if len(from.Metadata) > 0 {
+		if err := json.Unmarshal(from.Metadata, &unmarshalledMetadata); err != nil {
+			return folder.Folder{}, err
+		}
+	}
+
+	return folder.Folder{
+		ID:        from.ID,
+		Name:      from.Name,
+		Title:     from.Title.String,
+		OrgID:     from.OrgID,
+		ParentID:  from.ParentID.String,
+		Metadata:  unmarshalledMetadata,
+		CreatedAt: from.CreatedAt,
+		UpdatedAt: from.UpdatedAt,
+	}, nil
+}
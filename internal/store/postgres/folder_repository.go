@@ -0,0 +1,282 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/raystack/frontier/core/folder"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+type FolderRepository struct {
+	dbc *db.Client
+}
+
+func NewFolderRepository(dbc *db.Client) *FolderRepository {
+	return &FolderRepository{
+		dbc: dbc,
+	}
+}
+
+func (r FolderRepository) GetByID(ctx context.Context, id string) (folder.Folder, error) {
+	if strings.TrimSpace(id) == "" {
+		return folder.Folder{}, folder.ErrInvalidID
+	}
+
+	query, params, err := dialect.From(TABLE_FOLDERS).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return folder.Folder{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var folderModel Folder
+	if err = r.dbc.WithTimeout(ctx, TABLE_FOLDERS, "GetByID", func(ctx context.Context) error {
+		return r.dbc.GetContext(ctx, &folderModel, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return folder.Folder{}, folder.ErrNotExist
+		case errors.Is(err, ErrInvalidTextRepresentation):
+			return folder.Folder{}, folder.ErrInvalidUUID
+		default:
+			return folder.Folder{}, err
+		}
+	}
+
+	return folderModel.transformToFolder()
+}
+
+func (r FolderRepository) GetByIDs(ctx context.Context, ids []string) ([]folder.Folder, error) {
+	if len(ids) == 0 {
+		return []folder.Folder{}, nil
+	}
+	query, params, err := dialect.From(TABLE_FOLDERS).Where(goqu.Ex{
+		"id": goqu.Op{"in": ids},
+	}).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var folderModels []Folder
+	if err = r.dbc.WithTimeout(ctx, TABLE_FOLDERS, "GetByIDs", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &folderModels, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, ErrInvalidTextRepresentation):
+			return nil, folder.ErrInvalidUUID
+		default:
+			return nil, err
+		}
+	}
+	return transformFolders(folderModels)
+}
+
+func (r FolderRepository) Create(ctx context.Context, f folder.Folder) (folder.Folder, error) {
+	if strings.TrimSpace(f.Name) == "" || strings.TrimSpace(f.OrgID) == "" {
+		return folder.Folder{}, folder.ErrInvalidDetail
+	}
+
+	marshaledMetadata, err := json.Marshal(f.Metadata)
+	if err != nil {
+		return folder.Folder{}, fmt.Errorf("%w: %s", parseErr, err)
+	}
+
+	insertRow := goqu.Record{
+		"name":     f.Name,
+		"title":    f.Title,
+		"org_id":   f.OrgID,
+		"metadata": marshaledMetadata,
+	}
+	if f.ParentID != "" {
+		insertRow["parent_id"] = f.ParentID
+	}
+	query, params, err := dialect.Insert(TABLE_FOLDERS).Rows(insertRow).Returning(&Folder{}).ToSQL()
+	if err != nil {
+		return folder.Folder{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var folderModel Folder
+	if err = r.dbc.WithTimeout(ctx, TABLE_FOLDERS, "Create", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&folderModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, ErrForeignKeyViolation):
+			return folder.Folder{}, folder.ErrInvalidDetail
+		case errors.Is(err, ErrInvalidTextRepresentation):
+			return folder.Folder{}, folder.ErrInvalidUUID
+		case errors.Is(err, ErrDuplicateKey):
+			return folder.Folder{}, folder.ErrConflict
+		default:
+			return folder.Folder{}, err
+		}
+	}
+
+	return folderModel.transformToFolder()
+}
+
+func (r FolderRepository) List(ctx context.Context, flt folder.Filter) ([]folder.Folder, error) {
+	stmt := dialect.From(TABLE_FOLDERS)
+	if flt.OrgID != "" {
+		stmt = stmt.Where(goqu.Ex{
+			"org_id": flt.OrgID,
+		})
+	}
+	if flt.ParentID != "" {
+		stmt = stmt.Where(goqu.Ex{
+			"parent_id": flt.ParentID,
+		})
+	}
+	if flt.TopLevel {
+		stmt = stmt.Where(goqu.Ex{
+			"parent_id": nil,
+		})
+	}
+	query, params, err := stmt.ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var folderModels []Folder
+	if err = r.dbc.WithTimeout(ctx, TABLE_FOLDERS, "List", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &folderModels, query, params...)
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []folder.Folder{}, nil
+		}
+		return nil, fmt.Errorf("%w: %s", dbErr, err)
+	}
+	return transformFolders(folderModels)
+}
+
+func (r FolderRepository) UpdateByID(ctx context.Context, f folder.Folder) (folder.Folder, error) {
+	if strings.TrimSpace(f.ID) == "" {
+		return folder.Folder{}, folder.ErrInvalidID
+	}
+	if strings.TrimSpace(f.Name) == "" {
+		return folder.Folder{}, folder.ErrInvalidDetail
+	}
+
+	marshaledMetadata, err := json.Marshal(f.Metadata)
+	if err != nil {
+		return folder.Folder{}, fmt.Errorf("%w: %s", parseErr, err)
+	}
+
+	query, params, err := dialect.Update(TABLE_FOLDERS).Set(
+		goqu.Record{
+			"name":       f.Name,
+			"title":      f.Title,
+			"metadata":   marshaledMetadata,
+			"updated_at": goqu.L("now()"),
+		}).Where(goqu.Ex{"id": f.ID}).Returning(&Folder{}).ToSQL()
+	if err != nil {
+		return folder.Folder{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var folderModel Folder
+	if err = r.dbc.WithTimeout(ctx, TABLE_FOLDERS, "UpdateByID", func(ctx context.Context) error {
+		return r.dbc.GetContext(ctx, &folderModel, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return folder.Folder{}, folder.ErrNotExist
+		case errors.Is(err, ErrInvalidTextRepresentation):
+			return folder.Folder{}, folder.ErrInvalidUUID
+		case errors.Is(err, ErrDuplicateKey):
+			return folder.Folder{}, folder.ErrConflict
+		default:
+			return folder.Folder{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	return folderModel.transformToFolder()
+}
+
+func (r FolderRepository) SetParent(ctx context.Context, id, parentID string) error {
+	var parent any
+	if parentID != "" {
+		parent = parentID
+	}
+	query, params, err := dialect.Update(TABLE_FOLDERS).Set(
+		goqu.Record{
+			"parent_id":  parent,
+			"updated_at": goqu.L("now()"),
+		}).Where(goqu.Ex{"id": id}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	if err = r.dbc.WithTimeout(ctx, TABLE_FOLDERS, "SetParent", func(ctx context.Context) error {
+		result, err := r.dbc.DB.ExecContext(ctx, query, params...)
+		if err != nil {
+			return err
+		}
+		if count, _ := result.RowsAffected(); count == 0 {
+			return sql.ErrNoRows
+		}
+		return nil
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return folder.ErrNotExist
+		case errors.Is(err, ErrInvalidTextRepresentation):
+			return folder.ErrInvalidUUID
+		case errors.Is(err, ErrForeignKeyViolation):
+			return folder.ErrInvalidDetail
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+func (r FolderRepository) Delete(ctx context.Context, id string) error {
+	query, params, err := dialect.Delete(TABLE_FOLDERS).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	if err = r.dbc.WithTimeout(ctx, TABLE_FOLDERS, "Delete", func(ctx context.Context) error {
+		result, err := r.dbc.DB.ExecContext(ctx, query, params...)
+		if err != nil {
+			return err
+		}
+		if count, _ := result.RowsAffected(); count == 0 {
+			return sql.ErrNoRows
+		}
+		return nil
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return folder.ErrNotExist
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+func transformFolders(folderModels []Folder) ([]folder.Folder, error) {
+	var transformedFolders []folder.Folder
+	for _, f := range folderModels {
+		transformedFolder, err := f.transformToFolder()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", parseErr, err)
+		}
+		transformedFolders = append(transformedFolders, transformedFolder)
+	}
+	return transformedFolders, nil
+}
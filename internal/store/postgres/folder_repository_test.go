@@ -0,0 +1,275 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/ory/dockertest"
+	"github.com/raystack/frontier/core/folder"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/internal/store/postgres"
+	"github.com/raystack/frontier/pkg/db"
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type FolderRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *db.Client
+	pool       *dockertest.Pool
+	resource   *dockertest.Resource
+	repository *postgres.FolderRepository
+	orgs       []organization.Organization
+	folders    []folder.Folder
+}
+
+func (s *FolderRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := log.NewZap()
+	s.client, s.pool, s.resource, err = newTestClient(logger)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.ctx = context.TODO()
+	s.repository = postgres.NewFolderRepository(s.client)
+
+	s.orgs, err = bootstrapOrganization(s.client)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *FolderRepositoryTestSuite) SetupTest() {
+	// folder-1 and folder-3 sit under the first org, folder-2 is nested in folder-1
+	s.folders = nil
+	for _, f := range []folder.Folder{
+		{Name: "folder-1", OrgID: s.orgs[0].ID},
+		{Name: "folder-2", OrgID: s.orgs[0].ID},
+		{Name: "folder-3", OrgID: s.orgs[0].ID},
+		{Name: "folder-1", OrgID: s.orgs[1].ID},
+	} {
+		if f.Name == "folder-2" {
+			f.ParentID = s.folders[0].ID
+		}
+		created, err := s.repository.Create(s.ctx, f)
+		if err != nil {
+			s.T().Fatal(err)
+		}
+		s.folders = append(s.folders, created)
+	}
+}
+
+func (s *FolderRepositoryTestSuite) TearDownSuite() {
+	// Clean tests
+	if err := purgeDocker(s.pool, s.resource); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *FolderRepositoryTestSuite) TearDownTest() {
+	if err := s.cleanup(); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *FolderRepositoryTestSuite) cleanup() error {
+	queries := []string{
+		fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", postgres.TABLE_FOLDERS),
+	}
+	return execQueries(context.TODO(), s.client, queries)
+}
+
+func (s *FolderRepositoryTestSuite) TestGetByID() {
+	type testCase struct {
+		Description    string
+		SelectedID     string
+		ExpectedFolder folder.Folder
+		ErrString      string
+	}
+
+	var testCases = []testCase{
+		{
+			Description: "should get a nested folder",
+			SelectedID:  s.folders[1].ID,
+			ExpectedFolder: folder.Folder{
+				ID:       s.folders[1].ID,
+				Name:     "folder-2",
+				OrgID:    s.orgs[0].ID,
+				ParentID: s.folders[0].ID,
+			},
+		},
+		{
+			Description: "should return error no exist if can't found folder",
+			SelectedID:  uuid.NewString(),
+			ErrString:   folder.ErrNotExist.Error(),
+		},
+		{
+			Description: "should return error if id empty",
+			ErrString:   folder.ErrInvalidID.Error(),
+		},
+		{
+			Description: "should return error if id is not uuid",
+			SelectedID:  "10000",
+			ErrString:   folder.ErrInvalidUUID.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.Description, func() {
+			got, err := s.repository.GetByID(s.ctx, tc.SelectedID)
+			if tc.ErrString != "" {
+				if err.Error() != tc.ErrString {
+					s.T().Fatalf("got error %s, expected was %s", err.Error(), tc.ErrString)
+				}
+			}
+			if !cmp.Equal(got, tc.ExpectedFolder, cmpopts.IgnoreFields(folder.Folder{}, "Metadata", "CreatedAt", "UpdatedAt")) {
+				s.T().Fatalf("got result %+v, expected was %+v", got, tc.ExpectedFolder)
+			}
+		})
+	}
+}
+
+func (s *FolderRepositoryTestSuite) TestCreate() {
+	type testCase struct {
+		Description string
+		FolderToAdd folder.Folder
+		ErrString   string
+	}
+
+	var testCases = []testCase{
+		{
+			Description: "should return error if folder name already exists in the org",
+			FolderToAdd: folder.Folder{Name: "folder-1", OrgID: s.orgs[0].ID},
+			ErrString:   folder.ErrConflict.Error(),
+		},
+		{
+			Description: "should return error if parent folder doesn't exist",
+			FolderToAdd: folder.Folder{Name: "folder-4", OrgID: s.orgs[0].ID, ParentID: uuid.NewString()},
+			ErrString:   folder.ErrInvalidDetail.Error(),
+		},
+		{
+			Description: "should return error if org id is missing",
+			FolderToAdd: folder.Folder{Name: "folder-4"},
+			ErrString:   folder.ErrInvalidDetail.Error(),
+		},
+		{
+			Description: "should create a folder with the same name in another org",
+			FolderToAdd: folder.Folder{Name: "folder-3", OrgID: s.orgs[1].ID},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.Description, func() {
+			got, err := s.repository.Create(s.ctx, tc.FolderToAdd)
+			if tc.ErrString != "" {
+				if err == nil || err.Error() != tc.ErrString {
+					s.T().Fatalf("got error %v, expected was %s", err, tc.ErrString)
+				}
+				return
+			}
+			s.Assert().NoError(err)
+			s.Assert().NotEmpty(got.ID)
+			s.Assert().Equal(tc.FolderToAdd.OrgID, got.OrgID)
+		})
+	}
+}
+
+func (s *FolderRepositoryTestSuite) TestList() {
+	type testCase struct {
+		Description string
+		Filter      folder.Filter
+		Expected    []string
+	}
+
+	var testCases = []testCase{
+		{
+			Description: "should list all folders of an org",
+			Filter:      folder.Filter{OrgID: s.orgs[0].ID},
+			Expected:    []string{s.folders[0].ID, s.folders[1].ID, s.folders[2].ID},
+		},
+		{
+			Description: "should list folders nested in a folder",
+			Filter:      folder.Filter{OrgID: s.orgs[0].ID, ParentID: s.folders[0].ID},
+			Expected:    []string{s.folders[1].ID},
+		},
+		{
+			Description: "should list top level folders of an org",
+			Filter:      folder.Filter{OrgID: s.orgs[0].ID, TopLevel: true},
+			Expected:    []string{s.folders[0].ID, s.folders[2].ID},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.Description, func() {
+			got, err := s.repository.List(s.ctx, tc.Filter)
+			s.Assert().NoError(err)
+			var ids []string
+			for _, f := range got {
+				ids = append(ids, f.ID)
+			}
+			s.Assert().ElementsMatch(tc.Expected, ids)
+		})
+	}
+}
+
+func (s *FolderRepositoryTestSuite) TestSetParent() {
+	s.Run("should move folder under another folder and back to the top level", func() {
+		s.Assert().NoError(s.repository.SetParent(s.ctx, s.folders[2].ID, s.folders[0].ID))
+		got, err := s.repository.GetByID(s.ctx, s.folders[2].ID)
+		s.Assert().NoError(err)
+		s.Assert().Equal(s.folders[0].ID, got.ParentID)
+
+		s.Assert().NoError(s.repository.SetParent(s.ctx, s.folders[2].ID, ""))
+		got, err = s.repository.GetByID(s.ctx, s.folders[2].ID)
+		s.Assert().NoError(err)
+		s.Assert().Empty(got.ParentID)
+	})
+	s.Run("should return error if folder doesn't exist", func() {
+		err := s.repository.SetParent(s.ctx, uuid.NewString(), "")
+		s.Assert().ErrorIs(err, folder.ErrNotExist)
+	})
+	s.Run("should return error if parent doesn't exist", func() {
+		err := s.repository.SetParent(s.ctx, s.folders[2].ID, uuid.NewString())
+		s.Assert().ErrorIs(err, folder.ErrInvalidDetail)
+	})
+}
+
+func (s *FolderRepositoryTestSuite) TestUpdateByID() {
+	s.Run("should update name and title but keep the parent", func() {
+		got, err := s.repository.UpdateByID(s.ctx, folder.Folder{
+			ID:    s.folders[1].ID,
+			Name:  "folder-2-renamed",
+			Title: "Renamed",
+		})
+		s.Assert().NoError(err)
+		s.Assert().Equal("folder-2-renamed", got.Name)
+		s.Assert().Equal("Renamed", got.Title)
+		s.Assert().Equal(s.folders[0].ID, got.ParentID)
+	})
+	s.Run("should return error if name conflicts with another folder of the org", func() {
+		_, err := s.repository.UpdateByID(s.ctx, folder.Folder{ID: s.folders[2].ID, Name: "folder-1"})
+		s.Assert().ErrorIs(err, folder.ErrConflict)
+	})
+}
+
+func (s *FolderRepositoryTestSuite) TestDelete() {
+	s.Run("should delete a folder", func() {
+		s.Assert().NoError(s.repository.Delete(s.ctx, s.folders[2].ID))
+		_, err := s.repository.GetByID(s.ctx, s.folders[2].ID)
+		s.Assert().ErrorIs(err, folder.ErrNotExist)
+	})
+	s.Run("should return error if folder doesn't exist", func() {
+		s.Assert().ErrorIs(s.repository.Delete(s.ctx, uuid.NewString()), folder.ErrNotExist)
+	})
+}
+
+func TestFolderRepository(t *testing.T) {
+	suite.Run(t, new(FolderRepositoryTestSuite))
+}
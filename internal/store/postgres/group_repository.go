@@ -183,6 +183,10 @@ func (r GroupRepository) List(ctx context.Context, flt group.Filter) ([]group.Gr
 		sqlStatement = sqlStatement.Where(notDisabledGroupExp)
 	}
 
+	sqlStatement, err := paginate(sqlStatement, flt.Pagination, defaultPaginationColumns)
+	if err != nil {
+		return []group.Group{}, err
+	}
 	query, params, err := sqlStatement.ToSQL()
 	if err != nil {
 		return []group.Group{}, fmt.Errorf("%w: %s", queryErr, err)
@@ -202,6 +206,7 @@ func (r GroupRepository) List(ctx context.Context, flt group.Filter) ([]group.Gr
 			return []group.Group{}, fmt.Errorf("%w: %s", dbErr, err)
 		}
 	}
+	fetchedGroups = setNextPage(flt.Pagination, fetchedGroups, defaultPaginationColumns)
 
 	var transformedGroups []group.Group
 	for _, v := range fetchedGroups {
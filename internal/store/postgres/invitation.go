@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -16,6 +17,12 @@ type Invitation struct {
 	Metadata  []byte    `db:"metadata"`
 	CreatedAt time.Time `db:"created_at"`
 	ExpiresAt time.Time `db:"expires_at"`
+
+	Status      string       `db:"status"`
+	UpdatedAt   time.Time    `db:"updated_at"`
+	SentAt      sql.NullTime `db:"sent_at"`
+	ResendCount int          `db:"resend_count"`
+	RemindedAt  sql.NullTime `db:"reminded_at"`
 }
 
 func (from Invitation) transformToInvitation() (invitation.Invitation, error) {
@@ -38,6 +45,12 @@ func (from Invitation) transformToInvitation() (invitation.Invitation, error) {
 		}
 	}
 
+	// invitations created before sent_at was tracked were sent when created
+	sentAt := from.CreatedAt
+	if from.SentAt.Valid {
+		sentAt = from.SentAt.Time
+	}
+
 	return invitation.Invitation{
 		ID:        from.ID,
 		UserID:    from.UserID,
@@ -47,5 +60,11 @@ func (from Invitation) transformToInvitation() (invitation.Invitation, error) {
 		Metadata:  unmarshalledMetadata,
 		CreatedAt: from.CreatedAt,
 		ExpiresAt: from.ExpiresAt,
+
+		Status:      invitation.Status(from.Status),
+		UpdatedAt:   from.UpdatedAt,
+		SentAt:      sentAt,
+		ResendCount: from.ResendCount,
+		RemindedAt:  from.RemindedAt.Time,
 	}, nil
 }
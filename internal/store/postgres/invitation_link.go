@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/raystack/frontier/core/invitation"
+)
+
+type InvitationLink struct {
+	ID         string         `db:"id"`
+	OrgID      string         `db:"org_id"`
+	GroupIDs   []byte         `db:"group_ids"`
+	RoleIDs    []byte         `db:"role_ids"`
+	Domains    []byte         `db:"domains"`
+	MaxUses    int            `db:"max_uses"`
+	Uses       int            `db:"uses"`
+	CreatedBy  sql.NullString `db:"created_by"`
+	SecretHash string         `db:"secret_hash"`
+	ExpiresAt  time.Time      `db:"expires_at"`
+	CreatedAt  time.Time      `db:"created_at"`
+}
+
+func (from InvitationLink) transformToLink() (invitation.Link, error) {
+	link := invitation.Link{
+		ID:         from.ID,
+		OrgID:      from.OrgID,
+		MaxUses:    from.MaxUses,
+		Uses:       from.Uses,
+		CreatedBy:  from.CreatedBy.String,
+		SecretHash: from.SecretHash,
+		ExpiresAt:  from.ExpiresAt,
+		CreatedAt:  from.CreatedAt,
+	}
+	for _, field := range []struct {
+		raw  []byte
+		dest *[]string
+	}{
+		{from.GroupIDs, &link.GroupIDs},
+		{from.RoleIDs, &link.RoleIDs},
+		{from.Domains, &link.Domains},
+	} {
+		if len(field.raw) == 0 {
+			continue
+		}
+		if err := json.Unmarshal(field.raw, field.dest); err != nil {
+			return invitation.Link{}, err
+		}
+	}
+	return link, nil
+}
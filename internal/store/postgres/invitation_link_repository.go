@@ -0,0 +1,213 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/raystack/frontier/core/invitation"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+type InvitationLinkRepository struct {
+	dbc *db.Client
+}
+
+func NewInvitationLinkRepository(dbc *db.Client) *InvitationLinkRepository {
+	return &InvitationLinkRepository{
+		dbc: dbc,
+	}
+}
+
+func (r InvitationLinkRepository) Create(ctx context.Context, link invitation.Link) (invitation.Link, error) {
+	record := goqu.Record{
+		"org_id":      link.OrgID,
+		"max_uses":    link.MaxUses,
+		"secret_hash": link.SecretHash,
+		"expires_at":  link.ExpiresAt,
+	}
+	for column, values := range map[string][]string{
+		"group_ids": link.GroupIDs,
+		"role_ids":  link.RoleIDs,
+		"domains":   link.Domains,
+	} {
+		if len(values) == 0 {
+			continue
+		}
+		valuesJson, err := json.Marshal(values)
+		if err != nil {
+			return invitation.Link{}, fmt.Errorf("%w: %s", parseErr, err)
+		}
+		record[column] = valuesJson
+	}
+	if link.CreatedBy != "" {
+		record["created_by"] = link.CreatedBy
+	}
+	query, params, err := dialect.Insert(TABLE_INVITATION_LINKS).Rows(record).
+		Returning(&InvitationLink{}).ToSQL()
+	if err != nil {
+		return invitation.Link{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var linkModel InvitationLink
+	if err = r.dbc.WithTimeout(ctx, TABLE_INVITATION_LINKS, "Create", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&linkModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, ErrForeignKeyViolation), errors.Is(err, ErrInvalidTextRepresentation):
+			return invitation.Link{}, invitation.ErrInvalidLinkDetail
+		default:
+			return invitation.Link{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	return linkModel.transformToLink()
+}
+
+func (r InvitationLinkRepository) List(ctx context.Context, orgID string) ([]invitation.Link, error) {
+	query, params, err := dialect.From(TABLE_INVITATION_LINKS).Where(goqu.Ex{
+		"org_id": orgID,
+	}).Order(goqu.C("created_at").Asc()).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var linkModels []InvitationLink
+	if err = r.dbc.WithTimeout(ctx, TABLE_INVITATION_LINKS, "List", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &linkModels, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, ErrInvalidTextRepresentation) {
+			return []invitation.Link{}, nil
+		}
+		return nil, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	links := make([]invitation.Link, 0, len(linkModels))
+	for _, linkModel := range linkModels {
+		link, err := linkModel.transformToLink()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", parseErr, err)
+		}
+		links = append(links, link)
+	}
+	return links, nil
+}
+
+func (r InvitationLinkRepository) Get(ctx context.Context, id string) (invitation.Link, error) {
+	return r.get(ctx, "Get", goqu.Ex{"id": id})
+}
+
+func (r InvitationLinkRepository) GetBySecretHash(ctx context.Context, secretHash string) (invitation.Link, error) {
+	return r.get(ctx, "GetBySecretHash", goqu.Ex{"secret_hash": secretHash})
+}
+
+func (r InvitationLinkRepository) get(ctx context.Context, op string, where goqu.Ex) (invitation.Link, error) {
+	query, params, err := dialect.From(TABLE_INVITATION_LINKS).Where(where).ToSQL()
+	if err != nil {
+		return invitation.Link{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var linkModel InvitationLink
+	if err = r.dbc.WithTimeout(ctx, TABLE_INVITATION_LINKS, op, func(ctx context.Context) error {
+		return r.dbc.GetContext(ctx, &linkModel, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows), errors.Is(err, ErrInvalidTextRepresentation):
+			return invitation.Link{}, invitation.ErrLinkNotFound
+		default:
+			return invitation.Link{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	link, err := linkModel.transformToLink()
+	if err != nil {
+		return invitation.Link{}, fmt.Errorf("%w: %s", parseErr, err)
+	}
+	return link, nil
+}
+
+func (r InvitationLinkRepository) Delete(ctx context.Context, id string) error {
+	query, params, err := dialect.Delete(TABLE_INVITATION_LINKS).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_INVITATION_LINKS, "Delete", func(ctx context.Context) error {
+		result, err := r.dbc.ExecContext(ctx, query, params...)
+		if err != nil {
+			err = checkPostgresError(err)
+			if errors.Is(err, ErrInvalidTextRepresentation) {
+				return invitation.ErrLinkNotFound
+			}
+			return fmt.Errorf("%w: %s", dbErr, err)
+		}
+
+		if count, _ := result.RowsAffected(); count > 0 {
+			return nil
+		}
+
+		return invitation.ErrLinkNotFound
+	})
+}
+
+// Use reserves a use of the link in a single statement before the user joins,
+// so concurrent joins can't go over its max uses
+func (r InvitationLinkRepository) Use(ctx context.Context, id string) (invitation.Link, error) {
+	query, params, err := dialect.Update(TABLE_INVITATION_LINKS).Set(goqu.Record{
+		"uses": goqu.L("uses + 1"),
+	}).Where(
+		goqu.Ex{"id": id},
+		goqu.Or(
+			goqu.Ex{"max_uses": 0},
+			goqu.C("uses").Lt(goqu.I("max_uses")),
+		),
+	).Returning(&InvitationLink{}).ToSQL()
+	if err != nil {
+		return invitation.Link{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var linkModel InvitationLink
+	if err = r.dbc.WithTimeout(ctx, TABLE_INVITATION_LINKS, "Use", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&linkModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return invitation.Link{}, invitation.ErrLinkExhausted
+		case errors.Is(err, ErrInvalidTextRepresentation):
+			return invitation.Link{}, invitation.ErrLinkNotFound
+		default:
+			return invitation.Link{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	return linkModel.transformToLink()
+}
+
+// Release gives back a use reserved by Use if the user couldn't join
+func (r InvitationLinkRepository) Release(ctx context.Context, id string) error {
+	query, params, err := dialect.Update(TABLE_INVITATION_LINKS).Set(goqu.Record{
+		"uses": goqu.L("uses - 1"),
+	}).Where(
+		goqu.Ex{"id": id},
+		goqu.C("uses").Gt(0),
+	).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_INVITATION_LINKS, "Release", func(ctx context.Context) error {
+		if _, err := r.dbc.ExecContext(ctx, query, params...); err != nil {
+			return fmt.Errorf("%w: %s", dbErr, checkPostgresError(err))
+		}
+		return nil
+	})
+}
@@ -50,19 +50,40 @@ func (s *InvitationRepository) Set(ctx context.Context, invite invitation.Invita
 	if invite.ExpiresAt.IsZero() {
 		invite.ExpiresAt = s.Now().Add(invitation.DefaultExpiryDuration)
 	}
+	if invite.Status == "" {
+		invite.Status = invitation.StatusPending
+	}
+	var sentAt, remindedAt interface{}
+	if !invite.SentAt.IsZero() {
+		sentAt = invite.SentAt
+	}
+	if !invite.RemindedAt.IsZero() {
+		remindedAt = invite.RemindedAt
+	}
 
 	query, params, err := dialect.Insert(TABLE_INVITATIONS).Rows(
 		goqu.Record{
-			"id":         invite.ID,
-			"user_id":    invite.UserID,
-			"org_id":     invite.OrgID,
-			"metadata":   marshaledMetadata,
-			"created_at": invite.CreatedAt,
-			"expires_at": invite.ExpiresAt,
+			"id":           invite.ID,
+			"user_id":      invite.UserID,
+			"org_id":       invite.OrgID,
+			"metadata":     marshaledMetadata,
+			"created_at":   invite.CreatedAt,
+			"expires_at":   invite.ExpiresAt,
+			"status":       invite.Status,
+			"updated_at":   invite.CreatedAt,
+			"sent_at":      sentAt,
+			"resend_count": invite.ResendCount,
+			"reminded_at":  remindedAt,
 		}).OnConflict(goqu.DoUpdate("id", goqu.Record{
-		"user_id":  invite.UserID,
-		"org_id":   invite.OrgID,
-		"metadata": marshaledMetadata,
+		"user_id":      invite.UserID,
+		"org_id":       invite.OrgID,
+		"metadata":     marshaledMetadata,
+		"expires_at":   invite.ExpiresAt,
+		"status":       invite.Status,
+		"updated_at":   s.Now(),
+		"sent_at":      sentAt,
+		"resend_count": invite.ResendCount,
+		"reminded_at":  remindedAt,
 	})).Returning(&Invitation{}).ToSQL()
 	if err != nil {
 		return inviModel, fmt.Errorf("%w: %s", queryErr, err)
@@ -117,7 +138,21 @@ func (s *InvitationRepository) List(ctx context.Context, flt invitation.Filter)
 			"user_id": flt.UserID,
 		})
 	}
+	if flt.Status != "" {
+		stmt = stmt.Where(goqu.Ex{
+			"status": flt.Status,
+		})
+	}
+	if !flt.ExpiresBefore.IsZero() {
+		stmt = stmt.Where(goqu.Ex{
+			"expires_at": goqu.Op{"lte": flt.ExpiresBefore},
+		})
+	}
 
+	stmt, err := paginate(stmt, flt.Pagination, invitationPaginationColumns)
+	if err != nil {
+		return nil, err
+	}
 	query, params, err := stmt.ToSQL()
 	if err != nil {
 		return nil, fmt.Errorf("%w: %s", queryErr, err)
@@ -131,6 +166,7 @@ func (s *InvitationRepository) List(ctx context.Context, flt invitation.Filter)
 		}
 		return nil, fmt.Errorf("%w: %s", dbErr, err)
 	}
+	fetchedInvitations = setNextPage(flt.Pagination, fetchedInvitations, invitationPaginationColumns)
 
 	var transformedInvitations []invitation.Invitation
 	for _, o := range fetchedInvitations {
@@ -198,7 +234,8 @@ func (s *InvitationRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	})
 }
 
-// TODO(kushsharma): schedue a cron for it
+// GarbageCollect deletes expired invitations, the invitation service cleans them
+// up along with their relations instead
 func (s *InvitationRepository) GarbageCollect(ctx context.Context) error {
 	query, params, err := dialect.Delete(TABLE_INVITATIONS).
 		Where(
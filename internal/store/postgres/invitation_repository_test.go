@@ -151,6 +151,9 @@ func (s *InvitationRespositoryTestSuite) TestGet() {
 				Metadata:  s.invites[0].Metadata,
 				CreatedAt: s.invites[0].CreatedAt,
 				ExpiresAt: s.invites[0].ExpiresAt,
+				Status:    s.invites[0].Status,
+				UpdatedAt: s.invites[0].UpdatedAt,
+				SentAt:    s.invites[0].SentAt,
 			},
 			ExpectedError: nil,
 		},
@@ -197,6 +200,9 @@ func (s *InvitationRespositoryTestSuite) TestList() {
 					Metadata:  s.invites[0].Metadata,
 					CreatedAt: s.invites[0].CreatedAt,
 					ExpiresAt: s.invites[0].ExpiresAt,
+					Status:    s.invites[0].Status,
+					UpdatedAt: s.invites[0].UpdatedAt,
+					SentAt:    s.invites[0].SentAt,
 				},
 			},
 			ExpectedError: nil,
@@ -211,6 +217,9 @@ func (s *InvitationRespositoryTestSuite) TestList() {
 					Metadata:  s.invites[0].Metadata,
 					CreatedAt: s.invites[0].CreatedAt,
 					ExpiresAt: s.invites[0].ExpiresAt,
+					Status:    s.invites[0].Status,
+					UpdatedAt: s.invites[0].UpdatedAt,
+					SentAt:    s.invites[0].SentAt,
 				},
 				{
 					ID:        s.invites[1].ID,
@@ -220,6 +229,9 @@ func (s *InvitationRespositoryTestSuite) TestList() {
 					Metadata:  s.invites[1].Metadata,
 					CreatedAt: s.invites[1].CreatedAt,
 					ExpiresAt: s.invites[1].ExpiresAt,
+					Status:    s.invites[1].Status,
+					UpdatedAt: s.invites[1].UpdatedAt,
+					SentAt:    s.invites[1].SentAt,
 				},
 			},
 			ExpectedError: nil,
@@ -263,6 +275,9 @@ func (s *InvitationRespositoryTestSuite) TestListByUser() {
 					Metadata:  s.invites[0].Metadata,
 					CreatedAt: s.invites[0].CreatedAt,
 					ExpiresAt: s.invites[0].ExpiresAt,
+					Status:    s.invites[0].Status,
+					UpdatedAt: s.invites[0].UpdatedAt,
+					SentAt:    s.invites[0].SentAt,
 				},
 			},
 			ExpectedError: nil,
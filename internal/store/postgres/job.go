@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/raystack/frontier/core/job"
+)
+
+type Job struct {
+	ID        string         `db:"id"`
+	Kind      string         `db:"kind"`
+	State     string         `db:"state"`
+	Payload   []byte         `db:"payload"`
+	Items     []byte         `db:"items"`
+	Error     sql.NullString `db:"error"`
+	CreatedBy sql.NullString `db:"created_by"`
+	CreatedAt time.Time      `db:"created_at"`
+	UpdatedAt time.Time      `db:"updated_at"`
+}
+
+func (from Job) transformToJob() (job.Job, error) {
+	var unmarshalledPayload map[string]any
+	if len(from.Payload) > 0 {
+		if err := json.Unmarshal(from.Payload, &unmarshalledPayload); err != nil {
+			return job.Job{}, err
+		}
+	}
+	var items []job.Item
+	if len(from.Items) > 0 {
+		if err := json.Unmarshal(from.Items, &items); err != nil {
+			return job.Job{}, err
+		}
+	}
+
+	return job.Job{
+		ID:        from.ID,
+		Kind:      from.Kind,
+		State:     job.State(from.State),
+		Payload:   unmarshalledPayload,
+		Items:     items,
+		Error:     from.Error.String,
+		CreatedBy: from.CreatedBy.String,
+		CreatedAt: from.CreatedAt,
+		UpdatedAt: from.UpdatedAt,
+	}, nil
+}
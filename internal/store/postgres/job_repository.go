@@ -0,0 +1,252 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/raystack/frontier/core/job"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+type JobRepository struct {
+	dbc *db.Client
+}
+
+func NewJobRepository(dbc *db.Client) *JobRepository {
+	return &JobRepository{
+		dbc: dbc,
+	}
+}
+
+func (r JobRepository) Create(ctx context.Context, j job.Job) (job.Job, error) {
+	if strings.TrimSpace(j.Kind) == "" || len(j.Items) == 0 {
+		return job.Job{}, job.ErrInvalidDetail
+	}
+
+	marshaledPayload, err := json.Marshal(j.Payload)
+	if err != nil {
+		return job.Job{}, fmt.Errorf("%w: %s", parseErr, err)
+	}
+	marshaledItems, err := json.Marshal(j.Items)
+	if err != nil {
+		return job.Job{}, fmt.Errorf("%w: %s", parseErr, err)
+	}
+
+	query, params, err := dialect.Insert(TABLE_JOBS).Rows(
+		goqu.Record{
+			"kind":       j.Kind,
+			"state":      j.State,
+			"payload":    marshaledPayload,
+			"items":      marshaledItems,
+			"created_by": j.CreatedBy,
+		}).Returning(&Job{}).ToSQL()
+	if err != nil {
+		return job.Job{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var jobModel Job
+	if err = r.dbc.WithTimeout(ctx, TABLE_JOBS, "Create", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&jobModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		return job.Job{}, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	return jobModel.transformToJob()
+}
+
+func (r JobRepository) GetByID(ctx context.Context, id string) (job.Job, error) {
+	if strings.TrimSpace(id) == "" {
+		return job.Job{}, job.ErrInvalidID
+	}
+
+	query, params, err := dialect.From(TABLE_JOBS).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return job.Job{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var jobModel Job
+	if err = r.dbc.WithTimeout(ctx, TABLE_JOBS, "GetByID", func(ctx context.Context) error {
+		return r.dbc.GetContext(ctx, &jobModel, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return job.Job{}, job.ErrNotExist
+		case errors.Is(err, ErrInvalidTextRepresentation):
+			return job.Job{}, job.ErrInvalidUUID
+		default:
+			return job.Job{}, err
+		}
+	}
+
+	return jobModel.transformToJob()
+}
+
+func (r JobRepository) List(ctx context.Context, flt job.Filter) ([]job.Job, error) {
+	stmt := dialect.From(TABLE_JOBS).Order(goqu.C("created_at").Desc())
+	if flt.Kind != "" {
+		stmt = stmt.Where(goqu.Ex{
+			"kind": flt.Kind,
+		})
+	}
+	if flt.State != "" {
+		stmt = stmt.Where(goqu.Ex{
+			"state": flt.State,
+		})
+	}
+	if flt.CreatedBy != "" {
+		stmt = stmt.Where(goqu.Ex{
+			"created_by": flt.CreatedBy,
+		})
+	}
+	query, params, err := stmt.ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var jobModels []Job
+	if err = r.dbc.WithTimeout(ctx, TABLE_JOBS, "List", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &jobModels, query, params...)
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []job.Job{}, nil
+		}
+		return nil, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	var jobs []job.Job
+	for _, jobModel := range jobModels {
+		j, err := jobModel.transformToJob()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", parseErr, err)
+		}
+		jobs = append(jobs, j)
+	}
+	return jobs, nil
+}
+
+func (r JobRepository) Claim(ctx context.Context, staleAfter time.Duration) (job.Job, error) {
+	// skip locked rows so concurrent workers of all instances claim different jobs
+	claimable := dialect.From(TABLE_JOBS).Select("id").Where(
+		goqu.Or(
+			goqu.Ex{"state": job.StatePending},
+			goqu.And(
+				goqu.Ex{"state": job.StateRunning},
+				goqu.C("updated_at").Lt(time.Now().Add(-staleAfter)),
+			),
+		),
+	).Order(goqu.C("created_at").Asc()).Limit(1).ForUpdate(exp.SkipLocked)
+
+	query, params, err := dialect.Update(TABLE_JOBS).Set(
+		goqu.Record{
+			"state":      job.StateRunning,
+			"updated_at": goqu.L("now()"),
+		}).Where(goqu.C("id").Eq(claimable)).Returning(&Job{}).ToSQL()
+	if err != nil {
+		return job.Job{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var jobModel Job
+	if err = r.dbc.WithTimeout(ctx, TABLE_JOBS, "Claim", func(ctx context.Context) error {
+		return r.dbc.GetContext(ctx, &jobModel, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return job.Job{}, job.ErrNotExist
+		}
+		return job.Job{}, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	return jobModel.transformToJob()
+}
+
+func (r JobRepository) Update(ctx context.Context, j job.Job) (job.Job, error) {
+	if strings.TrimSpace(j.ID) == "" {
+		return job.Job{}, job.ErrInvalidID
+	}
+
+	marshaledItems, err := json.Marshal(j.Items)
+	if err != nil {
+		return job.Job{}, fmt.Errorf("%w: %s", parseErr, err)
+	}
+
+	query, params, err := dialect.Update(TABLE_JOBS).Set(
+		goqu.Record{
+			"state":      j.State,
+			"items":      marshaledItems,
+			"error":      j.Error,
+			"updated_at": goqu.L("now()"),
+		}).Where(goqu.Ex{
+		"id":    j.ID,
+		"state": job.StateRunning,
+	}).Returning(&Job{}).ToSQL()
+	if err != nil {
+		return job.Job{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var jobModel Job
+	if err = r.dbc.WithTimeout(ctx, TABLE_JOBS, "Update", func(ctx context.Context) error {
+		return r.dbc.GetContext(ctx, &jobModel, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			// job is not running anymore
+			return job.Job{}, job.ErrFinished
+		case errors.Is(err, ErrInvalidTextRepresentation):
+			return job.Job{}, job.ErrInvalidUUID
+		default:
+			return job.Job{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	return jobModel.transformToJob()
+}
+
+func (r JobRepository) Cancel(ctx context.Context, id string) (job.Job, error) {
+	if strings.TrimSpace(id) == "" {
+		return job.Job{}, job.ErrInvalidID
+	}
+
+	query, params, err := dialect.Update(TABLE_JOBS).Set(
+		goqu.Record{
+			"state":      job.StateCancelled,
+			"updated_at": goqu.L("now()"),
+		}).Where(goqu.Ex{
+		"id":    id,
+		"state": goqu.Op{"in": []job.State{job.StatePending, job.StateRunning}},
+	}).Returning(&Job{}).ToSQL()
+	if err != nil {
+		return job.Job{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var jobModel Job
+	if err = r.dbc.WithTimeout(ctx, TABLE_JOBS, "Cancel", func(ctx context.Context) error {
+		return r.dbc.GetContext(ctx, &jobModel, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			if _, err := r.GetByID(ctx, id); err != nil {
+				return job.Job{}, err
+			}
+			return job.Job{}, job.ErrFinished
+		case errors.Is(err, ErrInvalidTextRepresentation):
+			return job.Job{}, job.ErrInvalidUUID
+		default:
+			return job.Job{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	return jobModel.transformToJob()
+}
@@ -0,0 +1,190 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ory/dockertest"
+	"github.com/raystack/frontier/core/job"
+	"github.com/raystack/frontier/internal/store/postgres"
+	"github.com/raystack/frontier/pkg/db"
+	"github.com/raystack/frontier/pkg/metadata"
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type JobRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *db.Client
+	pool       *dockertest.Pool
+	resource   *dockertest.Resource
+	repository *postgres.JobRepository
+}
+
+func (s *JobRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := log.NewZap()
+	s.client, s.pool, s.resource, err = newTestClient(logger)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.ctx = context.TODO()
+	s.repository = postgres.NewJobRepository(s.client)
+}
+
+func (s *JobRepositoryTestSuite) TearDownSuite() {
+	// Clean tests
+	if err := purgeDocker(s.pool, s.resource); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *JobRepositoryTestSuite) TearDownTest() {
+	if err := s.cleanup(); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *JobRepositoryTestSuite) cleanup() error {
+	queries := []string{
+		fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", postgres.TABLE_JOBS),
+	}
+	return execQueries(context.TODO(), s.client, queries)
+}
+
+func (s *JobRepositoryTestSuite) createJob(state job.State) job.Job {
+	created, err := s.repository.Create(s.ctx, job.Job{
+		Kind:      "organization_add_users",
+		State:     state,
+		Payload:   metadata.Metadata{"org_id": "some-org-id"},
+		Items:     job.NewItems([]string{"john@acme.org", "jane@acme.org"}),
+		CreatedBy: uuid.NewString(),
+	})
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	return created
+}
+
+// touch moves updated_at of a job back as if it stopped reporting progress
+func (s *JobRepositoryTestSuite) touch(id string, age time.Duration) {
+	query := fmt.Sprintf("UPDATE %s SET updated_at = now() - interval '%d seconds' WHERE id = '%s'",
+		postgres.TABLE_JOBS, int(age.Seconds()), id)
+	if err := execQueries(s.ctx, s.client, []string{query}); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *JobRepositoryTestSuite) TestCreate() {
+	s.Run("should store payload and items of a job", func() {
+		created := s.createJob(job.StatePending)
+		got, err := s.repository.GetByID(s.ctx, created.ID)
+		s.Assert().NoError(err)
+		s.Assert().Equal(job.StatePending, got.State)
+		s.Assert().Equal("some-org-id", got.Payload["org_id"])
+		s.Assert().Equal(created.Items, got.Items)
+	})
+	s.Run("should return error if job has no items", func() {
+		_, err := s.repository.Create(s.ctx, job.Job{Kind: "organization_add_users", State: job.StatePending})
+		s.Assert().ErrorIs(err, job.ErrInvalidDetail)
+	})
+	s.Run("should return error if job doesn't exist", func() {
+		_, err := s.repository.GetByID(s.ctx, uuid.NewString())
+		s.Assert().ErrorIs(err, job.ErrNotExist)
+	})
+}
+
+func (s *JobRepositoryTestSuite) TestClaim() {
+	s.Run("should claim the oldest pending job", func() {
+		first := s.createJob(job.StatePending)
+		s.createJob(job.StatePending)
+
+		got, err := s.repository.Claim(s.ctx, time.Minute)
+		s.Assert().NoError(err)
+		s.Assert().Equal(first.ID, got.ID)
+		s.Assert().Equal(job.StateRunning, got.State)
+	})
+	s.Run("should not claim a running job which reports progress", func() {
+		s.Assert().NoError(s.cleanup())
+		s.createJob(job.StateRunning)
+
+		_, err := s.repository.Claim(s.ctx, time.Minute)
+		s.Assert().ErrorIs(err, job.ErrNotExist)
+	})
+	s.Run("should claim again a running job which stopped reporting progress", func() {
+		s.Assert().NoError(s.cleanup())
+		stale := s.createJob(job.StateRunning)
+		s.touch(stale.ID, time.Hour)
+
+		got, err := s.repository.Claim(s.ctx, time.Minute)
+		s.Assert().NoError(err)
+		s.Assert().Equal(stale.ID, got.ID)
+
+		// claiming refreshes the job so other workers don't pick it too
+		_, err = s.repository.Claim(s.ctx, time.Minute)
+		s.Assert().ErrorIs(err, job.ErrNotExist)
+	})
+	s.Run("should not claim finished jobs", func() {
+		s.Assert().NoError(s.cleanup())
+		finished := s.createJob(job.StateCompleted)
+		s.touch(finished.ID, time.Hour)
+
+		_, err := s.repository.Claim(s.ctx, time.Minute)
+		s.Assert().ErrorIs(err, job.ErrNotExist)
+	})
+}
+
+func (s *JobRepositoryTestSuite) TestUpdate() {
+	s.Run("should save progress of a running job", func() {
+		running := s.createJob(job.StateRunning)
+		running.Items[0].State = job.ItemStateSucceeded
+		running.Items[1].State = job.ItemStateFailed
+		running.Items[1].Error = "user doesn't exist"
+
+		got, err := s.repository.Update(s.ctx, running)
+		s.Assert().NoError(err)
+		s.Assert().Equal(running.Items, got.Items)
+	})
+	s.Run("should return error finished if job is not running", func() {
+		pending := s.createJob(job.StatePending)
+		_, err := s.repository.Update(s.ctx, pending)
+		s.Assert().ErrorIs(err, job.ErrFinished)
+	})
+}
+
+func (s *JobRepositoryTestSuite) TestCancel() {
+	s.Run("should cancel a running job and stop its updates", func() {
+		running := s.createJob(job.StateRunning)
+		got, err := s.repository.Cancel(s.ctx, running.ID)
+		s.Assert().NoError(err)
+		s.Assert().Equal(job.StateCancelled, got.State)
+
+		_, err = s.repository.Update(s.ctx, running)
+		s.Assert().ErrorIs(err, job.ErrFinished)
+	})
+	s.Run("should return error finished if job is completed", func() {
+		completed := s.createJob(job.StateCompleted)
+		_, err := s.repository.Cancel(s.ctx, completed.ID)
+		s.Assert().ErrorIs(err, job.ErrFinished)
+	})
+}
+
+func (s *JobRepositoryTestSuite) TestList() {
+	s.createJob(job.StatePending)
+	s.createJob(job.StateCompleted)
+
+	got, err := s.repository.List(s.ctx, job.Filter{State: job.StateCompleted})
+	s.Assert().NoError(err)
+	s.Assert().Len(got, 1)
+	s.Assert().Equal(job.StateCompleted, got[0].State)
+}
+
+func TestJobRepository(t *testing.T) {
+	suite.Run(t, new(JobRepositoryTestSuite))
+}
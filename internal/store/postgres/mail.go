@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/raystack/frontier/core/email"
+)
+
+type MailTemplate struct {
+	Name      string    `db:"name"`
+	OrgID     string    `db:"org_id"`
+	Locale    string    `db:"locale"`
+	Subject   string    `db:"subject"`
+	HTMLBody  string    `db:"html_body"`
+	TextBody  string    `db:"text_body"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func (from MailTemplate) transformToTemplate() email.Template {
+	return email.Template{
+		Name:      from.Name,
+		OrgID:     from.OrgID,
+		Locale:    from.Locale,
+		Subject:   from.Subject,
+		HTMLBody:  from.HTMLBody,
+		TextBody:  from.TextBody,
+		UpdatedAt: from.UpdatedAt,
+	}
+}
+
+type Mail struct {
+	ID            string         `db:"id"`
+	Template      string         `db:"template"`
+	OrgID         string         `db:"org_id"`
+	Locale        string         `db:"locale"`
+	Recipients    []byte         `db:"recipients"`
+	Subject       string         `db:"subject"`
+	HTMLBody      string         `db:"html_body"`
+	TextBody      string         `db:"text_body"`
+	Status        string         `db:"status"`
+	Attempts      int            `db:"attempts"`
+	LastError     sql.NullString `db:"last_error"`
+	NextAttemptAt time.Time      `db:"next_attempt_at"`
+	SentAt        sql.NullTime   `db:"sent_at"`
+	CreatedAt     time.Time      `db:"created_at"`
+	UpdatedAt     time.Time      `db:"updated_at"`
+}
+
+func (from Mail) transformToMail() (email.Mail, error) {
+	var recipients []string
+	if len(from.Recipients) > 0 {
+		if err := json.Unmarshal(from.Recipients, &recipients); err != nil {
+			return email.Mail{}, err
+		}
+	}
+
+	return email.Mail{
+		ID:       from.ID,
+		Template: from.Template,
+		OrgID:    from.OrgID,
+		Locale:   from.Locale,
+		To:       recipients,
+		Message: email.Message{
+			Subject:  from.Subject,
+			HTMLBody: from.HTMLBody,
+			TextBody: from.TextBody,
+		},
+		Status:        email.Status(from.Status),
+		Attempts:      from.Attempts,
+		LastError:     from.LastError.String,
+		NextAttemptAt: from.NextAttemptAt,
+		SentAt:        from.SentAt.Time,
+		CreatedAt:     from.CreatedAt,
+		UpdatedAt:     from.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,224 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/raystack/frontier/core/email"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+type MailQueueRepository struct {
+	dbc *db.Client
+}
+
+func NewMailQueueRepository(dbc *db.Client) *MailQueueRepository {
+	return &MailQueueRepository{
+		dbc: dbc,
+	}
+}
+
+func (r MailQueueRepository) Create(ctx context.Context, m email.Mail) (email.Mail, error) {
+	if strings.TrimSpace(m.Template) == "" || len(m.To) == 0 {
+		return email.Mail{}, email.ErrInvalidDetail
+	}
+
+	marshaledRecipients, err := json.Marshal(m.To)
+	if err != nil {
+		return email.Mail{}, fmt.Errorf("%w: %s", parseErr, err)
+	}
+
+	query, params, err := dialect.Insert(TABLE_MAIL_QUEUE).Rows(
+		goqu.Record{
+			"template":        m.Template,
+			"org_id":          m.OrgID,
+			"locale":          m.Locale,
+			"recipients":      marshaledRecipients,
+			"subject":         m.Message.Subject,
+			"html_body":       m.Message.HTMLBody,
+			"text_body":       m.Message.TextBody,
+			"status":          m.Status,
+			"next_attempt_at": m.NextAttemptAt,
+		}).Returning(&Mail{}).ToSQL()
+	if err != nil {
+		return email.Mail{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var mailModel Mail
+	if err = r.dbc.WithTimeout(ctx, TABLE_MAIL_QUEUE, "Create", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&mailModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		return email.Mail{}, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	return mailModel.transformToMail()
+}
+
+func (r MailQueueRepository) Get(ctx context.Context, id string) (email.Mail, error) {
+	if strings.TrimSpace(id) == "" {
+		return email.Mail{}, email.ErrNotExist
+	}
+
+	query, params, err := dialect.From(TABLE_MAIL_QUEUE).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return email.Mail{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var mailModel Mail
+	if err = r.dbc.WithTimeout(ctx, TABLE_MAIL_QUEUE, "Get", func(ctx context.Context) error {
+		return r.dbc.GetContext(ctx, &mailModel, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return email.Mail{}, email.ErrNotExist
+		case errors.Is(err, ErrInvalidTextRepresentation):
+			return email.Mail{}, email.ErrInvalidUUID
+		default:
+			return email.Mail{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	return mailModel.transformToMail()
+}
+
+func (r MailQueueRepository) List(ctx context.Context, flt email.MailFilter) ([]email.Mail, error) {
+	stmt := dialect.From(TABLE_MAIL_QUEUE).Order(goqu.C("created_at").Desc())
+	if flt.Status != "" {
+		stmt = stmt.Where(goqu.Ex{
+			"status": flt.Status,
+		})
+	}
+	if flt.Limit > 0 {
+		stmt = stmt.Limit(uint(flt.Limit))
+	}
+	query, params, err := stmt.ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var mailModels []Mail
+	if err = r.dbc.WithTimeout(ctx, TABLE_MAIL_QUEUE, "List", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &mailModels, query, params...)
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []email.Mail{}, nil
+		}
+		return nil, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	var mails []email.Mail
+	for _, mailModel := range mailModels {
+		m, err := mailModel.transformToMail()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", parseErr, err)
+		}
+		mails = append(mails, m)
+	}
+	return mails, nil
+}
+
+func (r MailQueueRepository) Claim(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]email.Mail, error) {
+	// skip locked rows so workers of all instances claim different mails
+	claimable := dialect.From(TABLE_MAIL_QUEUE).Select("id").Where(
+		goqu.Ex{"status": email.StatusQueued},
+		goqu.C("next_attempt_at").Lte(now),
+	).Order(goqu.C("next_attempt_at").Asc()).Limit(uint(limit)).ForUpdate(exp.SkipLocked)
+
+	query, params, err := dialect.Update(TABLE_MAIL_QUEUE).Set(
+		goqu.Record{
+			"attempts":        goqu.L("attempts + 1"),
+			"next_attempt_at": now.Add(lease),
+			"updated_at":      goqu.L("now()"),
+		}).Where(goqu.C("id").In(claimable)).Returning(&Mail{}).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var mailModels []Mail
+	if err = r.dbc.WithTimeout(ctx, TABLE_MAIL_QUEUE, "Claim", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &mailModels, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return []email.Mail{}, nil
+		}
+		return nil, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	var mails []email.Mail
+	for _, mailModel := range mailModels {
+		m, err := mailModel.transformToMail()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", parseErr, err)
+		}
+		mails = append(mails, m)
+	}
+	return mails, nil
+}
+
+func (r MailQueueRepository) Update(ctx context.Context, m email.Mail) (email.Mail, error) {
+	if strings.TrimSpace(m.ID) == "" {
+		return email.Mail{}, email.ErrNotExist
+	}
+
+	record := goqu.Record{
+		"status":          m.Status,
+		"last_error":      m.LastError,
+		"next_attempt_at": m.NextAttemptAt,
+		"updated_at":      goqu.L("now()"),
+	}
+	if !m.SentAt.IsZero() {
+		record["sent_at"] = m.SentAt
+	}
+	query, params, err := dialect.Update(TABLE_MAIL_QUEUE).Set(record).Where(goqu.Ex{
+		"id": m.ID,
+	}).Returning(&Mail{}).ToSQL()
+	if err != nil {
+		return email.Mail{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var mailModel Mail
+	if err = r.dbc.WithTimeout(ctx, TABLE_MAIL_QUEUE, "Update", func(ctx context.Context) error {
+		return r.dbc.GetContext(ctx, &mailModel, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return email.Mail{}, email.ErrNotExist
+		case errors.Is(err, ErrInvalidTextRepresentation):
+			return email.Mail{}, email.ErrInvalidUUID
+		default:
+			return email.Mail{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	return mailModel.transformToMail()
+}
+
+func (r MailQueueRepository) DeleteBefore(ctx context.Context, before time.Time) error {
+	query, params, err := dialect.Delete(TABLE_MAIL_QUEUE).Where(
+		goqu.Ex{"status": goqu.Op{"in": []email.Status{email.StatusSent, email.StatusFailed}}},
+		goqu.C("updated_at").Lt(before),
+	).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_MAIL_QUEUE, "DeleteBefore", func(ctx context.Context) error {
+		if _, err := r.dbc.ExecContext(ctx, query, params...); err != nil {
+			return fmt.Errorf("%w: %s", dbErr, checkPostgresError(err))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/raystack/frontier/core/email"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+type MailTemplateRepository struct {
+	dbc *db.Client
+}
+
+func NewMailTemplateRepository(dbc *db.Client) *MailTemplateRepository {
+	return &MailTemplateRepository{
+		dbc: dbc,
+	}
+}
+
+func (r MailTemplateRepository) Set(ctx context.Context, tmpl email.Template) (email.Template, error) {
+	if strings.TrimSpace(tmpl.Name) == "" {
+		return email.Template{}, email.ErrInvalidTemplate
+	}
+
+	query, params, err := dialect.Insert(TABLE_MAIL_TEMPLATES).Rows(
+		goqu.Record{
+			"name":       tmpl.Name,
+			"org_id":     tmpl.OrgID,
+			"locale":     tmpl.Locale,
+			"subject":    tmpl.Subject,
+			"html_body":  tmpl.HTMLBody,
+			"text_body":  tmpl.TextBody,
+			"updated_at": goqu.L("NOW()"),
+		}).OnConflict(goqu.DoUpdate("name, org_id, locale",
+		goqu.Record{
+			"subject":    tmpl.Subject,
+			"html_body":  tmpl.HTMLBody,
+			"text_body":  tmpl.TextBody,
+			"updated_at": goqu.L("NOW()"),
+		},
+	)).Returning(&MailTemplate{}).ToSQL()
+	if err != nil {
+		return email.Template{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var tmplModel MailTemplate
+	if err = r.dbc.WithTimeout(ctx, TABLE_MAIL_TEMPLATES, "Set", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&tmplModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		return email.Template{}, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	return tmplModel.transformToTemplate(), nil
+}
+
+func (r MailTemplateRepository) List(ctx context.Context, flt email.TemplateFilter) ([]email.Template, error) {
+	stmt := dialect.From(TABLE_MAIL_TEMPLATES).Order(goqu.C("name").Asc(), goqu.C("locale").Asc())
+	if flt.Name != "" {
+		stmt = stmt.Where(goqu.Ex{
+			"name": flt.Name,
+		})
+	}
+	owners := []string{flt.OrgID}
+	if flt.Platform && flt.OrgID != "" {
+		owners = append(owners, "")
+	}
+	stmt = stmt.Where(goqu.Ex{
+		"org_id": goqu.Op{"in": owners},
+	})
+	query, params, err := stmt.ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var tmplModels []MailTemplate
+	if err = r.dbc.WithTimeout(ctx, TABLE_MAIL_TEMPLATES, "List", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &tmplModels, query, params...)
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []email.Template{}, nil
+		}
+		return nil, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	var tmpls []email.Template
+	for _, tmplModel := range tmplModels {
+		tmpls = append(tmpls, tmplModel.transformToTemplate())
+	}
+	return tmpls, nil
+}
+
+func (r MailTemplateRepository) Delete(ctx context.Context, name, orgID, locale string) error {
+	query, params, err := dialect.Delete(TABLE_MAIL_TEMPLATES).Where(goqu.Ex{
+		"name":   name,
+		"org_id": orgID,
+		"locale": locale,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_MAIL_TEMPLATES, "Delete", func(ctx context.Context) error {
+		result, err := r.dbc.ExecContext(ctx, query, params...)
+		if err != nil {
+			return fmt.Errorf("%w: %s", dbErr, checkPostgresError(err))
+		}
+		if count, _ := result.RowsAffected(); count == 0 {
+			return email.ErrTemplateNotExist
+		}
+		return nil
+	})
+}
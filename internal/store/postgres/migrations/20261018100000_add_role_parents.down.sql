@@ -0,0 +1 @@
+ALTER TABLE roles DROP COLUMN IF EXISTS parents;
\ No newline at end of file
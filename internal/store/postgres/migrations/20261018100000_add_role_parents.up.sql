@@ -0,0 +1,2 @@
+ALTER TABLE roles ADD COLUMN parents text[];
+CREATE INDEX roles_parents_idx ON roles USING gin(parents);
\ No newline at end of file
@@ -0,0 +1,3 @@
+DELETE FROM policies WHERE effect = 'deny';
+ALTER TABLE policies DROP COLUMN IF EXISTS effect;
+ALTER TABLE policies ADD CONSTRAINT policies_role_id_resource_id_resource_type_principal_id_pri_key UNIQUE (role_id, resource_id, resource_type, principal_id, principal_type);
\ No newline at end of file
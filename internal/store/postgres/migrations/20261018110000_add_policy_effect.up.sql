@@ -0,0 +1,4 @@
+ALTER TABLE policies ADD COLUMN effect text NOT NULL DEFAULT 'allow';
+-- a principal can be allowed and denied the same role on a resource
+ALTER TABLE policies DROP CONSTRAINT IF EXISTS policies_role_id_resource_id_resource_type_principal_id_pri_key;
+ALTER TABLE policies ADD CONSTRAINT policies_role_resource_principal_effect_unique UNIQUE (role_id, resource_id, resource_type, principal_id, principal_type, effect);
\ No newline at end of file
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS folders (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name text NOT NULL,
+    title text,
+    org_id uuid NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    parent_id uuid REFERENCES folders(id) ON DELETE CASCADE,
+    metadata jsonb,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT folders_org_id_name_unique UNIQUE (org_id, name)
+);
+CREATE INDEX IF NOT EXISTS folders_org_id_idx ON folders(org_id);
+CREATE INDEX IF NOT EXISTS folders_parent_id_idx ON folders(parent_id);
+ALTER TABLE projects ADD COLUMN folder_id uuid REFERENCES folders(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS projects_folder_id_idx ON projects(folder_id);
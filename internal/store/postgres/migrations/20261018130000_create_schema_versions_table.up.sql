@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS schema_versions (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    version bigserial NOT NULL UNIQUE,
+    definition jsonb NOT NULL,
+    source text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
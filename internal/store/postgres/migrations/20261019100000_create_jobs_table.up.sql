@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS jobs (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    kind text NOT NULL,
+    state text NOT NULL,
+    payload jsonb,
+    items jsonb NOT NULL,
+    error text,
+    created_by text,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS jobs_state_created_at_idx ON jobs(state, created_at);
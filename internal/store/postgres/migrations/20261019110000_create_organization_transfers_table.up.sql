@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS organization_transfers (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    org_id uuid NOT NULL UNIQUE REFERENCES organizations(id) ON DELETE CASCADE,
+    from_user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    to_user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS serviceuser_credentials_expires_at_idx;
+ALTER TABLE serviceuser_credentials DROP COLUMN IF EXISTS expiry_notified_at;
+ALTER TABLE serviceuser_credentials DROP COLUMN IF EXISTS last_used_ip;
+ALTER TABLE serviceuser_credentials DROP COLUMN IF EXISTS last_used_at;
+ALTER TABLE serviceuser_credentials DROP COLUMN IF EXISTS scope;
+ALTER TABLE serviceuser_credentials DROP COLUMN IF EXISTS expires_at;
@@ -0,0 +1,6 @@
+ALTER TABLE serviceuser_credentials ADD COLUMN IF NOT EXISTS expires_at timestamptz;
+ALTER TABLE serviceuser_credentials ADD COLUMN IF NOT EXISTS scope jsonb;
+ALTER TABLE serviceuser_credentials ADD COLUMN IF NOT EXISTS last_used_at timestamptz;
+ALTER TABLE serviceuser_credentials ADD COLUMN IF NOT EXISTS last_used_ip text;
+ALTER TABLE serviceuser_credentials ADD COLUMN IF NOT EXISTS expiry_notified_at timestamptz;
+CREATE INDEX IF NOT EXISTS serviceuser_credentials_expires_at_idx ON serviceuser_credentials(expires_at);
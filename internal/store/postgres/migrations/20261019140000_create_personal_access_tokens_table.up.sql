@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS personal_access_tokens (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    org_id uuid REFERENCES organizations(id) ON DELETE CASCADE,
+    title text NOT NULL,
+    secret_hash text NOT NULL UNIQUE,
+    scope jsonb,
+    expires_at timestamptz NOT NULL,
+    last_used_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS personal_access_tokens_user_id_idx ON personal_access_tokens(user_id);
+CREATE INDEX IF NOT EXISTS personal_access_tokens_org_id_idx ON personal_access_tokens(org_id);
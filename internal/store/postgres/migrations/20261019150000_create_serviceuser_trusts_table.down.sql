@@ -0,0 +1 @@
+DROP TABLE IF EXISTS serviceuser_trusts;
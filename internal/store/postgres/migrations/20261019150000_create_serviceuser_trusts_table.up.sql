@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS serviceuser_trusts (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    serviceuser_id uuid NOT NULL REFERENCES serviceusers(id) ON DELETE CASCADE,
+    title text,
+    issuer text NOT NULL,
+    jwks_url text,
+    jwks jsonb,
+    subject text,
+    audience text,
+    claims jsonb,
+    scope jsonb,
+    expires_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS serviceuser_trusts_serviceuser_id_idx ON serviceuser_trusts(serviceuser_id);
+CREATE INDEX IF NOT EXISTS serviceuser_trusts_issuer_idx ON serviceuser_trusts(issuer);
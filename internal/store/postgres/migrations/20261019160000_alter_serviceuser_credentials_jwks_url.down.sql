@@ -0,0 +1 @@
+ALTER TABLE serviceuser_credentials DROP COLUMN IF EXISTS jwks_url;
@@ -0,0 +1 @@
+ALTER TABLE serviceuser_credentials ADD COLUMN IF NOT EXISTS jwks_url text;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS invitations_expires_at_idx;
+ALTER TABLE invitations DROP COLUMN IF EXISTS reminded_at;
+ALTER TABLE invitations DROP COLUMN IF EXISTS resend_count;
+ALTER TABLE invitations DROP COLUMN IF EXISTS sent_at;
+ALTER TABLE invitations DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE invitations DROP COLUMN IF EXISTS status;
@@ -0,0 +1,6 @@
+ALTER TABLE invitations ADD COLUMN IF NOT EXISTS status text NOT NULL DEFAULT 'pending';
+ALTER TABLE invitations ADD COLUMN IF NOT EXISTS updated_at timestamptz NOT NULL DEFAULT NOW();
+ALTER TABLE invitations ADD COLUMN IF NOT EXISTS sent_at timestamptz;
+ALTER TABLE invitations ADD COLUMN IF NOT EXISTS resend_count integer NOT NULL DEFAULT 0;
+ALTER TABLE invitations ADD COLUMN IF NOT EXISTS reminded_at timestamptz;
+CREATE INDEX IF NOT EXISTS invitations_expires_at_idx ON invitations(expires_at);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS invitation_links (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    org_id uuid NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    group_ids jsonb,
+    role_ids jsonb,
+    domains jsonb,
+    max_uses integer NOT NULL DEFAULT 0,
+    uses integer NOT NULL DEFAULT 0,
+    created_by uuid REFERENCES users(id) ON DELETE SET NULL,
+    secret_hash text NOT NULL UNIQUE,
+    expires_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS invitation_links_org_id_idx ON invitation_links(org_id);
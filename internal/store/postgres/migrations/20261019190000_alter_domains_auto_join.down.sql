@@ -0,0 +1 @@
+ALTER TABLE domains DROP COLUMN IF EXISTS auto_join;
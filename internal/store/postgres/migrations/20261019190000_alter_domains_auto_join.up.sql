@@ -0,0 +1 @@
+ALTER TABLE domains ADD COLUMN IF NOT EXISTS auto_join jsonb NOT NULL DEFAULT '{}'::jsonb;
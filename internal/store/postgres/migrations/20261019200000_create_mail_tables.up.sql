@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS mail_templates (
+    name text NOT NULL,
+    org_id text NOT NULL DEFAULT '',
+    locale text NOT NULL DEFAULT '',
+    subject text NOT NULL,
+    html_body text NOT NULL,
+    text_body text NOT NULL DEFAULT '',
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (name, org_id, locale)
+);
+CREATE TABLE IF NOT EXISTS mail_queue (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    template text NOT NULL,
+    org_id text NOT NULL DEFAULT '',
+    locale text NOT NULL DEFAULT '',
+    recipients jsonb NOT NULL,
+    subject text NOT NULL,
+    html_body text NOT NULL,
+    text_body text NOT NULL,
+    status text NOT NULL,
+    attempts integer NOT NULL DEFAULT 0,
+    last_error text,
+    next_attempt_at timestamptz NOT NULL DEFAULT NOW(),
+    sent_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS mail_queue_status_next_attempt_at_idx ON mail_queue(status, next_attempt_at);
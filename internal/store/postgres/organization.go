@@ -38,5 +38,6 @@ func (from Organization) transformToOrg() (organization.Organization, error) {
 		State:     organization.State(from.State.String),
 		CreatedAt: from.CreatedAt,
 		UpdatedAt: from.UpdatedAt,
+		DeletedAt: from.DeletedAt.Time,
 	}, nil
 }
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
 	"github.com/raystack/frontier/core/organization"
 	"github.com/raystack/frontier/pkg/db"
 )
@@ -23,12 +24,21 @@ func NewOrganizationRepository(dbc *db.Client) *OrganizationRepository {
 	}
 }
 
-var notDisabledOrgExp = goqu.Or(
+var activeOrgExp = goqu.Or(
 	goqu.Ex{
 		"state": nil,
 	},
 	goqu.Ex{
-		"state": goqu.Op{"neq": organization.Disabled},
+		"state": goqu.Op{"notIn": []organization.State{organization.Disabled, organization.Deleted}},
+	},
+)
+
+var notDeletedOrgExp = goqu.Or(
+	goqu.Ex{
+		"state": nil,
+	},
+	goqu.Ex{
+		"state": goqu.Op{"neq": organization.Deleted},
 	},
 )
 
@@ -74,7 +84,7 @@ func (r OrganizationRepository) GetByIDs(ctx context.Context, ids []string) ([]o
 
 	query, params, err := dialect.From(TABLE_ORGANIZATIONS).Where(goqu.Ex{
 		"id": goqu.Op{"in": ids},
-	}).Where(notDisabledOrgExp).ToSQL()
+	}).Where(activeOrgExp).ToSQL()
 	if err != nil {
 		return nil, fmt.Errorf("%w: %s", queryErr, err)
 	}
@@ -189,12 +199,19 @@ func (r OrganizationRepository) Create(ctx context.Context, org organization.Org
 
 func (r OrganizationRepository) List(ctx context.Context, flt organization.Filter) ([]organization.Organization, error) {
 	stmt := dialect.From(TABLE_ORGANIZATIONS)
-	if flt.State == "" {
-		stmt = stmt.Where(notDisabledOrgExp)
-	} else {
-		stmt = stmt.Where(goqu.Ex{
+	var stateExp exp.Expression = activeOrgExp
+	if flt.State != "" {
+		stateExp = goqu.Ex{
 			"state": flt.State.String(),
-		})
+		}
+	}
+	if flt.IncludeDeleted {
+		stateExp = goqu.Or(stateExp, goqu.Ex{"state": organization.Deleted.String()})
+	}
+	stmt = stmt.Where(stateExp)
+	stmt, err := paginate(stmt, flt.Pagination, defaultPaginationColumns)
+	if err != nil {
+		return []organization.Organization{}, err
 	}
 	query, params, err := stmt.ToSQL()
 	if err != nil {
@@ -210,6 +227,7 @@ func (r OrganizationRepository) List(ctx context.Context, flt organization.Filte
 		}
 		return []organization.Organization{}, fmt.Errorf("%w: %s", dbErr, err)
 	}
+	orgModels = setNextPage(flt.Pagination, orgModels, defaultPaginationColumns)
 
 	var transformedOrgs []organization.Organization
 	for _, o := range orgModels {
@@ -323,12 +341,47 @@ func (r OrganizationRepository) UpdateByName(ctx context.Context, org organizati
 }
 
 func (r OrganizationRepository) SetState(ctx context.Context, id string, state organization.State) error {
+	record := goqu.Record{
+		"state": state.String(),
+	}
+	if state == organization.Deleted {
+		record["deleted_at"] = goqu.L("now()")
+	}
+	// deleted organizations can only be brought back with Restore
+	query, params, err := dialect.Update(TABLE_ORGANIZATIONS).Set(record).Where(
+		goqu.Ex{
+			"id": id,
+		},
+		notDeletedOrgExp,
+	).Returning(&Organization{}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var orgModel Organization
+	if err = r.dbc.WithTimeout(ctx, TABLE_ORGANIZATIONS, "SetState", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&orgModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return organization.ErrNotExist
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+func (r OrganizationRepository) Restore(ctx context.Context, id string) error {
 	query, params, err := dialect.Update(TABLE_ORGANIZATIONS).Set(
 		goqu.Record{
-			"state": state.String(),
+			"state":      organization.Enabled.String(),
+			"deleted_at": nil,
 		}).Where(
 		goqu.Ex{
-			"id": id,
+			"id":    id,
+			"state": organization.Deleted.String(),
 		},
 	).Returning(&Organization{}).ToSQL()
 	if err != nil {
@@ -336,7 +389,7 @@ func (r OrganizationRepository) SetState(ctx context.Context, id string, state o
 	}
 
 	var orgModel Organization
-	if err = r.dbc.WithTimeout(ctx, TABLE_ORGANIZATIONS, "SetState", func(ctx context.Context) error {
+	if err = r.dbc.WithTimeout(ctx, TABLE_ORGANIZATIONS, "Restore", func(ctx context.Context) error {
 		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&orgModel)
 	}); err != nil {
 		err = checkPostgresError(err)
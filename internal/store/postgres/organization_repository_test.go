@@ -17,6 +17,7 @@ import (
 	"github.com/raystack/frontier/internal/store/postgres"
 	"github.com/raystack/frontier/pkg/db"
 	"github.com/raystack/frontier/pkg/metadata"
+	"github.com/raystack/frontier/pkg/pagination"
 	"github.com/raystack/salt/log"
 	"github.com/stretchr/testify/suite"
 )
@@ -288,6 +289,40 @@ func (s *OrganizationRepositoryTestSuite) TestList() {
 			},
 			ErrString: "",
 		},
+		{
+			Description: "should get a page of organizations in requested order",
+			Filter: organization.Filter{
+				Pagination: &pagination.Pagination{
+					PageSize: 1,
+					OrderBy:  pagination.Order{Field: "name", Desc: true},
+				},
+			},
+			ExpectedOrganizations: []organization.Organization{
+				{
+					Name:     "org-2",
+					State:    organization.Enabled,
+					Metadata: metadata.Metadata{},
+				},
+			},
+		},
+		{
+			Description: "should get organizations matching filter",
+			Filter: organization.Filter{
+				Pagination: &pagination.Pagination{
+					OrderBy: pagination.Order{Field: "created_at"},
+					Filters: []pagination.Filter{
+						{Field: "name", Operator: pagination.OperatorEqual, Value: "org-1"},
+					},
+				},
+			},
+			ExpectedOrganizations: []organization.Organization{
+				{
+					Name:     "org-1",
+					State:    organization.Enabled,
+					Metadata: metadata.Metadata{},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -495,6 +530,31 @@ func (s *OrganizationRepositoryTestSuite) TestSetState() {
 	}
 }
 
+func (s *OrganizationRepositoryTestSuite) TestRestore() {
+	orgID := s.orgs[0].ID
+	s.Require().NoError(s.repository.SetState(s.ctx, orgID, organization.Deleted))
+
+	deletedOrgs, err := s.repository.List(s.ctx, organization.Filter{State: organization.Deleted})
+	s.Require().NoError(err)
+	s.Require().Len(deletedOrgs, 1)
+	s.Assert().Equal(orgID, deletedOrgs[0].ID)
+	s.Assert().False(deletedOrgs[0].DeletedAt.IsZero())
+
+	activeOrgs, err := s.repository.List(s.ctx, organization.Filter{})
+	s.Require().NoError(err)
+	s.Assert().Len(activeOrgs, len(s.orgs)-1)
+
+	// state of a deleted organization can only be changed by restoring it
+	s.Assert().ErrorIs(s.repository.SetState(s.ctx, orgID, organization.Enabled), organization.ErrNotExist)
+	s.Assert().NoError(s.repository.Restore(s.ctx, orgID))
+	s.Assert().ErrorIs(s.repository.Restore(s.ctx, orgID), organization.ErrNotExist)
+
+	restoredOrg, err := s.repository.GetByID(s.ctx, orgID)
+	s.Require().NoError(err)
+	s.Assert().Equal(organization.Enabled, restoredOrg.State)
+	s.Assert().True(restoredOrg.DeletedAt.IsZero())
+}
+
 func (s *OrganizationRepositoryTestSuite) TestGetByIDs() {
 	type testCase struct {
 		Description           string
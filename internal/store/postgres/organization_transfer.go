@@ -0,0 +1,25 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/raystack/frontier/core/organization"
+)
+
+type OrganizationTransfer struct {
+	ID         string    `db:"id"`
+	OrgID      string    `db:"org_id"`
+	FromUserID string    `db:"from_user_id"`
+	ToUserID   string    `db:"to_user_id"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+func (from OrganizationTransfer) transformToTransfer() organization.Transfer {
+	return organization.Transfer{
+		ID:         from.ID,
+		OrgID:      from.OrgID,
+		FromUserID: from.FromUserID,
+		ToUserID:   from.ToUserID,
+		CreatedAt:  from.CreatedAt,
+	}
+}
@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+type OrganizationTransferRepository struct {
+	dbc *db.Client
+}
+
+func NewOrganizationTransferRepository(dbc *db.Client) *OrganizationTransferRepository {
+	return &OrganizationTransferRepository{
+		dbc: dbc,
+	}
+}
+
+// Create stores a transfer, a pending transfer of the same org is replaced
+func (r OrganizationTransferRepository) Create(ctx context.Context, transfer organization.Transfer) (organization.Transfer, error) {
+	query, params, err := dialect.Insert(TABLE_ORGANIZATION_TRANSFERS).Rows(
+		goqu.Record{
+			"org_id":       transfer.OrgID,
+			"from_user_id": transfer.FromUserID,
+			"to_user_id":   transfer.ToUserID,
+		}).OnConflict(goqu.DoUpdate("org_id", goqu.Record{
+		"id":           goqu.L("uuid_generate_v4()"),
+		"from_user_id": transfer.FromUserID,
+		"to_user_id":   transfer.ToUserID,
+		"created_at":   goqu.L("now()"),
+	})).Returning(&OrganizationTransfer{}).ToSQL()
+	if err != nil {
+		return organization.Transfer{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var transferModel OrganizationTransfer
+	if err = r.dbc.WithTimeout(ctx, TABLE_ORGANIZATION_TRANSFERS, "Create", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&transferModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, ErrForeignKeyViolation):
+			return organization.Transfer{}, organization.ErrInvalidDetail
+		default:
+			return organization.Transfer{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	return transferModel.transformToTransfer(), nil
+}
+
+func (r OrganizationTransferRepository) Get(ctx context.Context, id string) (organization.Transfer, error) {
+	if strings.TrimSpace(id) == "" {
+		return organization.Transfer{}, organization.ErrTransferNotExist
+	}
+
+	query, params, err := dialect.From(TABLE_ORGANIZATION_TRANSFERS).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return organization.Transfer{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var transferModel OrganizationTransfer
+	if err = r.dbc.WithTimeout(ctx, TABLE_ORGANIZATION_TRANSFERS, "Get", func(ctx context.Context) error {
+		return r.dbc.GetContext(ctx, &transferModel, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows),
+			errors.Is(err, ErrInvalidTextRepresentation):
+			return organization.Transfer{}, organization.ErrTransferNotExist
+		default:
+			return organization.Transfer{}, err
+		}
+	}
+
+	return transferModel.transformToTransfer(), nil
+}
+
+func (r OrganizationTransferRepository) Delete(ctx context.Context, id string) error {
+	query, params, err := dialect.Delete(TABLE_ORGANIZATION_TRANSFERS).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_ORGANIZATION_TRANSFERS, "Delete", func(ctx context.Context) error {
+		result, err := r.dbc.ExecContext(ctx, query, params...)
+		if err != nil {
+			err = checkPostgresError(err)
+			return fmt.Errorf("%w: %s", dbErr, err)
+		}
+
+		if count, _ := result.RowsAffected(); count > 0 {
+			return nil
+		}
+
+		return organization.ErrTransferNotExist
+	})
+}
@@ -0,0 +1,218 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/raystack/frontier/pkg/pagination"
+)
+
+// paginationColumns maps fields allowed in order by and filters to columns of a
+// table, metadata keys are filtered over the jsonb column mapped to "metadata"
+type paginationColumns map[string]string
+
+var (
+	// defaultPaginationColumns are present in all tables of core entities
+	defaultPaginationColumns = paginationColumns{
+		"id":         "id",
+		"name":       "name",
+		"title":      "title",
+		"state":      "state",
+		"metadata":   "metadata",
+		"created_at": "created_at",
+		"updated_at": "updated_at",
+	}
+
+	policyPaginationColumns = paginationColumns{
+		"id":             "p.id",
+		"role_id":        "p.role_id",
+		"resource_id":    "p.resource_id",
+		"resource_type":  "p.resource_type",
+		"principal_id":   "p.principal_id",
+		"principal_type": "p.principal_type",
+		"effect":         "p.effect",
+		"metadata":       "p.metadata",
+		"created_at":     "p.created_at",
+		"updated_at":     "p.updated_at",
+	}
+
+	resourcePaginationColumns = paginationColumns{
+		"id":           "id",
+		"urn":          "urn",
+		"name":         "name",
+		"title":        "title",
+		"namespace":    "namespace_name",
+		"principal_id": "principal_id",
+		"metadata":     "metadata",
+		"created_at":   "created_at",
+		"updated_at":   "updated_at",
+	}
+
+	invitationPaginationColumns = paginationColumns{
+		"id":         "id",
+		"user_id":    "user_id",
+		"metadata":   "metadata",
+		"created_at": "created_at",
+		"expires_at": "expires_at",
+	}
+
+	serviceUserPaginationColumns = paginationColumns{
+		"id":         "s.id",
+		"title":      "s.title",
+		"state":      "s.state",
+		"metadata":   "s.metadata",
+		"created_at": "s.created_at",
+		"updated_at": "s.updated_at",
+	}
+
+	userPaginationColumns = paginationColumns{
+		"id":         "users.id",
+		"name":       "name",
+		"email":      "email",
+		"title":      "title",
+		"state":      "state",
+		"metadata":   "metadata",
+		"created_at": "users.created_at",
+		"updated_at": "users.updated_at",
+	}
+
+	relationPaginationColumns = paginationColumns{
+		"id":                "id",
+		"subject_namespace": "subject_namespace_name",
+		"subject_id":        "subject_id",
+		"object_namespace":  "object_namespace_name",
+		"object_id":         "object_id",
+		"relation":          "relation_name",
+		"created_at":        "created_at",
+		"updated_at":        "updated_at",
+	}
+
+	nullablePaginationFields = map[string]bool{
+		"title": true,
+		"state": true,
+	}
+)
+
+// paginate applies filters, order and cursor of the page over the statement. One
+// extra row is fetched to know if there is a next page, see setNextPage.
+// Statement is returned as is if page is nil.
+func paginate(stmt *goqu.SelectDataset, page *pagination.Pagination, columns paginationColumns) (*goqu.SelectDataset, error) {
+	if page == nil {
+		return stmt, nil
+	}
+	for _, flt := range page.Filters {
+		col, err := filterColumn(flt.Field, columns)
+		if err != nil {
+			return nil, err
+		}
+		switch flt.Operator {
+		case pagination.OperatorEqual:
+			stmt = stmt.Where(col.Eq(flt.Value))
+		case pagination.OperatorNotEqual:
+			stmt = stmt.Where(col.Neq(flt.Value))
+		case pagination.OperatorContains:
+			stmt = stmt.Where(col.ILike(fmt.Sprintf("%%%s%%", flt.Value)))
+		default:
+			return nil, fmt.Errorf("%w: %s", pagination.ErrInvalidFilter, flt.Operator)
+		}
+	}
+
+	orderCol, ok := columns[page.OrderBy.Field]
+	if !ok || page.OrderBy.Field == "metadata" {
+		return nil, fmt.Errorf("%w: unknown field %s", pagination.ErrInvalidOrderBy, page.OrderBy.Field)
+	}
+	idCol := columns["id"]
+
+	// nulls would be skipped by the keyset comparison
+	var orderExpr orderExpression = goqu.I(orderCol)
+	if nullablePaginationFields[page.OrderBy.Field] {
+		orderExpr = goqu.L("COALESCE(?, '')", goqu.I(orderCol))
+	}
+
+	// keyset over order column and id to keep pages stable while rows are added
+	value, id, ok, err := page.Cursor()
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		cmp := ">"
+		if page.OrderBy.Desc {
+			cmp = "<"
+		}
+		stmt = stmt.Where(goqu.L(fmt.Sprintf("(?, ?) %s (?, ?)", cmp),
+			orderExpr, goqu.I(idCol), value, id))
+	}
+
+	if page.OrderBy.Desc {
+		stmt = stmt.Order(orderExpr.Desc(), goqu.I(idCol).Desc())
+	} else {
+		stmt = stmt.Order(orderExpr.Asc(), goqu.I(idCol).Asc())
+	}
+	return stmt.Limit(uint(page.Limit() + 1)), nil
+}
+
+type orderExpression interface {
+	exp.Expression
+	exp.Orderable
+}
+
+type filterExpression interface {
+	exp.Comparable
+	exp.Likeable
+}
+
+func filterColumn(field string, columns paginationColumns) (filterExpression, error) {
+	if key, ok := strings.CutPrefix(field, pagination.MetadataFieldPrefix); ok {
+		metadataCol, ok := columns["metadata"]
+		if !ok || key == "" {
+			return nil, fmt.Errorf("%w: unknown field %s", pagination.ErrInvalidFilter, field)
+		}
+		return goqu.L("?->>?", goqu.I(metadataCol), key), nil
+	}
+	col, ok := columns[field]
+	if !ok || field == "metadata" {
+		return nil, fmt.Errorf("%w: unknown field %s", pagination.ErrInvalidFilter, field)
+	}
+	return goqu.I(col), nil
+}
+
+// setNextPage drops the extra row fetched by paginate and sets the next page
+// token from the last row, rows must be db models tagged with column names
+func setNextPage[T any](page *pagination.Pagination, rows []T, columns paginationColumns) []T {
+	if page == nil || len(rows) <= page.Limit() {
+		return rows
+	}
+	rows = rows[:page.Limit()]
+	last := rows[len(rows)-1]
+	page.SetNext(columnValue(last, columns[page.OrderBy.Field]), columnValue(last, columns["id"]))
+	return rows
+}
+
+// columnValue reads the field of a model tagged with the column name
+func columnValue(row any, column string) string {
+	if idx := strings.LastIndex(column, "."); idx >= 0 {
+		column = column[idx+1:]
+	}
+	v := reflect.Indirect(reflect.ValueOf(row))
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).Tag.Get("db") != column {
+			continue
+		}
+		switch val := v.Field(i).Interface().(type) {
+		case time.Time:
+			return val.Format(time.RFC3339Nano)
+		case sql.NullString:
+			return val.String
+		case sql.NullTime:
+			return val.Time.Format(time.RFC3339Nano)
+		default:
+			return fmt.Sprint(val)
+		}
+	}
+	return ""
+}
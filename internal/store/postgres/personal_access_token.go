@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/raystack/frontier/core/pat"
+)
+
+type PersonalAccessToken struct {
+	ID         string         `db:"id"`
+	UserID     string         `db:"user_id"`
+	OrgID      sql.NullString `db:"org_id"`
+	Title      string         `db:"title"`
+	SecretHash string         `db:"secret_hash"`
+	Scope      []byte         `db:"scope"`
+	ExpiresAt  time.Time      `db:"expires_at"`
+	LastUsedAt sql.NullTime   `db:"last_used_at"`
+	CreatedAt  time.Time      `db:"created_at"`
+}
+
+func (from PersonalAccessToken) transformToToken() (pat.Token, error) {
+	token := pat.Token{
+		ID:         from.ID,
+		UserID:     from.UserID,
+		Title:      from.Title,
+		SecretHash: from.SecretHash,
+		ExpiresAt:  from.ExpiresAt,
+		LastUsedAt: from.LastUsedAt.Time,
+		CreatedAt:  from.CreatedAt,
+	}
+	if len(from.Scope) > 0 {
+		if err := json.Unmarshal(from.Scope, &token.Scope); err != nil {
+			return pat.Token{}, err
+		}
+	}
+	token.Scope.OrgID = from.OrgID.String
+	return token, nil
+}
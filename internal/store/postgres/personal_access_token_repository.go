@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/raystack/frontier/core/pat"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+type PersonalAccessTokenRepository struct {
+	dbc *db.Client
+}
+
+func NewPersonalAccessTokenRepository(dbc *db.Client) *PersonalAccessTokenRepository {
+	return &PersonalAccessTokenRepository{
+		dbc: dbc,
+	}
+}
+
+func (r PersonalAccessTokenRepository) Create(ctx context.Context, token pat.Token) (pat.Token, error) {
+	// org is kept in its own column to list tokens of an org
+	orgID := token.Scope.OrgID
+	token.Scope.OrgID = ""
+	var scopeJson []byte
+	if !token.Scope.IsEmpty() {
+		var err error
+		if scopeJson, err = json.Marshal(token.Scope); err != nil {
+			return pat.Token{}, fmt.Errorf("%w: %s", parseErr, err)
+		}
+	}
+	record := goqu.Record{
+		"user_id":     token.UserID,
+		"title":       token.Title,
+		"secret_hash": token.SecretHash,
+		"scope":       scopeJson,
+		"expires_at":  token.ExpiresAt,
+	}
+	if orgID != "" {
+		record["org_id"] = orgID
+	}
+	query, params, err := dialect.Insert(TABLE_PERSONAL_TOKENS).Rows(record).
+		Returning(&PersonalAccessToken{}).ToSQL()
+	if err != nil {
+		return pat.Token{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var tokenModel PersonalAccessToken
+	if err = r.dbc.WithTimeout(ctx, TABLE_PERSONAL_TOKENS, "Create", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&tokenModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, ErrForeignKeyViolation), errors.Is(err, ErrInvalidTextRepresentation):
+			return pat.Token{}, pat.ErrInvalidDetail
+		default:
+			return pat.Token{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	return tokenModel.transformToToken()
+}
+
+func (r PersonalAccessTokenRepository) List(ctx context.Context, flt pat.Filter) ([]pat.Token, error) {
+	stmt := dialect.From(TABLE_PERSONAL_TOKENS)
+	if flt.UserID != "" {
+		stmt = stmt.Where(goqu.Ex{"user_id": flt.UserID})
+	}
+	if flt.OrgID != "" {
+		stmt = stmt.Where(goqu.Ex{"org_id": flt.OrgID})
+	}
+	query, params, err := stmt.Order(goqu.C("created_at").Asc()).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var tokenModels []PersonalAccessToken
+	if err = r.dbc.WithTimeout(ctx, TABLE_PERSONAL_TOKENS, "List", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &tokenModels, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, ErrInvalidTextRepresentation) {
+			return []pat.Token{}, nil
+		}
+		return nil, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	tokens := make([]pat.Token, 0, len(tokenModels))
+	for _, tokenModel := range tokenModels {
+		token, err := tokenModel.transformToToken()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", parseErr, err)
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
+}
+
+func (r PersonalAccessTokenRepository) Get(ctx context.Context, id string) (pat.Token, error) {
+	return r.get(ctx, "Get", goqu.Ex{"id": id})
+}
+
+func (r PersonalAccessTokenRepository) GetBySecretHash(ctx context.Context, secretHash string) (pat.Token, error) {
+	return r.get(ctx, "GetBySecretHash", goqu.Ex{"secret_hash": secretHash})
+}
+
+func (r PersonalAccessTokenRepository) get(ctx context.Context, op string, where goqu.Ex) (pat.Token, error) {
+	query, params, err := dialect.From(TABLE_PERSONAL_TOKENS).Where(where).ToSQL()
+	if err != nil {
+		return pat.Token{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var tokenModel PersonalAccessToken
+	if err = r.dbc.WithTimeout(ctx, TABLE_PERSONAL_TOKENS, op, func(ctx context.Context) error {
+		return r.dbc.GetContext(ctx, &tokenModel, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows), errors.Is(err, ErrInvalidTextRepresentation):
+			return pat.Token{}, pat.ErrNotExist
+		default:
+			return pat.Token{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	token, err := tokenModel.transformToToken()
+	if err != nil {
+		return pat.Token{}, fmt.Errorf("%w: %s", parseErr, err)
+	}
+	return token, nil
+}
+
+func (r PersonalAccessTokenRepository) Delete(ctx context.Context, id string) error {
+	query, params, err := dialect.Delete(TABLE_PERSONAL_TOKENS).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_PERSONAL_TOKENS, "Delete", func(ctx context.Context) error {
+		result, err := r.dbc.ExecContext(ctx, query, params...)
+		if err != nil {
+			err = checkPostgresError(err)
+			if errors.Is(err, ErrInvalidTextRepresentation) {
+				return pat.ErrNotExist
+			}
+			return fmt.Errorf("%w: %s", dbErr, err)
+		}
+
+		if count, _ := result.RowsAffected(); count > 0 {
+			return nil
+		}
+
+		return pat.ErrNotExist
+	})
+}
+
+func (r PersonalAccessTokenRepository) UpdateUsage(ctx context.Context, id string, usedAt time.Time) error {
+	query, params, err := dialect.Update(TABLE_PERSONAL_TOKENS).Set(goqu.Record{
+		"last_used_at": usedAt,
+	}).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_PERSONAL_TOKENS, "UpdateUsage", func(ctx context.Context) error {
+		if _, err := r.dbc.ExecContext(ctx, query, params...); err != nil {
+			return fmt.Errorf("%w: %s", dbErr, checkPostgresError(err))
+		}
+		return nil
+	})
+}
@@ -34,6 +34,7 @@ func (r PolicyRepository) buildListQuery() *goqu.SelectDataset {
 		"p.principal_id",
 		"p.principal_type",
 		"p.role_id",
+		"p.effect",
 	).From(goqu.T(TABLE_POLICIES).As("p"))
 }
 
@@ -111,7 +112,16 @@ func (r PolicyRepository) List(ctx context.Context, flt policy.Filter) ([]policy
 			"role_id": flt.RoleID,
 		})
 	}
+	if flt.Effect != "" {
+		stmt = stmt.Where(goqu.Ex{
+			"effect": flt.Effect.String(),
+		})
+	}
 
+	stmt, err := paginate(stmt.SelectAppend("p.created_at", "p.updated_at"), flt.Pagination, policyPaginationColumns)
+	if err != nil {
+		return []policy.Policy{}, err
+	}
 	query, params, err := stmt.ToSQL()
 	if err != nil {
 		return []policy.Policy{}, fmt.Errorf("%w: %s", queryErr, err)
@@ -128,6 +138,7 @@ func (r PolicyRepository) List(ctx context.Context, flt policy.Filter) ([]policy
 			return []policy.Policy{}, err
 		}
 	}
+	fetchedPolicies = setNextPage(flt.Pagination, fetchedPolicies, policyPaginationColumns)
 
 	var transformedPolicies []policy.Policy
 	for _, p := range fetchedPolicies {
@@ -142,6 +153,9 @@ func (r PolicyRepository) List(ctx context.Context, flt policy.Filter) ([]policy
 }
 
 func (r PolicyRepository) Upsert(ctx context.Context, pol policy.Policy) (policy.Policy, error) {
+	if pol.Effect == "" {
+		pol.Effect = policy.Allow
+	}
 	marshaledMetadata, err := json.Marshal(pol.Metadata)
 	if err != nil {
 		return policy.Policy{}, fmt.Errorf("%w: %s", parseErr, err)
@@ -154,8 +168,9 @@ func (r PolicyRepository) Upsert(ctx context.Context, pol policy.Policy) (policy
 			"resource_id":    pol.ResourceID,
 			"principal_id":   pol.PrincipalID,
 			"principal_type": pol.PrincipalType,
+			"effect":         pol.Effect.String(),
 			"metadata":       marshaledMetadata,
-		}).OnConflict(goqu.DoUpdate("role_id, resource_id, resource_type, principal_id, principal_type", goqu.Record{
+		}).OnConflict(goqu.DoUpdate("role_id, resource_id, resource_type, principal_id, principal_type, effect", goqu.Record{
 		"metadata": marshaledMetadata,
 	})).Returning(&PolicyCols{}).ToSQL()
 	if err != nil {
@@ -211,6 +211,29 @@ func (s *PolicyRepositoryTestSuite) TestCreate() {
 	}
 }
 
+func (s *PolicyRepositoryTestSuite) TestUpsertEffect() {
+	toCreate := policy.Policy{
+		RoleID:        s.role.ID,
+		ResourceID:    uuid.NewString(),
+		ResourceType:  "ns1",
+		PrincipalID:   s.userID,
+		PrincipalType: schema.UserPrincipal,
+	}
+	allowed, err := s.repository.Upsert(s.ctx, toCreate)
+	s.Assert().NoError(err)
+	s.Assert().Equal(policy.Allow, allowed.Effect)
+
+	toCreate.Effect = policy.Deny
+	denied, err := s.repository.Upsert(s.ctx, toCreate)
+	s.Assert().NoError(err)
+	s.Assert().Equal(policy.Deny, denied.Effect)
+	s.Assert().NotEqual(allowed.ID, denied.ID)
+
+	deniedAgain, err := s.repository.Upsert(s.ctx, toCreate)
+	s.Assert().NoError(err)
+	s.Assert().Equal(denied.ID, deniedAgain.ID)
+}
+
 func (s *PolicyRepositoryTestSuite) TestList() {
 	type testCase struct {
 		Description     string
@@ -248,6 +271,13 @@ func (s *PolicyRepositoryTestSuite) TestList() {
 				ProjectID:     uuid.NewString(),
 			},
 		},
+		{
+			Description:     "should get no deny policies when all of them allow",
+			ExpectedPolicys: nil,
+			flt: policy.Filter{
+				Effect: policy.Deny,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
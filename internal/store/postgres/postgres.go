@@ -39,6 +39,17 @@ const (
 	TABLE_AUDITLOGS              = "auditlogs"
 	TABLE_DOMAINS                = "domains"
 	TABLE_PREFERENCES            = "preferences"
+	TABLE_FOLDERS                = "folders"
+	TABLE_SCHEMA_VERSIONS        = "schema_versions"
+	TABLE_JOBS                   = "jobs"
+	TABLE_ORGANIZATION_TRANSFERS = "organization_transfers"
+	TABLE_USER_IDENTITIES        = "user_identities"
+	TABLE_USER_EMAILS            = "user_emails"
+	TABLE_PERSONAL_TOKENS        = "personal_access_tokens"
+	TABLE_SERVICEUSER_TRUSTS     = "serviceuser_trusts"
+	TABLE_INVITATION_LINKS       = "invitation_links"
+	TABLE_MAIL_TEMPLATES         = "mail_templates"
+	TABLE_MAIL_QUEUE             = "mail_queue"
 )
 
 func checkPostgresError(err error) error {
@@ -15,6 +15,7 @@ type Project struct {
 	Name      string         `db:"name"`
 	Title     sql.NullString `db:"title"`
 	OrgID     string         `db:"org_id"`
+	FolderID  sql.NullString `db:"folder_id"`
 	Metadata  []byte         `db:"metadata"`
 	State     sql.NullString `db:"state"`
 	CreatedAt time.Time      `db:"created_at"`
@@ -35,9 +36,11 @@ func (from Project) transformToProject() (project.Project, error) {
 		Name:         from.Name,
 		Title:        from.Title.String,
 		Organization: organization.Organization{ID: from.OrgID},
+		FolderID:     from.FolderID.String,
 		Metadata:     unmarshalledMetadata,
 		State:        project.State(from.State.String),
 		CreatedAt:    from.CreatedAt,
 		UpdatedAt:    from.UpdatedAt,
+		DeletedAt:    from.DeletedAt.Time,
 	}, nil
 }
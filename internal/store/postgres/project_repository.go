@@ -9,9 +9,9 @@ import (
 	"strings"
 
 	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
 	"github.com/raystack/frontier/core/organization"
 	"github.com/raystack/frontier/core/project"
-	"github.com/raystack/frontier/core/user"
 	"github.com/raystack/frontier/pkg/db"
 )
 
@@ -25,12 +25,21 @@ func NewProjectRepository(dbc *db.Client) *ProjectRepository {
 	}
 }
 
-var notDisabledProjectExp = goqu.Or(
+var activeProjectExp = goqu.Or(
 	goqu.Ex{
 		"state": nil,
 	},
 	goqu.Ex{
-		"state": goqu.Op{"neq": project.Disabled},
+		"state": goqu.Op{"notIn": []project.State{project.Disabled, project.Deleted}},
+	},
+)
+
+var notDeletedProjectExp = goqu.Or(
+	goqu.Ex{
+		"state": nil,
+	},
+	goqu.Ex{
+		"state": goqu.Op{"neq": project.Deleted},
 	},
 )
 
@@ -41,7 +50,7 @@ func (r ProjectRepository) GetByID(ctx context.Context, id string) (project.Proj
 
 	query, params, err := dialect.From(TABLE_PROJECTS).Where(goqu.ExOr{
 		"id": id,
-	}).Where(notDisabledProjectExp).ToSQL()
+	}).Where(activeProjectExp).ToSQL()
 	if err != nil {
 		return project.Project{}, fmt.Errorf("%w: %s", queryErr, err)
 	}
@@ -81,7 +90,7 @@ func (r ProjectRepository) GetByIDs(ctx context.Context, ids []string, flt proje
 			"org_id": flt.OrgID,
 		})
 	}
-	query, params, err := stmt.Where(notDisabledProjectExp).ToSQL()
+	query, params, err := stmt.Where(activeProjectExp).ToSQL()
 	if err != nil {
 		return nil, fmt.Errorf("%w: %s", queryErr, err)
 	}
@@ -119,7 +128,7 @@ func (r ProjectRepository) GetByName(ctx context.Context, name string) (project.
 
 	query, params, err := dialect.From(TABLE_PROJECTS).Where(goqu.Ex{
 		"name": name,
-	}).Where(notDisabledProjectExp).ToSQL()
+	}).Where(activeProjectExp).ToSQL()
 	if err != nil {
 		return project.Project{}, fmt.Errorf("%w: %s", queryErr, err)
 	}
@@ -166,6 +175,9 @@ func (r ProjectRepository) Create(ctx context.Context, prj project.Project) (pro
 	if prj.State != "" {
 		insertRow["state"] = prj.State
 	}
+	if prj.FolderID != "" {
+		insertRow["folder_id"] = prj.FolderID
+	}
 	query, params, err := dialect.Insert(TABLE_PROJECTS).Rows(insertRow).Returning(&Project{}).ToSQL()
 	if err != nil {
 		return project.Project{}, fmt.Errorf("%w: %s", queryErr, err)
@@ -203,13 +215,25 @@ func (r ProjectRepository) List(ctx context.Context, flt project.Filter) ([]proj
 			"org_id": flt.OrgID,
 		})
 	}
-	if flt.State == "" {
-		stmt = stmt.Where(notDisabledProjectExp)
-	} else {
+	if flt.FolderID != "" {
 		stmt = stmt.Where(goqu.Ex{
-			"state": flt.State.String(),
+			"folder_id": flt.FolderID,
 		})
 	}
+	var stateExp exp.Expression = activeProjectExp
+	if flt.State != "" {
+		stateExp = goqu.Ex{
+			"state": flt.State.String(),
+		}
+	}
+	if flt.IncludeDeleted {
+		stateExp = goqu.Or(stateExp, goqu.Ex{"state": project.Deleted.String()})
+	}
+	stmt = stmt.Where(stateExp)
+	stmt, err := paginate(stmt, flt.Pagination, defaultPaginationColumns)
+	if err != nil {
+		return []project.Project{}, err
+	}
 	query, params, err := stmt.ToSQL()
 	if err != nil {
 		return []project.Project{}, fmt.Errorf("%w: %s", queryErr, err)
@@ -224,6 +248,7 @@ func (r ProjectRepository) List(ctx context.Context, flt project.Filter) ([]proj
 		}
 		return []project.Project{}, fmt.Errorf("%w: %s", dbErr, err)
 	}
+	projectModels = setNextPage(flt.Pagination, projectModels, defaultPaginationColumns)
 
 	var transformedProjects []project.Project
 	for _, p := range projectModels {
@@ -335,9 +360,77 @@ func (r ProjectRepository) UpdateByName(ctx context.Context, prj project.Project
 }
 
 func (r ProjectRepository) SetState(ctx context.Context, id string, state project.State) error {
+	record := goqu.Record{
+		"state": state.String(),
+	}
+	if state == project.Deleted {
+		record["deleted_at"] = goqu.L("now()")
+	}
+	// deleted projects can only be brought back with Restore
+	query, params, err := dialect.Update(TABLE_PROJECTS).Set(record).Where(
+		goqu.Ex{
+			"id": id,
+		},
+		notDeletedProjectExp,
+	).Returning(&Project{}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var projectModel Project
+	if err = r.dbc.WithTimeout(ctx, TABLE_PROJECTS, "SetState", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&projectModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return project.ErrNotExist
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+func (r ProjectRepository) Restore(ctx context.Context, id string) error {
 	query, params, err := dialect.Update(TABLE_PROJECTS).Set(
 		goqu.Record{
-			"state": state.String(),
+			"state":      project.Enabled.String(),
+			"deleted_at": nil,
+		}).Where(
+		goqu.Ex{
+			"id":    id,
+			"state": project.Deleted.String(),
+		},
+	).Returning(&Project{}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var projectModel Project
+	if err = r.dbc.WithTimeout(ctx, TABLE_PROJECTS, "Restore", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&projectModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return project.ErrNotExist
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+func (r ProjectRepository) SetFolder(ctx context.Context, id, folderID string) error {
+	var folder any
+	if folderID != "" {
+		folder = folderID
+	}
+	query, params, err := dialect.Update(TABLE_PROJECTS).Set(
+		goqu.Record{
+			"folder_id":  folder,
+			"updated_at": goqu.L("now()"),
 		}).Where(
 		goqu.Ex{
 			"id": id,
@@ -347,16 +440,24 @@ func (r ProjectRepository) SetState(ctx context.Context, id string, state projec
 		return fmt.Errorf("%w: %s", queryErr, err)
 	}
 
-	if err = r.dbc.WithTimeout(ctx, TABLE_PROJECTS, "SetState", func(ctx context.Context) error {
-		if _, err = r.dbc.DB.ExecContext(ctx, query, params...); err != nil {
+	if err = r.dbc.WithTimeout(ctx, TABLE_PROJECTS, "SetFolder", func(ctx context.Context) error {
+		result, err := r.dbc.DB.ExecContext(ctx, query, params...)
+		if err != nil {
 			return err
 		}
+		if count, _ := result.RowsAffected(); count == 0 {
+			return sql.ErrNoRows
+		}
 		return nil
 	}); err != nil {
 		err = checkPostgresError(err)
 		switch {
 		case errors.Is(err, sql.ErrNoRows):
-			return user.ErrNotExist
+			return project.ErrNotExist
+		case errors.Is(err, ErrInvalidTextRepresentation):
+			return project.ErrInvalidUUID
+		case errors.Is(err, ErrForeignKeyViolation):
+			return project.ErrInvalidDetail
 		default:
 			return err
 		}
@@ -56,8 +56,12 @@ func (r RelationRepository) Upsert(ctx context.Context, relationToCreate relatio
 	return relationModel.transformToRelationV2(), nil
 }
 
-func (r RelationRepository) List(ctx context.Context) ([]relation.Relation, error) {
-	query, params, err := dialect.Select(&relationCols{}).From(TABLE_RELATIONS).ToSQL()
+func (r RelationRepository) List(ctx context.Context, flt relation.Filter) ([]relation.Relation, error) {
+	stmt, err := paginate(dialect.Select(&relationCols{}).From(TABLE_RELATIONS), flt.Pagination, relationPaginationColumns)
+	if err != nil {
+		return []relation.Relation{}, err
+	}
+	query, params, err := stmt.ToSQL()
 	if err != nil {
 		return []relation.Relation{}, fmt.Errorf("%w: %s", queryErr, err)
 	}
@@ -72,6 +76,7 @@ func (r RelationRepository) List(ctx context.Context) ([]relation.Relation, erro
 		}
 		return []relation.Relation{}, fmt.Errorf("%w: %s", dbErr, err)
 	}
+	fetchedRelations = setNextPage(flt.Pagination, fetchedRelations, relationPaginationColumns)
 
 	var transformedRelations []relation.Relation
 	for _, r := range fetchedRelations {
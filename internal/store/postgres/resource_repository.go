@@ -115,6 +115,10 @@ func (r ResourceRepository) List(ctx context.Context, flt resource.Filter) ([]re
 	if flt.NamespaceID != "" {
 		sqlStatement = sqlStatement.Where(goqu.Ex{"namespace_name": flt.NamespaceID})
 	}
+	sqlStatement, err := paginate(sqlStatement, flt.Pagination, resourcePaginationColumns)
+	if err != nil {
+		return nil, err
+	}
 	query, params, err := sqlStatement.ToSQL()
 	if err != nil {
 		return nil, err
@@ -132,6 +136,7 @@ func (r ResourceRepository) List(ctx context.Context, flt resource.Filter) ([]re
 		}
 		return []resource.Resource{}, fmt.Errorf("%w: %s", dbErr, err)
 	}
+	fetchedResources = setNextPage(flt.Pagination, fetchedResources, resourcePaginationColumns)
 
 	var transformedResources []resource.Resource
 	for _, r := range fetchedResources {
@@ -216,6 +221,33 @@ func (r ResourceRepository) Update(ctx context.Context, res resource.Resource) (
 	return resourceModel.transformToResource()
 }
 
+func (r ResourceRepository) UpdatePrincipal(ctx context.Context, id, principalID, principalType string) error {
+	if strings.TrimSpace(id) == "" || !utils.IsValidUUID(id) {
+		return resource.ErrInvalidID
+	}
+	query, params, err := dialect.Update(TABLE_RESOURCES).Set(
+		goqu.Record{
+			"principal_id":   principalID,
+			"principal_type": principalType,
+			"updated_at":     goqu.L("now()"),
+		},
+	).Where(goqu.Ex{"id": id}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_RESOURCES, "UpdatePrincipal", func(ctx context.Context) error {
+		result, err := r.dbc.ExecContext(ctx, query, params...)
+		if err != nil {
+			return checkPostgresError(err)
+		}
+		if count, _ := result.RowsAffected(); count == 0 {
+			return resource.ErrNotExist
+		}
+		return nil
+	})
+}
+
 func (r ResourceRepository) GetByURN(ctx context.Context, urn string) (resource.Resource, error) {
 	if strings.TrimSpace(urn) == "" {
 		return resource.Resource{}, resource.ErrInvalidURN
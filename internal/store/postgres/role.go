@@ -18,6 +18,7 @@ type Role struct {
 	Permissions []byte         `db:"permissions"`
 	State       string         `db:"state"`
 	Scopes      pq.StringArray `db:"scopes"`
+	Parents     pq.StringArray `db:"parents"`
 	Metadata    []byte         `db:"metadata"`
 	CreatedAt   time.Time      `db:"created_at"`
 	UpdatedAt   time.Time      `db:"updated_at"`
@@ -46,6 +47,7 @@ func (from Role) transformToRole() (role.Role, error) {
 		Permissions: unmarshalledPermissions,
 		Metadata:    unmarshalledMetadata,
 		Scopes:      from.Scopes,
+		Parents:     from.Parents,
 		State:       role.State(from.State),
 		CreatedAt:   from.CreatedAt,
 		UpdatedAt:   from.UpdatedAt,
@@ -40,6 +40,7 @@ func (r RoleRepository) buildListQuery(dialect goqu.DialectWrapper) *goqu.Select
 		goqu.I("r.title"),
 		goqu.I("r.permissions"),
 		goqu.I("r.scopes"),
+		goqu.I("r.parents"),
 		goqu.I("r.state"),
 		goqu.I("r.metadata"),
 		goqu.I("r.created_at"),
@@ -132,12 +133,14 @@ func (r RoleRepository) Upsert(ctx context.Context, rl role.Role) (role.Role, er
 			"state":       goqu.L("$6"),
 			"metadata":    goqu.L("$7"),
 			"scopes":      goqu.L("$8"),
+			"parents":     goqu.L("$9"),
 		}).OnConflict(goqu.DoUpdate("org_id, name", goqu.Record{
 		"title":       goqu.L("$4"),
 		"permissions": goqu.L("$5"),
 		"state":       goqu.L("$6"),
 		"metadata":    goqu.L("$7"),
 		"scopes":      goqu.L("$8"),
+		"parents":     goqu.L("$9"),
 	})).Returning(&Role{}).ToSQL()
 	if err != nil {
 		return role.Role{}, fmt.Errorf("%w: %s", queryErr, err)
@@ -146,7 +149,7 @@ func (r RoleRepository) Upsert(ctx context.Context, rl role.Role) (role.Role, er
 	var roleDB Role
 	if err = r.dbc.WithTimeout(ctx, TABLE_ROLES, "Upsert", func(ctx context.Context) error {
 		return r.dbc.QueryRowxContext(ctx, query, rl.ID, rl.OrgID, rl.Name, rl.Title, marshaledPermissions,
-			rl.State, marshaledMetadata, pq.Array(rl.Scopes)).StructScan(&roleDB)
+			rl.State, marshaledMetadata, pq.Array(rl.Scopes), pq.Array(rl.Parents)).StructScan(&roleDB)
 	}); err != nil {
 		err = checkPostgresError(err)
 		switch {
@@ -173,6 +176,9 @@ func (r RoleRepository) List(ctx context.Context, flt role.Filter) ([]role.Role,
 		flt.Scopes = utils.Map(flt.Scopes, strings.ToLower)
 		stmt = stmt.Where(goqu.L("scopes && ?", pq.Array(flt.Scopes)))
 	}
+	if flt.ParentID != "" {
+		stmt = stmt.Where(goqu.L("parents @> ?", pq.Array([]string{flt.ParentID})))
+	}
 
 	query, params, err := stmt.ToSQL()
 	if err != nil {
@@ -224,6 +230,7 @@ func (r RoleRepository) Update(ctx context.Context, rl role.Role) (role.Role, er
 			"state":       goqu.L("$5"),
 			"metadata":    goqu.L("$6"),
 			"scopes":      goqu.L("$7"),
+			"parents":     goqu.L("$8"),
 			"updated_at":  goqu.L("now()"),
 		}).Where(
 		goqu.Ex{"id": goqu.L("$1")},
@@ -235,7 +242,7 @@ func (r RoleRepository) Update(ctx context.Context, rl role.Role) (role.Role, er
 	var roleDB Role
 	if err = r.dbc.WithTimeout(ctx, TABLE_ROLES, "Update", func(ctx context.Context) error {
 		return r.dbc.QueryRowxContext(ctx, query, rl.ID, rl.Name, marshaledPermissions, rl.Title, rl.State,
-			marshaledMetadata, pq.Array(rl.Scopes)).StructScan(&roleDB)
+			marshaledMetadata, pq.Array(rl.Scopes), pq.Array(rl.Parents)).StructScan(&roleDB)
 	}); err != nil {
 		err = checkPostgresError(err)
 		switch {
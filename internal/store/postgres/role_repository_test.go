@@ -257,6 +257,26 @@ func (s *RoleRepositoryTestSuite) TestList() {
 	}
 }
 
+func (s *RoleRepositoryTestSuite) TestListByParent() {
+	child, err := s.repository.Upsert(s.ctx, role.Role{
+		Name:        "role child",
+		OrgID:       s.orgID,
+		Permissions: []string{"member"},
+		Parents:     []string{s.roles[0].ID},
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal([]string{s.roles[0].ID}, child.Parents)
+
+	got, err := s.repository.List(s.ctx, role.Filter{ParentID: s.roles[0].ID})
+	s.Require().NoError(err)
+	s.Require().Len(got, 1)
+	s.Assert().Equal(child.ID, got[0].ID)
+
+	got, err = s.repository.List(s.ctx, role.Filter{ParentID: s.roles[1].ID})
+	s.Require().NoError(err)
+	s.Assert().Len(got, 0)
+}
+
 func (s *RoleRepositoryTestSuite) TestUpdate() {
 	type testCase struct {
 		Description    string
@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/raystack/frontier/internal/bootstrap"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+)
+
+type SchemaVersion struct {
+	ID         string    `db:"id"`
+	Version    int64     `db:"version"`
+	Definition []byte    `db:"definition"`
+	Source     string    `db:"source"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+func (from SchemaVersion) transformToSchemaVersion() (bootstrap.SchemaVersion, error) {
+	var definition schema.ServiceDefinition
+	if len(from.Definition) > 0 {
+		if err := json.Unmarshal(from.Definition, &definition); err != nil {
+			return bootstrap.SchemaVersion{}, err
+		}
+	}
+
+	return bootstrap.SchemaVersion{
+		ID:         from.ID,
+		Version:    from.Version,
+		Definition: definition,
+		Source:     from.Source,
+		CreatedAt:  from.CreatedAt,
+	}, nil
+}
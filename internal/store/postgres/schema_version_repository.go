@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/raystack/frontier/internal/bootstrap"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+type SchemaVersionRepository struct {
+	dbc *db.Client
+}
+
+func NewSchemaVersionRepository(dbc *db.Client) *SchemaVersionRepository {
+	return &SchemaVersionRepository{
+		dbc: dbc,
+	}
+}
+
+func (r SchemaVersionRepository) Create(ctx context.Context, version bootstrap.SchemaVersion) (bootstrap.SchemaVersion, error) {
+	marshaledDefinition, err := json.Marshal(version.Definition)
+	if err != nil {
+		return bootstrap.SchemaVersion{}, fmt.Errorf("%w: %s", parseErr, err)
+	}
+
+	query, params, err := dialect.Insert(TABLE_SCHEMA_VERSIONS).Rows(
+		goqu.Record{
+			"definition": marshaledDefinition,
+			"source":     version.Source,
+		}).Returning(&SchemaVersion{}).ToSQL()
+	if err != nil {
+		return bootstrap.SchemaVersion{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var versionModel SchemaVersion
+	if err = r.dbc.WithTimeout(ctx, TABLE_SCHEMA_VERSIONS, "Create", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&versionModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		return bootstrap.SchemaVersion{}, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	return versionModel.transformToSchemaVersion()
+}
+
+func (r SchemaVersionRepository) Get(ctx context.Context, version int64) (bootstrap.SchemaVersion, error) {
+	query, params, err := dialect.From(TABLE_SCHEMA_VERSIONS).Where(
+		goqu.Ex{
+			"version": version,
+		},
+	).ToSQL()
+	if err != nil {
+		return bootstrap.SchemaVersion{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.getOne(ctx, "Get", query, params)
+}
+
+func (r SchemaVersionRepository) Latest(ctx context.Context) (bootstrap.SchemaVersion, error) {
+	query, params, err := dialect.From(TABLE_SCHEMA_VERSIONS).
+		Order(goqu.C("version").Desc()).Limit(1).ToSQL()
+	if err != nil {
+		return bootstrap.SchemaVersion{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.getOne(ctx, "Latest", query, params)
+}
+
+func (r SchemaVersionRepository) getOne(ctx context.Context, operation, query string, params []interface{}) (bootstrap.SchemaVersion, error) {
+	var versionModel SchemaVersion
+	if err := r.dbc.WithTimeout(ctx, TABLE_SCHEMA_VERSIONS, operation, func(ctx context.Context) error {
+		return r.dbc.GetContext(ctx, &versionModel, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return bootstrap.SchemaVersion{}, bootstrap.ErrSchemaVersionNotExist
+		}
+		return bootstrap.SchemaVersion{}, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	return versionModel.transformToSchemaVersion()
+}
+
+func (r SchemaVersionRepository) List(ctx context.Context) ([]bootstrap.SchemaVersion, error) {
+	query, params, err := dialect.From(TABLE_SCHEMA_VERSIONS).
+		Order(goqu.C("version").Asc()).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var versionModels []SchemaVersion
+	if err = r.dbc.WithTimeout(ctx, TABLE_SCHEMA_VERSIONS, "List", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &versionModels, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return []bootstrap.SchemaVersion{}, nil
+		}
+		return nil, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	var versions []bootstrap.SchemaVersion
+	for _, versionModel := range versionModels {
+		version, err := versionModel.transformToSchemaVersion()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", parseErr, err)
+		}
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
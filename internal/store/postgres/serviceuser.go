@@ -40,15 +40,21 @@ func (s ServiceUser) transform() (serviceuser.ServiceUser, error) {
 }
 
 type ServiceUserCredential struct {
-	ID            string         `db:"id"`
-	ServiceUserID string         `db:"serviceuser_id"`
-	SecretHash    sql.NullString `db:"secret_hash"`
-	PublicKey     []byte         `db:"public_key"`
-	Title         sql.NullString `db:"title"`
-	Metadata      []byte         `db:"metadata"`
-	CreatedAt     time.Time      `db:"created_at"`
-	UpdatedAt     time.Time      `db:"updated_at"`
-	DeletedAt     sql.NullTime   `db:"deleted_at"`
+	ID               string         `db:"id"`
+	ServiceUserID    string         `db:"serviceuser_id"`
+	SecretHash       sql.NullString `db:"secret_hash"`
+	PublicKey        []byte         `db:"public_key"`
+	JWKSURL          sql.NullString `db:"jwks_url"`
+	Title            sql.NullString `db:"title"`
+	Metadata         []byte         `db:"metadata"`
+	ExpiresAt        sql.NullTime   `db:"expires_at"`
+	Scope            []byte         `db:"scope"`
+	LastUsedAt       sql.NullTime   `db:"last_used_at"`
+	LastUsedIP       sql.NullString `db:"last_used_ip"`
+	ExpiryNotifiedAt sql.NullTime   `db:"expiry_notified_at"`
+	CreatedAt        time.Time      `db:"created_at"`
+	UpdatedAt        time.Time      `db:"updated_at"`
+	DeletedAt        sql.NullTime   `db:"deleted_at"`
 }
 
 func (s ServiceUserCredential) transform() (serviceuser.Credential, error) {
@@ -59,23 +65,84 @@ func (s ServiceUserCredential) transform() (serviceuser.Credential, error) {
 		}
 	}
 	var keySet jwk.Set
-	if len(s.SecretHash.String) == 0 {
-		// if a secret hash is created, public key would be null
+	if len(s.SecretHash.String) == 0 && len(s.JWKSURL.String) == 0 {
+		// if a secret hash is created or keys are fetched from a url, public key would be null
 		set, err := jwk.Parse(s.PublicKey)
 		if err != nil {
 			return serviceuser.Credential{}, fmt.Errorf("failed to parse public key: %w", err)
 		}
 		keySet = set
 	}
+	var scope serviceuser.Scope
+	if len(s.Scope) > 0 {
+		if err := json.Unmarshal(s.Scope, &scope); err != nil {
+			return serviceuser.Credential{}, err
+		}
+	}
 
 	return serviceuser.Credential{
+		ID:               s.ID,
+		ServiceUserID:    s.ServiceUserID,
+		SecretHash:       []byte(s.SecretHash.String),
+		PublicKey:        keySet,
+		JWKSURL:          s.JWKSURL.String,
+		Title:            s.Title.String,
+		Metadata:         unmarshalledMetadata,
+		ExpiresAt:        s.ExpiresAt.Time,
+		Scope:            scope,
+		LastUsedAt:       s.LastUsedAt.Time,
+		LastUsedIP:       s.LastUsedIP.String,
+		ExpiryNotifiedAt: s.ExpiryNotifiedAt.Time,
+		CreatedAt:        s.CreatedAt,
+		UpdatedAt:        s.UpdatedAt,
+	}, nil
+}
+
+type ServiceUserTrust struct {
+	ID            string         `db:"id"`
+	ServiceUserID string         `db:"serviceuser_id"`
+	Title         sql.NullString `db:"title"`
+	Issuer        string         `db:"issuer"`
+	JWKSURL       sql.NullString `db:"jwks_url"`
+	JWKS          []byte         `db:"jwks"`
+	Subject       sql.NullString `db:"subject"`
+	Audience      sql.NullString `db:"audience"`
+	Claims        []byte         `db:"claims"`
+	Scope         []byte         `db:"scope"`
+	ExpiresAt     sql.NullTime   `db:"expires_at"`
+	CreatedAt     time.Time      `db:"created_at"`
+	UpdatedAt     time.Time      `db:"updated_at"`
+}
+
+func (s ServiceUserTrust) transform() (serviceuser.Trust, error) {
+	trust := serviceuser.Trust{
 		ID:            s.ID,
 		ServiceUserID: s.ServiceUserID,
-		SecretHash:    []byte(s.SecretHash.String),
-		PublicKey:     keySet,
 		Title:         s.Title.String,
-		Metadata:      unmarshalledMetadata,
+		Issuer:        s.Issuer,
+		JWKSURL:       s.JWKSURL.String,
+		Subject:       s.Subject.String,
+		Audience:      s.Audience.String,
+		ExpiresAt:     s.ExpiresAt.Time,
 		CreatedAt:     s.CreatedAt,
 		UpdatedAt:     s.UpdatedAt,
-	}, nil
+	}
+	if len(s.JWKS) > 0 {
+		set, err := jwk.Parse(s.JWKS)
+		if err != nil {
+			return serviceuser.Trust{}, fmt.Errorf("failed to parse trust keys: %w", err)
+		}
+		trust.JWKS = set
+	}
+	if len(s.Claims) > 0 {
+		if err := json.Unmarshal(s.Claims, &trust.Claims); err != nil {
+			return serviceuser.Trust{}, err
+		}
+	}
+	if len(s.Scope) > 0 {
+		if err := json.Unmarshal(s.Scope, &trust.Scope); err != nil {
+			return serviceuser.Trust{}, err
+		}
+	}
+	return trust, nil
 }
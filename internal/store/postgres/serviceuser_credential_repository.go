@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	"github.com/google/uuid"
@@ -29,26 +30,44 @@ func (s ServiceUserCredentialRepository) List(ctx context.Context, flt serviceus
 	stmt := dialect.Select(
 		goqu.I("s.id"),
 		goqu.I("s.serviceuser_id"),
+		goqu.I("s.secret_hash"),
+		goqu.I("s.public_key"),
+		goqu.I("s.jwks_url"),
 		goqu.I("s.title"),
 		goqu.I("s.metadata"),
+		goqu.I("s.expires_at"),
+		goqu.I("s.scope"),
+		goqu.I("s.last_used_at"),
+		goqu.I("s.last_used_ip"),
+		goqu.I("s.expiry_notified_at"),
 		goqu.I("s.created_at"),
 		goqu.I("s.updated_at"),
 	)
-	if flt.OrgID != "" {
+	if flt.ServiceUserID != "" {
 		stmt = stmt.Where(goqu.Ex{
-			"org_id": flt.OrgID,
+			"s.serviceuser_id": flt.ServiceUserID,
 		})
 	}
 	if flt.IsKey {
+		// keys are stored with an empty secret hash
+		stmt = stmt.Where(goqu.Or(
+			goqu.Ex{"s.secret_hash": nil},
+			goqu.Ex{"s.secret_hash": ""},
+		))
+	}
+	if flt.IsSecret {
 		stmt = stmt.Where(goqu.Ex{
-			"public_key": goqu.Op{"is not": nil},
+			"s.secret_hash": goqu.Op{"neq": ""},
 		})
 	}
-	if flt.IsSecret {
+	if !flt.ExpiresBefore.IsZero() {
 		stmt = stmt.Where(goqu.Ex{
-			"secret_hash": goqu.Op{"is not": nil},
+			"s.expires_at": goqu.Op{"lt": flt.ExpiresBefore},
 		})
 	}
+	if !flt.UsedBefore.IsZero() {
+		stmt = stmt.Where(goqu.L("COALESCE(s.last_used_at, s.created_at)").Lt(flt.UsedBefore))
+	}
 
 	query, params, err := stmt.From(goqu.T(TABLE_SERVICEUSERCREDENTIALS).As("s")).ToSQL()
 	if err != nil {
@@ -84,9 +103,23 @@ func (s ServiceUserCredentialRepository) Create(ctx context.Context, credential
 		return serviceuser.Credential{}, fmt.Errorf("%w: %s", parseErr, err)
 	}
 
-	publicKeyJson, err := json.Marshal(credential.PublicKey)
-	if err != nil {
-		return serviceuser.Credential{}, fmt.Errorf("%w: %s", parseErr, err)
+	var publicKeyJson []byte
+	if credential.PublicKey != nil {
+		if publicKeyJson, err = json.Marshal(credential.PublicKey); err != nil {
+			return serviceuser.Credential{}, fmt.Errorf("%w: %s", parseErr, err)
+		}
+	}
+
+	var scopeJson []byte
+	if !credential.Scope.IsEmpty() {
+		if scopeJson, err = json.Marshal(credential.Scope); err != nil {
+			return serviceuser.Credential{}, fmt.Errorf("%w: %s", parseErr, err)
+		}
+	}
+
+	var expiresAt *time.Time
+	if !credential.ExpiresAt.IsZero() {
+		expiresAt = &credential.ExpiresAt
 	}
 
 	svUserCred := ServiceUserCredential{}
@@ -96,8 +129,11 @@ func (s ServiceUserCredentialRepository) Create(ctx context.Context, credential
 			"serviceuser_id": credential.ServiceUserID,
 			"secret_hash":    string(credential.SecretHash),
 			"public_key":     publicKeyJson,
+			"jwks_url":       credential.JWKSURL,
 			"title":          credential.Title,
 			"metadata":       marshaledMetadata,
+			"expires_at":     expiresAt,
+			"scope":          scopeJson,
 		}).OnConflict(
 		goqu.DoUpdate("id", goqu.Record{
 			"title":    credential.Title,
@@ -122,8 +158,14 @@ func (s ServiceUserCredentialRepository) Get(ctx context.Context, id string) (se
 		goqu.I("s.serviceuser_id"),
 		goqu.I("s.secret_hash"),
 		goqu.I("s.public_key"),
+		goqu.I("s.jwks_url"),
 		goqu.I("s.title"),
 		goqu.I("s.metadata"),
+		goqu.I("s.expires_at"),
+		goqu.I("s.scope"),
+		goqu.I("s.last_used_at"),
+		goqu.I("s.last_used_ip"),
+		goqu.I("s.expiry_notified_at"),
 		goqu.I("s.created_at"),
 		goqu.I("s.updated_at"),
 	).Where(
@@ -172,3 +214,62 @@ func (s ServiceUserCredentialRepository) Delete(ctx context.Context, id string)
 	}
 	return nil
 }
+
+func (s ServiceUserCredentialRepository) UpdateUsage(ctx context.Context, id string, usedAt time.Time, ip string) error {
+	query, params, err := dialect.Update(TABLE_SERVICEUSERCREDENTIALS).Set(
+		goqu.Record{
+			"last_used_at": usedAt,
+			"last_used_ip": ip,
+		}).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return s.dbc.WithTimeout(ctx, TABLE_SERVICEUSERCREDENTIALS, "UpdateUsage", func(ctx context.Context) error {
+		if _, err = s.dbc.DB.ExecContext(ctx, query, params...); err != nil {
+			return checkPostgresError(err)
+		}
+		return nil
+	})
+}
+
+func (s ServiceUserCredentialRepository) SetExpiryNotified(ctx context.Context, id string) error {
+	query, params, err := dialect.Update(TABLE_SERVICEUSERCREDENTIALS).Set(
+		goqu.Record{
+			"expiry_notified_at": goqu.L("now()"),
+		}).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return s.dbc.WithTimeout(ctx, TABLE_SERVICEUSERCREDENTIALS, "SetExpiryNotified", func(ctx context.Context) error {
+		if _, err = s.dbc.DB.ExecContext(ctx, query, params...); err != nil {
+			return checkPostgresError(err)
+		}
+		return nil
+	})
+}
+
+func (s ServiceUserCredentialRepository) UpdateSecretHash(ctx context.Context, id string, secretHash []byte) error {
+	query, params, err := dialect.Update(TABLE_SERVICEUSERCREDENTIALS).Set(
+		goqu.Record{
+			"secret_hash": string(secretHash),
+			"updated_at":  goqu.L("now()"),
+		}).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return s.dbc.WithTimeout(ctx, TABLE_SERVICEUSERCREDENTIALS, "UpdateSecretHash", func(ctx context.Context) error {
+		if _, err = s.dbc.DB.ExecContext(ctx, query, params...); err != nil {
+			return checkPostgresError(err)
+		}
+		return nil
+	})
+}
@@ -47,7 +47,11 @@ func (s ServiceUserRepository) List(ctx context.Context, flt serviceuser.Filter)
 		})
 	}
 
-	query, params, err := stmt.From(goqu.T(TABLE_SERVICEUSER).As("s")).ToSQL()
+	stmt, err := paginate(stmt.From(goqu.T(TABLE_SERVICEUSER).As("s")), flt.Pagination, serviceUserPaginationColumns)
+	if err != nil {
+		return nil, err
+	}
+	query, params, err := stmt.ToSQL()
 	if err != nil {
 		return nil, fmt.Errorf("%w: %s", queryErr, err)
 	}
@@ -58,6 +62,7 @@ func (s ServiceUserRepository) List(ctx context.Context, flt serviceuser.Filter)
 	}); err != nil {
 		return nil, fmt.Errorf("%w: %s", dbErr, err)
 	}
+	fetchedServiceUsers = setNextPage(flt.Pagination, fetchedServiceUsers, serviceUserPaginationColumns)
 
 	var transformedServiceUsers []serviceuser.ServiceUser
 	for _, o := range fetchedServiceUsers {
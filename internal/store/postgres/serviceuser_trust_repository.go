@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/raystack/frontier/core/serviceuser"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+type ServiceUserTrustRepository struct {
+	dbc *db.Client
+}
+
+func NewServiceUserTrustRepository(dbc *db.Client) *ServiceUserTrustRepository {
+	return &ServiceUserTrustRepository{
+		dbc: dbc,
+	}
+}
+
+func (s ServiceUserTrustRepository) List(ctx context.Context, flt serviceuser.TrustFilter) ([]serviceuser.Trust, error) {
+	stmt := dialect.From(TABLE_SERVICEUSER_TRUSTS)
+	if flt.ServiceUserID != "" {
+		stmt = stmt.Where(goqu.Ex{"serviceuser_id": flt.ServiceUserID})
+	}
+	if flt.Issuer != "" {
+		stmt = stmt.Where(goqu.Ex{"issuer": flt.Issuer})
+	}
+	query, params, err := stmt.Order(goqu.C("created_at").Asc()).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var trustModels []ServiceUserTrust
+	if err = s.dbc.WithTimeout(ctx, TABLE_SERVICEUSER_TRUSTS, "List", func(ctx context.Context) error {
+		return s.dbc.SelectContext(ctx, &trustModels, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, ErrInvalidTextRepresentation) {
+			return []serviceuser.Trust{}, nil
+		}
+		return nil, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	trusts := make([]serviceuser.Trust, 0, len(trustModels))
+	for _, trustModel := range trustModels {
+		trust, err := trustModel.transform()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", parseErr, err)
+		}
+		trusts = append(trusts, trust)
+	}
+	return trusts, nil
+}
+
+func (s ServiceUserTrustRepository) Create(ctx context.Context, trust serviceuser.Trust) (serviceuser.Trust, error) {
+	record := goqu.Record{
+		"serviceuser_id": trust.ServiceUserID,
+		"title":          trust.Title,
+		"issuer":         trust.Issuer,
+		"jwks_url":       trust.JWKSURL,
+		"subject":        trust.Subject,
+		"audience":       trust.Audience,
+	}
+	if trust.JWKS != nil && trust.JWKS.Len() > 0 {
+		jwksJson, err := json.Marshal(trust.JWKS)
+		if err != nil {
+			return serviceuser.Trust{}, fmt.Errorf("%w: %s", parseErr, err)
+		}
+		record["jwks"] = jwksJson
+	}
+	if len(trust.Claims) > 0 {
+		claimsJson, err := json.Marshal(trust.Claims)
+		if err != nil {
+			return serviceuser.Trust{}, fmt.Errorf("%w: %s", parseErr, err)
+		}
+		record["claims"] = claimsJson
+	}
+	if !trust.Scope.IsEmpty() {
+		scopeJson, err := json.Marshal(trust.Scope)
+		if err != nil {
+			return serviceuser.Trust{}, fmt.Errorf("%w: %s", parseErr, err)
+		}
+		record["scope"] = scopeJson
+	}
+	if !trust.ExpiresAt.IsZero() {
+		record["expires_at"] = trust.ExpiresAt
+	}
+	query, params, err := dialect.Insert(TABLE_SERVICEUSER_TRUSTS).Rows(record).
+		Returning(&ServiceUserTrust{}).ToSQL()
+	if err != nil {
+		return serviceuser.Trust{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var trustModel ServiceUserTrust
+	if err = s.dbc.WithTimeout(ctx, TABLE_SERVICEUSER_TRUSTS, "Create", func(ctx context.Context) error {
+		return s.dbc.QueryRowxContext(ctx, query, params...).StructScan(&trustModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, ErrForeignKeyViolation), errors.Is(err, ErrInvalidTextRepresentation):
+			return serviceuser.Trust{}, serviceuser.ErrNotExist
+		default:
+			return serviceuser.Trust{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+	return trustModel.transform()
+}
+
+func (s ServiceUserTrustRepository) Get(ctx context.Context, id string) (serviceuser.Trust, error) {
+	query, params, err := dialect.From(TABLE_SERVICEUSER_TRUSTS).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return serviceuser.Trust{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var trustModel ServiceUserTrust
+	if err = s.dbc.WithTimeout(ctx, TABLE_SERVICEUSER_TRUSTS, "Get", func(ctx context.Context) error {
+		return s.dbc.GetContext(ctx, &trustModel, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows), errors.Is(err, ErrInvalidTextRepresentation):
+			return serviceuser.Trust{}, serviceuser.ErrTrustNotExist
+		default:
+			return serviceuser.Trust{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	trust, err := trustModel.transform()
+	if err != nil {
+		return serviceuser.Trust{}, fmt.Errorf("%w: %s", parseErr, err)
+	}
+	return trust, nil
+}
+
+func (s ServiceUserTrustRepository) Delete(ctx context.Context, id string) error {
+	query, params, err := dialect.Delete(TABLE_SERVICEUSER_TRUSTS).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return s.dbc.WithTimeout(ctx, TABLE_SERVICEUSER_TRUSTS, "Delete", func(ctx context.Context) error {
+		result, err := s.dbc.ExecContext(ctx, query, params...)
+		if err != nil {
+			err = checkPostgresError(err)
+			if errors.Is(err, ErrInvalidTextRepresentation) {
+				return serviceuser.ErrTrustNotExist
+			}
+			return fmt.Errorf("%w: %s", dbErr, err)
+		}
+
+		if count, _ := result.RowsAffected(); count > 0 {
+			return nil
+		}
+
+		return serviceuser.ErrTrustNotExist
+	})
+}
@@ -92,6 +92,34 @@ func (s *SessionRepository) Get(ctx context.Context, id uuid.UUID) (*frontierses
 	return session.transformToSession()
 }
 
+func (s *SessionRepository) List(ctx context.Context, userID string) ([]*frontiersession.Session, error) {
+	query, params, err := dialect.From(TABLE_SESSIONS).Where(
+		goqu.Ex{
+			"user_id": userID,
+		}).Order(goqu.C("created_at").Asc()).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var sessionModels []Session
+	if err := s.dbc.WithTimeout(ctx, TABLE_SESSIONS, "List", func(ctx context.Context) error {
+		return s.dbc.SelectContext(ctx, &sessionModels, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		return nil, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	var sessions []*frontiersession.Session
+	for _, sessionModel := range sessionModels {
+		sess, err := sessionModel.transformToSession()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", parseErr, err)
+		}
+		sessions = append(sessions, sess)
+	}
+	return sessions, nil
+}
+
 func (s *SessionRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	query, params, err := dialect.Delete(TABLE_SESSIONS).
 		Where(
@@ -40,5 +40,6 @@ func (from User) transformToUser() (user.User, error) {
 		Metadata:  unmarshalledMetadata,
 		CreatedAt: from.CreatedAt,
 		UpdatedAt: from.UpdatedAt,
+		DeletedAt: from.DeletedAt.Time,
 	}, nil
 }
@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/raystack/frontier/core/identity"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+type UserEmailRepository struct {
+	dbc *db.Client
+}
+
+func NewUserEmailRepository(dbc *db.Client) *UserEmailRepository {
+	return &UserEmailRepository{
+		dbc: dbc,
+	}
+}
+
+func (r UserEmailRepository) Create(ctx context.Context, email identity.Email) (identity.Email, error) {
+	query, params, err := dialect.Insert(TABLE_USER_EMAILS).Rows(
+		goqu.Record{
+			"email":   email.Email,
+			"user_id": email.UserID,
+		}).Returning(&UserEmail{}).ToSQL()
+	if err != nil {
+		return identity.Email{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var emailModel UserEmail
+	if err = r.dbc.WithTimeout(ctx, TABLE_USER_EMAILS, "Create", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&emailModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, ErrDuplicateKey):
+			return identity.Email{}, identity.ErrEmailConflict
+		case errors.Is(err, ErrForeignKeyViolation):
+			return identity.Email{}, identity.ErrInvalidDetail
+		default:
+			return identity.Email{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	return emailModel.transformToEmail(), nil
+}
+
+func (r UserEmailRepository) Get(ctx context.Context, email string) (identity.Email, error) {
+	query, params, err := dialect.From(TABLE_USER_EMAILS).Where(goqu.Ex{
+		"email": email,
+	}).ToSQL()
+	if err != nil {
+		return identity.Email{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var emailModel UserEmail
+	if err = r.dbc.WithTimeout(ctx, TABLE_USER_EMAILS, "Get", func(ctx context.Context) error {
+		return r.dbc.GetContext(ctx, &emailModel, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return identity.Email{}, identity.ErrEmailNotExist
+		default:
+			return identity.Email{}, err
+		}
+	}
+
+	return emailModel.transformToEmail(), nil
+}
+
+func (r UserEmailRepository) List(ctx context.Context, userID string) ([]identity.Email, error) {
+	query, params, err := dialect.From(TABLE_USER_EMAILS).Where(goqu.Ex{
+		"user_id": userID,
+	}).Order(goqu.C("created_at").Asc()).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var emailModels []UserEmail
+	if err = r.dbc.WithTimeout(ctx, TABLE_USER_EMAILS, "List", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &emailModels, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, ErrInvalidTextRepresentation) {
+			return []identity.Email{}, nil
+		}
+		return nil, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	emails := make([]identity.Email, 0, len(emailModels))
+	for _, emailModel := range emailModels {
+		emails = append(emails, emailModel.transformToEmail())
+	}
+	return emails, nil
+}
+
+func (r UserEmailRepository) Delete(ctx context.Context, userID, email string) error {
+	query, params, err := dialect.Delete(TABLE_USER_EMAILS).Where(goqu.Ex{
+		"email":   email,
+		"user_id": userID,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_USER_EMAILS, "Delete", func(ctx context.Context) error {
+		result, err := r.dbc.ExecContext(ctx, query, params...)
+		if err != nil {
+			err = checkPostgresError(err)
+			if errors.Is(err, ErrInvalidTextRepresentation) {
+				return identity.ErrEmailNotExist
+			}
+			return fmt.Errorf("%w: %s", dbErr, err)
+		}
+
+		if count, _ := result.RowsAffected(); count > 0 {
+			return nil
+		}
+
+		return identity.ErrEmailNotExist
+	})
+}
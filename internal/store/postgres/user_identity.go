@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/raystack/frontier/core/identity"
+)
+
+type UserIdentity struct {
+	ID        string         `db:"id"`
+	UserID    string         `db:"user_id"`
+	Provider  string         `db:"provider"`
+	Subject   string         `db:"subject"`
+	Email     sql.NullString `db:"email"`
+	CreatedAt time.Time      `db:"created_at"`
+	UpdatedAt time.Time      `db:"updated_at"`
+}
+
+func (from UserIdentity) transformToIdentity() identity.Identity {
+	return identity.Identity{
+		ID:        from.ID,
+		UserID:    from.UserID,
+		Provider:  from.Provider,
+		Subject:   from.Subject,
+		Email:     from.Email.String,
+		CreatedAt: from.CreatedAt,
+		UpdatedAt: from.UpdatedAt,
+	}
+}
+
+type UserEmail struct {
+	Email     string    `db:"email"`
+	UserID    string    `db:"user_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func (from UserEmail) transformToEmail() identity.Email {
+	return identity.Email{
+		UserID:    from.UserID,
+		Email:     from.Email,
+		CreatedAt: from.CreatedAt,
+	}
+}
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/raystack/frontier/core/identity"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+type UserIdentityRepository struct {
+	dbc *db.Client
+}
+
+func NewUserIdentityRepository(dbc *db.Client) *UserIdentityRepository {
+	return &UserIdentityRepository{
+		dbc: dbc,
+	}
+}
+
+// Create stores a linked identity, the email of an identity linked again is updated
+func (r UserIdentityRepository) Create(ctx context.Context, ident identity.Identity) (identity.Identity, error) {
+	query, params, err := dialect.Insert(TABLE_USER_IDENTITIES).Rows(
+		goqu.Record{
+			"user_id":  ident.UserID,
+			"provider": ident.Provider,
+			"subject":  ident.Subject,
+			"email":    ident.Email,
+		}).OnConflict(goqu.DoUpdate("provider, subject", goqu.Record{
+		"email":      ident.Email,
+		"updated_at": goqu.L("now()"),
+	}).Where(goqu.I(TABLE_USER_IDENTITIES + ".user_id").Eq(ident.UserID))).
+		Returning(&UserIdentity{}).ToSQL()
+	if err != nil {
+		return identity.Identity{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var identityModel UserIdentity
+	if err = r.dbc.WithTimeout(ctx, TABLE_USER_IDENTITIES, "Create", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&identityModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			// conflicting identity belongs to another user
+			return identity.Identity{}, identity.ErrConflict
+		case errors.Is(err, ErrForeignKeyViolation):
+			return identity.Identity{}, identity.ErrInvalidDetail
+		default:
+			return identity.Identity{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	return identityModel.transformToIdentity(), nil
+}
+
+func (r UserIdentityRepository) GetBySubject(ctx context.Context, provider, subject string) (identity.Identity, error) {
+	query, params, err := dialect.From(TABLE_USER_IDENTITIES).Where(goqu.Ex{
+		"provider": provider,
+		"subject":  subject,
+	}).ToSQL()
+	if err != nil {
+		return identity.Identity{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var identityModel UserIdentity
+	if err = r.dbc.WithTimeout(ctx, TABLE_USER_IDENTITIES, "GetBySubject", func(ctx context.Context) error {
+		return r.dbc.GetContext(ctx, &identityModel, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return identity.Identity{}, identity.ErrNotExist
+		default:
+			return identity.Identity{}, err
+		}
+	}
+
+	return identityModel.transformToIdentity(), nil
+}
+
+func (r UserIdentityRepository) List(ctx context.Context, userID string) ([]identity.Identity, error) {
+	query, params, err := dialect.From(TABLE_USER_IDENTITIES).Where(goqu.Ex{
+		"user_id": userID,
+	}).Order(goqu.C("created_at").Asc()).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var identityModels []UserIdentity
+	if err = r.dbc.WithTimeout(ctx, TABLE_USER_IDENTITIES, "List", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &identityModels, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, ErrInvalidTextRepresentation) {
+			return []identity.Identity{}, nil
+		}
+		return nil, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	identities := make([]identity.Identity, 0, len(identityModels))
+	for _, identityModel := range identityModels {
+		identities = append(identities, identityModel.transformToIdentity())
+	}
+	return identities, nil
+}
+
+func (r UserIdentityRepository) Delete(ctx context.Context, userID, id string) error {
+	query, params, err := dialect.Delete(TABLE_USER_IDENTITIES).Where(goqu.Ex{
+		"id":      id,
+		"user_id": userID,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_USER_IDENTITIES, "Delete", func(ctx context.Context) error {
+		result, err := r.dbc.ExecContext(ctx, query, params...)
+		if err != nil {
+			err = checkPostgresError(err)
+			if errors.Is(err, ErrInvalidTextRepresentation) {
+				return identity.ErrNotExist
+			}
+			return fmt.Errorf("%w: %s", dbErr, err)
+		}
+
+		if count, _ := result.RowsAffected(); count > 0 {
+			return nil
+		}
+
+		return identity.ErrNotExist
+	})
+}
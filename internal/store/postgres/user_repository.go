@@ -13,8 +13,10 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
 	"github.com/jmoiron/sqlx"
 	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
 	"github.com/raystack/frontier/pkg/db"
 )
 
@@ -28,10 +30,12 @@ type joinUserMetadata struct {
 	Email     string         `db:"email"`
 	Title     sql.NullString `db:"title"`
 	Avatar    sql.NullString `db:"avatar"`
+	State     sql.NullString `db:"state"`
 	Key       any            `db:"key"`
 	Value     sql.NullString `db:"value"`
 	CreatedAt time.Time      `db:"created_at"`
 	UpdatedAt time.Time      `db:"updated_at"`
+	DeletedAt sql.NullTime   `db:"deleted_at"`
 }
 
 func NewUserRepository(dbc *db.Client) *UserRepository {
@@ -40,12 +44,21 @@ func NewUserRepository(dbc *db.Client) *UserRepository {
 	}
 }
 
-var notDisabledUserExp = goqu.Or(
+var activeUserExp = goqu.Or(
 	goqu.Ex{
 		"state": nil,
 	},
 	goqu.Ex{
-		"state": goqu.Op{"neq": user.Disabled},
+		"state": goqu.Op{"notIn": []user.State{user.Disabled, user.Deleted}},
+	},
+)
+
+var notDeletedUserExp = goqu.Or(
+	goqu.Ex{
+		"state": nil,
+	},
+	goqu.Ex{
+		"state": goqu.Op{"neq": user.Deleted},
 	},
 )
 
@@ -58,7 +71,7 @@ func (r UserRepository) GetByID(ctx context.Context, id string) (user.User, erro
 	userQuery, params, err := dialect.From(TABLE_USERS).
 		Where(goqu.Ex{
 			"id": id,
-		}).Where(notDisabledUserExp).ToSQL()
+		}).Where(activeUserExp).ToSQL()
 	if err != nil {
 		return user.User{}, fmt.Errorf("%w: %s", queryErr, err)
 	}
@@ -96,7 +109,7 @@ func (r UserRepository) GetByName(ctx context.Context, name string) (user.User,
 	query, params, err := dialect.From(TABLE_USERS).
 		Where(goqu.Ex{
 			"name": strings.ToLower(name),
-		}).ToSQL()
+		}, notDeletedUserExp).ToSQL()
 	if err != nil {
 		return user.User{}, fmt.Errorf("%w: %s", queryErr, err)
 	}
@@ -185,6 +198,7 @@ func (r UserRepository) Create(ctx context.Context, usr user.User) (user.User, e
 
 func (r UserRepository) List(ctx context.Context, flt user.Filter) ([]user.User, error) {
 	var fetchedJoinUserMetadata []joinUserMetadata
+	var err error
 
 	var defaultLimit int32 = 50
 	var defaultPage int32 = 1
@@ -197,7 +211,7 @@ func (r UserRepository) List(ctx context.Context, flt user.Filter) ([]user.User,
 	offset := (flt.Page - 1) * flt.Limit
 
 	sqlStmt := dialect.From(TABLE_USERS).
-		Select("users.id", "name", "email", "title", "avatar", "users.created_at", "users.updated_at")
+		Select("users.id", "name", "email", "title", "avatar", "state", "users.created_at", "users.updated_at", "users.deleted_at")
 
 	if len(flt.Keyword) != 0 {
 		sqlStmt = sqlStmt.Where(goqu.Or(
@@ -205,15 +219,32 @@ func (r UserRepository) List(ctx context.Context, flt user.Filter) ([]user.User,
 			goqu.C("email").ILike(fmt.Sprintf("%%%s%%", flt.Keyword)),
 		))
 	}
+	var stateExp exp.Expression = activeUserExp
 	if len(flt.State) != 0 {
-		sqlStmt = sqlStmt.Where(goqu.Ex{
+		stateExp = goqu.Ex{
 			"state": flt.State.String(),
-		})
-	} else {
-		sqlStmt = sqlStmt.Where(notDisabledUserExp)
+		}
+	}
+	if flt.IncludeDeleted {
+		stateExp = goqu.Or(stateExp, goqu.Ex{"state": user.Deleted.String()})
+	}
+	sqlStmt = sqlStmt.Where(stateExp)
+
+	if flt.OrgID != "" {
+		sqlStmt = sqlStmt.Where(goqu.Cast(goqu.I("users.id"), "TEXT").In(orgMembersQuery(flt.OrgID)))
+	} else if flt.GroupID != "" {
+		sqlStmt = sqlStmt.Where(goqu.Cast(goqu.I("users.id"), "TEXT").In(groupMembersQuery(flt.GroupID)))
 	}
 
-	query, params, err := sqlStmt.Limit(uint(flt.Limit)).Offset(uint(offset)).ToSQL()
+	if flt.Pagination != nil {
+		sqlStmt, err = paginate(sqlStmt, flt.Pagination, userPaginationColumns)
+		if err != nil {
+			return []user.User{}, err
+		}
+	} else {
+		sqlStmt = sqlStmt.Limit(uint(flt.Limit)).Offset(uint(offset))
+	}
+	query, params, err := sqlStmt.ToSQL()
 	if err != nil {
 		return []user.User{}, fmt.Errorf("%w: %s", queryErr, err)
 	}
@@ -226,11 +257,15 @@ func (r UserRepository) List(ctx context.Context, flt user.Filter) ([]user.User,
 		}
 		return []user.User{}, fmt.Errorf("%w: %s", dbErr, err)
 	}
+	fetchedJoinUserMetadata = setNextPage(flt.Pagination, fetchedJoinUserMetadata, userPaginationColumns)
 
+	// keep the order of users as returned from db
+	var orderedUserIDs []string
 	groupedMetadataByUser := make(map[string]user.User)
 	for _, u := range fetchedJoinUserMetadata {
 		if _, ok := groupedMetadataByUser[u.ID]; !ok {
 			groupedMetadataByUser[u.ID] = user.User{}
+			orderedUserIDs = append(orderedUserIDs, u.ID)
 		}
 		currentUser := groupedMetadataByUser[u.ID]
 		currentUser.ID = u.ID
@@ -238,8 +273,10 @@ func (r UserRepository) List(ctx context.Context, flt user.Filter) ([]user.User,
 		currentUser.Name = u.Name
 		currentUser.Title = u.Title.String
 		currentUser.Avatar = u.Avatar.String
+		currentUser.State = user.State(u.State.String)
 		currentUser.CreatedAt = u.CreatedAt
 		currentUser.UpdatedAt = u.UpdatedAt
+		currentUser.DeletedAt = u.DeletedAt.Time
 
 		if currentUser.Metadata == nil {
 			currentUser.Metadata = make(map[string]any)
@@ -259,8 +296,8 @@ func (r UserRepository) List(ctx context.Context, flt user.Filter) ([]user.User,
 	}
 
 	var transformedUsers []user.User
-	for _, user := range groupedMetadataByUser {
-		transformedUsers = append(transformedUsers, user)
+	for _, id := range orderedUserIDs {
+		transformedUsers = append(transformedUsers, groupedMetadataByUser[id])
 	}
 
 	return transformedUsers, nil
@@ -275,7 +312,7 @@ func (r UserRepository) GetByIDs(ctx context.Context, userIDs []string) ([]user.
 	query, params, err := dialect.From(TABLE_USERS).Select("id", "name", "email", "title", "avatar", "state").Where(
 		goqu.Ex{
 			"id": goqu.Op{"in": userIDs},
-		}).Where(notDisabledUserExp).ToSQL()
+		}).Where(activeUserExp).ToSQL()
 	if err != nil {
 		return []user.User{}, fmt.Errorf("%w: %s", queryErr, err)
 	}
@@ -473,6 +510,8 @@ func (r UserRepository) UpdateByName(ctx context.Context, usr user.User) (user.U
 	return transformedUser, nil
 }
 
+// GetByEmail returns enabled and soft deleted users, emails of deleted users
+// stay reserved until they are purged
 func (r UserRepository) GetByEmail(ctx context.Context, email string) (user.User, error) {
 	if strings.TrimSpace(email) == "" {
 		return user.User{}, user.ErrInvalidEmail
@@ -482,7 +521,14 @@ func (r UserRepository) GetByEmail(ctx context.Context, email string) (user.User
 	query, params, err := dialect.From(TABLE_USERS).Where(
 		goqu.Ex{
 			"email": strings.ToLower(email),
-		}).Where(notDisabledUserExp).ToSQL()
+		}).Where(goqu.Or(
+		goqu.Ex{
+			"state": nil,
+		},
+		goqu.Ex{
+			"state": goqu.Op{"neq": user.Disabled},
+		},
+	)).ToSQL()
 
 	if err != nil {
 		return user.User{}, fmt.Errorf("%w: %s", queryErr, err)
@@ -506,20 +552,95 @@ func (r UserRepository) GetByEmail(ctx context.Context, email string) (user.User
 }
 
 func (r UserRepository) SetState(ctx context.Context, id string, state user.State) error {
+	record := goqu.Record{
+		"state": state.String(),
+	}
+	if state == user.Deleted {
+		record["deleted_at"] = goqu.L("now()")
+	}
+	// deleted users can only be brought back with Restore
+	query, params, err := dialect.Update(TABLE_USERS).Set(record).Where(
+		goqu.Ex{
+			"id": id,
+		},
+		notDeletedUserExp,
+	).Returning(&User{}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var userModel User
+	if err = r.dbc.WithTimeout(ctx, TABLE_USERS, "SetState", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&userModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return user.ErrNotExist
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+func (r UserRepository) SetEmail(ctx context.Context, id, email string) (user.User, error) {
+	if id == "" || !utils.IsValidUUID(id) {
+		return user.User{}, user.ErrInvalidID
+	}
 	query, params, err := dialect.Update(TABLE_USERS).Set(
 		goqu.Record{
-			"state": state.String(),
+			"email":      strings.ToLower(email),
+			"updated_at": goqu.L("now()"),
 		}).Where(
 		goqu.Ex{
 			"id": id,
 		},
+		notDeletedUserExp,
+	).Returning(&User{}).ToSQL()
+	if err != nil {
+		return user.User{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	var userModel User
+	if err = r.dbc.WithTimeout(ctx, TABLE_USERS, "SetEmail", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&userModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return user.User{}, user.ErrNotExist
+		case errors.Is(err, ErrDuplicateKey):
+			return user.User{}, user.ErrConflict
+		default:
+			return user.User{}, err
+		}
+	}
+
+	transformedUser, err := userModel.transformToUser()
+	if err != nil {
+		return user.User{}, fmt.Errorf("%w: %s", parseErr, err)
+	}
+	return transformedUser, nil
+}
+
+func (r UserRepository) Restore(ctx context.Context, id string) error {
+	query, params, err := dialect.Update(TABLE_USERS).Set(
+		goqu.Record{
+			"state":      user.Enabled.String(),
+			"deleted_at": nil,
+		}).Where(
+		goqu.Ex{
+			"id":    id,
+			"state": user.Deleted.String(),
+		},
 	).Returning(&User{}).ToSQL()
 	if err != nil {
 		return fmt.Errorf("%w: %s", queryErr, err)
 	}
 
 	var userModel User
-	if err = r.dbc.WithTimeout(ctx, TABLE_USERS, "SetState", func(ctx context.Context) error {
+	if err = r.dbc.WithTimeout(ctx, TABLE_USERS, "Restore", func(ctx context.Context) error {
 		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&userModel)
 	}); err != nil {
 		err = checkPostgresError(err)
@@ -557,3 +678,36 @@ func (r UserRepository) Delete(ctx context.Context, id string) error {
 	}
 	return nil
 }
+
+// orgMembersQuery selects ids of users who are members or owners of the org
+// directly or through one of its groups
+func orgMembersQuery(orgID string) *goqu.SelectDataset {
+	direct := dialect.From(TABLE_RELATIONS).Select("subject_id").Where(goqu.Ex{
+		"object_namespace_name":  schema.OrganizationNamespace,
+		"object_id":              orgID,
+		"relation_name":          []string{schema.MemberRelationName, schema.OwnerRelationName},
+		"subject_namespace_name": schema.UserPrincipal,
+	})
+	viaGroups := dialect.From(goqu.T(TABLE_RELATIONS).As("gm")).
+		Join(goqu.T(TABLE_RELATIONS).As("om"), goqu.On(goqu.I("om.subject_id").Eq(goqu.I("gm.object_id")))).
+		Select("gm.subject_id").Where(goqu.Ex{
+		"om.object_namespace_name":  schema.OrganizationNamespace,
+		"om.object_id":              orgID,
+		"om.relation_name":          []string{schema.MemberRelationName, schema.OwnerRelationName},
+		"om.subject_namespace_name": schema.GroupPrincipal,
+		"gm.object_namespace_name":  schema.GroupNamespace,
+		"gm.relation_name":          []string{schema.MemberRelationName, schema.OwnerRelationName},
+		"gm.subject_namespace_name": schema.UserPrincipal,
+	})
+	return direct.Union(viaGroups)
+}
+
+// groupMembersQuery selects ids of users who are members or owners of the group
+func groupMembersQuery(groupID string) *goqu.SelectDataset {
+	return dialect.From(TABLE_RELATIONS).Select("subject_id").Where(goqu.Ex{
+		"object_namespace_name":  schema.GroupNamespace,
+		"object_id":              groupID,
+		"relation_name":          []string{schema.MemberRelationName, schema.OwnerRelationName},
+		"subject_namespace_name": schema.UserPrincipal,
+	})
+}
@@ -0,0 +1,192 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 1000
+
+	// DefaultOrderField is used when no order is requested, it is
+	// available in all tables
+	DefaultOrderField = "created_at"
+
+	// MetadataFieldPrefix is used to filter over keys of metadata e.g. metadata.team=infra
+	MetadataFieldPrefix = "metadata."
+)
+
+var (
+	ErrInvalidPageToken = errors.New("invalid page token")
+	ErrInvalidOrderBy   = errors.New("invalid order by, format should be <field> [asc|desc]")
+	ErrInvalidFilter    = errors.New("invalid filter, format should be <field><=|!=|~><value>")
+)
+
+type Operator string
+
+const (
+	OperatorEqual    Operator = "="
+	OperatorNotEqual Operator = "!="
+	// OperatorContains matches a case-insensitive substring
+	OperatorContains Operator = "~"
+)
+
+type Order struct {
+	Field string
+	Desc  bool
+}
+
+type Filter struct {
+	Field    string
+	Operator Operator
+	Value    string
+}
+
+// Pagination is a page of a list request, it is passed to repositories
+// which set the token of the next page after reading the current one
+type Pagination struct {
+	PageSize  int
+	PageToken string
+	OrderBy   Order
+	Filters   []Filter
+
+	nextPageToken string
+}
+
+// cursor is the opaque content of a page token, it points to the last item
+// of the previous page in the requested order
+type cursor struct {
+	Field string `json:"f"`
+	Desc  bool   `json:"d,omitempty"`
+	Value string `json:"v"`
+	ID    string `json:"id"`
+}
+
+// New builds a pagination from request params
+// orderBy is of format "<field> [asc|desc]"
+// filter is a comma separated list of "<field><operator><value>"
+func New(pageSize int, pageToken, orderBy, filter string) (*Pagination, error) {
+	order, err := parseOrder(orderBy)
+	if err != nil {
+		return nil, err
+	}
+	filters, err := parseFilters(filter)
+	if err != nil {
+		return nil, err
+	}
+	p := &Pagination{
+		PageSize:  pageSize,
+		PageToken: pageToken,
+		OrderBy:   order,
+		Filters:   filters,
+	}
+	if _, _, _, err := p.Cursor(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Limit is the page size capped between defaults
+func (p *Pagination) Limit() int {
+	if p.PageSize < 1 {
+		return DefaultPageSize
+	}
+	if p.PageSize > MaxPageSize {
+		return MaxPageSize
+	}
+	return p.PageSize
+}
+
+// Cursor returns the order field value and id of the last item of previous page,
+// ok is false for the first page
+func (p *Pagination) Cursor() (value string, id string, ok bool, err error) {
+	if p.PageToken == "" {
+		return "", "", false, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(p.PageToken)
+	if err != nil {
+		return "", "", false, ErrInvalidPageToken
+	}
+	var c cursor
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return "", "", false, ErrInvalidPageToken
+	}
+	// a token is only valid for the order it was issued with
+	if c.Field != p.OrderBy.Field || c.Desc != p.OrderBy.Desc || c.ID == "" {
+		return "", "", false, ErrInvalidPageToken
+	}
+	return c.Value, c.ID, true, nil
+}
+
+// SetNext builds the token of the next page from the last item of current page
+func (p *Pagination) SetNext(value, id string) {
+	raw, _ := json.Marshal(cursor{
+		Field: p.OrderBy.Field,
+		Desc:  p.OrderBy.Desc,
+		Value: value,
+		ID:    id,
+	})
+	p.nextPageToken = base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// NextPageToken is empty if current page is the last one
+func (p *Pagination) NextPageToken() string {
+	return p.nextPageToken
+}
+
+func parseOrder(orderBy string) (Order, error) {
+	parts := strings.Fields(orderBy)
+	switch len(parts) {
+	case 0:
+		return Order{Field: DefaultOrderField}, nil
+	case 1:
+		return Order{Field: parts[0]}, nil
+	case 2:
+		switch strings.ToLower(parts[1]) {
+		case "asc":
+			return Order{Field: parts[0]}, nil
+		case "desc":
+			return Order{Field: parts[0], Desc: true}, nil
+		}
+	}
+	return Order{}, ErrInvalidOrderBy
+}
+
+func parseFilters(filter string) ([]Filter, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, nil
+	}
+	var filters []Filter
+	for _, expr := range strings.Split(filter, ",") {
+		f, err := parseFilter(strings.TrimSpace(expr))
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+	return filters, nil
+}
+
+func parseFilter(expr string) (Filter, error) {
+	// the first operator splits field and value, value may contain operators
+	idx := strings.IndexAny(expr, "=!~")
+	if idx < 1 {
+		return Filter{}, fmt.Errorf("%w: %s", ErrInvalidFilter, expr)
+	}
+	op := Operator(expr[idx : idx+1])
+	if expr[idx] == '!' {
+		if !strings.HasPrefix(expr[idx:], string(OperatorNotEqual)) {
+			return Filter{}, fmt.Errorf("%w: %s", ErrInvalidFilter, expr)
+		}
+		op = OperatorNotEqual
+	}
+	return Filter{
+		Field:    strings.TrimSpace(expr[:idx]),
+		Operator: op,
+		Value:    strings.TrimSpace(expr[idx+len(op):]),
+	}, nil
+}
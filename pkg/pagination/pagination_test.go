@@ -0,0 +1,95 @@
+package pagination
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		orderBy string
+		filter  string
+		want    *Pagination
+		wantErr error
+	}{
+		{
+			name: "should order by created_at by default",
+			want: &Pagination{OrderBy: Order{Field: DefaultOrderField}},
+		},
+		{
+			name:    "should parse order direction",
+			orderBy: "title desc",
+			want:    &Pagination{OrderBy: Order{Field: "title", Desc: true}},
+		},
+		{
+			name:    "should fail for unknown order direction",
+			orderBy: "title up",
+			wantErr: ErrInvalidOrderBy,
+		},
+		{
+			name:   "should parse filter expressions",
+			filter: "state=enabled, title~acme,metadata.team!=infra=ops",
+			want: &Pagination{
+				OrderBy: Order{Field: DefaultOrderField},
+				Filters: []Filter{
+					{Field: "state", Operator: OperatorEqual, Value: "enabled"},
+					{Field: "title", Operator: OperatorContains, Value: "acme"},
+					{Field: "metadata.team", Operator: OperatorNotEqual, Value: "infra=ops"},
+				},
+			},
+		},
+		{
+			name:    "should fail for filter without operator",
+			filter:  "state",
+			wantErr: ErrInvalidFilter,
+		},
+		{
+			name:    "should fail for filter without field",
+			filter:  "=enabled",
+			wantErr: ErrInvalidFilter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(0, "", tt.orderBy, tt.filter)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPagination_Cursor(t *testing.T) {
+	t.Run("should return cursor set by previous page", func(t *testing.T) {
+		prev, err := New(10, "", "name desc", "")
+		assert.NoError(t, err)
+		prev.SetNext("acme", "9b1c5f3e")
+
+		next, err := New(10, prev.NextPageToken(), "name desc", "")
+		assert.NoError(t, err)
+		value, id, ok, err := next.Cursor()
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, "acme", value)
+		assert.Equal(t, "9b1c5f3e", id)
+	})
+	t.Run("should reject token issued for a different order", func(t *testing.T) {
+		prev, err := New(10, "", "name", "")
+		assert.NoError(t, err)
+		prev.SetNext("acme", "9b1c5f3e")
+
+		_, err = New(10, prev.NextPageToken(), "title", "")
+		assert.ErrorIs(t, err, ErrInvalidPageToken)
+	})
+	t.Run("should reject malformed token", func(t *testing.T) {
+		_, err := New(10, "not-a-token", "", "")
+		assert.ErrorIs(t, err, ErrInvalidPageToken)
+	})
+}
+
+func TestPagination_Limit(t *testing.T) {
+	assert.Equal(t, DefaultPageSize, (&Pagination{}).Limit())
+	assert.Equal(t, 20, (&Pagination{PageSize: 20}).Limit())
+	assert.Equal(t, MaxPageSize, (&Pagination{PageSize: MaxPageSize + 1}).Limit())
+}
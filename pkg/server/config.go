@@ -10,6 +10,12 @@ import (
 	"github.com/raystack/frontier/internal/bootstrap"
 
 	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/deleter"
+	"github.com/raystack/frontier/core/domain"
+	"github.com/raystack/frontier/core/email"
+	"github.com/raystack/frontier/core/invitation"
+	"github.com/raystack/frontier/core/pat"
+	"github.com/raystack/frontier/core/serviceuser"
 	"github.com/raystack/frontier/pkg/telemetry"
 )
 
@@ -62,4 +68,22 @@ type Config struct {
 	Admin bootstrap.AdminConfig `yaml:"admin" mapstructure:"admin"`
 
 	Mailer mailer.Config `yaml:"mailer" mapstructure:"mailer"`
+
+	// Email configures the outbound mail queue and the locale of mail templates
+	Email email.Config `yaml:"email" mapstructure:"email"`
+
+	// Deleter configures soft deletion of organizations, projects and users
+	Deleter deleter.Config `yaml:"deleter" mapstructure:"deleter"`
+
+	// ServiceUser configures expiry notices and cleanup of service user credentials
+	ServiceUser serviceuser.Config `yaml:"service_user" mapstructure:"service_user"`
+
+	// PersonalToken configures lifetime of personal access tokens of users
+	PersonalToken pat.Config `yaml:"personal_token" mapstructure:"personal_token"`
+
+	// Invitation configures resends, reminders and cleanup of organization invitations
+	Invitation invitation.Config `yaml:"invitation" mapstructure:"invitation"`
+
+	// Domain configures verification and reverification of organization domains
+	Domain domain.Config `yaml:"domain" mapstructure:"domain"`
 }
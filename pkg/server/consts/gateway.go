@@ -35,4 +35,8 @@ const (
 
 	// SessionRequestKey is the key to store session value in browser
 	SessionRequestKey = "sid"
+
+	// LocaleRequestKey picks the locale mails sent during the request are rendered in,
+	// the first language of the Accept-Language header is used
+	LocaleRequestKey = "accept-language"
 )
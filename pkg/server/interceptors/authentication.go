@@ -57,6 +57,7 @@ var authenticationSkipList = map[string]bool{
 	"/raystack.frontier.v1beta1.FrontierService/Authenticate":       true,
 	"/raystack.frontier.v1beta1.FrontierService/AuthCallback":       true,
 	"/raystack.frontier.v1beta1.FrontierService/AuthToken":          true,
+	"/raystack.frontier.v1beta1.FrontierService/AuthDeviceCode":     true,
 	"/raystack.frontier.v1beta1.FrontierService/AuthLogout":         true,
 	"/raystack.frontier.v1beta1.FrontierService/ListMetaSchemas":    true,
 	"/raystack.frontier.v1beta1.FrontierService/GetMetaSchema":      true,
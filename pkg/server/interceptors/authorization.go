@@ -62,6 +62,8 @@ var authorizationSkipList = map[string]bool{
 	"/raystack.frontier.v1beta1.FrontierService/Authenticate":            true,
 	"/raystack.frontier.v1beta1.FrontierService/AuthCallback":            true,
 	"/raystack.frontier.v1beta1.FrontierService/AuthToken":               true,
+	"/raystack.frontier.v1beta1.FrontierService/AuthDeviceCode":          true,
+	"/raystack.frontier.v1beta1.FrontierService/ApproveAuthDevice":       true,
 	"/raystack.frontier.v1beta1.FrontierService/AuthLogout":              true,
 	"/raystack.frontier.v1beta1.FrontierService/CheckResourcePermission": true,
 	"/raystack.frontier.v1beta1.FrontierService/BatchCheckPermission":    true,
@@ -79,6 +81,18 @@ var authorizationSkipList = map[string]bool{
 	"/raystack.frontier.v1beta1.FrontierService/ListCurrentUserGroups":          true,
 	"/raystack.frontier.v1beta1.FrontierService/GetCurrentUser":                 true,
 	"/raystack.frontier.v1beta1.FrontierService/UpdateCurrentUser":              true,
+	"/raystack.frontier.v1beta1.FrontierService/ChangeCurrentUserEmail":         true,
+	"/raystack.frontier.v1beta1.FrontierService/VerifyCurrentUserEmail":         true,
+	"/raystack.frontier.v1beta1.FrontierService/AddCurrentUserEmail":            true,
+	"/raystack.frontier.v1beta1.FrontierService/ListCurrentUserEmails":          true,
+	"/raystack.frontier.v1beta1.FrontierService/RemoveCurrentUserEmail":         true,
+	"/raystack.frontier.v1beta1.FrontierService/ListCurrentUserIdentities":      true,
+	"/raystack.frontier.v1beta1.FrontierService/UnlinkCurrentUserIdentity":      true,
+	"/raystack.frontier.v1beta1.FrontierService/DeleteCurrentUser":              true,
+	"/raystack.frontier.v1beta1.FrontierService/ExportCurrentUserData":          true,
+	"/raystack.frontier.v1beta1.FrontierService/CreateCurrentUserPersonalToken": true,
+	"/raystack.frontier.v1beta1.FrontierService/ListCurrentUserPersonalTokens":  true,
+	"/raystack.frontier.v1beta1.FrontierService/RevokeCurrentUserPersonalToken": true,
 	"/raystack.frontier.v1beta1.FrontierService/ListOrganizationsByCurrentUser": true,
 	"/raystack.frontier.v1beta1.FrontierService/ListProjectsByCurrentUser":      true,
 	"/raystack.frontier.v1beta1.FrontierService/CreateCurrentUserPreferences":   true,
@@ -86,6 +100,16 @@ var authorizationSkipList = map[string]bool{
 	"/raystack.frontier.v1beta1.FrontierService/ListCurrentUserInvitations":     true,
 
 	"/raystack.frontier.v1beta1.FrontierService/JoinOrganization": true,
+	// anyone holding the token of an invite link can join with it
+	"/raystack.frontier.v1beta1.FrontierService/JoinWithInviteLink": true,
+
+	// transfers are only accepted or declined by the user they were requested for
+	"/raystack.frontier.v1beta1.FrontierService/AcceptOrganizationTransfer":  true,
+	"/raystack.frontier.v1beta1.FrontierService/DeclineOrganizationTransfer": true,
+
+	// jobs are only returned to their creator or a superuser by the handler
+	"/raystack.frontier.v1beta1.FrontierService/GetJob":    true,
+	"/raystack.frontier.v1beta1.FrontierService/CancelJob": true,
 
 	"/raystack.frontier.v1beta1.FrontierService/GetServiceUserKey": true,
 
@@ -195,6 +219,18 @@ var authorizationValidationMap = map[string]func(ctx context.Context, handler *v
 		pbreq := req.(*frontierv1beta1.DeleteServiceUserKeyRequest)
 		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.ServiceUserPrincipal, ID: pbreq.GetId()}, schema.ManagePermission)
 	},
+	"/raystack.frontier.v1beta1.FrontierService/ListServiceUserTrusts": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.ListServiceUserTrustsRequest)
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.ServiceUserPrincipal, ID: pbreq.GetId()}, schema.ManagePermission)
+	},
+	"/raystack.frontier.v1beta1.FrontierService/CreateServiceUserTrust": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.CreateServiceUserTrustRequest)
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.ServiceUserPrincipal, ID: pbreq.GetId()}, schema.ManagePermission)
+	},
+	"/raystack.frontier.v1beta1.FrontierService/DeleteServiceUserTrust": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.DeleteServiceUserTrustRequest)
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.ServiceUserPrincipal, ID: pbreq.GetId()}, schema.ManagePermission)
+	},
 	"/raystack.frontier.v1beta1.FrontierService/CreateServiceUserSecret": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
 		pbreq := req.(*frontierv1beta1.CreateServiceUserSecretRequest)
 		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.ServiceUserPrincipal, ID: pbreq.GetId()}, schema.ManagePermission)
@@ -244,6 +280,18 @@ var authorizationValidationMap = map[string]func(ctx context.Context, handler *v
 		pbreq := req.(*frontierv1beta1.AddOrganizationUsersRequest)
 		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.GetId()}, schema.UpdatePermission)
 	},
+	"/raystack.frontier.v1beta1.FrontierService/TransferOrganizationOwnership": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.TransferOrganizationOwnershipRequest)
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.GetId()}, schema.UpdatePermission)
+	},
+	"/raystack.frontier.v1beta1.FrontierService/ListOrganizationPersonalTokens": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.ListOrganizationPersonalTokensRequest)
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.GetId()}, schema.UpdatePermission)
+	},
+	"/raystack.frontier.v1beta1.FrontierService/RevokeOrganizationPersonalToken": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.RevokeOrganizationPersonalTokenRequest)
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.GetId()}, schema.UpdatePermission)
+	},
 	"/raystack.frontier.v1beta1.FrontierService/RemoveOrganizationUser": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
 		pbreq := req.(*frontierv1beta1.RemoveOrganizationUserRequest)
 		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.GetId()}, schema.UpdatePermission)
@@ -264,10 +312,26 @@ var authorizationValidationMap = map[string]func(ctx context.Context, handler *v
 		pbreq := req.(*frontierv1beta1.AcceptOrganizationInvitationRequest)
 		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.InvitationNamespace, ID: pbreq.GetId()}, schema.AcceptPermission)
 	},
+	"/raystack.frontier.v1beta1.FrontierService/DeclineOrganizationInvitation": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.DeclineOrganizationInvitationRequest)
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.InvitationNamespace, ID: pbreq.GetId()}, schema.AcceptPermission)
+	},
 	"/raystack.frontier.v1beta1.FrontierService/DeleteOrganizationInvitation": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
 		pbreq := req.(*frontierv1beta1.DeleteOrganizationInvitationRequest)
 		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.InvitationNamespace, ID: pbreq.GetId()}, schema.DeletePermission)
 	},
+	"/raystack.frontier.v1beta1.FrontierService/CreateOrganizationInviteLink": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.CreateOrganizationInviteLinkRequest)
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.GetOrgId()}, schema.InvitationCreatePermission)
+	},
+	"/raystack.frontier.v1beta1.FrontierService/ListOrganizationInviteLinks": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.ListOrganizationInviteLinksRequest)
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.GetOrgId()}, schema.InvitationListPermission)
+	},
+	"/raystack.frontier.v1beta1.FrontierService/DeleteOrganizationInviteLink": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.DeleteOrganizationInviteLinkRequest)
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.GetOrgId()}, schema.InvitationCreatePermission)
+	},
 	"/raystack.frontier.v1beta1.FrontierService/CreateOrganizationDomain": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
 		pbreq := req.(*frontierv1beta1.CreateOrganizationDomainRequest)
 		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.GetOrgId()}, schema.UpdatePermission)
@@ -291,6 +355,10 @@ var authorizationValidationMap = map[string]func(ctx context.Context, handler *v
 		pbreq := req.(*frontierv1beta1.VerifyOrganizationDomainRequest)
 		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.GetOrgId()}, schema.UpdatePermission)
 	},
+	"/raystack.frontier.v1beta1.FrontierService/UpdateOrganizationDomainAutoJoin": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.UpdateOrganizationDomainAutoJoinRequest)
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.GetOrgId()}, schema.UpdatePermission)
+	},
 	"/raystack.frontier.v1beta1.FrontierService/EnableOrganization": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
 		prefs, err := handler.ListPlatformPreferences(ctx)
 		if err != nil {
@@ -356,7 +424,13 @@ var authorizationValidationMap = map[string]func(ctx context.Context, handler *v
 	// project
 	"/raystack.frontier.v1beta1.FrontierService/CreateProject": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
 		pbreq := req.(*frontierv1beta1.CreateProjectRequest)
-		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.GetBody().GetOrgId()}, schema.ProjectCreatePermission)
+		if err := handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.GetBody().GetOrgId()}, schema.ProjectCreatePermission); err != nil {
+			return err
+		}
+		if pbreq.GetBody().GetFolderId() == "" {
+			return nil
+		}
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.FolderNamespace, ID: pbreq.GetBody().GetFolderId()}, schema.UpdatePermission)
 	},
 	"/raystack.frontier.v1beta1.FrontierService/GetProject": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
 		pbreq := req.(*frontierv1beta1.GetProjectRequest)
@@ -394,6 +468,56 @@ var authorizationValidationMap = map[string]func(ctx context.Context, handler *v
 		pbreq := req.(*frontierv1beta1.DeleteProjectRequest)
 		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.ProjectNamespace, ID: pbreq.GetId()}, schema.DeletePermission)
 	},
+	"/raystack.frontier.v1beta1.FrontierService/MoveProject": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.MoveProjectRequest)
+		if err := handler.IsAuthorized(ctx, relation.Object{Namespace: schema.ProjectNamespace, ID: pbreq.GetId()}, schema.UpdatePermission); err != nil {
+			return err
+		}
+		if pbreq.GetFolderId() == "" {
+			return nil
+		}
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.FolderNamespace, ID: pbreq.GetFolderId()}, schema.UpdatePermission)
+	},
+
+	// folders
+	"/raystack.frontier.v1beta1.FrontierService/ListOrganizationFolders": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.ListOrganizationFoldersRequest)
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.GetOrgId()}, schema.GetPermission)
+	},
+	"/raystack.frontier.v1beta1.FrontierService/CreateFolder": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.CreateFolderRequest)
+		if pbreq.GetBody().GetParentId() != "" {
+			return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.FolderNamespace, ID: pbreq.GetBody().GetParentId()}, schema.UpdatePermission)
+		}
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.GetOrgId()}, schema.UpdatePermission)
+	},
+	"/raystack.frontier.v1beta1.FrontierService/GetFolder": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.GetFolderRequest)
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.FolderNamespace, ID: pbreq.GetId()}, schema.GetPermission)
+	},
+	"/raystack.frontier.v1beta1.FrontierService/UpdateFolder": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.UpdateFolderRequest)
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.FolderNamespace, ID: pbreq.GetId()}, schema.UpdatePermission)
+	},
+	"/raystack.frontier.v1beta1.FrontierService/DeleteFolder": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.DeleteFolderRequest)
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.FolderNamespace, ID: pbreq.GetId()}, schema.DeletePermission)
+	},
+	"/raystack.frontier.v1beta1.FrontierService/ListFolderProjects": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.ListFolderProjectsRequest)
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.FolderNamespace, ID: pbreq.GetId()}, schema.GetPermission)
+	},
+	"/raystack.frontier.v1beta1.FrontierService/MoveFolder": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.MoveFolderRequest)
+		if err := handler.IsAuthorized(ctx, relation.Object{Namespace: schema.FolderNamespace, ID: pbreq.GetId()}, schema.UpdatePermission); err != nil {
+			return err
+		}
+		// moving to the top level needs the same access as creating a folder there
+		if pbreq.GetParentId() == "" {
+			return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.GetOrgId()}, schema.UpdatePermission)
+		}
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.FolderNamespace, ID: pbreq.GetParentId()}, schema.UpdatePermission)
+	},
 
 	// roles
 	"/raystack.frontier.v1beta1.FrontierService/ListRoles": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
@@ -526,6 +650,22 @@ var authorizationValidationMap = map[string]func(ctx context.Context, handler *v
 		pbreq := req.(*frontierv1beta1.ListOrganizationPreferencesRequest)
 		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.GetId()}, schema.GetPermission)
 	},
+	"/raystack.frontier.v1beta1.FrontierService/ListOrganizationMailTemplates": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.ListOrganizationMailTemplatesRequest)
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.GetId()}, schema.GetPermission)
+	},
+	"/raystack.frontier.v1beta1.FrontierService/SetOrganizationMailTemplate": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.SetOrganizationMailTemplateRequest)
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.GetId()}, schema.UpdatePermission)
+	},
+	"/raystack.frontier.v1beta1.FrontierService/DeleteOrganizationMailTemplate": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.DeleteOrganizationMailTemplateRequest)
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.GetId()}, schema.UpdatePermission)
+	},
+	"/raystack.frontier.v1beta1.FrontierService/PreviewOrganizationMailTemplate": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		pbreq := req.(*frontierv1beta1.PreviewOrganizationMailTemplateRequest)
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.GetId()}, schema.GetPermission)
+	},
 	"/raystack.frontier.v1beta1.FrontierService/CreateProjectPreferences": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
 		pbreq := req.(*frontierv1beta1.CreateProjectPreferencesRequest)
 		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.ProjectNamespace, ID: pbreq.GetId()}, schema.UpdatePermission)
@@ -567,6 +707,28 @@ var authorizationValidationMap = map[string]func(ctx context.Context, handler *v
 	"/raystack.frontier.v1beta1.AdminService/ListAllOrganizations": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
 		return handler.IsSuperUser(ctx)
 	},
+	"/raystack.frontier.v1beta1.AdminService/ExportOrganization": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		return handler.IsSuperUser(ctx)
+	},
+	"/raystack.frontier.v1beta1.AdminService/ImportOrganization": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		return handler.IsSuperUser(ctx)
+	},
+	// relations of soft deleted entities are suspended, only superusers can restore them
+	"/raystack.frontier.v1beta1.AdminService/RestoreOrganization": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		return handler.IsSuperUser(ctx)
+	},
+	"/raystack.frontier.v1beta1.AdminService/RestoreProject": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		return handler.IsSuperUser(ctx)
+	},
+	"/raystack.frontier.v1beta1.AdminService/RestoreUser": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		return handler.IsSuperUser(ctx)
+	},
+	"/raystack.frontier.v1beta1.AdminService/MergeUser": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		return handler.IsSuperUser(ctx)
+	},
+	"/raystack.frontier.v1beta1.AdminService/ListJobs": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		return handler.IsSuperUser(ctx)
+	},
 	"/raystack.frontier.v1beta1.AdminService/ListProjects": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
 		return handler.IsSuperUser(ctx)
 	},
@@ -603,4 +765,19 @@ var authorizationValidationMap = map[string]func(ctx context.Context, handler *v
 	"/raystack.frontier.v1beta1.AdminService/ListPreferences": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
 		return handler.IsSuperUser(ctx)
 	},
+	"/raystack.frontier.v1beta1.AdminService/ListMailTemplates": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		return handler.IsSuperUser(ctx)
+	},
+	"/raystack.frontier.v1beta1.AdminService/SetMailTemplate": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		return handler.IsSuperUser(ctx)
+	},
+	"/raystack.frontier.v1beta1.AdminService/DeleteMailTemplate": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		return handler.IsSuperUser(ctx)
+	},
+	"/raystack.frontier.v1beta1.AdminService/PreviewMailTemplate": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		return handler.IsSuperUser(ctx)
+	},
+	"/raystack.frontier.v1beta1.AdminService/ListMails": func(ctx context.Context, handler *v1beta1.Handler, req any) error {
+		return handler.IsSuperUser(ctx)
+	},
 }
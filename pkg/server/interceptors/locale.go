@@ -0,0 +1,27 @@
+package interceptors
+
+import (
+	"context"
+
+	"github.com/raystack/frontier/core/email"
+	"github.com/raystack/frontier/pkg/server/consts"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// UnaryCtxWithLocale sets the locale of the Accept-Language header in context,
+// mails sent during the request are rendered in it
+func UnaryCtxWithLocale() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+		if values := md.Get(consts.LocaleRequestKey); len(values) > 0 {
+			if locale := email.ParseAcceptLanguage(values[0]); locale != "" {
+				ctx = email.WithLocale(ctx, locale)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
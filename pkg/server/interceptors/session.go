@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/pat"
 
 	"github.com/lestrrat-go/jwx/v2/jwt"
 
@@ -159,7 +160,10 @@ func (h Session) UnaryGRPCRequestHeadersAnnotator() grpc.UnaryServerInterceptor
 			// check if the same token is part of Authorization header
 			if authHeader := incomingMD.Get("authorization"); len(authHeader) > 0 {
 				tokenVal := strings.TrimSpace(strings.TrimPrefix(authHeader[0], "Bearer "))
-				if token, err := jwt.ParseInsecure([]byte(tokenVal)); err == nil {
+				if strings.HasPrefix(tokenVal, pat.TokenPrefix) {
+					// personal access tokens aren't jwts
+					incomingMD.Set(consts.UserTokenGatewayKey, tokenVal)
+				} else if token, err := jwt.ParseInsecure([]byte(tokenVal)); err == nil {
 					if token.JwtID() != "" && token.Expiration().After(time.Now().UTC()) {
 						incomingMD.Set(consts.UserTokenGatewayKey, tokenVal)
 					}
@@ -102,6 +102,7 @@ func Serve(
 					"cookie":                                 true,
 					"authorization":                          true,
 					consts.ProjectRequestKey:                 true,
+					consts.LocaleRequestKey:                  true,
 				},
 			),
 		),
@@ -211,6 +212,7 @@ func getGRPCMiddleware(logger log.Logger, identityProxyHeader string, nrApp newr
 			nrgrpc.UnaryServerInterceptor(nrApp),
 			interceptors.EnrichCtxWithPassthroughEmail(identityProxyHeader),
 			grpc_ctxtags.UnaryServerInterceptor(),
+			interceptors.UnaryCtxWithLocale(),
 			grpc_validator.UnaryServerInterceptor(),
 			sessionMiddleware.UnaryGRPCRequestHeadersAnnotator(),
 			interceptors.UnaryAuthenticationCheck(),
@@ -35,6 +35,9 @@ var (
 	_ = sort.Sort
 )
 
+// define the regex for a UUID once up-front
+var _admin_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
 // Validate checks the field values on ListAllUsersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -95,6 +98,14 @@ func (m *ListAllUsersRequest) validate(all bool) error {
 
 	// no validation rules for State
 
+	// no validation rules for PageToken
+
+	// no validation rules for OrderBy
+
+	// no validation rules for Filter
+
+	// no validation rules for IncludeDeleted
+
 	if len(errors) > 0 {
 		return ListAllUsersRequestMultiError(errors)
 	}
@@ -233,6 +244,8 @@ func (m *ListAllUsersResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return ListAllUsersResponseMultiError(errors)
 	}
@@ -339,6 +352,23 @@ func (m *ListGroupsRequest) validate(all bool) error {
 
 	// no validation rules for State
 
+	if m.GetPageSize() < 0 {
+		err := ListGroupsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for OrderBy
+
+	// no validation rules for Filter
+
 	if len(errors) > 0 {
 		return ListGroupsRequestMultiError(errors)
 	}
@@ -475,6 +505,8 @@ func (m *ListGroupsResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return ListGroupsResponseMultiError(errors)
 	}
@@ -581,6 +613,25 @@ func (m *ListAllOrganizationsRequest) validate(all bool) error {
 
 	// no validation rules for State
 
+	if m.GetPageSize() < 0 {
+		err := ListAllOrganizationsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for OrderBy
+
+	// no validation rules for Filter
+
+	// no validation rules for IncludeDeleted
+
 	if len(errors) > 0 {
 		return ListAllOrganizationsRequestMultiError(errors)
 	}
@@ -718,6 +769,8 @@ func (m *ListAllOrganizationsResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return ListAllOrganizationsResponseMultiError(errors)
 	}
@@ -799,46 +852,62 @@ var _ interface {
 	ErrorName() string
 } = ListAllOrganizationsResponseValidationError{}
 
-// Validate checks the field values on ListProjectsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RestoreOrganizationRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectsRequest) Validate() error {
+func (m *RestoreOrganizationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RestoreOrganizationRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListProjectsRequestMultiError, or nil if none found.
-func (m *ListProjectsRequest) ValidateAll() error {
+// RestoreOrganizationRequestMultiError, or nil if none found.
+func (m *RestoreOrganizationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectsRequest) validate(all bool) error {
+func (m *RestoreOrganizationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OrgId
-
-	// no validation rules for State
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = RestoreOrganizationRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return ListProjectsRequestMultiError(errors)
+		return RestoreOrganizationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListProjectsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListProjectsRequestMultiError []error
+func (m *RestoreOrganizationRequest) _validateUuid(uuid string) error {
+	if matched := _admin_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RestoreOrganizationRequestMultiError is an error wrapping multiple
+// validation errors returned by RestoreOrganizationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RestoreOrganizationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectsRequestMultiError) Error() string {
+func (m RestoreOrganizationRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -847,11 +916,11 @@ func (m ListProjectsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectsRequestMultiError) AllErrors() []error { return m }
+func (m RestoreOrganizationRequestMultiError) AllErrors() []error { return m }
 
-// ListProjectsRequestValidationError is the validation error returned by
-// ListProjectsRequest.Validate if the designated constraints aren't met.
-type ListProjectsRequestValidationError struct {
+// RestoreOrganizationRequestValidationError is the validation error returned
+// by RestoreOrganizationRequest.Validate if the designated constraints aren't met.
+type RestoreOrganizationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -859,24 +928,24 @@ type ListProjectsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectsRequestValidationError) Field() string { return e.field }
+func (e RestoreOrganizationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectsRequestValidationError) Reason() string { return e.reason }
+func (e RestoreOrganizationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectsRequestValidationError) Cause() error { return e.cause }
+func (e RestoreOrganizationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectsRequestValidationError) Key() bool { return e.key }
+func (e RestoreOrganizationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectsRequestValidationError) ErrorName() string {
-	return "ListProjectsRequestValidationError"
+func (e RestoreOrganizationRequestValidationError) ErrorName() string {
+	return "RestoreOrganizationRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectsRequestValidationError) Error() string {
+func (e RestoreOrganizationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -888,14 +957,14 @@ func (e ListProjectsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectsRequest.%s: %s%s",
+		"invalid %sRestoreOrganizationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectsRequestValidationError{}
+var _ error = RestoreOrganizationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -903,78 +972,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectsRequestValidationError{}
+} = RestoreOrganizationRequestValidationError{}
 
-// Validate checks the field values on ListProjectsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RestoreOrganizationResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectsResponse) Validate() error {
+func (m *RestoreOrganizationResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RestoreOrganizationResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListProjectsResponseMultiError, or nil if none found.
-func (m *ListProjectsResponse) ValidateAll() error {
+// RestoreOrganizationResponseMultiError, or nil if none found.
+func (m *RestoreOrganizationResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectsResponse) validate(all bool) error {
+func (m *RestoreOrganizationResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetProjects() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListProjectsResponseValidationError{
-						field:  fmt.Sprintf("Projects[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListProjectsResponseValidationError{
-						field:  fmt.Sprintf("Projects[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListProjectsResponseValidationError{
-					field:  fmt.Sprintf("Projects[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return ListProjectsResponseMultiError(errors)
+		return RestoreOrganizationResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListProjectsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListProjectsResponseMultiError []error
+// RestoreOrganizationResponseMultiError is an error wrapping multiple
+// validation errors returned by RestoreOrganizationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RestoreOrganizationResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectsResponseMultiError) Error() string {
+func (m RestoreOrganizationResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -983,11 +1018,12 @@ func (m ListProjectsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectsResponseMultiError) AllErrors() []error { return m }
+func (m RestoreOrganizationResponseMultiError) AllErrors() []error { return m }
 
-// ListProjectsResponseValidationError is the validation error returned by
-// ListProjectsResponse.Validate if the designated constraints aren't met.
-type ListProjectsResponseValidationError struct {
+// RestoreOrganizationResponseValidationError is the validation error returned
+// by RestoreOrganizationResponse.Validate if the designated constraints
+// aren't met.
+type RestoreOrganizationResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -995,24 +1031,24 @@ type ListProjectsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectsResponseValidationError) Field() string { return e.field }
+func (e RestoreOrganizationResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectsResponseValidationError) Reason() string { return e.reason }
+func (e RestoreOrganizationResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectsResponseValidationError) Cause() error { return e.cause }
+func (e RestoreOrganizationResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectsResponseValidationError) Key() bool { return e.key }
+func (e RestoreOrganizationResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectsResponseValidationError) ErrorName() string {
-	return "ListProjectsResponseValidationError"
+func (e RestoreOrganizationResponseValidationError) ErrorName() string {
+	return "RestoreOrganizationResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectsResponseValidationError) Error() string {
+func (e RestoreOrganizationResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1024,14 +1060,14 @@ func (e ListProjectsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectsResponse.%s: %s%s",
+		"invalid %sRestoreOrganizationResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectsResponseValidationError{}
+var _ error = RestoreOrganizationResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1039,44 +1075,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectsResponseValidationError{}
+} = RestoreOrganizationResponseValidationError{}
 
-// Validate checks the field values on ListRelationsRequest with the rules
+// Validate checks the field values on RestoreProjectRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListRelationsRequest) Validate() error {
+func (m *RestoreProjectRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListRelationsRequest with the rules
+// ValidateAll checks the field values on RestoreProjectRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListRelationsRequestMultiError, or nil if none found.
-func (m *ListRelationsRequest) ValidateAll() error {
+// RestoreProjectRequestMultiError, or nil if none found.
+func (m *RestoreProjectRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListRelationsRequest) validate(all bool) error {
+func (m *RestoreProjectRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = RestoreProjectRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return ListRelationsRequestMultiError(errors)
+		return RestoreProjectRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListRelationsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListRelationsRequest.ValidateAll() if the designated
+func (m *RestoreProjectRequest) _validateUuid(uuid string) error {
+	if matched := _admin_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RestoreProjectRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreProjectRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListRelationsRequestMultiError []error
+type RestoreProjectRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListRelationsRequestMultiError) Error() string {
+func (m RestoreProjectRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1085,11 +1141,11 @@ func (m ListRelationsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListRelationsRequestMultiError) AllErrors() []error { return m }
+func (m RestoreProjectRequestMultiError) AllErrors() []error { return m }
 
-// ListRelationsRequestValidationError is the validation error returned by
-// ListRelationsRequest.Validate if the designated constraints aren't met.
-type ListRelationsRequestValidationError struct {
+// RestoreProjectRequestValidationError is the validation error returned by
+// RestoreProjectRequest.Validate if the designated constraints aren't met.
+type RestoreProjectRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1097,24 +1153,24 @@ type ListRelationsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListRelationsRequestValidationError) Field() string { return e.field }
+func (e RestoreProjectRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListRelationsRequestValidationError) Reason() string { return e.reason }
+func (e RestoreProjectRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListRelationsRequestValidationError) Cause() error { return e.cause }
+func (e RestoreProjectRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListRelationsRequestValidationError) Key() bool { return e.key }
+func (e RestoreProjectRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListRelationsRequestValidationError) ErrorName() string {
-	return "ListRelationsRequestValidationError"
+func (e RestoreProjectRequestValidationError) ErrorName() string {
+	return "RestoreProjectRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListRelationsRequestValidationError) Error() string {
+func (e RestoreProjectRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1126,14 +1182,14 @@ func (e ListRelationsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListRelationsRequest.%s: %s%s",
+		"invalid %sRestoreProjectRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListRelationsRequestValidationError{}
+var _ error = RestoreProjectRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1141,78 +1197,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListRelationsRequestValidationError{}
+} = RestoreProjectRequestValidationError{}
 
-// Validate checks the field values on ListRelationsResponse with the rules
+// Validate checks the field values on RestoreProjectResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListRelationsResponse) Validate() error {
+func (m *RestoreProjectResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListRelationsResponse with the rules
+// ValidateAll checks the field values on RestoreProjectResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListRelationsResponseMultiError, or nil if none found.
-func (m *ListRelationsResponse) ValidateAll() error {
+// RestoreProjectResponseMultiError, or nil if none found.
+func (m *RestoreProjectResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListRelationsResponse) validate(all bool) error {
+func (m *RestoreProjectResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRelations() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListRelationsResponseValidationError{
-						field:  fmt.Sprintf("Relations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListRelationsResponseValidationError{
-						field:  fmt.Sprintf("Relations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListRelationsResponseValidationError{
-					field:  fmt.Sprintf("Relations[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return ListRelationsResponseMultiError(errors)
+		return RestoreProjectResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListRelationsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListRelationsResponse.ValidateAll() if the designated
+// RestoreProjectResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreProjectResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListRelationsResponseMultiError []error
+type RestoreProjectResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListRelationsResponseMultiError) Error() string {
+func (m RestoreProjectResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1221,11 +1243,11 @@ func (m ListRelationsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListRelationsResponseMultiError) AllErrors() []error { return m }
+func (m RestoreProjectResponseMultiError) AllErrors() []error { return m }
 
-// ListRelationsResponseValidationError is the validation error returned by
-// ListRelationsResponse.Validate if the designated constraints aren't met.
-type ListRelationsResponseValidationError struct {
+// RestoreProjectResponseValidationError is the validation error returned by
+// RestoreProjectResponse.Validate if the designated constraints aren't met.
+type RestoreProjectResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1233,24 +1255,24 @@ type ListRelationsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListRelationsResponseValidationError) Field() string { return e.field }
+func (e RestoreProjectResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListRelationsResponseValidationError) Reason() string { return e.reason }
+func (e RestoreProjectResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListRelationsResponseValidationError) Cause() error { return e.cause }
+func (e RestoreProjectResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListRelationsResponseValidationError) Key() bool { return e.key }
+func (e RestoreProjectResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListRelationsResponseValidationError) ErrorName() string {
-	return "ListRelationsResponseValidationError"
+func (e RestoreProjectResponseValidationError) ErrorName() string {
+	return "RestoreProjectResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListRelationsResponseValidationError) Error() string {
+func (e RestoreProjectResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1262,14 +1284,14 @@ func (e ListRelationsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListRelationsResponse.%s: %s%s",
+		"invalid %sRestoreProjectResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListRelationsResponseValidationError{}
+var _ error = RestoreProjectResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1277,40 +1299,35 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListRelationsResponseValidationError{}
+} = RestoreProjectResponseValidationError{}
 
-// Validate checks the field values on ListResourcesRequest with the rules
+// Validate checks the field values on RestoreUserRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListResourcesRequest) Validate() error {
+func (m *RestoreUserRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListResourcesRequest with the rules
+// ValidateAll checks the field values on RestoreUserRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListResourcesRequestMultiError, or nil if none found.
-func (m *ListResourcesRequest) ValidateAll() error {
+// RestoreUserRequestMultiError, or nil if none found.
+func (m *RestoreUserRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListResourcesRequest) validate(all bool) error {
+func (m *RestoreUserRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	// no validation rules for ProjectId
-
-	// no validation rules for OrganizationId
-
-	if utf8.RuneCountInString(m.GetNamespace()) < 3 {
-		err := ListResourcesRequestValidationError{
-			field:  "Namespace",
-			reason: "value length must be at least 3 runes",
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = RestoreUserRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
 		}
 		if !all {
 			return err
@@ -1319,19 +1336,27 @@ func (m *ListResourcesRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListResourcesRequestMultiError(errors)
+		return RestoreUserRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListResourcesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListResourcesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListResourcesRequestMultiError []error
+func (m *RestoreUserRequest) _validateUuid(uuid string) error {
+	if matched := _admin_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RestoreUserRequestMultiError is an error wrapping multiple validation errors
+// returned by RestoreUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RestoreUserRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListResourcesRequestMultiError) Error() string {
+func (m RestoreUserRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1340,11 +1365,11 @@ func (m ListResourcesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListResourcesRequestMultiError) AllErrors() []error { return m }
+func (m RestoreUserRequestMultiError) AllErrors() []error { return m }
 
-// ListResourcesRequestValidationError is the validation error returned by
-// ListResourcesRequest.Validate if the designated constraints aren't met.
-type ListResourcesRequestValidationError struct {
+// RestoreUserRequestValidationError is the validation error returned by
+// RestoreUserRequest.Validate if the designated constraints aren't met.
+type RestoreUserRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1352,24 +1377,24 @@ type ListResourcesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListResourcesRequestValidationError) Field() string { return e.field }
+func (e RestoreUserRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListResourcesRequestValidationError) Reason() string { return e.reason }
+func (e RestoreUserRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListResourcesRequestValidationError) Cause() error { return e.cause }
+func (e RestoreUserRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListResourcesRequestValidationError) Key() bool { return e.key }
+func (e RestoreUserRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListResourcesRequestValidationError) ErrorName() string {
-	return "ListResourcesRequestValidationError"
+func (e RestoreUserRequestValidationError) ErrorName() string {
+	return "RestoreUserRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListResourcesRequestValidationError) Error() string {
+func (e RestoreUserRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1381,14 +1406,14 @@ func (e ListResourcesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListResourcesRequest.%s: %s%s",
+		"invalid %sRestoreUserRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListResourcesRequestValidationError{}
+var _ error = RestoreUserRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1396,78 +1421,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListResourcesRequestValidationError{}
+} = RestoreUserRequestValidationError{}
 
-// Validate checks the field values on ListResourcesResponse with the rules
+// Validate checks the field values on RestoreUserResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListResourcesResponse) Validate() error {
+func (m *RestoreUserResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListResourcesResponse with the rules
+// ValidateAll checks the field values on RestoreUserResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListResourcesResponseMultiError, or nil if none found.
-func (m *ListResourcesResponse) ValidateAll() error {
+// RestoreUserResponseMultiError, or nil if none found.
+func (m *RestoreUserResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListResourcesResponse) validate(all bool) error {
+func (m *RestoreUserResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetResources() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListResourcesResponseValidationError{
-						field:  fmt.Sprintf("Resources[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListResourcesResponseValidationError{
-						field:  fmt.Sprintf("Resources[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListResourcesResponseValidationError{
-					field:  fmt.Sprintf("Resources[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return ListResourcesResponseMultiError(errors)
+		return RestoreUserResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListResourcesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListResourcesResponse.ValidateAll() if the designated
+// RestoreUserResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreUserResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListResourcesResponseMultiError []error
+type RestoreUserResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListResourcesResponseMultiError) Error() string {
+func (m RestoreUserResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1476,11 +1467,11 @@ func (m ListResourcesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListResourcesResponseMultiError) AllErrors() []error { return m }
+func (m RestoreUserResponseMultiError) AllErrors() []error { return m }
 
-// ListResourcesResponseValidationError is the validation error returned by
-// ListResourcesResponse.Validate if the designated constraints aren't met.
-type ListResourcesResponseValidationError struct {
+// RestoreUserResponseValidationError is the validation error returned by
+// RestoreUserResponse.Validate if the designated constraints aren't met.
+type RestoreUserResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1488,24 +1479,24 @@ type ListResourcesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListResourcesResponseValidationError) Field() string { return e.field }
+func (e RestoreUserResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListResourcesResponseValidationError) Reason() string { return e.reason }
+func (e RestoreUserResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListResourcesResponseValidationError) Cause() error { return e.cause }
+func (e RestoreUserResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListResourcesResponseValidationError) Key() bool { return e.key }
+func (e RestoreUserResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListResourcesResponseValidationError) ErrorName() string {
-	return "ListResourcesResponseValidationError"
+func (e RestoreUserResponseValidationError) ErrorName() string {
+	return "RestoreUserResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListResourcesResponseValidationError) Error() string {
+func (e RestoreUserResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1517,14 +1508,14 @@ func (e ListResourcesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListResourcesResponse.%s: %s%s",
+		"invalid %sRestoreUserResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListResourcesResponseValidationError{}
+var _ error = RestoreUserResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1532,54 +1523,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListResourcesResponseValidationError{}
+} = RestoreUserResponseValidationError{}
 
-// Validate checks the field values on ListPoliciesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListPoliciesRequest) Validate() error {
+// Validate checks the field values on MergeUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MergeUserRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListPoliciesRequest with the rules
+// ValidateAll checks the field values on MergeUserRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListPoliciesRequestMultiError, or nil if none found.
-func (m *ListPoliciesRequest) ValidateAll() error {
+// MergeUserRequestMultiError, or nil if none found.
+func (m *MergeUserRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListPoliciesRequest) validate(all bool) error {
+func (m *MergeUserRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OrgId
-
-	// no validation rules for ProjectId
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = MergeUserRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for UserId
+	if utf8.RuneCountInString(m.GetTargetId()) < 1 {
+		err := MergeUserRequestValidationError{
+			field:  "TargetId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for RoleId
+	if len(errors) > 0 {
+		return MergeUserRequestMultiError(errors)
+	}
 
-	// no validation rules for GroupId
+	return nil
+}
 
-	if len(errors) > 0 {
-		return ListPoliciesRequestMultiError(errors)
+func (m *MergeUserRequest) _validateUuid(uuid string) error {
+	if matched := _admin_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
 	}
 
 	return nil
 }
 
-// ListPoliciesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListPoliciesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListPoliciesRequestMultiError []error
+// MergeUserRequestMultiError is an error wrapping multiple validation errors
+// returned by MergeUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MergeUserRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListPoliciesRequestMultiError) Error() string {
+func (m MergeUserRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1588,11 +1600,11 @@ func (m ListPoliciesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListPoliciesRequestMultiError) AllErrors() []error { return m }
+func (m MergeUserRequestMultiError) AllErrors() []error { return m }
 
-// ListPoliciesRequestValidationError is the validation error returned by
-// ListPoliciesRequest.Validate if the designated constraints aren't met.
-type ListPoliciesRequestValidationError struct {
+// MergeUserRequestValidationError is the validation error returned by
+// MergeUserRequest.Validate if the designated constraints aren't met.
+type MergeUserRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1600,24 +1612,22 @@ type ListPoliciesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListPoliciesRequestValidationError) Field() string { return e.field }
+func (e MergeUserRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListPoliciesRequestValidationError) Reason() string { return e.reason }
+func (e MergeUserRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListPoliciesRequestValidationError) Cause() error { return e.cause }
+func (e MergeUserRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListPoliciesRequestValidationError) Key() bool { return e.key }
+func (e MergeUserRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListPoliciesRequestValidationError) ErrorName() string {
-	return "ListPoliciesRequestValidationError"
-}
+func (e MergeUserRequestValidationError) ErrorName() string { return "MergeUserRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListPoliciesRequestValidationError) Error() string {
+func (e MergeUserRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1629,14 +1639,14 @@ func (e ListPoliciesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListPoliciesRequest.%s: %s%s",
+		"invalid %sMergeUserRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListPoliciesRequestValidationError{}
+var _ error = MergeUserRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1644,78 +1654,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListPoliciesRequestValidationError{}
+} = MergeUserRequestValidationError{}
 
-// Validate checks the field values on ListPoliciesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListPoliciesResponse) Validate() error {
+// Validate checks the field values on MergeUserResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MergeUserResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListPoliciesResponse with the rules
+// ValidateAll checks the field values on MergeUserResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListPoliciesResponseMultiError, or nil if none found.
-func (m *ListPoliciesResponse) ValidateAll() error {
+// MergeUserResponseMultiError, or nil if none found.
+func (m *MergeUserResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListPoliciesResponse) validate(all bool) error {
+func (m *MergeUserResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPolicies() {
-		_, _ = idx, item
+	// no validation rules for Policies
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListPoliciesResponseValidationError{
-						field:  fmt.Sprintf("Policies[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListPoliciesResponseValidationError{
-						field:  fmt.Sprintf("Policies[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListPoliciesResponseValidationError{
-					field:  fmt.Sprintf("Policies[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Relations
 
-	}
+	// no validation rules for Resources
+
+	// no validation rules for Preferences
 
 	if len(errors) > 0 {
-		return ListPoliciesResponseMultiError(errors)
+		return MergeUserResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListPoliciesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListPoliciesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListPoliciesResponseMultiError []error
+// MergeUserResponseMultiError is an error wrapping multiple validation errors
+// returned by MergeUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MergeUserResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListPoliciesResponseMultiError) Error() string {
+func (m MergeUserResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1724,11 +1708,11 @@ func (m ListPoliciesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListPoliciesResponseMultiError) AllErrors() []error { return m }
+func (m MergeUserResponseMultiError) AllErrors() []error { return m }
 
-// ListPoliciesResponseValidationError is the validation error returned by
-// ListPoliciesResponse.Validate if the designated constraints aren't met.
-type ListPoliciesResponseValidationError struct {
+// MergeUserResponseValidationError is the validation error returned by
+// MergeUserResponse.Validate if the designated constraints aren't met.
+type MergeUserResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1736,24 +1720,24 @@ type ListPoliciesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListPoliciesResponseValidationError) Field() string { return e.field }
+func (e MergeUserResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListPoliciesResponseValidationError) Reason() string { return e.reason }
+func (e MergeUserResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListPoliciesResponseValidationError) Cause() error { return e.cause }
+func (e MergeUserResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListPoliciesResponseValidationError) Key() bool { return e.key }
+func (e MergeUserResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListPoliciesResponseValidationError) ErrorName() string {
-	return "ListPoliciesResponseValidationError"
+func (e MergeUserResponseValidationError) ErrorName() string {
+	return "MergeUserResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListPoliciesResponseValidationError) Error() string {
+func (e MergeUserResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1765,14 +1749,14 @@ func (e ListPoliciesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListPoliciesResponse.%s: %s%s",
+		"invalid %sMergeUserResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListPoliciesResponseValidationError{}
+var _ error = MergeUserResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1780,73 +1764,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListPoliciesResponseValidationError{}
+} = MergeUserResponseValidationError{}
 
-// Validate checks the field values on CreateRoleRequest with the rules defined
+// Validate checks the field values on ListJobsRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *CreateRoleRequest) Validate() error {
+func (m *ListJobsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateRoleRequest with the rules
+// ValidateAll checks the field values on ListJobsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateRoleRequestMultiError, or nil if none found.
-func (m *CreateRoleRequest) ValidateAll() error {
+// ListJobsRequestMultiError, or nil if none found.
+func (m *ListJobsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateRoleRequest) validate(all bool) error {
+func (m *ListJobsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateRoleRequestValidationError{
-					field:  "Body",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateRoleRequestValidationError{
-					field:  "Body",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateRoleRequestValidationError{
-				field:  "Body",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Kind
+
+	// no validation rules for State
 
 	if len(errors) > 0 {
-		return CreateRoleRequestMultiError(errors)
+		return ListJobsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateRoleRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateRoleRequest.ValidateAll() if the designated constraints
+// ListJobsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListJobsRequest.ValidateAll() if the designated constraints
 // aren't met.
-type CreateRoleRequestMultiError []error
+type ListJobsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateRoleRequestMultiError) Error() string {
+func (m ListJobsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1855,11 +1814,11 @@ func (m CreateRoleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateRoleRequestMultiError) AllErrors() []error { return m }
+func (m ListJobsRequestMultiError) AllErrors() []error { return m }
 
-// CreateRoleRequestValidationError is the validation error returned by
-// CreateRoleRequest.Validate if the designated constraints aren't met.
-type CreateRoleRequestValidationError struct {
+// ListJobsRequestValidationError is the validation error returned by
+// ListJobsRequest.Validate if the designated constraints aren't met.
+type ListJobsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1867,24 +1826,22 @@ type CreateRoleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateRoleRequestValidationError) Field() string { return e.field }
+func (e ListJobsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateRoleRequestValidationError) Reason() string { return e.reason }
+func (e ListJobsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateRoleRequestValidationError) Cause() error { return e.cause }
+func (e ListJobsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateRoleRequestValidationError) Key() bool { return e.key }
+func (e ListJobsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateRoleRequestValidationError) ErrorName() string {
-	return "CreateRoleRequestValidationError"
-}
+func (e ListJobsRequestValidationError) ErrorName() string { return "ListJobsRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateRoleRequestValidationError) Error() string {
+func (e ListJobsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1896,14 +1853,14 @@ func (e CreateRoleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateRoleRequest.%s: %s%s",
+		"invalid %sListJobsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateRoleRequestValidationError{}
+var _ error = ListJobsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1911,73 +1868,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateRoleRequestValidationError{}
+} = ListJobsRequestValidationError{}
 
-// Validate checks the field values on CreateRoleResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateRoleResponse) Validate() error {
+// Validate checks the field values on ListJobsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListJobsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateRoleResponse with the rules
+// ValidateAll checks the field values on ListJobsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateRoleResponseMultiError, or nil if none found.
-func (m *CreateRoleResponse) ValidateAll() error {
+// ListJobsResponseMultiError, or nil if none found.
+func (m *ListJobsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateRoleResponse) validate(all bool) error {
+func (m *ListJobsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetRole()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateRoleResponseValidationError{
-					field:  "Role",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetJobs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListJobsResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListJobsResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateRoleResponseValidationError{
-					field:  "Role",
+				return ListJobsResponseValidationError{
+					field:  fmt.Sprintf("Jobs[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateRoleResponseValidationError{
-				field:  "Role",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return CreateRoleResponseMultiError(errors)
+		return ListJobsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateRoleResponseMultiError is an error wrapping multiple validation errors
-// returned by CreateRoleResponse.ValidateAll() if the designated constraints
+// ListJobsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListJobsResponse.ValidateAll() if the designated constraints
 // aren't met.
-type CreateRoleResponseMultiError []error
+type ListJobsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateRoleResponseMultiError) Error() string {
+func (m ListJobsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1986,11 +1948,11 @@ func (m CreateRoleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateRoleResponseMultiError) AllErrors() []error { return m }
+func (m ListJobsResponseMultiError) AllErrors() []error { return m }
 
-// CreateRoleResponseValidationError is the validation error returned by
-// CreateRoleResponse.Validate if the designated constraints aren't met.
-type CreateRoleResponseValidationError struct {
+// ListJobsResponseValidationError is the validation error returned by
+// ListJobsResponse.Validate if the designated constraints aren't met.
+type ListJobsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1998,24 +1960,22 @@ type CreateRoleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateRoleResponseValidationError) Field() string { return e.field }
+func (e ListJobsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateRoleResponseValidationError) Reason() string { return e.reason }
+func (e ListJobsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateRoleResponseValidationError) Cause() error { return e.cause }
+func (e ListJobsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateRoleResponseValidationError) Key() bool { return e.key }
+func (e ListJobsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateRoleResponseValidationError) ErrorName() string {
-	return "CreateRoleResponseValidationError"
-}
+func (e ListJobsResponseValidationError) ErrorName() string { return "ListJobsResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateRoleResponseValidationError) Error() string {
+func (e ListJobsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2027,14 +1987,14 @@ func (e CreateRoleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateRoleResponse.%s: %s%s",
+		"invalid %sListJobsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateRoleResponseValidationError{}
+var _ error = ListJobsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2042,75 +2002,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateRoleResponseValidationError{}
+} = ListJobsResponseValidationError{}
 
-// Validate checks the field values on UpdateRoleRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UpdateRoleRequest) Validate() error {
+// Validate checks the field values on ExportOrganizationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportOrganizationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateRoleRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ExportOrganizationRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateRoleRequestMultiError, or nil if none found.
-func (m *UpdateRoleRequest) ValidateAll() error {
+// ExportOrganizationRequestMultiError, or nil if none found.
+func (m *ExportOrganizationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateRoleRequest) validate(all bool) error {
+func (m *ExportOrganizationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := ExportOrganizationRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if all {
-		switch v := interface{}(m.GetBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateRoleRequestValidationError{
-					field:  "Body",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateRoleRequestValidationError{
-					field:  "Body",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if _, ok := _ExportOrganizationRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := ExportOrganizationRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [ json ndjson]",
 		}
-	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateRoleRequestValidationError{
-				field:  "Body",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return UpdateRoleRequestMultiError(errors)
+		return ExportOrganizationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateRoleRequestMultiError is an error wrapping multiple validation errors
-// returned by UpdateRoleRequest.ValidateAll() if the designated constraints
-// aren't met.
-type UpdateRoleRequestMultiError []error
+// ExportOrganizationRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportOrganizationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ExportOrganizationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateRoleRequestMultiError) Error() string {
+func (m ExportOrganizationRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2119,11 +2070,11 @@ func (m UpdateRoleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateRoleRequestMultiError) AllErrors() []error { return m }
+func (m ExportOrganizationRequestMultiError) AllErrors() []error { return m }
 
-// UpdateRoleRequestValidationError is the validation error returned by
-// UpdateRoleRequest.Validate if the designated constraints aren't met.
-type UpdateRoleRequestValidationError struct {
+// ExportOrganizationRequestValidationError is the validation error returned by
+// ExportOrganizationRequest.Validate if the designated constraints aren't met.
+type ExportOrganizationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2131,24 +2082,24 @@ type UpdateRoleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateRoleRequestValidationError) Field() string { return e.field }
+func (e ExportOrganizationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateRoleRequestValidationError) Reason() string { return e.reason }
+func (e ExportOrganizationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateRoleRequestValidationError) Cause() error { return e.cause }
+func (e ExportOrganizationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateRoleRequestValidationError) Key() bool { return e.key }
+func (e ExportOrganizationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateRoleRequestValidationError) ErrorName() string {
-	return "UpdateRoleRequestValidationError"
+func (e ExportOrganizationRequestValidationError) ErrorName() string {
+	return "ExportOrganizationRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateRoleRequestValidationError) Error() string {
+func (e ExportOrganizationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2160,14 +2111,14 @@ func (e UpdateRoleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateRoleRequest.%s: %s%s",
+		"invalid %sExportOrganizationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateRoleRequestValidationError{}
+var _ error = ExportOrganizationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2175,73 +2126,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateRoleRequestValidationError{}
+} = ExportOrganizationRequestValidationError{}
 
-// Validate checks the field values on UpdateRoleResponse with the rules
-// defined in the proto definition for this message. If any rules are
+var _ExportOrganizationRequest_Format_InLookup = map[string]struct{}{
+	"":       {},
+	"json":   {},
+	"ndjson": {},
+}
+
+// Validate checks the field values on ExportOrganizationResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateRoleResponse) Validate() error {
+func (m *ExportOrganizationResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateRoleResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ExportOrganizationResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateRoleResponseMultiError, or nil if none found.
-func (m *UpdateRoleResponse) ValidateAll() error {
+// ExportOrganizationResponseMultiError, or nil if none found.
+func (m *ExportOrganizationResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateRoleResponse) validate(all bool) error {
+func (m *ExportOrganizationResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetRole()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateRoleResponseValidationError{
-					field:  "Role",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateRoleResponseValidationError{
-					field:  "Role",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateRoleResponseValidationError{
-				field:  "Role",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Archive
 
 	if len(errors) > 0 {
-		return UpdateRoleResponseMultiError(errors)
+		return ExportOrganizationResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateRoleResponseMultiError is an error wrapping multiple validation errors
-// returned by UpdateRoleResponse.ValidateAll() if the designated constraints
-// aren't met.
-type UpdateRoleResponseMultiError []error
+// ExportOrganizationResponseMultiError is an error wrapping multiple
+// validation errors returned by ExportOrganizationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ExportOrganizationResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateRoleResponseMultiError) Error() string {
+func (m ExportOrganizationResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2250,11 +2180,11 @@ func (m UpdateRoleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateRoleResponseMultiError) AllErrors() []error { return m }
+func (m ExportOrganizationResponseMultiError) AllErrors() []error { return m }
 
-// UpdateRoleResponseValidationError is the validation error returned by
-// UpdateRoleResponse.Validate if the designated constraints aren't met.
-type UpdateRoleResponseValidationError struct {
+// ExportOrganizationResponseValidationError is the validation error returned
+// by ExportOrganizationResponse.Validate if the designated constraints aren't met.
+type ExportOrganizationResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2262,24 +2192,24 @@ type UpdateRoleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateRoleResponseValidationError) Field() string { return e.field }
+func (e ExportOrganizationResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateRoleResponseValidationError) Reason() string { return e.reason }
+func (e ExportOrganizationResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateRoleResponseValidationError) Cause() error { return e.cause }
+func (e ExportOrganizationResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateRoleResponseValidationError) Key() bool { return e.key }
+func (e ExportOrganizationResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateRoleResponseValidationError) ErrorName() string {
-	return "UpdateRoleResponseValidationError"
+func (e ExportOrganizationResponseValidationError) ErrorName() string {
+	return "ExportOrganizationResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateRoleResponseValidationError) Error() string {
+func (e ExportOrganizationResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2291,14 +2221,14 @@ func (e UpdateRoleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateRoleResponse.%s: %s%s",
+		"invalid %sExportOrganizationResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateRoleResponseValidationError{}
+var _ error = ExportOrganizationResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2306,46 +2236,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateRoleResponseValidationError{}
+} = ExportOrganizationResponseValidationError{}
 
-// Validate checks the field values on DeleteRoleRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DeleteRoleRequest) Validate() error {
+// Validate checks the field values on ImportOrganizationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportOrganizationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteRoleRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ImportOrganizationRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteRoleRequestMultiError, or nil if none found.
-func (m *DeleteRoleRequest) ValidateAll() error {
+// ImportOrganizationRequestMultiError, or nil if none found.
+func (m *ImportOrganizationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteRoleRequest) validate(all bool) error {
+func (m *ImportOrganizationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if len(m.GetArchive()) < 1 {
+		err := ImportOrganizationRequestValidationError{
+			field:  "Archive",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return DeleteRoleRequestMultiError(errors)
+		return ImportOrganizationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteRoleRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteRoleRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteRoleRequestMultiError []error
+// ImportOrganizationRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportOrganizationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ImportOrganizationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteRoleRequestMultiError) Error() string {
+func (m ImportOrganizationRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2354,11 +2293,11 @@ func (m DeleteRoleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteRoleRequestMultiError) AllErrors() []error { return m }
+func (m ImportOrganizationRequestMultiError) AllErrors() []error { return m }
 
-// DeleteRoleRequestValidationError is the validation error returned by
-// DeleteRoleRequest.Validate if the designated constraints aren't met.
-type DeleteRoleRequestValidationError struct {
+// ImportOrganizationRequestValidationError is the validation error returned by
+// ImportOrganizationRequest.Validate if the designated constraints aren't met.
+type ImportOrganizationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2366,24 +2305,24 @@ type DeleteRoleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteRoleRequestValidationError) Field() string { return e.field }
+func (e ImportOrganizationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteRoleRequestValidationError) Reason() string { return e.reason }
+func (e ImportOrganizationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteRoleRequestValidationError) Cause() error { return e.cause }
+func (e ImportOrganizationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteRoleRequestValidationError) Key() bool { return e.key }
+func (e ImportOrganizationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteRoleRequestValidationError) ErrorName() string {
-	return "DeleteRoleRequestValidationError"
+func (e ImportOrganizationRequestValidationError) ErrorName() string {
+	return "ImportOrganizationRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteRoleRequestValidationError) Error() string {
+func (e ImportOrganizationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2395,14 +2334,14 @@ func (e DeleteRoleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteRoleRequest.%s: %s%s",
+		"invalid %sImportOrganizationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteRoleRequestValidationError{}
+var _ error = ImportOrganizationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2410,44 +2349,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteRoleRequestValidationError{}
+} = ImportOrganizationRequestValidationError{}
 
-// Validate checks the field values on DeleteRoleResponse with the rules
+// Validate checks the field values on ImportOrganizationStats with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteRoleResponse) Validate() error {
+func (m *ImportOrganizationStats) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteRoleResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ImportOrganizationStats with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteRoleResponseMultiError, or nil if none found.
-func (m *DeleteRoleResponse) ValidateAll() error {
+// ImportOrganizationStatsMultiError, or nil if none found.
+func (m *ImportOrganizationStats) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteRoleResponse) validate(all bool) error {
+func (m *ImportOrganizationStats) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Kind
+
+	// no validation rules for Created
+
+	// no validation rules for Existing
+
+	// no validation rules for Skipped
+
 	if len(errors) > 0 {
-		return DeleteRoleResponseMultiError(errors)
+		return ImportOrganizationStatsMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteRoleResponseMultiError is an error wrapping multiple validation errors
-// returned by DeleteRoleResponse.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteRoleResponseMultiError []error
+// ImportOrganizationStatsMultiError is an error wrapping multiple validation
+// errors returned by ImportOrganizationStats.ValidateAll() if the designated
+// constraints aren't met.
+type ImportOrganizationStatsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteRoleResponseMultiError) Error() string {
+func (m ImportOrganizationStatsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2456,11 +2403,11 @@ func (m DeleteRoleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteRoleResponseMultiError) AllErrors() []error { return m }
+func (m ImportOrganizationStatsMultiError) AllErrors() []error { return m }
 
-// DeleteRoleResponseValidationError is the validation error returned by
-// DeleteRoleResponse.Validate if the designated constraints aren't met.
-type DeleteRoleResponseValidationError struct {
+// ImportOrganizationStatsValidationError is the validation error returned by
+// ImportOrganizationStats.Validate if the designated constraints aren't met.
+type ImportOrganizationStatsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2468,24 +2415,24 @@ type DeleteRoleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteRoleResponseValidationError) Field() string { return e.field }
+func (e ImportOrganizationStatsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteRoleResponseValidationError) Reason() string { return e.reason }
+func (e ImportOrganizationStatsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteRoleResponseValidationError) Cause() error { return e.cause }
+func (e ImportOrganizationStatsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteRoleResponseValidationError) Key() bool { return e.key }
+func (e ImportOrganizationStatsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteRoleResponseValidationError) ErrorName() string {
-	return "DeleteRoleResponseValidationError"
+func (e ImportOrganizationStatsValidationError) ErrorName() string {
+	return "ImportOrganizationStatsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteRoleResponseValidationError) Error() string {
+func (e ImportOrganizationStatsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2497,14 +2444,14 @@ func (e DeleteRoleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteRoleResponse.%s: %s%s",
+		"invalid %sImportOrganizationStats.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteRoleResponseValidationError{}
+var _ error = ImportOrganizationStatsValidationError{}
 
 var _ interface {
 	Field() string
@@ -2512,81 +2459,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteRoleResponseValidationError{}
+} = ImportOrganizationStatsValidationError{}
 
-// Validate checks the field values on PermissionRequestBody with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ImportOrganizationResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PermissionRequestBody) Validate() error {
+func (m *ImportOrganizationResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PermissionRequestBody with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ImportOrganizationResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PermissionRequestBodyMultiError, or nil if none found.
-func (m *PermissionRequestBody) ValidateAll() error {
+// ImportOrganizationResponseMultiError, or nil if none found.
+func (m *ImportOrganizationResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionRequestBody) validate(all bool) error {
+func (m *ImportOrganizationResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for OrgId
 
-	// no validation rules for Namespace
+	for idx, item := range m.GetStats() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionRequestBodyValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportOrganizationResponseValidationError{
+						field:  fmt.Sprintf("Stats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportOrganizationResponseValidationError{
+						field:  fmt.Sprintf("Stats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionRequestBodyValidationError{
-					field:  "Metadata",
+				return ImportOrganizationResponseValidationError{
+					field:  fmt.Sprintf("Stats[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PermissionRequestBodyValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
-
-	// no validation rules for Title
 
-	// no validation rules for Key
+	}
 
 	if len(errors) > 0 {
-		return PermissionRequestBodyMultiError(errors)
+		return ImportOrganizationResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionRequestBodyMultiError is an error wrapping multiple validation
-// errors returned by PermissionRequestBody.ValidateAll() if the designated
-// constraints aren't met.
-type PermissionRequestBodyMultiError []error
+// ImportOrganizationResponseMultiError is an error wrapping multiple
+// validation errors returned by ImportOrganizationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ImportOrganizationResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionRequestBodyMultiError) Error() string {
+func (m ImportOrganizationResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2595,11 +2541,11 @@ func (m PermissionRequestBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionRequestBodyMultiError) AllErrors() []error { return m }
+func (m ImportOrganizationResponseMultiError) AllErrors() []error { return m }
 
-// PermissionRequestBodyValidationError is the validation error returned by
-// PermissionRequestBody.Validate if the designated constraints aren't met.
-type PermissionRequestBodyValidationError struct {
+// ImportOrganizationResponseValidationError is the validation error returned
+// by ImportOrganizationResponse.Validate if the designated constraints aren't met.
+type ImportOrganizationResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2607,24 +2553,24 @@ type PermissionRequestBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionRequestBodyValidationError) Field() string { return e.field }
+func (e ImportOrganizationResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionRequestBodyValidationError) Reason() string { return e.reason }
+func (e ImportOrganizationResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionRequestBodyValidationError) Cause() error { return e.cause }
+func (e ImportOrganizationResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionRequestBodyValidationError) Key() bool { return e.key }
+func (e ImportOrganizationResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionRequestBodyValidationError) ErrorName() string {
-	return "PermissionRequestBodyValidationError"
+func (e ImportOrganizationResponseValidationError) ErrorName() string {
+	return "ImportOrganizationResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionRequestBodyValidationError) Error() string {
+func (e ImportOrganizationResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2636,14 +2582,14 @@ func (e PermissionRequestBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionRequestBody.%s: %s%s",
+		"invalid %sImportOrganizationResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionRequestBodyValidationError{}
+var _ error = ImportOrganizationResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2651,78 +2597,67 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionRequestBodyValidationError{}
+} = ImportOrganizationResponseValidationError{}
 
-// Validate checks the field values on CreatePermissionRequest with the rules
+// Validate checks the field values on ListProjectsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreatePermissionRequest) Validate() error {
+func (m *ListProjectsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreatePermissionRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListProjectsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreatePermissionRequestMultiError, or nil if none found.
-func (m *CreatePermissionRequest) ValidateAll() error {
+// ListProjectsRequestMultiError, or nil if none found.
+func (m *ListProjectsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreatePermissionRequest) validate(all bool) error {
+func (m *ListProjectsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetBodies() {
-		_, _ = idx, item
+	// no validation rules for OrgId
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreatePermissionRequestValidationError{
-						field:  fmt.Sprintf("Bodies[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreatePermissionRequestValidationError{
-						field:  fmt.Sprintf("Bodies[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CreatePermissionRequestValidationError{
-					field:  fmt.Sprintf("Bodies[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for State
 
+	if m.GetPageSize() < 0 {
+		err := ListProjectsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for PageToken
+
+	// no validation rules for OrderBy
+
+	// no validation rules for Filter
+
+	// no validation rules for IncludeDeleted
+
 	if len(errors) > 0 {
-		return CreatePermissionRequestMultiError(errors)
+		return ListProjectsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreatePermissionRequestMultiError is an error wrapping multiple validation
-// errors returned by CreatePermissionRequest.ValidateAll() if the designated
+// ListProjectsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListProjectsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreatePermissionRequestMultiError []error
+type ListProjectsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreatePermissionRequestMultiError) Error() string {
+func (m ListProjectsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2731,11 +2666,11 @@ func (m CreatePermissionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreatePermissionRequestMultiError) AllErrors() []error { return m }
+func (m ListProjectsRequestMultiError) AllErrors() []error { return m }
 
-// CreatePermissionRequestValidationError is the validation error returned by
-// CreatePermissionRequest.Validate if the designated constraints aren't met.
-type CreatePermissionRequestValidationError struct {
+// ListProjectsRequestValidationError is the validation error returned by
+// ListProjectsRequest.Validate if the designated constraints aren't met.
+type ListProjectsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2743,24 +2678,24 @@ type CreatePermissionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreatePermissionRequestValidationError) Field() string { return e.field }
+func (e ListProjectsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreatePermissionRequestValidationError) Reason() string { return e.reason }
+func (e ListProjectsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreatePermissionRequestValidationError) Cause() error { return e.cause }
+func (e ListProjectsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreatePermissionRequestValidationError) Key() bool { return e.key }
+func (e ListProjectsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreatePermissionRequestValidationError) ErrorName() string {
-	return "CreatePermissionRequestValidationError"
+func (e ListProjectsRequestValidationError) ErrorName() string {
+	return "ListProjectsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreatePermissionRequestValidationError) Error() string {
+func (e ListProjectsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2772,14 +2707,14 @@ func (e CreatePermissionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreatePermissionRequest.%s: %s%s",
+		"invalid %sListProjectsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreatePermissionRequestValidationError{}
+var _ error = ListProjectsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2787,47 +2722,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreatePermissionRequestValidationError{}
+} = ListProjectsRequestValidationError{}
 
-// Validate checks the field values on CreatePermissionResponse with the rules
+// Validate checks the field values on ListProjectsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreatePermissionResponse) Validate() error {
+func (m *ListProjectsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreatePermissionResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListProjectsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreatePermissionResponseMultiError, or nil if none found.
-func (m *CreatePermissionResponse) ValidateAll() error {
+// ListProjectsResponseMultiError, or nil if none found.
+func (m *ListProjectsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreatePermissionResponse) validate(all bool) error {
+func (m *ListProjectsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPermissions() {
+	for idx, item := range m.GetProjects() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreatePermissionResponseValidationError{
-						field:  fmt.Sprintf("Permissions[%v]", idx),
+					errors = append(errors, ListProjectsResponseValidationError{
+						field:  fmt.Sprintf("Projects[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, CreatePermissionResponseValidationError{
-						field:  fmt.Sprintf("Permissions[%v]", idx),
+					errors = append(errors, ListProjectsResponseValidationError{
+						field:  fmt.Sprintf("Projects[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -2835,8 +2770,8 @@ func (m *CreatePermissionResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return CreatePermissionResponseValidationError{
-					field:  fmt.Sprintf("Permissions[%v]", idx),
+				return ListProjectsResponseValidationError{
+					field:  fmt.Sprintf("Projects[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2845,20 +2780,22 @@ func (m *CreatePermissionResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return CreatePermissionResponseMultiError(errors)
+		return ListProjectsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreatePermissionResponseMultiError is an error wrapping multiple validation
-// errors returned by CreatePermissionResponse.ValidateAll() if the designated
+// ListProjectsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListProjectsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreatePermissionResponseMultiError []error
+type ListProjectsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreatePermissionResponseMultiError) Error() string {
+func (m ListProjectsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2867,11 +2804,11 @@ func (m CreatePermissionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreatePermissionResponseMultiError) AllErrors() []error { return m }
+func (m ListProjectsResponseMultiError) AllErrors() []error { return m }
 
-// CreatePermissionResponseValidationError is the validation error returned by
-// CreatePermissionResponse.Validate if the designated constraints aren't met.
-type CreatePermissionResponseValidationError struct {
+// ListProjectsResponseValidationError is the validation error returned by
+// ListProjectsResponse.Validate if the designated constraints aren't met.
+type ListProjectsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2879,24 +2816,24 @@ type CreatePermissionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreatePermissionResponseValidationError) Field() string { return e.field }
+func (e ListProjectsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreatePermissionResponseValidationError) Reason() string { return e.reason }
+func (e ListProjectsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreatePermissionResponseValidationError) Cause() error { return e.cause }
+func (e ListProjectsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreatePermissionResponseValidationError) Key() bool { return e.key }
+func (e ListProjectsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreatePermissionResponseValidationError) ErrorName() string {
-	return "CreatePermissionResponseValidationError"
+func (e ListProjectsResponseValidationError) ErrorName() string {
+	return "ListProjectsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreatePermissionResponseValidationError) Error() string {
+func (e ListProjectsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2908,14 +2845,14 @@ func (e CreatePermissionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreatePermissionResponse.%s: %s%s",
+		"invalid %sListProjectsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreatePermissionResponseValidationError{}
+var _ error = ListProjectsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2923,55 +2860,2927 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreatePermissionResponseValidationError{}
+} = ListProjectsResponseValidationError{}
 
-// Validate checks the field values on UpdatePermissionRequest with the rules
+// Validate checks the field values on ListRelationsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdatePermissionRequest) Validate() error {
+func (m *ListRelationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRelationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRelationsRequestMultiError, or nil if none found.
+func (m *ListRelationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRelationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPageSize() < 0 {
+		err := ListRelationsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for OrderBy
+
+	// no validation rules for Filter
+
+	if len(errors) > 0 {
+		return ListRelationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRelationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRelationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListRelationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRelationsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRelationsRequestMultiError) AllErrors() []error { return m }
+
+// ListRelationsRequestValidationError is the validation error returned by
+// ListRelationsRequest.Validate if the designated constraints aren't met.
+type ListRelationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRelationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRelationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRelationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRelationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRelationsRequestValidationError) ErrorName() string {
+	return "ListRelationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRelationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRelationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRelationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRelationsRequestValidationError{}
+
+// Validate checks the field values on ListRelationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRelationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRelationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRelationsResponseMultiError, or nil if none found.
+func (m *ListRelationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRelationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRelations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRelationsResponseValidationError{
+						field:  fmt.Sprintf("Relations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRelationsResponseValidationError{
+						field:  fmt.Sprintf("Relations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRelationsResponseValidationError{
+					field:  fmt.Sprintf("Relations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListRelationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRelationsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListRelationsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListRelationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRelationsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRelationsResponseMultiError) AllErrors() []error { return m }
+
+// ListRelationsResponseValidationError is the validation error returned by
+// ListRelationsResponse.Validate if the designated constraints aren't met.
+type ListRelationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRelationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRelationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRelationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRelationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRelationsResponseValidationError) ErrorName() string {
+	return "ListRelationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRelationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRelationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRelationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRelationsResponseValidationError{}
+
+// Validate checks the field values on ListResourcesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListResourcesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListResourcesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListResourcesRequestMultiError, or nil if none found.
+func (m *ListResourcesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListResourcesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ProjectId
+
+	// no validation rules for OrganizationId
+
+	if utf8.RuneCountInString(m.GetNamespace()) < 3 {
+		err := ListResourcesRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 3 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() < 0 {
+		err := ListResourcesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for OrderBy
+
+	// no validation rules for Filter
+
+	if len(errors) > 0 {
+		return ListResourcesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListResourcesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListResourcesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListResourcesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListResourcesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListResourcesRequestMultiError) AllErrors() []error { return m }
+
+// ListResourcesRequestValidationError is the validation error returned by
+// ListResourcesRequest.Validate if the designated constraints aren't met.
+type ListResourcesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListResourcesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListResourcesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListResourcesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListResourcesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListResourcesRequestValidationError) ErrorName() string {
+	return "ListResourcesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListResourcesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListResourcesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListResourcesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListResourcesRequestValidationError{}
+
+// Validate checks the field values on ListResourcesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListResourcesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListResourcesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListResourcesResponseMultiError, or nil if none found.
+func (m *ListResourcesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListResourcesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListResourcesResponseValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListResourcesResponseValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListResourcesResponseValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListResourcesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListResourcesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListResourcesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListResourcesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListResourcesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListResourcesResponseMultiError) AllErrors() []error { return m }
+
+// ListResourcesResponseValidationError is the validation error returned by
+// ListResourcesResponse.Validate if the designated constraints aren't met.
+type ListResourcesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListResourcesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListResourcesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListResourcesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListResourcesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListResourcesResponseValidationError) ErrorName() string {
+	return "ListResourcesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListResourcesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListResourcesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListResourcesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListResourcesResponseValidationError{}
+
+// Validate checks the field values on ListPoliciesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPoliciesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPoliciesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPoliciesRequestMultiError, or nil if none found.
+func (m *ListPoliciesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPoliciesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrgId
+
+	// no validation rules for ProjectId
+
+	// no validation rules for UserId
+
+	// no validation rules for RoleId
+
+	// no validation rules for GroupId
+
+	// no validation rules for Effect
+
+	if m.GetPageSize() < 0 {
+		err := ListPoliciesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for OrderBy
+
+	// no validation rules for Filter
+
+	if len(errors) > 0 {
+		return ListPoliciesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPoliciesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPoliciesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPoliciesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPoliciesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPoliciesRequestMultiError) AllErrors() []error { return m }
+
+// ListPoliciesRequestValidationError is the validation error returned by
+// ListPoliciesRequest.Validate if the designated constraints aren't met.
+type ListPoliciesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPoliciesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPoliciesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPoliciesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPoliciesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPoliciesRequestValidationError) ErrorName() string {
+	return "ListPoliciesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPoliciesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPoliciesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPoliciesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPoliciesRequestValidationError{}
+
+// Validate checks the field values on ListPoliciesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPoliciesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPoliciesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPoliciesResponseMultiError, or nil if none found.
+func (m *ListPoliciesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPoliciesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPoliciesResponseValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPoliciesResponseValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPoliciesResponseValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListPoliciesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPoliciesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListPoliciesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListPoliciesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPoliciesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPoliciesResponseMultiError) AllErrors() []error { return m }
+
+// ListPoliciesResponseValidationError is the validation error returned by
+// ListPoliciesResponse.Validate if the designated constraints aren't met.
+type ListPoliciesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPoliciesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPoliciesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPoliciesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPoliciesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPoliciesResponseValidationError) ErrorName() string {
+	return "ListPoliciesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPoliciesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPoliciesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPoliciesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPoliciesResponseValidationError{}
+
+// Validate checks the field values on CreateRoleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRoleRequestMultiError, or nil if none found.
+func (m *CreateRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRoleRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRoleRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRoleRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRoleRequestMultiError) AllErrors() []error { return m }
+
+// CreateRoleRequestValidationError is the validation error returned by
+// CreateRoleRequest.Validate if the designated constraints aren't met.
+type CreateRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRoleRequestValidationError) ErrorName() string {
+	return "CreateRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRoleRequestValidationError{}
+
+// Validate checks the field values on CreateRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRoleResponseMultiError, or nil if none found.
+func (m *CreateRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRole()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRoleResponseValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRoleResponseValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRoleResponseValidationError{
+				field:  "Role",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRoleResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateRoleResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRoleResponseMultiError) AllErrors() []error { return m }
+
+// CreateRoleResponseValidationError is the validation error returned by
+// CreateRoleResponse.Validate if the designated constraints aren't met.
+type CreateRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRoleResponseValidationError) ErrorName() string {
+	return "CreateRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRoleResponseValidationError{}
+
+// Validate checks the field values on UpdateRoleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRoleRequestMultiError, or nil if none found.
+func (m *UpdateRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRoleRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRoleRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRoleRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRoleRequestMultiError) AllErrors() []error { return m }
+
+// UpdateRoleRequestValidationError is the validation error returned by
+// UpdateRoleRequest.Validate if the designated constraints aren't met.
+type UpdateRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRoleRequestValidationError) ErrorName() string {
+	return "UpdateRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRoleRequestValidationError{}
+
+// Validate checks the field values on UpdateRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRoleResponseMultiError, or nil if none found.
+func (m *UpdateRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRole()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRoleResponseValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRoleResponseValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRoleResponseValidationError{
+				field:  "Role",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRoleResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateRoleResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRoleResponseMultiError) AllErrors() []error { return m }
+
+// UpdateRoleResponseValidationError is the validation error returned by
+// UpdateRoleResponse.Validate if the designated constraints aren't met.
+type UpdateRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRoleResponseValidationError) ErrorName() string {
+	return "UpdateRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRoleResponseValidationError{}
+
+// Validate checks the field values on DeleteRoleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRoleRequestMultiError, or nil if none found.
+func (m *DeleteRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRoleRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRoleRequestValidationError is the validation error returned by
+// DeleteRoleRequest.Validate if the designated constraints aren't met.
+type DeleteRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRoleRequestValidationError) ErrorName() string {
+	return "DeleteRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRoleRequestValidationError{}
+
+// Validate checks the field values on DeleteRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRoleResponseMultiError, or nil if none found.
+func (m *DeleteRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRoleResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteRoleResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRoleResponseMultiError) AllErrors() []error { return m }
+
+// DeleteRoleResponseValidationError is the validation error returned by
+// DeleteRoleResponse.Validate if the designated constraints aren't met.
+type DeleteRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRoleResponseValidationError) ErrorName() string {
+	return "DeleteRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRoleResponseValidationError{}
+
+// Validate checks the field values on PermissionRequestBody with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionRequestBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionRequestBody with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PermissionRequestBodyMultiError, or nil if none found.
+func (m *PermissionRequestBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionRequestBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Namespace
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionRequestBodyValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionRequestBodyValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionRequestBodyValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Title
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return PermissionRequestBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionRequestBodyMultiError is an error wrapping multiple validation
+// errors returned by PermissionRequestBody.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionRequestBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionRequestBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionRequestBodyMultiError) AllErrors() []error { return m }
+
+// PermissionRequestBodyValidationError is the validation error returned by
+// PermissionRequestBody.Validate if the designated constraints aren't met.
+type PermissionRequestBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionRequestBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionRequestBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionRequestBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionRequestBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionRequestBodyValidationError) ErrorName() string {
+	return "PermissionRequestBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionRequestBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionRequestBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionRequestBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionRequestBodyValidationError{}
+
+// Validate checks the field values on CreatePermissionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePermissionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePermissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePermissionRequestMultiError, or nil if none found.
+func (m *CreatePermissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePermissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBodies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreatePermissionRequestValidationError{
+						field:  fmt.Sprintf("Bodies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreatePermissionRequestValidationError{
+						field:  fmt.Sprintf("Bodies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreatePermissionRequestValidationError{
+					field:  fmt.Sprintf("Bodies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreatePermissionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePermissionRequestMultiError is an error wrapping multiple validation
+// errors returned by CreatePermissionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePermissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePermissionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePermissionRequestMultiError) AllErrors() []error { return m }
+
+// CreatePermissionRequestValidationError is the validation error returned by
+// CreatePermissionRequest.Validate if the designated constraints aren't met.
+type CreatePermissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePermissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePermissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePermissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePermissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePermissionRequestValidationError) ErrorName() string {
+	return "CreatePermissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePermissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePermissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePermissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePermissionRequestValidationError{}
+
+// Validate checks the field values on CreatePermissionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePermissionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePermissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePermissionResponseMultiError, or nil if none found.
+func (m *CreatePermissionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePermissionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreatePermissionResponseValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreatePermissionResponseValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreatePermissionResponseValidationError{
+					field:  fmt.Sprintf("Permissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreatePermissionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePermissionResponseMultiError is an error wrapping multiple validation
+// errors returned by CreatePermissionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePermissionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePermissionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePermissionResponseMultiError) AllErrors() []error { return m }
+
+// CreatePermissionResponseValidationError is the validation error returned by
+// CreatePermissionResponse.Validate if the designated constraints aren't met.
+type CreatePermissionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePermissionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePermissionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePermissionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePermissionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePermissionResponseValidationError) ErrorName() string {
+	return "CreatePermissionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePermissionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePermissionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePermissionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePermissionResponseValidationError{}
+
+// Validate checks the field values on UpdatePermissionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePermissionRequest) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on UpdatePermissionRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdatePermissionRequestMultiError, or nil if none found.
-func (m *UpdatePermissionRequest) ValidateAll() error {
+// UpdatePermissionRequestMultiError, or nil if none found.
+func (m *UpdatePermissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePermissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePermissionRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePermissionRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePermissionRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdatePermissionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePermissionRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdatePermissionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePermissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePermissionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePermissionRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePermissionRequestValidationError is the validation error returned by
+// UpdatePermissionRequest.Validate if the designated constraints aren't met.
+type UpdatePermissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePermissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePermissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePermissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePermissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePermissionRequestValidationError) ErrorName() string {
+	return "UpdatePermissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePermissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePermissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePermissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePermissionRequestValidationError{}
+
+// Validate checks the field values on UpdatePermissionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePermissionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePermissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePermissionResponseMultiError, or nil if none found.
+func (m *UpdatePermissionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePermissionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPermission()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePermissionResponseValidationError{
+					field:  "Permission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePermissionResponseValidationError{
+					field:  "Permission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPermission()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePermissionResponseValidationError{
+				field:  "Permission",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdatePermissionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePermissionResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdatePermissionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePermissionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePermissionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePermissionResponseMultiError) AllErrors() []error { return m }
+
+// UpdatePermissionResponseValidationError is the validation error returned by
+// UpdatePermissionResponse.Validate if the designated constraints aren't met.
+type UpdatePermissionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePermissionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePermissionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePermissionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePermissionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePermissionResponseValidationError) ErrorName() string {
+	return "UpdatePermissionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePermissionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePermissionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePermissionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePermissionResponseValidationError{}
+
+// Validate checks the field values on DeletePermissionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePermissionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePermissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePermissionRequestMultiError, or nil if none found.
+func (m *DeletePermissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePermissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeletePermissionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePermissionRequestMultiError is an error wrapping multiple validation
+// errors returned by DeletePermissionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePermissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePermissionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePermissionRequestMultiError) AllErrors() []error { return m }
+
+// DeletePermissionRequestValidationError is the validation error returned by
+// DeletePermissionRequest.Validate if the designated constraints aren't met.
+type DeletePermissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePermissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePermissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePermissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePermissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePermissionRequestValidationError) ErrorName() string {
+	return "DeletePermissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePermissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePermissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePermissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePermissionRequestValidationError{}
+
+// Validate checks the field values on DeletePermissionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePermissionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePermissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePermissionResponseMultiError, or nil if none found.
+func (m *DeletePermissionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePermissionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeletePermissionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePermissionResponseMultiError is an error wrapping multiple validation
+// errors returned by DeletePermissionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePermissionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePermissionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePermissionResponseMultiError) AllErrors() []error { return m }
+
+// DeletePermissionResponseValidationError is the validation error returned by
+// DeletePermissionResponse.Validate if the designated constraints aren't met.
+type DeletePermissionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePermissionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePermissionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePermissionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePermissionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePermissionResponseValidationError) ErrorName() string {
+	return "DeletePermissionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePermissionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePermissionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePermissionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePermissionResponseValidationError{}
+
+// Validate checks the field values on ListPreferencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPreferencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPreferencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPreferencesRequestMultiError, or nil if none found.
+func (m *ListPreferencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPreferencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListPreferencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPreferencesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPreferencesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPreferencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPreferencesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPreferencesRequestMultiError) AllErrors() []error { return m }
+
+// ListPreferencesRequestValidationError is the validation error returned by
+// ListPreferencesRequest.Validate if the designated constraints aren't met.
+type ListPreferencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPreferencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPreferencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPreferencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPreferencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPreferencesRequestValidationError) ErrorName() string {
+	return "ListPreferencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPreferencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPreferencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPreferencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPreferencesRequestValidationError{}
+
+// Validate checks the field values on ListMailTemplatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMailTemplatesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMailTemplatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMailTemplatesRequestMultiError, or nil if none found.
+func (m *ListMailTemplatesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMailTemplatesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListMailTemplatesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMailTemplatesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMailTemplatesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMailTemplatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMailTemplatesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMailTemplatesRequestMultiError) AllErrors() []error { return m }
+
+// ListMailTemplatesRequestValidationError is the validation error returned by
+// ListMailTemplatesRequest.Validate if the designated constraints aren't met.
+type ListMailTemplatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMailTemplatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMailTemplatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMailTemplatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMailTemplatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMailTemplatesRequestValidationError) ErrorName() string {
+	return "ListMailTemplatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMailTemplatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMailTemplatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMailTemplatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMailTemplatesRequestValidationError{}
+
+// Validate checks the field values on ListMailTemplatesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMailTemplatesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMailTemplatesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMailTemplatesResponseMultiError, or nil if none found.
+func (m *ListMailTemplatesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMailTemplatesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTemplates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMailTemplatesResponseValidationError{
+						field:  fmt.Sprintf("Templates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMailTemplatesResponseValidationError{
+						field:  fmt.Sprintf("Templates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMailTemplatesResponseValidationError{
+					field:  fmt.Sprintf("Templates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListMailTemplatesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMailTemplatesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListMailTemplatesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListMailTemplatesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMailTemplatesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMailTemplatesResponseMultiError) AllErrors() []error { return m }
+
+// ListMailTemplatesResponseValidationError is the validation error returned by
+// ListMailTemplatesResponse.Validate if the designated constraints aren't met.
+type ListMailTemplatesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMailTemplatesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMailTemplatesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMailTemplatesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMailTemplatesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMailTemplatesResponseValidationError) ErrorName() string {
+	return "ListMailTemplatesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMailTemplatesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMailTemplatesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMailTemplatesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMailTemplatesResponseValidationError{}
+
+// Validate checks the field values on SetMailTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetMailTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetMailTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetMailTemplateRequestMultiError, or nil if none found.
+func (m *SetMailTemplateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdatePermissionRequest) validate(all bool) error {
+func (m *SetMailTemplateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := SetMailTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Locale
+
+	// no validation rules for Subject
+
+	// no validation rules for HtmlBody
+
+	// no validation rules for TextBody
+
+	if len(errors) > 0 {
+		return SetMailTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetMailTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by SetMailTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetMailTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetMailTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetMailTemplateRequestMultiError) AllErrors() []error { return m }
+
+// SetMailTemplateRequestValidationError is the validation error returned by
+// SetMailTemplateRequest.Validate if the designated constraints aren't met.
+type SetMailTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetMailTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetMailTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetMailTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetMailTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetMailTemplateRequestValidationError) ErrorName() string {
+	return "SetMailTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetMailTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetMailTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetMailTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetMailTemplateRequestValidationError{}
+
+// Validate checks the field values on SetMailTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetMailTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetMailTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetMailTemplateResponseMultiError, or nil if none found.
+func (m *SetMailTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetMailTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetBody()).(type) {
+		switch v := interface{}(m.GetTemplate()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdatePermissionRequestValidationError{
-					field:  "Body",
+				errors = append(errors, SetMailTemplateResponseValidationError{
+					field:  "Template",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdatePermissionRequestValidationError{
-					field:  "Body",
+				errors = append(errors, SetMailTemplateResponseValidationError{
+					field:  "Template",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdatePermissionRequestValidationError{
-				field:  "Body",
+			return SetMailTemplateResponseValidationError{
+				field:  "Template",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2979,19 +5788,236 @@ func (m *UpdatePermissionRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdatePermissionRequestMultiError(errors)
+		return SetMailTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetMailTemplateResponseMultiError is an error wrapping multiple validation
+// errors returned by SetMailTemplateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetMailTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetMailTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetMailTemplateResponseMultiError) AllErrors() []error { return m }
+
+// SetMailTemplateResponseValidationError is the validation error returned by
+// SetMailTemplateResponse.Validate if the designated constraints aren't met.
+type SetMailTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetMailTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetMailTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetMailTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetMailTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetMailTemplateResponseValidationError) ErrorName() string {
+	return "SetMailTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetMailTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetMailTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetMailTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetMailTemplateResponseValidationError{}
+
+// Validate checks the field values on DeleteMailTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteMailTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteMailTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteMailTemplateRequestMultiError, or nil if none found.
+func (m *DeleteMailTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteMailTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := DeleteMailTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Locale
+
+	if len(errors) > 0 {
+		return DeleteMailTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteMailTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteMailTemplateRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteMailTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteMailTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteMailTemplateRequestMultiError) AllErrors() []error { return m }
+
+// DeleteMailTemplateRequestValidationError is the validation error returned by
+// DeleteMailTemplateRequest.Validate if the designated constraints aren't met.
+type DeleteMailTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteMailTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteMailTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteMailTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteMailTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteMailTemplateRequestValidationError) ErrorName() string {
+	return "DeleteMailTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteMailTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteMailTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteMailTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteMailTemplateRequestValidationError{}
+
+// Validate checks the field values on DeleteMailTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteMailTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteMailTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteMailTemplateResponseMultiError, or nil if none found.
+func (m *DeleteMailTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteMailTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteMailTemplateResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdatePermissionRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdatePermissionRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdatePermissionRequestMultiError []error
+// DeleteMailTemplateResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteMailTemplateResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteMailTemplateResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdatePermissionRequestMultiError) Error() string {
+func (m DeleteMailTemplateResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3000,11 +6026,11 @@ func (m UpdatePermissionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdatePermissionRequestMultiError) AllErrors() []error { return m }
+func (m DeleteMailTemplateResponseMultiError) AllErrors() []error { return m }
 
-// UpdatePermissionRequestValidationError is the validation error returned by
-// UpdatePermissionRequest.Validate if the designated constraints aren't met.
-type UpdatePermissionRequestValidationError struct {
+// DeleteMailTemplateResponseValidationError is the validation error returned
+// by DeleteMailTemplateResponse.Validate if the designated constraints aren't met.
+type DeleteMailTemplateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3012,24 +6038,24 @@ type UpdatePermissionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdatePermissionRequestValidationError) Field() string { return e.field }
+func (e DeleteMailTemplateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdatePermissionRequestValidationError) Reason() string { return e.reason }
+func (e DeleteMailTemplateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdatePermissionRequestValidationError) Cause() error { return e.cause }
+func (e DeleteMailTemplateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdatePermissionRequestValidationError) Key() bool { return e.key }
+func (e DeleteMailTemplateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdatePermissionRequestValidationError) ErrorName() string {
-	return "UpdatePermissionRequestValidationError"
+func (e DeleteMailTemplateResponseValidationError) ErrorName() string {
+	return "DeleteMailTemplateResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdatePermissionRequestValidationError) Error() string {
+func (e DeleteMailTemplateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3041,14 +6067,14 @@ func (e UpdatePermissionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdatePermissionRequest.%s: %s%s",
+		"invalid %sDeleteMailTemplateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdatePermissionRequestValidationError{}
+var _ error = DeleteMailTemplateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3056,73 +6082,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdatePermissionRequestValidationError{}
+} = DeleteMailTemplateResponseValidationError{}
 
-// Validate checks the field values on UpdatePermissionResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on PreviewMailTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdatePermissionResponse) Validate() error {
+func (m *PreviewMailTemplateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdatePermissionResponse with the
+// ValidateAll checks the field values on PreviewMailTemplateRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdatePermissionResponseMultiError, or nil if none found.
-func (m *UpdatePermissionResponse) ValidateAll() error {
+// PreviewMailTemplateRequestMultiError, or nil if none found.
+func (m *PreviewMailTemplateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdatePermissionResponse) validate(all bool) error {
+func (m *PreviewMailTemplateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetPermission()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdatePermissionResponseValidationError{
-					field:  "Permission",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdatePermissionResponseValidationError{
-					field:  "Permission",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := PreviewMailTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetPermission()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdatePermissionResponseValidationError{
-				field:  "Permission",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for Locale
+
 	if len(errors) > 0 {
-		return UpdatePermissionResponseMultiError(errors)
+		return PreviewMailTemplateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdatePermissionResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdatePermissionResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpdatePermissionResponseMultiError []error
+// PreviewMailTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by PreviewMailTemplateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type PreviewMailTemplateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdatePermissionResponseMultiError) Error() string {
+func (m PreviewMailTemplateRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3131,11 +6141,11 @@ func (m UpdatePermissionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdatePermissionResponseMultiError) AllErrors() []error { return m }
+func (m PreviewMailTemplateRequestMultiError) AllErrors() []error { return m }
 
-// UpdatePermissionResponseValidationError is the validation error returned by
-// UpdatePermissionResponse.Validate if the designated constraints aren't met.
-type UpdatePermissionResponseValidationError struct {
+// PreviewMailTemplateRequestValidationError is the validation error returned
+// by PreviewMailTemplateRequest.Validate if the designated constraints aren't met.
+type PreviewMailTemplateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3143,24 +6153,24 @@ type UpdatePermissionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdatePermissionResponseValidationError) Field() string { return e.field }
+func (e PreviewMailTemplateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdatePermissionResponseValidationError) Reason() string { return e.reason }
+func (e PreviewMailTemplateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdatePermissionResponseValidationError) Cause() error { return e.cause }
+func (e PreviewMailTemplateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdatePermissionResponseValidationError) Key() bool { return e.key }
+func (e PreviewMailTemplateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdatePermissionResponseValidationError) ErrorName() string {
-	return "UpdatePermissionResponseValidationError"
+func (e PreviewMailTemplateRequestValidationError) ErrorName() string {
+	return "PreviewMailTemplateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdatePermissionResponseValidationError) Error() string {
+func (e PreviewMailTemplateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3172,14 +6182,14 @@ func (e UpdatePermissionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdatePermissionResponse.%s: %s%s",
+		"invalid %sPreviewMailTemplateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdatePermissionResponseValidationError{}
+var _ error = PreviewMailTemplateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3187,46 +6197,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdatePermissionResponseValidationError{}
+} = PreviewMailTemplateRequestValidationError{}
 
-// Validate checks the field values on DeletePermissionRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on PreviewMailTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeletePermissionRequest) Validate() error {
+func (m *PreviewMailTemplateResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeletePermissionRequest with the
+// ValidateAll checks the field values on PreviewMailTemplateResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeletePermissionRequestMultiError, or nil if none found.
-func (m *DeletePermissionRequest) ValidateAll() error {
+// PreviewMailTemplateResponseMultiError, or nil if none found.
+func (m *PreviewMailTemplateResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeletePermissionRequest) validate(all bool) error {
+func (m *PreviewMailTemplateResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if all {
+		switch v := interface{}(m.GetMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PreviewMailTemplateResponseValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PreviewMailTemplateResponseValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PreviewMailTemplateResponseValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeletePermissionRequestMultiError(errors)
+		return PreviewMailTemplateResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeletePermissionRequestMultiError is an error wrapping multiple validation
-// errors returned by DeletePermissionRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeletePermissionRequestMultiError []error
+// PreviewMailTemplateResponseMultiError is an error wrapping multiple
+// validation errors returned by PreviewMailTemplateResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PreviewMailTemplateResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeletePermissionRequestMultiError) Error() string {
+func (m PreviewMailTemplateResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3235,11 +6272,12 @@ func (m DeletePermissionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeletePermissionRequestMultiError) AllErrors() []error { return m }
+func (m PreviewMailTemplateResponseMultiError) AllErrors() []error { return m }
 
-// DeletePermissionRequestValidationError is the validation error returned by
-// DeletePermissionRequest.Validate if the designated constraints aren't met.
-type DeletePermissionRequestValidationError struct {
+// PreviewMailTemplateResponseValidationError is the validation error returned
+// by PreviewMailTemplateResponse.Validate if the designated constraints
+// aren't met.
+type PreviewMailTemplateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3247,24 +6285,24 @@ type DeletePermissionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeletePermissionRequestValidationError) Field() string { return e.field }
+func (e PreviewMailTemplateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeletePermissionRequestValidationError) Reason() string { return e.reason }
+func (e PreviewMailTemplateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeletePermissionRequestValidationError) Cause() error { return e.cause }
+func (e PreviewMailTemplateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeletePermissionRequestValidationError) Key() bool { return e.key }
+func (e PreviewMailTemplateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeletePermissionRequestValidationError) ErrorName() string {
-	return "DeletePermissionRequestValidationError"
+func (e PreviewMailTemplateResponseValidationError) ErrorName() string {
+	return "PreviewMailTemplateResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeletePermissionRequestValidationError) Error() string {
+func (e PreviewMailTemplateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3276,14 +6314,14 @@ func (e DeletePermissionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeletePermissionRequest.%s: %s%s",
+		"invalid %sPreviewMailTemplateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeletePermissionRequestValidationError{}
+var _ error = PreviewMailTemplateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3291,44 +6329,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeletePermissionRequestValidationError{}
+} = PreviewMailTemplateResponseValidationError{}
 
-// Validate checks the field values on DeletePermissionResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeletePermissionResponse) Validate() error {
+// Validate checks the field values on ListMailsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListMailsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeletePermissionResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListMailsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeletePermissionResponseMultiError, or nil if none found.
-func (m *DeletePermissionResponse) ValidateAll() error {
+// ListMailsRequestMultiError, or nil if none found.
+func (m *ListMailsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeletePermissionResponse) validate(all bool) error {
+func (m *ListMailsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if _, ok := _ListMailsRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListMailsRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [queued sent failed]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 1000 {
+		err := ListMailsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return DeletePermissionResponseMultiError(errors)
+		return ListMailsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeletePermissionResponseMultiError is an error wrapping multiple validation
-// errors returned by DeletePermissionResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DeletePermissionResponseMultiError []error
+// ListMailsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListMailsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListMailsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeletePermissionResponseMultiError) Error() string {
+func (m ListMailsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3337,11 +6397,11 @@ func (m DeletePermissionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeletePermissionResponseMultiError) AllErrors() []error { return m }
+func (m ListMailsRequestMultiError) AllErrors() []error { return m }
 
-// DeletePermissionResponseValidationError is the validation error returned by
-// DeletePermissionResponse.Validate if the designated constraints aren't met.
-type DeletePermissionResponseValidationError struct {
+// ListMailsRequestValidationError is the validation error returned by
+// ListMailsRequest.Validate if the designated constraints aren't met.
+type ListMailsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3349,24 +6409,22 @@ type DeletePermissionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeletePermissionResponseValidationError) Field() string { return e.field }
+func (e ListMailsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeletePermissionResponseValidationError) Reason() string { return e.reason }
+func (e ListMailsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeletePermissionResponseValidationError) Cause() error { return e.cause }
+func (e ListMailsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeletePermissionResponseValidationError) Key() bool { return e.key }
+func (e ListMailsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeletePermissionResponseValidationError) ErrorName() string {
-	return "DeletePermissionResponseValidationError"
-}
+func (e ListMailsRequestValidationError) ErrorName() string { return "ListMailsRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeletePermissionResponseValidationError) Error() string {
+func (e ListMailsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3378,14 +6436,14 @@ func (e DeletePermissionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeletePermissionResponse.%s: %s%s",
+		"invalid %sListMailsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeletePermissionResponseValidationError{}
+var _ error = ListMailsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3393,44 +6451,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeletePermissionResponseValidationError{}
+} = ListMailsRequestValidationError{}
 
-// Validate checks the field values on ListPreferencesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListPreferencesRequest) Validate() error {
+var _ListMailsRequest_Status_InLookup = map[string]struct{}{
+	"queued": {},
+	"sent":   {},
+	"failed": {},
+}
+
+// Validate checks the field values on ListMailsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListMailsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListPreferencesRequest with the rules
+// ValidateAll checks the field values on ListMailsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListPreferencesRequestMultiError, or nil if none found.
-func (m *ListPreferencesRequest) ValidateAll() error {
+// ListMailsResponseMultiError, or nil if none found.
+func (m *ListMailsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListPreferencesRequest) validate(all bool) error {
+func (m *ListMailsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetMails() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMailsResponseValidationError{
+						field:  fmt.Sprintf("Mails[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMailsResponseValidationError{
+						field:  fmt.Sprintf("Mails[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMailsResponseValidationError{
+					field:  fmt.Sprintf("Mails[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return ListPreferencesRequestMultiError(errors)
+		return ListMailsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListPreferencesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListPreferencesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListPreferencesRequestMultiError []error
+// ListMailsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListMailsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListMailsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListPreferencesRequestMultiError) Error() string {
+func (m ListMailsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3439,11 +6537,11 @@ func (m ListPreferencesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListPreferencesRequestMultiError) AllErrors() []error { return m }
+func (m ListMailsResponseMultiError) AllErrors() []error { return m }
 
-// ListPreferencesRequestValidationError is the validation error returned by
-// ListPreferencesRequest.Validate if the designated constraints aren't met.
-type ListPreferencesRequestValidationError struct {
+// ListMailsResponseValidationError is the validation error returned by
+// ListMailsResponse.Validate if the designated constraints aren't met.
+type ListMailsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3451,24 +6549,24 @@ type ListPreferencesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListPreferencesRequestValidationError) Field() string { return e.field }
+func (e ListMailsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListPreferencesRequestValidationError) Reason() string { return e.reason }
+func (e ListMailsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListPreferencesRequestValidationError) Cause() error { return e.cause }
+func (e ListMailsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListPreferencesRequestValidationError) Key() bool { return e.key }
+func (e ListMailsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListPreferencesRequestValidationError) ErrorName() string {
-	return "ListPreferencesRequestValidationError"
+func (e ListMailsResponseValidationError) ErrorName() string {
+	return "ListMailsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListPreferencesRequestValidationError) Error() string {
+func (e ListMailsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3480,14 +6578,14 @@ func (e ListPreferencesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListPreferencesRequest.%s: %s%s",
+		"invalid %sListMailsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListPreferencesRequestValidationError{}
+var _ error = ListMailsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3495,7 +6593,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListPreferencesRequestValidationError{}
+} = ListMailsResponseValidationError{}
 
 // Validate checks the field values on ListPreferencesResponse with the rules
 // defined in the proto definition for this message. If any rules are
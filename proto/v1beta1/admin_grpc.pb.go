@@ -22,6 +22,13 @@ const (
 	AdminService_ListAllUsers_FullMethodName         = "/raystack.frontier.v1beta1.AdminService/ListAllUsers"
 	AdminService_ListGroups_FullMethodName           = "/raystack.frontier.v1beta1.AdminService/ListGroups"
 	AdminService_ListAllOrganizations_FullMethodName = "/raystack.frontier.v1beta1.AdminService/ListAllOrganizations"
+	AdminService_RestoreOrganization_FullMethodName  = "/raystack.frontier.v1beta1.AdminService/RestoreOrganization"
+	AdminService_RestoreProject_FullMethodName       = "/raystack.frontier.v1beta1.AdminService/RestoreProject"
+	AdminService_RestoreUser_FullMethodName          = "/raystack.frontier.v1beta1.AdminService/RestoreUser"
+	AdminService_MergeUser_FullMethodName            = "/raystack.frontier.v1beta1.AdminService/MergeUser"
+	AdminService_ListJobs_FullMethodName             = "/raystack.frontier.v1beta1.AdminService/ListJobs"
+	AdminService_ExportOrganization_FullMethodName   = "/raystack.frontier.v1beta1.AdminService/ExportOrganization"
+	AdminService_ImportOrganization_FullMethodName   = "/raystack.frontier.v1beta1.AdminService/ImportOrganization"
 	AdminService_ListProjects_FullMethodName         = "/raystack.frontier.v1beta1.AdminService/ListProjects"
 	AdminService_ListRelations_FullMethodName        = "/raystack.frontier.v1beta1.AdminService/ListRelations"
 	AdminService_ListResources_FullMethodName        = "/raystack.frontier.v1beta1.AdminService/ListResources"
@@ -34,6 +41,11 @@ const (
 	AdminService_DeletePermission_FullMethodName     = "/raystack.frontier.v1beta1.AdminService/DeletePermission"
 	AdminService_ListPreferences_FullMethodName      = "/raystack.frontier.v1beta1.AdminService/ListPreferences"
 	AdminService_CreatePreferences_FullMethodName    = "/raystack.frontier.v1beta1.AdminService/CreatePreferences"
+	AdminService_ListMailTemplates_FullMethodName    = "/raystack.frontier.v1beta1.AdminService/ListMailTemplates"
+	AdminService_SetMailTemplate_FullMethodName      = "/raystack.frontier.v1beta1.AdminService/SetMailTemplate"
+	AdminService_DeleteMailTemplate_FullMethodName   = "/raystack.frontier.v1beta1.AdminService/DeleteMailTemplate"
+	AdminService_PreviewMailTemplate_FullMethodName  = "/raystack.frontier.v1beta1.AdminService/PreviewMailTemplate"
+	AdminService_ListMails_FullMethodName            = "/raystack.frontier.v1beta1.AdminService/ListMails"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -46,6 +58,13 @@ type AdminServiceClient interface {
 	ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error)
 	// Organizations
 	ListAllOrganizations(ctx context.Context, in *ListAllOrganizationsRequest, opts ...grpc.CallOption) (*ListAllOrganizationsResponse, error)
+	RestoreOrganization(ctx context.Context, in *RestoreOrganizationRequest, opts ...grpc.CallOption) (*RestoreOrganizationResponse, error)
+	RestoreProject(ctx context.Context, in *RestoreProjectRequest, opts ...grpc.CallOption) (*RestoreProjectResponse, error)
+	RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error)
+	MergeUser(ctx context.Context, in *MergeUserRequest, opts ...grpc.CallOption) (*MergeUserResponse, error)
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	ExportOrganization(ctx context.Context, in *ExportOrganizationRequest, opts ...grpc.CallOption) (*ExportOrganizationResponse, error)
+	ImportOrganization(ctx context.Context, in *ImportOrganizationRequest, opts ...grpc.CallOption) (*ImportOrganizationResponse, error)
 	// Projects
 	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error)
 	// Relations
@@ -65,6 +84,11 @@ type AdminServiceClient interface {
 	// Preferences
 	ListPreferences(ctx context.Context, in *ListPreferencesRequest, opts ...grpc.CallOption) (*ListPreferencesResponse, error)
 	CreatePreferences(ctx context.Context, in *CreatePreferencesRequest, opts ...grpc.CallOption) (*CreatePreferencesResponse, error)
+	ListMailTemplates(ctx context.Context, in *ListMailTemplatesRequest, opts ...grpc.CallOption) (*ListMailTemplatesResponse, error)
+	SetMailTemplate(ctx context.Context, in *SetMailTemplateRequest, opts ...grpc.CallOption) (*SetMailTemplateResponse, error)
+	DeleteMailTemplate(ctx context.Context, in *DeleteMailTemplateRequest, opts ...grpc.CallOption) (*DeleteMailTemplateResponse, error)
+	PreviewMailTemplate(ctx context.Context, in *PreviewMailTemplateRequest, opts ...grpc.CallOption) (*PreviewMailTemplateResponse, error)
+	ListMails(ctx context.Context, in *ListMailsRequest, opts ...grpc.CallOption) (*ListMailsResponse, error)
 }
 
 type adminServiceClient struct {
@@ -102,6 +126,69 @@ func (c *adminServiceClient) ListAllOrganizations(ctx context.Context, in *ListA
 	return out, nil
 }
 
+func (c *adminServiceClient) RestoreOrganization(ctx context.Context, in *RestoreOrganizationRequest, opts ...grpc.CallOption) (*RestoreOrganizationResponse, error) {
+	out := new(RestoreOrganizationResponse)
+	err := c.cc.Invoke(ctx, AdminService_RestoreOrganization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RestoreProject(ctx context.Context, in *RestoreProjectRequest, opts ...grpc.CallOption) (*RestoreProjectResponse, error) {
+	out := new(RestoreProjectResponse)
+	err := c.cc.Invoke(ctx, AdminService_RestoreProject_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error) {
+	out := new(RestoreUserResponse)
+	err := c.cc.Invoke(ctx, AdminService_RestoreUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) MergeUser(ctx context.Context, in *MergeUserRequest, opts ...grpc.CallOption) (*MergeUserResponse, error) {
+	out := new(MergeUserResponse)
+	err := c.cc.Invoke(ctx, AdminService_MergeUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListJobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ExportOrganization(ctx context.Context, in *ExportOrganizationRequest, opts ...grpc.CallOption) (*ExportOrganizationResponse, error) {
+	out := new(ExportOrganizationResponse)
+	err := c.cc.Invoke(ctx, AdminService_ExportOrganization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ImportOrganization(ctx context.Context, in *ImportOrganizationRequest, opts ...grpc.CallOption) (*ImportOrganizationResponse, error) {
+	out := new(ImportOrganizationResponse)
+	err := c.cc.Invoke(ctx, AdminService_ImportOrganization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error) {
 	out := new(ListProjectsResponse)
 	err := c.cc.Invoke(ctx, AdminService_ListProjects_FullMethodName, in, out, opts...)
@@ -210,6 +297,51 @@ func (c *adminServiceClient) CreatePreferences(ctx context.Context, in *CreatePr
 	return out, nil
 }
 
+func (c *adminServiceClient) ListMailTemplates(ctx context.Context, in *ListMailTemplatesRequest, opts ...grpc.CallOption) (*ListMailTemplatesResponse, error) {
+	out := new(ListMailTemplatesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListMailTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetMailTemplate(ctx context.Context, in *SetMailTemplateRequest, opts ...grpc.CallOption) (*SetMailTemplateResponse, error) {
+	out := new(SetMailTemplateResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetMailTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteMailTemplate(ctx context.Context, in *DeleteMailTemplateRequest, opts ...grpc.CallOption) (*DeleteMailTemplateResponse, error) {
+	out := new(DeleteMailTemplateResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteMailTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PreviewMailTemplate(ctx context.Context, in *PreviewMailTemplateRequest, opts ...grpc.CallOption) (*PreviewMailTemplateResponse, error) {
+	out := new(PreviewMailTemplateResponse)
+	err := c.cc.Invoke(ctx, AdminService_PreviewMailTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListMails(ctx context.Context, in *ListMailsRequest, opts ...grpc.CallOption) (*ListMailsResponse, error) {
+	out := new(ListMailsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListMails_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -220,6 +352,13 @@ type AdminServiceServer interface {
 	ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error)
 	// Organizations
 	ListAllOrganizations(context.Context, *ListAllOrganizationsRequest) (*ListAllOrganizationsResponse, error)
+	RestoreOrganization(context.Context, *RestoreOrganizationRequest) (*RestoreOrganizationResponse, error)
+	RestoreProject(context.Context, *RestoreProjectRequest) (*RestoreProjectResponse, error)
+	RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserResponse, error)
+	MergeUser(context.Context, *MergeUserRequest) (*MergeUserResponse, error)
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	ExportOrganization(context.Context, *ExportOrganizationRequest) (*ExportOrganizationResponse, error)
+	ImportOrganization(context.Context, *ImportOrganizationRequest) (*ImportOrganizationResponse, error)
 	// Projects
 	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
 	// Relations
@@ -239,6 +378,11 @@ type AdminServiceServer interface {
 	// Preferences
 	ListPreferences(context.Context, *ListPreferencesRequest) (*ListPreferencesResponse, error)
 	CreatePreferences(context.Context, *CreatePreferencesRequest) (*CreatePreferencesResponse, error)
+	ListMailTemplates(context.Context, *ListMailTemplatesRequest) (*ListMailTemplatesResponse, error)
+	SetMailTemplate(context.Context, *SetMailTemplateRequest) (*SetMailTemplateResponse, error)
+	DeleteMailTemplate(context.Context, *DeleteMailTemplateRequest) (*DeleteMailTemplateResponse, error)
+	PreviewMailTemplate(context.Context, *PreviewMailTemplateRequest) (*PreviewMailTemplateResponse, error)
+	ListMails(context.Context, *ListMailsRequest) (*ListMailsResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -255,6 +399,27 @@ func (UnimplementedAdminServiceServer) ListGroups(context.Context, *ListGroupsRe
 func (UnimplementedAdminServiceServer) ListAllOrganizations(context.Context, *ListAllOrganizationsRequest) (*ListAllOrganizationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAllOrganizations not implemented")
 }
+func (UnimplementedAdminServiceServer) RestoreOrganization(context.Context, *RestoreOrganizationRequest) (*RestoreOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreOrganization not implemented")
+}
+func (UnimplementedAdminServiceServer) RestoreProject(context.Context, *RestoreProjectRequest) (*RestoreProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreProject not implemented")
+}
+func (UnimplementedAdminServiceServer) RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreUser not implemented")
+}
+func (UnimplementedAdminServiceServer) MergeUser(context.Context, *MergeUserRequest) (*MergeUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeUser not implemented")
+}
+func (UnimplementedAdminServiceServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedAdminServiceServer) ExportOrganization(context.Context, *ExportOrganizationRequest) (*ExportOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportOrganization not implemented")
+}
+func (UnimplementedAdminServiceServer) ImportOrganization(context.Context, *ImportOrganizationRequest) (*ImportOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportOrganization not implemented")
+}
 func (UnimplementedAdminServiceServer) ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProjects not implemented")
 }
@@ -291,6 +456,21 @@ func (UnimplementedAdminServiceServer) ListPreferences(context.Context, *ListPre
 func (UnimplementedAdminServiceServer) CreatePreferences(context.Context, *CreatePreferencesRequest) (*CreatePreferencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePreferences not implemented")
 }
+func (UnimplementedAdminServiceServer) ListMailTemplates(context.Context, *ListMailTemplatesRequest) (*ListMailTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMailTemplates not implemented")
+}
+func (UnimplementedAdminServiceServer) SetMailTemplate(context.Context, *SetMailTemplateRequest) (*SetMailTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMailTemplate not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteMailTemplate(context.Context, *DeleteMailTemplateRequest) (*DeleteMailTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMailTemplate not implemented")
+}
+func (UnimplementedAdminServiceServer) PreviewMailTemplate(context.Context, *PreviewMailTemplateRequest) (*PreviewMailTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewMailTemplate not implemented")
+}
+func (UnimplementedAdminServiceServer) ListMails(context.Context, *ListMailsRequest) (*ListMailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMails not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -358,6 +538,132 @@ func _AdminService_ListAllOrganizations_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_RestoreOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RestoreOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RestoreOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RestoreOrganization(ctx, req.(*RestoreOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RestoreProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RestoreProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RestoreProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RestoreProject(ctx, req.(*RestoreProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RestoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RestoreUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RestoreUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RestoreUser(ctx, req.(*RestoreUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_MergeUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).MergeUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_MergeUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).MergeUser(ctx, req.(*MergeUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ExportOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ExportOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ExportOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ExportOrganization(ctx, req.(*ExportOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ImportOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ImportOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ImportOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ImportOrganization(ctx, req.(*ImportOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProjectsRequest)
 	if err := dec(in); err != nil {
@@ -574,6 +880,96 @@ func _AdminService_CreatePreferences_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListMailTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMailTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListMailTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListMailTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListMailTemplates(ctx, req.(*ListMailTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetMailTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMailTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetMailTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetMailTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetMailTemplate(ctx, req.(*SetMailTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteMailTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMailTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteMailTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteMailTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteMailTemplate(ctx, req.(*DeleteMailTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PreviewMailTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewMailTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PreviewMailTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PreviewMailTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PreviewMailTemplate(ctx, req.(*PreviewMailTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListMails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListMails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListMails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListMails(ctx, req.(*ListMailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -593,6 +989,34 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAllOrganizations",
 			Handler:    _AdminService_ListAllOrganizations_Handler,
 		},
+		{
+			MethodName: "RestoreOrganization",
+			Handler:    _AdminService_RestoreOrganization_Handler,
+		},
+		{
+			MethodName: "RestoreProject",
+			Handler:    _AdminService_RestoreProject_Handler,
+		},
+		{
+			MethodName: "RestoreUser",
+			Handler:    _AdminService_RestoreUser_Handler,
+		},
+		{
+			MethodName: "MergeUser",
+			Handler:    _AdminService_MergeUser_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _AdminService_ListJobs_Handler,
+		},
+		{
+			MethodName: "ExportOrganization",
+			Handler:    _AdminService_ExportOrganization_Handler,
+		},
+		{
+			MethodName: "ImportOrganization",
+			Handler:    _AdminService_ImportOrganization_Handler,
+		},
 		{
 			MethodName: "ListProjects",
 			Handler:    _AdminService_ListProjects_Handler,
@@ -641,6 +1065,26 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreatePreferences",
 			Handler:    _AdminService_CreatePreferences_Handler,
 		},
+		{
+			MethodName: "ListMailTemplates",
+			Handler:    _AdminService_ListMailTemplates_Handler,
+		},
+		{
+			MethodName: "SetMailTemplate",
+			Handler:    _AdminService_SetMailTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteMailTemplate",
+			Handler:    _AdminService_DeleteMailTemplate_Handler,
+		},
+		{
+			MethodName: "PreviewMailTemplate",
+			Handler:    _AdminService_PreviewMailTemplate_Handler,
+		},
+		{
+			MethodName: "ListMails",
+			Handler:    _AdminService_ListMails_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "raystack/frontier/v1beta1/admin.proto",
@@ -19,127 +19,169 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	FrontierService_ListUsers_FullMethodName                      = "/raystack.frontier.v1beta1.FrontierService/ListUsers"
-	FrontierService_CreateUser_FullMethodName                     = "/raystack.frontier.v1beta1.FrontierService/CreateUser"
-	FrontierService_GetUser_FullMethodName                        = "/raystack.frontier.v1beta1.FrontierService/GetUser"
-	FrontierService_ListUserGroups_FullMethodName                 = "/raystack.frontier.v1beta1.FrontierService/ListUserGroups"
-	FrontierService_ListCurrentUserGroups_FullMethodName          = "/raystack.frontier.v1beta1.FrontierService/ListCurrentUserGroups"
-	FrontierService_GetCurrentUser_FullMethodName                 = "/raystack.frontier.v1beta1.FrontierService/GetCurrentUser"
-	FrontierService_UpdateUser_FullMethodName                     = "/raystack.frontier.v1beta1.FrontierService/UpdateUser"
-	FrontierService_UpdateCurrentUser_FullMethodName              = "/raystack.frontier.v1beta1.FrontierService/UpdateCurrentUser"
-	FrontierService_EnableUser_FullMethodName                     = "/raystack.frontier.v1beta1.FrontierService/EnableUser"
-	FrontierService_DisableUser_FullMethodName                    = "/raystack.frontier.v1beta1.FrontierService/DisableUser"
-	FrontierService_DeleteUser_FullMethodName                     = "/raystack.frontier.v1beta1.FrontierService/DeleteUser"
-	FrontierService_ListOrganizationsByUser_FullMethodName        = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationsByUser"
-	FrontierService_ListOrganizationsByCurrentUser_FullMethodName = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationsByCurrentUser"
-	FrontierService_ListProjectsByUser_FullMethodName             = "/raystack.frontier.v1beta1.FrontierService/ListProjectsByUser"
-	FrontierService_ListProjectsByCurrentUser_FullMethodName      = "/raystack.frontier.v1beta1.FrontierService/ListProjectsByCurrentUser"
-	FrontierService_ListUserInvitations_FullMethodName            = "/raystack.frontier.v1beta1.FrontierService/ListUserInvitations"
-	FrontierService_ListCurrentUserInvitations_FullMethodName     = "/raystack.frontier.v1beta1.FrontierService/ListCurrentUserInvitations"
-	FrontierService_ListServiceUsers_FullMethodName               = "/raystack.frontier.v1beta1.FrontierService/ListServiceUsers"
-	FrontierService_CreateServiceUser_FullMethodName              = "/raystack.frontier.v1beta1.FrontierService/CreateServiceUser"
-	FrontierService_GetServiceUser_FullMethodName                 = "/raystack.frontier.v1beta1.FrontierService/GetServiceUser"
-	FrontierService_DeleteServiceUser_FullMethodName              = "/raystack.frontier.v1beta1.FrontierService/DeleteServiceUser"
-	FrontierService_CreateServiceUserKey_FullMethodName           = "/raystack.frontier.v1beta1.FrontierService/CreateServiceUserKey"
-	FrontierService_ListServiceUserKeys_FullMethodName            = "/raystack.frontier.v1beta1.FrontierService/ListServiceUserKeys"
-	FrontierService_GetServiceUserKey_FullMethodName              = "/raystack.frontier.v1beta1.FrontierService/GetServiceUserKey"
-	FrontierService_DeleteServiceUserKey_FullMethodName           = "/raystack.frontier.v1beta1.FrontierService/DeleteServiceUserKey"
-	FrontierService_CreateServiceUserSecret_FullMethodName        = "/raystack.frontier.v1beta1.FrontierService/CreateServiceUserSecret"
-	FrontierService_ListServiceUserSecrets_FullMethodName         = "/raystack.frontier.v1beta1.FrontierService/ListServiceUserSecrets"
-	FrontierService_DeleteServiceUserSecret_FullMethodName        = "/raystack.frontier.v1beta1.FrontierService/DeleteServiceUserSecret"
-	FrontierService_ListOrganizationGroups_FullMethodName         = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationGroups"
-	FrontierService_CreateGroup_FullMethodName                    = "/raystack.frontier.v1beta1.FrontierService/CreateGroup"
-	FrontierService_GetGroup_FullMethodName                       = "/raystack.frontier.v1beta1.FrontierService/GetGroup"
-	FrontierService_UpdateGroup_FullMethodName                    = "/raystack.frontier.v1beta1.FrontierService/UpdateGroup"
-	FrontierService_ListGroupUsers_FullMethodName                 = "/raystack.frontier.v1beta1.FrontierService/ListGroupUsers"
-	FrontierService_AddGroupUsers_FullMethodName                  = "/raystack.frontier.v1beta1.FrontierService/AddGroupUsers"
-	FrontierService_RemoveGroupUser_FullMethodName                = "/raystack.frontier.v1beta1.FrontierService/RemoveGroupUser"
-	FrontierService_EnableGroup_FullMethodName                    = "/raystack.frontier.v1beta1.FrontierService/EnableGroup"
-	FrontierService_DisableGroup_FullMethodName                   = "/raystack.frontier.v1beta1.FrontierService/DisableGroup"
-	FrontierService_DeleteGroup_FullMethodName                    = "/raystack.frontier.v1beta1.FrontierService/DeleteGroup"
-	FrontierService_ListRoles_FullMethodName                      = "/raystack.frontier.v1beta1.FrontierService/ListRoles"
-	FrontierService_ListOrganizationRoles_FullMethodName          = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationRoles"
-	FrontierService_CreateOrganizationRole_FullMethodName         = "/raystack.frontier.v1beta1.FrontierService/CreateOrganizationRole"
-	FrontierService_GetOrganizationRole_FullMethodName            = "/raystack.frontier.v1beta1.FrontierService/GetOrganizationRole"
-	FrontierService_UpdateOrganizationRole_FullMethodName         = "/raystack.frontier.v1beta1.FrontierService/UpdateOrganizationRole"
-	FrontierService_DeleteOrganizationRole_FullMethodName         = "/raystack.frontier.v1beta1.FrontierService/DeleteOrganizationRole"
-	FrontierService_ListOrganizations_FullMethodName              = "/raystack.frontier.v1beta1.FrontierService/ListOrganizations"
-	FrontierService_CreateOrganization_FullMethodName             = "/raystack.frontier.v1beta1.FrontierService/CreateOrganization"
-	FrontierService_GetOrganization_FullMethodName                = "/raystack.frontier.v1beta1.FrontierService/GetOrganization"
-	FrontierService_UpdateOrganization_FullMethodName             = "/raystack.frontier.v1beta1.FrontierService/UpdateOrganization"
-	FrontierService_ListOrganizationProjects_FullMethodName       = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationProjects"
-	FrontierService_ListOrganizationAdmins_FullMethodName         = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationAdmins"
-	FrontierService_ListOrganizationUsers_FullMethodName          = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationUsers"
-	FrontierService_AddOrganizationUsers_FullMethodName           = "/raystack.frontier.v1beta1.FrontierService/AddOrganizationUsers"
-	FrontierService_RemoveOrganizationUser_FullMethodName         = "/raystack.frontier.v1beta1.FrontierService/RemoveOrganizationUser"
-	FrontierService_ListOrganizationServiceUsers_FullMethodName   = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationServiceUsers"
-	FrontierService_ListOrganizationInvitations_FullMethodName    = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationInvitations"
-	FrontierService_CreateOrganizationInvitation_FullMethodName   = "/raystack.frontier.v1beta1.FrontierService/CreateOrganizationInvitation"
-	FrontierService_GetOrganizationInvitation_FullMethodName      = "/raystack.frontier.v1beta1.FrontierService/GetOrganizationInvitation"
-	FrontierService_AcceptOrganizationInvitation_FullMethodName   = "/raystack.frontier.v1beta1.FrontierService/AcceptOrganizationInvitation"
-	FrontierService_DeleteOrganizationInvitation_FullMethodName   = "/raystack.frontier.v1beta1.FrontierService/DeleteOrganizationInvitation"
-	FrontierService_ListOrganizationDomains_FullMethodName        = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationDomains"
-	FrontierService_CreateOrganizationDomain_FullMethodName       = "/raystack.frontier.v1beta1.FrontierService/CreateOrganizationDomain"
-	FrontierService_DeleteOrganizationDomain_FullMethodName       = "/raystack.frontier.v1beta1.FrontierService/DeleteOrganizationDomain"
-	FrontierService_GetOrganizationDomain_FullMethodName          = "/raystack.frontier.v1beta1.FrontierService/GetOrganizationDomain"
-	FrontierService_VerifyOrganizationDomain_FullMethodName       = "/raystack.frontier.v1beta1.FrontierService/VerifyOrganizationDomain"
-	FrontierService_JoinOrganization_FullMethodName               = "/raystack.frontier.v1beta1.FrontierService/JoinOrganization"
-	FrontierService_EnableOrganization_FullMethodName             = "/raystack.frontier.v1beta1.FrontierService/EnableOrganization"
-	FrontierService_DisableOrganization_FullMethodName            = "/raystack.frontier.v1beta1.FrontierService/DisableOrganization"
-	FrontierService_DeleteOrganization_FullMethodName             = "/raystack.frontier.v1beta1.FrontierService/DeleteOrganization"
-	FrontierService_CreateProject_FullMethodName                  = "/raystack.frontier.v1beta1.FrontierService/CreateProject"
-	FrontierService_GetProject_FullMethodName                     = "/raystack.frontier.v1beta1.FrontierService/GetProject"
-	FrontierService_UpdateProject_FullMethodName                  = "/raystack.frontier.v1beta1.FrontierService/UpdateProject"
-	FrontierService_ListProjectAdmins_FullMethodName              = "/raystack.frontier.v1beta1.FrontierService/ListProjectAdmins"
-	FrontierService_ListProjectUsers_FullMethodName               = "/raystack.frontier.v1beta1.FrontierService/ListProjectUsers"
-	FrontierService_ListProjectServiceUsers_FullMethodName        = "/raystack.frontier.v1beta1.FrontierService/ListProjectServiceUsers"
-	FrontierService_ListProjectGroups_FullMethodName              = "/raystack.frontier.v1beta1.FrontierService/ListProjectGroups"
-	FrontierService_EnableProject_FullMethodName                  = "/raystack.frontier.v1beta1.FrontierService/EnableProject"
-	FrontierService_DisableProject_FullMethodName                 = "/raystack.frontier.v1beta1.FrontierService/DisableProject"
-	FrontierService_DeleteProject_FullMethodName                  = "/raystack.frontier.v1beta1.FrontierService/DeleteProject"
-	FrontierService_CreatePolicy_FullMethodName                   = "/raystack.frontier.v1beta1.FrontierService/CreatePolicy"
-	FrontierService_GetPolicy_FullMethodName                      = "/raystack.frontier.v1beta1.FrontierService/GetPolicy"
-	FrontierService_UpdatePolicy_FullMethodName                   = "/raystack.frontier.v1beta1.FrontierService/UpdatePolicy"
-	FrontierService_DeletePolicy_FullMethodName                   = "/raystack.frontier.v1beta1.FrontierService/DeletePolicy"
-	FrontierService_CreateRelation_FullMethodName                 = "/raystack.frontier.v1beta1.FrontierService/CreateRelation"
-	FrontierService_GetRelation_FullMethodName                    = "/raystack.frontier.v1beta1.FrontierService/GetRelation"
-	FrontierService_DeleteRelation_FullMethodName                 = "/raystack.frontier.v1beta1.FrontierService/DeleteRelation"
-	FrontierService_ListPermissions_FullMethodName                = "/raystack.frontier.v1beta1.FrontierService/ListPermissions"
-	FrontierService_GetPermission_FullMethodName                  = "/raystack.frontier.v1beta1.FrontierService/GetPermission"
-	FrontierService_ListNamespaces_FullMethodName                 = "/raystack.frontier.v1beta1.FrontierService/ListNamespaces"
-	FrontierService_GetNamespace_FullMethodName                   = "/raystack.frontier.v1beta1.FrontierService/GetNamespace"
-	FrontierService_ListProjectResources_FullMethodName           = "/raystack.frontier.v1beta1.FrontierService/ListProjectResources"
-	FrontierService_CreateProjectResource_FullMethodName          = "/raystack.frontier.v1beta1.FrontierService/CreateProjectResource"
-	FrontierService_GetProjectResource_FullMethodName             = "/raystack.frontier.v1beta1.FrontierService/GetProjectResource"
-	FrontierService_UpdateProjectResource_FullMethodName          = "/raystack.frontier.v1beta1.FrontierService/UpdateProjectResource"
-	FrontierService_DeleteProjectResource_FullMethodName          = "/raystack.frontier.v1beta1.FrontierService/DeleteProjectResource"
-	FrontierService_CheckResourcePermission_FullMethodName        = "/raystack.frontier.v1beta1.FrontierService/CheckResourcePermission"
-	FrontierService_BatchCheckPermission_FullMethodName           = "/raystack.frontier.v1beta1.FrontierService/BatchCheckPermission"
-	FrontierService_GetJWKs_FullMethodName                        = "/raystack.frontier.v1beta1.FrontierService/GetJWKs"
-	FrontierService_ListAuthStrategies_FullMethodName             = "/raystack.frontier.v1beta1.FrontierService/ListAuthStrategies"
-	FrontierService_Authenticate_FullMethodName                   = "/raystack.frontier.v1beta1.FrontierService/Authenticate"
-	FrontierService_AuthCallback_FullMethodName                   = "/raystack.frontier.v1beta1.FrontierService/AuthCallback"
-	FrontierService_AuthToken_FullMethodName                      = "/raystack.frontier.v1beta1.FrontierService/AuthToken"
-	FrontierService_AuthLogout_FullMethodName                     = "/raystack.frontier.v1beta1.FrontierService/AuthLogout"
-	FrontierService_ListMetaSchemas_FullMethodName                = "/raystack.frontier.v1beta1.FrontierService/ListMetaSchemas"
-	FrontierService_CreateMetaSchema_FullMethodName               = "/raystack.frontier.v1beta1.FrontierService/CreateMetaSchema"
-	FrontierService_GetMetaSchema_FullMethodName                  = "/raystack.frontier.v1beta1.FrontierService/GetMetaSchema"
-	FrontierService_UpdateMetaSchema_FullMethodName               = "/raystack.frontier.v1beta1.FrontierService/UpdateMetaSchema"
-	FrontierService_DeleteMetaSchema_FullMethodName               = "/raystack.frontier.v1beta1.FrontierService/DeleteMetaSchema"
-	FrontierService_ListOrganizationAuditLogs_FullMethodName      = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationAuditLogs"
-	FrontierService_CreateOrganizationAuditLogs_FullMethodName    = "/raystack.frontier.v1beta1.FrontierService/CreateOrganizationAuditLogs"
-	FrontierService_GetOrganizationAuditLog_FullMethodName        = "/raystack.frontier.v1beta1.FrontierService/GetOrganizationAuditLog"
-	FrontierService_DescribePreferences_FullMethodName            = "/raystack.frontier.v1beta1.FrontierService/DescribePreferences"
-	FrontierService_CreateOrganizationPreferences_FullMethodName  = "/raystack.frontier.v1beta1.FrontierService/CreateOrganizationPreferences"
-	FrontierService_ListOrganizationPreferences_FullMethodName    = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationPreferences"
-	FrontierService_CreateProjectPreferences_FullMethodName       = "/raystack.frontier.v1beta1.FrontierService/CreateProjectPreferences"
-	FrontierService_ListProjectPreferences_FullMethodName         = "/raystack.frontier.v1beta1.FrontierService/ListProjectPreferences"
-	FrontierService_CreateGroupPreferences_FullMethodName         = "/raystack.frontier.v1beta1.FrontierService/CreateGroupPreferences"
-	FrontierService_ListGroupPreferences_FullMethodName           = "/raystack.frontier.v1beta1.FrontierService/ListGroupPreferences"
-	FrontierService_CreateUserPreferences_FullMethodName          = "/raystack.frontier.v1beta1.FrontierService/CreateUserPreferences"
-	FrontierService_ListUserPreferences_FullMethodName            = "/raystack.frontier.v1beta1.FrontierService/ListUserPreferences"
-	FrontierService_CreateCurrentUserPreferences_FullMethodName   = "/raystack.frontier.v1beta1.FrontierService/CreateCurrentUserPreferences"
-	FrontierService_ListCurrentUserPreferences_FullMethodName     = "/raystack.frontier.v1beta1.FrontierService/ListCurrentUserPreferences"
+	FrontierService_ListUsers_FullMethodName                        = "/raystack.frontier.v1beta1.FrontierService/ListUsers"
+	FrontierService_CreateUser_FullMethodName                       = "/raystack.frontier.v1beta1.FrontierService/CreateUser"
+	FrontierService_GetUser_FullMethodName                          = "/raystack.frontier.v1beta1.FrontierService/GetUser"
+	FrontierService_ListUserGroups_FullMethodName                   = "/raystack.frontier.v1beta1.FrontierService/ListUserGroups"
+	FrontierService_ListCurrentUserGroups_FullMethodName            = "/raystack.frontier.v1beta1.FrontierService/ListCurrentUserGroups"
+	FrontierService_GetCurrentUser_FullMethodName                   = "/raystack.frontier.v1beta1.FrontierService/GetCurrentUser"
+	FrontierService_UpdateUser_FullMethodName                       = "/raystack.frontier.v1beta1.FrontierService/UpdateUser"
+	FrontierService_UpdateCurrentUser_FullMethodName                = "/raystack.frontier.v1beta1.FrontierService/UpdateCurrentUser"
+	FrontierService_ChangeCurrentUserEmail_FullMethodName           = "/raystack.frontier.v1beta1.FrontierService/ChangeCurrentUserEmail"
+	FrontierService_VerifyCurrentUserEmail_FullMethodName           = "/raystack.frontier.v1beta1.FrontierService/VerifyCurrentUserEmail"
+	FrontierService_AddCurrentUserEmail_FullMethodName              = "/raystack.frontier.v1beta1.FrontierService/AddCurrentUserEmail"
+	FrontierService_ListCurrentUserEmails_FullMethodName            = "/raystack.frontier.v1beta1.FrontierService/ListCurrentUserEmails"
+	FrontierService_RemoveCurrentUserEmail_FullMethodName           = "/raystack.frontier.v1beta1.FrontierService/RemoveCurrentUserEmail"
+	FrontierService_ListCurrentUserIdentities_FullMethodName        = "/raystack.frontier.v1beta1.FrontierService/ListCurrentUserIdentities"
+	FrontierService_UnlinkCurrentUserIdentity_FullMethodName        = "/raystack.frontier.v1beta1.FrontierService/UnlinkCurrentUserIdentity"
+	FrontierService_CreateCurrentUserPersonalToken_FullMethodName   = "/raystack.frontier.v1beta1.FrontierService/CreateCurrentUserPersonalToken"
+	FrontierService_ListCurrentUserPersonalTokens_FullMethodName    = "/raystack.frontier.v1beta1.FrontierService/ListCurrentUserPersonalTokens"
+	FrontierService_RevokeCurrentUserPersonalToken_FullMethodName   = "/raystack.frontier.v1beta1.FrontierService/RevokeCurrentUserPersonalToken"
+	FrontierService_EnableUser_FullMethodName                       = "/raystack.frontier.v1beta1.FrontierService/EnableUser"
+	FrontierService_DisableUser_FullMethodName                      = "/raystack.frontier.v1beta1.FrontierService/DisableUser"
+	FrontierService_DeleteUser_FullMethodName                       = "/raystack.frontier.v1beta1.FrontierService/DeleteUser"
+	FrontierService_DeleteCurrentUser_FullMethodName                = "/raystack.frontier.v1beta1.FrontierService/DeleteCurrentUser"
+	FrontierService_ExportCurrentUserData_FullMethodName            = "/raystack.frontier.v1beta1.FrontierService/ExportCurrentUserData"
+	FrontierService_ListOrganizationsByUser_FullMethodName          = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationsByUser"
+	FrontierService_ListOrganizationsByCurrentUser_FullMethodName   = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationsByCurrentUser"
+	FrontierService_ListProjectsByUser_FullMethodName               = "/raystack.frontier.v1beta1.FrontierService/ListProjectsByUser"
+	FrontierService_ListProjectsByCurrentUser_FullMethodName        = "/raystack.frontier.v1beta1.FrontierService/ListProjectsByCurrentUser"
+	FrontierService_ListUserInvitations_FullMethodName              = "/raystack.frontier.v1beta1.FrontierService/ListUserInvitations"
+	FrontierService_ListCurrentUserInvitations_FullMethodName       = "/raystack.frontier.v1beta1.FrontierService/ListCurrentUserInvitations"
+	FrontierService_ListServiceUsers_FullMethodName                 = "/raystack.frontier.v1beta1.FrontierService/ListServiceUsers"
+	FrontierService_CreateServiceUser_FullMethodName                = "/raystack.frontier.v1beta1.FrontierService/CreateServiceUser"
+	FrontierService_GetServiceUser_FullMethodName                   = "/raystack.frontier.v1beta1.FrontierService/GetServiceUser"
+	FrontierService_DeleteServiceUser_FullMethodName                = "/raystack.frontier.v1beta1.FrontierService/DeleteServiceUser"
+	FrontierService_CreateServiceUserKey_FullMethodName             = "/raystack.frontier.v1beta1.FrontierService/CreateServiceUserKey"
+	FrontierService_ListServiceUserKeys_FullMethodName              = "/raystack.frontier.v1beta1.FrontierService/ListServiceUserKeys"
+	FrontierService_GetServiceUserKey_FullMethodName                = "/raystack.frontier.v1beta1.FrontierService/GetServiceUserKey"
+	FrontierService_DeleteServiceUserKey_FullMethodName             = "/raystack.frontier.v1beta1.FrontierService/DeleteServiceUserKey"
+	FrontierService_CreateServiceUserSecret_FullMethodName          = "/raystack.frontier.v1beta1.FrontierService/CreateServiceUserSecret"
+	FrontierService_ListServiceUserSecrets_FullMethodName           = "/raystack.frontier.v1beta1.FrontierService/ListServiceUserSecrets"
+	FrontierService_DeleteServiceUserSecret_FullMethodName          = "/raystack.frontier.v1beta1.FrontierService/DeleteServiceUserSecret"
+	FrontierService_CreateServiceUserTrust_FullMethodName           = "/raystack.frontier.v1beta1.FrontierService/CreateServiceUserTrust"
+	FrontierService_ListServiceUserTrusts_FullMethodName            = "/raystack.frontier.v1beta1.FrontierService/ListServiceUserTrusts"
+	FrontierService_DeleteServiceUserTrust_FullMethodName           = "/raystack.frontier.v1beta1.FrontierService/DeleteServiceUserTrust"
+	FrontierService_ListOrganizationGroups_FullMethodName           = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationGroups"
+	FrontierService_CreateGroup_FullMethodName                      = "/raystack.frontier.v1beta1.FrontierService/CreateGroup"
+	FrontierService_GetGroup_FullMethodName                         = "/raystack.frontier.v1beta1.FrontierService/GetGroup"
+	FrontierService_UpdateGroup_FullMethodName                      = "/raystack.frontier.v1beta1.FrontierService/UpdateGroup"
+	FrontierService_ListGroupUsers_FullMethodName                   = "/raystack.frontier.v1beta1.FrontierService/ListGroupUsers"
+	FrontierService_AddGroupUsers_FullMethodName                    = "/raystack.frontier.v1beta1.FrontierService/AddGroupUsers"
+	FrontierService_RemoveGroupUser_FullMethodName                  = "/raystack.frontier.v1beta1.FrontierService/RemoveGroupUser"
+	FrontierService_EnableGroup_FullMethodName                      = "/raystack.frontier.v1beta1.FrontierService/EnableGroup"
+	FrontierService_DisableGroup_FullMethodName                     = "/raystack.frontier.v1beta1.FrontierService/DisableGroup"
+	FrontierService_DeleteGroup_FullMethodName                      = "/raystack.frontier.v1beta1.FrontierService/DeleteGroup"
+	FrontierService_ListRoles_FullMethodName                        = "/raystack.frontier.v1beta1.FrontierService/ListRoles"
+	FrontierService_ListOrganizationRoles_FullMethodName            = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationRoles"
+	FrontierService_CreateOrganizationRole_FullMethodName           = "/raystack.frontier.v1beta1.FrontierService/CreateOrganizationRole"
+	FrontierService_GetOrganizationRole_FullMethodName              = "/raystack.frontier.v1beta1.FrontierService/GetOrganizationRole"
+	FrontierService_UpdateOrganizationRole_FullMethodName           = "/raystack.frontier.v1beta1.FrontierService/UpdateOrganizationRole"
+	FrontierService_DeleteOrganizationRole_FullMethodName           = "/raystack.frontier.v1beta1.FrontierService/DeleteOrganizationRole"
+	FrontierService_ListOrganizations_FullMethodName                = "/raystack.frontier.v1beta1.FrontierService/ListOrganizations"
+	FrontierService_CreateOrganization_FullMethodName               = "/raystack.frontier.v1beta1.FrontierService/CreateOrganization"
+	FrontierService_GetOrganization_FullMethodName                  = "/raystack.frontier.v1beta1.FrontierService/GetOrganization"
+	FrontierService_UpdateOrganization_FullMethodName               = "/raystack.frontier.v1beta1.FrontierService/UpdateOrganization"
+	FrontierService_ListOrganizationProjects_FullMethodName         = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationProjects"
+	FrontierService_ListOrganizationAdmins_FullMethodName           = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationAdmins"
+	FrontierService_ListOrganizationUsers_FullMethodName            = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationUsers"
+	FrontierService_AddOrganizationUsers_FullMethodName             = "/raystack.frontier.v1beta1.FrontierService/AddOrganizationUsers"
+	FrontierService_RemoveOrganizationUser_FullMethodName           = "/raystack.frontier.v1beta1.FrontierService/RemoveOrganizationUser"
+	FrontierService_ListOrganizationServiceUsers_FullMethodName     = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationServiceUsers"
+	FrontierService_ListOrganizationInvitations_FullMethodName      = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationInvitations"
+	FrontierService_CreateOrganizationInvitation_FullMethodName     = "/raystack.frontier.v1beta1.FrontierService/CreateOrganizationInvitation"
+	FrontierService_GetOrganizationInvitation_FullMethodName        = "/raystack.frontier.v1beta1.FrontierService/GetOrganizationInvitation"
+	FrontierService_AcceptOrganizationInvitation_FullMethodName     = "/raystack.frontier.v1beta1.FrontierService/AcceptOrganizationInvitation"
+	FrontierService_DeclineOrganizationInvitation_FullMethodName    = "/raystack.frontier.v1beta1.FrontierService/DeclineOrganizationInvitation"
+	FrontierService_DeleteOrganizationInvitation_FullMethodName     = "/raystack.frontier.v1beta1.FrontierService/DeleteOrganizationInvitation"
+	FrontierService_CreateOrganizationInviteLink_FullMethodName     = "/raystack.frontier.v1beta1.FrontierService/CreateOrganizationInviteLink"
+	FrontierService_ListOrganizationInviteLinks_FullMethodName      = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationInviteLinks"
+	FrontierService_DeleteOrganizationInviteLink_FullMethodName     = "/raystack.frontier.v1beta1.FrontierService/DeleteOrganizationInviteLink"
+	FrontierService_JoinWithInviteLink_FullMethodName               = "/raystack.frontier.v1beta1.FrontierService/JoinWithInviteLink"
+	FrontierService_ListOrganizationDomains_FullMethodName          = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationDomains"
+	FrontierService_CreateOrganizationDomain_FullMethodName         = "/raystack.frontier.v1beta1.FrontierService/CreateOrganizationDomain"
+	FrontierService_DeleteOrganizationDomain_FullMethodName         = "/raystack.frontier.v1beta1.FrontierService/DeleteOrganizationDomain"
+	FrontierService_GetOrganizationDomain_FullMethodName            = "/raystack.frontier.v1beta1.FrontierService/GetOrganizationDomain"
+	FrontierService_VerifyOrganizationDomain_FullMethodName         = "/raystack.frontier.v1beta1.FrontierService/VerifyOrganizationDomain"
+	FrontierService_UpdateOrganizationDomainAutoJoin_FullMethodName = "/raystack.frontier.v1beta1.FrontierService/UpdateOrganizationDomainAutoJoin"
+	FrontierService_GetJob_FullMethodName                           = "/raystack.frontier.v1beta1.FrontierService/GetJob"
+	FrontierService_CancelJob_FullMethodName                        = "/raystack.frontier.v1beta1.FrontierService/CancelJob"
+	FrontierService_JoinOrganization_FullMethodName                 = "/raystack.frontier.v1beta1.FrontierService/JoinOrganization"
+	FrontierService_TransferOrganizationOwnership_FullMethodName    = "/raystack.frontier.v1beta1.FrontierService/TransferOrganizationOwnership"
+	FrontierService_AcceptOrganizationTransfer_FullMethodName       = "/raystack.frontier.v1beta1.FrontierService/AcceptOrganizationTransfer"
+	FrontierService_DeclineOrganizationTransfer_FullMethodName      = "/raystack.frontier.v1beta1.FrontierService/DeclineOrganizationTransfer"
+	FrontierService_ListOrganizationPersonalTokens_FullMethodName   = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationPersonalTokens"
+	FrontierService_RevokeOrganizationPersonalToken_FullMethodName  = "/raystack.frontier.v1beta1.FrontierService/RevokeOrganizationPersonalToken"
+	FrontierService_EnableOrganization_FullMethodName               = "/raystack.frontier.v1beta1.FrontierService/EnableOrganization"
+	FrontierService_DisableOrganization_FullMethodName              = "/raystack.frontier.v1beta1.FrontierService/DisableOrganization"
+	FrontierService_DeleteOrganization_FullMethodName               = "/raystack.frontier.v1beta1.FrontierService/DeleteOrganization"
+	FrontierService_CreateProject_FullMethodName                    = "/raystack.frontier.v1beta1.FrontierService/CreateProject"
+	FrontierService_GetProject_FullMethodName                       = "/raystack.frontier.v1beta1.FrontierService/GetProject"
+	FrontierService_UpdateProject_FullMethodName                    = "/raystack.frontier.v1beta1.FrontierService/UpdateProject"
+	FrontierService_ListProjectAdmins_FullMethodName                = "/raystack.frontier.v1beta1.FrontierService/ListProjectAdmins"
+	FrontierService_ListProjectUsers_FullMethodName                 = "/raystack.frontier.v1beta1.FrontierService/ListProjectUsers"
+	FrontierService_ListProjectServiceUsers_FullMethodName          = "/raystack.frontier.v1beta1.FrontierService/ListProjectServiceUsers"
+	FrontierService_ListProjectGroups_FullMethodName                = "/raystack.frontier.v1beta1.FrontierService/ListProjectGroups"
+	FrontierService_EnableProject_FullMethodName                    = "/raystack.frontier.v1beta1.FrontierService/EnableProject"
+	FrontierService_DisableProject_FullMethodName                   = "/raystack.frontier.v1beta1.FrontierService/DisableProject"
+	FrontierService_DeleteProject_FullMethodName                    = "/raystack.frontier.v1beta1.FrontierService/DeleteProject"
+	FrontierService_MoveProject_FullMethodName                      = "/raystack.frontier.v1beta1.FrontierService/MoveProject"
+	FrontierService_ListOrganizationFolders_FullMethodName          = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationFolders"
+	FrontierService_CreateFolder_FullMethodName                     = "/raystack.frontier.v1beta1.FrontierService/CreateFolder"
+	FrontierService_GetFolder_FullMethodName                        = "/raystack.frontier.v1beta1.FrontierService/GetFolder"
+	FrontierService_UpdateFolder_FullMethodName                     = "/raystack.frontier.v1beta1.FrontierService/UpdateFolder"
+	FrontierService_DeleteFolder_FullMethodName                     = "/raystack.frontier.v1beta1.FrontierService/DeleteFolder"
+	FrontierService_ListFolderProjects_FullMethodName               = "/raystack.frontier.v1beta1.FrontierService/ListFolderProjects"
+	FrontierService_MoveFolder_FullMethodName                       = "/raystack.frontier.v1beta1.FrontierService/MoveFolder"
+	FrontierService_CreatePolicy_FullMethodName                     = "/raystack.frontier.v1beta1.FrontierService/CreatePolicy"
+	FrontierService_GetPolicy_FullMethodName                        = "/raystack.frontier.v1beta1.FrontierService/GetPolicy"
+	FrontierService_UpdatePolicy_FullMethodName                     = "/raystack.frontier.v1beta1.FrontierService/UpdatePolicy"
+	FrontierService_DeletePolicy_FullMethodName                     = "/raystack.frontier.v1beta1.FrontierService/DeletePolicy"
+	FrontierService_CreateRelation_FullMethodName                   = "/raystack.frontier.v1beta1.FrontierService/CreateRelation"
+	FrontierService_GetRelation_FullMethodName                      = "/raystack.frontier.v1beta1.FrontierService/GetRelation"
+	FrontierService_DeleteRelation_FullMethodName                   = "/raystack.frontier.v1beta1.FrontierService/DeleteRelation"
+	FrontierService_ListPermissions_FullMethodName                  = "/raystack.frontier.v1beta1.FrontierService/ListPermissions"
+	FrontierService_GetPermission_FullMethodName                    = "/raystack.frontier.v1beta1.FrontierService/GetPermission"
+	FrontierService_ListNamespaces_FullMethodName                   = "/raystack.frontier.v1beta1.FrontierService/ListNamespaces"
+	FrontierService_GetNamespace_FullMethodName                     = "/raystack.frontier.v1beta1.FrontierService/GetNamespace"
+	FrontierService_ListProjectResources_FullMethodName             = "/raystack.frontier.v1beta1.FrontierService/ListProjectResources"
+	FrontierService_CreateProjectResource_FullMethodName            = "/raystack.frontier.v1beta1.FrontierService/CreateProjectResource"
+	FrontierService_GetProjectResource_FullMethodName               = "/raystack.frontier.v1beta1.FrontierService/GetProjectResource"
+	FrontierService_UpdateProjectResource_FullMethodName            = "/raystack.frontier.v1beta1.FrontierService/UpdateProjectResource"
+	FrontierService_DeleteProjectResource_FullMethodName            = "/raystack.frontier.v1beta1.FrontierService/DeleteProjectResource"
+	FrontierService_CheckResourcePermission_FullMethodName          = "/raystack.frontier.v1beta1.FrontierService/CheckResourcePermission"
+	FrontierService_BatchCheckPermission_FullMethodName             = "/raystack.frontier.v1beta1.FrontierService/BatchCheckPermission"
+	FrontierService_GetJWKs_FullMethodName                          = "/raystack.frontier.v1beta1.FrontierService/GetJWKs"
+	FrontierService_ListAuthStrategies_FullMethodName               = "/raystack.frontier.v1beta1.FrontierService/ListAuthStrategies"
+	FrontierService_Authenticate_FullMethodName                     = "/raystack.frontier.v1beta1.FrontierService/Authenticate"
+	FrontierService_AuthCallback_FullMethodName                     = "/raystack.frontier.v1beta1.FrontierService/AuthCallback"
+	FrontierService_AuthToken_FullMethodName                        = "/raystack.frontier.v1beta1.FrontierService/AuthToken"
+	FrontierService_AuthDeviceCode_FullMethodName                   = "/raystack.frontier.v1beta1.FrontierService/AuthDeviceCode"
+	FrontierService_ApproveAuthDevice_FullMethodName                = "/raystack.frontier.v1beta1.FrontierService/ApproveAuthDevice"
+	FrontierService_AuthLogout_FullMethodName                       = "/raystack.frontier.v1beta1.FrontierService/AuthLogout"
+	FrontierService_ListMetaSchemas_FullMethodName                  = "/raystack.frontier.v1beta1.FrontierService/ListMetaSchemas"
+	FrontierService_CreateMetaSchema_FullMethodName                 = "/raystack.frontier.v1beta1.FrontierService/CreateMetaSchema"
+	FrontierService_GetMetaSchema_FullMethodName                    = "/raystack.frontier.v1beta1.FrontierService/GetMetaSchema"
+	FrontierService_UpdateMetaSchema_FullMethodName                 = "/raystack.frontier.v1beta1.FrontierService/UpdateMetaSchema"
+	FrontierService_DeleteMetaSchema_FullMethodName                 = "/raystack.frontier.v1beta1.FrontierService/DeleteMetaSchema"
+	FrontierService_ListOrganizationAuditLogs_FullMethodName        = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationAuditLogs"
+	FrontierService_CreateOrganizationAuditLogs_FullMethodName      = "/raystack.frontier.v1beta1.FrontierService/CreateOrganizationAuditLogs"
+	FrontierService_GetOrganizationAuditLog_FullMethodName          = "/raystack.frontier.v1beta1.FrontierService/GetOrganizationAuditLog"
+	FrontierService_DescribePreferences_FullMethodName              = "/raystack.frontier.v1beta1.FrontierService/DescribePreferences"
+	FrontierService_CreateOrganizationPreferences_FullMethodName    = "/raystack.frontier.v1beta1.FrontierService/CreateOrganizationPreferences"
+	FrontierService_ListOrganizationPreferences_FullMethodName      = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationPreferences"
+	FrontierService_ListOrganizationMailTemplates_FullMethodName    = "/raystack.frontier.v1beta1.FrontierService/ListOrganizationMailTemplates"
+	FrontierService_SetOrganizationMailTemplate_FullMethodName      = "/raystack.frontier.v1beta1.FrontierService/SetOrganizationMailTemplate"
+	FrontierService_DeleteOrganizationMailTemplate_FullMethodName   = "/raystack.frontier.v1beta1.FrontierService/DeleteOrganizationMailTemplate"
+	FrontierService_PreviewOrganizationMailTemplate_FullMethodName  = "/raystack.frontier.v1beta1.FrontierService/PreviewOrganizationMailTemplate"
+	FrontierService_CreateProjectPreferences_FullMethodName         = "/raystack.frontier.v1beta1.FrontierService/CreateProjectPreferences"
+	FrontierService_ListProjectPreferences_FullMethodName           = "/raystack.frontier.v1beta1.FrontierService/ListProjectPreferences"
+	FrontierService_CreateGroupPreferences_FullMethodName           = "/raystack.frontier.v1beta1.FrontierService/CreateGroupPreferences"
+	FrontierService_ListGroupPreferences_FullMethodName             = "/raystack.frontier.v1beta1.FrontierService/ListGroupPreferences"
+	FrontierService_CreateUserPreferences_FullMethodName            = "/raystack.frontier.v1beta1.FrontierService/CreateUserPreferences"
+	FrontierService_ListUserPreferences_FullMethodName              = "/raystack.frontier.v1beta1.FrontierService/ListUserPreferences"
+	FrontierService_CreateCurrentUserPreferences_FullMethodName     = "/raystack.frontier.v1beta1.FrontierService/CreateCurrentUserPreferences"
+	FrontierService_ListCurrentUserPreferences_FullMethodName       = "/raystack.frontier.v1beta1.FrontierService/ListCurrentUserPreferences"
 )
 
 // FrontierServiceClient is the client API for FrontierService service.
@@ -155,9 +197,21 @@ type FrontierServiceClient interface {
 	GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*GetCurrentUserResponse, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
 	UpdateCurrentUser(ctx context.Context, in *UpdateCurrentUserRequest, opts ...grpc.CallOption) (*UpdateCurrentUserResponse, error)
+	ChangeCurrentUserEmail(ctx context.Context, in *ChangeCurrentUserEmailRequest, opts ...grpc.CallOption) (*ChangeCurrentUserEmailResponse, error)
+	VerifyCurrentUserEmail(ctx context.Context, in *VerifyCurrentUserEmailRequest, opts ...grpc.CallOption) (*VerifyCurrentUserEmailResponse, error)
+	AddCurrentUserEmail(ctx context.Context, in *AddCurrentUserEmailRequest, opts ...grpc.CallOption) (*AddCurrentUserEmailResponse, error)
+	ListCurrentUserEmails(ctx context.Context, in *ListCurrentUserEmailsRequest, opts ...grpc.CallOption) (*ListCurrentUserEmailsResponse, error)
+	RemoveCurrentUserEmail(ctx context.Context, in *RemoveCurrentUserEmailRequest, opts ...grpc.CallOption) (*RemoveCurrentUserEmailResponse, error)
+	ListCurrentUserIdentities(ctx context.Context, in *ListCurrentUserIdentitiesRequest, opts ...grpc.CallOption) (*ListCurrentUserIdentitiesResponse, error)
+	UnlinkCurrentUserIdentity(ctx context.Context, in *UnlinkCurrentUserIdentityRequest, opts ...grpc.CallOption) (*UnlinkCurrentUserIdentityResponse, error)
+	CreateCurrentUserPersonalToken(ctx context.Context, in *CreateCurrentUserPersonalTokenRequest, opts ...grpc.CallOption) (*CreateCurrentUserPersonalTokenResponse, error)
+	ListCurrentUserPersonalTokens(ctx context.Context, in *ListCurrentUserPersonalTokensRequest, opts ...grpc.CallOption) (*ListCurrentUserPersonalTokensResponse, error)
+	RevokeCurrentUserPersonalToken(ctx context.Context, in *RevokeCurrentUserPersonalTokenRequest, opts ...grpc.CallOption) (*RevokeCurrentUserPersonalTokenResponse, error)
 	EnableUser(ctx context.Context, in *EnableUserRequest, opts ...grpc.CallOption) (*EnableUserResponse, error)
 	DisableUser(ctx context.Context, in *DisableUserRequest, opts ...grpc.CallOption) (*DisableUserResponse, error)
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	DeleteCurrentUser(ctx context.Context, in *DeleteCurrentUserRequest, opts ...grpc.CallOption) (*DeleteCurrentUserResponse, error)
+	ExportCurrentUserData(ctx context.Context, in *ExportCurrentUserDataRequest, opts ...grpc.CallOption) (*ExportCurrentUserDataResponse, error)
 	ListOrganizationsByUser(ctx context.Context, in *ListOrganizationsByUserRequest, opts ...grpc.CallOption) (*ListOrganizationsByUserResponse, error)
 	ListOrganizationsByCurrentUser(ctx context.Context, in *ListOrganizationsByCurrentUserRequest, opts ...grpc.CallOption) (*ListOrganizationsByCurrentUserResponse, error)
 	ListProjectsByUser(ctx context.Context, in *ListProjectsByUserRequest, opts ...grpc.CallOption) (*ListProjectsByUserResponse, error)
@@ -176,6 +230,9 @@ type FrontierServiceClient interface {
 	CreateServiceUserSecret(ctx context.Context, in *CreateServiceUserSecretRequest, opts ...grpc.CallOption) (*CreateServiceUserSecretResponse, error)
 	ListServiceUserSecrets(ctx context.Context, in *ListServiceUserSecretsRequest, opts ...grpc.CallOption) (*ListServiceUserSecretsResponse, error)
 	DeleteServiceUserSecret(ctx context.Context, in *DeleteServiceUserSecretRequest, opts ...grpc.CallOption) (*DeleteServiceUserSecretResponse, error)
+	CreateServiceUserTrust(ctx context.Context, in *CreateServiceUserTrustRequest, opts ...grpc.CallOption) (*CreateServiceUserTrustResponse, error)
+	ListServiceUserTrusts(ctx context.Context, in *ListServiceUserTrustsRequest, opts ...grpc.CallOption) (*ListServiceUserTrustsResponse, error)
+	DeleteServiceUserTrust(ctx context.Context, in *DeleteServiceUserTrustRequest, opts ...grpc.CallOption) (*DeleteServiceUserTrustResponse, error)
 	// Group
 	ListOrganizationGroups(ctx context.Context, in *ListOrganizationGroupsRequest, opts ...grpc.CallOption) (*ListOrganizationGroupsResponse, error)
 	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error)
@@ -209,13 +266,27 @@ type FrontierServiceClient interface {
 	CreateOrganizationInvitation(ctx context.Context, in *CreateOrganizationInvitationRequest, opts ...grpc.CallOption) (*CreateOrganizationInvitationResponse, error)
 	GetOrganizationInvitation(ctx context.Context, in *GetOrganizationInvitationRequest, opts ...grpc.CallOption) (*GetOrganizationInvitationResponse, error)
 	AcceptOrganizationInvitation(ctx context.Context, in *AcceptOrganizationInvitationRequest, opts ...grpc.CallOption) (*AcceptOrganizationInvitationResponse, error)
+	DeclineOrganizationInvitation(ctx context.Context, in *DeclineOrganizationInvitationRequest, opts ...grpc.CallOption) (*DeclineOrganizationInvitationResponse, error)
 	DeleteOrganizationInvitation(ctx context.Context, in *DeleteOrganizationInvitationRequest, opts ...grpc.CallOption) (*DeleteOrganizationInvitationResponse, error)
+	CreateOrganizationInviteLink(ctx context.Context, in *CreateOrganizationInviteLinkRequest, opts ...grpc.CallOption) (*CreateOrganizationInviteLinkResponse, error)
+	ListOrganizationInviteLinks(ctx context.Context, in *ListOrganizationInviteLinksRequest, opts ...grpc.CallOption) (*ListOrganizationInviteLinksResponse, error)
+	DeleteOrganizationInviteLink(ctx context.Context, in *DeleteOrganizationInviteLinkRequest, opts ...grpc.CallOption) (*DeleteOrganizationInviteLinkResponse, error)
+	JoinWithInviteLink(ctx context.Context, in *JoinWithInviteLinkRequest, opts ...grpc.CallOption) (*JoinWithInviteLinkResponse, error)
 	ListOrganizationDomains(ctx context.Context, in *ListOrganizationDomainsRequest, opts ...grpc.CallOption) (*ListOrganizationDomainsResponse, error)
 	CreateOrganizationDomain(ctx context.Context, in *CreateOrganizationDomainRequest, opts ...grpc.CallOption) (*CreateOrganizationDomainResponse, error)
 	DeleteOrganizationDomain(ctx context.Context, in *DeleteOrganizationDomainRequest, opts ...grpc.CallOption) (*DeleteOrganizationDomainResponse, error)
 	GetOrganizationDomain(ctx context.Context, in *GetOrganizationDomainRequest, opts ...grpc.CallOption) (*GetOrganizationDomainResponse, error)
 	VerifyOrganizationDomain(ctx context.Context, in *VerifyOrganizationDomainRequest, opts ...grpc.CallOption) (*VerifyOrganizationDomainResponse, error)
+	UpdateOrganizationDomainAutoJoin(ctx context.Context, in *UpdateOrganizationDomainAutoJoinRequest, opts ...grpc.CallOption) (*UpdateOrganizationDomainAutoJoinResponse, error)
+	// Job
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error)
+	CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error)
 	JoinOrganization(ctx context.Context, in *JoinOrganizationRequest, opts ...grpc.CallOption) (*JoinOrganizationResponse, error)
+	TransferOrganizationOwnership(ctx context.Context, in *TransferOrganizationOwnershipRequest, opts ...grpc.CallOption) (*TransferOrganizationOwnershipResponse, error)
+	AcceptOrganizationTransfer(ctx context.Context, in *AcceptOrganizationTransferRequest, opts ...grpc.CallOption) (*AcceptOrganizationTransferResponse, error)
+	DeclineOrganizationTransfer(ctx context.Context, in *DeclineOrganizationTransferRequest, opts ...grpc.CallOption) (*DeclineOrganizationTransferResponse, error)
+	ListOrganizationPersonalTokens(ctx context.Context, in *ListOrganizationPersonalTokensRequest, opts ...grpc.CallOption) (*ListOrganizationPersonalTokensResponse, error)
+	RevokeOrganizationPersonalToken(ctx context.Context, in *RevokeOrganizationPersonalTokenRequest, opts ...grpc.CallOption) (*RevokeOrganizationPersonalTokenResponse, error)
 	EnableOrganization(ctx context.Context, in *EnableOrganizationRequest, opts ...grpc.CallOption) (*EnableOrganizationResponse, error)
 	DisableOrganization(ctx context.Context, in *DisableOrganizationRequest, opts ...grpc.CallOption) (*DisableOrganizationResponse, error)
 	DeleteOrganization(ctx context.Context, in *DeleteOrganizationRequest, opts ...grpc.CallOption) (*DeleteOrganizationResponse, error)
@@ -230,6 +301,15 @@ type FrontierServiceClient interface {
 	EnableProject(ctx context.Context, in *EnableProjectRequest, opts ...grpc.CallOption) (*EnableProjectResponse, error)
 	DisableProject(ctx context.Context, in *DisableProjectRequest, opts ...grpc.CallOption) (*DisableProjectResponse, error)
 	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error)
+	MoveProject(ctx context.Context, in *MoveProjectRequest, opts ...grpc.CallOption) (*MoveProjectResponse, error)
+	// Folder
+	ListOrganizationFolders(ctx context.Context, in *ListOrganizationFoldersRequest, opts ...grpc.CallOption) (*ListOrganizationFoldersResponse, error)
+	CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*CreateFolderResponse, error)
+	GetFolder(ctx context.Context, in *GetFolderRequest, opts ...grpc.CallOption) (*GetFolderResponse, error)
+	UpdateFolder(ctx context.Context, in *UpdateFolderRequest, opts ...grpc.CallOption) (*UpdateFolderResponse, error)
+	DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...grpc.CallOption) (*DeleteFolderResponse, error)
+	ListFolderProjects(ctx context.Context, in *ListFolderProjectsRequest, opts ...grpc.CallOption) (*ListFolderProjectsResponse, error)
+	MoveFolder(ctx context.Context, in *MoveFolderRequest, opts ...grpc.CallOption) (*MoveFolderResponse, error)
 	// Policies
 	CreatePolicy(ctx context.Context, in *CreatePolicyRequest, opts ...grpc.CallOption) (*CreatePolicyResponse, error)
 	GetPolicy(ctx context.Context, in *GetPolicyRequest, opts ...grpc.CallOption) (*GetPolicyResponse, error)
@@ -260,6 +340,8 @@ type FrontierServiceClient interface {
 	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
 	AuthCallback(ctx context.Context, in *AuthCallbackRequest, opts ...grpc.CallOption) (*AuthCallbackResponse, error)
 	AuthToken(ctx context.Context, in *AuthTokenRequest, opts ...grpc.CallOption) (*AuthTokenResponse, error)
+	AuthDeviceCode(ctx context.Context, in *AuthDeviceCodeRequest, opts ...grpc.CallOption) (*AuthDeviceCodeResponse, error)
+	ApproveAuthDevice(ctx context.Context, in *ApproveAuthDeviceRequest, opts ...grpc.CallOption) (*ApproveAuthDeviceResponse, error)
 	AuthLogout(ctx context.Context, in *AuthLogoutRequest, opts ...grpc.CallOption) (*AuthLogoutResponse, error)
 	// MetaSchemas
 	ListMetaSchemas(ctx context.Context, in *ListMetaSchemasRequest, opts ...grpc.CallOption) (*ListMetaSchemasResponse, error)
@@ -275,6 +357,10 @@ type FrontierServiceClient interface {
 	DescribePreferences(ctx context.Context, in *DescribePreferencesRequest, opts ...grpc.CallOption) (*DescribePreferencesResponse, error)
 	CreateOrganizationPreferences(ctx context.Context, in *CreateOrganizationPreferencesRequest, opts ...grpc.CallOption) (*CreateOrganizationPreferencesResponse, error)
 	ListOrganizationPreferences(ctx context.Context, in *ListOrganizationPreferencesRequest, opts ...grpc.CallOption) (*ListOrganizationPreferencesResponse, error)
+	ListOrganizationMailTemplates(ctx context.Context, in *ListOrganizationMailTemplatesRequest, opts ...grpc.CallOption) (*ListOrganizationMailTemplatesResponse, error)
+	SetOrganizationMailTemplate(ctx context.Context, in *SetOrganizationMailTemplateRequest, opts ...grpc.CallOption) (*SetOrganizationMailTemplateResponse, error)
+	DeleteOrganizationMailTemplate(ctx context.Context, in *DeleteOrganizationMailTemplateRequest, opts ...grpc.CallOption) (*DeleteOrganizationMailTemplateResponse, error)
+	PreviewOrganizationMailTemplate(ctx context.Context, in *PreviewOrganizationMailTemplateRequest, opts ...grpc.CallOption) (*PreviewOrganizationMailTemplateResponse, error)
 	CreateProjectPreferences(ctx context.Context, in *CreateProjectPreferencesRequest, opts ...grpc.CallOption) (*CreateProjectPreferencesResponse, error)
 	ListProjectPreferences(ctx context.Context, in *ListProjectPreferencesRequest, opts ...grpc.CallOption) (*ListProjectPreferencesResponse, error)
 	CreateGroupPreferences(ctx context.Context, in *CreateGroupPreferencesRequest, opts ...grpc.CallOption) (*CreateGroupPreferencesResponse, error)
@@ -365,6 +451,96 @@ func (c *frontierServiceClient) UpdateCurrentUser(ctx context.Context, in *Updat
 	return out, nil
 }
 
+func (c *frontierServiceClient) ChangeCurrentUserEmail(ctx context.Context, in *ChangeCurrentUserEmailRequest, opts ...grpc.CallOption) (*ChangeCurrentUserEmailResponse, error) {
+	out := new(ChangeCurrentUserEmailResponse)
+	err := c.cc.Invoke(ctx, FrontierService_ChangeCurrentUserEmail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) VerifyCurrentUserEmail(ctx context.Context, in *VerifyCurrentUserEmailRequest, opts ...grpc.CallOption) (*VerifyCurrentUserEmailResponse, error) {
+	out := new(VerifyCurrentUserEmailResponse)
+	err := c.cc.Invoke(ctx, FrontierService_VerifyCurrentUserEmail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) AddCurrentUserEmail(ctx context.Context, in *AddCurrentUserEmailRequest, opts ...grpc.CallOption) (*AddCurrentUserEmailResponse, error) {
+	out := new(AddCurrentUserEmailResponse)
+	err := c.cc.Invoke(ctx, FrontierService_AddCurrentUserEmail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) ListCurrentUserEmails(ctx context.Context, in *ListCurrentUserEmailsRequest, opts ...grpc.CallOption) (*ListCurrentUserEmailsResponse, error) {
+	out := new(ListCurrentUserEmailsResponse)
+	err := c.cc.Invoke(ctx, FrontierService_ListCurrentUserEmails_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) RemoveCurrentUserEmail(ctx context.Context, in *RemoveCurrentUserEmailRequest, opts ...grpc.CallOption) (*RemoveCurrentUserEmailResponse, error) {
+	out := new(RemoveCurrentUserEmailResponse)
+	err := c.cc.Invoke(ctx, FrontierService_RemoveCurrentUserEmail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) ListCurrentUserIdentities(ctx context.Context, in *ListCurrentUserIdentitiesRequest, opts ...grpc.CallOption) (*ListCurrentUserIdentitiesResponse, error) {
+	out := new(ListCurrentUserIdentitiesResponse)
+	err := c.cc.Invoke(ctx, FrontierService_ListCurrentUserIdentities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) UnlinkCurrentUserIdentity(ctx context.Context, in *UnlinkCurrentUserIdentityRequest, opts ...grpc.CallOption) (*UnlinkCurrentUserIdentityResponse, error) {
+	out := new(UnlinkCurrentUserIdentityResponse)
+	err := c.cc.Invoke(ctx, FrontierService_UnlinkCurrentUserIdentity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) CreateCurrentUserPersonalToken(ctx context.Context, in *CreateCurrentUserPersonalTokenRequest, opts ...grpc.CallOption) (*CreateCurrentUserPersonalTokenResponse, error) {
+	out := new(CreateCurrentUserPersonalTokenResponse)
+	err := c.cc.Invoke(ctx, FrontierService_CreateCurrentUserPersonalToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) ListCurrentUserPersonalTokens(ctx context.Context, in *ListCurrentUserPersonalTokensRequest, opts ...grpc.CallOption) (*ListCurrentUserPersonalTokensResponse, error) {
+	out := new(ListCurrentUserPersonalTokensResponse)
+	err := c.cc.Invoke(ctx, FrontierService_ListCurrentUserPersonalTokens_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) RevokeCurrentUserPersonalToken(ctx context.Context, in *RevokeCurrentUserPersonalTokenRequest, opts ...grpc.CallOption) (*RevokeCurrentUserPersonalTokenResponse, error) {
+	out := new(RevokeCurrentUserPersonalTokenResponse)
+	err := c.cc.Invoke(ctx, FrontierService_RevokeCurrentUserPersonalToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *frontierServiceClient) EnableUser(ctx context.Context, in *EnableUserRequest, opts ...grpc.CallOption) (*EnableUserResponse, error) {
 	out := new(EnableUserResponse)
 	err := c.cc.Invoke(ctx, FrontierService_EnableUser_FullMethodName, in, out, opts...)
@@ -392,6 +568,24 @@ func (c *frontierServiceClient) DeleteUser(ctx context.Context, in *DeleteUserRe
 	return out, nil
 }
 
+func (c *frontierServiceClient) DeleteCurrentUser(ctx context.Context, in *DeleteCurrentUserRequest, opts ...grpc.CallOption) (*DeleteCurrentUserResponse, error) {
+	out := new(DeleteCurrentUserResponse)
+	err := c.cc.Invoke(ctx, FrontierService_DeleteCurrentUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) ExportCurrentUserData(ctx context.Context, in *ExportCurrentUserDataRequest, opts ...grpc.CallOption) (*ExportCurrentUserDataResponse, error) {
+	out := new(ExportCurrentUserDataResponse)
+	err := c.cc.Invoke(ctx, FrontierService_ExportCurrentUserData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *frontierServiceClient) ListOrganizationsByUser(ctx context.Context, in *ListOrganizationsByUserRequest, opts ...grpc.CallOption) (*ListOrganizationsByUserResponse, error) {
 	out := new(ListOrganizationsByUserResponse)
 	err := c.cc.Invoke(ctx, FrontierService_ListOrganizationsByUser_FullMethodName, in, out, opts...)
@@ -545,6 +739,33 @@ func (c *frontierServiceClient) DeleteServiceUserSecret(ctx context.Context, in
 	return out, nil
 }
 
+func (c *frontierServiceClient) CreateServiceUserTrust(ctx context.Context, in *CreateServiceUserTrustRequest, opts ...grpc.CallOption) (*CreateServiceUserTrustResponse, error) {
+	out := new(CreateServiceUserTrustResponse)
+	err := c.cc.Invoke(ctx, FrontierService_CreateServiceUserTrust_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) ListServiceUserTrusts(ctx context.Context, in *ListServiceUserTrustsRequest, opts ...grpc.CallOption) (*ListServiceUserTrustsResponse, error) {
+	out := new(ListServiceUserTrustsResponse)
+	err := c.cc.Invoke(ctx, FrontierService_ListServiceUserTrusts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) DeleteServiceUserTrust(ctx context.Context, in *DeleteServiceUserTrustRequest, opts ...grpc.CallOption) (*DeleteServiceUserTrustResponse, error) {
+	out := new(DeleteServiceUserTrustResponse)
+	err := c.cc.Invoke(ctx, FrontierService_DeleteServiceUserTrust_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *frontierServiceClient) ListOrganizationGroups(ctx context.Context, in *ListOrganizationGroupsRequest, opts ...grpc.CallOption) (*ListOrganizationGroupsResponse, error) {
 	out := new(ListOrganizationGroupsResponse)
 	err := c.cc.Invoke(ctx, FrontierService_ListOrganizationGroups_FullMethodName, in, out, opts...)
@@ -815,6 +1036,15 @@ func (c *frontierServiceClient) AcceptOrganizationInvitation(ctx context.Context
 	return out, nil
 }
 
+func (c *frontierServiceClient) DeclineOrganizationInvitation(ctx context.Context, in *DeclineOrganizationInvitationRequest, opts ...grpc.CallOption) (*DeclineOrganizationInvitationResponse, error) {
+	out := new(DeclineOrganizationInvitationResponse)
+	err := c.cc.Invoke(ctx, FrontierService_DeclineOrganizationInvitation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *frontierServiceClient) DeleteOrganizationInvitation(ctx context.Context, in *DeleteOrganizationInvitationRequest, opts ...grpc.CallOption) (*DeleteOrganizationInvitationResponse, error) {
 	out := new(DeleteOrganizationInvitationResponse)
 	err := c.cc.Invoke(ctx, FrontierService_DeleteOrganizationInvitation_FullMethodName, in, out, opts...)
@@ -824,6 +1054,42 @@ func (c *frontierServiceClient) DeleteOrganizationInvitation(ctx context.Context
 	return out, nil
 }
 
+func (c *frontierServiceClient) CreateOrganizationInviteLink(ctx context.Context, in *CreateOrganizationInviteLinkRequest, opts ...grpc.CallOption) (*CreateOrganizationInviteLinkResponse, error) {
+	out := new(CreateOrganizationInviteLinkResponse)
+	err := c.cc.Invoke(ctx, FrontierService_CreateOrganizationInviteLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) ListOrganizationInviteLinks(ctx context.Context, in *ListOrganizationInviteLinksRequest, opts ...grpc.CallOption) (*ListOrganizationInviteLinksResponse, error) {
+	out := new(ListOrganizationInviteLinksResponse)
+	err := c.cc.Invoke(ctx, FrontierService_ListOrganizationInviteLinks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) DeleteOrganizationInviteLink(ctx context.Context, in *DeleteOrganizationInviteLinkRequest, opts ...grpc.CallOption) (*DeleteOrganizationInviteLinkResponse, error) {
+	out := new(DeleteOrganizationInviteLinkResponse)
+	err := c.cc.Invoke(ctx, FrontierService_DeleteOrganizationInviteLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) JoinWithInviteLink(ctx context.Context, in *JoinWithInviteLinkRequest, opts ...grpc.CallOption) (*JoinWithInviteLinkResponse, error) {
+	out := new(JoinWithInviteLinkResponse)
+	err := c.cc.Invoke(ctx, FrontierService_JoinWithInviteLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *frontierServiceClient) ListOrganizationDomains(ctx context.Context, in *ListOrganizationDomainsRequest, opts ...grpc.CallOption) (*ListOrganizationDomainsResponse, error) {
 	out := new(ListOrganizationDomainsResponse)
 	err := c.cc.Invoke(ctx, FrontierService_ListOrganizationDomains_FullMethodName, in, out, opts...)
@@ -869,6 +1135,33 @@ func (c *frontierServiceClient) VerifyOrganizationDomain(ctx context.Context, in
 	return out, nil
 }
 
+func (c *frontierServiceClient) UpdateOrganizationDomainAutoJoin(ctx context.Context, in *UpdateOrganizationDomainAutoJoinRequest, opts ...grpc.CallOption) (*UpdateOrganizationDomainAutoJoinResponse, error) {
+	out := new(UpdateOrganizationDomainAutoJoinResponse)
+	err := c.cc.Invoke(ctx, FrontierService_UpdateOrganizationDomainAutoJoin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error) {
+	out := new(GetJobResponse)
+	err := c.cc.Invoke(ctx, FrontierService_GetJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error) {
+	out := new(CancelJobResponse)
+	err := c.cc.Invoke(ctx, FrontierService_CancelJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *frontierServiceClient) JoinOrganization(ctx context.Context, in *JoinOrganizationRequest, opts ...grpc.CallOption) (*JoinOrganizationResponse, error) {
 	out := new(JoinOrganizationResponse)
 	err := c.cc.Invoke(ctx, FrontierService_JoinOrganization_FullMethodName, in, out, opts...)
@@ -878,6 +1171,51 @@ func (c *frontierServiceClient) JoinOrganization(ctx context.Context, in *JoinOr
 	return out, nil
 }
 
+func (c *frontierServiceClient) TransferOrganizationOwnership(ctx context.Context, in *TransferOrganizationOwnershipRequest, opts ...grpc.CallOption) (*TransferOrganizationOwnershipResponse, error) {
+	out := new(TransferOrganizationOwnershipResponse)
+	err := c.cc.Invoke(ctx, FrontierService_TransferOrganizationOwnership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) AcceptOrganizationTransfer(ctx context.Context, in *AcceptOrganizationTransferRequest, opts ...grpc.CallOption) (*AcceptOrganizationTransferResponse, error) {
+	out := new(AcceptOrganizationTransferResponse)
+	err := c.cc.Invoke(ctx, FrontierService_AcceptOrganizationTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) DeclineOrganizationTransfer(ctx context.Context, in *DeclineOrganizationTransferRequest, opts ...grpc.CallOption) (*DeclineOrganizationTransferResponse, error) {
+	out := new(DeclineOrganizationTransferResponse)
+	err := c.cc.Invoke(ctx, FrontierService_DeclineOrganizationTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) ListOrganizationPersonalTokens(ctx context.Context, in *ListOrganizationPersonalTokensRequest, opts ...grpc.CallOption) (*ListOrganizationPersonalTokensResponse, error) {
+	out := new(ListOrganizationPersonalTokensResponse)
+	err := c.cc.Invoke(ctx, FrontierService_ListOrganizationPersonalTokens_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) RevokeOrganizationPersonalToken(ctx context.Context, in *RevokeOrganizationPersonalTokenRequest, opts ...grpc.CallOption) (*RevokeOrganizationPersonalTokenResponse, error) {
+	out := new(RevokeOrganizationPersonalTokenResponse)
+	err := c.cc.Invoke(ctx, FrontierService_RevokeOrganizationPersonalToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *frontierServiceClient) EnableOrganization(ctx context.Context, in *EnableOrganizationRequest, opts ...grpc.CallOption) (*EnableOrganizationResponse, error) {
 	out := new(EnableOrganizationResponse)
 	err := c.cc.Invoke(ctx, FrontierService_EnableOrganization_FullMethodName, in, out, opts...)
@@ -995,6 +1333,78 @@ func (c *frontierServiceClient) DeleteProject(ctx context.Context, in *DeletePro
 	return out, nil
 }
 
+func (c *frontierServiceClient) MoveProject(ctx context.Context, in *MoveProjectRequest, opts ...grpc.CallOption) (*MoveProjectResponse, error) {
+	out := new(MoveProjectResponse)
+	err := c.cc.Invoke(ctx, FrontierService_MoveProject_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) ListOrganizationFolders(ctx context.Context, in *ListOrganizationFoldersRequest, opts ...grpc.CallOption) (*ListOrganizationFoldersResponse, error) {
+	out := new(ListOrganizationFoldersResponse)
+	err := c.cc.Invoke(ctx, FrontierService_ListOrganizationFolders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*CreateFolderResponse, error) {
+	out := new(CreateFolderResponse)
+	err := c.cc.Invoke(ctx, FrontierService_CreateFolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) GetFolder(ctx context.Context, in *GetFolderRequest, opts ...grpc.CallOption) (*GetFolderResponse, error) {
+	out := new(GetFolderResponse)
+	err := c.cc.Invoke(ctx, FrontierService_GetFolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) UpdateFolder(ctx context.Context, in *UpdateFolderRequest, opts ...grpc.CallOption) (*UpdateFolderResponse, error) {
+	out := new(UpdateFolderResponse)
+	err := c.cc.Invoke(ctx, FrontierService_UpdateFolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...grpc.CallOption) (*DeleteFolderResponse, error) {
+	out := new(DeleteFolderResponse)
+	err := c.cc.Invoke(ctx, FrontierService_DeleteFolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) ListFolderProjects(ctx context.Context, in *ListFolderProjectsRequest, opts ...grpc.CallOption) (*ListFolderProjectsResponse, error) {
+	out := new(ListFolderProjectsResponse)
+	err := c.cc.Invoke(ctx, FrontierService_ListFolderProjects_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) MoveFolder(ctx context.Context, in *MoveFolderRequest, opts ...grpc.CallOption) (*MoveFolderResponse, error) {
+	out := new(MoveFolderResponse)
+	err := c.cc.Invoke(ctx, FrontierService_MoveFolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *frontierServiceClient) CreatePolicy(ctx context.Context, in *CreatePolicyRequest, opts ...grpc.CallOption) (*CreatePolicyResponse, error) {
 	out := new(CreatePolicyResponse)
 	err := c.cc.Invoke(ctx, FrontierService_CreatePolicy_FullMethodName, in, out, opts...)
@@ -1202,6 +1612,24 @@ func (c *frontierServiceClient) AuthToken(ctx context.Context, in *AuthTokenRequ
 	return out, nil
 }
 
+func (c *frontierServiceClient) AuthDeviceCode(ctx context.Context, in *AuthDeviceCodeRequest, opts ...grpc.CallOption) (*AuthDeviceCodeResponse, error) {
+	out := new(AuthDeviceCodeResponse)
+	err := c.cc.Invoke(ctx, FrontierService_AuthDeviceCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) ApproveAuthDevice(ctx context.Context, in *ApproveAuthDeviceRequest, opts ...grpc.CallOption) (*ApproveAuthDeviceResponse, error) {
+	out := new(ApproveAuthDeviceResponse)
+	err := c.cc.Invoke(ctx, FrontierService_ApproveAuthDevice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *frontierServiceClient) AuthLogout(ctx context.Context, in *AuthLogoutRequest, opts ...grpc.CallOption) (*AuthLogoutResponse, error) {
 	out := new(AuthLogoutResponse)
 	err := c.cc.Invoke(ctx, FrontierService_AuthLogout_FullMethodName, in, out, opts...)
@@ -1310,6 +1738,42 @@ func (c *frontierServiceClient) ListOrganizationPreferences(ctx context.Context,
 	return out, nil
 }
 
+func (c *frontierServiceClient) ListOrganizationMailTemplates(ctx context.Context, in *ListOrganizationMailTemplatesRequest, opts ...grpc.CallOption) (*ListOrganizationMailTemplatesResponse, error) {
+	out := new(ListOrganizationMailTemplatesResponse)
+	err := c.cc.Invoke(ctx, FrontierService_ListOrganizationMailTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) SetOrganizationMailTemplate(ctx context.Context, in *SetOrganizationMailTemplateRequest, opts ...grpc.CallOption) (*SetOrganizationMailTemplateResponse, error) {
+	out := new(SetOrganizationMailTemplateResponse)
+	err := c.cc.Invoke(ctx, FrontierService_SetOrganizationMailTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) DeleteOrganizationMailTemplate(ctx context.Context, in *DeleteOrganizationMailTemplateRequest, opts ...grpc.CallOption) (*DeleteOrganizationMailTemplateResponse, error) {
+	out := new(DeleteOrganizationMailTemplateResponse)
+	err := c.cc.Invoke(ctx, FrontierService_DeleteOrganizationMailTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frontierServiceClient) PreviewOrganizationMailTemplate(ctx context.Context, in *PreviewOrganizationMailTemplateRequest, opts ...grpc.CallOption) (*PreviewOrganizationMailTemplateResponse, error) {
+	out := new(PreviewOrganizationMailTemplateResponse)
+	err := c.cc.Invoke(ctx, FrontierService_PreviewOrganizationMailTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *frontierServiceClient) CreateProjectPreferences(ctx context.Context, in *CreateProjectPreferencesRequest, opts ...grpc.CallOption) (*CreateProjectPreferencesResponse, error) {
 	out := new(CreateProjectPreferencesResponse)
 	err := c.cc.Invoke(ctx, FrontierService_CreateProjectPreferences_FullMethodName, in, out, opts...)
@@ -1395,9 +1859,21 @@ type FrontierServiceServer interface {
 	GetCurrentUser(context.Context, *GetCurrentUserRequest) (*GetCurrentUserResponse, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
 	UpdateCurrentUser(context.Context, *UpdateCurrentUserRequest) (*UpdateCurrentUserResponse, error)
+	ChangeCurrentUserEmail(context.Context, *ChangeCurrentUserEmailRequest) (*ChangeCurrentUserEmailResponse, error)
+	VerifyCurrentUserEmail(context.Context, *VerifyCurrentUserEmailRequest) (*VerifyCurrentUserEmailResponse, error)
+	AddCurrentUserEmail(context.Context, *AddCurrentUserEmailRequest) (*AddCurrentUserEmailResponse, error)
+	ListCurrentUserEmails(context.Context, *ListCurrentUserEmailsRequest) (*ListCurrentUserEmailsResponse, error)
+	RemoveCurrentUserEmail(context.Context, *RemoveCurrentUserEmailRequest) (*RemoveCurrentUserEmailResponse, error)
+	ListCurrentUserIdentities(context.Context, *ListCurrentUserIdentitiesRequest) (*ListCurrentUserIdentitiesResponse, error)
+	UnlinkCurrentUserIdentity(context.Context, *UnlinkCurrentUserIdentityRequest) (*UnlinkCurrentUserIdentityResponse, error)
+	CreateCurrentUserPersonalToken(context.Context, *CreateCurrentUserPersonalTokenRequest) (*CreateCurrentUserPersonalTokenResponse, error)
+	ListCurrentUserPersonalTokens(context.Context, *ListCurrentUserPersonalTokensRequest) (*ListCurrentUserPersonalTokensResponse, error)
+	RevokeCurrentUserPersonalToken(context.Context, *RevokeCurrentUserPersonalTokenRequest) (*RevokeCurrentUserPersonalTokenResponse, error)
 	EnableUser(context.Context, *EnableUserRequest) (*EnableUserResponse, error)
 	DisableUser(context.Context, *DisableUserRequest) (*DisableUserResponse, error)
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	DeleteCurrentUser(context.Context, *DeleteCurrentUserRequest) (*DeleteCurrentUserResponse, error)
+	ExportCurrentUserData(context.Context, *ExportCurrentUserDataRequest) (*ExportCurrentUserDataResponse, error)
 	ListOrganizationsByUser(context.Context, *ListOrganizationsByUserRequest) (*ListOrganizationsByUserResponse, error)
 	ListOrganizationsByCurrentUser(context.Context, *ListOrganizationsByCurrentUserRequest) (*ListOrganizationsByCurrentUserResponse, error)
 	ListProjectsByUser(context.Context, *ListProjectsByUserRequest) (*ListProjectsByUserResponse, error)
@@ -1416,6 +1892,9 @@ type FrontierServiceServer interface {
 	CreateServiceUserSecret(context.Context, *CreateServiceUserSecretRequest) (*CreateServiceUserSecretResponse, error)
 	ListServiceUserSecrets(context.Context, *ListServiceUserSecretsRequest) (*ListServiceUserSecretsResponse, error)
 	DeleteServiceUserSecret(context.Context, *DeleteServiceUserSecretRequest) (*DeleteServiceUserSecretResponse, error)
+	CreateServiceUserTrust(context.Context, *CreateServiceUserTrustRequest) (*CreateServiceUserTrustResponse, error)
+	ListServiceUserTrusts(context.Context, *ListServiceUserTrustsRequest) (*ListServiceUserTrustsResponse, error)
+	DeleteServiceUserTrust(context.Context, *DeleteServiceUserTrustRequest) (*DeleteServiceUserTrustResponse, error)
 	// Group
 	ListOrganizationGroups(context.Context, *ListOrganizationGroupsRequest) (*ListOrganizationGroupsResponse, error)
 	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
@@ -1449,13 +1928,27 @@ type FrontierServiceServer interface {
 	CreateOrganizationInvitation(context.Context, *CreateOrganizationInvitationRequest) (*CreateOrganizationInvitationResponse, error)
 	GetOrganizationInvitation(context.Context, *GetOrganizationInvitationRequest) (*GetOrganizationInvitationResponse, error)
 	AcceptOrganizationInvitation(context.Context, *AcceptOrganizationInvitationRequest) (*AcceptOrganizationInvitationResponse, error)
+	DeclineOrganizationInvitation(context.Context, *DeclineOrganizationInvitationRequest) (*DeclineOrganizationInvitationResponse, error)
 	DeleteOrganizationInvitation(context.Context, *DeleteOrganizationInvitationRequest) (*DeleteOrganizationInvitationResponse, error)
+	CreateOrganizationInviteLink(context.Context, *CreateOrganizationInviteLinkRequest) (*CreateOrganizationInviteLinkResponse, error)
+	ListOrganizationInviteLinks(context.Context, *ListOrganizationInviteLinksRequest) (*ListOrganizationInviteLinksResponse, error)
+	DeleteOrganizationInviteLink(context.Context, *DeleteOrganizationInviteLinkRequest) (*DeleteOrganizationInviteLinkResponse, error)
+	JoinWithInviteLink(context.Context, *JoinWithInviteLinkRequest) (*JoinWithInviteLinkResponse, error)
 	ListOrganizationDomains(context.Context, *ListOrganizationDomainsRequest) (*ListOrganizationDomainsResponse, error)
 	CreateOrganizationDomain(context.Context, *CreateOrganizationDomainRequest) (*CreateOrganizationDomainResponse, error)
 	DeleteOrganizationDomain(context.Context, *DeleteOrganizationDomainRequest) (*DeleteOrganizationDomainResponse, error)
 	GetOrganizationDomain(context.Context, *GetOrganizationDomainRequest) (*GetOrganizationDomainResponse, error)
 	VerifyOrganizationDomain(context.Context, *VerifyOrganizationDomainRequest) (*VerifyOrganizationDomainResponse, error)
+	UpdateOrganizationDomainAutoJoin(context.Context, *UpdateOrganizationDomainAutoJoinRequest) (*UpdateOrganizationDomainAutoJoinResponse, error)
+	// Job
+	GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error)
+	CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error)
 	JoinOrganization(context.Context, *JoinOrganizationRequest) (*JoinOrganizationResponse, error)
+	TransferOrganizationOwnership(context.Context, *TransferOrganizationOwnershipRequest) (*TransferOrganizationOwnershipResponse, error)
+	AcceptOrganizationTransfer(context.Context, *AcceptOrganizationTransferRequest) (*AcceptOrganizationTransferResponse, error)
+	DeclineOrganizationTransfer(context.Context, *DeclineOrganizationTransferRequest) (*DeclineOrganizationTransferResponse, error)
+	ListOrganizationPersonalTokens(context.Context, *ListOrganizationPersonalTokensRequest) (*ListOrganizationPersonalTokensResponse, error)
+	RevokeOrganizationPersonalToken(context.Context, *RevokeOrganizationPersonalTokenRequest) (*RevokeOrganizationPersonalTokenResponse, error)
 	EnableOrganization(context.Context, *EnableOrganizationRequest) (*EnableOrganizationResponse, error)
 	DisableOrganization(context.Context, *DisableOrganizationRequest) (*DisableOrganizationResponse, error)
 	DeleteOrganization(context.Context, *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error)
@@ -1470,6 +1963,15 @@ type FrontierServiceServer interface {
 	EnableProject(context.Context, *EnableProjectRequest) (*EnableProjectResponse, error)
 	DisableProject(context.Context, *DisableProjectRequest) (*DisableProjectResponse, error)
 	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
+	MoveProject(context.Context, *MoveProjectRequest) (*MoveProjectResponse, error)
+	// Folder
+	ListOrganizationFolders(context.Context, *ListOrganizationFoldersRequest) (*ListOrganizationFoldersResponse, error)
+	CreateFolder(context.Context, *CreateFolderRequest) (*CreateFolderResponse, error)
+	GetFolder(context.Context, *GetFolderRequest) (*GetFolderResponse, error)
+	UpdateFolder(context.Context, *UpdateFolderRequest) (*UpdateFolderResponse, error)
+	DeleteFolder(context.Context, *DeleteFolderRequest) (*DeleteFolderResponse, error)
+	ListFolderProjects(context.Context, *ListFolderProjectsRequest) (*ListFolderProjectsResponse, error)
+	MoveFolder(context.Context, *MoveFolderRequest) (*MoveFolderResponse, error)
 	// Policies
 	CreatePolicy(context.Context, *CreatePolicyRequest) (*CreatePolicyResponse, error)
 	GetPolicy(context.Context, *GetPolicyRequest) (*GetPolicyResponse, error)
@@ -1500,6 +2002,8 @@ type FrontierServiceServer interface {
 	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
 	AuthCallback(context.Context, *AuthCallbackRequest) (*AuthCallbackResponse, error)
 	AuthToken(context.Context, *AuthTokenRequest) (*AuthTokenResponse, error)
+	AuthDeviceCode(context.Context, *AuthDeviceCodeRequest) (*AuthDeviceCodeResponse, error)
+	ApproveAuthDevice(context.Context, *ApproveAuthDeviceRequest) (*ApproveAuthDeviceResponse, error)
 	AuthLogout(context.Context, *AuthLogoutRequest) (*AuthLogoutResponse, error)
 	// MetaSchemas
 	ListMetaSchemas(context.Context, *ListMetaSchemasRequest) (*ListMetaSchemasResponse, error)
@@ -1515,6 +2019,10 @@ type FrontierServiceServer interface {
 	DescribePreferences(context.Context, *DescribePreferencesRequest) (*DescribePreferencesResponse, error)
 	CreateOrganizationPreferences(context.Context, *CreateOrganizationPreferencesRequest) (*CreateOrganizationPreferencesResponse, error)
 	ListOrganizationPreferences(context.Context, *ListOrganizationPreferencesRequest) (*ListOrganizationPreferencesResponse, error)
+	ListOrganizationMailTemplates(context.Context, *ListOrganizationMailTemplatesRequest) (*ListOrganizationMailTemplatesResponse, error)
+	SetOrganizationMailTemplate(context.Context, *SetOrganizationMailTemplateRequest) (*SetOrganizationMailTemplateResponse, error)
+	DeleteOrganizationMailTemplate(context.Context, *DeleteOrganizationMailTemplateRequest) (*DeleteOrganizationMailTemplateResponse, error)
+	PreviewOrganizationMailTemplate(context.Context, *PreviewOrganizationMailTemplateRequest) (*PreviewOrganizationMailTemplateResponse, error)
 	CreateProjectPreferences(context.Context, *CreateProjectPreferencesRequest) (*CreateProjectPreferencesResponse, error)
 	ListProjectPreferences(context.Context, *ListProjectPreferencesRequest) (*ListProjectPreferencesResponse, error)
 	CreateGroupPreferences(context.Context, *CreateGroupPreferencesRequest) (*CreateGroupPreferencesResponse, error)
@@ -1554,15 +2062,51 @@ func (UnimplementedFrontierServiceServer) UpdateUser(context.Context, *UpdateUse
 func (UnimplementedFrontierServiceServer) UpdateCurrentUser(context.Context, *UpdateCurrentUserRequest) (*UpdateCurrentUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCurrentUser not implemented")
 }
-func (UnimplementedFrontierServiceServer) EnableUser(context.Context, *EnableUserRequest) (*EnableUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableUser not implemented")
+func (UnimplementedFrontierServiceServer) ChangeCurrentUserEmail(context.Context, *ChangeCurrentUserEmailRequest) (*ChangeCurrentUserEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeCurrentUserEmail not implemented")
 }
-func (UnimplementedFrontierServiceServer) DisableUser(context.Context, *DisableUserRequest) (*DisableUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableUser not implemented")
+func (UnimplementedFrontierServiceServer) VerifyCurrentUserEmail(context.Context, *VerifyCurrentUserEmailRequest) (*VerifyCurrentUserEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCurrentUserEmail not implemented")
+}
+func (UnimplementedFrontierServiceServer) AddCurrentUserEmail(context.Context, *AddCurrentUserEmailRequest) (*AddCurrentUserEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCurrentUserEmail not implemented")
+}
+func (UnimplementedFrontierServiceServer) ListCurrentUserEmails(context.Context, *ListCurrentUserEmailsRequest) (*ListCurrentUserEmailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCurrentUserEmails not implemented")
+}
+func (UnimplementedFrontierServiceServer) RemoveCurrentUserEmail(context.Context, *RemoveCurrentUserEmailRequest) (*RemoveCurrentUserEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCurrentUserEmail not implemented")
+}
+func (UnimplementedFrontierServiceServer) ListCurrentUserIdentities(context.Context, *ListCurrentUserIdentitiesRequest) (*ListCurrentUserIdentitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCurrentUserIdentities not implemented")
+}
+func (UnimplementedFrontierServiceServer) UnlinkCurrentUserIdentity(context.Context, *UnlinkCurrentUserIdentityRequest) (*UnlinkCurrentUserIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkCurrentUserIdentity not implemented")
+}
+func (UnimplementedFrontierServiceServer) CreateCurrentUserPersonalToken(context.Context, *CreateCurrentUserPersonalTokenRequest) (*CreateCurrentUserPersonalTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCurrentUserPersonalToken not implemented")
+}
+func (UnimplementedFrontierServiceServer) ListCurrentUserPersonalTokens(context.Context, *ListCurrentUserPersonalTokensRequest) (*ListCurrentUserPersonalTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCurrentUserPersonalTokens not implemented")
+}
+func (UnimplementedFrontierServiceServer) RevokeCurrentUserPersonalToken(context.Context, *RevokeCurrentUserPersonalTokenRequest) (*RevokeCurrentUserPersonalTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCurrentUserPersonalToken not implemented")
+}
+func (UnimplementedFrontierServiceServer) EnableUser(context.Context, *EnableUserRequest) (*EnableUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableUser not implemented")
+}
+func (UnimplementedFrontierServiceServer) DisableUser(context.Context, *DisableUserRequest) (*DisableUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableUser not implemented")
 }
 func (UnimplementedFrontierServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (UnimplementedFrontierServiceServer) DeleteCurrentUser(context.Context, *DeleteCurrentUserRequest) (*DeleteCurrentUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCurrentUser not implemented")
+}
+func (UnimplementedFrontierServiceServer) ExportCurrentUserData(context.Context, *ExportCurrentUserDataRequest) (*ExportCurrentUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCurrentUserData not implemented")
+}
 func (UnimplementedFrontierServiceServer) ListOrganizationsByUser(context.Context, *ListOrganizationsByUserRequest) (*ListOrganizationsByUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationsByUser not implemented")
 }
@@ -1614,6 +2158,15 @@ func (UnimplementedFrontierServiceServer) ListServiceUserSecrets(context.Context
 func (UnimplementedFrontierServiceServer) DeleteServiceUserSecret(context.Context, *DeleteServiceUserSecretRequest) (*DeleteServiceUserSecretResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteServiceUserSecret not implemented")
 }
+func (UnimplementedFrontierServiceServer) CreateServiceUserTrust(context.Context, *CreateServiceUserTrustRequest) (*CreateServiceUserTrustResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceUserTrust not implemented")
+}
+func (UnimplementedFrontierServiceServer) ListServiceUserTrusts(context.Context, *ListServiceUserTrustsRequest) (*ListServiceUserTrustsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceUserTrusts not implemented")
+}
+func (UnimplementedFrontierServiceServer) DeleteServiceUserTrust(context.Context, *DeleteServiceUserTrustRequest) (*DeleteServiceUserTrustResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServiceUserTrust not implemented")
+}
 func (UnimplementedFrontierServiceServer) ListOrganizationGroups(context.Context, *ListOrganizationGroupsRequest) (*ListOrganizationGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationGroups not implemented")
 }
@@ -1704,9 +2257,24 @@ func (UnimplementedFrontierServiceServer) GetOrganizationInvitation(context.Cont
 func (UnimplementedFrontierServiceServer) AcceptOrganizationInvitation(context.Context, *AcceptOrganizationInvitationRequest) (*AcceptOrganizationInvitationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AcceptOrganizationInvitation not implemented")
 }
+func (UnimplementedFrontierServiceServer) DeclineOrganizationInvitation(context.Context, *DeclineOrganizationInvitationRequest) (*DeclineOrganizationInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineOrganizationInvitation not implemented")
+}
 func (UnimplementedFrontierServiceServer) DeleteOrganizationInvitation(context.Context, *DeleteOrganizationInvitationRequest) (*DeleteOrganizationInvitationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrganizationInvitation not implemented")
 }
+func (UnimplementedFrontierServiceServer) CreateOrganizationInviteLink(context.Context, *CreateOrganizationInviteLinkRequest) (*CreateOrganizationInviteLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganizationInviteLink not implemented")
+}
+func (UnimplementedFrontierServiceServer) ListOrganizationInviteLinks(context.Context, *ListOrganizationInviteLinksRequest) (*ListOrganizationInviteLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationInviteLinks not implemented")
+}
+func (UnimplementedFrontierServiceServer) DeleteOrganizationInviteLink(context.Context, *DeleteOrganizationInviteLinkRequest) (*DeleteOrganizationInviteLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrganizationInviteLink not implemented")
+}
+func (UnimplementedFrontierServiceServer) JoinWithInviteLink(context.Context, *JoinWithInviteLinkRequest) (*JoinWithInviteLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinWithInviteLink not implemented")
+}
 func (UnimplementedFrontierServiceServer) ListOrganizationDomains(context.Context, *ListOrganizationDomainsRequest) (*ListOrganizationDomainsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationDomains not implemented")
 }
@@ -1722,9 +2290,33 @@ func (UnimplementedFrontierServiceServer) GetOrganizationDomain(context.Context,
 func (UnimplementedFrontierServiceServer) VerifyOrganizationDomain(context.Context, *VerifyOrganizationDomainRequest) (*VerifyOrganizationDomainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyOrganizationDomain not implemented")
 }
+func (UnimplementedFrontierServiceServer) UpdateOrganizationDomainAutoJoin(context.Context, *UpdateOrganizationDomainAutoJoinRequest) (*UpdateOrganizationDomainAutoJoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganizationDomainAutoJoin not implemented")
+}
+func (UnimplementedFrontierServiceServer) GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedFrontierServiceServer) CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
+}
 func (UnimplementedFrontierServiceServer) JoinOrganization(context.Context, *JoinOrganizationRequest) (*JoinOrganizationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method JoinOrganization not implemented")
 }
+func (UnimplementedFrontierServiceServer) TransferOrganizationOwnership(context.Context, *TransferOrganizationOwnershipRequest) (*TransferOrganizationOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOrganizationOwnership not implemented")
+}
+func (UnimplementedFrontierServiceServer) AcceptOrganizationTransfer(context.Context, *AcceptOrganizationTransferRequest) (*AcceptOrganizationTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOrganizationTransfer not implemented")
+}
+func (UnimplementedFrontierServiceServer) DeclineOrganizationTransfer(context.Context, *DeclineOrganizationTransferRequest) (*DeclineOrganizationTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineOrganizationTransfer not implemented")
+}
+func (UnimplementedFrontierServiceServer) ListOrganizationPersonalTokens(context.Context, *ListOrganizationPersonalTokensRequest) (*ListOrganizationPersonalTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationPersonalTokens not implemented")
+}
+func (UnimplementedFrontierServiceServer) RevokeOrganizationPersonalToken(context.Context, *RevokeOrganizationPersonalTokenRequest) (*RevokeOrganizationPersonalTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeOrganizationPersonalToken not implemented")
+}
 func (UnimplementedFrontierServiceServer) EnableOrganization(context.Context, *EnableOrganizationRequest) (*EnableOrganizationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnableOrganization not implemented")
 }
@@ -1764,6 +2356,30 @@ func (UnimplementedFrontierServiceServer) DisableProject(context.Context, *Disab
 func (UnimplementedFrontierServiceServer) DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
 }
+func (UnimplementedFrontierServiceServer) MoveProject(context.Context, *MoveProjectRequest) (*MoveProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveProject not implemented")
+}
+func (UnimplementedFrontierServiceServer) ListOrganizationFolders(context.Context, *ListOrganizationFoldersRequest) (*ListOrganizationFoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationFolders not implemented")
+}
+func (UnimplementedFrontierServiceServer) CreateFolder(context.Context, *CreateFolderRequest) (*CreateFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFolder not implemented")
+}
+func (UnimplementedFrontierServiceServer) GetFolder(context.Context, *GetFolderRequest) (*GetFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFolder not implemented")
+}
+func (UnimplementedFrontierServiceServer) UpdateFolder(context.Context, *UpdateFolderRequest) (*UpdateFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFolder not implemented")
+}
+func (UnimplementedFrontierServiceServer) DeleteFolder(context.Context, *DeleteFolderRequest) (*DeleteFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFolder not implemented")
+}
+func (UnimplementedFrontierServiceServer) ListFolderProjects(context.Context, *ListFolderProjectsRequest) (*ListFolderProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFolderProjects not implemented")
+}
+func (UnimplementedFrontierServiceServer) MoveFolder(context.Context, *MoveFolderRequest) (*MoveFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveFolder not implemented")
+}
 func (UnimplementedFrontierServiceServer) CreatePolicy(context.Context, *CreatePolicyRequest) (*CreatePolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePolicy not implemented")
 }
@@ -1833,6 +2449,12 @@ func (UnimplementedFrontierServiceServer) AuthCallback(context.Context, *AuthCal
 func (UnimplementedFrontierServiceServer) AuthToken(context.Context, *AuthTokenRequest) (*AuthTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthToken not implemented")
 }
+func (UnimplementedFrontierServiceServer) AuthDeviceCode(context.Context, *AuthDeviceCodeRequest) (*AuthDeviceCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthDeviceCode not implemented")
+}
+func (UnimplementedFrontierServiceServer) ApproveAuthDevice(context.Context, *ApproveAuthDeviceRequest) (*ApproveAuthDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAuthDevice not implemented")
+}
 func (UnimplementedFrontierServiceServer) AuthLogout(context.Context, *AuthLogoutRequest) (*AuthLogoutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthLogout not implemented")
 }
@@ -1869,6 +2491,18 @@ func (UnimplementedFrontierServiceServer) CreateOrganizationPreferences(context.
 func (UnimplementedFrontierServiceServer) ListOrganizationPreferences(context.Context, *ListOrganizationPreferencesRequest) (*ListOrganizationPreferencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationPreferences not implemented")
 }
+func (UnimplementedFrontierServiceServer) ListOrganizationMailTemplates(context.Context, *ListOrganizationMailTemplatesRequest) (*ListOrganizationMailTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationMailTemplates not implemented")
+}
+func (UnimplementedFrontierServiceServer) SetOrganizationMailTemplate(context.Context, *SetOrganizationMailTemplateRequest) (*SetOrganizationMailTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrganizationMailTemplate not implemented")
+}
+func (UnimplementedFrontierServiceServer) DeleteOrganizationMailTemplate(context.Context, *DeleteOrganizationMailTemplateRequest) (*DeleteOrganizationMailTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrganizationMailTemplate not implemented")
+}
+func (UnimplementedFrontierServiceServer) PreviewOrganizationMailTemplate(context.Context, *PreviewOrganizationMailTemplateRequest) (*PreviewOrganizationMailTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewOrganizationMailTemplate not implemented")
+}
 func (UnimplementedFrontierServiceServer) CreateProjectPreferences(context.Context, *CreateProjectPreferencesRequest) (*CreateProjectPreferencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateProjectPreferences not implemented")
 }
@@ -2050,6 +2684,186 @@ func _FrontierService_UpdateCurrentUser_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FrontierService_ChangeCurrentUserEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeCurrentUserEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).ChangeCurrentUserEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_ChangeCurrentUserEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).ChangeCurrentUserEmail(ctx, req.(*ChangeCurrentUserEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_VerifyCurrentUserEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyCurrentUserEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).VerifyCurrentUserEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_VerifyCurrentUserEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).VerifyCurrentUserEmail(ctx, req.(*VerifyCurrentUserEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_AddCurrentUserEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCurrentUserEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).AddCurrentUserEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_AddCurrentUserEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).AddCurrentUserEmail(ctx, req.(*AddCurrentUserEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_ListCurrentUserEmails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCurrentUserEmailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).ListCurrentUserEmails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_ListCurrentUserEmails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).ListCurrentUserEmails(ctx, req.(*ListCurrentUserEmailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_RemoveCurrentUserEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCurrentUserEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).RemoveCurrentUserEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_RemoveCurrentUserEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).RemoveCurrentUserEmail(ctx, req.(*RemoveCurrentUserEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_ListCurrentUserIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCurrentUserIdentitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).ListCurrentUserIdentities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_ListCurrentUserIdentities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).ListCurrentUserIdentities(ctx, req.(*ListCurrentUserIdentitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_UnlinkCurrentUserIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkCurrentUserIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).UnlinkCurrentUserIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_UnlinkCurrentUserIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).UnlinkCurrentUserIdentity(ctx, req.(*UnlinkCurrentUserIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_CreateCurrentUserPersonalToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCurrentUserPersonalTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).CreateCurrentUserPersonalToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_CreateCurrentUserPersonalToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).CreateCurrentUserPersonalToken(ctx, req.(*CreateCurrentUserPersonalTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_ListCurrentUserPersonalTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCurrentUserPersonalTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).ListCurrentUserPersonalTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_ListCurrentUserPersonalTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).ListCurrentUserPersonalTokens(ctx, req.(*ListCurrentUserPersonalTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_RevokeCurrentUserPersonalToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCurrentUserPersonalTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).RevokeCurrentUserPersonalToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_RevokeCurrentUserPersonalToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).RevokeCurrentUserPersonalToken(ctx, req.(*RevokeCurrentUserPersonalTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FrontierService_EnableUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EnableUserRequest)
 	if err := dec(in); err != nil {
@@ -2104,6 +2918,42 @@ func _FrontierService_DeleteUser_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FrontierService_DeleteCurrentUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCurrentUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).DeleteCurrentUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_DeleteCurrentUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).DeleteCurrentUser(ctx, req.(*DeleteCurrentUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_ExportCurrentUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCurrentUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).ExportCurrentUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_ExportCurrentUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).ExportCurrentUserData(ctx, req.(*ExportCurrentUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FrontierService_ListOrganizationsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListOrganizationsByUserRequest)
 	if err := dec(in); err != nil {
@@ -2410,6 +3260,60 @@ func _FrontierService_DeleteServiceUserSecret_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FrontierService_CreateServiceUserTrust_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceUserTrustRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).CreateServiceUserTrust(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_CreateServiceUserTrust_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).CreateServiceUserTrust(ctx, req.(*CreateServiceUserTrustRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_ListServiceUserTrusts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceUserTrustsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).ListServiceUserTrusts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_ListServiceUserTrusts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).ListServiceUserTrusts(ctx, req.(*ListServiceUserTrustsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_DeleteServiceUserTrust_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceUserTrustRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).DeleteServiceUserTrust(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_DeleteServiceUserTrust_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).DeleteServiceUserTrust(ctx, req.(*DeleteServiceUserTrustRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FrontierService_ListOrganizationGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListOrganizationGroupsRequest)
 	if err := dec(in); err != nil {
@@ -2950,38 +3854,128 @@ func _FrontierService_AcceptOrganizationInvitation_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FrontierService_DeleteOrganizationInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteOrganizationInvitationRequest)
+func _FrontierService_DeclineOrganizationInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineOrganizationInvitationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FrontierServiceServer).DeleteOrganizationInvitation(ctx, in)
+		return srv.(FrontierServiceServer).DeclineOrganizationInvitation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FrontierService_DeleteOrganizationInvitation_FullMethodName,
+		FullMethod: FrontierService_DeclineOrganizationInvitation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FrontierServiceServer).DeleteOrganizationInvitation(ctx, req.(*DeleteOrganizationInvitationRequest))
+		return srv.(FrontierServiceServer).DeclineOrganizationInvitation(ctx, req.(*DeclineOrganizationInvitationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FrontierService_ListOrganizationDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListOrganizationDomainsRequest)
+func _FrontierService_DeleteOrganizationInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationInvitationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FrontierServiceServer).ListOrganizationDomains(ctx, in)
+		return srv.(FrontierServiceServer).DeleteOrganizationInvitation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FrontierService_ListOrganizationDomains_FullMethodName,
+		FullMethod: FrontierService_DeleteOrganizationInvitation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FrontierServiceServer).ListOrganizationDomains(ctx, req.(*ListOrganizationDomainsRequest))
+		return srv.(FrontierServiceServer).DeleteOrganizationInvitation(ctx, req.(*DeleteOrganizationInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_CreateOrganizationInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationInviteLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).CreateOrganizationInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_CreateOrganizationInviteLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).CreateOrganizationInviteLink(ctx, req.(*CreateOrganizationInviteLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_ListOrganizationInviteLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationInviteLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).ListOrganizationInviteLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_ListOrganizationInviteLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).ListOrganizationInviteLinks(ctx, req.(*ListOrganizationInviteLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_DeleteOrganizationInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationInviteLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).DeleteOrganizationInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_DeleteOrganizationInviteLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).DeleteOrganizationInviteLink(ctx, req.(*DeleteOrganizationInviteLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_JoinWithInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinWithInviteLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).JoinWithInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_JoinWithInviteLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).JoinWithInviteLink(ctx, req.(*JoinWithInviteLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_ListOrganizationDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationDomainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).ListOrganizationDomains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_ListOrganizationDomains_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).ListOrganizationDomains(ctx, req.(*ListOrganizationDomainsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3058,6 +4052,60 @@ func _FrontierService_VerifyOrganizationDomain_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FrontierService_UpdateOrganizationDomainAutoJoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationDomainAutoJoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).UpdateOrganizationDomainAutoJoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_UpdateOrganizationDomainAutoJoin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).UpdateOrganizationDomainAutoJoin(ctx, req.(*UpdateOrganizationDomainAutoJoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_GetJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).GetJob(ctx, req.(*GetJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).CancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_CancelJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).CancelJob(ctx, req.(*CancelJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FrontierService_JoinOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(JoinOrganizationRequest)
 	if err := dec(in); err != nil {
@@ -3068,244 +4116,478 @@ func _FrontierService_JoinOrganization_Handler(srv interface{}, ctx context.Cont
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FrontierService_JoinOrganization_FullMethodName,
+		FullMethod: FrontierService_JoinOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).JoinOrganization(ctx, req.(*JoinOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_TransferOrganizationOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOrganizationOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).TransferOrganizationOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_TransferOrganizationOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).TransferOrganizationOwnership(ctx, req.(*TransferOrganizationOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_AcceptOrganizationTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptOrganizationTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).AcceptOrganizationTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_AcceptOrganizationTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).AcceptOrganizationTransfer(ctx, req.(*AcceptOrganizationTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_DeclineOrganizationTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineOrganizationTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).DeclineOrganizationTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_DeclineOrganizationTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).DeclineOrganizationTransfer(ctx, req.(*DeclineOrganizationTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_ListOrganizationPersonalTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationPersonalTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).ListOrganizationPersonalTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_ListOrganizationPersonalTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).ListOrganizationPersonalTokens(ctx, req.(*ListOrganizationPersonalTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_RevokeOrganizationPersonalToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeOrganizationPersonalTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).RevokeOrganizationPersonalToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_RevokeOrganizationPersonalToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).RevokeOrganizationPersonalToken(ctx, req.(*RevokeOrganizationPersonalTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_EnableOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).EnableOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_EnableOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).EnableOrganization(ctx, req.(*EnableOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_DisableOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).DisableOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_DisableOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).DisableOrganization(ctx, req.(*DisableOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_DeleteOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).DeleteOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_DeleteOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).DeleteOrganization(ctx, req.(*DeleteOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).CreateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_CreateProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).CreateProject(ctx, req.(*CreateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_GetProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).GetProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_GetProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).GetProject(ctx, req.(*GetProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).UpdateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_UpdateProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).UpdateProject(ctx, req.(*UpdateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_ListProjectAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectAdminsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).ListProjectAdmins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_ListProjectAdmins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).ListProjectAdmins(ctx, req.(*ListProjectAdminsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_ListProjectUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).ListProjectUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_ListProjectUsers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FrontierServiceServer).JoinOrganization(ctx, req.(*JoinOrganizationRequest))
+		return srv.(FrontierServiceServer).ListProjectUsers(ctx, req.(*ListProjectUsersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FrontierService_EnableOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EnableOrganizationRequest)
+func _FrontierService_ListProjectServiceUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectServiceUsersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FrontierServiceServer).EnableOrganization(ctx, in)
+		return srv.(FrontierServiceServer).ListProjectServiceUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FrontierService_EnableOrganization_FullMethodName,
+		FullMethod: FrontierService_ListProjectServiceUsers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FrontierServiceServer).EnableOrganization(ctx, req.(*EnableOrganizationRequest))
+		return srv.(FrontierServiceServer).ListProjectServiceUsers(ctx, req.(*ListProjectServiceUsersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FrontierService_DisableOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DisableOrganizationRequest)
+func _FrontierService_ListProjectGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectGroupsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FrontierServiceServer).DisableOrganization(ctx, in)
+		return srv.(FrontierServiceServer).ListProjectGroups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FrontierService_DisableOrganization_FullMethodName,
+		FullMethod: FrontierService_ListProjectGroups_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FrontierServiceServer).DisableOrganization(ctx, req.(*DisableOrganizationRequest))
+		return srv.(FrontierServiceServer).ListProjectGroups(ctx, req.(*ListProjectGroupsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FrontierService_DeleteOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteOrganizationRequest)
+func _FrontierService_EnableProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableProjectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FrontierServiceServer).DeleteOrganization(ctx, in)
+		return srv.(FrontierServiceServer).EnableProject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FrontierService_DeleteOrganization_FullMethodName,
+		FullMethod: FrontierService_EnableProject_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FrontierServiceServer).DeleteOrganization(ctx, req.(*DeleteOrganizationRequest))
+		return srv.(FrontierServiceServer).EnableProject(ctx, req.(*EnableProjectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FrontierService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProjectRequest)
+func _FrontierService_DisableProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableProjectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FrontierServiceServer).CreateProject(ctx, in)
+		return srv.(FrontierServiceServer).DisableProject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FrontierService_CreateProject_FullMethodName,
+		FullMethod: FrontierService_DisableProject_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FrontierServiceServer).CreateProject(ctx, req.(*CreateProjectRequest))
+		return srv.(FrontierServiceServer).DisableProject(ctx, req.(*DisableProjectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FrontierService_GetProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProjectRequest)
+func _FrontierService_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FrontierServiceServer).GetProject(ctx, in)
+		return srv.(FrontierServiceServer).DeleteProject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FrontierService_GetProject_FullMethodName,
+		FullMethod: FrontierService_DeleteProject_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FrontierServiceServer).GetProject(ctx, req.(*GetProjectRequest))
+		return srv.(FrontierServiceServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FrontierService_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProjectRequest)
+func _FrontierService_MoveProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveProjectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FrontierServiceServer).UpdateProject(ctx, in)
+		return srv.(FrontierServiceServer).MoveProject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FrontierService_UpdateProject_FullMethodName,
+		FullMethod: FrontierService_MoveProject_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FrontierServiceServer).UpdateProject(ctx, req.(*UpdateProjectRequest))
+		return srv.(FrontierServiceServer).MoveProject(ctx, req.(*MoveProjectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FrontierService_ListProjectAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProjectAdminsRequest)
+func _FrontierService_ListOrganizationFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationFoldersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FrontierServiceServer).ListProjectAdmins(ctx, in)
+		return srv.(FrontierServiceServer).ListOrganizationFolders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FrontierService_ListProjectAdmins_FullMethodName,
+		FullMethod: FrontierService_ListOrganizationFolders_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FrontierServiceServer).ListProjectAdmins(ctx, req.(*ListProjectAdminsRequest))
+		return srv.(FrontierServiceServer).ListOrganizationFolders(ctx, req.(*ListOrganizationFoldersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FrontierService_ListProjectUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProjectUsersRequest)
+func _FrontierService_CreateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFolderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FrontierServiceServer).ListProjectUsers(ctx, in)
+		return srv.(FrontierServiceServer).CreateFolder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FrontierService_ListProjectUsers_FullMethodName,
+		FullMethod: FrontierService_CreateFolder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FrontierServiceServer).ListProjectUsers(ctx, req.(*ListProjectUsersRequest))
+		return srv.(FrontierServiceServer).CreateFolder(ctx, req.(*CreateFolderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FrontierService_ListProjectServiceUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProjectServiceUsersRequest)
+func _FrontierService_GetFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFolderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FrontierServiceServer).ListProjectServiceUsers(ctx, in)
+		return srv.(FrontierServiceServer).GetFolder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FrontierService_ListProjectServiceUsers_FullMethodName,
+		FullMethod: FrontierService_GetFolder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FrontierServiceServer).ListProjectServiceUsers(ctx, req.(*ListProjectServiceUsersRequest))
+		return srv.(FrontierServiceServer).GetFolder(ctx, req.(*GetFolderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FrontierService_ListProjectGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProjectGroupsRequest)
+func _FrontierService_UpdateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFolderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FrontierServiceServer).ListProjectGroups(ctx, in)
+		return srv.(FrontierServiceServer).UpdateFolder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FrontierService_ListProjectGroups_FullMethodName,
+		FullMethod: FrontierService_UpdateFolder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FrontierServiceServer).ListProjectGroups(ctx, req.(*ListProjectGroupsRequest))
+		return srv.(FrontierServiceServer).UpdateFolder(ctx, req.(*UpdateFolderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FrontierService_EnableProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EnableProjectRequest)
+func _FrontierService_DeleteFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFolderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FrontierServiceServer).EnableProject(ctx, in)
+		return srv.(FrontierServiceServer).DeleteFolder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FrontierService_EnableProject_FullMethodName,
+		FullMethod: FrontierService_DeleteFolder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FrontierServiceServer).EnableProject(ctx, req.(*EnableProjectRequest))
+		return srv.(FrontierServiceServer).DeleteFolder(ctx, req.(*DeleteFolderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FrontierService_DisableProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DisableProjectRequest)
+func _FrontierService_ListFolderProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFolderProjectsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FrontierServiceServer).DisableProject(ctx, in)
+		return srv.(FrontierServiceServer).ListFolderProjects(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FrontierService_DisableProject_FullMethodName,
+		FullMethod: FrontierService_ListFolderProjects_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FrontierServiceServer).DisableProject(ctx, req.(*DisableProjectRequest))
+		return srv.(FrontierServiceServer).ListFolderProjects(ctx, req.(*ListFolderProjectsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FrontierService_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteProjectRequest)
+func _FrontierService_MoveFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveFolderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FrontierServiceServer).DeleteProject(ctx, in)
+		return srv.(FrontierServiceServer).MoveFolder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FrontierService_DeleteProject_FullMethodName,
+		FullMethod: FrontierService_MoveFolder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FrontierServiceServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+		return srv.(FrontierServiceServer).MoveFolder(ctx, req.(*MoveFolderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3724,6 +5006,42 @@ func _FrontierService_AuthToken_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FrontierService_AuthDeviceCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthDeviceCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).AuthDeviceCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_AuthDeviceCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).AuthDeviceCode(ctx, req.(*AuthDeviceCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_ApproveAuthDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveAuthDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).ApproveAuthDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_ApproveAuthDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).ApproveAuthDevice(ctx, req.(*ApproveAuthDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FrontierService_AuthLogout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AuthLogoutRequest)
 	if err := dec(in); err != nil {
@@ -3940,6 +5258,78 @@ func _FrontierService_ListOrganizationPreferences_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FrontierService_ListOrganizationMailTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationMailTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).ListOrganizationMailTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_ListOrganizationMailTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).ListOrganizationMailTemplates(ctx, req.(*ListOrganizationMailTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_SetOrganizationMailTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOrganizationMailTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).SetOrganizationMailTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_SetOrganizationMailTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).SetOrganizationMailTemplate(ctx, req.(*SetOrganizationMailTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_DeleteOrganizationMailTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationMailTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).DeleteOrganizationMailTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_DeleteOrganizationMailTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).DeleteOrganizationMailTemplate(ctx, req.(*DeleteOrganizationMailTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrontierService_PreviewOrganizationMailTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewOrganizationMailTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrontierServiceServer).PreviewOrganizationMailTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrontierService_PreviewOrganizationMailTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrontierServiceServer).PreviewOrganizationMailTemplate(ctx, req.(*PreviewOrganizationMailTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FrontierService_CreateProjectPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateProjectPreferencesRequest)
 	if err := dec(in); err != nil {
@@ -4123,6 +5513,46 @@ var FrontierService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCurrentUser",
 			Handler:    _FrontierService_UpdateCurrentUser_Handler,
 		},
+		{
+			MethodName: "ChangeCurrentUserEmail",
+			Handler:    _FrontierService_ChangeCurrentUserEmail_Handler,
+		},
+		{
+			MethodName: "VerifyCurrentUserEmail",
+			Handler:    _FrontierService_VerifyCurrentUserEmail_Handler,
+		},
+		{
+			MethodName: "AddCurrentUserEmail",
+			Handler:    _FrontierService_AddCurrentUserEmail_Handler,
+		},
+		{
+			MethodName: "ListCurrentUserEmails",
+			Handler:    _FrontierService_ListCurrentUserEmails_Handler,
+		},
+		{
+			MethodName: "RemoveCurrentUserEmail",
+			Handler:    _FrontierService_RemoveCurrentUserEmail_Handler,
+		},
+		{
+			MethodName: "ListCurrentUserIdentities",
+			Handler:    _FrontierService_ListCurrentUserIdentities_Handler,
+		},
+		{
+			MethodName: "UnlinkCurrentUserIdentity",
+			Handler:    _FrontierService_UnlinkCurrentUserIdentity_Handler,
+		},
+		{
+			MethodName: "CreateCurrentUserPersonalToken",
+			Handler:    _FrontierService_CreateCurrentUserPersonalToken_Handler,
+		},
+		{
+			MethodName: "ListCurrentUserPersonalTokens",
+			Handler:    _FrontierService_ListCurrentUserPersonalTokens_Handler,
+		},
+		{
+			MethodName: "RevokeCurrentUserPersonalToken",
+			Handler:    _FrontierService_RevokeCurrentUserPersonalToken_Handler,
+		},
 		{
 			MethodName: "EnableUser",
 			Handler:    _FrontierService_EnableUser_Handler,
@@ -4135,6 +5565,14 @@ var FrontierService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _FrontierService_DeleteUser_Handler,
 		},
+		{
+			MethodName: "DeleteCurrentUser",
+			Handler:    _FrontierService_DeleteCurrentUser_Handler,
+		},
+		{
+			MethodName: "ExportCurrentUserData",
+			Handler:    _FrontierService_ExportCurrentUserData_Handler,
+		},
 		{
 			MethodName: "ListOrganizationsByUser",
 			Handler:    _FrontierService_ListOrganizationsByUser_Handler,
@@ -4203,6 +5641,18 @@ var FrontierService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteServiceUserSecret",
 			Handler:    _FrontierService_DeleteServiceUserSecret_Handler,
 		},
+		{
+			MethodName: "CreateServiceUserTrust",
+			Handler:    _FrontierService_CreateServiceUserTrust_Handler,
+		},
+		{
+			MethodName: "ListServiceUserTrusts",
+			Handler:    _FrontierService_ListServiceUserTrusts_Handler,
+		},
+		{
+			MethodName: "DeleteServiceUserTrust",
+			Handler:    _FrontierService_DeleteServiceUserTrust_Handler,
+		},
 		{
 			MethodName: "ListOrganizationGroups",
 			Handler:    _FrontierService_ListOrganizationGroups_Handler,
@@ -4323,10 +5773,30 @@ var FrontierService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AcceptOrganizationInvitation",
 			Handler:    _FrontierService_AcceptOrganizationInvitation_Handler,
 		},
+		{
+			MethodName: "DeclineOrganizationInvitation",
+			Handler:    _FrontierService_DeclineOrganizationInvitation_Handler,
+		},
 		{
 			MethodName: "DeleteOrganizationInvitation",
 			Handler:    _FrontierService_DeleteOrganizationInvitation_Handler,
 		},
+		{
+			MethodName: "CreateOrganizationInviteLink",
+			Handler:    _FrontierService_CreateOrganizationInviteLink_Handler,
+		},
+		{
+			MethodName: "ListOrganizationInviteLinks",
+			Handler:    _FrontierService_ListOrganizationInviteLinks_Handler,
+		},
+		{
+			MethodName: "DeleteOrganizationInviteLink",
+			Handler:    _FrontierService_DeleteOrganizationInviteLink_Handler,
+		},
+		{
+			MethodName: "JoinWithInviteLink",
+			Handler:    _FrontierService_JoinWithInviteLink_Handler,
+		},
 		{
 			MethodName: "ListOrganizationDomains",
 			Handler:    _FrontierService_ListOrganizationDomains_Handler,
@@ -4347,10 +5817,42 @@ var FrontierService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyOrganizationDomain",
 			Handler:    _FrontierService_VerifyOrganizationDomain_Handler,
 		},
+		{
+			MethodName: "UpdateOrganizationDomainAutoJoin",
+			Handler:    _FrontierService_UpdateOrganizationDomainAutoJoin_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _FrontierService_GetJob_Handler,
+		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _FrontierService_CancelJob_Handler,
+		},
 		{
 			MethodName: "JoinOrganization",
 			Handler:    _FrontierService_JoinOrganization_Handler,
 		},
+		{
+			MethodName: "TransferOrganizationOwnership",
+			Handler:    _FrontierService_TransferOrganizationOwnership_Handler,
+		},
+		{
+			MethodName: "AcceptOrganizationTransfer",
+			Handler:    _FrontierService_AcceptOrganizationTransfer_Handler,
+		},
+		{
+			MethodName: "DeclineOrganizationTransfer",
+			Handler:    _FrontierService_DeclineOrganizationTransfer_Handler,
+		},
+		{
+			MethodName: "ListOrganizationPersonalTokens",
+			Handler:    _FrontierService_ListOrganizationPersonalTokens_Handler,
+		},
+		{
+			MethodName: "RevokeOrganizationPersonalToken",
+			Handler:    _FrontierService_RevokeOrganizationPersonalToken_Handler,
+		},
 		{
 			MethodName: "EnableOrganization",
 			Handler:    _FrontierService_EnableOrganization_Handler,
@@ -4403,6 +5905,38 @@ var FrontierService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProject",
 			Handler:    _FrontierService_DeleteProject_Handler,
 		},
+		{
+			MethodName: "MoveProject",
+			Handler:    _FrontierService_MoveProject_Handler,
+		},
+		{
+			MethodName: "ListOrganizationFolders",
+			Handler:    _FrontierService_ListOrganizationFolders_Handler,
+		},
+		{
+			MethodName: "CreateFolder",
+			Handler:    _FrontierService_CreateFolder_Handler,
+		},
+		{
+			MethodName: "GetFolder",
+			Handler:    _FrontierService_GetFolder_Handler,
+		},
+		{
+			MethodName: "UpdateFolder",
+			Handler:    _FrontierService_UpdateFolder_Handler,
+		},
+		{
+			MethodName: "DeleteFolder",
+			Handler:    _FrontierService_DeleteFolder_Handler,
+		},
+		{
+			MethodName: "ListFolderProjects",
+			Handler:    _FrontierService_ListFolderProjects_Handler,
+		},
+		{
+			MethodName: "MoveFolder",
+			Handler:    _FrontierService_MoveFolder_Handler,
+		},
 		{
 			MethodName: "CreatePolicy",
 			Handler:    _FrontierService_CreatePolicy_Handler,
@@ -4495,6 +6029,14 @@ var FrontierService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AuthToken",
 			Handler:    _FrontierService_AuthToken_Handler,
 		},
+		{
+			MethodName: "AuthDeviceCode",
+			Handler:    _FrontierService_AuthDeviceCode_Handler,
+		},
+		{
+			MethodName: "ApproveAuthDevice",
+			Handler:    _FrontierService_ApproveAuthDevice_Handler,
+		},
 		{
 			MethodName: "AuthLogout",
 			Handler:    _FrontierService_AuthLogout_Handler,
@@ -4543,6 +6085,22 @@ var FrontierService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListOrganizationPreferences",
 			Handler:    _FrontierService_ListOrganizationPreferences_Handler,
 		},
+		{
+			MethodName: "ListOrganizationMailTemplates",
+			Handler:    _FrontierService_ListOrganizationMailTemplates_Handler,
+		},
+		{
+			MethodName: "SetOrganizationMailTemplate",
+			Handler:    _FrontierService_SetOrganizationMailTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteOrganizationMailTemplate",
+			Handler:    _FrontierService_DeleteOrganizationMailTemplate_Handler,
+		},
+		{
+			MethodName: "PreviewOrganizationMailTemplate",
+			Handler:    _FrontierService_PreviewOrganizationMailTemplate_Handler,
+		},
 		{
 			MethodName: "CreateProjectPreferences",
 			Handler:    _FrontierService_CreateProjectPreferences_Handler,
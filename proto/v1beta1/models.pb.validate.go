@@ -1327,6 +1327,8 @@ func (m *Project) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for FolderId
+
 	if len(errors) > 0 {
 		return ProjectMultiError(errors)
 	}
@@ -1406,21 +1408,21 @@ var _ interface {
 
 var _Project_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9-_]+$")
 
-// Validate checks the field values on Domain with the rules defined in the
+// Validate checks the field values on Folder with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Domain) Validate() error {
+func (m *Folder) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Domain with the rules defined in the
+// ValidateAll checks the field values on Folder with the rules defined in the
 // proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in DomainMultiError, or nil if none found.
-func (m *Domain) ValidateAll() error {
+// a list of violation errors wrapped in FolderMultiError, or nil if none found.
+func (m *Folder) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Domain) validate(all bool) error {
+func (m *Folder) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1430,7 +1432,7 @@ func (m *Domain) validate(all bool) error {
 	// no validation rules for Id
 
 	if utf8.RuneCountInString(m.GetName()) < 2 {
-		err := DomainValidationError{
+		err := FolderValidationError{
 			field:  "Name",
 			reason: "value length must be at least 2 runes",
 		}
@@ -1440,17 +1442,57 @@ func (m *Domain) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if !_Folder_Name_Pattern.MatchString(m.GetName()) {
+		err := FolderValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9-_]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Title
+
 	// no validation rules for OrgId
 
-	// no validation rules for Token
+	// no validation rules for ParentId
 
-	// no validation rules for State
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FolderValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FolderValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FolderValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if all {
 		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DomainValidationError{
+				errors = append(errors, FolderValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1458,7 +1500,7 @@ func (m *Domain) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DomainValidationError{
+				errors = append(errors, FolderValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1467,7 +1509,7 @@ func (m *Domain) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DomainValidationError{
+			return FolderValidationError{
 				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1479,7 +1521,7 @@ func (m *Domain) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DomainValidationError{
+				errors = append(errors, FolderValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1487,7 +1529,7 @@ func (m *Domain) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DomainValidationError{
+				errors = append(errors, FolderValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1496,7 +1538,7 @@ func (m *Domain) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DomainValidationError{
+			return FolderValidationError{
 				field:  "UpdatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1505,18 +1547,18 @@ func (m *Domain) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DomainMultiError(errors)
+		return FolderMultiError(errors)
 	}
 
 	return nil
 }
 
-// DomainMultiError is an error wrapping multiple validation errors returned by
-// Domain.ValidateAll() if the designated constraints aren't met.
-type DomainMultiError []error
+// FolderMultiError is an error wrapping multiple validation errors returned by
+// Folder.ValidateAll() if the designated constraints aren't met.
+type FolderMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DomainMultiError) Error() string {
+func (m FolderMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1525,11 +1567,11 @@ func (m DomainMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DomainMultiError) AllErrors() []error { return m }
+func (m FolderMultiError) AllErrors() []error { return m }
 
-// DomainValidationError is the validation error returned by Domain.Validate if
+// FolderValidationError is the validation error returned by Folder.Validate if
 // the designated constraints aren't met.
-type DomainValidationError struct {
+type FolderValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1537,22 +1579,22 @@ type DomainValidationError struct {
 }
 
 // Field function returns field value.
-func (e DomainValidationError) Field() string { return e.field }
+func (e FolderValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DomainValidationError) Reason() string { return e.reason }
+func (e FolderValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DomainValidationError) Cause() error { return e.cause }
+func (e FolderValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DomainValidationError) Key() bool { return e.key }
+func (e FolderValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DomainValidationError) ErrorName() string { return "DomainValidationError" }
+func (e FolderValidationError) ErrorName() string { return "FolderValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DomainValidationError) Error() string {
+func (e FolderValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1564,14 +1606,14 @@ func (e DomainValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDomain.%s: %s%s",
+		"invalid %sFolder.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DomainValidationError{}
+var _ error = FolderValidationError{}
 
 var _ interface {
 	Field() string
@@ -1579,23 +1621,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DomainValidationError{}
+} = FolderValidationError{}
 
-// Validate checks the field values on Policy with the rules defined in the
+var _Folder_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9-_]+$")
+
+// Validate checks the field values on Domain with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Policy) Validate() error {
+func (m *Domain) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Policy with the rules defined in the
+// ValidateAll checks the field values on Domain with the rules defined in the
 // proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in PolicyMultiError, or nil if none found.
-func (m *Policy) ValidateAll() error {
+// a list of violation errors wrapped in DomainMultiError, or nil if none found.
+func (m *Domain) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Policy) validate(all bool) error {
+func (m *Domain) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1604,13 +1648,28 @@ func (m *Policy) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Title
+	if utf8.RuneCountInString(m.GetName()) < 2 {
+		err := DomainValidationError{
+			field:  "Name",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OrgId
+
+	// no validation rules for Token
+
+	// no validation rules for State
 
 	if all {
 		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PolicyValidationError{
+				errors = append(errors, DomainValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1618,7 +1677,7 @@ func (m *Policy) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PolicyValidationError{
+				errors = append(errors, DomainValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1627,7 +1686,7 @@ func (m *Policy) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PolicyValidationError{
+			return DomainValidationError{
 				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1639,7 +1698,7 @@ func (m *Policy) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PolicyValidationError{
+				errors = append(errors, DomainValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1647,7 +1706,7 @@ func (m *Policy) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PolicyValidationError{
+				errors = append(errors, DomainValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1656,7 +1715,7 @@ func (m *Policy) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PolicyValidationError{
+			return DomainValidationError{
 				field:  "UpdatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1664,35 +1723,29 @@ func (m *Policy) validate(all bool) error {
 		}
 	}
 
-	// no validation rules for RoleId
-
-	// no validation rules for Resource
-
-	// no validation rules for Principal
-
 	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
+		switch v := interface{}(m.GetAutoJoin()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PolicyValidationError{
-					field:  "Metadata",
+				errors = append(errors, DomainValidationError{
+					field:  "AutoJoin",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PolicyValidationError{
-					field:  "Metadata",
+				errors = append(errors, DomainValidationError{
+					field:  "AutoJoin",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetAutoJoin()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PolicyValidationError{
-				field:  "Metadata",
+			return DomainValidationError{
+				field:  "AutoJoin",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1700,18 +1753,18 @@ func (m *Policy) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PolicyMultiError(errors)
+		return DomainMultiError(errors)
 	}
 
 	return nil
 }
 
-// PolicyMultiError is an error wrapping multiple validation errors returned by
-// Policy.ValidateAll() if the designated constraints aren't met.
-type PolicyMultiError []error
+// DomainMultiError is an error wrapping multiple validation errors returned by
+// Domain.ValidateAll() if the designated constraints aren't met.
+type DomainMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PolicyMultiError) Error() string {
+func (m DomainMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1720,11 +1773,11 @@ func (m PolicyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PolicyMultiError) AllErrors() []error { return m }
+func (m DomainMultiError) AllErrors() []error { return m }
 
-// PolicyValidationError is the validation error returned by Policy.Validate if
+// DomainValidationError is the validation error returned by Domain.Validate if
 // the designated constraints aren't met.
-type PolicyValidationError struct {
+type DomainValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1732,22 +1785,22 @@ type PolicyValidationError struct {
 }
 
 // Field function returns field value.
-func (e PolicyValidationError) Field() string { return e.field }
+func (e DomainValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PolicyValidationError) Reason() string { return e.reason }
+func (e DomainValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PolicyValidationError) Cause() error { return e.cause }
+func (e DomainValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PolicyValidationError) Key() bool { return e.key }
+func (e DomainValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PolicyValidationError) ErrorName() string { return "PolicyValidationError" }
+func (e DomainValidationError) ErrorName() string { return "DomainValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PolicyValidationError) Error() string {
+func (e DomainValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1759,14 +1812,14 @@ func (e PolicyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPolicy.%s: %s%s",
+		"invalid %sDomain.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PolicyValidationError{}
+var _ error = DomainValidationError{}
 
 var _ interface {
 	Field() string
@@ -1774,111 +1827,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PolicyValidationError{}
+} = DomainValidationError{}
 
-// Validate checks the field values on Relation with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on DomainAutoJoin with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Relation) Validate() error {
+func (m *DomainAutoJoin) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Relation with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RelationMultiError, or nil
-// if none found.
-func (m *Relation) ValidateAll() error {
+// ValidateAll checks the field values on DomainAutoJoin with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DomainAutoJoinMultiError,
+// or nil if none found.
+func (m *DomainAutoJoin) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Relation) validate(all bool) error {
+func (m *DomainAutoJoin) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RelationValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, RelationValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RelationValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RelationValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, RelationValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RelationValidationError{
-				field:  "UpdatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for SubjectSubRelation
-
-	// no validation rules for Relation
-
-	// no validation rules for Object
-
-	// no validation rules for Subject
+	// no validation rules for Enabled
 
 	if len(errors) > 0 {
-		return RelationMultiError(errors)
+		return DomainAutoJoinMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationMultiError is an error wrapping multiple validation errors returned
-// by Relation.ValidateAll() if the designated constraints aren't met.
-type RelationMultiError []error
+// DomainAutoJoinMultiError is an error wrapping multiple validation errors
+// returned by DomainAutoJoin.ValidateAll() if the designated constraints
+// aren't met.
+type DomainAutoJoinMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationMultiError) Error() string {
+func (m DomainAutoJoinMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1887,11 +1875,11 @@ func (m RelationMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationMultiError) AllErrors() []error { return m }
+func (m DomainAutoJoinMultiError) AllErrors() []error { return m }
 
-// RelationValidationError is the validation error returned by
-// Relation.Validate if the designated constraints aren't met.
-type RelationValidationError struct {
+// DomainAutoJoinValidationError is the validation error returned by
+// DomainAutoJoin.Validate if the designated constraints aren't met.
+type DomainAutoJoinValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1899,22 +1887,22 @@ type RelationValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationValidationError) Field() string { return e.field }
+func (e DomainAutoJoinValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationValidationError) Reason() string { return e.reason }
+func (e DomainAutoJoinValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationValidationError) Cause() error { return e.cause }
+func (e DomainAutoJoinValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationValidationError) Key() bool { return e.key }
+func (e DomainAutoJoinValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationValidationError) ErrorName() string { return "RelationValidationError" }
+func (e DomainAutoJoinValidationError) ErrorName() string { return "DomainAutoJoinValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RelationValidationError) Error() string {
+func (e DomainAutoJoinValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1926,14 +1914,14 @@ func (e RelationValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelation.%s: %s%s",
+		"invalid %sDomainAutoJoin.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationValidationError{}
+var _ error = DomainAutoJoinValidationError{}
 
 var _ interface {
 	Field() string
@@ -1941,24 +1929,23 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationValidationError{}
+} = DomainAutoJoinValidationError{}
 
-// Validate checks the field values on Permission with the rules defined in the
+// Validate checks the field values on Policy with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Permission) Validate() error {
+func (m *Policy) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Permission with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PermissionMultiError, or
-// nil if none found.
-func (m *Permission) ValidateAll() error {
+// ValidateAll checks the field values on Policy with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PolicyMultiError, or nil if none found.
+func (m *Policy) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Permission) validate(all bool) error {
+func (m *Policy) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1967,35 +1954,13 @@ func (m *Permission) validate(all bool) error {
 
 	// no validation rules for Id
 
-	if utf8.RuneCountInString(m.GetName()) < 2 {
-		err := PermissionValidationError{
-			field:  "Name",
-			reason: "value length must be at least 2 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_Permission_Name_Pattern.MatchString(m.GetName()) {
-		err := PermissionValidationError{
-			field:  "Name",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	// no validation rules for Title
 
 	if all {
 		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionValidationError{
+				errors = append(errors, PolicyValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2003,7 +1968,7 @@ func (m *Permission) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionValidationError{
+				errors = append(errors, PolicyValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2012,7 +1977,7 @@ func (m *Permission) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PermissionValidationError{
+			return PolicyValidationError{
 				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2024,7 +1989,7 @@ func (m *Permission) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionValidationError{
+				errors = append(errors, PolicyValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2032,7 +1997,7 @@ func (m *Permission) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionValidationError{
+				errors = append(errors, PolicyValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2041,7 +2006,7 @@ func (m *Permission) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PermissionValidationError{
+			return PolicyValidationError{
 				field:  "UpdatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2049,13 +2014,17 @@ func (m *Permission) validate(all bool) error {
 		}
 	}
 
-	// no validation rules for Namespace
+	// no validation rules for RoleId
+
+	// no validation rules for Resource
+
+	// no validation rules for Principal
 
 	if all {
 		switch v := interface{}(m.GetMetadata()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionValidationError{
+				errors = append(errors, PolicyValidationError{
 					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2063,7 +2032,7 @@ func (m *Permission) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionValidationError{
+				errors = append(errors, PolicyValidationError{
 					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2072,7 +2041,7 @@ func (m *Permission) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PermissionValidationError{
+			return PolicyValidationError{
 				field:  "Metadata",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2080,21 +2049,21 @@ func (m *Permission) validate(all bool) error {
 		}
 	}
 
-	// no validation rules for Key
+	// no validation rules for Effect
 
 	if len(errors) > 0 {
-		return PermissionMultiError(errors)
+		return PolicyMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionMultiError is an error wrapping multiple validation errors
-// returned by Permission.ValidateAll() if the designated constraints aren't met.
-type PermissionMultiError []error
+// PolicyMultiError is an error wrapping multiple validation errors returned by
+// Policy.ValidateAll() if the designated constraints aren't met.
+type PolicyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionMultiError) Error() string {
+func (m PolicyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2103,11 +2072,11 @@ func (m PermissionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionMultiError) AllErrors() []error { return m }
+func (m PolicyMultiError) AllErrors() []error { return m }
 
-// PermissionValidationError is the validation error returned by
-// Permission.Validate if the designated constraints aren't met.
-type PermissionValidationError struct {
+// PolicyValidationError is the validation error returned by Policy.Validate if
+// the designated constraints aren't met.
+type PolicyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2115,22 +2084,22 @@ type PermissionValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionValidationError) Field() string { return e.field }
+func (e PolicyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionValidationError) Reason() string { return e.reason }
+func (e PolicyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionValidationError) Cause() error { return e.cause }
+func (e PolicyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionValidationError) Key() bool { return e.key }
+func (e PolicyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionValidationError) ErrorName() string { return "PermissionValidationError" }
+func (e PolicyValidationError) ErrorName() string { return "PolicyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PermissionValidationError) Error() string {
+func (e PolicyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2142,14 +2111,14 @@ func (e PermissionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermission.%s: %s%s",
+		"invalid %sPolicy.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionValidationError{}
+var _ error = PolicyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2157,26 +2126,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionValidationError{}
-
-var _Permission_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9]+$")
+} = PolicyValidationError{}
 
-// Validate checks the field values on Namespace with the rules defined in the
+// Validate checks the field values on Relation with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Namespace) Validate() error {
+func (m *Relation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Namespace with the rules defined in
+// ValidateAll checks the field values on Relation with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in NamespaceMultiError, or nil
+// result is a list of violation errors wrapped in RelationMultiError, or nil
 // if none found.
-func (m *Namespace) ValidateAll() error {
+func (m *Relation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Namespace) validate(all bool) error {
+func (m *Relation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2185,51 +2152,11 @@ func (m *Namespace) validate(all bool) error {
 
 	// no validation rules for Id
 
-	if !_Namespace_Name_Pattern.MatchString(m.GetName()) {
-		err := NamespaceValidationError{
-			field:  "Name",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9/]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, NamespaceValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, NamespaceValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return NamespaceValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if all {
 		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, NamespaceValidationError{
+				errors = append(errors, RelationValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2237,7 +2164,7 @@ func (m *Namespace) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, NamespaceValidationError{
+				errors = append(errors, RelationValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2246,7 +2173,7 @@ func (m *Namespace) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return NamespaceValidationError{
+			return RelationValidationError{
 				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2258,7 +2185,7 @@ func (m *Namespace) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, NamespaceValidationError{
+				errors = append(errors, RelationValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2266,7 +2193,7 @@ func (m *Namespace) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, NamespaceValidationError{
+				errors = append(errors, RelationValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2275,7 +2202,7 @@ func (m *Namespace) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return NamespaceValidationError{
+			return RelationValidationError{
 				field:  "UpdatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2283,19 +2210,27 @@ func (m *Namespace) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for SubjectSubRelation
+
+	// no validation rules for Relation
+
+	// no validation rules for Object
+
+	// no validation rules for Subject
+
 	if len(errors) > 0 {
-		return NamespaceMultiError(errors)
+		return RelationMultiError(errors)
 	}
 
 	return nil
 }
 
-// NamespaceMultiError is an error wrapping multiple validation errors returned
-// by Namespace.ValidateAll() if the designated constraints aren't met.
-type NamespaceMultiError []error
+// RelationMultiError is an error wrapping multiple validation errors returned
+// by Relation.ValidateAll() if the designated constraints aren't met.
+type RelationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NamespaceMultiError) Error() string {
+func (m RelationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2304,11 +2239,11 @@ func (m NamespaceMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NamespaceMultiError) AllErrors() []error { return m }
+func (m RelationMultiError) AllErrors() []error { return m }
 
-// NamespaceValidationError is the validation error returned by
-// Namespace.Validate if the designated constraints aren't met.
-type NamespaceValidationError struct {
+// RelationValidationError is the validation error returned by
+// Relation.Validate if the designated constraints aren't met.
+type RelationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2316,22 +2251,22 @@ type NamespaceValidationError struct {
 }
 
 // Field function returns field value.
-func (e NamespaceValidationError) Field() string { return e.field }
+func (e RelationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NamespaceValidationError) Reason() string { return e.reason }
+func (e RelationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NamespaceValidationError) Cause() error { return e.cause }
+func (e RelationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NamespaceValidationError) Key() bool { return e.key }
+func (e RelationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NamespaceValidationError) ErrorName() string { return "NamespaceValidationError" }
+func (e RelationValidationError) ErrorName() string { return "RelationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e NamespaceValidationError) Error() string {
+func (e RelationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2343,14 +2278,14 @@ func (e NamespaceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNamespace.%s: %s%s",
+		"invalid %sRelation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NamespaceValidationError{}
+var _ error = RelationValidationError{}
 
 var _ interface {
 	Field() string
@@ -2358,26 +2293,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NamespaceValidationError{}
-
-var _Namespace_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9/]+$")
+} = RelationValidationError{}
 
-// Validate checks the field values on Resource with the rules defined in the
+// Validate checks the field values on Permission with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Resource) Validate() error {
+func (m *Permission) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Resource with the rules defined in
+// ValidateAll checks the field values on Permission with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ResourceMultiError, or nil
-// if none found.
-func (m *Resource) ValidateAll() error {
+// result is a list of violation errors wrapped in PermissionMultiError, or
+// nil if none found.
+func (m *Permission) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Resource) validate(all bool) error {
+func (m *Permission) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2386,13 +2319,35 @@ func (m *Resource) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Name
+	if utf8.RuneCountInString(m.GetName()) < 2 {
+		err := PermissionValidationError{
+			field:  "Name",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Permission_Name_Pattern.MatchString(m.GetName()) {
+		err := PermissionValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Title
 
 	if all {
 		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ResourceValidationError{
+				errors = append(errors, PermissionValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2400,7 +2355,7 @@ func (m *Resource) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ResourceValidationError{
+				errors = append(errors, PermissionValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2409,7 +2364,7 @@ func (m *Resource) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ResourceValidationError{
+			return PermissionValidationError{
 				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2421,7 +2376,7 @@ func (m *Resource) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ResourceValidationError{
+				errors = append(errors, PermissionValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2429,7 +2384,7 @@ func (m *Resource) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ResourceValidationError{
+				errors = append(errors, PermissionValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2438,7 +2393,7 @@ func (m *Resource) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ResourceValidationError{
+			return PermissionValidationError{
 				field:  "UpdatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2446,19 +2401,13 @@ func (m *Resource) validate(all bool) error {
 		}
 	}
 
-	// no validation rules for Urn
-
-	// no validation rules for ProjectId
-
 	// no validation rules for Namespace
 
-	// no validation rules for Principal
-
 	if all {
 		switch v := interface{}(m.GetMetadata()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ResourceValidationError{
+				errors = append(errors, PermissionValidationError{
 					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2466,7 +2415,7 @@ func (m *Resource) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ResourceValidationError{
+				errors = append(errors, PermissionValidationError{
 					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2475,7 +2424,7 @@ func (m *Resource) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ResourceValidationError{
+			return PermissionValidationError{
 				field:  "Metadata",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2483,21 +2432,21 @@ func (m *Resource) validate(all bool) error {
 		}
 	}
 
-	// no validation rules for Title
+	// no validation rules for Key
 
 	if len(errors) > 0 {
-		return ResourceMultiError(errors)
+		return PermissionMultiError(errors)
 	}
 
 	return nil
 }
 
-// ResourceMultiError is an error wrapping multiple validation errors returned
-// by Resource.ValidateAll() if the designated constraints aren't met.
-type ResourceMultiError []error
+// PermissionMultiError is an error wrapping multiple validation errors
+// returned by Permission.ValidateAll() if the designated constraints aren't met.
+type PermissionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ResourceMultiError) Error() string {
+func (m PermissionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2506,11 +2455,11 @@ func (m ResourceMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ResourceMultiError) AllErrors() []error { return m }
+func (m PermissionMultiError) AllErrors() []error { return m }
 
-// ResourceValidationError is the validation error returned by
-// Resource.Validate if the designated constraints aren't met.
-type ResourceValidationError struct {
+// PermissionValidationError is the validation error returned by
+// Permission.Validate if the designated constraints aren't met.
+type PermissionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2518,22 +2467,22 @@ type ResourceValidationError struct {
 }
 
 // Field function returns field value.
-func (e ResourceValidationError) Field() string { return e.field }
+func (e PermissionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ResourceValidationError) Reason() string { return e.reason }
+func (e PermissionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ResourceValidationError) Cause() error { return e.cause }
+func (e PermissionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ResourceValidationError) Key() bool { return e.key }
+func (e PermissionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ResourceValidationError) ErrorName() string { return "ResourceValidationError" }
+func (e PermissionValidationError) ErrorName() string { return "PermissionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ResourceValidationError) Error() string {
+func (e PermissionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2545,14 +2494,14 @@ func (e ResourceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sResource.%s: %s%s",
+		"invalid %sPermission.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ResourceValidationError{}
+var _ error = PermissionValidationError{}
 
 var _ interface {
 	Field() string
@@ -2560,24 +2509,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ResourceValidationError{}
+} = PermissionValidationError{}
 
-// Validate checks the field values on MetaSchema with the rules defined in the
+var _Permission_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9]+$")
+
+// Validate checks the field values on Namespace with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *MetaSchema) Validate() error {
+func (m *Namespace) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetaSchema with the rules defined in
+// ValidateAll checks the field values on Namespace with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MetaSchemaMultiError, or
-// nil if none found.
-func (m *MetaSchema) ValidateAll() error {
+// result is a list of violation errors wrapped in NamespaceMultiError, or nil
+// if none found.
+func (m *Namespace) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetaSchema) validate(all bool) error {
+func (m *Namespace) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2586,10 +2537,10 @@ func (m *MetaSchema) validate(all bool) error {
 
 	// no validation rules for Id
 
-	if utf8.RuneCountInString(m.GetName()) < 2 {
-		err := MetaSchemaValidationError{
+	if !_Namespace_Name_Pattern.MatchString(m.GetName()) {
+		err := NamespaceValidationError{
 			field:  "Name",
-			reason: "value length must be at least 2 runes",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9/]+$\"",
 		}
 		if !all {
 			return err
@@ -2597,24 +2548,40 @@ func (m *MetaSchema) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_MetaSchema_Name_Pattern.MatchString(m.GetName()) {
-		err := MetaSchemaValidationError{
-			field:  "Name",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9-_]+$\"",
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NamespaceValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NamespaceValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NamespaceValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for Schema
-
 	if all {
 		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetaSchemaValidationError{
+				errors = append(errors, NamespaceValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2622,7 +2589,7 @@ func (m *MetaSchema) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetaSchemaValidationError{
+				errors = append(errors, NamespaceValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2631,7 +2598,7 @@ func (m *MetaSchema) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetaSchemaValidationError{
+			return NamespaceValidationError{
 				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2643,7 +2610,7 @@ func (m *MetaSchema) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetaSchemaValidationError{
+				errors = append(errors, NamespaceValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2651,7 +2618,7 @@ func (m *MetaSchema) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetaSchemaValidationError{
+				errors = append(errors, NamespaceValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2660,7 +2627,7 @@ func (m *MetaSchema) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetaSchemaValidationError{
+			return NamespaceValidationError{
 				field:  "UpdatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2669,18 +2636,18 @@ func (m *MetaSchema) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return MetaSchemaMultiError(errors)
+		return NamespaceMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetaSchemaMultiError is an error wrapping multiple validation errors
-// returned by MetaSchema.ValidateAll() if the designated constraints aren't met.
-type MetaSchemaMultiError []error
+// NamespaceMultiError is an error wrapping multiple validation errors returned
+// by Namespace.ValidateAll() if the designated constraints aren't met.
+type NamespaceMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetaSchemaMultiError) Error() string {
+func (m NamespaceMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2689,11 +2656,11 @@ func (m MetaSchemaMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetaSchemaMultiError) AllErrors() []error { return m }
+func (m NamespaceMultiError) AllErrors() []error { return m }
 
-// MetaSchemaValidationError is the validation error returned by
-// MetaSchema.Validate if the designated constraints aren't met.
-type MetaSchemaValidationError struct {
+// NamespaceValidationError is the validation error returned by
+// Namespace.Validate if the designated constraints aren't met.
+type NamespaceValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2701,22 +2668,22 @@ type MetaSchemaValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetaSchemaValidationError) Field() string { return e.field }
+func (e NamespaceValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetaSchemaValidationError) Reason() string { return e.reason }
+func (e NamespaceValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetaSchemaValidationError) Cause() error { return e.cause }
+func (e NamespaceValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetaSchemaValidationError) Key() bool { return e.key }
+func (e NamespaceValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetaSchemaValidationError) ErrorName() string { return "MetaSchemaValidationError" }
+func (e NamespaceValidationError) ErrorName() string { return "NamespaceValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MetaSchemaValidationError) Error() string {
+func (e NamespaceValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2728,14 +2695,14 @@ func (e MetaSchemaValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetaSchema.%s: %s%s",
+		"invalid %sNamespace.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetaSchemaValidationError{}
+var _ error = NamespaceValidationError{}
 
 var _ interface {
 	Field() string
@@ -2743,26 +2710,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetaSchemaValidationError{}
+} = NamespaceValidationError{}
 
-var _MetaSchema_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9-_]+$")
+var _Namespace_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9/]+$")
 
-// Validate checks the field values on Invitation with the rules defined in the
+// Validate checks the field values on Resource with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Invitation) Validate() error {
+func (m *Resource) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Invitation with the rules defined in
+// ValidateAll checks the field values on Resource with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in InvitationMultiError, or
-// nil if none found.
-func (m *Invitation) ValidateAll() error {
+// result is a list of violation errors wrapped in ResourceMultiError, or nil
+// if none found.
+func (m *Resource) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Invitation) validate(all bool) error {
+func (m *Resource) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2771,33 +2738,31 @@ func (m *Invitation) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for UserId
-
-	// no validation rules for OrgId
+	// no validation rules for Name
 
 	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InvitationValidationError{
-					field:  "Metadata",
+				errors = append(errors, ResourceValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, InvitationValidationError{
-					field:  "Metadata",
+				errors = append(errors, ResourceValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return InvitationValidationError{
-				field:  "Metadata",
+			return ResourceValidationError{
+				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2805,76 +2770,86 @@ func (m *Invitation) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InvitationValidationError{
-					field:  "CreatedAt",
+				errors = append(errors, ResourceValidationError{
+					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, InvitationValidationError{
-					field:  "CreatedAt",
+				errors = append(errors, ResourceValidationError{
+					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return InvitationValidationError{
-				field:  "CreatedAt",
+			return ResourceValidationError{
+				field:  "UpdatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Urn
+
+	// no validation rules for ProjectId
+
+	// no validation rules for Namespace
+
+	// no validation rules for Principal
+
 	if all {
-		switch v := interface{}(m.GetExpiresAt()).(type) {
+		switch v := interface{}(m.GetMetadata()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InvitationValidationError{
-					field:  "ExpiresAt",
+				errors = append(errors, ResourceValidationError{
+					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, InvitationValidationError{
-					field:  "ExpiresAt",
+				errors = append(errors, ResourceValidationError{
+					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return InvitationValidationError{
-				field:  "ExpiresAt",
+			return ResourceValidationError{
+				field:  "Metadata",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Title
+
 	if len(errors) > 0 {
-		return InvitationMultiError(errors)
+		return ResourceMultiError(errors)
 	}
 
 	return nil
 }
 
-// InvitationMultiError is an error wrapping multiple validation errors
-// returned by Invitation.ValidateAll() if the designated constraints aren't met.
-type InvitationMultiError []error
+// ResourceMultiError is an error wrapping multiple validation errors returned
+// by Resource.ValidateAll() if the designated constraints aren't met.
+type ResourceMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InvitationMultiError) Error() string {
+func (m ResourceMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2883,34 +2858,2121 @@ func (m InvitationMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InvitationMultiError) AllErrors() []error { return m }
+func (m ResourceMultiError) AllErrors() []error { return m }
 
-// InvitationValidationError is the validation error returned by
-// Invitation.Validate if the designated constraints aren't met.
-type InvitationValidationError struct {
-	field  string
+// ResourceValidationError is the validation error returned by
+// Resource.Validate if the designated constraints aren't met.
+type ResourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResourceValidationError) ErrorName() string { return "ResourceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResourceValidationError{}
+
+// Validate checks the field values on MetaSchema with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MetaSchema) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetaSchema with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MetaSchemaMultiError, or
+// nil if none found.
+func (m *MetaSchema) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetaSchema) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if utf8.RuneCountInString(m.GetName()) < 2 {
+		err := MetaSchemaValidationError{
+			field:  "Name",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_MetaSchema_Name_Pattern.MatchString(m.GetName()) {
+		err := MetaSchemaValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9-_]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Schema
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetaSchemaValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetaSchemaValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetaSchemaValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetaSchemaValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetaSchemaValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetaSchemaValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MetaSchemaMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetaSchemaMultiError is an error wrapping multiple validation errors
+// returned by MetaSchema.ValidateAll() if the designated constraints aren't met.
+type MetaSchemaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetaSchemaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetaSchemaMultiError) AllErrors() []error { return m }
+
+// MetaSchemaValidationError is the validation error returned by
+// MetaSchema.Validate if the designated constraints aren't met.
+type MetaSchemaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetaSchemaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetaSchemaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetaSchemaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetaSchemaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetaSchemaValidationError) ErrorName() string { return "MetaSchemaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetaSchemaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetaSchema.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetaSchemaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetaSchemaValidationError{}
+
+var _MetaSchema_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9-_]+$")
+
+// Validate checks the field values on Invitation with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Invitation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Invitation with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InvitationMultiError, or
+// nil if none found.
+func (m *Invitation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Invitation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for OrgId
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvitationValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvitationValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvitationValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvitationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvitationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvitationValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvitationValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvitationValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvitationValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return InvitationMultiError(errors)
+	}
+
+	return nil
+}
+
+// InvitationMultiError is an error wrapping multiple validation errors
+// returned by Invitation.ValidateAll() if the designated constraints aren't met.
+type InvitationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InvitationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InvitationMultiError) AllErrors() []error { return m }
+
+// InvitationValidationError is the validation error returned by
+// Invitation.Validate if the designated constraints aren't met.
+type InvitationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InvitationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InvitationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InvitationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InvitationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InvitationValidationError) ErrorName() string { return "InvitationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InvitationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvitation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InvitationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InvitationValidationError{}
+
+// Validate checks the field values on InviteLink with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InviteLink) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InviteLink with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InviteLinkMultiError, or
+// nil if none found.
+func (m *InviteLink) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InviteLink) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OrgId
+
+	// no validation rules for MaxUses
+
+	// no validation rules for Uses
+
+	// no validation rules for CreatedBy
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InviteLinkValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InviteLinkValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InviteLinkValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InviteLinkValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InviteLinkValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InviteLinkValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return InviteLinkMultiError(errors)
+	}
+
+	return nil
+}
+
+// InviteLinkMultiError is an error wrapping multiple validation errors
+// returned by InviteLink.ValidateAll() if the designated constraints aren't met.
+type InviteLinkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InviteLinkMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InviteLinkMultiError) AllErrors() []error { return m }
+
+// InviteLinkValidationError is the validation error returned by
+// InviteLink.Validate if the designated constraints aren't met.
+type InviteLinkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteLinkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteLinkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteLinkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteLinkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteLinkValidationError) ErrorName() string { return "InviteLinkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InviteLinkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInviteLink.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteLinkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteLinkValidationError{}
+
+// Validate checks the field values on MailTemplate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MailTemplate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MailTemplate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MailTemplateMultiError, or
+// nil if none found.
+func (m *MailTemplate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MailTemplate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for OrgId
+
+	// no validation rules for Locale
+
+	// no validation rules for Subject
+
+	// no validation rules for HtmlBody
+
+	// no validation rules for TextBody
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MailTemplateValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MailTemplateValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MailTemplateValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MailTemplateMultiError(errors)
+	}
+
+	return nil
+}
+
+// MailTemplateMultiError is an error wrapping multiple validation errors
+// returned by MailTemplate.ValidateAll() if the designated constraints aren't met.
+type MailTemplateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MailTemplateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MailTemplateMultiError) AllErrors() []error { return m }
+
+// MailTemplateValidationError is the validation error returned by
+// MailTemplate.Validate if the designated constraints aren't met.
+type MailTemplateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MailTemplateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MailTemplateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MailTemplateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MailTemplateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MailTemplateValidationError) ErrorName() string { return "MailTemplateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MailTemplateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMailTemplate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MailTemplateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MailTemplateValidationError{}
+
+// Validate checks the field values on MailMessage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MailMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MailMessage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MailMessageMultiError, or
+// nil if none found.
+func (m *MailMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MailMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Subject
+
+	// no validation rules for HtmlBody
+
+	// no validation rules for TextBody
+
+	if len(errors) > 0 {
+		return MailMessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// MailMessageMultiError is an error wrapping multiple validation errors
+// returned by MailMessage.ValidateAll() if the designated constraints aren't met.
+type MailMessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MailMessageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MailMessageMultiError) AllErrors() []error { return m }
+
+// MailMessageValidationError is the validation error returned by
+// MailMessage.Validate if the designated constraints aren't met.
+type MailMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MailMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MailMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MailMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MailMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MailMessageValidationError) ErrorName() string { return "MailMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MailMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMailMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MailMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MailMessageValidationError{}
+
+// Validate checks the field values on Mail with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Mail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Mail with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MailMultiError, or nil if none found.
+func (m *Mail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Mail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Template
+
+	// no validation rules for OrgId
+
+	// no validation rules for Subject
+
+	// no validation rules for Status
+
+	// no validation rules for Attempts
+
+	// no validation rules for LastError
+
+	if all {
+		switch v := interface{}(m.GetNextAttemptAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MailValidationError{
+					field:  "NextAttemptAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MailValidationError{
+					field:  "NextAttemptAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextAttemptAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MailValidationError{
+				field:  "NextAttemptAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSentAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MailValidationError{
+					field:  "SentAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MailValidationError{
+					field:  "SentAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSentAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MailValidationError{
+				field:  "SentAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MailValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MailValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MailValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MailMultiError(errors)
+	}
+
+	return nil
+}
+
+// MailMultiError is an error wrapping multiple validation errors returned by
+// Mail.ValidateAll() if the designated constraints aren't met.
+type MailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MailMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MailMultiError) AllErrors() []error { return m }
+
+// MailValidationError is the validation error returned by Mail.Validate if the
+// designated constraints aren't met.
+type MailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MailValidationError) ErrorName() string { return "MailValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MailValidationError{}
+
+// Validate checks the field values on ServiceUserKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ServiceUserKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ServiceUserKey with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ServiceUserKeyMultiError,
+// or nil if none found.
+func (m *ServiceUserKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ServiceUserKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Title
+
+	// no validation rules for PrincipalId
+
+	// no validation rules for PublicKey
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceUserKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceUserKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceUserKeyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceUserKeyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceUserKeyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceUserKeyValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastUsedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceUserKeyValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceUserKeyValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastUsedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceUserKeyValidationError{
+				field:  "LastUsedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastUsedIp
+
+	// no validation rules for JwksUrl
+
+	if len(errors) > 0 {
+		return ServiceUserKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ServiceUserKeyMultiError is an error wrapping multiple validation errors
+// returned by ServiceUserKey.ValidateAll() if the designated constraints
+// aren't met.
+type ServiceUserKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ServiceUserKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ServiceUserKeyMultiError) AllErrors() []error { return m }
+
+// ServiceUserKeyValidationError is the validation error returned by
+// ServiceUserKey.Validate if the designated constraints aren't met.
+type ServiceUserKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServiceUserKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServiceUserKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServiceUserKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServiceUserKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServiceUserKeyValidationError) ErrorName() string { return "ServiceUserKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ServiceUserKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServiceUserKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServiceUserKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServiceUserKeyValidationError{}
+
+// Validate checks the field values on KeyCredential with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *KeyCredential) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KeyCredential with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in KeyCredentialMultiError, or
+// nil if none found.
+func (m *KeyCredential) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KeyCredential) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Kid
+
+	// no validation rules for PrincipalId
+
+	// no validation rules for PrivateKey
+
+	if len(errors) > 0 {
+		return KeyCredentialMultiError(errors)
+	}
+
+	return nil
+}
+
+// KeyCredentialMultiError is an error wrapping multiple validation errors
+// returned by KeyCredential.ValidateAll() if the designated constraints
+// aren't met.
+type KeyCredentialMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KeyCredentialMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KeyCredentialMultiError) AllErrors() []error { return m }
+
+// KeyCredentialValidationError is the validation error returned by
+// KeyCredential.Validate if the designated constraints aren't met.
+type KeyCredentialValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KeyCredentialValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KeyCredentialValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KeyCredentialValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KeyCredentialValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KeyCredentialValidationError) ErrorName() string { return "KeyCredentialValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KeyCredentialValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKeyCredential.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KeyCredentialValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KeyCredentialValidationError{}
+
+// Validate checks the field values on SecretCredential with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SecretCredential) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecretCredential with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SecretCredentialMultiError, or nil if none found.
+func (m *SecretCredential) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecretCredential) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Title
+
+	// no validation rules for Secret
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecretCredentialValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecretCredentialValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecretCredentialValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecretCredentialValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecretCredentialValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecretCredentialValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastUsedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecretCredentialValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecretCredentialValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastUsedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecretCredentialValidationError{
+				field:  "LastUsedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastUsedIp
+
+	if len(errors) > 0 {
+		return SecretCredentialMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecretCredentialMultiError is an error wrapping multiple validation errors
+// returned by SecretCredential.ValidateAll() if the designated constraints
+// aren't met.
+type SecretCredentialMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecretCredentialMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecretCredentialMultiError) AllErrors() []error { return m }
+
+// SecretCredentialValidationError is the validation error returned by
+// SecretCredential.Validate if the designated constraints aren't met.
+type SecretCredentialValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecretCredentialValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecretCredentialValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecretCredentialValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecretCredentialValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecretCredentialValidationError) ErrorName() string { return "SecretCredentialValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SecretCredentialValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecretCredential.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecretCredentialValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecretCredentialValidationError{}
+
+// Validate checks the field values on ServiceUserTrust with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ServiceUserTrust) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ServiceUserTrust with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ServiceUserTrustMultiError, or nil if none found.
+func (m *ServiceUserTrust) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ServiceUserTrust) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ServiceUserId
+
+	// no validation rules for Title
+
+	// no validation rules for Issuer
+
+	// no validation rules for JwksUrl
+
+	// no validation rules for Subject
+
+	// no validation rules for Audience
+
+	// no validation rules for Claims
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceUserTrustValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceUserTrustValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceUserTrustValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceUserTrustValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceUserTrustValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceUserTrustValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ServiceUserTrustMultiError(errors)
+	}
+
+	return nil
+}
+
+// ServiceUserTrustMultiError is an error wrapping multiple validation errors
+// returned by ServiceUserTrust.ValidateAll() if the designated constraints
+// aren't met.
+type ServiceUserTrustMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ServiceUserTrustMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ServiceUserTrustMultiError) AllErrors() []error { return m }
+
+// ServiceUserTrustValidationError is the validation error returned by
+// ServiceUserTrust.Validate if the designated constraints aren't met.
+type ServiceUserTrustValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServiceUserTrustValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServiceUserTrustValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServiceUserTrustValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServiceUserTrustValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServiceUserTrustValidationError) ErrorName() string { return "ServiceUserTrustValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ServiceUserTrustValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServiceUserTrust.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServiceUserTrustValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServiceUserTrustValidationError{}
+
+// Validate checks the field values on JSONWebKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JSONWebKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JSONWebKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JSONWebKeyMultiError, or
+// nil if none found.
+func (m *JSONWebKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JSONWebKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kty
+
+	// no validation rules for Alg
+
+	// no validation rules for Use
+
+	// no validation rules for Kid
+
+	// no validation rules for N
+
+	// no validation rules for E
+
+	// no validation rules for X
+
+	// no validation rules for Y
+
+	// no validation rules for Crv
+
+	if len(errors) > 0 {
+		return JSONWebKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// JSONWebKeyMultiError is an error wrapping multiple validation errors
+// returned by JSONWebKey.ValidateAll() if the designated constraints aren't met.
+type JSONWebKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JSONWebKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JSONWebKeyMultiError) AllErrors() []error { return m }
+
+// JSONWebKeyValidationError is the validation error returned by
+// JSONWebKey.Validate if the designated constraints aren't met.
+type JSONWebKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JSONWebKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JSONWebKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JSONWebKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JSONWebKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JSONWebKeyValidationError) ErrorName() string { return "JSONWebKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JSONWebKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJSONWebKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JSONWebKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JSONWebKeyValidationError{}
+
+// Validate checks the field values on OrganizationTransfer with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrganizationTransfer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrganizationTransfer with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrganizationTransferMultiError, or nil if none found.
+func (m *OrganizationTransfer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrganizationTransfer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OrgId
+
+	// no validation rules for FromUserId
+
+	// no validation rules for ToUserId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationTransferValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationTransferValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationTransferValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrganizationTransferMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrganizationTransferMultiError is an error wrapping multiple validation
+// errors returned by OrganizationTransfer.ValidateAll() if the designated
+// constraints aren't met.
+type OrganizationTransferMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrganizationTransferMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrganizationTransferMultiError) AllErrors() []error { return m }
+
+// OrganizationTransferValidationError is the validation error returned by
+// OrganizationTransfer.Validate if the designated constraints aren't met.
+type OrganizationTransferValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrganizationTransferValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrganizationTransferValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrganizationTransferValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrganizationTransferValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrganizationTransferValidationError) ErrorName() string {
+	return "OrganizationTransferValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrganizationTransferValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrganizationTransfer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrganizationTransferValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrganizationTransferValidationError{}
+
+// Validate checks the field values on UserIdentity with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserIdentity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserIdentity with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserIdentityMultiError, or
+// nil if none found.
+func (m *UserIdentity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserIdentity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Provider
+
+	// no validation rules for Email
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserIdentityValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserIdentityValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserIdentityValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserIdentityValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserIdentityValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserIdentityValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserIdentityMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserIdentityMultiError is an error wrapping multiple validation errors
+// returned by UserIdentity.ValidateAll() if the designated constraints aren't met.
+type UserIdentityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserIdentityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserIdentityMultiError) AllErrors() []error { return m }
+
+// UserIdentityValidationError is the validation error returned by
+// UserIdentity.Validate if the designated constraints aren't met.
+type UserIdentityValidationError struct {
+	field  string
 	reason string
 	cause  error
 	key    bool
 }
 
 // Field function returns field value.
-func (e InvitationValidationError) Field() string { return e.field }
+func (e UserIdentityValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InvitationValidationError) Reason() string { return e.reason }
+func (e UserIdentityValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InvitationValidationError) Cause() error { return e.cause }
+func (e UserIdentityValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InvitationValidationError) Key() bool { return e.key }
+func (e UserIdentityValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InvitationValidationError) ErrorName() string { return "InvitationValidationError" }
+func (e UserIdentityValidationError) ErrorName() string { return "UserIdentityValidationError" }
 
 // Error satisfies the builtin error interface
-func (e InvitationValidationError) Error() string {
+func (e UserIdentityValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2922,14 +4984,14 @@ func (e InvitationValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInvitation.%s: %s%s",
+		"invalid %sUserIdentity.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InvitationValidationError{}
+var _ error = UserIdentityValidationError{}
 
 var _ interface {
 	Field() string
@@ -2937,43 +4999,231 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InvitationValidationError{}
+} = UserIdentityValidationError{}
 
-// Validate checks the field values on ServiceUserKey with the rules defined in
+// Validate checks the field values on UserEmail with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserEmail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserEmail with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserEmailMultiError, or nil
+// if none found.
+func (m *UserEmail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserEmail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserEmailValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserEmailValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserEmailValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserEmailMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserEmailMultiError is an error wrapping multiple validation errors returned
+// by UserEmail.ValidateAll() if the designated constraints aren't met.
+type UserEmailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserEmailMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserEmailMultiError) AllErrors() []error { return m }
+
+// UserEmailValidationError is the validation error returned by
+// UserEmail.Validate if the designated constraints aren't met.
+type UserEmailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserEmailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserEmailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserEmailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserEmailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserEmailValidationError) ErrorName() string { return "UserEmailValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserEmailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserEmail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserEmailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserEmailValidationError{}
+
+// Validate checks the field values on PersonalToken with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ServiceUserKey) Validate() error {
+func (m *PersonalToken) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ServiceUserKey with the rules defined
+// ValidateAll checks the field values on PersonalToken with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ServiceUserKeyMultiError,
-// or nil if none found.
-func (m *ServiceUserKey) ValidateAll() error {
+// result is a list of violation errors wrapped in PersonalTokenMultiError, or
+// nil if none found.
+func (m *PersonalToken) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ServiceUserKey) validate(all bool) error {
+func (m *PersonalToken) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for Id
-
-	// no validation rules for Title
-
-	// no validation rules for PrincipalId
-
-	// no validation rules for PublicKey
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Title
+
+	// no validation rules for OrgId
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PersonalTokenValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PersonalTokenValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PersonalTokenValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastUsedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PersonalTokenValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PersonalTokenValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastUsedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PersonalTokenValidationError{
+				field:  "LastUsedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if all {
 		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ServiceUserKeyValidationError{
+				errors = append(errors, PersonalTokenValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2981,7 +5231,7 @@ func (m *ServiceUserKey) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ServiceUserKeyValidationError{
+				errors = append(errors, PersonalTokenValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2990,7 +5240,7 @@ func (m *ServiceUserKey) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ServiceUserKeyValidationError{
+			return PersonalTokenValidationError{
 				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2999,19 +5249,19 @@ func (m *ServiceUserKey) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ServiceUserKeyMultiError(errors)
+		return PersonalTokenMultiError(errors)
 	}
 
 	return nil
 }
 
-// ServiceUserKeyMultiError is an error wrapping multiple validation errors
-// returned by ServiceUserKey.ValidateAll() if the designated constraints
+// PersonalTokenMultiError is an error wrapping multiple validation errors
+// returned by PersonalToken.ValidateAll() if the designated constraints
 // aren't met.
-type ServiceUserKeyMultiError []error
+type PersonalTokenMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ServiceUserKeyMultiError) Error() string {
+func (m PersonalTokenMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3020,11 +5270,11 @@ func (m ServiceUserKeyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ServiceUserKeyMultiError) AllErrors() []error { return m }
+func (m PersonalTokenMultiError) AllErrors() []error { return m }
 
-// ServiceUserKeyValidationError is the validation error returned by
-// ServiceUserKey.Validate if the designated constraints aren't met.
-type ServiceUserKeyValidationError struct {
+// PersonalTokenValidationError is the validation error returned by
+// PersonalToken.Validate if the designated constraints aren't met.
+type PersonalTokenValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3032,22 +5282,22 @@ type ServiceUserKeyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ServiceUserKeyValidationError) Field() string { return e.field }
+func (e PersonalTokenValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ServiceUserKeyValidationError) Reason() string { return e.reason }
+func (e PersonalTokenValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ServiceUserKeyValidationError) Cause() error { return e.cause }
+func (e PersonalTokenValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ServiceUserKeyValidationError) Key() bool { return e.key }
+func (e PersonalTokenValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ServiceUserKeyValidationError) ErrorName() string { return "ServiceUserKeyValidationError" }
+func (e PersonalTokenValidationError) ErrorName() string { return "PersonalTokenValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ServiceUserKeyValidationError) Error() string {
+func (e PersonalTokenValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3059,14 +5309,14 @@ func (e ServiceUserKeyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sServiceUserKey.%s: %s%s",
+		"invalid %sPersonalToken.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ServiceUserKeyValidationError{}
+var _ error = PersonalTokenValidationError{}
 
 var _ interface {
 	Field() string
@@ -3074,52 +5324,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ServiceUserKeyValidationError{}
+} = PersonalTokenValidationError{}
 
-// Validate checks the field values on KeyCredential with the rules defined in
+// Validate checks the field values on DeleteReport with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *KeyCredential) Validate() error {
+func (m *DeleteReport) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on KeyCredential with the rules defined
+// ValidateAll checks the field values on DeleteReport with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in KeyCredentialMultiError, or
+// result is a list of violation errors wrapped in DeleteReportMultiError, or
 // nil if none found.
-func (m *KeyCredential) ValidateAll() error {
+func (m *DeleteReport) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *KeyCredential) validate(all bool) error {
+func (m *DeleteReport) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
-
-	// no validation rules for Kid
-
-	// no validation rules for PrincipalId
-
-	// no validation rules for PrivateKey
-
 	if len(errors) > 0 {
-		return KeyCredentialMultiError(errors)
+		return DeleteReportMultiError(errors)
 	}
 
 	return nil
 }
 
-// KeyCredentialMultiError is an error wrapping multiple validation errors
-// returned by KeyCredential.ValidateAll() if the designated constraints
-// aren't met.
-type KeyCredentialMultiError []error
+// DeleteReportMultiError is an error wrapping multiple validation errors
+// returned by DeleteReport.ValidateAll() if the designated constraints aren't met.
+type DeleteReportMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m KeyCredentialMultiError) Error() string {
+func (m DeleteReportMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3128,11 +5369,11 @@ func (m KeyCredentialMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m KeyCredentialMultiError) AllErrors() []error { return m }
+func (m DeleteReportMultiError) AllErrors() []error { return m }
 
-// KeyCredentialValidationError is the validation error returned by
-// KeyCredential.Validate if the designated constraints aren't met.
-type KeyCredentialValidationError struct {
+// DeleteReportValidationError is the validation error returned by
+// DeleteReport.Validate if the designated constraints aren't met.
+type DeleteReportValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3140,22 +5381,22 @@ type KeyCredentialValidationError struct {
 }
 
 // Field function returns field value.
-func (e KeyCredentialValidationError) Field() string { return e.field }
+func (e DeleteReportValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e KeyCredentialValidationError) Reason() string { return e.reason }
+func (e DeleteReportValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e KeyCredentialValidationError) Cause() error { return e.cause }
+func (e DeleteReportValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e KeyCredentialValidationError) Key() bool { return e.key }
+func (e DeleteReportValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e KeyCredentialValidationError) ErrorName() string { return "KeyCredentialValidationError" }
+func (e DeleteReportValidationError) ErrorName() string { return "DeleteReportValidationError" }
 
 // Error satisfies the builtin error interface
-func (e KeyCredentialValidationError) Error() string {
+func (e DeleteReportValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3167,14 +5408,14 @@ func (e KeyCredentialValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sKeyCredential.%s: %s%s",
+		"invalid %sDeleteReport.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = KeyCredentialValidationError{}
+var _ error = DeleteReportValidationError{}
 
 var _ interface {
 	Field() string
@@ -3182,79 +5423,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = KeyCredentialValidationError{}
+} = DeleteReportValidationError{}
 
-// Validate checks the field values on SecretCredential with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *SecretCredential) Validate() error {
+// Validate checks the field values on JobItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobItem) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SecretCredential with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SecretCredentialMultiError, or nil if none found.
-func (m *SecretCredential) ValidateAll() error {
+// ValidateAll checks the field values on JobItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in JobItemMultiError, or nil if none found.
+func (m *JobItem) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SecretCredential) validate(all bool) error {
+func (m *JobItem) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Title
+	// no validation rules for Key
 
-	// no validation rules for Secret
+	// no validation rules for State
 
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SecretCredentialValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SecretCredentialValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SecretCredentialValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Error
 
 	if len(errors) > 0 {
-		return SecretCredentialMultiError(errors)
+		return JobItemMultiError(errors)
 	}
 
 	return nil
 }
 
-// SecretCredentialMultiError is an error wrapping multiple validation errors
-// returned by SecretCredential.ValidateAll() if the designated constraints
-// aren't met.
-type SecretCredentialMultiError []error
+// JobItemMultiError is an error wrapping multiple validation errors returned
+// by JobItem.ValidateAll() if the designated constraints aren't met.
+type JobItemMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SecretCredentialMultiError) Error() string {
+func (m JobItemMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3263,11 +5473,11 @@ func (m SecretCredentialMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SecretCredentialMultiError) AllErrors() []error { return m }
+func (m JobItemMultiError) AllErrors() []error { return m }
 
-// SecretCredentialValidationError is the validation error returned by
-// SecretCredential.Validate if the designated constraints aren't met.
-type SecretCredentialValidationError struct {
+// JobItemValidationError is the validation error returned by JobItem.Validate
+// if the designated constraints aren't met.
+type JobItemValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3275,22 +5485,22 @@ type SecretCredentialValidationError struct {
 }
 
 // Field function returns field value.
-func (e SecretCredentialValidationError) Field() string { return e.field }
+func (e JobItemValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SecretCredentialValidationError) Reason() string { return e.reason }
+func (e JobItemValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SecretCredentialValidationError) Cause() error { return e.cause }
+func (e JobItemValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SecretCredentialValidationError) Key() bool { return e.key }
+func (e JobItemValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SecretCredentialValidationError) ErrorName() string { return "SecretCredentialValidationError" }
+func (e JobItemValidationError) ErrorName() string { return "JobItemValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SecretCredentialValidationError) Error() string {
+func (e JobItemValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3302,14 +5512,14 @@ func (e SecretCredentialValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSecretCredential.%s: %s%s",
+		"invalid %sJobItem.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SecretCredentialValidationError{}
+var _ error = JobItemValidationError{}
 
 var _ interface {
 	Field() string
@@ -3317,61 +5527,148 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SecretCredentialValidationError{}
+} = JobItemValidationError{}
 
-// Validate checks the field values on JSONWebKey with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *JSONWebKey) Validate() error {
+// Validate checks the field values on Job with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Job) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JSONWebKey with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in JSONWebKeyMultiError, or
-// nil if none found.
-func (m *JSONWebKey) ValidateAll() error {
+// ValidateAll checks the field values on Job with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in JobMultiError, or nil if none found.
+func (m *Job) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JSONWebKey) validate(all bool) error {
+func (m *Job) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Kty
+	// no validation rules for Id
 
-	// no validation rules for Alg
+	// no validation rules for Kind
 
-	// no validation rules for Use
+	// no validation rules for State
 
-	// no validation rules for Kid
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for N
+	}
 
-	// no validation rules for E
+	// no validation rules for Error
 
-	// no validation rules for X
+	// no validation rules for CreatedBy
 
-	// no validation rules for Y
+	// no validation rules for Succeeded
 
-	// no validation rules for Crv
+	// no validation rules for Failed
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return JSONWebKeyMultiError(errors)
+		return JobMultiError(errors)
 	}
 
 	return nil
 }
 
-// JSONWebKeyMultiError is an error wrapping multiple validation errors
-// returned by JSONWebKey.ValidateAll() if the designated constraints aren't met.
-type JSONWebKeyMultiError []error
+// JobMultiError is an error wrapping multiple validation errors returned by
+// Job.ValidateAll() if the designated constraints aren't met.
+type JobMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JSONWebKeyMultiError) Error() string {
+func (m JobMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3380,11 +5677,11 @@ func (m JSONWebKeyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JSONWebKeyMultiError) AllErrors() []error { return m }
+func (m JobMultiError) AllErrors() []error { return m }
 
-// JSONWebKeyValidationError is the validation error returned by
-// JSONWebKey.Validate if the designated constraints aren't met.
-type JSONWebKeyValidationError struct {
+// JobValidationError is the validation error returned by Job.Validate if the
+// designated constraints aren't met.
+type JobValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3392,22 +5689,22 @@ type JSONWebKeyValidationError struct {
 }
 
 // Field function returns field value.
-func (e JSONWebKeyValidationError) Field() string { return e.field }
+func (e JobValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JSONWebKeyValidationError) Reason() string { return e.reason }
+func (e JobValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JSONWebKeyValidationError) Cause() error { return e.cause }
+func (e JobValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JSONWebKeyValidationError) Key() bool { return e.key }
+func (e JobValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JSONWebKeyValidationError) ErrorName() string { return "JSONWebKeyValidationError" }
+func (e JobValidationError) ErrorName() string { return "JobValidationError" }
 
 // Error satisfies the builtin error interface
-func (e JSONWebKeyValidationError) Error() string {
+func (e JobValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3419,14 +5716,14 @@ func (e JSONWebKeyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJSONWebKey.%s: %s%s",
+		"invalid %sJob.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JSONWebKeyValidationError{}
+var _ error = JobValidationError{}
 
 var _ interface {
 	Field() string
@@ -3434,7 +5731,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JSONWebKeyValidationError{}
+} = JobValidationError{}
 
 // Validate checks the field values on AuditLogActor with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
@@ -4322,6 +6619,8 @@ func (m *RoleRequestBody) validate(all bool) error {
 
 	// no validation rules for Title
 
+	// no validation rules for ClearParents
+
 	if len(errors) > 0 {
 		return RoleRequestBodyMultiError(errors)
 	}
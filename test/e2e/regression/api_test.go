@@ -1243,7 +1243,7 @@ func (s *APIRegressionTestSuite) TestInvitationAPI() {
 		s.Assert().NoError(err)
 		s.Assert().Len(listGroupUsersBeforeAccept.GetUsers(), 1)
 
-		// accept invite should add user to org and delete it
+		// accept invite should add user to org and close it
 		ctxOrgUserAuth := metadata.NewOutgoingContext(context.Background(), metadata.New(map[string]string{
 			testbench.IdentityHeader: createUserResp.GetUser().GetEmail(),
 		}))
@@ -1260,8 +1260,14 @@ func (s *APIRegressionTestSuite) TestInvitationAPI() {
 		s.Assert().NoError(err)
 		s.Assert().Equal(1, len(userOrgsAfterAcceptResp.GetOrganizations()))
 
-		// invitation should be deleted
-		_, err = s.testBench.Client.GetOrganizationInvitation(ctxOrgAdminAuth, &frontierv1beta1.GetOrganizationInvitationRequest{
+		// invitation should be kept as accepted and can't be accepted again
+		getAcceptedInviteResp, err := s.testBench.Client.GetOrganizationInvitation(ctxOrgAdminAuth, &frontierv1beta1.GetOrganizationInvitationRequest{
+			Id:    createdInvite.GetId(),
+			OrgId: existingOrg.GetOrganization().GetId(),
+		})
+		s.Assert().NoError(err)
+		s.Assert().Equal("accepted", getAcceptedInviteResp.GetInvitation().GetStatus())
+		_, err = s.testBench.Client.AcceptOrganizationInvitation(ctxOrgUserAuth, &frontierv1beta1.AcceptOrganizationInvitationRequest{
 			Id:    createdInvite.GetId(),
 			OrgId: existingOrg.GetOrganization().GetId(),
 		})